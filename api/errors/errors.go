@@ -34,6 +34,12 @@ var ErrGetESDTBalance = errors.New("get esdt balance for account error")
 // ErrGetGuardianData signals an error in getting the guardian data for given address
 var ErrGetGuardianData = errors.New("get guardian data for account error")
 
+// ErrGetAccountTransactions signals an error in getting the transactions of a given address
+var ErrGetAccountTransactions = errors.New("get transactions for account error")
+
+// ErrInvalidAddress signals that an invalid address was provided
+var ErrInvalidAddress = errors.New("invalid address")
+
 // ErrGetRolesForAccount signals an error in getting esdt tokens and roles for a given address
 var ErrGetRolesForAccount = errors.New("get roles for account error")
 
@@ -82,6 +88,9 @@ var ErrValidationEmptyBlockHash = errors.New("block hash is empty")
 // ErrGetTransaction signals an error happening when trying to fetch a transaction
 var ErrGetTransaction = errors.New("getting transaction failed")
 
+// ErrTraceTransaction signals an error happening when trying to trace a transaction's execution
+var ErrTraceTransaction = errors.New("tracing transaction failed")
+
 // ErrGetSmartContractResults signals an error happening when trying to fetch smart contract results
 var ErrGetSmartContractResults = errors.New("getting smart contract results failed")
 
@@ -183,3 +192,42 @@ var ErrGetWaitingEpochsLeftForPublicKey = errors.New("error getting the waiting
 
 // ErrRecursiveRelayedTxIsNotAllowed signals that recursive relayed tx is not allowed
 var ErrRecursiveRelayedTxIsNotAllowed = errors.New("recursive relayed tx is not allowed")
+
+// ErrSubscribe signals that an error occurred while subscribing to the node events
+var ErrSubscribe = errors.New("error subscribing to the node events")
+
+// ErrGetEvents signals that an error occurred while querying the events
+var ErrGetEvents = errors.New("error getting events")
+
+// ErrInvalidStateOverrides signals that invalid state overrides were provided
+var ErrInvalidStateOverrides = errors.New("invalid state overrides")
+
+// ErrNilStateOverride signals that a nil state override was provided
+var ErrNilStateOverride = errors.New("nil state override")
+
+// ErrUnauthorized signals that the request does not provide valid credentials
+var ErrUnauthorized = errors.New("unauthorized")
+
+// ErrForbidden signals that the caller's role does not allow accessing the endpoint
+var ErrForbidden = errors.New("forbidden")
+
+// ErrInvalidAuthenticationConfig signals that the REST API authentication configuration is invalid
+var ErrInvalidAuthenticationConfig = errors.New("invalid REST API authentication config")
+
+// ErrAddManagedKey signals that an error occurred while adding a managed key
+var ErrAddManagedKey = errors.New("error adding the managed key")
+
+// ErrRemoveManagedKey signals that an error occurred while removing a managed key
+var ErrRemoveManagedKey = errors.New("error removing the managed key")
+
+// ErrPauseManagedKey signals that an error occurred while pausing a managed key
+var ErrPauseManagedKey = errors.New("error pausing the managed key")
+
+// ErrResumeManagedKey signals that an error occurred while resuming a managed key
+var ErrResumeManagedKey = errors.New("error resuming the managed key")
+
+// ErrStartTrieVerification signals that an error occurred while starting a state trie verification
+var ErrStartTrieVerification = errors.New("error starting the trie verification")
+
+// ErrGetTrieVerificationStatus signals that an error occurred while getting the state trie verification status
+var ErrGetTrieVerificationStatus = errors.New("error getting the trie verification status")
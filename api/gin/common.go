@@ -1,8 +1,12 @@
 package gin
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
 	"reflect"
 
 	"github.com/gin-gonic/gin"
@@ -12,6 +16,9 @@ import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/logs"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/api/openapi"
+	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/config"
 	"gopkg.in/go-playground/validator.v8"
 )
@@ -43,13 +50,21 @@ func checkArgs(args ArgsNewWebServer) error {
 }
 
 func isLogRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
-	logConfig, ok := routesConfig.APIPackages["log"]
+	return isRouteOpen(routesConfig, "log", "/log")
+}
+
+func isOpenAPIRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
+	return isRouteOpen(routesConfig, "openapi", openAPIRoute)
+}
+
+func isRouteOpen(routesConfig config.ApiRoutesConfig, packageName string, routeName string) bool {
+	packageConfig, ok := routesConfig.APIPackages[packageName]
 	if !ok {
 		return false
 	}
 
-	for _, cfg := range logConfig.Routes {
-		if cfg.Name == "/log" && cfg.Open {
+	for _, cfg := range packageConfig.Routes {
+		if cfg.Name == routeName && cfg.Open {
 			return true
 		}
 	}
@@ -57,6 +72,52 @@ func isLogRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
 	return false
 }
 
+// parseUnauthenticatedRole defaults to the public role, so the configuration files lacking the authentication section
+// do not open the operator and admin endpoints to the anonymous callers
+func parseUnauthenticatedRole(roleName string) (shared.AccessRole, error) {
+	if len(roleName) == 0 {
+		return shared.RolePublic, nil
+	}
+
+	role, err := shared.ParseAccessRole(roleName)
+	if err != nil {
+		return shared.RolePublic, fmt.Errorf("%w: %s", apiErrors.ErrInvalidAuthenticationConfig, err.Error())
+	}
+
+	return role, nil
+}
+
+// createTLSConfig loads the server certificate and, if provided, the CA used to verify the client certificates. The
+// client certificates are optional, the callers without one will be authenticated by their token, if any
+func createTLSConfig(tlsConfig config.ApiTLSConfig) (*tls.Config, error) {
+	certificate, err := tls.LoadX509KeyPair(tlsConfig.CertificateFile, tlsConfig.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s while loading the REST API certificate", apiErrors.ErrInvalidAuthenticationConfig, err.Error())
+	}
+
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if len(tlsConfig.ClientCAFile) == 0 {
+		return cfg, nil
+	}
+
+	caBuff, err := os.ReadFile(tlsConfig.ClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s while reading the client CA file", apiErrors.ErrInvalidAuthenticationConfig, err.Error())
+	}
+
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caBuff) {
+		return nil, fmt.Errorf("%w: no certificate found in the client CA file %s", apiErrors.ErrInvalidAuthenticationConfig, tlsConfig.ClientCAFile)
+	}
+	cfg.ClientCAs = clientCAs
+	cfg.ClientAuth = tls.VerifyClientCertIfGiven
+
+	return cfg, nil
+}
+
 func registerValidators() error {
 	validators := []validatorInput{
 		{
@@ -79,7 +140,7 @@ func registerValidators() error {
 func registerLoggerWsRoute(ws *gin.Engine, marshalizer marshal.Marshalizer) {
 	upgrader := websocket.Upgrader{}
 
-	ws.GET("/log", func(c *gin.Context) {
+	ws.GET("/log", middleware.CreateRoleChecker(shared.RoleOperator), func(c *gin.Context) {
 		upgrader.CheckOrigin = func(r *http.Request) bool {
 			return true
 		}
@@ -99,3 +160,27 @@ func registerLoggerWsRoute(ws *gin.Engine, marshalizer marshal.Marshalizer) {
 		ls.StartSendingBlocking()
 	})
 }
+
+// registerOpenAPIRoute generates the OpenAPI document describing the open endpoints of the provided groups and serves it
+func registerOpenAPIRoute(ws *gin.Engine, groupsMap map[string]shared.GroupHandler, routesConfig config.ApiRoutesConfig) error {
+	doc, err := openapi.GenerateDocument(openapi.ArgsGenerateDocument{
+		Title:     openAPITitle,
+		Version:   openAPIDocumentVersion,
+		Groups:    groupsMap,
+		ApiConfig: routesConfig,
+	})
+	if err != nil {
+		return err
+	}
+
+	docBytes, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	ws.GET(openAPIRoute, middleware.CreateRoleChecker(shared.RolePublic), func(c *gin.Context) {
+		c.Data(http.StatusOK, gin.MIMEJSON, docBytes)
+	})
+
+	return nil
+}
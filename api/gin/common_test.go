@@ -1,10 +1,28 @@
 package gin
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
 	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/gin-gonic/gin"
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/openapi"
+	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/facade/initial"
 	"github.com/multiversx/mx-chain-go/testscommon"
@@ -53,3 +71,179 @@ func TestCommon_isLogRouteEnabled(t *testing.T) {
 	require.True(t, isLogRouteEnabled(routesConfig))
 	require.False(t, isLogRouteEnabled(config.ApiRoutesConfig{}))
 }
+
+func TestCommon_isOpenAPIRouteEnabled(t *testing.T) {
+	t.Parallel()
+
+	routesConfig := config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"openapi": {
+				Routes: []config.RouteConfig{
+					{Name: "/openapi.json", Open: true},
+				},
+			},
+		},
+	}
+	require.True(t, isOpenAPIRouteEnabled(routesConfig))
+	require.False(t, isLogRouteEnabled(routesConfig))
+
+	routesConfig.APIPackages["openapi"].Routes[0].Open = false
+	require.False(t, isOpenAPIRouteEnabled(routesConfig))
+	require.False(t, isOpenAPIRouteEnabled(config.ApiRoutesConfig{}))
+}
+
+func TestCommon_registerOpenAPIRoute(t *testing.T) {
+	t.Parallel()
+
+	ws := &webServer{
+		facade: &mock.FacadeStub{},
+	}
+	require.NoError(t, ws.createGroups())
+
+	// all the routes are opened, so any route added without docs will make the generation fail
+	numEndpoints := 0
+	routesConfig := config.ApiRoutesConfig{
+		APIPackages: make(map[string]config.APIPackageConfig),
+	}
+	for groupName, groupHandler := range ws.groups {
+		routes := make([]config.RouteConfig, 0)
+		for _, endpoint := range groupHandler.GetEndpoints() {
+			routes = append(routes, config.RouteConfig{Name: endpoint.Path, Open: true})
+			numEndpoints++
+		}
+		routesConfig.APIPackages[groupName] = config.APIPackageConfig{Routes: routes}
+	}
+
+	engine := gin.New()
+	err := registerOpenAPIRoute(engine, ws.groups, routesConfig)
+	require.NoError(t, err)
+
+	req, _ := http.NewRequest(http.MethodGet, "/openapi.json", nil)
+	resp := httptest.NewRecorder()
+	engine.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	doc := &openapi.Document{}
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), doc))
+	require.Equal(t, "3.0.3", doc.OpenAPI)
+
+	numOperations := 0
+	for _, pathItem := range doc.Paths {
+		numOperations += len(pathItem)
+	}
+	require.Equal(t, numEndpoints, numOperations)
+
+	getAccount := doc.Paths["/address/{address}"]["get"]
+	require.NotNil(t, getAccount)
+	paramsNames := make([]string, 0, len(getAccount.Parameters))
+	for _, param := range getAccount.Parameters {
+		paramsNames = append(paramsNames, param.Name)
+	}
+	require.Equal(t, []string{"address", "withKeys", "onFinalBlock", "onStartOfEpoch", "blockNonce", "blockHash", "blockRootHash", "hintEpoch"}, paramsNames)
+
+	sendTx := doc.Paths["/transaction/send"]["post"]
+	require.NotNil(t, sendTx)
+	require.Equal(t, "#/components/schemas/transaction.FrontendTransaction", sendTx.RequestBody.Content["application/json"].Schema.Ref)
+	require.NotNil(t, doc.Components.Schemas["transaction.FrontendTransaction"])
+}
+
+func TestCommon_parseUnauthenticatedRole(t *testing.T) {
+	t.Parallel()
+
+	role, err := parseUnauthenticatedRole("")
+	require.NoError(t, err)
+	require.Equal(t, shared.RolePublic, role)
+
+	role, err = parseUnauthenticatedRole("Operator")
+	require.NoError(t, err)
+	require.Equal(t, shared.RoleOperator, role)
+
+	_, err = parseUnauthenticatedRole("root")
+	require.True(t, errors.Is(err, apiErrors.ErrInvalidAuthenticationConfig))
+}
+
+func TestCommon_createTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing certificate should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg, err := createTLSConfig(config.ApiTLSConfig{
+			Enabled:         true,
+			CertificateFile: filepath.Join(t.TempDir(), "missing.pem"),
+			KeyFile:         filepath.Join(t.TempDir(), "missing.pem"),
+		})
+		require.True(t, errors.Is(err, apiErrors.ErrInvalidAuthenticationConfig))
+		require.Nil(t, cfg)
+	})
+	t.Run("invalid client CA file should error", func(t *testing.T) {
+		t.Parallel()
+
+		certFile, keyFile := createTestCertificateFiles(t)
+		clientCAFile := filepath.Join(t.TempDir(), "clientCA.pem")
+		require.NoError(t, os.WriteFile(clientCAFile, []byte("not a certificate"), 0600))
+
+		cfg, err := createTLSConfig(config.ApiTLSConfig{
+			Enabled:         true,
+			CertificateFile: certFile,
+			KeyFile:         keyFile,
+			ClientCAFile:    clientCAFile,
+		})
+		require.True(t, errors.Is(err, apiErrors.ErrInvalidAuthenticationConfig))
+		require.Nil(t, cfg)
+	})
+	t.Run("without client CA should not verify client certificates", func(t *testing.T) {
+		t.Parallel()
+
+		certFile, keyFile := createTestCertificateFiles(t)
+		cfg, err := createTLSConfig(config.ApiTLSConfig{
+			Enabled:         true,
+			CertificateFile: certFile,
+			KeyFile:         keyFile,
+		})
+		require.NoError(t, err)
+		require.Len(t, cfg.Certificates, 1)
+		require.Nil(t, cfg.ClientCAs)
+		require.Equal(t, tls.NoClientCert, cfg.ClientAuth)
+	})
+	t.Run("with client CA should verify the provided client certificates", func(t *testing.T) {
+		t.Parallel()
+
+		certFile, keyFile := createTestCertificateFiles(t)
+		cfg, err := createTLSConfig(config.ApiTLSConfig{
+			Enabled:         true,
+			CertificateFile: certFile,
+			KeyFile:         keyFile,
+			ClientCAFile:    certFile,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, cfg.ClientCAs)
+		require.Equal(t, tls.VerifyClientCertIfGiven, cfg.ClientAuth)
+	})
+}
+
+func createTestCertificateFiles(tb testing.TB) (string, string) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(tb, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "node"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.NoError(tb, err)
+	keyBytes, err := x509.MarshalECPrivateKey(privateKey)
+	require.NoError(tb, err)
+
+	dir := tb.TempDir()
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	require.NoError(tb, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes}), 0600))
+	require.NoError(tb, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}), 0600))
+
+	return certFile, keyFile
+}
@@ -8,6 +8,16 @@ import (
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
 )
 
+// tlsServer is an http.Server that serves HTTPS, using the certificates already set in its TLSConfig
+type tlsServer struct {
+	*http.Server
+}
+
+// ListenAndServe listens on the configured address and serves HTTPS
+func (ts *tlsServer) ListenAndServe() error {
+	return ts.Server.ListenAndServeTLS("", "")
+}
+
 type httpServer struct {
 	server server
 }
@@ -1,12 +1,33 @@
 package gin
 
-import "context"
+import (
+	"context"
+	"crypto/tls"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-go/api/shared"
+)
 
 type resetHandler interface {
 	Reset()
 	IsInterfaceNil() bool
 }
 
+// authenticator is shared by the REST and the gRPC servers
+type authenticator interface {
+	MiddlewareHandlerFunc() gin.HandlerFunc
+	Authenticate(header string, tlsState *tls.ConnectionState) (shared.AccessRole, string, bool)
+	IsInterfaceNil() bool
+}
+
+// rateLimiter is shared by the REST and the gRPC servers, so a caller is charged from the same bucket on both
+type rateLimiter interface {
+	MiddlewareHandlerFunc() gin.HandlerFunc
+	ConsumeCallerCost(identity string, remoteAddr string, forwardedFor []string, endpoint string) (bool, error)
+	Reset()
+	IsInterfaceNil() bool
+}
+
 type server interface {
 	ListenAndServe() error
 	Shutdown(ctx context.Context) error
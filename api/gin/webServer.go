@@ -14,6 +14,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/groups"
+	apiGrpc "github.com/multiversx/mx-chain-go/api/grpc"
 	"github.com/multiversx/mx-chain-go/api/middleware"
 	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/config"
@@ -24,7 +25,13 @@ import (
 
 var log = logger.GetOrCreate("api/gin")
 
-const prometheusMetricsRoute = "/debug/metrics/prometheus"
+const (
+	prometheusMetricsRoute            = "/debug/metrics/prometheus"
+	openAPIRoute                      = "/openapi.json"
+	openAPITitle                      = "MultiversX node REST API"
+	openAPIDocumentVersion            = "1.0.0"
+	defaultIdleBucketsCleanupInterval = time.Minute
+)
 
 // ArgsNewWebServer holds the arguments needed to create a new instance of webServer
 type ArgsNewWebServer struct {
@@ -39,8 +46,11 @@ type webServer struct {
 	apiConfig       config.ApiRoutesConfig
 	antiFloodConfig config.WebServerAntifloodConfig
 	httpServer      shared.HttpServerCloser
+	grpcServer      shared.GRPCServerHandler
 	groups          map[string]shared.GroupHandler
 	cancelFunc      func()
+
+	rateLimiterCancelFunc func()
 }
 
 // NewGinWebServerHandler returns a new instance of webServer
@@ -77,6 +87,13 @@ func (ws *webServer) UpdateFacade(facade shared.FacadeHandler) error {
 		}
 	}
 
+	if !check.IfNil(ws.grpcServer) {
+		err := ws.grpcServer.UpdateFacade(facade)
+		if err != nil {
+			log.Error("cannot update facade for the gRPC server", "error", err)
+		}
+	}
+
 	return nil
 }
 
@@ -85,6 +102,21 @@ func (ws *webServer) StartHttpServer() error {
 	ws.Lock()
 	defer ws.Unlock()
 
+	auth, err := ws.createAuthenticator()
+	if err != nil {
+		return err
+	}
+
+	limiter, err := ws.createRateLimiterIfEnabled()
+	if err != nil {
+		return err
+	}
+
+	err = ws.startGRPCServer(auth, limiter)
+	if err != nil {
+		return err
+	}
+
 	if ws.facade.RestApiInterface() == facade.DefaultRestPortOff {
 		log.Debug("web server is turned off")
 		return nil
@@ -100,7 +132,7 @@ func (ws *webServer) StartHttpServer() error {
 	engine = gin.Default()
 	engine.Use(cors.Default())
 
-	processors, err := ws.createMiddlewareLimiters()
+	processors, err := ws.createMiddlewareLimiters(auth, limiter)
 	if err != nil {
 		return err
 	}
@@ -126,8 +158,13 @@ func (ws *webServer) StartHttpServer() error {
 
 	ws.registerRoutes(engine)
 
-	server := &http.Server{Addr: ws.facade.RestApiInterface(), Handler: engine}
-	log.Debug("creating gin web sever", "interface", ws.facade.RestApiInterface())
+	server, err := ws.createServer(engine)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("creating gin web sever", "interface", ws.facade.RestApiInterface(),
+		"TLS enabled", ws.apiConfig.Authentication.TLS.Enabled)
 	ws.httpServer, err = NewHttpServer(server)
 	if err != nil {
 		return err
@@ -148,6 +185,57 @@ func (ws *webServer) StartHttpServer() error {
 	return nil
 }
 
+// startGRPCServer starts the gRPC server, if enabled, with the same authenticator and rate limiter as the REST server
+func (ws *webServer) startGRPCServer(auth authenticator, limiter rateLimiter) error {
+	if !ws.apiConfig.GRPC.Enabled {
+		return nil
+	}
+
+	args := apiGrpc.ArgsNewServer{
+		Facade:        ws.facade,
+		Config:        ws.apiConfig.GRPC,
+		Authenticator: auth,
+	}
+	if !check.IfNil(limiter) {
+		args.RateLimiter = limiter
+	}
+	if ws.apiConfig.Authentication.TLS.Enabled {
+		tlsConfig, err := createTLSConfig(ws.apiConfig.Authentication.TLS)
+		if err != nil {
+			return err
+		}
+		args.TLSConfig = tlsConfig
+	}
+
+	grpcServer, err := apiGrpc.NewServer(args)
+	if err != nil {
+		return err
+	}
+
+	err = grpcServer.Start()
+	if err != nil {
+		return err
+	}
+	ws.grpcServer = grpcServer
+
+	return nil
+}
+
+func (ws *webServer) createServer(engine *gin.Engine) (server, error) {
+	httpServer := &http.Server{Addr: ws.facade.RestApiInterface(), Handler: engine}
+	if !ws.apiConfig.Authentication.TLS.Enabled {
+		return httpServer, nil
+	}
+
+	tlsConfig, err := createTLSConfig(ws.apiConfig.Authentication.TLS)
+	if err != nil {
+		return nil, err
+	}
+	httpServer.TLSConfig = tlsConfig
+
+	return &tlsServer{Server: httpServer}, nil
+}
+
 func (ws *webServer) createGroups() error {
 	groupsMap := make(map[string]shared.GroupHandler)
 	addressGroup, err := groups.NewAddressGroup(ws.facade)
@@ -162,6 +250,12 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["block"] = blockGroup
 
+	eventsGroup, err := groups.NewEventsGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["events"] = eventsGroup
+
 	internalBlockGroup, err := groups.NewInternalBlockGroup(ws.facade)
 	if err != nil {
 		return err
@@ -192,6 +286,18 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["proof"] = proofGroup
 
+	rpcGroup, err := groups.NewRPCGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["rpc"] = rpcGroup
+
+	subscriptionsGroup, err := groups.NewSubscriptionsGroup(ws.facade, ws.apiConfig.Subscriptions.AllowedOrigins)
+	if err != nil {
+		return err
+	}
+	groupsMap["subscriptions"] = subscriptionsGroup
+
 	transactionGroup, err := groups.NewTransactionGroup(ws.facade)
 	if err != nil {
 		return err
@@ -227,16 +333,23 @@ func (ws *webServer) registerRoutes(ginRouter *gin.Engine) {
 		registerLoggerWsRoute(ginRouter, marshalizerForLogs)
 	}
 
+	if isOpenAPIRouteEnabled(ws.apiConfig) {
+		err := registerOpenAPIRoute(ginRouter, ws.groups, ws.apiConfig)
+		if err != nil {
+			log.Error("could not register the OpenAPI route", "error", err)
+		}
+	}
+
 	if ws.facade.PprofEnabled() {
-		pprof.Register(ginRouter)
+		pprof.RouteRegister(ginRouter.Group("", middleware.CreateRoleChecker(shared.RoleOperator)))
 	}
 
 	if ws.facade.P2PPrometheusMetricsEnabled() {
-		ginRouter.GET(prometheusMetricsRoute, gin.WrapH(promhttp.Handler()))
+		ginRouter.GET(prometheusMetricsRoute, middleware.CreateRoleChecker(shared.RolePublic), gin.WrapH(promhttp.Handler()))
 	}
 }
 
-func (ws *webServer) createMiddlewareLimiters() ([]shared.MiddlewareProcessor, error) {
+func (ws *webServer) createMiddlewareLimiters(auth authenticator, limiter rateLimiter) ([]shared.MiddlewareProcessor, error) {
 	middlewares := make([]shared.MiddlewareProcessor, 0)
 
 	if ws.apiConfig.Logging.LoggingEnabled {
@@ -253,7 +366,8 @@ func (ws *webServer) createMiddlewareLimiters() ([]shared.MiddlewareProcessor, e
 		var ctx context.Context
 		ctx, ws.cancelFunc = context.WithCancel(context.Background())
 
-		go ws.sourceLimiterReset(ctx, sourceLimiter)
+		betweenResetDuration := time.Second * time.Duration(ws.antiFloodConfig.SameSourceResetIntervalInSec)
+		go ws.resetPeriodically(ctx, sourceLimiter, betweenResetDuration)
 
 		middlewares = append(middlewares, sourceLimiter)
 
@@ -265,18 +379,70 @@ func (ws *webServer) createMiddlewareLimiters() ([]shared.MiddlewareProcessor, e
 		middlewares = append(middlewares, globalLimiter)
 	}
 
+	middlewares = append(middlewares, auth)
+	if !check.IfNil(limiter) {
+		middlewares = append(middlewares, limiter)
+	}
+
 	return middlewares, nil
 }
 
-func (ws *webServer) sourceLimiterReset(ctx context.Context, reset resetHandler) {
-	betweenResetDuration := time.Second * time.Duration(ws.antiFloodConfig.SameSourceResetIntervalInSec)
+func (ws *webServer) createAuthenticator() (authenticator, error) {
+	unauthenticatedRole, err := parseUnauthenticatedRole(ws.apiConfig.Authentication.UnauthenticatedRole)
+	if err != nil {
+		return nil, err
+	}
+
+	return middleware.NewAuthenticator(middleware.ArgsAuthenticator{
+		TokensFile:          ws.apiConfig.Authentication.TokensFile,
+		UnauthenticatedRole: unauthenticatedRole,
+	})
+}
+
+// createRateLimiterIfEnabled creates the token bucket limiter and starts the go routine removing its idle buckets.
+// Returns nil if the rate limiter is disabled
+func (ws *webServer) createRateLimiterIfEnabled() (rateLimiter, error) {
+	if !ws.antiFloodConfig.WebServerAntifloodEnabled || !ws.antiFloodConfig.RateLimiter.Enabled {
+		return nil, nil
+	}
+
+	rateLimiterConfig := ws.antiFloodConfig.RateLimiter
+	endpointsCosts := make(map[string]uint32, len(rateLimiterConfig.EndpointsCosts))
+	for _, endpointCost := range rateLimiterConfig.EndpointsCosts {
+		endpointsCosts[endpointCost.Endpoint] = endpointCost.Cost
+	}
+
+	limiter, err := middleware.NewTokenBucketLimiter(middleware.ArgsTokenBucketLimiter{
+		BucketCapacity:        rateLimiterConfig.BucketCapacity,
+		RefillTokensPerSecond: rateLimiterConfig.RefillTokensPerSecond,
+		DefaultCost:           rateLimiterConfig.DefaultCost,
+		EndpointsCosts:        endpointsCosts,
+		TrustedProxies:        rateLimiterConfig.TrustedProxies,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cleanupInterval := time.Second * time.Duration(rateLimiterConfig.IdleBucketsCleanupIntervalInSec)
+	if cleanupInterval == 0 {
+		cleanupInterval = defaultIdleBucketsCleanupInterval
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ws.rateLimiterCancelFunc = cancel
+	go ws.resetPeriodically(ctx, limiter, cleanupInterval)
+
+	return limiter, nil
+}
+
+func (ws *webServer) resetPeriodically(ctx context.Context, reset resetHandler, betweenResetDuration time.Duration) {
 	for {
 		select {
 		case <-time.After(betweenResetDuration):
-			log.Trace("calling reset on WS source limiter")
+			log.Trace("calling reset on WS limiter")
 			reset.Reset()
 		case <-ctx.Done():
-			log.Debug("closing nodeFacade.sourceLimiterReset go routine")
+			log.Debug("closing webServer.resetPeriodically go routine")
 			return
 		}
 	}
@@ -287,19 +453,29 @@ func (ws *webServer) Close() error {
 	if ws.cancelFunc != nil {
 		ws.cancelFunc()
 	}
+	if ws.rateLimiterCancelFunc != nil {
+		ws.rateLimiterCancelFunc()
+	}
 
 	var err error
+	var errGRPC error
 	ws.Lock()
 	if !check.IfNil(ws.httpServer) {
 		err = ws.httpServer.Close()
 	}
+	if !check.IfNil(ws.grpcServer) {
+		errGRPC = ws.grpcServer.Close()
+	}
 	ws.Unlock()
 
 	if err != nil {
-		err = fmt.Errorf("%w while closing the http server in gin/webServer", err)
+		return fmt.Errorf("%w while closing the http server in gin/webServer", err)
+	}
+	if errGRPC != nil {
+		return fmt.Errorf("%w while closing the gRPC server in gin/webServer", errGRPC)
 	}
 
-	return err
+	return nil
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	apiGrpc "github.com/multiversx/mx-chain-go/api/grpc"
 	"github.com/multiversx/mx-chain-go/api/middleware"
 	"github.com/multiversx/mx-chain-go/api/mock"
 	"github.com/multiversx/mx-chain-go/api/shared"
@@ -45,6 +46,16 @@ func createMockArgsNewWebServer() ArgsNewWebServer {
 			SimultaneousRequests:         1,
 			SameSourceRequests:           1,
 			SameSourceResetIntervalInSec: 1,
+			RateLimiter: config.WebServerRateLimiterConfig{
+				Enabled:                         true,
+				BucketCapacity:                  100,
+				RefillTokensPerSecond:           10,
+				DefaultCost:                     1,
+				IdleBucketsCleanupIntervalInSec: 1,
+				EndpointsCosts: []config.EndpointCostConfig{
+					{Endpoint: "/log", Cost: 10},
+				},
+			},
 		},
 	}
 }
@@ -87,6 +98,43 @@ func TestWebServer_StartHttpServer(t *testing.T) {
 		err := ws.StartHttpServer()
 		require.Nil(t, err)
 	})
+	t.Run("invalid gRPC config should error", func(t *testing.T) {
+		args := createMockArgsNewWebServer()
+		args.ApiConfig.GRPC = config.ApiGRPCConfig{
+			Enabled:   true,
+			Interface: "localhost:0",
+		}
+		ws, _ := NewGinWebServerHandler(args)
+		require.NotNil(t, ws)
+
+		err := ws.StartHttpServer()
+		require.True(t, errors.Is(err, apiGrpc.ErrInvalidGRPCConfig))
+	})
+	t.Run("gRPC server should start beside a disabled REST server", func(t *testing.T) {
+		args := createMockArgsNewWebServer()
+		args.Facade = &mock.FacadeStub{
+			RestApiInterfaceCalled: func() string {
+				return facade.DefaultRestPortOff
+			},
+		}
+		args.ApiConfig.GRPC = config.ApiGRPCConfig{
+			Enabled:               true,
+			Interface:             "localhost:0",
+			MaxMessageSizeInBytes: 1024,
+			MaxConcurrentStreams:  1,
+		}
+		ws, _ := NewGinWebServerHandler(args)
+		require.NotNil(t, ws)
+
+		err := ws.StartHttpServer()
+		require.Nil(t, err)
+		require.NotNil(t, ws.grpcServer)
+		require.Nil(t, ws.httpServer)
+
+		err = ws.UpdateFacade(&mock.FacadeStub{})
+		require.Nil(t, err)
+		require.Nil(t, ws.Close())
+	})
 	t.Run("createMiddlewareLimiters returns error due to middleware.NewSourceThrottler error", func(t *testing.T) {
 		args := createMockArgsNewWebServer()
 		args.AntiFloodConfig.SameSourceRequests = 0
@@ -105,6 +153,21 @@ func TestWebServer_StartHttpServer(t *testing.T) {
 		err := ws.StartHttpServer()
 		require.Equal(t, middleware.ErrInvalidMaxNumRequests, err)
 	})
+	t.Run("createMiddlewareLimiters returns error due to middleware.NewTokenBucketLimiter error", func(t *testing.T) {
+		args := createMockArgsNewWebServer()
+		args.AntiFloodConfig.RateLimiter = config.WebServerRateLimiterConfig{
+			Enabled:               true,
+			BucketCapacity:        0,
+			RefillTokensPerSecond: 1,
+			DefaultCost:           1,
+		}
+		ws, _ := NewGinWebServerHandler(args)
+		require.NotNil(t, ws)
+
+		err := ws.StartHttpServer()
+		require.True(t, errors.Is(err, middleware.ErrInvalidRateLimiterValue))
+		require.Nil(t, ws.Close())
+	})
 	t.Run("should work", func(t *testing.T) {
 		ws, _ := NewGinWebServerHandler(createMockArgsNewWebServer())
 		require.NotNil(t, ws)
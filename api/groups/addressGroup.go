@@ -12,8 +12,10 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 )
 
 const (
@@ -32,6 +34,7 @@ const (
 	getRegisteredNFTsPath          = "/:address/registered-nfts"
 	getESDTNFTDataPath             = "/:address/nft/:tokenIdentifier/nonce/:nonce"
 	getGuardianData                = "/:address/guardian-data"
+	getAccountTransactionsPath     = "/:address/transactions"
 	urlParamOnFinalBlock           = "onFinalBlock"
 	urlParamOnStartOfEpoch         = "onStartOfEpoch"
 	urlParamBlockNonce             = "blockNonce"
@@ -39,6 +42,9 @@ const (
 	urlParamBlockRootHash          = "blockRootHash"
 	urlParamHintEpoch              = "hintEpoch"
 	urlParamWithKeys               = "withKeys"
+	urlParamBeforeNonce            = "beforeNonce"
+	urlParamBeforeEpoch            = "beforeEpoch"
+	urlParamSize                   = "size"
 )
 
 // addressFacadeHandler defines the methods to be implemented by a facade for handling address requests
@@ -57,6 +63,8 @@ type addressFacadeHandler interface {
 	GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
 	GetGuardianData(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
+	GetTransactionsByAddress(address string, beforeNonce uint64, beforeEpoch uint32, pageSize uint32) (*common.AccountTransactionsAPIResponse, error)
+	DecodeAddressPubkey(pk string) ([]byte, error)
 	IsInterfaceNil() bool
 }
 
@@ -94,8 +102,10 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 	}
 
 	ag := &addressGroup{
-		facade:    facade,
-		baseGroup: &baseGroup{},
+		facade: facade,
+		baseGroup: &baseGroup{
+			requiredRole: shared.RolePublic,
+		},
 	}
 
 	endpoints := []*shared.EndpointHandlerData{
@@ -103,76 +113,173 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 			Path:    getAccountPath,
 			Method:  http.MethodGet,
 			Handler: ag.getAccount,
+			Docs: &shared.EndpointDocs{
+				Summary: "returns the account of the given address",
+				QueryParams: append([]shared.QueryParameter{
+					{Name: urlParamWithKeys, Type: shared.ParamTypeBoolean, Description: "include the account's key-value pairs"},
+				}, accountQueryParams...),
+				ResponseData: map[string]interface{}{"account": api.AccountResponse{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getAccountsPath,
 			Method:  http.MethodPost,
 			Handler: ag.getAccounts,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the accounts of the given addresses",
+				QueryParams:  accountQueryParams,
+				RequestBody:  []string{},
+				ResponseData: map[string]interface{}{"accounts": map[string]*api.AccountResponse{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getBalancePath,
 			Method:  http.MethodGet,
 			Handler: ag.getBalance,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the balance of the given address",
+				QueryParams:  accountQueryParams,
+				ResponseData: map[string]interface{}{"balance": "", "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getUsernamePath,
 			Method:  http.MethodGet,
 			Handler: ag.getUsername,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the username of the given address",
+				QueryParams:  accountQueryParams,
+				ResponseData: map[string]interface{}{"username": "", "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getCodeHashPath,
 			Method:  http.MethodGet,
 			Handler: ag.getCodeHash,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the code hash of the given address",
+				QueryParams:  accountQueryParams,
+				ResponseData: map[string]interface{}{"codeHash": []byte{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getKeyPath,
 			Method:  http.MethodGet,
 			Handler: ag.getValueForKey,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the value stored under the given hex encoded key of the address",
+				QueryParams:  accountQueryParams,
+				ResponseData: map[string]interface{}{"value": "", "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getKeysPath,
 			Method:  http.MethodGet,
 			Handler: ag.getKeyValuePairs,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns all the key-value pairs of the given address",
+				QueryParams:  accountQueryParams,
+				ResponseData: map[string]interface{}{"pairs": map[string]string{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTBalancePath,
 			Method:  http.MethodGet,
 			Handler: ag.getESDTBalance,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the balance of the given fungible token held by the address",
+				QueryParams:  accountQueryParams,
+				ResponseData: map[string]interface{}{"tokenData": esdtTokenData{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTNFTDataPath,
 			Method:  http.MethodGet,
 			Handler: ag.getESDTNFTData,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the data of the given token and nonce held by the address",
+				QueryParams:  accountQueryParams,
+				ResponseData: map[string]interface{}{"tokenData": ESDTNFTTokenData{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTTokensPath,
 			Method:  http.MethodGet,
 			Handler: ag.getAllESDTData,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns all the tokens held by the address",
+				QueryParams:  accountQueryParams,
+				ResponseData: map[string]interface{}{"esdts": map[string]*ESDTNFTTokenData{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getRegisteredNFTsPath,
 			Method:  http.MethodGet,
 			Handler: ag.getNFTTokenIDsRegisteredByAddress,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the identifiers of the NFT collections registered by the address",
+				QueryParams:  accountQueryParams,
+				ResponseData: map[string]interface{}{"tokens": []string{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTTokensWithRolePath,
 			Method:  http.MethodGet,
 			Handler: ag.getESDTTokensWithRole,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the identifiers of the tokens for which the address has the given role",
+				QueryParams:  accountQueryParams,
+				ResponseData: map[string]interface{}{"tokens": []string{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTsRolesPath,
 			Method:  http.MethodGet,
 			Handler: ag.getESDTsRoles,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the roles of the address for each token",
+				QueryParams:  accountQueryParams,
+				ResponseData: map[string]interface{}{"roles": map[string][]string{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getGuardianData,
 			Method:  http.MethodGet,
 			Handler: ag.getGuardianData,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the guardians of the given address",
+				QueryParams:  accountQueryParams,
+				ResponseData: map[string]interface{}{"guardianData": api.GuardianData{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getDataTrieMigrationStatusPath,
 			Method:  http.MethodGet,
 			Handler: ag.isDataTrieMigrated,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns whether the data trie of the given address was migrated to the latest version",
+				QueryParams:  accountQueryParams,
+				ResponseData: map[string]interface{}{"isMigrated": false},
+			},
+		},
+		{
+			Path:    getAccountTransactionsPath,
+			Method:  http.MethodGet,
+			Handler: ag.getAccountTransactions,
+			Docs: &shared.EndpointDocs{
+				Summary: "returns a page of the transactions sent or received by the given address, newest first",
+				QueryParams: []shared.QueryParameter{
+					{Name: urlParamBeforeNonce, Type: shared.ParamTypeInteger, Description: "return only the transactions indexed before the given nonce"},
+					{Name: urlParamBeforeEpoch, Type: shared.ParamTypeInteger, Description: "return only the transactions indexed before the given epoch"},
+					{Name: urlParamSize, Type: shared.ParamTypeInteger, Description: "the page size"},
+				},
+				ResponseData: map[string]interface{}{
+					"transactions":    []*transaction.ApiTransactionResult{},
+					"hasMore":         false,
+					"nextBeforeNonce": uint64(0),
+					"nextBeforeEpoch": uint32(0),
+				},
+			},
 		},
 	}
 	ag.endpoints = endpoints
@@ -327,6 +434,53 @@ func (ag *addressGroup) getGuardianData(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"guardianData": guardianData, "blockInfo": blockInfo})
 }
 
+// getAccountTransactions returns a page of the transactions sent or received by the given address, newest first.
+// The cursor of the next page is made of the returned nextBeforeNonce and nextBeforeEpoch values
+func (ag *addressGroup) getAccountTransactions(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetAccountTransactions, errors.ErrEmptyAddress)
+		return
+	}
+
+	_, err := ag.getFacade().DecodeAddressPubkey(addr)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetAccountTransactions, fmt.Errorf("%w: %v", errors.ErrInvalidAddress, err))
+		return
+	}
+
+	beforeNonce, err := parseUint64UrlParam(c, urlParamBeforeNonce)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetAccountTransactions, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err))
+		return
+	}
+
+	beforeEpoch, err := parseUint32UrlParam(c, urlParamBeforeEpoch)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetAccountTransactions, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err))
+		return
+	}
+
+	size, err := parseUint32UrlParam(c, urlParamSize)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetAccountTransactions, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err))
+		return
+	}
+
+	response, err := ag.getFacade().GetTransactionsByAddress(addr, beforeNonce.Value, beforeEpoch.Value, size.Value)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetAccountTransactions, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{
+		"transactions":    response.Transactions,
+		"hasMore":         response.HasMore,
+		"nextBeforeNonce": response.NextBeforeNonce,
+		"nextBeforeEpoch": response.NextBeforeEpoch,
+	})
+}
+
 // addressGroup returns all the key-value pairs for the given address
 func (ag *addressGroup) getKeyValuePairs(c *gin.Context) {
 	addr, options, err := extractBaseParams(c)
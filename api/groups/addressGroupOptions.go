@@ -7,8 +7,19 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	customErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
 )
 
+// accountQueryParams describes the URL parameters parsed into the account query options
+var accountQueryParams = []shared.QueryParameter{
+	{Name: urlParamOnFinalBlock, Type: shared.ParamTypeBoolean, Description: "query the state of the final block"},
+	{Name: urlParamOnStartOfEpoch, Type: shared.ParamTypeInteger, Description: "query the state at the start of the given epoch"},
+	{Name: urlParamBlockNonce, Type: shared.ParamTypeInteger, Description: "query the state at the block with the given nonce"},
+	{Name: urlParamBlockHash, Type: shared.ParamTypeString, Description: "query the state at the block with the given hex encoded hash"},
+	{Name: urlParamBlockRootHash, Type: shared.ParamTypeString, Description: "query the state with the given hex encoded root hash"},
+	{Name: urlParamHintEpoch, Type: shared.ParamTypeInteger, Description: "the epoch of the block, used together with the block root hash"},
+}
+
 func extractAccountQueryOptions(c *gin.Context) (api.AccountQueryOptions, error) {
 	options, err := parseAccountQueryOptions(c)
 	if err != nil {
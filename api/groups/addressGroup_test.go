@@ -15,10 +15,12 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/mock"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -99,6 +101,19 @@ type guardianDataResponse struct {
 	Code  string                   `json:"code"`
 }
 
+type accountTransactionsResponseData struct {
+	Transactions    []*transaction.ApiTransactionResult `json:"transactions"`
+	HasMore         bool                                `json:"hasMore"`
+	NextBeforeNonce uint64                              `json:"nextBeforeNonce"`
+	NextBeforeEpoch uint32                              `json:"nextBeforeEpoch"`
+}
+
+type accountTransactionsResponse struct {
+	Data  accountTransactionsResponseData `json:"data"`
+	Error string                          `json:"error"`
+	Code  string                          `json:"code"`
+}
+
 type esdtNFTResponse struct {
 	Data  esdtNFTResponseData `json:"data"`
 	Error string              `json:"error"`
@@ -609,6 +624,81 @@ func TestAddressGroup_getGuardianData(t *testing.T) {
 	})
 }
 
+func TestAddressGroup_getAccountTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty address should error",
+		testErrorScenario("/address//transactions", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetAccountTransactions, apiErrors.ErrEmptyAddress)))
+	t.Run("invalid address should error",
+		testErrorScenario("/address/erd1alice/transactions", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetAccountTransactions, apiErrors.ErrInvalidAddress)))
+	t.Run("invalid before nonce should error",
+		testErrorScenario("/address/aabbcc/transactions?beforeNonce=not-uint64", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetAccountTransactions, apiErrors.ErrBadUrlParams)))
+	t.Run("invalid before epoch should error",
+		testErrorScenario("/address/aabbcc/transactions?beforeEpoch=not-uint32", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetAccountTransactions, apiErrors.ErrBadUrlParams)))
+	t.Run("invalid size should error",
+		testErrorScenario("/address/aabbcc/transactions?size=-1", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetAccountTransactions, apiErrors.ErrBadUrlParams)))
+	t.Run("with node fail should err", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsByAddressCalled: func(address string, beforeNonce uint64, beforeEpoch uint32, pageSize uint32) (*common.AccountTransactionsAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		testAddressGroup(
+			t,
+			facade,
+			"/address/aabbcc/transactions",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			formatExpectedErr(apiErrors.ErrGetAccountTransactions, expectedErr),
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTxs := []*transaction.ApiTransactionResult{
+			{Hash: "aa", Nonce: 1},
+			{Hash: "bb", Nonce: 2},
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionsByAddressCalled: func(address string, beforeNonce uint64, beforeEpoch uint32, pageSize uint32) (*common.AccountTransactionsAPIResponse, error) {
+				assert.Equal(t, "aabbcc", address)
+				assert.Equal(t, uint64(100), beforeNonce)
+				assert.Equal(t, uint32(4), beforeEpoch)
+				assert.Equal(t, uint32(2), pageSize)
+
+				return &common.AccountTransactionsAPIResponse{
+					Transactions:    expectedTxs,
+					HasMore:         true,
+					NextBeforeNonce: 37,
+					NextBeforeEpoch: 3,
+				}, nil
+			},
+		}
+
+		response := &accountTransactionsResponse{}
+		loadAddressGroupResponse(
+			t,
+			facade,
+			"/address/aabbcc/transactions?beforeNonce=100&beforeEpoch=4&size=2",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, expectedTxs, response.Data.Transactions)
+		assert.True(t, response.Data.HasMore)
+		assert.Equal(t, uint64(37), response.Data.NextBeforeNonce)
+		assert.Equal(t, uint32(3), response.Data.NextBeforeEpoch)
+	})
+}
+
 func TestAddressGroup_getKeyValuePairs(t *testing.T) {
 	t.Parallel()
 
@@ -1151,6 +1241,7 @@ func getAddressRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/esdts-with-role/:role", Open: true},
 					{Name: "/:address/registered-nfts", Open: true},
 					{Name: "/:address/is-data-trie-migrated", Open: true},
+					{Name: "/:address/transactions", Open: true},
 				},
 			},
 		},
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-go/api/middleware"
 	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/config"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -16,7 +17,8 @@ type endpointProperties struct {
 }
 
 type baseGroup struct {
-	endpoints []*shared.EndpointHandlerData
+	endpoints    []*shared.EndpointHandlerData
+	requiredRole shared.AccessRole
 }
 
 // GetEndpoints returns all the endpoints specific to the group
@@ -37,7 +39,8 @@ func (bg *baseGroup) RegisterRoutes(
 			continue
 		}
 
-		middlewares := make([]gin.HandlerFunc, 0)
+		middlewares := []gin.HandlerFunc{middleware.CreateRoleChecker(bg.getRequiredRole(handlerData))}
+
 		beforeSpecifiesMiddlewares, afterSpecificMiddlewares := extractSpecificMiddlewares(handlerData.AdditionalMiddlewares)
 
 		middlewares = append(middlewares, beforeSpecifiesMiddlewares...)
@@ -48,6 +51,14 @@ func (bg *baseGroup) RegisterRoutes(
 	}
 }
 
+func (bg *baseGroup) getRequiredRole(handlerData *shared.EndpointHandlerData) shared.AccessRole {
+	if handlerData.RequiredRole > bg.requiredRole {
+		return handlerData.RequiredRole
+	}
+
+	return bg.requiredRole
+}
+
 func extractSpecificMiddlewares(middlewares []shared.AdditionalMiddleware) ([]gin.HandlerFunc, []gin.HandlerFunc) {
 	if len(middlewares) == 0 {
 		return nil, nil
@@ -28,6 +28,17 @@ const (
 	urlParamWithLogs          = "withLogs"
 )
 
+// blockQueryParams describes the URL parameters parsed into the block query options
+var blockQueryParams = []shared.QueryParameter{
+	{Name: urlParamWithTxs, Type: shared.ParamTypeBoolean, Description: "include the block's transactions"},
+	{Name: urlParamWithLogs, Type: shared.ParamTypeBoolean, Description: "include the logs of the block's transactions"},
+}
+
+// alteredAccountsQueryParams describes the URL parameters parsed into the altered accounts query options
+var alteredAccountsQueryParams = []shared.QueryParameter{
+	{Name: urlParamTokensFilter, Type: shared.ParamTypeString, Description: "comma separated tokens to filter the altered accounts by"},
+}
+
 // blockFacadeHandler defines the methods to be implemented by a facade for handling block requests
 type blockFacadeHandler interface {
 	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
@@ -50,8 +61,10 @@ func NewBlockGroup(facade blockFacadeHandler) (*blockGroup, error) {
 	}
 
 	bg := &blockGroup{
-		facade:    facade,
-		baseGroup: &baseGroup{},
+		facade: facade,
+		baseGroup: &baseGroup{
+			requiredRole: shared.RolePublic,
+		},
 	}
 
 	endpoints := []*shared.EndpointHandlerData{
@@ -59,26 +72,51 @@ func NewBlockGroup(facade blockFacadeHandler) (*blockGroup, error) {
 			Path:    getBlockByNoncePath,
 			Method:  http.MethodGet,
 			Handler: bg.getBlockByNonce,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the block with the given nonce",
+				QueryParams:  blockQueryParams,
+				ResponseData: map[string]interface{}{"block": api.Block{}},
+			},
 		},
 		{
 			Path:    getBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: bg.getBlockByHash,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the block with the given hex encoded hash",
+				QueryParams:  blockQueryParams,
+				ResponseData: map[string]interface{}{"block": api.Block{}},
+			},
 		},
 		{
 			Path:    getBlockByRoundPath,
 			Method:  http.MethodGet,
 			Handler: bg.getBlockByRound,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the block proposed in the given round",
+				QueryParams:  blockQueryParams,
+				ResponseData: map[string]interface{}{"block": api.Block{}},
+			},
 		},
 		{
 			Path:    getAlteredAccountsByNonce,
 			Method:  http.MethodGet,
 			Handler: bg.getAlteredAccountsByNonce,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the accounts altered by the block with the given nonce",
+				QueryParams:  alteredAccountsQueryParams,
+				ResponseData: map[string]interface{}{"accounts": []*alteredAccount.AlteredAccount{}},
+			},
 		},
 		{
 			Path:    getAlteredAccountsByHash,
 			Method:  http.MethodGet,
 			Handler: bg.getAlteredAccountsByHash,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the accounts altered by the block with the given hex encoded hash",
+				QueryParams:  alteredAccountsQueryParams,
+				ResponseData: map[string]interface{}{"accounts": []*alteredAccount.AlteredAccount{}},
+			},
 		},
 	}
 	bg.endpoints = endpoints
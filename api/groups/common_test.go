@@ -16,8 +16,16 @@ func init() {
 }
 
 func startWebServer(group shared.GroupHandler, path string, apiConfig config.ApiRoutesConfig) *gin.Engine {
+	return startWebServerWithRole(group, path, apiConfig, shared.RoleAdmin)
+}
+
+func startWebServerWithRole(group shared.GroupHandler, path string, apiConfig config.ApiRoutesConfig, role shared.AccessRole) *gin.Engine {
 	ws := gin.New()
 	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set(shared.AccessRoleContextKey, role)
+		c.Next()
+	})
 	routes := ws.Group(path)
 	group.RegisterRoutes(routes, apiConfig)
 	return ws
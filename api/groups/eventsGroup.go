@@ -0,0 +1,121 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// eventsFacadeHandler defines the methods to be implemented by a facade for events requests
+type eventsFacadeHandler interface {
+	GetEvents(query common.EventsQuery) ([]*common.ApiEvent, error)
+	IsInterfaceNil() bool
+}
+
+type eventsGroup struct {
+	*baseGroup
+	facade    eventsFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// EventsQueryRequest represents the structure of an events query. The topics are matched by position: an event
+// matches if, for each position, its topic equals any of the provided (base64-encoded) values. An empty list of values
+// matches any topic on that position.
+type EventsQueryRequest struct {
+	FromNonce   uint64     `json:"fromNonce"`
+	ToNonce     uint64     `json:"toNonce"`
+	Addresses   []string   `json:"addresses"`
+	Identifiers []string   `json:"identifiers"`
+	Topics      [][][]byte `json:"topics"`
+}
+
+// NewEventsGroup returns a new instance of eventsGroup
+func NewEventsGroup(facade eventsFacadeHandler) (*eventsGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for events group", errors.ErrNilFacadeHandler)
+	}
+
+	eg := &eventsGroup{
+		facade: facade,
+		baseGroup: &baseGroup{
+			requiredRole: shared.RolePublic,
+		},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    queryPath,
+			Method:  http.MethodPost,
+			Handler: eg.queryEvents,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the events matching the given query",
+				RequestBody:  EventsQueryRequest{},
+				ResponseData: map[string]interface{}{"events": []*common.ApiEvent{}},
+			},
+		},
+	}
+	eg.endpoints = endpoints
+
+	return eg, nil
+}
+
+// queryEvents returns the events matching the provided query
+func (eg *eventsGroup) queryEvents(c *gin.Context) {
+	request := EventsQueryRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	query := common.EventsQuery{
+		FromNonce:   request.FromNonce,
+		ToNonce:     request.ToNonce,
+		Addresses:   request.Addresses,
+		Identifiers: request.Identifiers,
+		Topics:      request.Topics,
+	}
+
+	events, err := eg.getFacade().GetEvents(query)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, fmt.Sprintf("%s: %s", errors.ErrGetEvents.Error(), err.Error()), shared.ReturnCodeRequestError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"events": events}, "", shared.ReturnCodeSuccess)
+}
+
+func (eg *eventsGroup) getFacade() eventsFacadeHandler {
+	eg.mutFacade.RLock()
+	defer eg.mutFacade.RUnlock()
+
+	return eg.facade
+}
+
+// UpdateFacade will update the facade
+func (eg *eventsGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(eventsFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	eg.mutFacade.Lock()
+	eg.facade = castFacade
+	eg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (eg *eventsGroup) IsInterfaceNil() bool {
+	return eg == nil
+}
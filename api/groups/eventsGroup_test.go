@@ -0,0 +1,187 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type eventsQueryResponseData struct {
+	Events []*common.ApiEvent `json:"events"`
+}
+
+type eventsQueryResponse struct {
+	Data  eventsQueryResponseData `json:"data"`
+	Error string                  `json:"error"`
+	Code  string                  `json:"code"`
+}
+
+func TestNewEventsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		eg, err := groups.NewEventsGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, eg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		eg, err := groups.NewEventsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, eg)
+	})
+}
+
+func TestEventsGroup_QueryEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		eg, _ := groups.NewEventsGroup(&mock.FacadeStub{})
+		ws := startWebServer(eg, "events", getEventsRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/events/query", bytes.NewBufferString("not a json"))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetEventsCalled: func(query common.EventsQuery) ([]*common.ApiEvent, error) {
+				return nil, expectedErr
+			},
+		}
+		eg, _ := groups.NewEventsGroup(facade)
+		ws := startWebServer(eg, "events", getEventsRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/events/query", bytes.NewBufferString("{}"))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetEvents.Error())
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		request := groups.EventsQueryRequest{
+			FromNonce:   10,
+			ToNonce:     20,
+			Addresses:   []string{"erd1a"},
+			Identifiers: []string{"transfer"},
+			Topics:      [][][]byte{{[]byte("t1"), []byte("t2")}, nil, {[]byte("t3")}},
+		}
+		expectedEvents := []*common.ApiEvent{
+			{
+				TxHash:     "aa",
+				BlockNonce: 11,
+				BlockHash:  "bb",
+				EventIndex: 1,
+				Address:    "erd1a",
+				Identifier: "transfer",
+				Topics:     [][]byte{[]byte("t1"), []byte("x"), []byte("t3")},
+				Data:       []byte("data"),
+			},
+		}
+		var providedQuery common.EventsQuery
+		facade := &mock.FacadeStub{
+			GetEventsCalled: func(query common.EventsQuery) ([]*common.ApiEvent, error) {
+				providedQuery = query
+				return expectedEvents, nil
+			},
+		}
+		eg, _ := groups.NewEventsGroup(facade)
+		ws := startWebServer(eg, "events", getEventsRoutesConfig())
+
+		buff, _ := json.Marshal(request)
+		req, _ := http.NewRequest("POST", "/events/query", bytes.NewBuffer(buff))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		expectedQuery := common.EventsQuery{
+			FromNonce:   request.FromNonce,
+			ToNonce:     request.ToNonce,
+			Addresses:   request.Addresses,
+			Identifiers: request.Identifiers,
+			Topics:      request.Topics,
+		}
+		assert.Equal(t, expectedQuery, providedQuery)
+
+		response := eventsQueryResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, expectedEvents, response.Data.Events)
+	})
+}
+
+func TestEventsGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		eg, _ := groups.NewEventsGroup(&mock.FacadeStub{})
+		err := eg.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		eg, _ := groups.NewEventsGroup(&mock.FacadeStub{})
+		err := eg.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		eg, _ := groups.NewEventsGroup(&mock.FacadeStub{})
+		err := eg.UpdateFacade(&mock.FacadeStub{})
+		require.NoError(t, err)
+	})
+}
+
+func TestEventsGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	eg, _ := groups.NewEventsGroup(nil)
+	require.True(t, eg.IsInterfaceNil())
+
+	eg, _ = groups.NewEventsGroup(&mock.FacadeStub{})
+	require.False(t, eg.IsInterfaceNil())
+}
+
+func getEventsRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"events": {
+				Routes: []config.RouteConfig{
+					{Name: "/query", Open: true},
+				},
+			},
+		},
+	}
+}
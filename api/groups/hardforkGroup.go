@@ -37,8 +37,10 @@ func NewHardforkGroup(facade hardforkFacadeHandler) (*hardforkGroup, error) {
 	}
 
 	hg := &hardforkGroup{
-		facade:    facade,
-		baseGroup: &baseGroup{},
+		facade: facade,
+		baseGroup: &baseGroup{
+			requiredRole: shared.RoleAdmin,
+		},
 	}
 
 	endpoints := []*shared.EndpointHandlerData{
@@ -46,6 +48,11 @@ func NewHardforkGroup(facade hardforkFacadeHandler) (*hardforkGroup, error) {
 			Path:    triggerPath,
 			Method:  http.MethodPost,
 			Handler: hg.triggerHandler,
+			Docs: &shared.EndpointDocs{
+				Summary:      "triggers the hardfork process",
+				RequestBody:  HardforkRequest{},
+				ResponseData: map[string]interface{}{"status": ""},
+			},
 		},
 	}
 	hg.endpoints = endpoints
@@ -9,6 +9,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	dataBlock "github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/api/shared/logging"
@@ -63,8 +64,10 @@ func NewInternalBlockGroup(facade internalBlockFacadeHandler) (*internalBlockGro
 	}
 
 	ib := &internalBlockGroup{
-		facade:    facade,
-		baseGroup: &baseGroup{},
+		facade: facade,
+		baseGroup: &baseGroup{
+			requiredRole: shared.RolePublic,
+		},
 	}
 
 	endpoints := []*shared.EndpointHandlerData{
@@ -72,86 +75,154 @@ func NewInternalBlockGroup(facade internalBlockFacadeHandler) (*internalBlockGro
 			Path:    getRawMetaBlockByNoncePath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawMetaBlockByNonce,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the protobuf encoded meta block with the given nonce",
+				ResponseData: map[string]interface{}{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getRawMetaBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawMetaBlockByHash,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the protobuf encoded meta block with the given hex encoded hash",
+				ResponseData: map[string]interface{}{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getRawMetaBlockByRoundPath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawMetaBlockByRound,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the protobuf encoded meta block proposed in the given round",
+				ResponseData: map[string]interface{}{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getRawStartOfEpochMetaBlockPath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawStartOfEpochMetaBlock,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the protobuf encoded start of epoch meta block of the given epoch",
+				ResponseData: map[string]interface{}{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getRawShardBlockByNoncePath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawShardBlockByNonce,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the protobuf encoded shard block with the given nonce",
+				ResponseData: map[string]interface{}{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getRawShardBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawShardBlockByHash,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the protobuf encoded shard block with the given hex encoded hash",
+				ResponseData: map[string]interface{}{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getRawShardBlockByRoundPath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawShardBlockByRound,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the protobuf encoded shard block proposed in the given round",
+				ResponseData: map[string]interface{}{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getJSONMetaBlockByNoncePath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONMetaBlockByNonce,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the internal meta block with the given nonce",
+				ResponseData: map[string]interface{}{"block": dataBlock.MetaBlock{}},
+			},
 		},
 		{
 			Path:    getJSONMetaBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONMetaBlockByHash,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the internal meta block with the given hex encoded hash",
+				ResponseData: map[string]interface{}{"block": dataBlock.MetaBlock{}},
+			},
 		},
 		{
 			Path:    getJSONMetaBlockByRoundPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONMetaBlockByRound,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the internal meta block proposed in the given round",
+				ResponseData: map[string]interface{}{"block": dataBlock.MetaBlock{}},
+			},
 		},
 		{
 			Path:    getJSONStartOfEpochMetaBlockPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONStartOfEpochMetaBlock,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the internal start of epoch meta block of the given epoch",
+				ResponseData: map[string]interface{}{"block": dataBlock.MetaBlock{}},
+			},
 		},
 		{
 			Path:    getJSONShardBlockByNoncePath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONShardBlockByNonce,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the internal shard block with the given nonce, its structure depends on the header version",
+				ResponseData: map[string]interface{}{"block": nil},
+			},
 		},
 		{
 			Path:    getJSONShardBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONShardBlockByHash,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the internal shard block with the given hex encoded hash, its structure depends on the header version",
+				ResponseData: map[string]interface{}{"block": nil},
+			},
 		},
 		{
 			Path:    getJSONShardBlockByRoundPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONShardBlockByRound,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the internal shard block proposed in the given round, its structure depends on the header version",
+				ResponseData: map[string]interface{}{"block": nil},
+			},
 		},
 		{
 			Path:    getRawMiniBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawMiniBlockByHash,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the protobuf encoded miniblock with the given hex encoded hash",
+				ResponseData: map[string]interface{}{"miniblock": []byte{}},
+			},
 		},
 		{
 			Path:    getJSONMiniBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONMiniBlockByHash,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the internal miniblock with the given hex encoded hash",
+				ResponseData: map[string]interface{}{"miniblock": dataBlock.MiniBlock{}},
+			},
 		},
 		{
 			Path:    getJSONStartOfEpochValidatorsInfoPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONStartOfEpochValidatorsInfo,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the validators info saved at the start of the given epoch",
+				ResponseData: map[string]interface{}{"validators": []*state.ShardValidatorInfo{}},
+			},
 		},
 	}
 	ib.endpoints = endpoints
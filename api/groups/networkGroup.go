@@ -74,8 +74,10 @@ func NewNetworkGroup(facade networkFacadeHandler) (*networkGroup, error) {
 	}
 
 	ng := &networkGroup{
-		facade:    facade,
-		baseGroup: &baseGroup{},
+		facade: facade,
+		baseGroup: &baseGroup{
+			requiredRole: shared.RolePublic,
+		},
 	}
 
 	endpoints := []*shared.EndpointHandlerData{
@@ -83,76 +85,136 @@ func NewNetworkGroup(facade networkFacadeHandler) (*networkGroup, error) {
 			Path:    getConfigPath,
 			Method:  http.MethodGet,
 			Handler: ng.getNetworkConfig,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the network configuration metrics",
+				ResponseData: map[string]interface{}{"config": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    getStatusPath,
 			Method:  http.MethodGet,
 			Handler: ng.getNetworkStatus,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the network status metrics",
+				ResponseData: map[string]interface{}{"status": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    economicsPath,
 			Method:  http.MethodGet,
 			Handler: ng.economicsMetrics,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the economics metrics",
+				ResponseData: map[string]interface{}{"metrics": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    enableEpochsPath,
 			Method:  http.MethodGet,
 			Handler: ng.getEnableEpochs,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the activation epochs of the protocol features",
+				ResponseData: map[string]interface{}{"enableEpochs": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    getESDTsPath,
 			Method:  http.MethodGet,
 			Handler: ng.getHandlerFuncForEsdt(""),
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the identifiers of all the issued ESDT tokens",
+				ResponseData: map[string]interface{}{"tokens": []string{}},
+			},
 		},
 		{
 			Path:    getFFTsPath,
 			Method:  http.MethodGet,
 			Handler: ng.getHandlerFuncForEsdt(core.FungibleESDT),
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the identifiers of the issued fungible tokens",
+				ResponseData: map[string]interface{}{"tokens": []string{}},
+			},
 		},
 		{
 			Path:    getSFTsPath,
 			Method:  http.MethodGet,
 			Handler: ng.getHandlerFuncForEsdt(core.SemiFungibleESDT),
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the identifiers of the issued semi-fungible tokens",
+				ResponseData: map[string]interface{}{"tokens": []string{}},
+			},
 		},
 		{
 			Path:    getNFTsPath,
 			Method:  http.MethodGet,
 			Handler: ng.getHandlerFuncForEsdt(core.NonFungibleESDT),
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the identifiers of the issued non-fungible tokens",
+				ResponseData: map[string]interface{}{"tokens": []string{}},
+			},
 		},
 		{
 			Path:    directStakedInfoPath,
 			Method:  http.MethodGet,
 			Handler: ng.directStakedInfo,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the directly staked values",
+				ResponseData: map[string]interface{}{"list": []*api.DirectStakedValue{}},
+			},
 		},
 		{
 			Path:    delegatedInfoPath,
 			Method:  http.MethodGet,
 			Handler: ng.delegatedInfo,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the delegated values",
+				ResponseData: map[string]interface{}{"list": []*api.Delegator{}},
+			},
 		},
 		{
 			Path:    getESDTSupplyPath,
 			Method:  http.MethodGet,
 			Handler: ng.getESDTTokenSupply,
+			Docs: &shared.EndpointDocs{
+				Summary:          "returns the supply of an ESDT token",
+				ResponseDataType: &api.ESDTSupply{},
+			},
 		},
 		{
 			Path:    ratingsPath,
 			Method:  http.MethodGet,
 			Handler: ng.getRatingsConfig,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the ratings configuration metrics",
+				ResponseData: map[string]interface{}{"config": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    genesisNodesConfigPath,
 			Method:  http.MethodGet,
 			Handler: ng.getGenesisNodesConfig,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the genesis nodes configuration",
+				ResponseData: map[string]interface{}{"nodes": GenesisNodesConfig{}},
+			},
 		},
 		{
 			Path:    genesisBalances,
 			Method:  http.MethodGet,
 			Handler: ng.getGenesisBalances,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the genesis balances",
+				ResponseData: map[string]interface{}{"balances": []*common.InitialAccountAPI{}},
+			},
 		},
 		{
 			Path:    gasConfigPath,
 			Method:  http.MethodGet,
 			Handler: ng.getGasConfig,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the gas configuration",
+				ResponseData: map[string]interface{}{"gasConfigs": GasConfig{}},
+			},
 		},
 	}
 	ng.endpoints = endpoints
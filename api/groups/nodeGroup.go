@@ -33,6 +33,12 @@ const (
 	eligibleManagedKeys       = "/managed-keys/eligible"
 	waitingManagedKeys        = "/managed-keys/waiting"
 	epochsLeftInWaiting       = "/waiting-epochs-left/:key"
+	addManagedKeyPath         = "/managed-keys/add"
+	removeManagedKeyPath      = "/managed-keys/remove"
+	pauseManagedKeyPath       = "/managed-keys/pause"
+	resumeManagedKeyPath      = "/managed-keys/resume"
+	trieVerificationPath      = "/trie-verification"
+	startTrieVerificationPath = "/trie-verification/start"
 )
 
 // nodeFacadeHandler defines the methods to be implemented by a facade for node requests
@@ -49,6 +55,12 @@ type nodeFacadeHandler interface {
 	GetEligibleManagedKeys() ([]string, error)
 	GetWaitingManagedKeys() ([]string, error)
 	GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32, error)
+	AddManagedKey(privateKey string) (string, error)
+	RemoveManagedKey(publicKey string) error
+	PauseManagedKey(publicKey string) error
+	ResumeManagedKey(publicKey string) error
+	StartTrieVerification(rootHash string, heal bool) error
+	GetTrieVerificationStatus() (common.TrieVerificationStatus, error)
 	IsInterfaceNil() bool
 }
 
@@ -58,6 +70,23 @@ type QueryDebugRequest struct {
 	Search string `form:"search" json:"search"`
 }
 
+// AddManagedKeyRequest represents the structure of a request for adding a managed key
+type AddManagedKeyRequest struct {
+	PrivateKey string `json:"privateKey"`
+}
+
+// ManagedKeyRequest represents the structure of a request for removing, pausing or resuming a managed key
+type ManagedKeyRequest struct {
+	PublicKey string `json:"publicKey"`
+}
+
+// StartTrieVerificationRequest represents the structure of a request for starting a state trie verification.
+// An empty root hash selects the root hash of the current block
+type StartTrieVerificationRequest struct {
+	RootHash string `json:"rootHash"`
+	Heal     bool   `json:"heal"`
+}
+
 type nodeGroup struct {
 	*baseGroup
 	facade    nodeFacadeHandler
@@ -71,8 +100,10 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 	}
 
 	ng := &nodeGroup{
-		facade:    facade,
-		baseGroup: &baseGroup{},
+		facade: facade,
+		baseGroup: &baseGroup{
+			requiredRole: shared.RolePublic,
+		},
 	}
 
 	endpoints := []*shared.EndpointHandlerData{
@@ -80,76 +111,211 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 			Path:    heartbeatStatusPath,
 			Method:  http.MethodGet,
 			Handler: ng.heartbeatStatus,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the heartbeat status of the nodes",
+				ResponseData: map[string]interface{}{"heartbeats": []data.PubKeyHeartbeat{}},
+			},
 		},
 		{
 			Path:    statusPath,
 			Method:  http.MethodGet,
 			Handler: ng.statusMetrics,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the node status metrics",
+				ResponseData: map[string]interface{}{"metrics": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    p2pStatusPath,
 			Method:  http.MethodGet,
 			Handler: ng.p2pStatusMetrics,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the node p2p status metrics",
+				ResponseData: map[string]interface{}{"metrics": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    metricsPath,
 			Method:  http.MethodGet,
 			Handler: ng.prometheusMetrics,
+			Docs: &shared.EndpointDocs{
+				Summary:           "returns the node status metrics in the prometheus format",
+				PlainTextResponse: true,
+			},
 		},
 		{
-			Path:    debugPath,
-			Method:  http.MethodPost,
-			Handler: ng.queryDebug,
+			Path:         debugPath,
+			Method:       http.MethodPost,
+			Handler:      ng.queryDebug,
+			RequiredRole: shared.RoleOperator,
+			Docs: &shared.EndpointDocs{
+				Summary:      "queries the debug information",
+				RequestBody:  QueryDebugRequest{},
+				ResponseData: map[string]interface{}{"result": []string{}},
+			},
 		},
 		{
 			Path:    peerInfoPath,
 			Method:  http.MethodGet,
 			Handler: ng.peerInfo,
+			Docs: &shared.EndpointDocs{
+				Summary: "returns the information of a p2p peer",
+				QueryParams: []shared.QueryParameter{
+					{Name: pidQueryParam, Type: shared.ParamTypeString, Description: "the peer ID", Required: true},
+				},
+				ResponseData: map[string]interface{}{"info": []core.QueryP2PPeerInfo{}},
+			},
 		},
 		{
 			Path:    epochStartDataForEpoch,
 			Method:  http.MethodGet,
 			Handler: ng.epochStartDataForEpoch,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the epoch start data of an epoch",
+				ResponseData: map[string]interface{}{"epochStart": &common.EpochStartDataAPI{}},
+			},
 		},
 		{
 			Path:    bootstrapStatusPath,
 			Method:  http.MethodGet,
 			Handler: ng.bootstrapMetrics,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the node bootstrap metrics",
+				ResponseData: map[string]interface{}{"metrics": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    connectedPeersRatingsPath,
 			Method:  http.MethodGet,
 			Handler: ng.connectedPeersRatings,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the ratings of the connected peers",
+				ResponseData: map[string]interface{}{"ratings": ""},
+			},
 		},
 		{
-			Path:    managedKeysCount,
-			Method:  http.MethodGet,
-			Handler: ng.managedKeysCount,
+			Path:         managedKeysCount,
+			Method:       http.MethodGet,
+			Handler:      ng.managedKeysCount,
+			RequiredRole: shared.RoleOperator,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the number of managed keys",
+				ResponseData: map[string]interface{}{"count": 0},
+			},
 		},
 		{
-			Path:    managedKeys,
-			Method:  http.MethodGet,
-			Handler: ng.managedKeys,
+			Path:         managedKeys,
+			Method:       http.MethodGet,
+			Handler:      ng.managedKeys,
+			RequiredRole: shared.RoleOperator,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the managed keys",
+				ResponseData: map[string]interface{}{"managedKeys": []string{}},
+			},
 		},
 		{
-			Path:    loadedKeys,
-			Method:  http.MethodGet,
-			Handler: ng.loadedKeys,
+			Path:         loadedKeys,
+			Method:       http.MethodGet,
+			Handler:      ng.loadedKeys,
+			RequiredRole: shared.RoleOperator,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the loaded keys",
+				ResponseData: map[string]interface{}{"loadedKeys": []string{}},
+			},
 		},
 		{
-			Path:    eligibleManagedKeys,
-			Method:  http.MethodGet,
-			Handler: ng.managedKeysEligible,
+			Path:         eligibleManagedKeys,
+			Method:       http.MethodGet,
+			Handler:      ng.managedKeysEligible,
+			RequiredRole: shared.RoleOperator,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the eligible managed keys",
+				ResponseData: map[string]interface{}{"eligibleKeys": []string{}},
+			},
 		},
 		{
-			Path:    waitingManagedKeys,
-			Method:  http.MethodGet,
-			Handler: ng.managedKeysWaiting,
+			Path:         waitingManagedKeys,
+			Method:       http.MethodGet,
+			Handler:      ng.managedKeysWaiting,
+			RequiredRole: shared.RoleOperator,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the waiting managed keys",
+				ResponseData: map[string]interface{}{"waitingKeys": []string{}},
+			},
 		},
 		{
 			Path:    epochsLeftInWaiting,
 			Method:  http.MethodGet,
 			Handler: ng.waitingEpochsLeft,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the number of epochs left in waiting for a public key",
+				ResponseData: map[string]interface{}{"epochsLeft": uint32(0)},
+			},
+		},
+		{
+			Path:         addManagedKeyPath,
+			Method:       http.MethodPost,
+			Handler:      ng.addManagedKey,
+			RequiredRole: shared.RoleAdmin,
+			Docs: &shared.EndpointDocs{
+				Summary:      "schedules the addition of a managed key",
+				RequestBody:  AddManagedKeyRequest{},
+				ResponseData: map[string]interface{}{"publicKey": ""},
+			},
+		},
+		{
+			Path:         removeManagedKeyPath,
+			Method:       http.MethodPost,
+			Handler:      ng.removeManagedKey,
+			RequiredRole: shared.RoleAdmin,
+			Docs: &shared.EndpointDocs{
+				Summary:      "schedules the removal of a managed key",
+				RequestBody:  ManagedKeyRequest{},
+				ResponseData: map[string]interface{}{"publicKey": ""},
+			},
+		},
+		{
+			Path:         pauseManagedKeyPath,
+			Method:       http.MethodPost,
+			Handler:      ng.pauseManagedKey,
+			RequiredRole: shared.RoleAdmin,
+			Docs: &shared.EndpointDocs{
+				Summary:      "schedules the pausing of a managed key",
+				RequestBody:  ManagedKeyRequest{},
+				ResponseData: map[string]interface{}{"publicKey": ""},
+			},
+		},
+		{
+			Path:         resumeManagedKeyPath,
+			Method:       http.MethodPost,
+			Handler:      ng.resumeManagedKey,
+			RequiredRole: shared.RoleAdmin,
+			Docs: &shared.EndpointDocs{
+				Summary:      "schedules the resuming of a managed key",
+				RequestBody:  ManagedKeyRequest{},
+				ResponseData: map[string]interface{}{"publicKey": ""},
+			},
+		},
+		{
+			Path:         startTrieVerificationPath,
+			Method:       http.MethodPost,
+			Handler:      ng.startTrieVerification,
+			RequiredRole: shared.RoleAdmin,
+			Docs: &shared.EndpointDocs{
+				Summary:      "starts the verification of the state trie and of its data tries, optionally healing the missing nodes",
+				RequestBody:  StartTrieVerificationRequest{},
+				ResponseData: map[string]interface{}{"status": common.TrieVerificationStatus{}},
+			},
+		},
+		{
+			Path:         trieVerificationPath,
+			Method:       http.MethodGet,
+			Handler:      ng.trieVerificationStatus,
+			RequiredRole: shared.RoleOperator,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the progress and the results of the current or of the last state trie verification",
+				ResponseData: map[string]interface{}{"status": common.TrieVerificationStatus{}},
+			},
 		},
 	}
 	ng.endpoints = endpoints
@@ -479,6 +645,85 @@ func (ng *nodeGroup) waitingEpochsLeft(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"epochsLeft": epochsLeft})
 }
 
+// addManagedKey schedules the addition of a managed key, applied at the start of the next round
+func (ng *nodeGroup) addManagedKey(c *gin.Context) {
+	request := AddManagedKeyRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	publicKey, err := ng.getFacade().AddManagedKey(request.PrivateKey)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrAddManagedKey, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"publicKey": publicKey})
+}
+
+// removeManagedKey schedules the removal of a managed key, applied at the start of the next round
+func (ng *nodeGroup) removeManagedKey(c *gin.Context) {
+	ng.handleManagedKeyChange(c, ng.getFacade().RemoveManagedKey, errors.ErrRemoveManagedKey)
+}
+
+// pauseManagedKey schedules the pausing of a managed key, applied at the start of the next round
+func (ng *nodeGroup) pauseManagedKey(c *gin.Context) {
+	ng.handleManagedKeyChange(c, ng.getFacade().PauseManagedKey, errors.ErrPauseManagedKey)
+}
+
+// resumeManagedKey schedules the resuming of a managed key, applied at the start of the next round
+func (ng *nodeGroup) resumeManagedKey(c *gin.Context) {
+	ng.handleManagedKeyChange(c, ng.getFacade().ResumeManagedKey, errors.ErrResumeManagedKey)
+}
+
+func (ng *nodeGroup) handleManagedKeyChange(c *gin.Context, changeHandler func(publicKey string) error, changeErr error) {
+	request := ManagedKeyRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	err = changeHandler(request.PublicKey)
+	if err != nil {
+		shared.RespondWithValidationError(c, changeErr, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"publicKey": request.PublicKey})
+}
+
+// startTrieVerification starts, in background, the verification of the state trie and of its data tries
+func (ng *nodeGroup) startTrieVerification(c *gin.Context) {
+	request := StartTrieVerificationRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	err = ng.getFacade().StartTrieVerification(request.RootHash, request.Heal)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrStartTrieVerification, err)
+		return
+	}
+
+	ng.trieVerificationStatus(c)
+}
+
+// trieVerificationStatus returns the status of the current or of the last state trie verification
+func (ng *nodeGroup) trieVerificationStatus(c *gin.Context) {
+	status, err := ng.getFacade().GetTrieVerificationStatus()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetTrieVerificationStatus, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"status": status})
+}
+
 func (ng *nodeGroup) getFacade() nodeFacadeHandler {
 	ng.mutFacade.RLock()
 	defer ng.mutFacade.RUnlock()
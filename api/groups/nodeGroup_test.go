@@ -974,6 +974,326 @@ func TestNodeGroup_WaitingEpochsLeft(t *testing.T) {
 	})
 }
 
+func doManagedKeysAdminRequest(ws *gin.Engine, path string, body string) (*httptest.ResponseRecorder, *shared.GenericAPIResponse) {
+	req, _ := http.NewRequest(http.MethodPost, path, bytes.NewBuffer([]byte(body)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	return resp, response
+}
+
+func TestNodeGroup_AddManagedKey(t *testing.T) {
+	t.Parallel()
+
+	providedPrivateKey := "private key"
+	providedPublicKey := "public key"
+	createFacade := func(addHandler func(privateKey string) (string, error)) *mock.FacadeStub {
+		return &mock.FacadeStub{
+			AddManagedKeyCalled: addHandler,
+		}
+	}
+
+	t.Run("operator role should be forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createFacade(func(privateKey string) (string, error) {
+			assert.Fail(t, "should have not been called")
+			return "", nil
+		})
+		nodeGroup, err := groups.NewNodeGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServerWithRole(nodeGroup, "node", getNodeRoutesConfig(), shared.RoleOperator)
+		resp, response := doManagedKeysAdminRequest(ws, "/node/managed-keys/add", `{"privateKey":"private key"}`)
+		assert.Equal(t, http.StatusForbidden, resp.Code)
+		assert.Equal(t, apiErrors.ErrForbidden.Error(), response.Error)
+	})
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createFacade(func(privateKey string) (string, error) {
+			assert.Fail(t, "should have not been called")
+			return "", nil
+		})
+		nodeGroup, err := groups.NewNodeGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+		resp, response := doManagedKeysAdminRequest(ws, "/node/managed-keys/add", "not a json")
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createFacade(func(privateKey string) (string, error) {
+			return "", expectedErr
+		})
+		nodeGroup, err := groups.NewNodeGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+		resp, response := doManagedKeysAdminRequest(ws, "/node/managed-keys/add", `{"privateKey":"private key"}`)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrAddManagedKey.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createFacade(func(privateKey string) (string, error) {
+			assert.Equal(t, providedPrivateKey, privateKey)
+			return providedPublicKey, nil
+		})
+		nodeGroup, err := groups.NewNodeGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+		resp, response := doManagedKeysAdminRequest(ws, "/node/managed-keys/add", `{"privateKey":"private key"}`)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, map[string]interface{}{"publicKey": providedPublicKey}, response.Data)
+	})
+}
+
+func TestNodeGroup_RemovePauseResumeManagedKey(t *testing.T) {
+	t.Parallel()
+
+	providedPublicKey := "public key"
+	testData := []struct {
+		path        string
+		expectedErr error
+		setHandler  func(facade *mock.FacadeStub, handler func(publicKey string) error)
+	}{
+		{
+			path:        "/node/managed-keys/remove",
+			expectedErr: apiErrors.ErrRemoveManagedKey,
+			setHandler: func(facade *mock.FacadeStub, handler func(publicKey string) error) {
+				facade.RemoveManagedKeyCalled = handler
+			},
+		},
+		{
+			path:        "/node/managed-keys/pause",
+			expectedErr: apiErrors.ErrPauseManagedKey,
+			setHandler: func(facade *mock.FacadeStub, handler func(publicKey string) error) {
+				facade.PauseManagedKeyCalled = handler
+			},
+		},
+		{
+			path:        "/node/managed-keys/resume",
+			expectedErr: apiErrors.ErrResumeManagedKey,
+			setHandler: func(facade *mock.FacadeStub, handler func(publicKey string) error) {
+				facade.ResumeManagedKeyCalled = handler
+			},
+		},
+	}
+
+	for _, td := range testData {
+		td := td
+		t.Run(td.path, func(t *testing.T) {
+			t.Parallel()
+
+			numCalls := 0
+			handlerErr := error(nil)
+			facade := &mock.FacadeStub{}
+			td.setHandler(facade, func(publicKey string) error {
+				assert.Equal(t, providedPublicKey, publicKey)
+				numCalls++
+				return handlerErr
+			})
+			nodeGroup, err := groups.NewNodeGroup(facade)
+			require.NoError(t, err)
+
+			body := `{"publicKey":"public key"}`
+			operatorWs := startWebServerWithRole(nodeGroup, "node", getNodeRoutesConfig(), shared.RoleOperator)
+			resp, _ := doManagedKeysAdminRequest(operatorWs, td.path, body)
+			assert.Equal(t, http.StatusForbidden, resp.Code)
+			assert.Equal(t, 0, numCalls)
+
+			ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+			resp, response := doManagedKeysAdminRequest(ws, td.path, body)
+			assert.Equal(t, http.StatusOK, resp.Code)
+			assert.Equal(t, map[string]interface{}{"publicKey": providedPublicKey}, response.Data)
+			assert.Equal(t, 1, numCalls)
+
+			handlerErr = expectedErr
+			resp, response = doManagedKeysAdminRequest(ws, td.path, body)
+			assert.Equal(t, http.StatusBadRequest, resp.Code)
+			assert.True(t, strings.Contains(response.Error, td.expectedErr.Error()))
+			assert.Equal(t, 2, numCalls)
+		})
+	}
+}
+
+func TestNodeGroup_StartTrieVerification(t *testing.T) {
+	t.Parallel()
+
+	providedRootHash := "726f6f742068617368"
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			StartTrieVerificationCalled: func(rootHash string, heal bool) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		nodeGroup, err := groups.NewNodeGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+		resp, response := doManagedKeysAdminRequest(ws, "/node/trie-verification/start", "not a json")
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			StartTrieVerificationCalled: func(rootHash string, heal bool) error {
+				return expectedErr
+			},
+		}
+		nodeGroup, err := groups.NewNodeGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+		resp, response := doManagedKeysAdminRequest(ws, "/node/trie-verification/start", `{"rootHash":"726f6f742068617368"}`)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrStartTrieVerification.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			StartTrieVerificationCalled: func(rootHash string, heal bool) error {
+				assert.Equal(t, providedRootHash, rootHash)
+				assert.True(t, heal)
+				return nil
+			},
+			GetTrieVerificationStatusCalled: func() (common.TrieVerificationStatus, error) {
+				return common.TrieVerificationStatus{
+					RootHash:   providedRootHash,
+					InProgress: true,
+					Heal:       true,
+				}, nil
+			},
+		}
+		nodeGroup, err := groups.NewNodeGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+		resp, response := doManagedKeysAdminRequest(ws, "/node/trie-verification/start", `{"rootHash":"726f6f742068617368","heal":true}`)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+
+		status := response.Data.(map[string]interface{})["status"].(map[string]interface{})
+		assert.Equal(t, providedRootHash, status["rootHash"])
+		assert.Equal(t, true, status["inProgress"])
+		assert.Equal(t, true, status["heal"])
+	})
+}
+
+func TestNodeGroup_TrieVerificationStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTrieVerificationStatusCalled: func() (common.TrieVerificationStatus, error) {
+				return common.TrieVerificationStatus{}, expectedErr
+			},
+		}
+		nodeGroup, err := groups.NewNodeGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+		req, _ := http.NewRequest(http.MethodGet, "/node/trie-verification", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTrieVerificationStatus.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTrieVerificationStatusCalled: func() (common.TrieVerificationStatus, error) {
+				return common.TrieVerificationStatus{
+					RootHash:        "726f6f742068617368",
+					NumCheckedNodes: 37,
+					NumMissingNodes: 1,
+					MissingNodes:    []string{"6d697373696e67"},
+				}, nil
+			},
+		}
+		nodeGroup, err := groups.NewNodeGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+		req, _ := http.NewRequest(http.MethodGet, "/node/trie-verification", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		status := response.Data.(map[string]interface{})["status"].(map[string]interface{})
+		assert.Equal(t, float64(37), status["numCheckedNodes"])
+		assert.Equal(t, float64(1), status["numMissingNodes"])
+		assert.Equal(t, []interface{}{"6d697373696e67"}, status["missingNodes"])
+	})
+}
+
+func TestNodeGroup_EndpointsRequiredRoles(t *testing.T) {
+	t.Parallel()
+
+	nodeGroup, err := groups.NewNodeGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	testData := []struct {
+		method       string
+		path         string
+		body         string
+		requiredRole shared.AccessRole
+	}{
+		{method: http.MethodGet, path: "/node/connected-peers-ratings", requiredRole: shared.RolePublic},
+		{method: http.MethodPost, path: "/node/debug", body: "not a json", requiredRole: shared.RoleOperator},
+		{method: http.MethodGet, path: "/node/managed-keys", requiredRole: shared.RoleOperator},
+		{method: http.MethodGet, path: "/node/loaded-keys", requiredRole: shared.RoleOperator},
+		{method: http.MethodPost, path: "/node/managed-keys/pause", body: `{"publicKey":"public key"}`, requiredRole: shared.RoleAdmin},
+		{method: http.MethodGet, path: "/node/trie-verification", requiredRole: shared.RoleOperator},
+		{method: http.MethodPost, path: "/node/trie-verification/start", body: `{"heal":true}`, requiredRole: shared.RoleAdmin},
+	}
+
+	roles := []shared.AccessRole{shared.RolePublic, shared.RoleOperator, shared.RoleAdmin}
+	for _, td := range testData {
+		for _, role := range roles {
+			ws := startWebServerWithRole(nodeGroup, "node", getNodeRoutesConfig(), role)
+			req, _ := http.NewRequest(td.method, td.path, bytes.NewBuffer([]byte(td.body)))
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			message := fmt.Sprintf("path %s, role %s", td.path, role.String())
+			if role < td.requiredRole {
+				assert.Equal(t, http.StatusForbidden, resp.Code, message)
+			} else {
+				assert.NotEqual(t, http.StatusForbidden, resp.Code, message)
+			}
+		}
+	}
+}
+
 func TestNodeGroup_UpdateFacade(t *testing.T) {
 	t.Parallel()
 
@@ -1087,6 +1407,12 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/managed-keys/eligible", Open: true},
 					{Name: "/managed-keys/waiting", Open: true},
 					{Name: "/waiting-epochs-left/:key", Open: true},
+					{Name: "/managed-keys/add", Open: true},
+					{Name: "/managed-keys/remove", Open: true},
+					{Name: "/managed-keys/pause", Open: true},
+					{Name: "/managed-keys/resume", Open: true},
+					{Name: "/trie-verification", Open: true},
+					{Name: "/trie-verification/start", Open: true},
 				},
 			},
 		},
@@ -49,8 +49,10 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 	}
 
 	pg := &proofGroup{
-		facade:    facade,
-		baseGroup: &baseGroup{},
+		facade: facade,
+		baseGroup: &baseGroup{
+			requiredRole: shared.RolePublic,
+		},
 	}
 
 	endpoints := []*shared.EndpointHandlerData{
@@ -64,6 +66,10 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 					Position:   shared.Before,
 				},
 			},
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the Merkle proof of the given address on the given root hash",
+				ResponseData: map[string]interface{}{"proof": []string{}, "value": ""},
+			},
 		},
 		{
 			Path:    getProofDataTriePath,
@@ -75,6 +81,14 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 					Position:   shared.Before,
 				},
 			},
+			Docs: &shared.EndpointDocs{
+				Summary: "returns the Merkle proofs of the given key from the data trie of the given address",
+				ResponseData: map[string]interface{}{
+					"proofs":           map[string][]string{},
+					"value":            "",
+					"dataTrieRootHash": "",
+				},
+			},
 		},
 		{
 			Path:    getProofCurrentRootHashPath,
@@ -86,6 +100,10 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 					Position:   shared.Before,
 				},
 			},
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the Merkle proof of the given address on the current root hash",
+				ResponseData: map[string]interface{}{"proof": []string{}, "value": "", "rootHash": ""},
+			},
 		},
 		{
 			Path:    verifyProofPath,
@@ -97,6 +115,11 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 					Position:   shared.Before,
 				},
 			},
+			Docs: &shared.EndpointDocs{
+				Summary:      "verifies the given Merkle proof",
+				RequestBody:  VerifyProofRequest{},
+				ResponseData: map[string]interface{}{"ok": false},
+			},
 		},
 	}
 	pg.endpoints = endpoints
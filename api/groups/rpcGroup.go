@@ -0,0 +1,646 @@
+package groups
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/api/shared/logging"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+const (
+	rpcPath         = ""
+	rpcEndpoint     = "/rpc"
+	rpcVersion      = "2.0"
+	maxRPCBatchSize = 100
+
+	rpcCodeParseError      = -32700
+	rpcCodeInvalidRequest  = -32600
+	rpcCodeMethodNotFound  = -32601
+	rpcCodeInvalidParams   = -32602
+	rpcCodeInternalError   = -32603
+	rpcCodeTooManyRequests = -32005
+)
+
+// rpcFacadeHandler defines the methods to be implemented by a facade for handling JSON-RPC requests
+type rpcFacadeHandler interface {
+	GetAccount(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
+	GetBalance(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error)
+	GetAllESDTTokens(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
+	CreateTransaction(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
+	ValidateTransaction(tx *transaction.Transaction) error
+	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
+	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error)
+	DecodeAddressPubkey(pk string) ([]byte, error)
+	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
+	StatusMetrics() external.StatusMetricsHandler
+	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
+	IsInterfaceNil() bool
+}
+
+// RPCRequest represents a JSON-RPC 2.0 request. A request without an id is a notification and receives no response
+type RPCRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+}
+
+// RPCResponse represents a JSON-RPC 2.0 response, holding either the result or the error of the request
+type RPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *RPCError       `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id"`
+}
+
+// RPCError represents the error object of a JSON-RPC 2.0 response
+type RPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type rpcHandler func(params json.RawMessage) (interface{}, *RPCError)
+
+// rpcMethod binds a JSON-RPC method to the REST endpoint it mirrors. The method is served only while the mirrored
+// endpoint is open, it is throttled by the same endpoint throttler, if any, and costs the same as the mirrored
+// endpoint in the caller's rate limiter bucket
+type rpcMethod struct {
+	group     string
+	path      string
+	throttler string
+	handler   rpcHandler
+}
+
+type rpcAccountParams struct {
+	Address        string  `json:"address"`
+	OnFinalBlock   bool    `json:"onFinalBlock"`
+	OnStartOfEpoch *uint32 `json:"onStartOfEpoch"`
+	BlockNonce     *uint64 `json:"blockNonce"`
+	BlockHash      string  `json:"blockHash"`
+	BlockRootHash  string  `json:"blockRootHash"`
+	HintEpoch      *uint32 `json:"hintEpoch"`
+	WithKeys       bool    `json:"withKeys"`
+}
+
+type rpcTransactionParams struct {
+	Hash        string `json:"hash"`
+	WithResults bool   `json:"withResults"`
+}
+
+type rpcBlockParams struct {
+	Nonce    *uint64 `json:"nonce"`
+	Hash     string  `json:"hash"`
+	WithTxs  bool    `json:"withTxs"`
+	WithLogs bool    `json:"withLogs"`
+}
+
+type rpcVMQueryParams struct {
+	VMValueRequest
+	BlockNonce *uint64 `json:"blockNonce"`
+	BlockHash  string  `json:"blockHash"`
+}
+
+type rpcGroup struct {
+	*baseGroup
+	facade         rpcFacadeHandler
+	mutFacade      sync.RWMutex
+	methods        map[string]*rpcMethod
+	openMethods    map[string]*rpcMethod
+	mutOpenMethods sync.RWMutex
+}
+
+// NewRPCGroup returns a new instance of rpcGroup
+func NewRPCGroup(facade rpcFacadeHandler) (*rpcGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for rpc group", errors.ErrNilFacadeHandler)
+	}
+
+	rg := &rpcGroup{
+		facade: facade,
+		baseGroup: &baseGroup{
+			requiredRole: shared.RolePublic,
+		},
+		openMethods: make(map[string]*rpcMethod),
+	}
+
+	rg.methods = map[string]*rpcMethod{
+		"address_getAccount":       {group: "address", path: getAccountPath, handler: rg.getAccount},
+		"address_getBalance":       {group: "address", path: getBalancePath, handler: rg.getBalance},
+		"address_getESDTTokens":    {group: "address", path: getESDTTokensPath, handler: rg.getAllESDTData},
+		"transaction_send":         {group: "transaction", path: sendTransactionPath, throttler: sendTransactionEndpoint, handler: rg.sendTransaction},
+		"transaction_sendMultiple": {group: "transaction", path: sendMultiplePath, throttler: sendMultipleTransactionsEndpoint, handler: rg.sendMultipleTransactions},
+		"transaction_get":          {group: "transaction", path: getTransactionPath, throttler: getTransactionEndpoint, handler: rg.getTransaction},
+		"vmValues_query":           {group: "vm-values", path: queryPath, handler: rg.executeQuery},
+		"block_getByNonce":         {group: "block", path: getBlockByNoncePath, handler: rg.getBlockByNonce},
+		"block_getByHash":          {group: "block", path: getBlockByHashPath, handler: rg.getBlockByHash},
+		"network_getConfig":        {group: "network", path: getConfigPath, handler: rg.getNetworkConfig},
+		"network_getStatus":        {group: "network", path: getStatusPath, handler: rg.getNetworkStatus},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    rpcPath,
+			Method:  http.MethodPost,
+			Handler: rg.handleRequests,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(rpcEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+			Docs: &shared.EndpointDocs{
+				Summary:      "handles a JSON-RPC 2.0 request or a batch of requests. The methods mirror the open REST endpoints",
+				RequestBody:  RPCRequest{},
+				ResponseBody: RPCResponse{},
+			},
+		},
+	}
+	rg.endpoints = endpoints
+
+	return rg, nil
+}
+
+// RegisterRoutes registers the JSON-RPC endpoint, serving only the methods that mirror open REST endpoints
+func (rg *rpcGroup) RegisterRoutes(ws *gin.RouterGroup, apiConfig config.ApiRoutesConfig) {
+	openMethods := make(map[string]*rpcMethod)
+	for name, method := range rg.methods {
+		if isRouteOpen(apiConfig, method.group, method.path) {
+			openMethods[name] = method
+		}
+	}
+
+	rg.mutOpenMethods.Lock()
+	rg.openMethods = openMethods
+	rg.mutOpenMethods.Unlock()
+
+	rg.baseGroup.RegisterRoutes(ws, apiConfig)
+}
+
+func isRouteOpen(apiConfig config.ApiRoutesConfig, group string, path string) bool {
+	groupConfig, ok := apiConfig.APIPackages[group]
+	if !ok {
+		return false
+	}
+
+	for _, route := range groupConfig.Routes {
+		if route.Name == path {
+			return route.Open
+		}
+	}
+
+	return false
+}
+
+// handleRequests handles a single request or a batch of requests, as described by the JSON-RPC 2.0 specification
+func (rg *rpcGroup) handleRequests(c *gin.Context) {
+	body, err := c.GetRawData()
+	if err != nil || !json.Valid(body) {
+		c.JSON(http.StatusOK, newRPCErrorResponse(nil, rpcCodeParseError, "parse error"))
+		return
+	}
+
+	body = bytes.TrimSpace(body)
+	if body[0] != '[' {
+		response := rg.processRawRequest(c, body)
+		if response == nil {
+			c.Status(http.StatusNoContent)
+			return
+		}
+
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	var batch []json.RawMessage
+	err = json.Unmarshal(body, &batch)
+	if err != nil || len(batch) == 0 {
+		c.JSON(http.StatusOK, newRPCErrorResponse(nil, rpcCodeInvalidRequest, "invalid request"))
+		return
+	}
+	if len(batch) > maxRPCBatchSize {
+		message := fmt.Sprintf("invalid request: the batch holds %d requests, at most %d are allowed", len(batch), maxRPCBatchSize)
+		c.JSON(http.StatusOK, newRPCErrorResponse(nil, rpcCodeInvalidRequest, message))
+		return
+	}
+
+	responses := make([]*RPCResponse, 0, len(batch))
+	for _, rawRequest := range batch {
+		response := rg.processRawRequest(c, rawRequest)
+		if response != nil {
+			responses = append(responses, response)
+		}
+	}
+
+	if len(responses) == 0 {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+func (rg *rpcGroup) processRawRequest(c *gin.Context, rawRequest json.RawMessage) *RPCResponse {
+	request := &RPCRequest{}
+	err := json.Unmarshal(rawRequest, request)
+	if err != nil || request.JSONRPC != rpcVersion || len(request.Method) == 0 {
+		return newRPCErrorResponse(request.ID, rpcCodeInvalidRequest, "invalid request")
+	}
+
+	result, rpcErr := rg.processRequest(c, request)
+	if request.ID == nil {
+		return nil
+	}
+	if rpcErr != nil {
+		return &RPCResponse{JSONRPC: rpcVersion, Error: rpcErr, ID: request.ID}
+	}
+
+	return &RPCResponse{JSONRPC: rpcVersion, Result: result, ID: request.ID}
+}
+
+func (rg *rpcGroup) processRequest(c *gin.Context, request *RPCRequest) (interface{}, *RPCError) {
+	rg.mutOpenMethods.RLock()
+	method, ok := rg.openMethods[request.Method]
+	rg.mutOpenMethods.RUnlock()
+	if !ok {
+		return nil, &RPCError{Code: rpcCodeMethodNotFound, Message: fmt.Sprintf("method not found: %s", request.Method)}
+	}
+
+	mirroredEndpoint := "/" + method.group + method.path
+	if !middleware.ConsumeEndpointCost(c, mirroredEndpoint) {
+		message := fmt.Sprintf("%s for endpoint %s", errors.ErrTooManyRequests.Error(), mirroredEndpoint)
+		return nil, &RPCError{Code: rpcCodeTooManyRequests, Message: message}
+	}
+
+	if len(method.throttler) > 0 {
+		endpointThrottler, found := rg.getFacade().GetThrottlerForEndpoint(method.throttler)
+		if found {
+			if !endpointThrottler.CanProcess() {
+				message := fmt.Sprintf("%s for endpoint %s", errors.ErrTooManyRequests.Error(), method.throttler)
+				return nil, &RPCError{Code: rpcCodeTooManyRequests, Message: message}
+			}
+
+			endpointThrottler.StartProcessing()
+			defer endpointThrottler.EndProcessing()
+		}
+	}
+
+	return method.handler(request.Params)
+}
+
+func newRPCErrorResponse(id json.RawMessage, code int, message string) *RPCResponse {
+	return &RPCResponse{
+		JSONRPC: rpcVersion,
+		Error:   &RPCError{Code: code, Message: message},
+		ID:      id,
+	}
+}
+
+func newInvalidParamsError(apiErr error, err error) *RPCError {
+	return &RPCError{Code: rpcCodeInvalidParams, Message: fmt.Sprintf("%s: %s", apiErr.Error(), err.Error())}
+}
+
+func newInternalRPCError(apiErr error, err error) *RPCError {
+	return &RPCError{Code: rpcCodeInternalError, Message: fmt.Sprintf("%s: %s", apiErr.Error(), err.Error())}
+}
+
+func decodeRPCParams(params json.RawMessage, destination interface{}) error {
+	if len(params) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(params, destination)
+}
+
+func (params *rpcAccountParams) toAccountQueryOptions() (api.AccountQueryOptions, error) {
+	blockHash, err := hex.DecodeString(params.BlockHash)
+	if err != nil {
+		return api.AccountQueryOptions{}, fmt.Errorf("%w for block hash", err)
+	}
+	blockRootHash, err := hex.DecodeString(params.BlockRootHash)
+	if err != nil {
+		return api.AccountQueryOptions{}, fmt.Errorf("%w for block root hash", err)
+	}
+
+	options := api.AccountQueryOptions{
+		OnFinalBlock:  params.OnFinalBlock,
+		BlockHash:     blockHash,
+		BlockRootHash: blockRootHash,
+		WithKeys:      params.WithKeys,
+	}
+	if params.OnStartOfEpoch != nil {
+		options.OnStartOfEpoch = core.OptionalUint32{Value: *params.OnStartOfEpoch, HasValue: true}
+	}
+	if params.BlockNonce != nil {
+		options.BlockNonce = core.OptionalUint64{Value: *params.BlockNonce, HasValue: true}
+	}
+	if params.HintEpoch != nil {
+		options.HintEpoch = core.OptionalUint32{Value: *params.HintEpoch, HasValue: true}
+	}
+
+	err = checkAccountQueryOptions(options)
+	if err != nil {
+		return api.AccountQueryOptions{}, err
+	}
+
+	return options, nil
+}
+
+func decodeAccountParams(rawParams json.RawMessage) (string, api.AccountQueryOptions, error) {
+	params := &rpcAccountParams{}
+	err := decodeRPCParams(rawParams, params)
+	if err != nil {
+		return "", api.AccountQueryOptions{}, err
+	}
+	if len(params.Address) == 0 {
+		return "", api.AccountQueryOptions{}, errors.ErrEmptyAddress
+	}
+
+	options, err := params.toAccountQueryOptions()
+	if err != nil {
+		return "", api.AccountQueryOptions{}, err
+	}
+
+	return params.Address, options, nil
+}
+
+func (rg *rpcGroup) getAccount(rawParams json.RawMessage) (interface{}, *RPCError) {
+	address, options, err := decodeAccountParams(rawParams)
+	if err != nil {
+		return nil, newInvalidParamsError(errors.ErrCouldNotGetAccount, err)
+	}
+
+	accountResponse, blockInfo, err := rg.getFacade().GetAccount(address, options)
+	if err != nil {
+		return nil, newInternalRPCError(errors.ErrCouldNotGetAccount, err)
+	}
+
+	accountResponse.Address = address
+	return gin.H{"account": accountResponse, "blockInfo": blockInfo}, nil
+}
+
+func (rg *rpcGroup) getBalance(rawParams json.RawMessage) (interface{}, *RPCError) {
+	address, options, err := decodeAccountParams(rawParams)
+	if err != nil {
+		return nil, newInvalidParamsError(errors.ErrGetBalance, err)
+	}
+
+	balance, blockInfo, err := rg.getFacade().GetBalance(address, options)
+	if err != nil {
+		return nil, newInternalRPCError(errors.ErrGetBalance, err)
+	}
+
+	return gin.H{"balance": balance.String(), "blockInfo": blockInfo}, nil
+}
+
+func (rg *rpcGroup) getAllESDTData(rawParams json.RawMessage) (interface{}, *RPCError) {
+	address, options, err := decodeAccountParams(rawParams)
+	if err != nil {
+		return nil, newInvalidParamsError(errors.ErrGetESDTNFTData, err)
+	}
+
+	tokens, blockInfo, err := rg.getFacade().GetAllESDTTokens(address, options)
+	if err != nil {
+		return nil, newInternalRPCError(errors.ErrGetESDTNFTData, err)
+	}
+
+	formattedTokens := make(map[string]*ESDTNFTTokenData)
+	for tokenID, esdtData := range tokens {
+		formattedTokens[tokenID] = buildTokenDataApiResponse(tokenID, esdtData)
+	}
+
+	return gin.H{"esdts": formattedTokens, "blockInfo": blockInfo}, nil
+}
+
+func (rg *rpcGroup) createTransaction(receivedTx *transaction.FrontendTransaction) (*transaction.Transaction, []byte, error) {
+	start := time.Now()
+	tx, txHash, err := rg.getFacade().CreateTransaction(newArgsCreateTransaction(receivedTx))
+	logging.LogAPIActionDurationIfNeeded(start, "JSON-RPC call: CreateTransaction")
+
+	return tx, txHash, err
+}
+
+func (rg *rpcGroup) sendTransaction(rawParams json.RawMessage) (interface{}, *RPCError) {
+	ftx := &transaction.FrontendTransaction{}
+	err := decodeRPCParams(rawParams, ftx)
+	if err != nil {
+		return nil, newInvalidParamsError(errors.ErrValidation, err)
+	}
+
+	tx, txHash, err := rg.createTransaction(ftx)
+	if err != nil {
+		return nil, newInvalidParamsError(errors.ErrTxGenerationFailed, err)
+	}
+
+	err = rg.getFacade().ValidateTransaction(tx)
+	if err != nil {
+		return nil, newInvalidParamsError(errors.ErrTxGenerationFailed, err)
+	}
+
+	start := time.Now()
+	_, err = rg.getFacade().SendBulkTransactions([]*transaction.Transaction{tx})
+	logging.LogAPIActionDurationIfNeeded(start, "JSON-RPC call: SendBulkTransactions")
+	if err != nil {
+		return nil, newInternalRPCError(errors.ErrTxGenerationFailed, err)
+	}
+
+	return gin.H{"txHash": hex.EncodeToString(txHash)}, nil
+}
+
+func (rg *rpcGroup) sendMultipleTransactions(rawParams json.RawMessage) (interface{}, *RPCError) {
+	var ftxs []transaction.FrontendTransaction
+	err := decodeRPCParams(rawParams, &ftxs)
+	if err != nil {
+		return nil, newInvalidParamsError(errors.ErrValidation, err)
+	}
+
+	txs := make([]*transaction.Transaction, 0, len(ftxs))
+	txsHashes := make(map[int]string)
+	for idx := range ftxs {
+		tx, txHash, errCreate := rg.createTransaction(&ftxs[idx])
+		if errCreate != nil {
+			continue
+		}
+
+		errValidate := rg.getFacade().ValidateTransaction(tx)
+		if errValidate != nil {
+			continue
+		}
+
+		txs = append(txs, tx)
+		txsHashes[idx] = hex.EncodeToString(txHash)
+	}
+
+	start := time.Now()
+	numOfSentTxs, err := rg.getFacade().SendBulkTransactions(txs)
+	logging.LogAPIActionDurationIfNeeded(start, "JSON-RPC call: SendBulkTransactions")
+	if err != nil {
+		return nil, newInternalRPCError(errors.ErrTxGenerationFailed, err)
+	}
+
+	return gin.H{"txsSent": numOfSentTxs, "txsHashes": txsHashes}, nil
+}
+
+func (rg *rpcGroup) getTransaction(rawParams json.RawMessage) (interface{}, *RPCError) {
+	params := &rpcTransactionParams{}
+	err := decodeRPCParams(rawParams, params)
+	if err != nil {
+		return nil, newInvalidParamsError(errors.ErrValidation, err)
+	}
+	if len(params.Hash) == 0 {
+		return nil, newInvalidParamsError(errors.ErrValidation, errors.ErrValidationEmptyTxHash)
+	}
+
+	start := time.Now()
+	tx, err := rg.getFacade().GetTransaction(params.Hash, params.WithResults)
+	logging.LogAPIActionDurationIfNeeded(start, "JSON-RPC call: GetTransaction")
+	if err != nil {
+		return nil, newInternalRPCError(errors.ErrGetTransaction, err)
+	}
+
+	return gin.H{"transaction": tx}, nil
+}
+
+func (rg *rpcGroup) executeQuery(rawParams json.RawMessage) (interface{}, *RPCError) {
+	params := &rpcVMQueryParams{}
+	err := decodeRPCParams(rawParams, params)
+	if err != nil {
+		return nil, newInvalidParamsError(errors.ErrQueryError, err)
+	}
+
+	query, err := createSCQuery(rg.getFacade(), &params.VMValueRequest)
+	if err != nil {
+		return nil, newInvalidParamsError(errors.ErrQueryError, err)
+	}
+
+	query.BlockHash, err = hex.DecodeString(params.BlockHash)
+	if err != nil {
+		return nil, newInvalidParamsError(errors.ErrQueryError, fmt.Errorf("%w for block hash", err))
+	}
+	if params.BlockNonce != nil {
+		query.BlockNonce = core.OptionalUint64{Value: *params.BlockNonce, HasValue: true}
+	}
+
+	vmOutputApi, blockInfo, err := rg.getFacade().ExecuteSCQuery(query)
+	if err != nil {
+		return nil, newInternalRPCError(errors.ErrQueryError, err)
+	}
+
+	return gin.H{"data": vmOutputApi, "blockInfo": blockInfo}, nil
+}
+
+func decodeBlockParams(rawParams json.RawMessage) (*rpcBlockParams, api.BlockQueryOptions, error) {
+	params := &rpcBlockParams{}
+	err := decodeRPCParams(rawParams, params)
+	if err != nil {
+		return nil, api.BlockQueryOptions{}, err
+	}
+
+	options := api.BlockQueryOptions{WithTransactions: params.WithTxs, WithLogs: params.WithLogs}
+	return params, options, nil
+}
+
+func (rg *rpcGroup) getBlockByNonce(rawParams json.RawMessage) (interface{}, *RPCError) {
+	params, options, err := decodeBlockParams(rawParams)
+	if err != nil {
+		return nil, newInvalidParamsError(errors.ErrGetBlock, err)
+	}
+	if params.Nonce == nil {
+		return nil, newInvalidParamsError(errors.ErrGetBlock, errors.ErrInvalidBlockNonce)
+	}
+
+	start := time.Now()
+	block, err := rg.getFacade().GetBlockByNonce(*params.Nonce, options)
+	logging.LogAPIActionDurationIfNeeded(start, "JSON-RPC call: GetBlockByNonce")
+	if err != nil {
+		return nil, newInternalRPCError(errors.ErrGetBlock, err)
+	}
+
+	return gin.H{"block": block}, nil
+}
+
+func (rg *rpcGroup) getBlockByHash(rawParams json.RawMessage) (interface{}, *RPCError) {
+	params, options, err := decodeBlockParams(rawParams)
+	if err != nil {
+		return nil, newInvalidParamsError(errors.ErrGetBlock, err)
+	}
+	if len(params.Hash) == 0 {
+		return nil, newInvalidParamsError(errors.ErrGetBlock, errors.ErrValidationEmptyBlockHash)
+	}
+
+	start := time.Now()
+	block, err := rg.getFacade().GetBlockByHash(params.Hash, options)
+	logging.LogAPIActionDurationIfNeeded(start, "JSON-RPC call: GetBlockByHash")
+	if err != nil {
+		return nil, newInternalRPCError(errors.ErrGetBlock, err)
+	}
+
+	return gin.H{"block": block}, nil
+}
+
+func (rg *rpcGroup) getNetworkConfig(_ json.RawMessage) (interface{}, *RPCError) {
+	configMetrics, err := rg.getFacade().StatusMetrics().ConfigMetrics()
+	if err != nil {
+		return nil, &RPCError{Code: rpcCodeInternalError, Message: err.Error()}
+	}
+
+	return gin.H{"config": configMetrics}, nil
+}
+
+func (rg *rpcGroup) getNetworkStatus(_ json.RawMessage) (interface{}, *RPCError) {
+	networkMetrics, err := rg.getFacade().StatusMetrics().NetworkMetrics()
+	if err != nil {
+		return nil, &RPCError{Code: rpcCodeInternalError, Message: err.Error()}
+	}
+
+	return gin.H{"status": networkMetrics}, nil
+}
+
+func (rg *rpcGroup) getFacade() rpcFacadeHandler {
+	rg.mutFacade.RLock()
+	defer rg.mutFacade.RUnlock()
+
+	return rg.facade
+}
+
+// UpdateFacade will update the facade
+func (rg *rpcGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(rpcFacadeHandler)
+	if !ok {
+		return fmt.Errorf("%w for rpc group", errors.ErrFacadeWrongTypeAssertion)
+	}
+
+	rg.mutFacade.Lock()
+	rg.facade = castFacade
+	rg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rg *rpcGroup) IsInterfaceNil() bool {
+	return rg == nil
+}
@@ -0,0 +1,411 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type rpcTestResponse struct {
+	JSONRPC string                 `json:"jsonrpc"`
+	Result  map[string]interface{} `json:"result"`
+	Error   *groups.RPCError       `json:"error"`
+	ID      json.RawMessage        `json:"id"`
+}
+
+func TestNewRPCGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		rg, err := groups.NewRPCGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, rg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		rg, err := groups.NewRPCGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, rg)
+	})
+}
+
+func TestRPCGroup_HandleRequests(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid json should return parse error", func(t *testing.T) {
+		t.Parallel()
+
+		resp := sendRPCRequest(t, &mock.FacadeStub{}, getRPCRoutesConfig(), "not a json")
+
+		response := rpcTestResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, -32700, response.Error.Code)
+	})
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		resp := sendRPCRequest(t, &mock.FacadeStub{}, getRPCRoutesConfig(), `{"jsonrpc":"1.0","method":"network_getConfig","id":1}`)
+
+		response := rpcTestResponse{}
+		loadResponse(resp.Body, &response)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, -32600, response.Error.Code)
+		assert.Equal(t, "1", string(response.ID))
+	})
+	t.Run("unknown method should error", func(t *testing.T) {
+		t.Parallel()
+
+		resp := sendRPCRequest(t, &mock.FacadeStub{}, getRPCRoutesConfig(), `{"jsonrpc":"2.0","method":"unknown","id":1}`)
+
+		response := rpcTestResponse{}
+		loadResponse(resp.Body, &response)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, -32601, response.Error.Code)
+	})
+	t.Run("method mirroring a closed endpoint should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+				require.Fail(t, "should have not been called")
+				return nil, api.BlockInfo{}, nil
+			},
+		}
+		routesConfig := getRPCRoutesConfig()
+		routesConfig.APIPackages["address"] = config.APIPackageConfig{
+			Routes: []config.RouteConfig{{Name: "/:address/balance", Open: false}},
+		}
+
+		resp := sendRPCRequest(t, facade, routesConfig, `{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1a"},"id":1}`)
+
+		response := rpcTestResponse{}
+		loadResponse(resp.Body, &response)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, -32601, response.Error.Code)
+	})
+	t.Run("invalid params should error", func(t *testing.T) {
+		t.Parallel()
+
+		resp := sendRPCRequest(t, &mock.FacadeStub{}, getRPCRoutesConfig(), `{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1a","blockHash":"zz"},"id":1}`)
+
+		response := rpcTestResponse{}
+		loadResponse(resp.Body, &response)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, -32602, response.Error.Code)
+		assert.Contains(t, response.Error.Message, apiErrors.ErrGetBalance.Error())
+	})
+	t.Run("facade error should return internal error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+				return nil, api.BlockInfo{}, expectedErr
+			},
+		}
+
+		resp := sendRPCRequest(t, facade, getRPCRoutesConfig(), `{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1a"},"id":1}`)
+
+		response := rpcTestResponse{}
+		loadResponse(resp.Body, &response)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, -32603, response.Error.Code)
+		assert.Contains(t, response.Error.Message, expectedErr.Error())
+	})
+	t.Run("throttled method should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+				return &mock.ThrottlerStub{
+					CanProcessCalled: func() bool {
+						return endpoint != "/transaction/:hash"
+					},
+				}, true
+			},
+			GetTransactionHandler: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+
+		resp := sendRPCRequest(t, facade, getRPCRoutesConfig(), `{"jsonrpc":"2.0","method":"transaction_get","params":{"hash":"aa"},"id":1}`)
+
+		response := rpcTestResponse{}
+		loadResponse(resp.Body, &response)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, -32005, response.Error.Code)
+		assert.Contains(t, response.Error.Message, apiErrors.ErrTooManyRequests.Error())
+	})
+	t.Run("notification should not respond", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		facade := &mock.FacadeStub{
+			GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+				numCalls++
+				return big.NewInt(10), api.BlockInfo{}, nil
+			},
+		}
+
+		resp := sendRPCRequest(t, facade, getRPCRoutesConfig(), `{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1a"}}`)
+
+		assert.Equal(t, http.StatusNoContent, resp.Code)
+		assert.Equal(t, 0, resp.Body.Len())
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+				assert.Equal(t, "erd1a", address)
+				assert.Equal(t, core.OptionalUint64{Value: 37, HasValue: true}, options.BlockNonce)
+				return big.NewInt(10), api.BlockInfo{Nonce: 37}, nil
+			},
+		}
+
+		resp := sendRPCRequest(t, facade, getRPCRoutesConfig(), `{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1a","blockNonce":37},"id":"abc"}`)
+
+		response := rpcTestResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Nil(t, response.Error)
+		assert.Equal(t, "2.0", response.JSONRPC)
+		assert.Equal(t, `"abc"`, string(response.ID))
+		assert.Equal(t, "10", response.Result["balance"])
+	})
+}
+
+func TestRPCGroup_HandleBatchRequests(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		resp := sendRPCRequest(t, &mock.FacadeStub{}, getRPCRoutesConfig(), "[]")
+
+		response := rpcTestResponse{}
+		loadResponse(resp.Body, &response)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, -32600, response.Error.Code)
+	})
+	t.Run("batch of notifications should not respond", func(t *testing.T) {
+		t.Parallel()
+
+		resp := sendRPCRequest(t, &mock.FacadeStub{}, getRPCRoutesConfig(), `[{"jsonrpc":"2.0","method":"unknown"}]`)
+
+		assert.Equal(t, http.StatusNoContent, resp.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+				return big.NewInt(10), api.BlockInfo{}, nil
+			},
+			StatusMetricsHandler: func() external.StatusMetricsHandler {
+				return &testscommon.StatusMetricsStub{
+					ConfigMetricsCalled: func() (map[string]interface{}, error) {
+						return map[string]interface{}{"erd_chain_id": "T"}, nil
+					},
+				}
+			},
+		}
+		body := `[
+			{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1a"},"id":1},
+			{"jsonrpc":"2.0","method":"network_getConfig"},
+			{"jsonrpc":"2.0","method":"network_getConfig","id":2},
+			{"jsonrpc":"2.0","method":"unknown","id":3},
+			1
+		]`
+
+		resp := sendRPCRequest(t, facade, getRPCRoutesConfig(), body)
+
+		var responses []rpcTestResponse
+		loadResponse(resp.Body, &responses)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, 4, len(responses))
+		assert.Equal(t, "10", responses[0].Result["balance"])
+		assert.Equal(t, map[string]interface{}{"erd_chain_id": "T"}, responses[1].Result["config"])
+		assert.Equal(t, "2", string(responses[1].ID))
+		assert.Equal(t, -32601, responses[2].Error.Code)
+		assert.Equal(t, -32600, responses[3].Error.Code)
+		assert.Equal(t, "null", string(responses[3].ID))
+	})
+	t.Run("calls exceeding the rate limiter bucket should error", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		facade := &mock.FacadeStub{
+			GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+				numCalls++
+				return big.NewInt(10), api.BlockInfo{}, nil
+			},
+		}
+		limiter, err := middleware.NewTokenBucketLimiter(middleware.ArgsTokenBucketLimiter{
+			BucketCapacity:        10,
+			RefillTokensPerSecond: 1,
+			DefaultCost:           1,
+			EndpointsCosts: map[string]uint32{
+				"/address/:address/balance": 4,
+			},
+		})
+		require.NoError(t, err)
+
+		rg, err := groups.NewRPCGroup(facade)
+		require.NoError(t, err)
+		ws := gin.New()
+		ws.Use(limiter.MiddlewareHandlerFunc())
+		rg.RegisterRoutes(ws.Group("rpc"), getRPCRoutesConfig())
+
+		body := `[
+			{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1a"},"id":1},
+			{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1a"},"id":2},
+			{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1a"},"id":3}
+		]`
+		req, _ := http.NewRequest("POST", "/rpc", bytes.NewBufferString(body))
+		req.RemoteAddr = "1.2.3.4:1111"
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		var responses []rpcTestResponse
+		loadResponse(resp.Body, &responses)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, 3, len(responses))
+		assert.Nil(t, responses[0].Error)
+		assert.Nil(t, responses[1].Error)
+		require.NotNil(t, responses[2].Error)
+		assert.Equal(t, -32005, responses[2].Error.Code)
+		assert.Contains(t, responses[2].Error.Message, "/address/:address/balance")
+		assert.Equal(t, 2, numCalls)
+	})
+	t.Run("throttled batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+				return &mock.ThrottlerStub{
+					CanProcessCalled: func() bool {
+						return endpoint != "/rpc"
+					},
+				}, true
+			},
+		}
+
+		resp := sendRPCRequest(t, facade, getRPCRoutesConfig(), `[{"jsonrpc":"2.0","method":"network_getConfig","id":1}]`)
+
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	})
+}
+
+func TestRPCGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		rg, _ := groups.NewRPCGroup(&mock.FacadeStub{})
+		err := rg.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		rg, _ := groups.NewRPCGroup(&mock.FacadeStub{})
+		err := rg.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		rg, _ := groups.NewRPCGroup(&mock.FacadeStub{})
+		newFacade := &mock.FacadeStub{
+			GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+				return nil, api.BlockInfo{}, expectedErr
+			},
+		}
+		err := rg.UpdateFacade(newFacade)
+		require.NoError(t, err)
+
+		ws := startWebServer(rg, "rpc", getRPCRoutesConfig())
+		req, _ := http.NewRequest("POST", "/rpc", bytes.NewBufferString(`{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1a"},"id":1}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := rpcTestResponse{}
+		loadResponse(resp.Body, &response)
+		require.NotNil(t, response.Error)
+		assert.Contains(t, response.Error.Message, expectedErr.Error())
+	})
+}
+
+func TestRPCGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	rg, _ := groups.NewRPCGroup(nil)
+	require.True(t, rg.IsInterfaceNil())
+
+	rg, _ = groups.NewRPCGroup(&mock.FacadeStub{})
+	require.False(t, rg.IsInterfaceNil())
+}
+
+func sendRPCRequest(t *testing.T, facade *mock.FacadeStub, routesConfig config.ApiRoutesConfig, body string) *httptest.ResponseRecorder {
+	rg, err := groups.NewRPCGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(rg, "rpc", routesConfig)
+	req, _ := http.NewRequest("POST", "/rpc", bytes.NewBufferString(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func getRPCRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"rpc": {
+				Routes: []config.RouteConfig{
+					{Name: "", Open: true},
+				},
+			},
+			"address": {
+				Routes: []config.RouteConfig{
+					{Name: "/:address/balance", Open: true},
+				},
+			},
+			"transaction": {
+				Routes: []config.RouteConfig{
+					{Name: "/:txhash", Open: true},
+				},
+			},
+			"network": {
+				Routes: []config.RouteConfig{
+					{Name: "/config", Open: true},
+				},
+			},
+		},
+	}
+}
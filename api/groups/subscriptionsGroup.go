@@ -0,0 +1,342 @@
+package groups
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const (
+	ssePath = "/sse"
+	wsPath  = "/ws"
+
+	urlParamEvents      = "events"
+	urlParamAddresses   = "addresses"
+	urlParamIdentifiers = "identifiers"
+	urlParamFromNonce   = "fromNonce"
+
+	lastEventIDHeader = "Last-Event-ID"
+	allowAllOrigins   = "*"
+	wsWriteTimeout    = 10 * time.Second
+)
+
+// subscriptionQueryParams describes the URL parameters parsed into the subscription filter. A reconnecting client can
+// also provide the Last-Event-ID header, which takes precedence over the fromNonce parameter
+var subscriptionQueryParams = []shared.QueryParameter{
+	{Name: urlParamEvents, Type: shared.ParamTypeString, Description: "comma separated event types to subscribe to"},
+	{Name: urlParamAddresses, Type: shared.ParamTypeString, Description: "comma separated addresses to filter the events by"},
+	{Name: urlParamIdentifiers, Type: shared.ParamTypeString, Description: "comma separated event identifiers to filter the events by"},
+	{Name: urlParamFromNonce, Type: shared.ParamTypeInteger, Description: "replay the events starting with the block with the given nonce"},
+}
+
+// subscriptionsFacadeHandler defines the methods to be implemented by a facade for subscriptions requests
+type subscriptionsFacadeHandler interface {
+	Subscribe(filter common.SubscriptionFilter) (common.Subscription, error)
+	IsInterfaceNil() bool
+}
+
+type subscriptionsGroup struct {
+	*baseGroup
+	facade    subscriptionsFacadeHandler
+	mutFacade sync.RWMutex
+	upgrader  websocket.Upgrader
+}
+
+// NewSubscriptionsGroup returns a new instance of subscriptionsGroup. The websocket connections are accepted only from
+// the provided origins or, if none is provided, from the same origin as the node's API
+func NewSubscriptionsGroup(facade subscriptionsFacadeHandler, allowedOrigins []string) (*subscriptionsGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for subscriptions group", errors.ErrNilFacadeHandler)
+	}
+
+	sg := &subscriptionsGroup{
+		facade: facade,
+		baseGroup: &baseGroup{
+			requiredRole: shared.RolePublic,
+		},
+		upgrader: websocket.Upgrader{
+			CheckOrigin: createCheckOrigin(allowedOrigins),
+		},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    ssePath,
+			Method:  http.MethodGet,
+			Handler: sg.streamServerSentEvents,
+			Docs: &shared.EndpointDocs{
+				Summary:     "streams the events matching the given filter as server-sent events, not as a JSON response",
+				QueryParams: subscriptionQueryParams,
+			},
+		},
+		{
+			Path:    wsPath,
+			Method:  http.MethodGet,
+			Handler: sg.streamWebSocket,
+			Docs: &shared.EndpointDocs{
+				Summary:     "streams the events matching the given filter as JSON messages over a websocket connection",
+				QueryParams: subscriptionQueryParams,
+			},
+		},
+	}
+	sg.endpoints = endpoints
+
+	return sg, nil
+}
+
+// createCheckOrigin returns nil for an empty list, the websocket upgrader falling back on its same origin check
+func createCheckOrigin(allowedOrigins []string) func(r *http.Request) bool {
+	if len(allowedOrigins) == 0 {
+		return nil
+	}
+
+	origins := make(map[string]struct{}, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		origins[strings.ToLower(strings.TrimSuffix(origin, "/"))] = struct{}{}
+	}
+
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if len(origin) == 0 {
+			// not a browser request, as the browsers always set the origin on websocket connections
+			return true
+		}
+
+		_, allowAll := origins[allowAllOrigins]
+		_, isAllowed := origins[strings.ToLower(origin)]
+
+		return allowAll || isAllowed
+	}
+}
+
+// streamServerSentEvents streams the events matching the provided filter as server-sent events. A reconnecting
+// client resumes from the nonce carried by the Last-Event-ID header
+func (sg *subscriptionsGroup) streamServerSentEvents(c *gin.Context) {
+	filter, err := parseSubscriptionFilter(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	subscription, err := sg.getFacade().Subscribe(filter)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, fmt.Sprintf("%s: %s", errors.ErrSubscribe.Error(), err.Error()), shared.ReturnCodeRequestError)
+		return
+	}
+	defer subscription.Close()
+
+	// the number of streams is bounded by the subscribers limit, so they do not need to hold a global throttler slot
+	middleware.ReleaseGlobalThrottlerSlot(c)
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	ctx := c.Request.Context()
+	for {
+		events, errNext := subscription.NextEvents(ctx)
+		if errNext != nil {
+			if ctx.Err() == nil {
+				writeServerSentError(c, errNext)
+			}
+			return
+		}
+
+		for _, event := range events {
+			errWrite := writeServerSentEvent(c, event)
+			if errWrite != nil {
+				log.Debug("subscriptionsGroup.streamServerSentEvents", "error", errWrite)
+				return
+			}
+		}
+		c.Writer.Flush()
+	}
+}
+
+func writeServerSentEvent(c *gin.Context, event *common.SubscriptionEvent) error {
+	buff, err := json.Marshal(event.Data)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(c.Writer, "id: %d\nevent: %s\ndata: %s\n\n", event.Nonce, event.Type, buff)
+	return err
+}
+
+func writeServerSentError(c *gin.Context, err error) {
+	_, _ = fmt.Fprintf(c.Writer, "event: error\ndata: %q\n\n", err.Error())
+	c.Writer.Flush()
+}
+
+// streamWebSocket streams the events matching the provided filter as JSON messages over a websocket connection
+func (sg *subscriptionsGroup) streamWebSocket(c *gin.Context) {
+	filter, err := parseSubscriptionFilter(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	subscription, err := sg.getFacade().Subscribe(filter)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, fmt.Sprintf("%s: %s", errors.ErrSubscribe.Error(), err.Error()), shared.ReturnCodeRequestError)
+		return
+	}
+	defer subscription.Close()
+
+	conn, err := sg.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Debug("subscriptionsGroup.streamWebSocket: upgrade", "error", err)
+		return
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	// the number of streams is bounded by the subscribers limit, so they do not need to hold a global throttler slot
+	middleware.ReleaseGlobalThrottlerSlot(c)
+
+	ctx := c.Request.Context()
+	chClosed := make(chan struct{})
+	go readUntilClosed(conn, chClosed)
+
+	for {
+		events, errNext := nextEventsUntilClosed(ctx, subscription, chClosed)
+		if errNext != nil {
+			closeMessage := websocket.FormatCloseMessage(websocket.CloseNormalClosure, errNext.Error())
+			_ = conn.WriteControl(websocket.CloseMessage, closeMessage, time.Now().Add(wsWriteTimeout))
+			return
+		}
+
+		for _, event := range events {
+			_ = conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+			errWrite := conn.WriteJSON(event)
+			if errWrite != nil {
+				log.Debug("subscriptionsGroup.streamWebSocket: write", "error", errWrite)
+				return
+			}
+		}
+	}
+}
+
+// readUntilClosed consumes the incoming messages so that the control frames get processed and signals when the
+// client went away
+func readUntilClosed(conn *websocket.Conn, chClosed chan struct{}) {
+	defer close(chClosed)
+
+	for {
+		_, _, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+	}
+}
+
+func nextEventsUntilClosed(
+	ctx context.Context,
+	subscription common.Subscription,
+	chClosed chan struct{},
+) ([]*common.SubscriptionEvent, error) {
+	ctxWithCancel, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		select {
+		case <-chClosed:
+			cancel()
+		case <-ctxWithCancel.Done():
+		}
+	}()
+
+	return subscription.NextEvents(ctxWithCancel)
+}
+
+func parseSubscriptionFilter(c *gin.Context) (common.SubscriptionFilter, error) {
+	filter := common.SubscriptionFilter{
+		EventTypes:  parseCommaSeparatedUrlParam(c, urlParamEvents),
+		Addresses:   parseCommaSeparatedUrlParam(c, urlParamAddresses),
+		Identifiers: parseCommaSeparatedUrlParam(c, urlParamIdentifiers),
+	}
+
+	fromNonce, err := parseUint64UrlParam(c, urlParamFromNonce)
+	if err != nil {
+		return common.SubscriptionFilter{}, fmt.Errorf("%w for %s", err, urlParamFromNonce)
+	}
+	filter.FromNonce = fromNonce.Value
+	filter.HasFromNonce = fromNonce.HasValue
+
+	lastEventID := c.GetHeader(lastEventIDHeader)
+	if len(lastEventID) == 0 {
+		return filter, nil
+	}
+
+	// the events of the last seen block might not have been fully delivered, so the stream resumes from that block
+	lastNonce, err := strconv.ParseUint(lastEventID, 10, 64)
+	if err != nil {
+		return common.SubscriptionFilter{}, fmt.Errorf("%w for %s header", err, lastEventIDHeader)
+	}
+	filter.FromNonce = lastNonce
+	filter.HasFromNonce = true
+
+	return filter, nil
+}
+
+func parseCommaSeparatedUrlParam(c *gin.Context, name string) []string {
+	param := c.Request.URL.Query().Get(name)
+	if param == "" {
+		return nil
+	}
+
+	values := make([]string, 0)
+	for _, value := range strings.Split(param, ",") {
+		value = strings.TrimSpace(value)
+		if len(value) > 0 {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+func (sg *subscriptionsGroup) getFacade() subscriptionsFacadeHandler {
+	sg.mutFacade.RLock()
+	defer sg.mutFacade.RUnlock()
+
+	return sg.facade
+}
+
+// UpdateFacade will update the facade
+func (sg *subscriptionsGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(subscriptionsFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	sg.mutFacade.Lock()
+	sg.facade = castFacade
+	sg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sg *subscriptionsGroup) IsInterfaceNil() bool {
+	return sg == nil
+}
@@ -0,0 +1,306 @@
+package groups_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errStreamEnded = errors.New("stream ended")
+
+func createSubscriptionStub(events []*common.SubscriptionEvent, closeCounter *uint32) *testscommon.SubscriptionStub {
+	numCalls := uint32(0)
+	return &testscommon.SubscriptionStub{
+		NextEventsCalled: func(ctx context.Context) ([]*common.SubscriptionEvent, error) {
+			if atomic.AddUint32(&numCalls, 1) == 1 {
+				return events, nil
+			}
+
+			return nil, errStreamEnded
+		},
+		CloseCalled: func() {
+			atomic.AddUint32(closeCounter, 1)
+		},
+	}
+}
+
+func TestNewSubscriptionsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		sg, err := groups.NewSubscriptionsGroup(nil, nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, sg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		sg, err := groups.NewSubscriptionsGroup(&mock.FacadeStub{}, nil)
+		require.NoError(t, err)
+		require.NotNil(t, sg)
+	})
+}
+
+func TestSubscriptionsGroup_ServerSentEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid from nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		sg, _ := groups.NewSubscriptionsGroup(&mock.FacadeStub{}, nil)
+		ws := startWebServer(sg, "subscriptions", getSubscriptionsRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/subscriptions/sse?fromNonce=abc", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			SubscribeCalled: func(filter common.SubscriptionFilter) (common.Subscription, error) {
+				return nil, expectedErr
+			},
+		}
+		sg, _ := groups.NewSubscriptionsGroup(facade, nil)
+		ws := startWebServer(sg, "subscriptions", getSubscriptionsRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/subscriptions/sse", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrSubscribe.Error())
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should stream the events", func(t *testing.T) {
+		t.Parallel()
+
+		events := []*common.SubscriptionEvent{
+			{Type: common.SubscriptionBlockEvent, Nonce: 7, Data: map[string]string{"hash": "aa"}},
+		}
+		closeCounter := uint32(0)
+		var providedFilter common.SubscriptionFilter
+		facade := &mock.FacadeStub{
+			SubscribeCalled: func(filter common.SubscriptionFilter) (common.Subscription, error) {
+				providedFilter = filter
+				return createSubscriptionStub(events, &closeCounter), nil
+			},
+		}
+		sg, _ := groups.NewSubscriptionsGroup(facade, nil)
+		ws := startWebServer(sg, "subscriptions", getSubscriptionsRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/subscriptions/sse?events=block,log&addresses=erd1a,%20erd1b&identifiers=transfer&fromNonce=5", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		expectedFilter := common.SubscriptionFilter{
+			EventTypes:   []string{"block", "log"},
+			Addresses:    []string{"erd1a", "erd1b"},
+			Identifiers:  []string{"transfer"},
+			FromNonce:    5,
+			HasFromNonce: true,
+		}
+		assert.Equal(t, expectedFilter, providedFilter)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "text/event-stream", resp.Header().Get("Content-Type"))
+		body := resp.Body.String()
+		assert.True(t, strings.HasPrefix(body, "id: 7\nevent: block\ndata: {\"hash\":\"aa\"}\n\n"))
+		assert.Contains(t, body, "event: error\ndata: \"stream ended\"\n\n")
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&closeCounter))
+	})
+	t.Run("last event id should override the from nonce", func(t *testing.T) {
+		t.Parallel()
+
+		closeCounter := uint32(0)
+		var providedFilter common.SubscriptionFilter
+		facade := &mock.FacadeStub{
+			SubscribeCalled: func(filter common.SubscriptionFilter) (common.Subscription, error) {
+				providedFilter = filter
+				return createSubscriptionStub(nil, &closeCounter), nil
+			},
+		}
+		sg, _ := groups.NewSubscriptionsGroup(facade, nil)
+		ws := startWebServer(sg, "subscriptions", getSubscriptionsRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/subscriptions/sse?fromNonce=5", nil)
+		req.Header.Set("Last-Event-ID", "12")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, uint64(12), providedFilter.FromNonce)
+		assert.True(t, providedFilter.HasFromNonce)
+	})
+}
+
+func TestSubscriptionsGroup_WebSocket(t *testing.T) {
+	t.Parallel()
+
+	events := []*common.SubscriptionEvent{
+		{Type: common.SubscriptionTransactionEvent, Nonce: 3, Data: map[string]string{"status": "success"}},
+	}
+	closeCounter := uint32(0)
+	facade := &mock.FacadeStub{
+		SubscribeCalled: func(filter common.SubscriptionFilter) (common.Subscription, error) {
+			return createSubscriptionStub(events, &closeCounter), nil
+		},
+	}
+	sg, _ := groups.NewSubscriptionsGroup(facade, nil)
+	server := httptest.NewServer(startWebServer(sg, "subscriptions", getSubscriptionsRoutesConfig()))
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/subscriptions/ws?events=transaction"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	receivedEvent := struct {
+		Type  string            `json:"type"`
+		Nonce uint64            `json:"nonce"`
+		Data  map[string]string `json:"data"`
+	}{}
+	err = conn.ReadJSON(&receivedEvent)
+	require.NoError(t, err)
+	assert.Equal(t, common.SubscriptionTransactionEvent, receivedEvent.Type)
+	assert.Equal(t, uint64(3), receivedEvent.Nonce)
+	assert.Equal(t, "success", receivedEvent.Data["status"])
+
+	_, _, err = conn.ReadMessage()
+	closeErr, ok := err.(*websocket.CloseError)
+	require.True(t, ok)
+	assert.Equal(t, websocket.CloseNormalClosure, closeErr.Code)
+	assert.Equal(t, errStreamEnded.Error(), closeErr.Text)
+}
+
+func TestSubscriptionsGroup_WebSocketOrigins(t *testing.T) {
+	t.Parallel()
+
+	dialWithOrigin := func(allowedOrigins []string, origin string) (*http.Response, error) {
+		facade := &mock.FacadeStub{
+			SubscribeCalled: func(filter common.SubscriptionFilter) (common.Subscription, error) {
+				return createSubscriptionStub(nil, new(uint32)), nil
+			},
+		}
+		sg, _ := groups.NewSubscriptionsGroup(facade, allowedOrigins)
+		server := httptest.NewServer(startWebServer(sg, "subscriptions", getSubscriptionsRoutesConfig()))
+		defer server.Close()
+
+		header := http.Header{}
+		if len(origin) > 0 {
+			header.Set("Origin", origin)
+		}
+		url := "ws" + strings.TrimPrefix(server.URL, "http") + "/subscriptions/ws?events=transaction"
+		conn, resp, err := websocket.DefaultDialer.Dial(url, header)
+		if err == nil {
+			_ = conn.Close()
+		}
+
+		return resp, err
+	}
+
+	t.Run("foreign origin is rejected by default", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := dialWithOrigin(nil, "https://evil.example.com")
+		require.Equal(t, websocket.ErrBadHandshake, err)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+	t.Run("missing origin is accepted by default", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := dialWithOrigin(nil, "")
+		assert.NoError(t, err)
+	})
+	t.Run("origin not in the allowed list is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := dialWithOrigin([]string{"https://explorer.example.com"}, "https://evil.example.com")
+		require.Equal(t, websocket.ErrBadHandshake, err)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+	t.Run("origin in the allowed list is accepted", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := dialWithOrigin([]string{"https://Explorer.example.com/"}, "https://explorer.example.com")
+		assert.NoError(t, err)
+	})
+	t.Run("any origin is accepted with wildcard", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := dialWithOrigin([]string{"*"}, "https://evil.example.com")
+		assert.NoError(t, err)
+	})
+}
+
+func TestSubscriptionsGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		sg, _ := groups.NewSubscriptionsGroup(&mock.FacadeStub{}, nil)
+		err := sg.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		sg, _ := groups.NewSubscriptionsGroup(&mock.FacadeStub{}, nil)
+		err := sg.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sg, _ := groups.NewSubscriptionsGroup(&mock.FacadeStub{}, nil)
+		err := sg.UpdateFacade(&mock.FacadeStub{})
+		require.NoError(t, err)
+	})
+}
+
+func TestSubscriptionsGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	sg, _ := groups.NewSubscriptionsGroup(nil, nil)
+	require.True(t, sg.IsInterfaceNil())
+
+	sg, _ = groups.NewSubscriptionsGroup(&mock.FacadeStub{}, nil)
+	require.False(t, sg.IsInterfaceNil())
+}
+
+func getSubscriptionsRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"subscriptions": {
+				Routes: []config.RouteConfig{
+					{Name: "/sse", Open: true},
+					{Name: "/ws", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -3,6 +3,7 @@ package groups
 import (
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"net/http"
 	"strconv"
 	"sync"
@@ -24,11 +25,15 @@ import (
 const (
 	sendTransactionEndpoint          = "/transaction/send"
 	simulateTransactionEndpoint      = "/transaction/simulate"
+	traceTransactionEndpoint         = "/transaction/trace"
+	getTransactionTraceEndpoint      = "/transaction/:txhash/trace"
 	sendMultipleTransactionsEndpoint = "/transaction/send-multiple"
 	getTransactionEndpoint           = "/transaction/:hash"
 	getScrsByTxHashEndpoint          = "/transaction/scrs-by-tx-hash/:txhash"
 	sendTransactionPath              = "/send"
 	simulateTransactionPath          = "/simulate"
+	traceTransactionPath             = "/trace"
+	getTransactionTracePath          = "/:txhash/trace"
 	costPath                         = "/cost"
 	sendMultiplePath                 = "/send-multiple"
 	getTransactionPath               = "/:txhash"
@@ -48,9 +53,12 @@ const (
 type transactionFacadeHandler interface {
 	CreateTransaction(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
 	ValidateTransaction(tx *transaction.Transaction) error
-	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
+	ValidateTransactionForSimulationWithStateOverrides(tx *transaction.Transaction, checkSignature bool, overrides []*txSimData.StateOverride) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionExecutionWithStateOverrides(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*txSimData.SimulationResultsWithVMOutput, error)
+	TraceTransactionExecution(tx *transaction.Transaction, overrides []*txSimData.StateOverride, blockNonce core.OptionalUint64) (*txSimData.ExecutionTrace, error)
+	GetTransactionExecutionTrace(hash string) (*txSimData.ExecutionTrace, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetSCRsByTxHash(txHash string, scrHash string) ([]*transaction.ApiSmartContractResult, error)
 	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
@@ -58,7 +66,9 @@ type transactionFacadeHandler interface {
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionGasLimitWithStateOverrides(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
+	DecodeAddressPubkey(pk string) ([]byte, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
 }
@@ -76,8 +86,10 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 	}
 
 	tg := &transactionGroup{
-		facade:    facade,
-		baseGroup: &baseGroup{},
+		facade: facade,
+		baseGroup: &baseGroup{
+			requiredRole: shared.RolePublic,
+		},
 	}
 
 	endpoints := []*shared.EndpointHandlerData{
@@ -91,6 +103,11 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 					Position:   shared.Before,
 				},
 			},
+			Docs: &shared.EndpointDocs{
+				Summary:      "sends a transaction",
+				RequestBody:  transaction.FrontendTransaction{},
+				ResponseData: map[string]interface{}{"txHash": ""},
+			},
 		},
 		{
 			Path:    simulateTransactionPath,
@@ -102,11 +119,44 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 					Position:   shared.Before,
 				},
 			},
+			Docs: &shared.EndpointDocs{
+				Summary: "simulates the execution of a transaction, with the optional state overrides applied",
+				QueryParams: []shared.QueryParameter{
+					{Name: queryParamCheckSignature, Type: shared.ParamTypeBoolean, Description: "whether the signature should be checked"},
+				},
+				RequestBody:  SimulationRequest{},
+				ResponseData: map[string]interface{}{"result": &txSimData.SimulationResultsWithVMOutput{}},
+			},
+		},
+		{
+			Path:    traceTransactionPath,
+			Method:  http.MethodPost,
+			Handler: tg.traceTransaction,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(traceTransactionEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+			Docs: &shared.EndpointDocs{
+				Summary: "traces the execution of a transaction, with the optional state overrides applied",
+				QueryParams: []shared.QueryParameter{
+					{Name: queryParamCheckSignature, Type: shared.ParamTypeBoolean, Description: "whether the signature should be checked"},
+					{Name: urlParamBlockNonce, Type: shared.ParamTypeInteger, Description: "the nonce of the block on top of which the transaction is executed"},
+				},
+				RequestBody:  SimulationRequest{},
+				ResponseData: map[string]interface{}{"trace": &txSimData.ExecutionTrace{}},
+			},
 		},
 		{
 			Path:    costPath,
 			Method:  http.MethodPost,
 			Handler: tg.computeTransactionGasLimit,
+			Docs: &shared.EndpointDocs{
+				Summary:          "computes the gas units needed by a transaction, with the optional state overrides applied",
+				RequestBody:      SimulationRequest{},
+				ResponseDataType: &transaction.CostResponse{},
+			},
 		},
 		{
 			Path:    getTransactionsPool,
@@ -118,6 +168,20 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 					Position:   shared.Before,
 				},
 			},
+			Docs: &shared.EndpointDocs{
+				Summary: "returns the transactions from the pool, the last nonce or the nonce gaps of a sender",
+				QueryParams: []shared.QueryParameter{
+					{Name: queryParamSender, Type: shared.ParamTypeString, Description: "the sender address"},
+					{Name: queryParamFields, Type: shared.ParamTypeString, Description: "the comma separated fields to be returned"},
+					{Name: queryParamLastNonce, Type: shared.ParamTypeBoolean, Description: "whether the last nonce of the sender should be returned"},
+					{Name: queryParamNonceGaps, Type: shared.ParamTypeBoolean, Description: "whether the nonce gaps of the sender should be returned"},
+				},
+				ResponseData: map[string]interface{}{
+					"txPool":    nil,
+					"nonce":     uint64(0),
+					"nonceGaps": &common.TransactionsPoolNonceGapsForSenderApiResponse{},
+				},
+			},
 		},
 		{
 			Path:    sendMultiplePath,
@@ -129,6 +193,11 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 					Position:   shared.Before,
 				},
 			},
+			Docs: &shared.EndpointDocs{
+				Summary:      "sends multiple transactions",
+				RequestBody:  []transaction.FrontendTransaction{},
+				ResponseData: map[string]interface{}{"txsSent": uint64(0), "txsHashes": map[int]string{}},
+			},
 		},
 		{
 			Path:    getTransactionPath,
@@ -140,6 +209,28 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 					Position:   shared.Before,
 				},
 			},
+			Docs: &shared.EndpointDocs{
+				Summary: "returns a transaction",
+				QueryParams: []shared.QueryParameter{
+					{Name: queryParamWithResults, Type: shared.ParamTypeBoolean, Description: "whether the smart contract results and the logs should be returned"},
+				},
+				ResponseData: map[string]interface{}{"transaction": &transaction.ApiTransactionResult{}},
+			},
+		},
+		{
+			Path:    getTransactionTracePath,
+			Method:  http.MethodGet,
+			Handler: tg.getTransactionTrace,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTransactionTraceEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the execution trace of a transaction",
+				ResponseData: map[string]interface{}{"trace": &txSimData.ExecutionTrace{}},
+			},
 		},
 		{
 			Path:    getScrsByTxHashPath,
@@ -151,6 +242,13 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 					Position:   shared.Before,
 				},
 			},
+			Docs: &shared.EndpointDocs{
+				Summary: "returns the smart contract results generated by a transaction",
+				QueryParams: []shared.QueryParameter{
+					{Name: queryParameterScrHash, Type: shared.ParamTypeString, Description: "the hash of a smart contract result of the transaction", Required: true},
+				},
+				ResponseData: map[string]interface{}{"scrs": []*transaction.ApiSmartContractResult{}},
+			},
 		},
 	}
 	tg.endpoints = endpoints
@@ -168,10 +266,37 @@ type TxResponse struct {
 	Timestamp   uint64 `json:"timestamp"`
 }
 
+// SimulationRequest represents the structure of a transaction to be simulated (or whose cost is to be computed), along
+// with the optional state overrides to be applied before the simulation
+type SimulationRequest struct {
+	transaction.FrontendTransaction
+	StateOverrides []*StateOverrideRequest `json:"stateOverrides,omitempty"`
+}
+
+// StateOverrideRequest represents the changes to be applied on an account before simulating a transaction. The balance
+// is a base 10 number, while the code, the code metadata and the storage keys and values are hex encoded
+type StateOverrideRequest struct {
+	Address      string                        `json:"address"`
+	Balance      string                        `json:"balance,omitempty"`
+	Nonce        *uint64                       `json:"nonce,omitempty"`
+	Code         string                        `json:"code,omitempty"`
+	CodeMetadata string                        `json:"codeMetadata,omitempty"`
+	Storage      map[string]string             `json:"storage,omitempty"`
+	ESDTBalances []*ESDTBalanceOverrideRequest `json:"esdtBalances,omitempty"`
+}
+
+// ESDTBalanceOverrideRequest represents the balance of an ESDT token to be set on an account. The nonce should be provided
+// for NFTs and SFTs, while the balance is a base 10 number
+type ESDTBalanceOverrideRequest struct {
+	TokenIdentifier string `json:"tokenIdentifier"`
+	Nonce           uint64 `json:"nonce,omitempty"`
+	Balance         string `json:"balance"`
+}
+
 // simulateTransaction will receive a transaction from the client and will simulate its execution and return the results
 func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
-	var ftx = transaction.FrontendTransaction{}
-	err := c.ShouldBindJSON(&ftx)
+	var request = SimulationRequest{}
+	err := c.ShouldBindJSON(&request)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
@@ -197,7 +322,7 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 		return
 	}
 
-	tx, txHash, err := tg.createTransaction(&ftx)
+	tx, txHash, err := tg.createTransaction(&request.FrontendTransaction)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
@@ -210,8 +335,21 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 		return
 	}
 
+	overrides, err := tg.createStateOverrides(request.StateOverrides)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrInvalidStateOverrides.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
 	start := time.Now()
-	err = tg.getFacade().ValidateTransactionForSimulation(tx, checkSignature)
+	err = tg.getFacade().ValidateTransactionForSimulationWithStateOverrides(tx, checkSignature, overrides)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: ValidateTransactionForSimulation")
 	if err != nil {
 		c.JSON(
@@ -226,7 +364,7 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 	}
 
 	start = time.Now()
-	executionResults, err := tg.getFacade().SimulateTransactionExecution(tx)
+	executionResults, err := tg.simulateTransactionExecution(tx, overrides)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: SimulateTransactionExecution")
 	if err != nil {
 		c.JSON(
@@ -251,6 +389,265 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 	)
 }
 
+// traceTransaction will receive a transaction from the client and will execute it in trace mode, within the block with
+// the provided nonce (if any), returning its execution trace
+func (tg *transactionGroup) traceTransaction(c *gin.Context) {
+	var request = SimulationRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	checkSignature, err := getQueryParameterCheckSignature(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	blockNonce, err := parseUint64UrlParam(c, urlParamBlockNonce)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	tx, _, err := tg.createTransaction(&request.FrontendTransaction)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	overrides, err := tg.createStateOverrides(request.StateOverrides)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrInvalidStateOverrides.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	// the validation is done against the current state, so it is skipped when tracing within a past block
+	if !blockNonce.HasValue {
+		start := time.Now()
+		err = tg.getFacade().ValidateTransactionForSimulationWithStateOverrides(tx, checkSignature, overrides)
+		logging.LogAPIActionDurationIfNeeded(start, "API call: ValidateTransactionForSimulation")
+		if err != nil {
+			c.JSON(
+				http.StatusBadRequest,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+	}
+
+	start := time.Now()
+	trace, err := tg.getFacade().TraceTransactionExecution(tx, overrides, blockNonce)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: TraceTransactionExecution")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrTraceTransaction.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"trace": trace},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getTransactionTrace re-executes, in trace mode, the transaction with the provided hash within the block it was
+// executed in and returns its execution trace
+func (tg *transactionGroup) getTransactionTrace(c *gin.Context) {
+	txhash := c.Param("txhash")
+	if txhash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start := time.Now()
+	trace, err := tg.getFacade().GetTransactionExecutionTrace(txhash)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionExecutionTrace")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrTraceTransaction.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"trace": trace},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func (tg *transactionGroup) simulateTransactionExecution(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*txSimData.SimulationResultsWithVMOutput, error) {
+	if len(overrides) == 0 {
+		return tg.getFacade().SimulateTransactionExecution(tx)
+	}
+
+	return tg.getFacade().SimulateTransactionExecutionWithStateOverrides(tx, overrides)
+}
+
+func (tg *transactionGroup) computeTransactionCost(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*transaction.CostResponse, error) {
+	if len(overrides) == 0 {
+		return tg.getFacade().ComputeTransactionGasLimit(tx)
+	}
+
+	return tg.getFacade().ComputeTransactionGasLimitWithStateOverrides(tx, overrides)
+}
+
+func (tg *transactionGroup) createStateOverrides(requests []*StateOverrideRequest) ([]*txSimData.StateOverride, error) {
+	overrides := make([]*txSimData.StateOverride, 0, len(requests))
+	for index, request := range requests {
+		override, err := tg.createStateOverride(request)
+		if err != nil {
+			return nil, fmt.Errorf("%w for state override at index %d", err, index)
+		}
+
+		overrides = append(overrides, override)
+	}
+
+	return overrides, nil
+}
+
+func (tg *transactionGroup) createStateOverride(request *StateOverrideRequest) (*txSimData.StateOverride, error) {
+	if request == nil {
+		return nil, errors.ErrNilStateOverride
+	}
+
+	address, err := tg.getFacade().DecodeAddressPubkey(request.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %w", err)
+	}
+
+	override := &txSimData.StateOverride{
+		Address: address,
+		Nonce:   request.Nonce,
+	}
+	if len(request.Balance) > 0 {
+		override.Balance, err = parseBalance(request.Balance)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(request.Code) > 0 {
+		override.Code, err = hex.DecodeString(request.Code)
+		if err != nil {
+			return nil, fmt.Errorf("invalid code: %w", err)
+		}
+	}
+	if len(request.CodeMetadata) > 0 {
+		override.CodeMetadata, err = hex.DecodeString(request.CodeMetadata)
+		if err != nil {
+			return nil, fmt.Errorf("invalid code metadata: %w", err)
+		}
+	}
+
+	override.Storage = make(map[string][]byte, len(request.Storage))
+	for hexKey, hexValue := range request.Storage {
+		key, errDecode := hex.DecodeString(hexKey)
+		if errDecode != nil || len(key) == 0 {
+			return nil, fmt.Errorf("invalid storage key %s", hexKey)
+		}
+		value, errDecode := hex.DecodeString(hexValue)
+		if errDecode != nil {
+			return nil, fmt.Errorf("invalid storage value for key %s", hexKey)
+		}
+
+		override.Storage[string(key)] = value
+	}
+
+	override.ESDTBalances = make([]*txSimData.ESDTBalanceOverride, 0, len(request.ESDTBalances))
+	for _, esdtRequest := range request.ESDTBalances {
+		if esdtRequest == nil || len(esdtRequest.TokenIdentifier) == 0 {
+			return nil, errors.ErrEmptyTokenIdentifier
+		}
+		balance, errParse := parseBalance(esdtRequest.Balance)
+		if errParse != nil {
+			return nil, fmt.Errorf("%w for token %s", errParse, esdtRequest.TokenIdentifier)
+		}
+
+		override.ESDTBalances = append(override.ESDTBalances, &txSimData.ESDTBalanceOverride{
+			TokenIdentifier: []byte(esdtRequest.TokenIdentifier),
+			Nonce:           esdtRequest.Nonce,
+			Balance:         balance,
+		})
+	}
+
+	return override, nil
+}
+
+func parseBalance(balance string) (*big.Int, error) {
+	value, ok := big.NewInt(0).SetString(balance, 10)
+	if !ok || value.Sign() < 0 {
+		return nil, fmt.Errorf("invalid balance %s", balance)
+	}
+
+	return value, nil
+}
+
 // sendTransaction will receive a transaction from the client and propagate it for processing
 func (tg *transactionGroup) sendTransaction(c *gin.Context) {
 	var ftx = transaction.FrontendTransaction{}
@@ -494,8 +891,8 @@ func (tg *transactionGroup) getTransaction(c *gin.Context) {
 
 // computeTransactionGasLimit returns how many gas units a transaction wil consume
 func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
-	var ftx transaction.FrontendTransaction
-	err := c.ShouldBindJSON(&ftx)
+	var request SimulationRequest
+	err := c.ShouldBindJSON(&request)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
@@ -508,7 +905,7 @@ func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
 		return
 	}
 
-	tx, _, err := tg.createTransaction(&ftx)
+	tx, _, err := tg.createTransaction(&request.FrontendTransaction)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -521,8 +918,21 @@ func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
 		return
 	}
 
+	overrides, err := tg.createStateOverrides(request.StateOverrides)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrInvalidStateOverrides.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
 	start := time.Now()
-	cost, err := tg.getFacade().ComputeTransactionGasLimit(tx)
+	cost, err := tg.computeTransactionCost(tx, overrides)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: ComputeTransactionGasLimit")
 	if err != nil {
 		c.JSON(
@@ -719,7 +1129,15 @@ func (tg *transactionGroup) getTransactionsPoolNonceGapsForSender(sender string,
 }
 
 func (tg *transactionGroup) createTransaction(receivedTx *transaction.FrontendTransaction) (*transaction.Transaction, []byte, error) {
-	txArgs := &external.ArgsCreateTransaction{
+	start := time.Now()
+	tx, txHash, err := tg.getFacade().CreateTransaction(newArgsCreateTransaction(receivedTx))
+	logging.LogAPIActionDurationIfNeeded(start, "API call: CreateTransaction")
+
+	return tx, txHash, err
+}
+
+func newArgsCreateTransaction(receivedTx *transaction.FrontendTransaction) *external.ArgsCreateTransaction {
+	return &external.ArgsCreateTransaction{
 		Nonce:            receivedTx.Nonce,
 		Value:            receivedTx.Value,
 		Receiver:         receivedTx.Receiver,
@@ -736,11 +1154,6 @@ func (tg *transactionGroup) createTransaction(receivedTx *transaction.FrontendTr
 		Guardian:         receivedTx.GuardianAddr,
 		GuardianSigHex:   receivedTx.GuardianSignature,
 	}
-	start := time.Now()
-	tx, txHash, err := tg.getFacade().CreateTransaction(txArgs)
-	logging.LogAPIActionDurationIfNeeded(start, "API call: CreateTransaction")
-
-	return tx, txHash, err
 }
 
 func validateQuery(sender, fields string, lastNonce, nonceGaps bool) error {
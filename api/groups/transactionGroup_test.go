@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -69,6 +70,16 @@ type simulateTxResponse struct {
 	Code  string      `json:"code"`
 }
 
+type traceTxResponseData struct {
+	Trace *txSimData.ExecutionTrace `json:"trace"`
+}
+
+type traceTxResponse struct {
+	Data  traceTxResponseData `json:"data"`
+	Error string              `json:"error"`
+	Code  string              `json:"code"`
+}
+
 type sendSingleTxResponseData struct {
 	TxHash string `json:"txHash"`
 }
@@ -631,6 +642,97 @@ func TestTransactionGroup_computeTransactionGasLimit(t *testing.T) {
 		)
 		assert.Equal(t, expectedGasLimit, response.Data.Cost)
 	})
+	t.Run("invalid state overrides should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, nil, nil
+			},
+			ComputeTransactionGasLimitWithStateOverridesCalled: func(tx *dataTx.Transaction, overrides []*txSimData.StateOverride) (*dataTx.CostResponse, error) {
+				require.Fail(t, "should not have been called")
+				return nil, nil
+			},
+		}
+		request := &groups.SimulationRequest{
+			StateOverrides: []*groups.StateOverrideRequest{{Address: "erd1alice", Balance: "-1"}},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/cost",
+			"POST",
+			request,
+			http.StatusBadRequest,
+			apiErrors.ErrInvalidStateOverrides,
+		)
+	})
+	t.Run("with state overrides should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedGasLimit := uint64(37)
+		nonce := uint64(5)
+		var providedOverrides []*txSimData.StateOverride
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, nil, nil
+			},
+			DecodeAddressPubkeyCalled: func(pk string) ([]byte, error) {
+				return []byte(pk), nil
+			},
+			ComputeTransactionGasLimitHandler: func(tx *dataTx.Transaction) (*dataTx.CostResponse, error) {
+				require.Fail(t, "should not have been called")
+				return nil, nil
+			},
+			ComputeTransactionGasLimitWithStateOverridesCalled: func(tx *dataTx.Transaction, overrides []*txSimData.StateOverride) (*dataTx.CostResponse, error) {
+				providedOverrides = overrides
+				return &dataTx.CostResponse{GasUnits: expectedGasLimit}, nil
+			},
+		}
+		request := &groups.SimulationRequest{
+			FrontendTransaction: dataTx.FrontendTransaction{
+				Sender:   "sender1",
+				Receiver: "receiver1",
+				Value:    "100",
+			},
+			StateOverrides: []*groups.StateOverrideRequest{
+				{
+					Address:      "alice",
+					Balance:      "1000",
+					Nonce:        &nonce,
+					Code:         hex.EncodeToString([]byte("code")),
+					CodeMetadata: "0500",
+					Storage:      map[string]string{hex.EncodeToString([]byte("key")): hex.EncodeToString([]byte("value"))},
+					ESDTBalances: []*groups.ESDTBalanceOverrideRequest{{TokenIdentifier: "TKN-abcdef", Balance: "10"}},
+				},
+			},
+		}
+		jsonBytes, _ := json.Marshal(request)
+
+		response := &transactionCostResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/cost",
+			"POST",
+			bytes.NewBuffer(jsonBytes),
+			response,
+		)
+		assert.Equal(t, expectedGasLimit, response.Data.Cost)
+
+		expectedOverrides := []*txSimData.StateOverride{
+			{
+				Address:      []byte("alice"),
+				Balance:      big.NewInt(1000),
+				Nonce:        &nonce,
+				Code:         []byte("code"),
+				CodeMetadata: []byte{5, 0},
+				Storage:      map[string][]byte{"key": []byte("value")},
+				ESDTBalances: []*txSimData.ESDTBalanceOverride{{TokenIdentifier: []byte("TKN-abcdef"), Balance: big.NewInt(10)}},
+			},
+		}
+		assert.Equal(t, expectedOverrides, providedOverrides)
+	})
 }
 
 func TestTransactionGroup_simulateTransaction(t *testing.T) {
@@ -646,7 +748,7 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
 				return nil, nil, expectedErr
 			},
-			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			ValidateTransactionForSimulationWithStateOverridesCalled: func(tx *dataTx.Transaction, bypassSignature bool, overrides []*txSimData.StateOverride) error {
 				require.Fail(t, "should have not been called")
 				return nil
 			},
@@ -668,7 +770,7 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
 				return nil, nil, nil
 			},
-			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			ValidateTransactionForSimulationWithStateOverridesCalled: func(tx *dataTx.Transaction, bypassSignature bool, overrides []*txSimData.StateOverride) error {
 				return expectedErr
 			},
 			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
@@ -693,7 +795,7 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
 				return nil, nil, nil
 			},
-			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			ValidateTransactionForSimulationWithStateOverridesCalled: func(tx *dataTx.Transaction, bypassSignature bool, overrides []*txSimData.StateOverride) error {
 				return nil
 			},
 			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
@@ -731,7 +833,7 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
 				return &dataTx.Transaction{}, []byte("hash"), nil
 			},
-			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			ValidateTransactionForSimulationWithStateOverridesCalled: func(tx *dataTx.Transaction, bypassSignature bool, overrides []*txSimData.StateOverride) error {
 				return nil
 			},
 		}
@@ -760,6 +862,141 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 		assert.True(t, processTxWasCalled)
 		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
 	})
+	t.Run("invalid state overrides should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, nil, nil
+			},
+			DecodeAddressPubkeyCalled: func(pk string) ([]byte, error) {
+				return []byte(pk), nil
+			},
+			ValidateTransactionForSimulationWithStateOverridesCalled: func(tx *dataTx.Transaction, bypassSignature bool, overrides []*txSimData.StateOverride) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		request := &groups.SimulationRequest{
+			StateOverrides: []*groups.StateOverrideRequest{{Address: "alice", Code: "not hex"}},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/simulate",
+			"POST",
+			request,
+			http.StatusBadRequest,
+			apiErrors.ErrInvalidStateOverrides,
+		)
+	})
+	t.Run("with state overrides should work", func(t *testing.T) {
+		t.Parallel()
+
+		var providedOverrides []*txSimData.StateOverride
+		facade := &mock.FacadeStub{
+			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+			SimulateTransactionExecutionWithStateOverridesCalled: func(tx *dataTx.Transaction, overrides []*txSimData.StateOverride) (*txSimData.SimulationResultsWithVMOutput, error) {
+				providedOverrides = overrides
+				return &txSimData.SimulationResultsWithVMOutput{
+					SimulationResults: dataTx.SimulationResults{
+						Status: "success",
+						Hash:   "hash",
+					},
+				}, nil
+			},
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, []byte("hash"), nil
+			},
+			DecodeAddressPubkeyCalled: func(pk string) ([]byte, error) {
+				return []byte(pk), nil
+			},
+			ValidateTransactionForSimulationWithStateOverridesCalled: func(tx *dataTx.Transaction, bypassSignature bool, overrides []*txSimData.StateOverride) error {
+				return nil
+			},
+		}
+		request := &groups.SimulationRequest{
+			FrontendTransaction: dataTx.FrontendTransaction{
+				Sender:   "sender1",
+				Receiver: "receiver1",
+				Value:    "100",
+			},
+			StateOverrides: []*groups.StateOverrideRequest{{Address: "alice", Balance: "1000"}},
+		}
+		jsonBytes, _ := json.Marshal(request)
+
+		response := &simulateTxResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/simulate",
+			"POST",
+			bytes.NewBuffer(jsonBytes),
+			response,
+		)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+		require.Len(t, providedOverrides, 1)
+		assert.Equal(t, []byte("alice"), providedOverrides[0].Address)
+		assert.Equal(t, big.NewInt(1000), providedOverrides[0].Balance)
+	})
+	t.Run("underfunded sender with overridden balance should work", func(t *testing.T) {
+		t.Parallel()
+
+		underfundedSender := []byte("alice")
+		facade := &mock.FacadeStub{
+			SimulateTransactionExecutionWithStateOverridesCalled: func(tx *dataTx.Transaction, overrides []*txSimData.StateOverride) (*txSimData.SimulationResultsWithVMOutput, error) {
+				return &txSimData.SimulationResultsWithVMOutput{
+					SimulationResults: dataTx.SimulationResults{
+						Status: "success",
+					},
+				}, nil
+			},
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{SndAddr: []byte(txArgs.Sender)}, []byte("hash"), nil
+			},
+			DecodeAddressPubkeyCalled: func(pk string) ([]byte, error) {
+				return []byte(pk), nil
+			},
+			ValidateTransactionForSimulationWithStateOverridesCalled: func(tx *dataTx.Transaction, bypassSignature bool, overrides []*txSimData.StateOverride) error {
+				for _, override := range overrides {
+					if bytes.Equal(override.Address, tx.SndAddr) {
+						return nil
+					}
+				}
+
+				return expectedErr
+			},
+		}
+		request := &groups.SimulationRequest{
+			FrontendTransaction: dataTx.FrontendTransaction{
+				Sender:   string(underfundedSender),
+				Receiver: "receiver1",
+				Value:    "100",
+			},
+		}
+
+		transactionGroup, _ := groups.NewTransactionGroup(facade)
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		jsonBytes, _ := json.Marshal(request)
+		req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &simulateTxResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+
+		request.StateOverrides = []*groups.StateOverrideRequest{{Address: string(underfundedSender), Balance: "1000"}}
+		jsonBytes, _ = json.Marshal(request)
+		response = &simulateTxResponse{}
+		loadTransactionGroupResponse(t, facade, "/transaction/simulate", "POST", bytes.NewBuffer(jsonBytes), response)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+	})
 }
 
 func TestTransactionGroup_getTransactionsPool(t *testing.T) {
@@ -1088,6 +1325,141 @@ func TestTransactionsGroup_IsInterfaceNil(t *testing.T) {
 	require.False(t, transactionGroup.IsInterfaceNil())
 }
 
+func TestTransactionGroup_traceTransaction(t *testing.T) {
+	t.Parallel()
+
+	expectedTrace := &txSimData.ExecutionTrace{
+		Status:   dataTx.TxStatusSuccess,
+		GasLimit: 100,
+		GasUsed:  60,
+		Root: &txSimData.CallFrame{
+			CallType: "SCInvoking",
+			Caller:   "alice",
+			Callee:   "contract",
+			Value:    "0",
+			Calls:    []*txSimData.CallFrame{{CallType: "ExecuteOnDestContext", Caller: "contract", Callee: "other", Value: "0"}},
+		},
+	}
+
+	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/trace", &dataTx.FrontendTransaction{}))
+	t.Run("invalid param transaction should error", testTransactionGroupErrorScenario("/transaction/trace", "POST", jsonTxStr, http.StatusBadRequest, apiErrors.ErrValidation))
+	t.Run("invalid param blockNonce should error", testTransactionGroupErrorScenario("/transaction/trace?blockNonce=not-a-number", "POST", &dataTx.FrontendTransaction{}, http.StatusBadRequest, apiErrors.ErrValidation))
+	t.Run("TraceTransactionExecution error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, nil, nil
+			},
+			TraceTransactionExecutionCalled: func(tx *dataTx.Transaction, overrides []*txSimData.StateOverride, blockNonce core.OptionalUint64) (*txSimData.ExecutionTrace, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/trace",
+			"POST",
+			&dataTx.FrontendTransaction{},
+			http.StatusInternalServerError,
+			apiErrors.ErrTraceTransaction,
+		)
+	})
+	t.Run("should work on the current state", func(t *testing.T) {
+		t.Parallel()
+
+		validated := false
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, nil, nil
+			},
+			DecodeAddressPubkeyCalled: func(pk string) ([]byte, error) {
+				return []byte(pk), nil
+			},
+			ValidateTransactionForSimulationWithStateOverridesCalled: func(tx *dataTx.Transaction, bypassSignature bool, overrides []*txSimData.StateOverride) error {
+				validated = true
+				return nil
+			},
+			TraceTransactionExecutionCalled: func(tx *dataTx.Transaction, overrides []*txSimData.StateOverride, blockNonce core.OptionalUint64) (*txSimData.ExecutionTrace, error) {
+				require.False(t, blockNonce.HasValue)
+				require.Len(t, overrides, 1)
+				require.Equal(t, []byte("alice"), overrides[0].Address)
+				return expectedTrace, nil
+			},
+		}
+		request := &groups.SimulationRequest{
+			StateOverrides: []*groups.StateOverrideRequest{{Address: "alice", Balance: "1000"}},
+		}
+		jsonBytes, _ := json.Marshal(request)
+
+		response := &traceTxResponse{}
+		loadTransactionGroupResponse(t, facade, "/transaction/trace", "POST", bytes.NewBuffer(jsonBytes), response)
+		assert.True(t, validated)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+		assert.Equal(t, expectedTrace, response.Data.Trace)
+	})
+	t.Run("should work within a past block without validating against the current state", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, nil, nil
+			},
+			ValidateTransactionForSimulationWithStateOverridesCalled: func(tx *dataTx.Transaction, bypassSignature bool, overrides []*txSimData.StateOverride) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+			TraceTransactionExecutionCalled: func(tx *dataTx.Transaction, overrides []*txSimData.StateOverride, blockNonce core.OptionalUint64) (*txSimData.ExecutionTrace, error) {
+				require.Equal(t, core.OptionalUint64{Value: 37, HasValue: true}, blockNonce)
+				return expectedTrace, nil
+			},
+		}
+		jsonBytes, _ := json.Marshal(&groups.SimulationRequest{})
+
+		response := &traceTxResponse{}
+		loadTransactionGroupResponse(t, facade, "/transaction/trace?blockNonce=37", "POST", bytes.NewBuffer(jsonBytes), response)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+		assert.Equal(t, expectedTrace, response.Data.Trace)
+	})
+}
+
+func TestTransactionGroup_getTransactionTrace(t *testing.T) {
+	t.Parallel()
+
+	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/hash/trace", nil))
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionExecutionTraceCalled: func(hash string) (*txSimData.ExecutionTrace, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(t, facade, "/transaction/hash/trace", "GET", nil, http.StatusInternalServerError, expectedErr)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTrace := &txSimData.ExecutionTrace{
+			Status:     dataTx.TxStatusFail,
+			FailReason: "out of gas",
+			BlockNonce: 10,
+			Root:       &txSimData.CallFrame{CallType: "SCInvoking", Caller: "alice", Callee: "contract", Value: "0"},
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionExecutionTraceCalled: func(hash string) (*txSimData.ExecutionTrace, error) {
+				require.Equal(t, "hash", hash)
+				return expectedTrace, nil
+			},
+		}
+
+		response := &traceTxResponse{}
+		loadTransactionGroupResponse(t, facade, "/transaction/hash/trace", "GET", nil, response)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+		assert.Equal(t, expectedTrace, response.Data.Trace)
+	})
+}
+
 func loadTransactionGroupResponse(
 	t *testing.T,
 	facade shared.FacadeHandler,
@@ -1192,6 +1564,8 @@ func getTransactionRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
 					{Name: "/simulate", Open: true},
+					{Name: "/trace", Open: true},
+					{Name: "/:txhash/trace", Open: true},
 					{Name: "/scrs-by-tx-hash/:txhash", Open: true},
 				},
 			},
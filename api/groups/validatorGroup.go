@@ -38,8 +38,10 @@ func NewValidatorGroup(facade validatorFacadeHandler) (*validatorGroup, error) {
 	}
 
 	ng := &validatorGroup{
-		facade:    facade,
-		baseGroup: &baseGroup{},
+		facade: facade,
+		baseGroup: &baseGroup{
+			requiredRole: shared.RolePublic,
+		},
 	}
 
 	endpoints := []*shared.EndpointHandlerData{
@@ -47,11 +49,19 @@ func NewValidatorGroup(facade validatorFacadeHandler) (*validatorGroup, error) {
 			Path:    statisticsPath,
 			Method:  http.MethodGet,
 			Handler: ng.statistics,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the statistics of all the validators",
+				ResponseData: map[string]interface{}{"statistics": map[string]*validator.ValidatorStatistics{}},
+			},
 		},
 		{
 			Path:    auctionPath,
 			Method:  http.MethodGet,
 			Handler: ng.auction,
+			Docs: &shared.EndpointDocs{
+				Summary:      "returns the auction list",
+				ResponseData: map[string]interface{}{"auctionList": []*common.AuctionListValidatorAPIResponse{}},
+			},
 		},
 	}
 	ng.endpoints = endpoints
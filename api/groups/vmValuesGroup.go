@@ -14,6 +14,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
@@ -23,15 +24,21 @@ const (
 	stringPath = "/string"
 	intPath    = "/int"
 	queryPath  = "/query"
+	batchPath  = "/batch"
 )
 
 // vmValuesFacadeHandler defines the methods to be implemented by a facade for vm-values requests
 type vmValuesFacadeHandler interface {
 	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, apiData.BlockInfo, error)
+	ExecuteSCQueries(queries []*process.SCQuery) ([]*common.SCQueryResultAPI, apiData.BlockInfo, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	IsInterfaceNil() bool
 }
 
+type addressPubkeyDecoder interface {
+	DecodeAddressPubkey(pk string) ([]byte, error)
+}
+
 type vmValuesGroup struct {
 	*baseGroup
 	facade    vmValuesFacadeHandler
@@ -45,8 +52,10 @@ func NewVmValuesGroup(facade vmValuesFacadeHandler) (*vmValuesGroup, error) {
 	}
 
 	vvg := &vmValuesGroup{
-		facade:    facade,
-		baseGroup: &baseGroup{},
+		facade: facade,
+		baseGroup: &baseGroup{
+			requiredRole: shared.RolePublic,
+		},
 	}
 
 	endpoints := []*shared.EndpointHandlerData{
@@ -54,21 +63,55 @@ func NewVmValuesGroup(facade vmValuesFacadeHandler) (*vmValuesGroup, error) {
 			Path:    hexPath,
 			Method:  http.MethodPost,
 			Handler: vvg.getHex,
+			Docs: &shared.EndpointDocs{
+				Summary:      "executes a smart contract query and returns its first result, hex encoded",
+				RequestBody:  VMValueRequest{},
+				ResponseData: map[string]interface{}{"data": "", "blockInfo": apiData.BlockInfo{}},
+			},
 		},
 		{
 			Path:    stringPath,
 			Method:  http.MethodPost,
 			Handler: vvg.getString,
+			Docs: &shared.EndpointDocs{
+				Summary:      "executes a smart contract query and returns its first result as a string",
+				RequestBody:  VMValueRequest{},
+				ResponseData: map[string]interface{}{"data": "", "blockInfo": apiData.BlockInfo{}},
+			},
 		},
 		{
 			Path:    intPath,
 			Method:  http.MethodPost,
 			Handler: vvg.getInt,
+			Docs: &shared.EndpointDocs{
+				Summary:      "executes a smart contract query and returns its first result as a base 10 number",
+				RequestBody:  VMValueRequest{},
+				ResponseData: map[string]interface{}{"data": "", "blockInfo": apiData.BlockInfo{}},
+			},
 		},
 		{
 			Path:    queryPath,
 			Method:  http.MethodPost,
 			Handler: vvg.executeQuery,
+			Docs: &shared.EndpointDocs{
+				Summary:      "executes a smart contract query and returns the VM output",
+				RequestBody:  VMValueRequest{},
+				ResponseData: map[string]interface{}{"data": &vm.VMOutputApi{}, "blockInfo": apiData.BlockInfo{}},
+			},
+		},
+		{
+			Path:    batchPath,
+			Method:  http.MethodPost,
+			Handler: vvg.executeQueriesBatch,
+			Docs: &shared.EndpointDocs{
+				Summary: "executes multiple smart contract queries against the same block",
+				QueryParams: []shared.QueryParameter{
+					{Name: urlParamBlockNonce, Type: shared.ParamTypeInteger, Description: "the nonce of the block the queries are executed on"},
+					{Name: urlParamBlockHash, Type: shared.ParamTypeString, Description: "the hex encoded hash of the block the queries are executed on"},
+				},
+				RequestBody:  VMValuesBatchRequest{},
+				ResponseData: map[string]interface{}{"data": []*VMValuesBatchResult{}, "blockInfo": apiData.BlockInfo{}},
+			},
 		},
 	}
 	vvg.endpoints = endpoints
@@ -87,6 +130,17 @@ type VMValueRequest struct {
 	ShouldBeSynced bool     `json:"shouldBeSynced"`
 }
 
+// VMValuesBatchRequest represents the structure of a request holding multiple queries to be executed against the same block
+type VMValuesBatchRequest struct {
+	Queries []VMValueRequest `json:"queries"`
+}
+
+// VMValuesBatchResult holds the outcome of a query executed as part of a batch
+type VMValuesBatchResult struct {
+	Data  *vm.VMOutputApi `json:"data,omitempty"`
+	Error string          `json:"error,omitempty"`
+}
+
 // getHex returns the data as bytes, hex-encoded
 func (vvg *vmValuesGroup) getHex(context *gin.Context) {
 	vvg.doGetVMValue(context, vm.AsHex)
@@ -159,6 +213,66 @@ func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi,
 	return vmOutputApi, vmExecErrMsg, blockInfo, nil
 }
 
+// executeQueriesBatch executes all the provided queries against the same block and returns the outcome of each query
+func (vvg *vmValuesGroup) executeQueriesBatch(context *gin.Context) {
+	request := VMValuesBatchRequest{}
+	err := context.ShouldBindJSON(&request)
+	if err != nil {
+		vvg.returnBadRequest(context, "executeQueriesBatch", errors.ErrInvalidJSONRequest)
+		return
+	}
+
+	blockNonce, blockHash, err := extractBlockCoordinates(context)
+	if err != nil {
+		vvg.returnBadRequest(context, "executeQueriesBatch", err)
+		return
+	}
+
+	queries := make([]*process.SCQuery, 0, len(request.Queries))
+	for i := range request.Queries {
+		query, errCreate := vvg.createSCQuery(&request.Queries[i])
+		if errCreate != nil {
+			vvg.returnBadRequest(context, "executeQueriesBatch", fmt.Errorf("query %d: %w", i, errCreate))
+			return
+		}
+
+		query.BlockNonce = blockNonce
+		query.BlockHash = blockHash
+		queries = append(queries, query)
+	}
+
+	results, blockInfo, err := vvg.getFacade().ExecuteSCQueries(queries)
+	if err != nil {
+		vvg.returnBadRequest(context, "executeQueriesBatch", err)
+		return
+	}
+
+	batchResults := make([]*VMValuesBatchResult, 0, len(results))
+	for _, result := range results {
+		batchResults = append(batchResults, createBatchResult(result))
+	}
+
+	vvg.returnOkResponse(context, batchResults, "", blockInfo)
+}
+
+func createBatchResult(result *common.SCQueryResultAPI) *VMValuesBatchResult {
+	if result.Error != nil {
+		return &VMValuesBatchResult{
+			Data:  result.VMOutput,
+			Error: result.Error.Error(),
+		}
+	}
+
+	batchResult := &VMValuesBatchResult{
+		Data: result.VMOutput,
+	}
+	if result.VMOutput != nil && len(result.VMOutput.ReturnCode) > 0 && result.VMOutput.ReturnCode != vmcommon.Ok.String() {
+		batchResult.Error = result.VMOutput.ReturnCode + ":" + result.VMOutput.ReturnMessage
+	}
+
+	return batchResult
+}
+
 func extractBlockCoordinates(context *gin.Context) (core.OptionalUint64, []byte, error) {
 	blockNonce, err := parseUint64UrlParam(context, urlParamBlockNonce)
 	if err != nil {
@@ -174,7 +288,11 @@ func extractBlockCoordinates(context *gin.Context) (core.OptionalUint64, []byte,
 }
 
 func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQuery, error) {
-	decodedAddress, err := vvg.getFacade().DecodeAddressPubkey(request.ScAddress)
+	return createSCQuery(vvg.getFacade(), request)
+}
+
+func createSCQuery(decoder addressPubkeyDecoder, request *VMValueRequest) (*process.SCQuery, error) {
+	decodedAddress, err := decoder.DecodeAddressPubkey(request.ScAddress)
 	if err != nil {
 		return nil, fmt.Errorf("'%s' is not a valid address: %s", request.ScAddress, err.Error())
 	}
@@ -199,7 +317,7 @@ func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQue
 	}
 
 	if len(request.CallerAddr) > 0 {
-		callerAddress, errDecodeCaller := vvg.getFacade().DecodeAddressPubkey(request.CallerAddr)
+		callerAddress, errDecodeCaller := decoder.DecodeAddressPubkey(request.CallerAddr)
 		if errDecodeCaller != nil {
 			return nil, errDecodeCaller
 		}
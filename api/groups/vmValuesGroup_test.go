@@ -19,6 +19,7 @@ import (
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/mock"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/process"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
@@ -211,6 +212,110 @@ func TestQuery(t *testing.T) {
 	})
 }
 
+type vmValuesBatchResponse struct {
+	Data      []*groups.VMValuesBatchResult `json:"data"`
+	BlockInfo api.BlockInfo                 `json:"blockInfo"`
+	Error     string                        `json:"error"`
+}
+
+func TestQueriesBatch(t *testing.T) {
+	t.Parallel()
+
+	batchRequest := groups.VMValuesBatchRequest{
+		Queries: []groups.VMValueRequest{
+			{ScAddress: dummyScAddress, FuncName: "first"},
+			{ScAddress: dummyScAddress, FuncName: "second", Args: []string{"aa"}},
+			{ScAddress: dummyScAddress, FuncName: "third"},
+		},
+	}
+
+	t.Run("invalid json should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := simpleResponse{}
+		statusCode := doPost(t, &mock.FacadeStub{}, "/vm-values/batch", []byte("not a json"), &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, apiErrors.ErrInvalidJSONRequest.Error())
+	})
+	t.Run("invalid block nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := simpleResponse{}
+		statusCode := doPost(t, &mock.FacadeStub{}, "/vm-values/batch?blockNonce=invalid", batchRequest, &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, "for block nonce")
+	})
+	t.Run("invalid query should error", func(t *testing.T) {
+		t.Parallel()
+
+		request := groups.VMValuesBatchRequest{
+			Queries: []groups.VMValueRequest{
+				{ScAddress: dummyScAddress, FuncName: "first"},
+				{ScAddress: dummyScAddress, FuncName: "second", Args: []string{"not hex"}},
+			},
+		}
+
+		response := simpleResponse{}
+		statusCode := doPost(t, &mock.FacadeStub{}, "/vm-values/batch", request, &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, "query 1")
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			ExecuteSCQueriesCalled: func(queries []*process.SCQuery) ([]*common.SCQueryResultAPI, api.BlockInfo, error) {
+				return nil, api.BlockInfo{}, expectedErr
+			},
+		}
+
+		response := simpleResponse{}
+		statusCode := doPost(t, facade, "/vm-values/batch", batchRequest, &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedBlockHash := []byte("provided hash")
+		providedBlockInfo := api.BlockInfo{
+			Nonce:    12,
+			Hash:     hex.EncodeToString(providedBlockHash),
+			RootHash: "provided root hash",
+		}
+		facade := &mock.FacadeStub{
+			ExecuteSCQueriesCalled: func(queries []*process.SCQuery) ([]*common.SCQueryResultAPI, api.BlockInfo, error) {
+				require.Len(t, queries, 3)
+				for i, query := range queries {
+					require.Equal(t, batchRequest.Queries[i].FuncName, query.FuncName)
+					require.Equal(t, providedBlockHash, query.BlockHash)
+				}
+				require.Equal(t, [][]byte{{0xaa}}, queries[1].Arguments)
+
+				return []*common.SCQueryResultAPI{
+					{VMOutput: &vm.VMOutputApi{ReturnCode: vmcommon.Ok.String(), ReturnData: [][]byte{[]byte("data")}}},
+					{VMOutput: &vm.VMOutputApi{ReturnCode: vmcommon.UserError.String(), ReturnMessage: "user error"}},
+					{Error: errors.New("query error")},
+				}, providedBlockInfo, nil
+			},
+		}
+
+		response := vmValuesBatchResponse{}
+		url := fmt.Sprintf("/vm-values/batch?blockHash=%s", hex.EncodeToString(providedBlockHash))
+		statusCode := doPost(t, facade, url, batchRequest, &response)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, providedBlockInfo, response.BlockInfo)
+		require.Len(t, response.Data, 3)
+
+		require.Empty(t, response.Data[0].Error)
+		require.Equal(t, [][]byte{[]byte("data")}, response.Data[0].Data.ReturnData)
+		require.Equal(t, vmcommon.UserError.String()+":user error", response.Data[1].Error)
+		require.Equal(t, "query error", response.Data[2].Error)
+		require.Nil(t, response.Data[2].Data)
+	})
+}
+
 func testQueryShouldWork(t *testing.T, url string, facade shared.FacadeHandler) {
 	request := groups.VMValueRequest{
 		ScAddress: dummyScAddress,
@@ -581,6 +686,7 @@ func getVmValuesRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/string", Open: true},
 					{Name: "/int", Open: true},
 					{Name: "/query", Open: true},
+					{Name: "/batch", Open: true},
 				},
 			},
 		},
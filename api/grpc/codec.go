@@ -0,0 +1,44 @@
+package grpc
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/marshal"
+)
+
+const codecName = "proto"
+
+// gogoCodec marshals the gRPC messages with the code generated by gogo protobuf, as the default codec does not
+// handle the custom types (big integers) used by the core data structures
+type gogoCodec struct{}
+
+// NewCodec returns the codec to be used by both the gRPC server and its clients
+func NewCodec() *gogoCodec {
+	return &gogoCodec{}
+}
+
+// Marshal returns the wire format of the provided message
+func (codec *gogoCodec) Marshal(v interface{}) ([]byte, error) {
+	msg, ok := v.(marshal.GogoProtoObj)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrInvalidMessageType, v)
+	}
+
+	return msg.Marshal()
+}
+
+// Unmarshal parses the wire format into the provided message
+func (codec *gogoCodec) Unmarshal(data []byte, v interface{}) error {
+	msg, ok := v.(marshal.GogoProtoObj)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrInvalidMessageType, v)
+	}
+
+	msg.Reset()
+	return msg.Unmarshal(data)
+}
+
+// Name returns the name of the codec, which is also the content subtype
+func (codec *gogoCodec) Name() string {
+	return codecName
+}
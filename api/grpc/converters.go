@@ -0,0 +1,331 @@
+package grpc
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/outport/subscriptions"
+)
+
+func convertAccountQueryOptions(options *AccountQueryOptions) api.AccountQueryOptions {
+	if options == nil {
+		return api.AccountQueryOptions{}
+	}
+
+	return api.AccountQueryOptions{
+		OnFinalBlock:   options.OnFinalBlock,
+		OnStartOfEpoch: core.OptionalUint32{Value: options.OnStartOfEpoch, HasValue: options.HasOnStartOfEpoch},
+		BlockNonce:     core.OptionalUint64{Value: options.BlockNonce, HasValue: options.HasBlockNonce},
+		BlockHash:      options.BlockHash,
+		BlockRootHash:  options.BlockRootHash,
+		HintEpoch:      core.OptionalUint32{Value: options.HintEpoch, HasValue: options.HasHintEpoch},
+	}
+}
+
+func convertBlockInfo(blockInfo api.BlockInfo) (*BlockInfo, error) {
+	hash, err := hex.DecodeString(blockInfo.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the block hash", err)
+	}
+	rootHash, err := hex.DecodeString(blockInfo.RootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the block root hash", err)
+	}
+
+	return &BlockInfo{
+		Nonce:    blockInfo.Nonce,
+		Hash:     hash,
+		RootHash: rootHash,
+	}, nil
+}
+
+func convertAccount(account api.AccountResponse, blockInfo api.BlockInfo) (*AccountReply, error) {
+	balance, err := parseBigInt(account.Balance)
+	if err != nil {
+		return nil, fmt.Errorf("%w while parsing the balance", err)
+	}
+	developerReward, err := parseBigInt(account.DeveloperReward)
+	if err != nil {
+		return nil, fmt.Errorf("%w while parsing the developer reward", err)
+	}
+	code, err := hex.DecodeString(account.Code)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the code", err)
+	}
+	convertedBlockInfo, err := convertBlockInfo(blockInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountReply{
+		Address:         account.Address,
+		Nonce:           account.Nonce,
+		Balance:         balance,
+		Username:        account.Username,
+		Code:            code,
+		CodeHash:        account.CodeHash,
+		RootHash:        account.RootHash,
+		CodeMetadata:    account.CodeMetadata,
+		DeveloperReward: developerReward,
+		OwnerAddress:    account.OwnerAddress,
+		BlockInfo:       convertedBlockInfo,
+	}, nil
+}
+
+func parseBigInt(value string) (*big.Int, error) {
+	if len(value) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	bigValue, ok := big.NewInt(0).SetString(value, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidBigInt, value)
+	}
+
+	return bigValue, nil
+}
+
+func convertBlock(block *api.Block, addressDecoder addressDecoder) (*BlockReply, error) {
+	hash, err := hex.DecodeString(block.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the block hash", err)
+	}
+	prevHash, err := hex.DecodeString(block.PrevBlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the previous block hash", err)
+	}
+	stateRootHash, err := hex.DecodeString(block.StateRootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the state root hash", err)
+	}
+
+	miniBlocks := make([]*MiniBlockInfo, 0, len(block.MiniBlocks))
+	for _, miniBlock := range block.MiniBlocks {
+		convertedMiniBlock, errConvert := convertMiniBlock(miniBlock, addressDecoder)
+		if errConvert != nil {
+			return nil, errConvert
+		}
+
+		miniBlocks = append(miniBlocks, convertedMiniBlock)
+	}
+
+	return &BlockReply{
+		Nonce:           block.Nonce,
+		Round:           block.Round,
+		Epoch:           block.Epoch,
+		Shard:           block.Shard,
+		NumTxs:          block.NumTxs,
+		Hash:            hash,
+		PrevBlockHash:   prevHash,
+		StateRootHash:   stateRootHash,
+		AccumulatedFees: block.AccumulatedFees,
+		DeveloperFees:   block.DeveloperFees,
+		Status:          block.Status,
+		Timestamp:       int64(block.Timestamp),
+		MiniBlocks:      miniBlocks,
+	}, nil
+}
+
+func convertMiniBlock(miniBlock *api.MiniBlock, addressDecoder addressDecoder) (*MiniBlockInfo, error) {
+	hash, err := hex.DecodeString(miniBlock.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the miniblock hash", err)
+	}
+
+	txs := make([]*TransactionInfo, 0, len(miniBlock.Transactions))
+	for _, tx := range miniBlock.Transactions {
+		convertedTx, errConvert := convertTransaction(tx, addressDecoder)
+		if errConvert != nil {
+			return nil, errConvert
+		}
+
+		txs = append(txs, convertedTx)
+	}
+
+	return &MiniBlockInfo{
+		Hash:             hash,
+		Type:             miniBlock.Type,
+		SourceShard:      miniBlock.SourceShard,
+		DestinationShard: miniBlock.DestinationShard,
+		Transactions:     txs,
+	}, nil
+}
+
+func convertTransaction(tx *transaction.ApiTransactionResult, addressDecoder addressDecoder) (*TransactionInfo, error) {
+	hash, err := hex.DecodeString(tx.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the transaction hash", err)
+	}
+	blockHash, err := hex.DecodeString(tx.BlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the block hash", err)
+	}
+	miniBlockHash, err := hex.DecodeString(tx.MiniBlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the miniblock hash", err)
+	}
+	txLog, err := convertLogs(tx.Logs, addressDecoder)
+	if err != nil {
+		return nil, err
+	}
+
+	scrHashes := make([][]byte, 0, len(tx.SmartContractResults))
+	for _, scr := range tx.SmartContractResults {
+		scrHash, errDecode := hex.DecodeString(scr.Hash)
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w while decoding the smart contract result hash", errDecode)
+		}
+
+		scrHashes = append(scrHashes, scrHash)
+	}
+
+	txInfo := &TransactionInfo{
+		Hash:                       hash,
+		Type:                       tx.Type,
+		Status:                     string(tx.Status),
+		SourceShard:                tx.SourceShard,
+		DestinationShard:           tx.DestinationShard,
+		BlockNonce:                 tx.BlockNonce,
+		BlockHash:                  blockHash,
+		MiniBlockHash:              miniBlockHash,
+		Epoch:                      tx.Epoch,
+		Round:                      tx.Round,
+		Timestamp:                  tx.Timestamp,
+		GasUsed:                    tx.GasUsed,
+		Fee:                        tx.Fee,
+		Log:                        txLog,
+		SmartContractResultsHashes: scrHashes,
+	}
+
+	switch originalTx := tx.Tx.(type) {
+	case *transaction.Transaction:
+		txInfo.Transaction = originalTx
+	case *smartContractResult.SmartContractResult:
+		txInfo.SmartContractResult = originalTx
+	case *rewardTx.RewardTx:
+		txInfo.RewardTx = originalTx
+	}
+
+	return txInfo, nil
+}
+
+func convertLogs(logs *transaction.ApiLogs, addressDecoder addressDecoder) (*transaction.Log, error) {
+	if logs == nil {
+		return nil, nil
+	}
+
+	address, err := decodeOptionalAddress(logs.Address, addressDecoder)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*transaction.Event, 0, len(logs.Events))
+	for _, event := range logs.Events {
+		eventAddress, errDecode := decodeOptionalAddress(event.Address, addressDecoder)
+		if errDecode != nil {
+			return nil, errDecode
+		}
+
+		events = append(events, &transaction.Event{
+			Address:        eventAddress,
+			Identifier:     []byte(event.Identifier),
+			Topics:         event.Topics,
+			Data:           event.Data,
+			AdditionalData: event.AdditionalData,
+		})
+	}
+
+	return &transaction.Log{
+		Address: address,
+		Events:  events,
+	}, nil
+}
+
+func decodeOptionalAddress(address string, addressDecoder addressDecoder) ([]byte, error) {
+	if len(address) == 0 {
+		return nil, nil
+	}
+
+	decoded, err := addressDecoder.DecodeAddressPubkey(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding address %s", err, address)
+	}
+
+	return decoded, nil
+}
+
+func convertToArgsCreateTransaction(tx *transaction.Transaction, addressEncoder addressEncoder) (*external.ArgsCreateTransaction, error) {
+	sender, err := addressEncoder.EncodeAddressPubkey(tx.SndAddr)
+	if err != nil {
+		return nil, fmt.Errorf("%w while encoding the sender", err)
+	}
+	receiver, err := addressEncoder.EncodeAddressPubkey(tx.RcvAddr)
+	if err != nil {
+		return nil, fmt.Errorf("%w while encoding the receiver", err)
+	}
+
+	guardian := ""
+	if len(tx.GuardianAddr) > 0 {
+		guardian, err = addressEncoder.EncodeAddressPubkey(tx.GuardianAddr)
+		if err != nil {
+			return nil, fmt.Errorf("%w while encoding the guardian", err)
+		}
+	}
+
+	value := "0"
+	if tx.Value != nil {
+		value = tx.Value.String()
+	}
+
+	return &external.ArgsCreateTransaction{
+		Nonce:            tx.Nonce,
+		Value:            value,
+		Receiver:         receiver,
+		ReceiverUsername: tx.RcvUserName,
+		Sender:           sender,
+		SenderUsername:   tx.SndUserName,
+		GasPrice:         tx.GasPrice,
+		GasLimit:         tx.GasLimit,
+		DataField:        tx.Data,
+		SignatureHex:     hex.EncodeToString(tx.Signature),
+		ChainID:          string(tx.ChainID),
+		Version:          tx.Version,
+		Options:          tx.Options,
+		Guardian:         guardian,
+		GuardianSigHex:   hex.EncodeToString(tx.GuardianSignature),
+	}, nil
+}
+
+func convertBlockEvent(event *subscriptions.BlockEvent) (*BlockNotification, error) {
+	hash, err := hex.DecodeString(event.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the block hash", err)
+	}
+	prevHash, err := hex.DecodeString(event.PrevHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the previous block hash", err)
+	}
+	stateRootHash, err := hex.DecodeString(event.StateRootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the state root hash", err)
+	}
+
+	return &BlockNotification{
+		Hash:          hash,
+		Nonce:         event.Nonce,
+		Round:         event.Round,
+		Epoch:         event.Epoch,
+		ShardID:       event.ShardID,
+		Timestamp:     event.Timestamp,
+		PrevHash:      prevHash,
+		StateRootHash: stateRootHash,
+		NumTxs:        event.NumTxs,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package grpc
+
+import "errors"
+
+// ErrInvalidGRPCConfig signals that an invalid gRPC configuration value has been provided
+var ErrInvalidGRPCConfig = errors.New("invalid gRPC config")
+
+// ErrServerAlreadyStarted signals that the gRPC server was already started
+var ErrServerAlreadyStarted = errors.New("gRPC server already started")
+
+// ErrInvalidMessageType signals that a message not generated with gogo protobuf was provided to the codec
+var ErrInvalidMessageType = errors.New("invalid message type")
+
+// ErrInvalidBigInt signals that a value could not be parsed as a big integer
+var ErrInvalidBigInt = errors.New("invalid big integer")
+
+// ErrNilAuthenticator signals that a nil authenticator has been provided
+var ErrNilAuthenticator = errors.New("nil authenticator")
+
+// ErrUnknownMethod signals that a gRPC method without access rules was called
+var ErrUnknownMethod = errors.New("unknown gRPC method")
+
+// ErrInvalidEventData signals that a subscription event holds unexpected data
+var ErrInvalidEventData = errors.New("invalid event data")
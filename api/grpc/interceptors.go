@@ -0,0 +1,158 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	grpcMethod               = "gRPC"
+	authorizationMetadataKey = "authorization"
+	forwardedForMetadataKey  = "x-forwarded-for"
+)
+
+// auditLog is the logger the REST API authentication uses, so the gRPC accesses are recorded along with the REST ones
+var auditLog = logger.GetOrCreate("api/audit")
+
+// methodAccess holds the REST endpoint mirrored by a gRPC method, the name of its endpoint throttler, if any, and the
+// role required to call it
+type methodAccess struct {
+	endpoint     string
+	throttler    string
+	requiredRole shared.AccessRole
+}
+
+// methodsAccess holds the access rules of the gRPC methods. The calls are charged by the rate limiter and throttled
+// as the calls of the REST endpoints they mirror
+var methodsAccess = map[string]methodAccess{
+	"/proto.NodeAPI/GetAccount":       {endpoint: "/address/:address", requiredRole: shared.RolePublic},
+	"/proto.NodeAPI/GetESDTTokens":    {endpoint: "/address/:address/esdt", requiredRole: shared.RolePublic},
+	"/proto.NodeAPI/GetBlockByNonce":  {endpoint: "/block/by-nonce/:nonce", requiredRole: shared.RolePublic},
+	"/proto.NodeAPI/GetBlockByHash":   {endpoint: "/block/by-hash/:hash", requiredRole: shared.RolePublic},
+	"/proto.NodeAPI/GetTransaction":   {endpoint: "/transaction/:txhash", throttler: "/transaction/:hash", requiredRole: shared.RolePublic},
+	"/proto.NodeAPI/SendTransactions": {endpoint: "/transaction/send-multiple", throttler: "/transaction/send-multiple", requiredRole: shared.RolePublic},
+	"/proto.NodeAPI/QueryVM":          {endpoint: "/vm-values/query", requiredRole: shared.RolePublic},
+	"/proto.NodeAPI/SubscribeBlocks":  {endpoint: "/subscriptions/ws", requiredRole: shared.RolePublic},
+}
+
+func (s *server) unaryInterceptor(
+	ctx context.Context,
+	request interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	release, err := s.admitCall(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return handler(ctx, request)
+}
+
+// streamInterceptor admits the streams the same way as the unary calls. The endpoint throttler, if any, is held until
+// the stream ends
+func (s *server) streamInterceptor(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	release, err := s.admitCall(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return handler(srv, stream)
+}
+
+// admitCall applies to a gRPC call the checks the REST API applies to the mirrored endpoint: the caller authentication
+// and role check, the rate limiter and the endpoint throttler. Returns the func to be called once the call ends
+func (s *server) admitCall(ctx context.Context, fullMethod string) (func(), error) {
+	access, ok := methodsAccess[fullMethod]
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "%s %s", ErrUnknownMethod.Error(), fullMethod)
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	remoteAddr, tlsState := getPeerInfo(ctx)
+
+	role, identity, ok := s.authenticator.Authenticate(getFirstMetadataValue(md, authorizationMetadataKey), tlsState)
+	if !ok {
+		auditLog.Warn("rejected API request with invalid credentials",
+			"method", grpcMethod, "path", fullMethod, "remote address", remoteAddr)
+		return nil, status.Error(codes.Unauthenticated, errors.ErrUnauthorized.Error())
+	}
+
+	if !middleware.IsAccessGranted(grpcMethod, fullMethod, remoteAddr, identity, role, access.requiredRole) {
+		return nil, status.Error(codes.PermissionDenied, errors.ErrForbidden.Error())
+	}
+
+	if !check.IfNil(s.rateLimiter) {
+		isAllowed, err := s.rateLimiter.ConsumeCallerCost(identity, remoteAddr, md.Get(forwardedForMetadataKey), access.endpoint)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if !isAllowed {
+			return nil, status.Errorf(codes.ResourceExhausted, "%s for %s", errors.ErrTooManyRequests.Error(), fullMethod)
+		}
+	}
+
+	return s.startEndpointThrottler(access.throttler)
+}
+
+func (s *server) startEndpointThrottler(throttlerName string) (func(), error) {
+	if len(throttlerName) == 0 {
+		return func() {}, nil
+	}
+
+	endpointThrottler, ok := s.getFacade().GetThrottlerForEndpoint(throttlerName)
+	if !ok {
+		return func() {}, nil
+	}
+	if !endpointThrottler.CanProcess() {
+		return nil, status.Error(codes.ResourceExhausted,
+			fmt.Sprintf("%s for endpoint %s", errors.ErrTooManyRequests.Error(), throttlerName))
+	}
+
+	endpointThrottler.StartProcessing()
+
+	return endpointThrottler.EndProcessing, nil
+}
+
+func getPeerInfo(ctx context.Context) (string, *tls.ConnectionState) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "", nil
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return p.Addr.String(), nil
+	}
+
+	return p.Addr.String(), &tlsInfo.State
+}
+
+func getFirstMetadataValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
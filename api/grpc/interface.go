@@ -0,0 +1,53 @@
+package grpc
+
+import (
+	"crypto/tls"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+// FacadeHandler defines the methods to be implemented by a facade for the gRPC requests
+type FacadeHandler interface {
+	GetAccount(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
+	GetAllESDTTokens(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
+	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
+	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	CreateTransaction(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
+	ValidateTransaction(tx *transaction.Transaction) error
+	SendBulkTransactions(txs []*transaction.Transaction) (uint64, error)
+	ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error)
+	EncodeAddressPubkey(pk []byte) (string, error)
+	DecodeAddressPubkey(pk string) ([]byte, error)
+	Subscribe(filter common.SubscriptionFilter) (common.Subscription, error)
+	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
+	IsInterfaceNil() bool
+}
+
+// Authenticator defines the methods used to resolve the role and the identity of the gRPC callers
+type Authenticator interface {
+	Authenticate(header string, tlsState *tls.ConnectionState) (shared.AccessRole, string, bool)
+	IsInterfaceNil() bool
+}
+
+// RateLimiter defines the methods used to charge the gRPC callers with the costs of the called methods
+type RateLimiter interface {
+	ConsumeCallerCost(identity string, remoteAddr string, forwardedFor []string, endpoint string) (bool, error)
+	IsInterfaceNil() bool
+}
+
+type addressEncoder interface {
+	EncodeAddressPubkey(pk []byte) (string, error)
+}
+
+type addressDecoder interface {
+	DecodeAddressPubkey(pk string) ([]byte, error)
+}
@@ -0,0 +1,8821 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nodeApi.proto
+
+package grpc
+
+import (
+	bytes "bytes"
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	github_com_multiversx_mx_chain_core_go_data "github.com/multiversx/mx-chain-core-go/data"
+	esdt "github.com/multiversx/mx-chain-core-go/data/esdt"
+	rewardTx "github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	smartContractResult "github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	transaction "github.com/multiversx/mx-chain-core-go/data/transaction"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_big "math/big"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AccountQueryOptions holds the coordinates of the state an account is read from. The Has* flags mark the values set
+type AccountQueryOptions struct {
+	OnFinalBlock      bool   `protobuf:"varint,1,opt,name=OnFinalBlock,proto3" json:"OnFinalBlock,omitempty"`
+	HasOnStartOfEpoch bool   `protobuf:"varint,2,opt,name=HasOnStartOfEpoch,proto3" json:"HasOnStartOfEpoch,omitempty"`
+	OnStartOfEpoch    uint32 `protobuf:"varint,3,opt,name=OnStartOfEpoch,proto3" json:"OnStartOfEpoch,omitempty"`
+	HasBlockNonce     bool   `protobuf:"varint,4,opt,name=HasBlockNonce,proto3" json:"HasBlockNonce,omitempty"`
+	BlockNonce        uint64 `protobuf:"varint,5,opt,name=BlockNonce,proto3" json:"BlockNonce,omitempty"`
+	BlockHash         []byte `protobuf:"bytes,6,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+	BlockRootHash     []byte `protobuf:"bytes,7,opt,name=BlockRootHash,proto3" json:"BlockRootHash,omitempty"`
+	HasHintEpoch      bool   `protobuf:"varint,8,opt,name=HasHintEpoch,proto3" json:"HasHintEpoch,omitempty"`
+	HintEpoch         uint32 `protobuf:"varint,9,opt,name=HintEpoch,proto3" json:"HintEpoch,omitempty"`
+}
+
+func (m *AccountQueryOptions) Reset()      { *m = AccountQueryOptions{} }
+func (*AccountQueryOptions) ProtoMessage() {}
+func (*AccountQueryOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{0}
+}
+func (m *AccountQueryOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountQueryOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountQueryOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountQueryOptions.Merge(m, src)
+}
+func (m *AccountQueryOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountQueryOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountQueryOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountQueryOptions proto.InternalMessageInfo
+
+func (m *AccountQueryOptions) GetOnFinalBlock() bool {
+	if m != nil {
+		return m.OnFinalBlock
+	}
+	return false
+}
+
+func (m *AccountQueryOptions) GetHasOnStartOfEpoch() bool {
+	if m != nil {
+		return m.HasOnStartOfEpoch
+	}
+	return false
+}
+
+func (m *AccountQueryOptions) GetOnStartOfEpoch() uint32 {
+	if m != nil {
+		return m.OnStartOfEpoch
+	}
+	return 0
+}
+
+func (m *AccountQueryOptions) GetHasBlockNonce() bool {
+	if m != nil {
+		return m.HasBlockNonce
+	}
+	return false
+}
+
+func (m *AccountQueryOptions) GetBlockNonce() uint64 {
+	if m != nil {
+		return m.BlockNonce
+	}
+	return 0
+}
+
+func (m *AccountQueryOptions) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *AccountQueryOptions) GetBlockRootHash() []byte {
+	if m != nil {
+		return m.BlockRootHash
+	}
+	return nil
+}
+
+func (m *AccountQueryOptions) GetHasHintEpoch() bool {
+	if m != nil {
+		return m.HasHintEpoch
+	}
+	return false
+}
+
+func (m *AccountQueryOptions) GetHintEpoch() uint32 {
+	if m != nil {
+		return m.HintEpoch
+	}
+	return 0
+}
+
+// AccountRequest holds the address of the requested account
+type AccountRequest struct {
+	Address string               `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Options *AccountQueryOptions `protobuf:"bytes,2,opt,name=Options,proto3" json:"Options,omitempty"`
+}
+
+func (m *AccountRequest) Reset()      { *m = AccountRequest{} }
+func (*AccountRequest) ProtoMessage() {}
+func (*AccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{1}
+}
+func (m *AccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountRequest.Merge(m, src)
+}
+func (m *AccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountRequest proto.InternalMessageInfo
+
+func (m *AccountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountRequest) GetOptions() *AccountQueryOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+// BlockInfo holds the coordinates of the block the state was read from
+type BlockInfo struct {
+	Nonce    uint64 `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Hash     []byte `protobuf:"bytes,2,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	RootHash []byte `protobuf:"bytes,3,opt,name=RootHash,proto3" json:"RootHash,omitempty"`
+}
+
+func (m *BlockInfo) Reset()      { *m = BlockInfo{} }
+func (*BlockInfo) ProtoMessage() {}
+func (*BlockInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{2}
+}
+func (m *BlockInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlockInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockInfo.Merge(m, src)
+}
+func (m *BlockInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockInfo proto.InternalMessageInfo
+
+func (m *BlockInfo) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *BlockInfo) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *BlockInfo) GetRootHash() []byte {
+	if m != nil {
+		return m.RootHash
+	}
+	return nil
+}
+
+// AccountReply holds an account
+type AccountReply struct {
+	Address         string        `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Nonce           uint64        `protobuf:"varint,2,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Balance         *math_big.Int `protobuf:"bytes,3,opt,name=Balance,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"Balance,omitempty"`
+	Username        string        `protobuf:"bytes,4,opt,name=Username,proto3" json:"Username,omitempty"`
+	Code            []byte        `protobuf:"bytes,5,opt,name=Code,proto3" json:"Code,omitempty"`
+	CodeHash        []byte        `protobuf:"bytes,6,opt,name=CodeHash,proto3" json:"CodeHash,omitempty"`
+	RootHash        []byte        `protobuf:"bytes,7,opt,name=RootHash,proto3" json:"RootHash,omitempty"`
+	CodeMetadata    []byte        `protobuf:"bytes,8,opt,name=CodeMetadata,proto3" json:"CodeMetadata,omitempty"`
+	DeveloperReward *math_big.Int `protobuf:"bytes,9,opt,name=DeveloperReward,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"DeveloperReward,omitempty"`
+	OwnerAddress    string        `protobuf:"bytes,10,opt,name=OwnerAddress,proto3" json:"OwnerAddress,omitempty"`
+	BlockInfo       *BlockInfo    `protobuf:"bytes,11,opt,name=BlockInfo,proto3" json:"BlockInfo,omitempty"`
+}
+
+func (m *AccountReply) Reset()      { *m = AccountReply{} }
+func (*AccountReply) ProtoMessage() {}
+func (*AccountReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{3}
+}
+func (m *AccountReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountReply.Merge(m, src)
+}
+func (m *AccountReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountReply proto.InternalMessageInfo
+
+func (m *AccountReply) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountReply) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *AccountReply) GetBalance() *math_big.Int {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *AccountReply) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *AccountReply) GetCode() []byte {
+	if m != nil {
+		return m.Code
+	}
+	return nil
+}
+
+func (m *AccountReply) GetCodeHash() []byte {
+	if m != nil {
+		return m.CodeHash
+	}
+	return nil
+}
+
+func (m *AccountReply) GetRootHash() []byte {
+	if m != nil {
+		return m.RootHash
+	}
+	return nil
+}
+
+func (m *AccountReply) GetCodeMetadata() []byte {
+	if m != nil {
+		return m.CodeMetadata
+	}
+	return nil
+}
+
+func (m *AccountReply) GetDeveloperReward() *math_big.Int {
+	if m != nil {
+		return m.DeveloperReward
+	}
+	return nil
+}
+
+func (m *AccountReply) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
+}
+
+func (m *AccountReply) GetBlockInfo() *BlockInfo {
+	if m != nil {
+		return m.BlockInfo
+	}
+	return nil
+}
+
+// ESDTTokensReply holds the ESDT tokens of an account, keyed by the token identifier
+type ESDTTokensReply struct {
+	Tokens    map[string]*esdt.ESDigitalToken `protobuf:"bytes,1,rep,name=Tokens,proto3" json:"Tokens,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	BlockInfo *BlockInfo                      `protobuf:"bytes,2,opt,name=BlockInfo,proto3" json:"BlockInfo,omitempty"`
+}
+
+func (m *ESDTTokensReply) Reset()      { *m = ESDTTokensReply{} }
+func (*ESDTTokensReply) ProtoMessage() {}
+func (*ESDTTokensReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{4}
+}
+func (m *ESDTTokensReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ESDTTokensReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ESDTTokensReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ESDTTokensReply.Merge(m, src)
+}
+func (m *ESDTTokensReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *ESDTTokensReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ESDTTokensReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ESDTTokensReply proto.InternalMessageInfo
+
+func (m *ESDTTokensReply) GetTokens() map[string]*esdt.ESDigitalToken {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *ESDTTokensReply) GetBlockInfo() *BlockInfo {
+	if m != nil {
+		return m.BlockInfo
+	}
+	return nil
+}
+
+// BlockByNonceRequest holds the nonce of the requested block
+type BlockByNonceRequest struct {
+	Nonce    uint64 `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	WithTxs  bool   `protobuf:"varint,2,opt,name=WithTxs,proto3" json:"WithTxs,omitempty"`
+	WithLogs bool   `protobuf:"varint,3,opt,name=WithLogs,proto3" json:"WithLogs,omitempty"`
+}
+
+func (m *BlockByNonceRequest) Reset()      { *m = BlockByNonceRequest{} }
+func (*BlockByNonceRequest) ProtoMessage() {}
+func (*BlockByNonceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{5}
+}
+func (m *BlockByNonceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockByNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlockByNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockByNonceRequest.Merge(m, src)
+}
+func (m *BlockByNonceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockByNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockByNonceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockByNonceRequest proto.InternalMessageInfo
+
+func (m *BlockByNonceRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *BlockByNonceRequest) GetWithTxs() bool {
+	if m != nil {
+		return m.WithTxs
+	}
+	return false
+}
+
+func (m *BlockByNonceRequest) GetWithLogs() bool {
+	if m != nil {
+		return m.WithLogs
+	}
+	return false
+}
+
+// BlockByHashRequest holds the hash of the requested block
+type BlockByHashRequest struct {
+	Hash     []byte `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	WithTxs  bool   `protobuf:"varint,2,opt,name=WithTxs,proto3" json:"WithTxs,omitempty"`
+	WithLogs bool   `protobuf:"varint,3,opt,name=WithLogs,proto3" json:"WithLogs,omitempty"`
+}
+
+func (m *BlockByHashRequest) Reset()      { *m = BlockByHashRequest{} }
+func (*BlockByHashRequest) ProtoMessage() {}
+func (*BlockByHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{6}
+}
+func (m *BlockByHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockByHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlockByHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockByHashRequest.Merge(m, src)
+}
+func (m *BlockByHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockByHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockByHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockByHashRequest proto.InternalMessageInfo
+
+func (m *BlockByHashRequest) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *BlockByHashRequest) GetWithTxs() bool {
+	if m != nil {
+		return m.WithTxs
+	}
+	return false
+}
+
+func (m *BlockByHashRequest) GetWithLogs() bool {
+	if m != nil {
+		return m.WithLogs
+	}
+	return false
+}
+
+// BlockReply holds a block, along with its transactions if requested
+type BlockReply struct {
+	Nonce           uint64           `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Round           uint64           `protobuf:"varint,2,opt,name=Round,proto3" json:"Round,omitempty"`
+	Epoch           uint32           `protobuf:"varint,3,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	Shard           uint32           `protobuf:"varint,4,opt,name=Shard,proto3" json:"Shard,omitempty"`
+	NumTxs          uint32           `protobuf:"varint,5,opt,name=NumTxs,proto3" json:"NumTxs,omitempty"`
+	Hash            []byte           `protobuf:"bytes,6,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	PrevBlockHash   []byte           `protobuf:"bytes,7,opt,name=PrevBlockHash,proto3" json:"PrevBlockHash,omitempty"`
+	StateRootHash   []byte           `protobuf:"bytes,8,opt,name=StateRootHash,proto3" json:"StateRootHash,omitempty"`
+	AccumulatedFees string           `protobuf:"bytes,9,opt,name=AccumulatedFees,proto3" json:"AccumulatedFees,omitempty"`
+	DeveloperFees   string           `protobuf:"bytes,10,opt,name=DeveloperFees,proto3" json:"DeveloperFees,omitempty"`
+	Status          string           `protobuf:"bytes,11,opt,name=Status,proto3" json:"Status,omitempty"`
+	Timestamp       int64            `protobuf:"varint,12,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	MiniBlocks      []*MiniBlockInfo `protobuf:"bytes,13,rep,name=MiniBlocks,proto3" json:"MiniBlocks,omitempty"`
+}
+
+func (m *BlockReply) Reset()      { *m = BlockReply{} }
+func (*BlockReply) ProtoMessage() {}
+func (*BlockReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{7}
+}
+func (m *BlockReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlockReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockReply.Merge(m, src)
+}
+func (m *BlockReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockReply proto.InternalMessageInfo
+
+func (m *BlockReply) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *BlockReply) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *BlockReply) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *BlockReply) GetShard() uint32 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+func (m *BlockReply) GetNumTxs() uint32 {
+	if m != nil {
+		return m.NumTxs
+	}
+	return 0
+}
+
+func (m *BlockReply) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *BlockReply) GetPrevBlockHash() []byte {
+	if m != nil {
+		return m.PrevBlockHash
+	}
+	return nil
+}
+
+func (m *BlockReply) GetStateRootHash() []byte {
+	if m != nil {
+		return m.StateRootHash
+	}
+	return nil
+}
+
+func (m *BlockReply) GetAccumulatedFees() string {
+	if m != nil {
+		return m.AccumulatedFees
+	}
+	return ""
+}
+
+func (m *BlockReply) GetDeveloperFees() string {
+	if m != nil {
+		return m.DeveloperFees
+	}
+	return ""
+}
+
+func (m *BlockReply) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *BlockReply) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *BlockReply) GetMiniBlocks() []*MiniBlockInfo {
+	if m != nil {
+		return m.MiniBlocks
+	}
+	return nil
+}
+
+// MiniBlockInfo holds a miniblock of a block
+type MiniBlockInfo struct {
+	Hash             []byte             `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Type             string             `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	SourceShard      uint32             `protobuf:"varint,3,opt,name=SourceShard,proto3" json:"SourceShard,omitempty"`
+	DestinationShard uint32             `protobuf:"varint,4,opt,name=DestinationShard,proto3" json:"DestinationShard,omitempty"`
+	Transactions     []*TransactionInfo `protobuf:"bytes,5,rep,name=Transactions,proto3" json:"Transactions,omitempty"`
+}
+
+func (m *MiniBlockInfo) Reset()      { *m = MiniBlockInfo{} }
+func (*MiniBlockInfo) ProtoMessage() {}
+func (*MiniBlockInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{8}
+}
+func (m *MiniBlockInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MiniBlockInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MiniBlockInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MiniBlockInfo.Merge(m, src)
+}
+func (m *MiniBlockInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *MiniBlockInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MiniBlockInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MiniBlockInfo proto.InternalMessageInfo
+
+func (m *MiniBlockInfo) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *MiniBlockInfo) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *MiniBlockInfo) GetSourceShard() uint32 {
+	if m != nil {
+		return m.SourceShard
+	}
+	return 0
+}
+
+func (m *MiniBlockInfo) GetDestinationShard() uint32 {
+	if m != nil {
+		return m.DestinationShard
+	}
+	return 0
+}
+
+func (m *MiniBlockInfo) GetTransactions() []*TransactionInfo {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+// TransactionRequest holds the hash of the requested transaction
+type TransactionRequest struct {
+	Hash        []byte `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	WithResults bool   `protobuf:"varint,2,opt,name=WithResults,proto3" json:"WithResults,omitempty"`
+}
+
+func (m *TransactionRequest) Reset()      { *m = TransactionRequest{} }
+func (*TransactionRequest) ProtoMessage() {}
+func (*TransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{9}
+}
+func (m *TransactionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionRequest.Merge(m, src)
+}
+func (m *TransactionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionRequest proto.InternalMessageInfo
+
+func (m *TransactionRequest) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetWithResults() bool {
+	if m != nil {
+		return m.WithResults
+	}
+	return false
+}
+
+// TransactionInfo holds a transaction along with its processing status. Depending on the type, exactly one of
+// Transaction, SmartContractResult and RewardTx is set
+type TransactionInfo struct {
+	Hash                       []byte                                   `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Type                       string                                   `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Status                     string                                   `protobuf:"bytes,3,opt,name=Status,proto3" json:"Status,omitempty"`
+	SourceShard                uint32                                   `protobuf:"varint,4,opt,name=SourceShard,proto3" json:"SourceShard,omitempty"`
+	DestinationShard           uint32                                   `protobuf:"varint,5,opt,name=DestinationShard,proto3" json:"DestinationShard,omitempty"`
+	BlockNonce                 uint64                                   `protobuf:"varint,6,opt,name=BlockNonce,proto3" json:"BlockNonce,omitempty"`
+	BlockHash                  []byte                                   `protobuf:"bytes,7,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+	MiniBlockHash              []byte                                   `protobuf:"bytes,8,opt,name=MiniBlockHash,proto3" json:"MiniBlockHash,omitempty"`
+	Epoch                      uint32                                   `protobuf:"varint,9,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	Round                      uint64                                   `protobuf:"varint,10,opt,name=Round,proto3" json:"Round,omitempty"`
+	Timestamp                  int64                                    `protobuf:"varint,11,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	GasUsed                    uint64                                   `protobuf:"varint,12,opt,name=GasUsed,proto3" json:"GasUsed,omitempty"`
+	Fee                        string                                   `protobuf:"bytes,13,opt,name=Fee,proto3" json:"Fee,omitempty"`
+	Transaction                *transaction.Transaction                 `protobuf:"bytes,14,opt,name=Transaction,proto3" json:"Transaction,omitempty"`
+	SmartContractResult        *smartContractResult.SmartContractResult `protobuf:"bytes,15,opt,name=SmartContractResult,proto3" json:"SmartContractResult,omitempty"`
+	RewardTx                   *rewardTx.RewardTx                       `protobuf:"bytes,16,opt,name=RewardTx,proto3" json:"RewardTx,omitempty"`
+	Log                        *transaction.Log                         `protobuf:"bytes,17,opt,name=Log,proto3" json:"Log,omitempty"`
+	SmartContractResultsHashes [][]byte                                 `protobuf:"bytes,18,rep,name=SmartContractResultsHashes,proto3" json:"SmartContractResultsHashes,omitempty"`
+}
+
+func (m *TransactionInfo) Reset()      { *m = TransactionInfo{} }
+func (*TransactionInfo) ProtoMessage() {}
+func (*TransactionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{10}
+}
+func (m *TransactionInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TransactionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionInfo.Merge(m, src)
+}
+func (m *TransactionInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionInfo proto.InternalMessageInfo
+
+func (m *TransactionInfo) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *TransactionInfo) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *TransactionInfo) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *TransactionInfo) GetSourceShard() uint32 {
+	if m != nil {
+		return m.SourceShard
+	}
+	return 0
+}
+
+func (m *TransactionInfo) GetDestinationShard() uint32 {
+	if m != nil {
+		return m.DestinationShard
+	}
+	return 0
+}
+
+func (m *TransactionInfo) GetBlockNonce() uint64 {
+	if m != nil {
+		return m.BlockNonce
+	}
+	return 0
+}
+
+func (m *TransactionInfo) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *TransactionInfo) GetMiniBlockHash() []byte {
+	if m != nil {
+		return m.MiniBlockHash
+	}
+	return nil
+}
+
+func (m *TransactionInfo) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *TransactionInfo) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *TransactionInfo) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *TransactionInfo) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *TransactionInfo) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *TransactionInfo) GetTransaction() *transaction.Transaction {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *TransactionInfo) GetSmartContractResult() *smartContractResult.SmartContractResult {
+	if m != nil {
+		return m.SmartContractResult
+	}
+	return nil
+}
+
+func (m *TransactionInfo) GetRewardTx() *rewardTx.RewardTx {
+	if m != nil {
+		return m.RewardTx
+	}
+	return nil
+}
+
+func (m *TransactionInfo) GetLog() *transaction.Log {
+	if m != nil {
+		return m.Log
+	}
+	return nil
+}
+
+func (m *TransactionInfo) GetSmartContractResultsHashes() [][]byte {
+	if m != nil {
+		return m.SmartContractResultsHashes
+	}
+	return nil
+}
+
+// SendTransactionsRequest holds the signed transactions to be sent
+type SendTransactionsRequest struct {
+	Transactions []*transaction.Transaction `protobuf:"bytes,1,rep,name=Transactions,proto3" json:"Transactions,omitempty"`
+}
+
+func (m *SendTransactionsRequest) Reset()      { *m = SendTransactionsRequest{} }
+func (*SendTransactionsRequest) ProtoMessage() {}
+func (*SendTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{11}
+}
+func (m *SendTransactionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SendTransactionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendTransactionsRequest.Merge(m, src)
+}
+func (m *SendTransactionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendTransactionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendTransactionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendTransactionsRequest proto.InternalMessageInfo
+
+func (m *SendTransactionsRequest) GetTransactions() []*transaction.Transaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+// SendTransactionsReply holds the number of sent transactions and the hashes of the valid ones, keyed by their index
+type SendTransactionsReply struct {
+	NumSent uint64            `protobuf:"varint,1,opt,name=NumSent,proto3" json:"NumSent,omitempty"`
+	Hashes  map[uint32][]byte `protobuf:"bytes,2,rep,name=Hashes,proto3" json:"Hashes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *SendTransactionsReply) Reset()      { *m = SendTransactionsReply{} }
+func (*SendTransactionsReply) ProtoMessage() {}
+func (*SendTransactionsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{12}
+}
+func (m *SendTransactionsReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendTransactionsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SendTransactionsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendTransactionsReply.Merge(m, src)
+}
+func (m *SendTransactionsReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendTransactionsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendTransactionsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendTransactionsReply proto.InternalMessageInfo
+
+func (m *SendTransactionsReply) GetNumSent() uint64 {
+	if m != nil {
+		return m.NumSent
+	}
+	return 0
+}
+
+func (m *SendTransactionsReply) GetHashes() map[uint32][]byte {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+// VMQueryRequest holds a smart contract query. The query is executed on the given block, if any
+type VMQueryRequest struct {
+	ScAddress      []byte        `protobuf:"bytes,1,opt,name=ScAddress,proto3" json:"ScAddress,omitempty"`
+	FuncName       string        `protobuf:"bytes,2,opt,name=FuncName,proto3" json:"FuncName,omitempty"`
+	CallerAddr     []byte        `protobuf:"bytes,3,opt,name=CallerAddr,proto3" json:"CallerAddr,omitempty"`
+	CallValue      *math_big.Int `protobuf:"bytes,4,opt,name=CallValue,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"CallValue,omitempty"`
+	Arguments      [][]byte      `protobuf:"bytes,5,rep,name=Arguments,proto3" json:"Arguments,omitempty"`
+	ShouldBeSynced bool          `protobuf:"varint,6,opt,name=ShouldBeSynced,proto3" json:"ShouldBeSynced,omitempty"`
+	HasBlockNonce  bool          `protobuf:"varint,7,opt,name=HasBlockNonce,proto3" json:"HasBlockNonce,omitempty"`
+	BlockNonce     uint64        `protobuf:"varint,8,opt,name=BlockNonce,proto3" json:"BlockNonce,omitempty"`
+	BlockHash      []byte        `protobuf:"bytes,9,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+}
+
+func (m *VMQueryRequest) Reset()      { *m = VMQueryRequest{} }
+func (*VMQueryRequest) ProtoMessage() {}
+func (*VMQueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{13}
+}
+func (m *VMQueryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VMQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *VMQueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VMQueryRequest.Merge(m, src)
+}
+func (m *VMQueryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VMQueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VMQueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VMQueryRequest proto.InternalMessageInfo
+
+func (m *VMQueryRequest) GetScAddress() []byte {
+	if m != nil {
+		return m.ScAddress
+	}
+	return nil
+}
+
+func (m *VMQueryRequest) GetFuncName() string {
+	if m != nil {
+		return m.FuncName
+	}
+	return ""
+}
+
+func (m *VMQueryRequest) GetCallerAddr() []byte {
+	if m != nil {
+		return m.CallerAddr
+	}
+	return nil
+}
+
+func (m *VMQueryRequest) GetCallValue() *math_big.Int {
+	if m != nil {
+		return m.CallValue
+	}
+	return nil
+}
+
+func (m *VMQueryRequest) GetArguments() [][]byte {
+	if m != nil {
+		return m.Arguments
+	}
+	return nil
+}
+
+func (m *VMQueryRequest) GetShouldBeSynced() bool {
+	if m != nil {
+		return m.ShouldBeSynced
+	}
+	return false
+}
+
+func (m *VMQueryRequest) GetHasBlockNonce() bool {
+	if m != nil {
+		return m.HasBlockNonce
+	}
+	return false
+}
+
+func (m *VMQueryRequest) GetBlockNonce() uint64 {
+	if m != nil {
+		return m.BlockNonce
+	}
+	return 0
+}
+
+func (m *VMQueryRequest) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+// VMQueryReply holds the outcome of a smart contract query
+type VMQueryReply struct {
+	ReturnData    [][]byte   `protobuf:"bytes,1,rep,name=ReturnData,proto3" json:"ReturnData,omitempty"`
+	ReturnCode    string     `protobuf:"bytes,2,opt,name=ReturnCode,proto3" json:"ReturnCode,omitempty"`
+	ReturnMessage string     `protobuf:"bytes,3,opt,name=ReturnMessage,proto3" json:"ReturnMessage,omitempty"`
+	GasRemaining  uint64     `protobuf:"varint,4,opt,name=GasRemaining,proto3" json:"GasRemaining,omitempty"`
+	BlockInfo     *BlockInfo `protobuf:"bytes,5,opt,name=BlockInfo,proto3" json:"BlockInfo,omitempty"`
+}
+
+func (m *VMQueryReply) Reset()      { *m = VMQueryReply{} }
+func (*VMQueryReply) ProtoMessage() {}
+func (*VMQueryReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{14}
+}
+func (m *VMQueryReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VMQueryReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *VMQueryReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VMQueryReply.Merge(m, src)
+}
+func (m *VMQueryReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *VMQueryReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_VMQueryReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VMQueryReply proto.InternalMessageInfo
+
+func (m *VMQueryReply) GetReturnData() [][]byte {
+	if m != nil {
+		return m.ReturnData
+	}
+	return nil
+}
+
+func (m *VMQueryReply) GetReturnCode() string {
+	if m != nil {
+		return m.ReturnCode
+	}
+	return ""
+}
+
+func (m *VMQueryReply) GetReturnMessage() string {
+	if m != nil {
+		return m.ReturnMessage
+	}
+	return ""
+}
+
+func (m *VMQueryReply) GetGasRemaining() uint64 {
+	if m != nil {
+		return m.GasRemaining
+	}
+	return 0
+}
+
+func (m *VMQueryReply) GetBlockInfo() *BlockInfo {
+	if m != nil {
+		return m.BlockInfo
+	}
+	return nil
+}
+
+// SubscribeBlocksRequest holds the nonce the notifications should start from. Without it, only the blocks finalized
+// after subscribing are streamed
+type SubscribeBlocksRequest struct {
+	HasFromNonce bool   `protobuf:"varint,1,opt,name=HasFromNonce,proto3" json:"HasFromNonce,omitempty"`
+	FromNonce    uint64 `protobuf:"varint,2,opt,name=FromNonce,proto3" json:"FromNonce,omitempty"`
+}
+
+func (m *SubscribeBlocksRequest) Reset()      { *m = SubscribeBlocksRequest{} }
+func (*SubscribeBlocksRequest) ProtoMessage() {}
+func (*SubscribeBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{15}
+}
+func (m *SubscribeBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SubscribeBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeBlocksRequest.Merge(m, src)
+}
+func (m *SubscribeBlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeBlocksRequest proto.InternalMessageInfo
+
+func (m *SubscribeBlocksRequest) GetHasFromNonce() bool {
+	if m != nil {
+		return m.HasFromNonce
+	}
+	return false
+}
+
+func (m *SubscribeBlocksRequest) GetFromNonce() uint64 {
+	if m != nil {
+		return m.FromNonce
+	}
+	return 0
+}
+
+// BlockNotification holds the data streamed for a finalized block
+type BlockNotification struct {
+	Hash          []byte `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Nonce         uint64 `protobuf:"varint,2,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Round         uint64 `protobuf:"varint,3,opt,name=Round,proto3" json:"Round,omitempty"`
+	Epoch         uint32 `protobuf:"varint,4,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	ShardID       uint32 `protobuf:"varint,5,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	Timestamp     uint64 `protobuf:"varint,6,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	PrevHash      []byte `protobuf:"bytes,7,opt,name=PrevHash,proto3" json:"PrevHash,omitempty"`
+	StateRootHash []byte `protobuf:"bytes,8,opt,name=StateRootHash,proto3" json:"StateRootHash,omitempty"`
+	NumTxs        uint32 `protobuf:"varint,9,opt,name=NumTxs,proto3" json:"NumTxs,omitempty"`
+}
+
+func (m *BlockNotification) Reset()      { *m = BlockNotification{} }
+func (*BlockNotification) ProtoMessage() {}
+func (*BlockNotification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{16}
+}
+func (m *BlockNotification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockNotification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlockNotification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockNotification.Merge(m, src)
+}
+func (m *BlockNotification) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockNotification) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockNotification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockNotification proto.InternalMessageInfo
+
+func (m *BlockNotification) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *BlockNotification) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *BlockNotification) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *BlockNotification) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *BlockNotification) GetShardID() uint32 {
+	if m != nil {
+		return m.ShardID
+	}
+	return 0
+}
+
+func (m *BlockNotification) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *BlockNotification) GetPrevHash() []byte {
+	if m != nil {
+		return m.PrevHash
+	}
+	return nil
+}
+
+func (m *BlockNotification) GetStateRootHash() []byte {
+	if m != nil {
+		return m.StateRootHash
+	}
+	return nil
+}
+
+func (m *BlockNotification) GetNumTxs() uint32 {
+	if m != nil {
+		return m.NumTxs
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*AccountQueryOptions)(nil), "proto.AccountQueryOptions")
+	proto.RegisterType((*AccountRequest)(nil), "proto.AccountRequest")
+	proto.RegisterType((*BlockInfo)(nil), "proto.BlockInfo")
+	proto.RegisterType((*AccountReply)(nil), "proto.AccountReply")
+	proto.RegisterType((*ESDTTokensReply)(nil), "proto.ESDTTokensReply")
+	proto.RegisterMapType((map[string]*esdt.ESDigitalToken)(nil), "proto.ESDTTokensReply.TokensEntry")
+	proto.RegisterType((*BlockByNonceRequest)(nil), "proto.BlockByNonceRequest")
+	proto.RegisterType((*BlockByHashRequest)(nil), "proto.BlockByHashRequest")
+	proto.RegisterType((*BlockReply)(nil), "proto.BlockReply")
+	proto.RegisterType((*MiniBlockInfo)(nil), "proto.MiniBlockInfo")
+	proto.RegisterType((*TransactionRequest)(nil), "proto.TransactionRequest")
+	proto.RegisterType((*TransactionInfo)(nil), "proto.TransactionInfo")
+	proto.RegisterType((*SendTransactionsRequest)(nil), "proto.SendTransactionsRequest")
+	proto.RegisterType((*SendTransactionsReply)(nil), "proto.SendTransactionsReply")
+	proto.RegisterMapType((map[uint32][]byte)(nil), "proto.SendTransactionsReply.HashesEntry")
+	proto.RegisterType((*VMQueryRequest)(nil), "proto.VMQueryRequest")
+	proto.RegisterType((*VMQueryReply)(nil), "proto.VMQueryReply")
+	proto.RegisterType((*SubscribeBlocksRequest)(nil), "proto.SubscribeBlocksRequest")
+	proto.RegisterType((*BlockNotification)(nil), "proto.BlockNotification")
+}
+
+func init() { proto.RegisterFile("nodeApi.proto", fileDescriptor_6aeeda6fa2765447) }
+
+var fileDescriptor_6aeeda6fa2765447 = []byte{
+	// 1730 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0x2d, 0xc9, 0xb2, 0x9e, 0x24, 0xdb, 0x19, 0x67, 0x53, 0xae, 0x90, 0xb2, 0x82, 0xb0,
+	0x2d, 0x84, 0xb6, 0xb1, 0x8b, 0x34, 0x2d, 0xb6, 0x59, 0x20, 0x58, 0xcb, 0x8e, 0x6d, 0x15, 0xb6,
+	0x93, 0x8c, 0xbc, 0x29, 0xba, 0x87, 0x62, 0xc7, 0xd4, 0x84, 0x22, 0x22, 0x91, 0x2a, 0x67, 0x98,
+	0xb5, 0x6f, 0x3d, 0xf6, 0xd8, 0x63, 0xaf, 0xbd, 0x15, 0x3d, 0xf4, 0x7f, 0xe8, 0x6d, 0x8f, 0x39,
+	0x06, 0xe8, 0x61, 0x1b, 0xe5, 0xd2, 0xe3, 0x1e, 0x0b, 0x14, 0x05, 0x8a, 0xf9, 0x20, 0x39, 0xa4,
+	0x19, 0xe7, 0x03, 0x39, 0x69, 0xde, 0x8f, 0x8f, 0xef, 0xcd, 0x7b, 0xf3, 0x7b, 0x6f, 0x1e, 0x05,
+	0xed, 0x20, 0x1c, 0xd3, 0x9d, 0xb9, 0xbf, 0x35, 0x8f, 0x42, 0x1e, 0xa2, 0x9a, 0xfc, 0xe9, 0xdc,
+	0xf2, 0x7c, 0x3e, 0x89, 0xcf, 0xb6, 0xdc, 0x70, 0xb6, 0xed, 0x85, 0x5e, 0xb8, 0x2d, 0xe1, 0xb3,
+	0xf8, 0x89, 0x94, 0xa4, 0x20, 0x57, 0xea, 0xad, 0xce, 0x8e, 0xa1, 0x3e, 0x8b, 0xa7, 0xdc, 0x7f,
+	0x46, 0x23, 0x76, 0xbe, 0x3d, 0x3b, 0xbf, 0xe5, 0x4e, 0x88, 0x1f, 0xdc, 0x72, 0xc3, 0x88, 0xde,
+	0xf2, 0xc2, 0xed, 0x31, 0xe1, 0x64, 0x9b, 0xb2, 0x31, 0x57, 0xf6, 0xe4, 0x52, 0x9b, 0xd8, 0x7d,
+	0x17, 0x13, 0x11, 0xfd, 0x9a, 0x44, 0xe3, 0xd3, 0xf3, 0x74, 0xa1, 0x8d, 0xfc, 0xf6, 0x5d, 0x8c,
+	0xb0, 0x19, 0x89, 0xf8, 0x6e, 0x18, 0xf0, 0x88, 0xb8, 0x1c, 0x53, 0x16, 0x4f, 0x79, 0x19, 0xf6,
+	0x3e, 0x21, 0xf2, 0x88, 0x04, 0x8c, 0xb8, 0xdc, 0x0f, 0x83, 0xed, 0x69, 0xe8, 0x69, 0x13, 0xc3,
+	0xf7, 0x35, 0x61, 0xac, 0x95, 0xa9, 0xde, 0x3f, 0x97, 0x61, 0x73, 0xc7, 0x75, 0xc3, 0x38, 0xe0,
+	0x8f, 0x62, 0x1a, 0x5d, 0x3c, 0x98, 0x8b, 0x87, 0x0c, 0xf5, 0xa0, 0xf5, 0x20, 0xd8, 0xf7, 0x03,
+	0x32, 0x1d, 0x4c, 0x43, 0xf7, 0xa9, 0x6d, 0x75, 0xad, 0xfe, 0x2a, 0xce, 0x61, 0xe8, 0xa7, 0x70,
+	0xed, 0x90, 0xb0, 0x07, 0xc1, 0x88, 0x93, 0x88, 0x3f, 0x78, 0x72, 0x7f, 0x1e, 0xba, 0x13, 0x7b,
+	0x59, 0x2a, 0x5e, 0x7e, 0x80, 0x7e, 0x04, 0x6b, 0x05, 0xd5, 0x4a, 0xd7, 0xea, 0xb7, 0x71, 0x01,
+	0x45, 0x9f, 0x40, 0xfb, 0x90, 0x30, 0xe9, 0xe1, 0x24, 0x0c, 0x5c, 0x6a, 0x57, 0xa5, 0xc5, 0x3c,
+	0x88, 0x1c, 0x00, 0x43, 0xa5, 0xd6, 0xb5, 0xfa, 0x55, 0x6c, 0x20, 0xe8, 0x26, 0x34, 0xa4, 0x74,
+	0x48, 0xd8, 0xc4, 0x5e, 0xe9, 0x5a, 0xfd, 0x16, 0xce, 0x00, 0xe1, 0x43, 0x0a, 0x38, 0x0c, 0xb9,
+	0xd4, 0xa8, 0x4b, 0x8d, 0x3c, 0x28, 0x72, 0x70, 0x48, 0xd8, 0xa1, 0x1f, 0x70, 0xb5, 0xdf, 0x55,
+	0x95, 0x03, 0x13, 0x13, 0x7e, 0x32, 0x85, 0x86, 0x0c, 0x28, 0x03, 0x7a, 0x5f, 0xc1, 0x9a, 0x4e,
+	0x2e, 0xa6, 0xbf, 0x8f, 0x29, 0xe3, 0xc8, 0x86, 0xfa, 0xce, 0x78, 0x1c, 0x51, 0xc6, 0x64, 0x4a,
+	0x1b, 0x38, 0x11, 0xd1, 0x1d, 0xa8, 0xeb, 0xe4, 0xcb, 0x1c, 0x36, 0x6f, 0x77, 0xd4, 0x11, 0x6d,
+	0x95, 0x1c, 0x0f, 0x4e, 0x54, 0x7b, 0x8f, 0x74, 0x9c, 0xc3, 0xe0, 0x49, 0x88, 0xae, 0x43, 0x4d,
+	0xe5, 0xc3, 0x92, 0xf9, 0x50, 0x02, 0x42, 0x50, 0x95, 0x31, 0x2e, 0xcb, 0x18, 0xe5, 0x1a, 0x75,
+	0x60, 0x35, 0x8d, 0xbd, 0x22, 0xf1, 0x54, 0xee, 0xfd, 0xa7, 0x02, 0xad, 0x74, 0xd7, 0xf3, 0xe9,
+	0xc5, 0x15, 0x7b, 0x4e, 0x1d, 0x2e, 0x9b, 0x0e, 0x5d, 0xa8, 0x0f, 0xc8, 0x94, 0x08, 0x5c, 0xda,
+	0x1e, 0x0c, 0xff, 0xf6, 0xed, 0x0f, 0xee, 0xcf, 0x08, 0x9f, 0x6c, 0x9f, 0xf9, 0xde, 0xd6, 0x30,
+	0xe0, 0x9f, 0xbd, 0x03, 0x81, 0xb7, 0x06, 0xbe, 0x37, 0x0c, 0xf8, 0x2e, 0x61, 0x9c, 0x46, 0x38,
+	0xb1, 0x2c, 0x22, 0xf8, 0x82, 0xd1, 0x28, 0x20, 0x33, 0xc5, 0x90, 0x06, 0x4e, 0x65, 0x11, 0xf1,
+	0x6e, 0x38, 0x56, 0xb4, 0x68, 0x61, 0xb9, 0x16, 0xfa, 0xe2, 0xd7, 0xe0, 0x43, 0x2a, 0xe7, 0xb2,
+	0x51, 0xcf, 0x67, 0x43, 0x90, 0x40, 0xe8, 0x1d, 0x53, 0x4e, 0xc4, 0x6e, 0x24, 0x09, 0x5a, 0x38,
+	0x87, 0x21, 0x06, 0xeb, 0x7b, 0xf4, 0x19, 0x9d, 0x86, 0x73, 0x1a, 0x61, 0xd9, 0x48, 0xec, 0xc6,
+	0x87, 0x0e, 0xbc, 0xe8, 0x41, 0x56, 0xe8, 0xd7, 0x01, 0x8d, 0x92, 0xa3, 0x01, 0x99, 0x84, 0x1c,
+	0x86, 0xb6, 0x0c, 0x76, 0xd8, 0x4d, 0xc9, 0xaa, 0x0d, 0xcd, 0xaa, 0x14, 0xc7, 0x99, 0x4a, 0xef,
+	0x5b, 0x0b, 0xd6, 0xef, 0x8f, 0xf6, 0x4e, 0x4f, 0xc3, 0xa7, 0x34, 0x60, 0xea, 0xf4, 0xef, 0xc2,
+	0x8a, 0x12, 0x6d, 0xab, 0x5b, 0xe9, 0x37, 0x6f, 0xf7, 0xb4, 0x81, 0x82, 0xde, 0x96, 0x5a, 0xdf,
+	0x0f, 0x78, 0x74, 0x81, 0xf5, 0x1b, 0x79, 0xff, 0xcb, 0x6f, 0xf4, 0xdf, 0xf9, 0x0a, 0x9a, 0x86,
+	0x19, 0xb4, 0x01, 0x95, 0xa7, 0xf4, 0x42, 0x93, 0x4e, 0x2c, 0xd1, 0x67, 0x50, 0x7b, 0x46, 0xa6,
+	0x31, 0xd5, 0xc6, 0x7e, 0xa8, 0x8c, 0x0d, 0x62, 0x7f, 0xca, 0x87, 0xc1, 0x7e, 0x1c, 0xc8, 0xd6,
+	0xc6, 0xc4, 0xde, 0x7c, 0xcf, 0xe7, 0x64, 0x2a, 0xad, 0x61, 0xf5, 0xce, 0xdd, 0xe5, 0x4f, 0xad,
+	0x1e, 0x81, 0x4d, 0xe9, 0x6e, 0x70, 0x21, 0xb9, 0x9a, 0x94, 0x65, 0x79, 0xe5, 0xd8, 0x50, 0xff,
+	0x8d, 0xcf, 0x27, 0xa7, 0xe7, 0x4c, 0xb7, 0xb5, 0x44, 0x14, 0x8c, 0x11, 0xcb, 0xa3, 0xd0, 0x63,
+	0x92, 0xe3, 0xab, 0x38, 0x95, 0x7b, 0xbf, 0x03, 0xa4, 0x5d, 0x08, 0x02, 0x25, 0x1e, 0x92, 0x2a,
+	0xb4, 0x8c, 0x2a, 0x7c, 0x3f, 0xfb, 0x7f, 0xae, 0xe8, 0xde, 0xa7, 0xce, 0xa7, 0x7c, 0xeb, 0xd7,
+	0xa1, 0x86, 0xc3, 0x38, 0x18, 0x27, 0x95, 0x29, 0x05, 0x81, 0x9a, 0xad, 0x57, 0x09, 0x02, 0x1d,
+	0x4d, 0x04, 0x69, 0xab, 0x0a, 0x95, 0x02, 0xba, 0x01, 0x2b, 0x27, 0xf1, 0x4c, 0xec, 0xad, 0x26,
+	0x61, 0x2d, 0xa5, 0x81, 0xac, 0x18, 0x81, 0x7c, 0x02, 0xed, 0x87, 0x11, 0x7d, 0x96, 0x75, 0x5c,
+	0xdd, 0x4f, 0x73, 0xa0, 0xd0, 0x1a, 0x71, 0xc2, 0x69, 0x5a, 0x6b, 0xaa, 0x96, 0xf2, 0x20, 0xea,
+	0xc3, 0xfa, 0x8e, 0xeb, 0xc6, 0xb3, 0x78, 0x4a, 0x38, 0x1d, 0xef, 0x53, 0xca, 0x64, 0x31, 0x35,
+	0x70, 0x11, 0x16, 0xf6, 0xd2, 0xa2, 0x90, 0x7a, 0xaa, 0x04, 0xf2, 0xa0, 0x88, 0x43, 0x38, 0x88,
+	0x99, 0x2c, 0x80, 0x06, 0xd6, 0x92, 0xe8, 0xdc, 0xa7, 0xfe, 0x8c, 0x32, 0x4e, 0x66, 0x73, 0xbb,
+	0xd5, 0xb5, 0xfa, 0x15, 0x9c, 0x01, 0xe8, 0x0e, 0xc0, 0xb1, 0x1f, 0xf8, 0x72, 0xf3, 0xcc, 0x6e,
+	0x4b, 0xe6, 0x5f, 0xd7, 0xd4, 0x4d, 0x1f, 0x48, 0xfa, 0x1a, 0x7a, 0xbd, 0x7f, 0x58, 0xd0, 0xce,
+	0x3d, 0x2d, 0x3d, 0x76, 0x04, 0xd5, 0xd3, 0x8b, 0xb9, 0xe2, 0x70, 0x03, 0xcb, 0x35, 0xea, 0x42,
+	0x73, 0x14, 0xc6, 0x91, 0x4b, 0xd5, 0x49, 0xa8, 0xf3, 0x31, 0x21, 0xf4, 0x63, 0xd8, 0xd8, 0xa3,
+	0x8c, 0xfb, 0x01, 0x11, 0x1c, 0x37, 0x0f, 0xec, 0x12, 0x8e, 0xee, 0x42, 0xeb, 0x34, 0xbb, 0xea,
+	0xc5, 0x09, 0x8a, 0xfd, 0xdf, 0xd0, 0xfb, 0x37, 0x1e, 0xc9, 0x08, 0x72, 0xba, 0xbd, 0x5f, 0x03,
+	0x32, 0xe4, 0xab, 0xe8, 0xdb, 0x85, 0xa6, 0x20, 0xa5, 0x9a, 0x6e, 0x12, 0x0a, 0x9b, 0x50, 0xef,
+	0x8f, 0x35, 0x58, 0x2f, 0x78, 0x7b, 0xeb, 0x8c, 0x64, 0xe7, 0x56, 0xc9, 0x9d, 0x5b, 0x21, 0x53,
+	0xd5, 0xb7, 0xcb, 0x54, 0xed, 0x35, 0x99, 0xca, 0xcf, 0x11, 0x2b, 0x57, 0xcf, 0x11, 0xf5, 0x92,
+	0x39, 0x22, 0x3d, 0x6e, 0x93, 0xd1, 0x39, 0x30, 0xab, 0xba, 0x46, 0xa1, 0xea, 0x54, 0x85, 0x82,
+	0x59, 0xa1, 0x39, 0x56, 0x36, 0x8b, 0xac, 0xb4, 0xa1, 0x7e, 0x40, 0xd8, 0x17, 0x8c, 0x8e, 0x25,
+	0x63, 0xab, 0x38, 0x11, 0x45, 0xab, 0xdc, 0xa7, 0xd4, 0x6e, 0xab, 0x56, 0xb9, 0x4f, 0x29, 0xba,
+	0x03, 0x4d, 0x23, 0xf5, 0xf6, 0x9a, 0x6c, 0x98, 0xe8, 0x32, 0x05, 0xb0, 0xa9, 0x86, 0x8e, 0x60,
+	0x73, 0x74, 0x79, 0x74, 0xb5, 0xd7, 0x73, 0x13, 0x49, 0x89, 0x06, 0x2e, 0x7b, 0x0d, 0xfd, 0x04,
+	0x56, 0xb1, 0x1e, 0xac, 0xed, 0x0d, 0x69, 0x62, 0x5d, 0x9b, 0x48, 0x60, 0x9c, 0x2a, 0xa0, 0x9b,
+	0x50, 0x39, 0x0a, 0x3d, 0xfb, 0x9a, 0xd4, 0x03, 0xad, 0x77, 0x14, 0x7a, 0x58, 0xc0, 0xe8, 0x1e,
+	0x74, 0x4a, 0x3c, 0x30, 0x91, 0x60, 0xca, 0x6c, 0xd4, 0xad, 0xf4, 0x5b, 0xf8, 0x0a, 0x8d, 0xde,
+	0x23, 0xf8, 0xde, 0x88, 0x06, 0x63, 0x93, 0xea, 0x09, 0xb7, 0x7f, 0x59, 0xa8, 0x16, 0x75, 0xcf,
+	0x95, 0xa5, 0x2a, 0x5f, 0x29, 0x7f, 0xb7, 0xe0, 0xa3, 0xcb, 0x36, 0xf5, 0xc4, 0x74, 0x12, 0xcf,
+	0x46, 0x34, 0xe0, 0xba, 0x2b, 0x27, 0x22, 0xfa, 0x1c, 0x56, 0xf4, 0x96, 0x97, 0xa5, 0x97, 0x7e,
+	0x92, 0xd2, 0x32, 0x3b, 0x5b, 0x4a, 0x55, 0xdf, 0xa9, 0x4a, 0xe8, 0xfc, 0x0a, 0x9a, 0x06, 0x6c,
+	0xde, 0x91, 0x6d, 0x75, 0x47, 0x5e, 0x37, 0xef, 0xc8, 0x96, 0x79, 0xf9, 0xfd, 0x6f, 0x19, 0xd6,
+	0x1e, 0x1f, 0xcb, 0x41, 0x32, 0x89, 0xfd, 0x26, 0x34, 0x46, 0xae, 0x39, 0xdd, 0xb5, 0x70, 0x06,
+	0x88, 0x6b, 0x48, 0x5c, 0xaa, 0x27, 0x64, 0xa6, 0xac, 0x35, 0x70, 0x2a, 0x8b, 0xca, 0xd9, 0x25,
+	0xd3, 0xa9, 0x1a, 0x36, 0xf4, 0x10, 0x69, 0x20, 0xc8, 0x83, 0x86, 0x90, 0x1e, 0xcb, 0xad, 0x54,
+	0x3f, 0xf4, 0x38, 0x94, 0xd9, 0x16, 0x21, 0xec, 0x44, 0x5e, 0x3c, 0xa3, 0x01, 0x57, 0x9d, 0xae,
+	0x85, 0x33, 0x40, 0x7c, 0x76, 0x8c, 0x26, 0x61, 0x3c, 0x1d, 0x0f, 0xe8, 0xe8, 0x22, 0x70, 0xe9,
+	0x58, 0x16, 0xf9, 0x2a, 0x2e, 0xa0, 0x97, 0x3f, 0x3b, 0xea, 0x6f, 0xfe, 0xec, 0x58, 0xbd, 0xba,
+	0x5d, 0x34, 0x0a, 0xed, 0xa2, 0xf7, 0x8d, 0x05, 0xad, 0x34, 0xff, 0x82, 0x27, 0x0e, 0x00, 0xa6,
+	0x3c, 0x8e, 0x82, 0x3d, 0x31, 0x5a, 0x5a, 0x72, 0xef, 0x06, 0x92, 0x3d, 0x97, 0xe3, 0xac, 0x3a,
+	0x01, 0x03, 0x11, 0x9b, 0x56, 0xd2, 0x31, 0x65, 0x8c, 0x78, 0x54, 0xb7, 0xca, 0x3c, 0x28, 0x26,
+	0xc5, 0x03, 0xc2, 0x30, 0x9d, 0x11, 0x3f, 0xf0, 0x03, 0x4f, 0x1e, 0x46, 0x15, 0xe7, 0xb0, 0xfc,
+	0xa4, 0x56, 0x7b, 0xf3, 0xa4, 0xf8, 0x25, 0xdc, 0x18, 0xc5, 0x67, 0xcc, 0x8d, 0xfc, 0x33, 0x2a,
+	0xd1, 0xb4, 0x9a, 0xd4, 0x57, 0xd3, 0x7e, 0x14, 0xce, 0xb2, 0xb1, 0x64, 0x15, 0xe7, 0x30, 0x91,
+	0xa6, 0x4c, 0x41, 0x4d, 0x28, 0x19, 0xd0, 0xfb, 0xaf, 0x05, 0xd7, 0x74, 0x4e, 0xb9, 0xff, 0xc4,
+	0x77, 0x65, 0xbb, 0x2e, 0xbd, 0x37, 0xca, 0xbf, 0x3f, 0xd2, 0xce, 0x5a, 0x29, 0x9d, 0x7d, 0xaa,
+	0x66, 0x17, 0xb6, 0xa1, 0x2e, 0x2f, 0x82, 0xe1, 0x9e, 0xbe, 0x22, 0x12, 0x31, 0xdf, 0x89, 0xd5,
+	0xc5, 0x90, 0x01, 0xa2, 0x32, 0xc4, 0x70, 0x63, 0x7e, 0x32, 0x24, 0xf2, 0x5b, 0xce, 0x39, 0xd9,
+	0x7c, 0xd5, 0x30, 0xe7, 0xab, 0xdb, 0x7f, 0xa9, 0x42, 0xfd, 0x44, 0xfc, 0x95, 0xf2, 0x70, 0x88,
+	0x3e, 0x05, 0x38, 0xa0, 0x5c, 0x7f, 0x8c, 0xa1, 0x8f, 0xf2, 0x1f, 0x84, 0x3a, 0xe1, 0x9d, 0xcd,
+	0x22, 0x2c, 0x98, 0x75, 0x0f, 0xda, 0x07, 0x94, 0x67, 0x33, 0xfa, 0xeb, 0x5e, 0xbe, 0x51, 0x3e,
+	0xcd, 0xa3, 0xcf, 0x61, 0xfd, 0x80, 0x72, 0x73, 0x54, 0x46, 0x1d, 0x93, 0x0f, 0xf9, 0xf9, 0xb9,
+	0x73, 0xcd, 0x7c, 0x96, 0xec, 0x60, 0x2d, 0xb3, 0x20, 0x23, 0xfe, 0x38, 0x6f, 0xc0, 0x98, 0x8e,
+	0xcb, 0xde, 0xdf, 0x95, 0xef, 0x9b, 0x77, 0xd3, 0xc7, 0x25, 0x1d, 0xb9, 0x10, 0x46, 0x71, 0xd8,
+	0x78, 0x08, 0x1b, 0xc5, 0xce, 0x8a, 0x9c, 0xd7, 0xb6, 0x5c, 0x65, 0xeb, 0xe6, 0x55, 0x2d, 0x19,
+	0xfd, 0x02, 0xea, 0xb2, 0x80, 0x1f, 0x1f, 0xa7, 0x29, 0xcd, 0xb7, 0xd4, 0xce, 0x66, 0x11, 0x16,
+	0xaf, 0x1d, 0xc1, 0x7a, 0xa1, 0x5e, 0xd0, 0xf7, 0x13, 0x3f, 0xa5, 0x75, 0xd4, 0xb1, 0xcd, 0x94,
+	0x98, 0x95, 0xf0, 0x33, 0x6b, 0x70, 0xef, 0xf9, 0x4b, 0x67, 0xe9, 0xc5, 0x4b, 0x67, 0xe9, 0xbb,
+	0x97, 0x8e, 0xf5, 0x87, 0x85, 0x63, 0xfd, 0x75, 0xe1, 0x58, 0xdf, 0x2c, 0x1c, 0xeb, 0xf9, 0xc2,
+	0xb1, 0x5e, 0x2c, 0x1c, 0xeb, 0x5f, 0x0b, 0xc7, 0xfa, 0xf7, 0xc2, 0x59, 0xfa, 0x6e, 0xe1, 0x58,
+	0x7f, 0x7a, 0xe5, 0x2c, 0x3d, 0x7f, 0xe5, 0x2c, 0xbd, 0x78, 0xe5, 0x2c, 0x7d, 0x59, 0xf5, 0xa2,
+	0xb9, 0x7b, 0xb6, 0x22, 0x4d, 0xff, 0xfc, 0xff, 0x03, 0x00, 0x3f, 0x0c, 0x7e, 0xe6, 0xb4, 0x13,
+	0x00, 0x00,
+}
+
+func (this *AccountQueryOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountQueryOptions)
+	if !ok {
+		that2, ok := that.(AccountQueryOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OnFinalBlock != that1.OnFinalBlock {
+		return false
+	}
+	if this.HasOnStartOfEpoch != that1.HasOnStartOfEpoch {
+		return false
+	}
+	if this.OnStartOfEpoch != that1.OnStartOfEpoch {
+		return false
+	}
+	if this.HasBlockNonce != that1.HasBlockNonce {
+		return false
+	}
+	if this.BlockNonce != that1.BlockNonce {
+		return false
+	}
+	if !bytes.Equal(this.BlockHash, that1.BlockHash) {
+		return false
+	}
+	if !bytes.Equal(this.BlockRootHash, that1.BlockRootHash) {
+		return false
+	}
+	if this.HasHintEpoch != that1.HasHintEpoch {
+		return false
+	}
+	if this.HintEpoch != that1.HintEpoch {
+		return false
+	}
+	return true
+}
+func (this *AccountRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountRequest)
+	if !ok {
+		that2, ok := that.(AccountRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Options.Equal(that1.Options) {
+		return false
+	}
+	return true
+}
+func (this *BlockInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockInfo)
+	if !ok {
+		that2, ok := that.(BlockInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	if !bytes.Equal(this.RootHash, that1.RootHash) {
+		return false
+	}
+	return true
+}
+func (this *AccountReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountReply)
+	if !ok {
+		that2, ok := that.(AccountReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.Balance, that1.Balance) {
+			return false
+		}
+	}
+	if this.Username != that1.Username {
+		return false
+	}
+	if !bytes.Equal(this.Code, that1.Code) {
+		return false
+	}
+	if !bytes.Equal(this.CodeHash, that1.CodeHash) {
+		return false
+	}
+	if !bytes.Equal(this.RootHash, that1.RootHash) {
+		return false
+	}
+	if !bytes.Equal(this.CodeMetadata, that1.CodeMetadata) {
+		return false
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.DeveloperReward, that1.DeveloperReward) {
+			return false
+		}
+	}
+	if this.OwnerAddress != that1.OwnerAddress {
+		return false
+	}
+	if !this.BlockInfo.Equal(that1.BlockInfo) {
+		return false
+	}
+	return true
+}
+func (this *ESDTTokensReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ESDTTokensReply)
+	if !ok {
+		that2, ok := that.(ESDTTokensReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Tokens) != len(that1.Tokens) {
+		return false
+	}
+	for i := range this.Tokens {
+		if !this.Tokens[i].Equal(that1.Tokens[i]) {
+			return false
+		}
+	}
+	if !this.BlockInfo.Equal(that1.BlockInfo) {
+		return false
+	}
+	return true
+}
+func (this *BlockByNonceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockByNonceRequest)
+	if !ok {
+		that2, ok := that.(BlockByNonceRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.WithTxs != that1.WithTxs {
+		return false
+	}
+	if this.WithLogs != that1.WithLogs {
+		return false
+	}
+	return true
+}
+func (this *BlockByHashRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockByHashRequest)
+	if !ok {
+		that2, ok := that.(BlockByHashRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	if this.WithTxs != that1.WithTxs {
+		return false
+	}
+	if this.WithLogs != that1.WithLogs {
+		return false
+	}
+	return true
+}
+func (this *BlockReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockReply)
+	if !ok {
+		that2, ok := that.(BlockReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.Shard != that1.Shard {
+		return false
+	}
+	if this.NumTxs != that1.NumTxs {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	if !bytes.Equal(this.PrevBlockHash, that1.PrevBlockHash) {
+		return false
+	}
+	if !bytes.Equal(this.StateRootHash, that1.StateRootHash) {
+		return false
+	}
+	if this.AccumulatedFees != that1.AccumulatedFees {
+		return false
+	}
+	if this.DeveloperFees != that1.DeveloperFees {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if len(this.MiniBlocks) != len(that1.MiniBlocks) {
+		return false
+	}
+	for i := range this.MiniBlocks {
+		if !this.MiniBlocks[i].Equal(that1.MiniBlocks[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MiniBlockInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MiniBlockInfo)
+	if !ok {
+		that2, ok := that.(MiniBlockInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.SourceShard != that1.SourceShard {
+		return false
+	}
+	if this.DestinationShard != that1.DestinationShard {
+		return false
+	}
+	if len(this.Transactions) != len(that1.Transactions) {
+		return false
+	}
+	for i := range this.Transactions {
+		if !this.Transactions[i].Equal(that1.Transactions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *TransactionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransactionRequest)
+	if !ok {
+		that2, ok := that.(TransactionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	if this.WithResults != that1.WithResults {
+		return false
+	}
+	return true
+}
+func (this *TransactionInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransactionInfo)
+	if !ok {
+		that2, ok := that.(TransactionInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.SourceShard != that1.SourceShard {
+		return false
+	}
+	if this.DestinationShard != that1.DestinationShard {
+		return false
+	}
+	if this.BlockNonce != that1.BlockNonce {
+		return false
+	}
+	if !bytes.Equal(this.BlockHash, that1.BlockHash) {
+		return false
+	}
+	if !bytes.Equal(this.MiniBlockHash, that1.MiniBlockHash) {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.GasUsed != that1.GasUsed {
+		return false
+	}
+	if this.Fee != that1.Fee {
+		return false
+	}
+	if !this.Transaction.Equal(that1.Transaction) {
+		return false
+	}
+	if !this.SmartContractResult.Equal(that1.SmartContractResult) {
+		return false
+	}
+	if !this.RewardTx.Equal(that1.RewardTx) {
+		return false
+	}
+	if !this.Log.Equal(that1.Log) {
+		return false
+	}
+	if len(this.SmartContractResultsHashes) != len(that1.SmartContractResultsHashes) {
+		return false
+	}
+	for i := range this.SmartContractResultsHashes {
+		if !bytes.Equal(this.SmartContractResultsHashes[i], that1.SmartContractResultsHashes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SendTransactionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SendTransactionsRequest)
+	if !ok {
+		that2, ok := that.(SendTransactionsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Transactions) != len(that1.Transactions) {
+		return false
+	}
+	for i := range this.Transactions {
+		if !this.Transactions[i].Equal(that1.Transactions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SendTransactionsReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SendTransactionsReply)
+	if !ok {
+		that2, ok := that.(SendTransactionsReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NumSent != that1.NumSent {
+		return false
+	}
+	if len(this.Hashes) != len(that1.Hashes) {
+		return false
+	}
+	for i := range this.Hashes {
+		if !bytes.Equal(this.Hashes[i], that1.Hashes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *VMQueryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VMQueryRequest)
+	if !ok {
+		that2, ok := that.(VMQueryRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.ScAddress, that1.ScAddress) {
+		return false
+	}
+	if this.FuncName != that1.FuncName {
+		return false
+	}
+	if !bytes.Equal(this.CallerAddr, that1.CallerAddr) {
+		return false
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.CallValue, that1.CallValue) {
+			return false
+		}
+	}
+	if len(this.Arguments) != len(that1.Arguments) {
+		return false
+	}
+	for i := range this.Arguments {
+		if !bytes.Equal(this.Arguments[i], that1.Arguments[i]) {
+			return false
+		}
+	}
+	if this.ShouldBeSynced != that1.ShouldBeSynced {
+		return false
+	}
+	if this.HasBlockNonce != that1.HasBlockNonce {
+		return false
+	}
+	if this.BlockNonce != that1.BlockNonce {
+		return false
+	}
+	if !bytes.Equal(this.BlockHash, that1.BlockHash) {
+		return false
+	}
+	return true
+}
+func (this *VMQueryReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VMQueryReply)
+	if !ok {
+		that2, ok := that.(VMQueryReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.ReturnData) != len(that1.ReturnData) {
+		return false
+	}
+	for i := range this.ReturnData {
+		if !bytes.Equal(this.ReturnData[i], that1.ReturnData[i]) {
+			return false
+		}
+	}
+	if this.ReturnCode != that1.ReturnCode {
+		return false
+	}
+	if this.ReturnMessage != that1.ReturnMessage {
+		return false
+	}
+	if this.GasRemaining != that1.GasRemaining {
+		return false
+	}
+	if !this.BlockInfo.Equal(that1.BlockInfo) {
+		return false
+	}
+	return true
+}
+func (this *SubscribeBlocksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SubscribeBlocksRequest)
+	if !ok {
+		that2, ok := that.(SubscribeBlocksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.HasFromNonce != that1.HasFromNonce {
+		return false
+	}
+	if this.FromNonce != that1.FromNonce {
+		return false
+	}
+	return true
+}
+func (this *BlockNotification) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockNotification)
+	if !ok {
+		that2, ok := that.(BlockNotification)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.ShardID != that1.ShardID {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if !bytes.Equal(this.PrevHash, that1.PrevHash) {
+		return false
+	}
+	if !bytes.Equal(this.StateRootHash, that1.StateRootHash) {
+		return false
+	}
+	if this.NumTxs != that1.NumTxs {
+		return false
+	}
+	return true
+}
+func (this *AccountQueryOptions) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&grpc.AccountQueryOptions{")
+	s = append(s, "OnFinalBlock: "+fmt.Sprintf("%#v", this.OnFinalBlock)+",\n")
+	s = append(s, "HasOnStartOfEpoch: "+fmt.Sprintf("%#v", this.HasOnStartOfEpoch)+",\n")
+	s = append(s, "OnStartOfEpoch: "+fmt.Sprintf("%#v", this.OnStartOfEpoch)+",\n")
+	s = append(s, "HasBlockNonce: "+fmt.Sprintf("%#v", this.HasBlockNonce)+",\n")
+	s = append(s, "BlockNonce: "+fmt.Sprintf("%#v", this.BlockNonce)+",\n")
+	s = append(s, "BlockHash: "+fmt.Sprintf("%#v", this.BlockHash)+",\n")
+	s = append(s, "BlockRootHash: "+fmt.Sprintf("%#v", this.BlockRootHash)+",\n")
+	s = append(s, "HasHintEpoch: "+fmt.Sprintf("%#v", this.HasHintEpoch)+",\n")
+	s = append(s, "HintEpoch: "+fmt.Sprintf("%#v", this.HintEpoch)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpc.AccountRequest{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	if this.Options != nil {
+		s = append(s, "Options: "+fmt.Sprintf("%#v", this.Options)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlockInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&grpc.BlockInfo{")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "RootHash: "+fmt.Sprintf("%#v", this.RootHash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&grpc.AccountReply{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Balance: "+fmt.Sprintf("%#v", this.Balance)+",\n")
+	s = append(s, "Username: "+fmt.Sprintf("%#v", this.Username)+",\n")
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	s = append(s, "CodeHash: "+fmt.Sprintf("%#v", this.CodeHash)+",\n")
+	s = append(s, "RootHash: "+fmt.Sprintf("%#v", this.RootHash)+",\n")
+	s = append(s, "CodeMetadata: "+fmt.Sprintf("%#v", this.CodeMetadata)+",\n")
+	s = append(s, "DeveloperReward: "+fmt.Sprintf("%#v", this.DeveloperReward)+",\n")
+	s = append(s, "OwnerAddress: "+fmt.Sprintf("%#v", this.OwnerAddress)+",\n")
+	if this.BlockInfo != nil {
+		s = append(s, "BlockInfo: "+fmt.Sprintf("%#v", this.BlockInfo)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ESDTTokensReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpc.ESDTTokensReply{")
+	keysForTokens := make([]string, 0, len(this.Tokens))
+	for k, _ := range this.Tokens {
+		keysForTokens = append(keysForTokens, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTokens)
+	mapStringForTokens := "map[string]*esdt.ESDigitalToken{"
+	for _, k := range keysForTokens {
+		mapStringForTokens += fmt.Sprintf("%#v: %#v,", k, this.Tokens[k])
+	}
+	mapStringForTokens += "}"
+	if this.Tokens != nil {
+		s = append(s, "Tokens: "+mapStringForTokens+",\n")
+	}
+	if this.BlockInfo != nil {
+		s = append(s, "BlockInfo: "+fmt.Sprintf("%#v", this.BlockInfo)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlockByNonceRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&grpc.BlockByNonceRequest{")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "WithTxs: "+fmt.Sprintf("%#v", this.WithTxs)+",\n")
+	s = append(s, "WithLogs: "+fmt.Sprintf("%#v", this.WithLogs)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlockByHashRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&grpc.BlockByHashRequest{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "WithTxs: "+fmt.Sprintf("%#v", this.WithTxs)+",\n")
+	s = append(s, "WithLogs: "+fmt.Sprintf("%#v", this.WithLogs)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlockReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 17)
+	s = append(s, "&grpc.BlockReply{")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "Shard: "+fmt.Sprintf("%#v", this.Shard)+",\n")
+	s = append(s, "NumTxs: "+fmt.Sprintf("%#v", this.NumTxs)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "PrevBlockHash: "+fmt.Sprintf("%#v", this.PrevBlockHash)+",\n")
+	s = append(s, "StateRootHash: "+fmt.Sprintf("%#v", this.StateRootHash)+",\n")
+	s = append(s, "AccumulatedFees: "+fmt.Sprintf("%#v", this.AccumulatedFees)+",\n")
+	s = append(s, "DeveloperFees: "+fmt.Sprintf("%#v", this.DeveloperFees)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	if this.MiniBlocks != nil {
+		s = append(s, "MiniBlocks: "+fmt.Sprintf("%#v", this.MiniBlocks)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MiniBlockInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&grpc.MiniBlockInfo{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "SourceShard: "+fmt.Sprintf("%#v", this.SourceShard)+",\n")
+	s = append(s, "DestinationShard: "+fmt.Sprintf("%#v", this.DestinationShard)+",\n")
+	if this.Transactions != nil {
+		s = append(s, "Transactions: "+fmt.Sprintf("%#v", this.Transactions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TransactionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpc.TransactionRequest{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "WithResults: "+fmt.Sprintf("%#v", this.WithResults)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TransactionInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 22)
+	s = append(s, "&grpc.TransactionInfo{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "SourceShard: "+fmt.Sprintf("%#v", this.SourceShard)+",\n")
+	s = append(s, "DestinationShard: "+fmt.Sprintf("%#v", this.DestinationShard)+",\n")
+	s = append(s, "BlockNonce: "+fmt.Sprintf("%#v", this.BlockNonce)+",\n")
+	s = append(s, "BlockHash: "+fmt.Sprintf("%#v", this.BlockHash)+",\n")
+	s = append(s, "MiniBlockHash: "+fmt.Sprintf("%#v", this.MiniBlockHash)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "GasUsed: "+fmt.Sprintf("%#v", this.GasUsed)+",\n")
+	s = append(s, "Fee: "+fmt.Sprintf("%#v", this.Fee)+",\n")
+	if this.Transaction != nil {
+		s = append(s, "Transaction: "+fmt.Sprintf("%#v", this.Transaction)+",\n")
+	}
+	if this.SmartContractResult != nil {
+		s = append(s, "SmartContractResult: "+fmt.Sprintf("%#v", this.SmartContractResult)+",\n")
+	}
+	if this.RewardTx != nil {
+		s = append(s, "RewardTx: "+fmt.Sprintf("%#v", this.RewardTx)+",\n")
+	}
+	if this.Log != nil {
+		s = append(s, "Log: "+fmt.Sprintf("%#v", this.Log)+",\n")
+	}
+	s = append(s, "SmartContractResultsHashes: "+fmt.Sprintf("%#v", this.SmartContractResultsHashes)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SendTransactionsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpc.SendTransactionsRequest{")
+	if this.Transactions != nil {
+		s = append(s, "Transactions: "+fmt.Sprintf("%#v", this.Transactions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SendTransactionsReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpc.SendTransactionsReply{")
+	s = append(s, "NumSent: "+fmt.Sprintf("%#v", this.NumSent)+",\n")
+	keysForHashes := make([]uint32, 0, len(this.Hashes))
+	for k, _ := range this.Hashes {
+		keysForHashes = append(keysForHashes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Uint32s(keysForHashes)
+	mapStringForHashes := "map[uint32][]byte{"
+	for _, k := range keysForHashes {
+		mapStringForHashes += fmt.Sprintf("%#v: %#v,", k, this.Hashes[k])
+	}
+	mapStringForHashes += "}"
+	if this.Hashes != nil {
+		s = append(s, "Hashes: "+mapStringForHashes+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VMQueryRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&grpc.VMQueryRequest{")
+	s = append(s, "ScAddress: "+fmt.Sprintf("%#v", this.ScAddress)+",\n")
+	s = append(s, "FuncName: "+fmt.Sprintf("%#v", this.FuncName)+",\n")
+	s = append(s, "CallerAddr: "+fmt.Sprintf("%#v", this.CallerAddr)+",\n")
+	s = append(s, "CallValue: "+fmt.Sprintf("%#v", this.CallValue)+",\n")
+	s = append(s, "Arguments: "+fmt.Sprintf("%#v", this.Arguments)+",\n")
+	s = append(s, "ShouldBeSynced: "+fmt.Sprintf("%#v", this.ShouldBeSynced)+",\n")
+	s = append(s, "HasBlockNonce: "+fmt.Sprintf("%#v", this.HasBlockNonce)+",\n")
+	s = append(s, "BlockNonce: "+fmt.Sprintf("%#v", this.BlockNonce)+",\n")
+	s = append(s, "BlockHash: "+fmt.Sprintf("%#v", this.BlockHash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VMQueryReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&grpc.VMQueryReply{")
+	s = append(s, "ReturnData: "+fmt.Sprintf("%#v", this.ReturnData)+",\n")
+	s = append(s, "ReturnCode: "+fmt.Sprintf("%#v", this.ReturnCode)+",\n")
+	s = append(s, "ReturnMessage: "+fmt.Sprintf("%#v", this.ReturnMessage)+",\n")
+	s = append(s, "GasRemaining: "+fmt.Sprintf("%#v", this.GasRemaining)+",\n")
+	if this.BlockInfo != nil {
+		s = append(s, "BlockInfo: "+fmt.Sprintf("%#v", this.BlockInfo)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SubscribeBlocksRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpc.SubscribeBlocksRequest{")
+	s = append(s, "HasFromNonce: "+fmt.Sprintf("%#v", this.HasFromNonce)+",\n")
+	s = append(s, "FromNonce: "+fmt.Sprintf("%#v", this.FromNonce)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlockNotification) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&grpc.BlockNotification{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "ShardID: "+fmt.Sprintf("%#v", this.ShardID)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "PrevHash: "+fmt.Sprintf("%#v", this.PrevHash)+",\n")
+	s = append(s, "StateRootHash: "+fmt.Sprintf("%#v", this.StateRootHash)+",\n")
+	s = append(s, "NumTxs: "+fmt.Sprintf("%#v", this.NumTxs)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringNodeApi(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// NodeAPIClient is the client API for NodeAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NodeAPIClient interface {
+	// GetAccount returns an account
+	GetAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountReply, error)
+	// GetESDTTokens returns all the ESDT tokens held by an account
+	GetESDTTokens(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*ESDTTokensReply, error)
+	// GetBlockByNonce returns the block with the given nonce
+	GetBlockByNonce(ctx context.Context, in *BlockByNonceRequest, opts ...grpc.CallOption) (*BlockReply, error)
+	// GetBlockByHash returns the block with the given hash
+	GetBlockByHash(ctx context.Context, in *BlockByHashRequest, opts ...grpc.CallOption) (*BlockReply, error)
+	// GetTransaction returns a transaction, a smart contract result or a reward transaction
+	GetTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*TransactionInfo, error)
+	// SendTransactions sends the valid transactions out of the provided ones
+	SendTransactions(ctx context.Context, in *SendTransactionsRequest, opts ...grpc.CallOption) (*SendTransactionsReply, error)
+	// QueryVM executes a smart contract query
+	QueryVM(ctx context.Context, in *VMQueryRequest, opts ...grpc.CallOption) (*VMQueryReply, error)
+	// SubscribeBlocks streams the notifications of the finalized blocks
+	SubscribeBlocks(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (NodeAPI_SubscribeBlocksClient, error)
+}
+
+type nodeAPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNodeAPIClient(cc *grpc.ClientConn) NodeAPIClient {
+	return &nodeAPIClient{cc}
+}
+
+func (c *nodeAPIClient) GetAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountReply, error) {
+	out := new(AccountReply)
+	err := c.cc.Invoke(ctx, "/proto.NodeAPI/GetAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeAPIClient) GetESDTTokens(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*ESDTTokensReply, error) {
+	out := new(ESDTTokensReply)
+	err := c.cc.Invoke(ctx, "/proto.NodeAPI/GetESDTTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeAPIClient) GetBlockByNonce(ctx context.Context, in *BlockByNonceRequest, opts ...grpc.CallOption) (*BlockReply, error) {
+	out := new(BlockReply)
+	err := c.cc.Invoke(ctx, "/proto.NodeAPI/GetBlockByNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeAPIClient) GetBlockByHash(ctx context.Context, in *BlockByHashRequest, opts ...grpc.CallOption) (*BlockReply, error) {
+	out := new(BlockReply)
+	err := c.cc.Invoke(ctx, "/proto.NodeAPI/GetBlockByHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeAPIClient) GetTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*TransactionInfo, error) {
+	out := new(TransactionInfo)
+	err := c.cc.Invoke(ctx, "/proto.NodeAPI/GetTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeAPIClient) SendTransactions(ctx context.Context, in *SendTransactionsRequest, opts ...grpc.CallOption) (*SendTransactionsReply, error) {
+	out := new(SendTransactionsReply)
+	err := c.cc.Invoke(ctx, "/proto.NodeAPI/SendTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeAPIClient) QueryVM(ctx context.Context, in *VMQueryRequest, opts ...grpc.CallOption) (*VMQueryReply, error) {
+	out := new(VMQueryReply)
+	err := c.cc.Invoke(ctx, "/proto.NodeAPI/QueryVM", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeAPIClient) SubscribeBlocks(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (NodeAPI_SubscribeBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NodeAPI_serviceDesc.Streams[0], "/proto.NodeAPI/SubscribeBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nodeAPISubscribeBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NodeAPI_SubscribeBlocksClient interface {
+	Recv() (*BlockNotification, error)
+	grpc.ClientStream
+}
+
+type nodeAPISubscribeBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *nodeAPISubscribeBlocksClient) Recv() (*BlockNotification, error) {
+	m := new(BlockNotification)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// NodeAPIServer is the server API for NodeAPI service.
+type NodeAPIServer interface {
+	// GetAccount returns an account
+	GetAccount(context.Context, *AccountRequest) (*AccountReply, error)
+	// GetESDTTokens returns all the ESDT tokens held by an account
+	GetESDTTokens(context.Context, *AccountRequest) (*ESDTTokensReply, error)
+	// GetBlockByNonce returns the block with the given nonce
+	GetBlockByNonce(context.Context, *BlockByNonceRequest) (*BlockReply, error)
+	// GetBlockByHash returns the block with the given hash
+	GetBlockByHash(context.Context, *BlockByHashRequest) (*BlockReply, error)
+	// GetTransaction returns a transaction, a smart contract result or a reward transaction
+	GetTransaction(context.Context, *TransactionRequest) (*TransactionInfo, error)
+	// SendTransactions sends the valid transactions out of the provided ones
+	SendTransactions(context.Context, *SendTransactionsRequest) (*SendTransactionsReply, error)
+	// QueryVM executes a smart contract query
+	QueryVM(context.Context, *VMQueryRequest) (*VMQueryReply, error)
+	// SubscribeBlocks streams the notifications of the finalized blocks
+	SubscribeBlocks(*SubscribeBlocksRequest, NodeAPI_SubscribeBlocksServer) error
+}
+
+// UnimplementedNodeAPIServer can be embedded to have forward compatible implementations.
+type UnimplementedNodeAPIServer struct {
+}
+
+func (*UnimplementedNodeAPIServer) GetAccount(ctx context.Context, req *AccountRequest) (*AccountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (*UnimplementedNodeAPIServer) GetESDTTokens(ctx context.Context, req *AccountRequest) (*ESDTTokensReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetESDTTokens not implemented")
+}
+func (*UnimplementedNodeAPIServer) GetBlockByNonce(ctx context.Context, req *BlockByNonceRequest) (*BlockReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByNonce not implemented")
+}
+func (*UnimplementedNodeAPIServer) GetBlockByHash(ctx context.Context, req *BlockByHashRequest) (*BlockReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByHash not implemented")
+}
+func (*UnimplementedNodeAPIServer) GetTransaction(ctx context.Context, req *TransactionRequest) (*TransactionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (*UnimplementedNodeAPIServer) SendTransactions(ctx context.Context, req *SendTransactionsRequest) (*SendTransactionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendTransactions not implemented")
+}
+func (*UnimplementedNodeAPIServer) QueryVM(ctx context.Context, req *VMQueryRequest) (*VMQueryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryVM not implemented")
+}
+func (*UnimplementedNodeAPIServer) SubscribeBlocks(req *SubscribeBlocksRequest, srv NodeAPI_SubscribeBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeBlocks not implemented")
+}
+
+func RegisterNodeAPIServer(s *grpc.Server, srv NodeAPIServer) {
+	s.RegisterService(&_NodeAPI_serviceDesc, srv)
+}
+
+func _NodeAPI_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeAPIServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.NodeAPI/GetAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeAPIServer).GetAccount(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeAPI_GetESDTTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeAPIServer).GetESDTTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.NodeAPI/GetESDTTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeAPIServer).GetESDTTokens(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeAPI_GetBlockByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockByNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeAPIServer).GetBlockByNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.NodeAPI/GetBlockByNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeAPIServer).GetBlockByNonce(ctx, req.(*BlockByNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeAPI_GetBlockByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeAPIServer).GetBlockByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.NodeAPI/GetBlockByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeAPIServer).GetBlockByHash(ctx, req.(*BlockByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeAPI_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeAPIServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.NodeAPI/GetTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeAPIServer).GetTransaction(ctx, req.(*TransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeAPI_SendTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeAPIServer).SendTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.NodeAPI/SendTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeAPIServer).SendTransactions(ctx, req.(*SendTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeAPI_QueryVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeAPIServer).QueryVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.NodeAPI/QueryVM",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeAPIServer).QueryVM(ctx, req.(*VMQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeAPI_SubscribeBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NodeAPIServer).SubscribeBlocks(m, &nodeAPISubscribeBlocksServer{stream})
+}
+
+type NodeAPI_SubscribeBlocksServer interface {
+	Send(*BlockNotification) error
+	grpc.ServerStream
+}
+
+type nodeAPISubscribeBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *nodeAPISubscribeBlocksServer) Send(m *BlockNotification) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _NodeAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.NodeAPI",
+	HandlerType: (*NodeAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAccount",
+			Handler:    _NodeAPI_GetAccount_Handler,
+		},
+		{
+			MethodName: "GetESDTTokens",
+			Handler:    _NodeAPI_GetESDTTokens_Handler,
+		},
+		{
+			MethodName: "GetBlockByNonce",
+			Handler:    _NodeAPI_GetBlockByNonce_Handler,
+		},
+		{
+			MethodName: "GetBlockByHash",
+			Handler:    _NodeAPI_GetBlockByHash_Handler,
+		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _NodeAPI_GetTransaction_Handler,
+		},
+		{
+			MethodName: "SendTransactions",
+			Handler:    _NodeAPI_SendTransactions_Handler,
+		},
+		{
+			MethodName: "QueryVM",
+			Handler:    _NodeAPI_QueryVM_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeBlocks",
+			Handler:       _NodeAPI_SubscribeBlocks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "nodeApi.proto",
+}
+
+func (m *AccountQueryOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountQueryOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountQueryOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HintEpoch != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.HintEpoch))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.HasHintEpoch {
+		i--
+		if m.HasHintEpoch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.BlockRootHash) > 0 {
+		i -= len(m.BlockRootHash)
+		copy(dAtA[i:], m.BlockRootHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.BlockRootHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlockNonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.BlockNonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.HasBlockNonce {
+		i--
+		if m.HasBlockNonce {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OnStartOfEpoch != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.OnStartOfEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HasOnStartOfEpoch {
+		i--
+		if m.HasOnStartOfEpoch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OnFinalBlock {
+		i--
+		if m.OnFinalBlock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockInfo != nil {
+		{
+			size, err := m.BlockInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.DeveloperReward)
+		i -= size
+		if _, err := __caster.MarshalTo(m.DeveloperReward, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintNodeApi(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.CodeMetadata) > 0 {
+		i -= len(m.CodeMetadata)
+		copy(dAtA[i:], m.CodeMetadata)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.CodeMetadata)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.Balance)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Balance, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintNodeApi(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Nonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ESDTTokensReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ESDTTokensReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ESDTTokensReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockInfo != nil {
+		{
+			size, err := m.BlockInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tokens) > 0 {
+		keysForTokens := make([]string, 0, len(m.Tokens))
+		for k := range m.Tokens {
+			keysForTokens = append(keysForTokens, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForTokens)
+		for iNdEx := len(keysForTokens) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Tokens[string(keysForTokens[iNdEx])]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintNodeApi(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(keysForTokens[iNdEx])
+			copy(dAtA[i:], keysForTokens[iNdEx])
+			i = encodeVarintNodeApi(dAtA, i, uint64(len(keysForTokens[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintNodeApi(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockByNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockByNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockByNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithLogs {
+		i--
+		if m.WithLogs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WithTxs {
+		i--
+		if m.WithTxs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockByHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockByHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithLogs {
+		i--
+		if m.WithLogs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WithTxs {
+		i--
+		if m.WithTxs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MiniBlocks) > 0 {
+		for iNdEx := len(m.MiniBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MiniBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNodeApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.DeveloperFees) > 0 {
+		i -= len(m.DeveloperFees)
+		copy(dAtA[i:], m.DeveloperFees)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.DeveloperFees)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.AccumulatedFees) > 0 {
+		i -= len(m.AccumulatedFees)
+		copy(dAtA[i:], m.AccumulatedFees)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.AccumulatedFees)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.StateRootHash) > 0 {
+		i -= len(m.StateRootHash)
+		copy(dAtA[i:], m.StateRootHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.StateRootHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PrevBlockHash) > 0 {
+		i -= len(m.PrevBlockHash)
+		copy(dAtA[i:], m.PrevBlockHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.PrevBlockHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.NumTxs != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.NumTxs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Shard != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Shard))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Round != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MiniBlockInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MiniBlockInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MiniBlockInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNodeApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.DestinationShard != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.DestinationShard))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SourceShard != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.SourceShard))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithResults {
+		i--
+		if m.WithResults {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SmartContractResultsHashes) > 0 {
+		for iNdEx := len(m.SmartContractResultsHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SmartContractResultsHashes[iNdEx])
+			copy(dAtA[i:], m.SmartContractResultsHashes[iNdEx])
+			i = encodeVarintNodeApi(dAtA, i, uint64(len(m.SmartContractResultsHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.Log != nil {
+		{
+			size, err := m.Log.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.RewardTx != nil {
+		{
+			size, err := m.RewardTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.SmartContractResult != nil {
+		{
+			size, err := m.SmartContractResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Transaction != nil {
+		{
+			size, err := m.Transaction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Round != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.MiniBlockHash) > 0 {
+		i -= len(m.MiniBlockHash)
+		copy(dAtA[i:], m.MiniBlockHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.MiniBlockHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.BlockNonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.BlockNonce))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DestinationShard != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.DestinationShard))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SourceShard != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.SourceShard))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendTransactionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendTransactionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendTransactionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNodeApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendTransactionsReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendTransactionsReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendTransactionsReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hashes) > 0 {
+		keysForHashes := make([]uint32, 0, len(m.Hashes))
+		for k := range m.Hashes {
+			keysForHashes = append(keysForHashes, uint32(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Uint32s(keysForHashes)
+		for iNdEx := len(keysForHashes) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Hashes[uint32(keysForHashes[iNdEx])]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintNodeApi(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintNodeApi(dAtA, i, uint64(keysForHashes[iNdEx]))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintNodeApi(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.NumSent != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.NumSent))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VMQueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VMQueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VMQueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.BlockNonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.BlockNonce))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.HasBlockNonce {
+		i--
+		if m.HasBlockNonce {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ShouldBeSynced {
+		i--
+		if m.ShouldBeSynced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Arguments) > 0 {
+		for iNdEx := len(m.Arguments) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Arguments[iNdEx])
+			copy(dAtA[i:], m.Arguments[iNdEx])
+			i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Arguments[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.CallValue)
+		i -= size
+		if _, err := __caster.MarshalTo(m.CallValue, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintNodeApi(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.CallerAddr) > 0 {
+		i -= len(m.CallerAddr)
+		copy(dAtA[i:], m.CallerAddr)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.CallerAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FuncName) > 0 {
+		i -= len(m.FuncName)
+		copy(dAtA[i:], m.FuncName)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.FuncName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScAddress) > 0 {
+		i -= len(m.ScAddress)
+		copy(dAtA[i:], m.ScAddress)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.ScAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VMQueryReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VMQueryReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VMQueryReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockInfo != nil {
+		{
+			size, err := m.BlockInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.GasRemaining != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.GasRemaining))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ReturnMessage) > 0 {
+		i -= len(m.ReturnMessage)
+		copy(dAtA[i:], m.ReturnMessage)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.ReturnMessage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ReturnCode) > 0 {
+		i -= len(m.ReturnCode)
+		copy(dAtA[i:], m.ReturnCode)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.ReturnCode)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReturnData) > 0 {
+		for iNdEx := len(m.ReturnData) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReturnData[iNdEx])
+			copy(dAtA[i:], m.ReturnData[iNdEx])
+			i = encodeVarintNodeApi(dAtA, i, uint64(len(m.ReturnData[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FromNonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.FromNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HasFromNonce {
+		i--
+		if m.HasFromNonce {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockNotification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockNotification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockNotification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumTxs != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.NumTxs))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.StateRootHash) > 0 {
+		i -= len(m.StateRootHash)
+		copy(dAtA[i:], m.StateRootHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.StateRootHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PrevHash) > 0 {
+		i -= len(m.PrevHash)
+		copy(dAtA[i:], m.PrevHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.PrevHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ShardID != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Round != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNodeApi(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNodeApi(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccountQueryOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OnFinalBlock {
+		n += 2
+	}
+	if m.HasOnStartOfEpoch {
+		n += 2
+	}
+	if m.OnStartOfEpoch != 0 {
+		n += 1 + sovNodeApi(uint64(m.OnStartOfEpoch))
+	}
+	if m.HasBlockNonce {
+		n += 2
+	}
+	if m.BlockNonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.BlockNonce))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.BlockRootHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.HasHintEpoch {
+		n += 2
+	}
+	if m.HintEpoch != 0 {
+		n += 1 + sovNodeApi(uint64(m.HintEpoch))
+	}
+	return n
+}
+
+func (m *AccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.Nonce))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.Nonce))
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.Balance)
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.CodeMetadata)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.DeveloperReward)
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.BlockInfo != nil {
+		l = m.BlockInfo.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ESDTTokensReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for k, v := range m.Tokens {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovNodeApi(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovNodeApi(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovNodeApi(uint64(mapEntrySize))
+		}
+	}
+	if m.BlockInfo != nil {
+		l = m.BlockInfo.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockByNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.Nonce))
+	}
+	if m.WithTxs {
+		n += 2
+	}
+	if m.WithLogs {
+		n += 2
+	}
+	return n
+}
+
+func (m *BlockByHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.WithTxs {
+		n += 2
+	}
+	if m.WithLogs {
+		n += 2
+	}
+	return n
+}
+
+func (m *BlockReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.Nonce))
+	}
+	if m.Round != 0 {
+		n += 1 + sovNodeApi(uint64(m.Round))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovNodeApi(uint64(m.Epoch))
+	}
+	if m.Shard != 0 {
+		n += 1 + sovNodeApi(uint64(m.Shard))
+	}
+	if m.NumTxs != 0 {
+		n += 1 + sovNodeApi(uint64(m.NumTxs))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.PrevBlockHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.StateRootHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.AccumulatedFees)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.DeveloperFees)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovNodeApi(uint64(m.Timestamp))
+	}
+	if len(m.MiniBlocks) > 0 {
+		for _, e := range m.MiniBlocks {
+			l = e.Size()
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MiniBlockInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.SourceShard != 0 {
+		n += 1 + sovNodeApi(uint64(m.SourceShard))
+	}
+	if m.DestinationShard != 0 {
+		n += 1 + sovNodeApi(uint64(m.DestinationShard))
+	}
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.WithResults {
+		n += 2
+	}
+	return n
+}
+
+func (m *TransactionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.SourceShard != 0 {
+		n += 1 + sovNodeApi(uint64(m.SourceShard))
+	}
+	if m.DestinationShard != 0 {
+		n += 1 + sovNodeApi(uint64(m.DestinationShard))
+	}
+	if m.BlockNonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.BlockNonce))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.MiniBlockHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovNodeApi(uint64(m.Epoch))
+	}
+	if m.Round != 0 {
+		n += 1 + sovNodeApi(uint64(m.Round))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovNodeApi(uint64(m.Timestamp))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovNodeApi(uint64(m.GasUsed))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.Transaction != nil {
+		l = m.Transaction.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.SmartContractResult != nil {
+		l = m.SmartContractResult.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.RewardTx != nil {
+		l = m.RewardTx.Size()
+		n += 2 + l + sovNodeApi(uint64(l))
+	}
+	if m.Log != nil {
+		l = m.Log.Size()
+		n += 2 + l + sovNodeApi(uint64(l))
+	}
+	if len(m.SmartContractResultsHashes) > 0 {
+		for _, b := range m.SmartContractResultsHashes {
+			l = len(b)
+			n += 2 + l + sovNodeApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SendTransactionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SendTransactionsReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumSent != 0 {
+		n += 1 + sovNodeApi(uint64(m.NumSent))
+	}
+	if len(m.Hashes) > 0 {
+		for k, v := range m.Hashes {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovNodeApi(uint64(len(v)))
+			}
+			mapEntrySize := 1 + sovNodeApi(uint64(k)) + l
+			n += mapEntrySize + 1 + sovNodeApi(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *VMQueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScAddress)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.FuncName)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.CallerAddr)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.CallValue)
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if len(m.Arguments) > 0 {
+		for _, b := range m.Arguments {
+			l = len(b)
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	if m.ShouldBeSynced {
+		n += 2
+	}
+	if m.HasBlockNonce {
+		n += 2
+	}
+	if m.BlockNonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.BlockNonce))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *VMQueryReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReturnData) > 0 {
+		for _, b := range m.ReturnData {
+			l = len(b)
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	l = len(m.ReturnCode)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.ReturnMessage)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.GasRemaining != 0 {
+		n += 1 + sovNodeApi(uint64(m.GasRemaining))
+	}
+	if m.BlockInfo != nil {
+		l = m.BlockInfo.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *SubscribeBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasFromNonce {
+		n += 2
+	}
+	if m.FromNonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.FromNonce))
+	}
+	return n
+}
+
+func (m *BlockNotification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.Nonce))
+	}
+	if m.Round != 0 {
+		n += 1 + sovNodeApi(uint64(m.Round))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovNodeApi(uint64(m.Epoch))
+	}
+	if m.ShardID != 0 {
+		n += 1 + sovNodeApi(uint64(m.ShardID))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovNodeApi(uint64(m.Timestamp))
+	}
+	l = len(m.PrevHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.StateRootHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.NumTxs != 0 {
+		n += 1 + sovNodeApi(uint64(m.NumTxs))
+	}
+	return n
+}
+
+func sovNodeApi(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNodeApi(x uint64) (n int) {
+	return sovNodeApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AccountQueryOptions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountQueryOptions{`,
+		`OnFinalBlock:` + fmt.Sprintf("%v", this.OnFinalBlock) + `,`,
+		`HasOnStartOfEpoch:` + fmt.Sprintf("%v", this.HasOnStartOfEpoch) + `,`,
+		`OnStartOfEpoch:` + fmt.Sprintf("%v", this.OnStartOfEpoch) + `,`,
+		`HasBlockNonce:` + fmt.Sprintf("%v", this.HasBlockNonce) + `,`,
+		`BlockNonce:` + fmt.Sprintf("%v", this.BlockNonce) + `,`,
+		`BlockHash:` + fmt.Sprintf("%v", this.BlockHash) + `,`,
+		`BlockRootHash:` + fmt.Sprintf("%v", this.BlockRootHash) + `,`,
+		`HasHintEpoch:` + fmt.Sprintf("%v", this.HasHintEpoch) + `,`,
+		`HintEpoch:` + fmt.Sprintf("%v", this.HintEpoch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountRequest{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Options:` + strings.Replace(this.Options.String(), "AccountQueryOptions", "AccountQueryOptions", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlockInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlockInfo{`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`RootHash:` + fmt.Sprintf("%v", this.RootHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountReply{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Balance:` + fmt.Sprintf("%v", this.Balance) + `,`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`CodeHash:` + fmt.Sprintf("%v", this.CodeHash) + `,`,
+		`RootHash:` + fmt.Sprintf("%v", this.RootHash) + `,`,
+		`CodeMetadata:` + fmt.Sprintf("%v", this.CodeMetadata) + `,`,
+		`DeveloperReward:` + fmt.Sprintf("%v", this.DeveloperReward) + `,`,
+		`OwnerAddress:` + fmt.Sprintf("%v", this.OwnerAddress) + `,`,
+		`BlockInfo:` + strings.Replace(this.BlockInfo.String(), "BlockInfo", "BlockInfo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ESDTTokensReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForTokens := make([]string, 0, len(this.Tokens))
+	for k, _ := range this.Tokens {
+		keysForTokens = append(keysForTokens, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTokens)
+	mapStringForTokens := "map[string]*esdt.ESDigitalToken{"
+	for _, k := range keysForTokens {
+		mapStringForTokens += fmt.Sprintf("%v: %v,", k, this.Tokens[k])
+	}
+	mapStringForTokens += "}"
+	s := strings.Join([]string{`&ESDTTokensReply{`,
+		`Tokens:` + mapStringForTokens + `,`,
+		`BlockInfo:` + strings.Replace(this.BlockInfo.String(), "BlockInfo", "BlockInfo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlockByNonceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlockByNonceRequest{`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`WithTxs:` + fmt.Sprintf("%v", this.WithTxs) + `,`,
+		`WithLogs:` + fmt.Sprintf("%v", this.WithLogs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlockByHashRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlockByHashRequest{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`WithTxs:` + fmt.Sprintf("%v", this.WithTxs) + `,`,
+		`WithLogs:` + fmt.Sprintf("%v", this.WithLogs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlockReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMiniBlocks := "[]*MiniBlockInfo{"
+	for _, f := range this.MiniBlocks {
+		repeatedStringForMiniBlocks += strings.Replace(f.String(), "MiniBlockInfo", "MiniBlockInfo", 1) + ","
+	}
+	repeatedStringForMiniBlocks += "}"
+	s := strings.Join([]string{`&BlockReply{`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`Shard:` + fmt.Sprintf("%v", this.Shard) + `,`,
+		`NumTxs:` + fmt.Sprintf("%v", this.NumTxs) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`PrevBlockHash:` + fmt.Sprintf("%v", this.PrevBlockHash) + `,`,
+		`StateRootHash:` + fmt.Sprintf("%v", this.StateRootHash) + `,`,
+		`AccumulatedFees:` + fmt.Sprintf("%v", this.AccumulatedFees) + `,`,
+		`DeveloperFees:` + fmt.Sprintf("%v", this.DeveloperFees) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`MiniBlocks:` + repeatedStringForMiniBlocks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MiniBlockInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTransactions := "[]*TransactionInfo{"
+	for _, f := range this.Transactions {
+		repeatedStringForTransactions += strings.Replace(f.String(), "TransactionInfo", "TransactionInfo", 1) + ","
+	}
+	repeatedStringForTransactions += "}"
+	s := strings.Join([]string{`&MiniBlockInfo{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`SourceShard:` + fmt.Sprintf("%v", this.SourceShard) + `,`,
+		`DestinationShard:` + fmt.Sprintf("%v", this.DestinationShard) + `,`,
+		`Transactions:` + repeatedStringForTransactions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TransactionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransactionRequest{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`WithResults:` + fmt.Sprintf("%v", this.WithResults) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TransactionInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransactionInfo{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`SourceShard:` + fmt.Sprintf("%v", this.SourceShard) + `,`,
+		`DestinationShard:` + fmt.Sprintf("%v", this.DestinationShard) + `,`,
+		`BlockNonce:` + fmt.Sprintf("%v", this.BlockNonce) + `,`,
+		`BlockHash:` + fmt.Sprintf("%v", this.BlockHash) + `,`,
+		`MiniBlockHash:` + fmt.Sprintf("%v", this.MiniBlockHash) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`GasUsed:` + fmt.Sprintf("%v", this.GasUsed) + `,`,
+		`Fee:` + fmt.Sprintf("%v", this.Fee) + `,`,
+		`Transaction:` + strings.Replace(fmt.Sprintf("%v", this.Transaction), "Transaction", "transaction.Transaction", 1) + `,`,
+		`SmartContractResult:` + strings.Replace(fmt.Sprintf("%v", this.SmartContractResult), "SmartContractResult", "smartContractResult.SmartContractResult", 1) + `,`,
+		`RewardTx:` + strings.Replace(fmt.Sprintf("%v", this.RewardTx), "RewardTx", "rewardTx.RewardTx", 1) + `,`,
+		`Log:` + strings.Replace(fmt.Sprintf("%v", this.Log), "Log", "transaction.Log", 1) + `,`,
+		`SmartContractResultsHashes:` + fmt.Sprintf("%v", this.SmartContractResultsHashes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SendTransactionsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTransactions := "[]*Transaction{"
+	for _, f := range this.Transactions {
+		repeatedStringForTransactions += strings.Replace(fmt.Sprintf("%v", f), "Transaction", "transaction.Transaction", 1) + ","
+	}
+	repeatedStringForTransactions += "}"
+	s := strings.Join([]string{`&SendTransactionsRequest{`,
+		`Transactions:` + repeatedStringForTransactions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SendTransactionsReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHashes := make([]uint32, 0, len(this.Hashes))
+	for k, _ := range this.Hashes {
+		keysForHashes = append(keysForHashes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Uint32s(keysForHashes)
+	mapStringForHashes := "map[uint32][]byte{"
+	for _, k := range keysForHashes {
+		mapStringForHashes += fmt.Sprintf("%v: %v,", k, this.Hashes[k])
+	}
+	mapStringForHashes += "}"
+	s := strings.Join([]string{`&SendTransactionsReply{`,
+		`NumSent:` + fmt.Sprintf("%v", this.NumSent) + `,`,
+		`Hashes:` + mapStringForHashes + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VMQueryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VMQueryRequest{`,
+		`ScAddress:` + fmt.Sprintf("%v", this.ScAddress) + `,`,
+		`FuncName:` + fmt.Sprintf("%v", this.FuncName) + `,`,
+		`CallerAddr:` + fmt.Sprintf("%v", this.CallerAddr) + `,`,
+		`CallValue:` + fmt.Sprintf("%v", this.CallValue) + `,`,
+		`Arguments:` + fmt.Sprintf("%v", this.Arguments) + `,`,
+		`ShouldBeSynced:` + fmt.Sprintf("%v", this.ShouldBeSynced) + `,`,
+		`HasBlockNonce:` + fmt.Sprintf("%v", this.HasBlockNonce) + `,`,
+		`BlockNonce:` + fmt.Sprintf("%v", this.BlockNonce) + `,`,
+		`BlockHash:` + fmt.Sprintf("%v", this.BlockHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VMQueryReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VMQueryReply{`,
+		`ReturnData:` + fmt.Sprintf("%v", this.ReturnData) + `,`,
+		`ReturnCode:` + fmt.Sprintf("%v", this.ReturnCode) + `,`,
+		`ReturnMessage:` + fmt.Sprintf("%v", this.ReturnMessage) + `,`,
+		`GasRemaining:` + fmt.Sprintf("%v", this.GasRemaining) + `,`,
+		`BlockInfo:` + strings.Replace(this.BlockInfo.String(), "BlockInfo", "BlockInfo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SubscribeBlocksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SubscribeBlocksRequest{`,
+		`HasFromNonce:` + fmt.Sprintf("%v", this.HasFromNonce) + `,`,
+		`FromNonce:` + fmt.Sprintf("%v", this.FromNonce) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlockNotification) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlockNotification{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`ShardID:` + fmt.Sprintf("%v", this.ShardID) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`PrevHash:` + fmt.Sprintf("%v", this.PrevHash) + `,`,
+		`StateRootHash:` + fmt.Sprintf("%v", this.StateRootHash) + `,`,
+		`NumTxs:` + fmt.Sprintf("%v", this.NumTxs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringNodeApi(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AccountQueryOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountQueryOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountQueryOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnFinalBlock", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnFinalBlock = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasOnStartOfEpoch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasOnStartOfEpoch = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnStartOfEpoch", wireType)
+			}
+			m.OnStartOfEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OnStartOfEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasBlockNonce", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasBlockNonce = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNonce", wireType)
+			}
+			m.BlockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRootHash = append(m.BlockRootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRootHash == nil {
+				m.BlockRootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasHintEpoch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasHintEpoch = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HintEpoch", wireType)
+			}
+			m.HintEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HintEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &AccountQueryOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RootHash == nil {
+				m.RootHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Balance = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RootHash == nil {
+				m.RootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeMetadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeMetadata = append(m.CodeMetadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeMetadata == nil {
+				m.CodeMetadata = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperReward", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.DeveloperReward = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockInfo == nil {
+				m.BlockInfo = &BlockInfo{}
+			}
+			if err := m.BlockInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ESDTTokensReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ESDTTokensReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ESDTTokensReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tokens == nil {
+				m.Tokens = make(map[string]*esdt.ESDigitalToken)
+			}
+			var mapkey string
+			var mapvalue *esdt.ESDigitalToken
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNodeApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNodeApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthNodeApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthNodeApi
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNodeApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthNodeApi
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthNodeApi
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &esdt.ESDigitalToken{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipNodeApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthNodeApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Tokens[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockInfo == nil {
+				m.BlockInfo = &BlockInfo{}
+			}
+			if err := m.BlockInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockByNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockByNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithTxs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithTxs = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithLogs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithLogs = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockByHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockByHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockByHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithTxs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithTxs = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithLogs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithLogs = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTxs", wireType)
+			}
+			m.NumTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTxs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevBlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevBlockHash = append(m.PrevBlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrevBlockHash == nil {
+				m.PrevBlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRootHash = append(m.StateRootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateRootHash == nil {
+				m.StateRootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccumulatedFees = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeveloperFees = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiniBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MiniBlocks = append(m.MiniBlocks, &MiniBlockInfo{})
+			if err := m.MiniBlocks[len(m.MiniBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MiniBlockInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MiniBlockInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MiniBlockInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceShard", wireType)
+			}
+			m.SourceShard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceShard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationShard", wireType)
+			}
+			m.DestinationShard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationShard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &TransactionInfo{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithResults", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithResults = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceShard", wireType)
+			}
+			m.SourceShard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceShard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationShard", wireType)
+			}
+			m.DestinationShard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationShard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNonce", wireType)
+			}
+			m.BlockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiniBlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MiniBlockHash = append(m.MiniBlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MiniBlockHash == nil {
+				m.MiniBlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transaction == nil {
+				m.Transaction = &transaction.Transaction{}
+			}
+			if err := m.Transaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmartContractResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SmartContractResult == nil {
+				m.SmartContractResult = &smartContractResult.SmartContractResult{}
+			}
+			if err := m.SmartContractResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RewardTx == nil {
+				m.RewardTx = &rewardTx.RewardTx{}
+			}
+			if err := m.RewardTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Log == nil {
+				m.Log = &transaction.Log{}
+			}
+			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmartContractResultsHashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SmartContractResultsHashes = append(m.SmartContractResultsHashes, make([]byte, postIndex-iNdEx))
+			copy(m.SmartContractResultsHashes[len(m.SmartContractResultsHashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendTransactionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendTransactionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendTransactionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &transaction.Transaction{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendTransactionsReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendTransactionsReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendTransactionsReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSent", wireType)
+			}
+			m.NumSent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumSent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hashes == nil {
+				m.Hashes = make(map[uint32][]byte)
+			}
+			var mapkey uint32
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNodeApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNodeApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNodeApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthNodeApi
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthNodeApi
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipNodeApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthNodeApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Hashes[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VMQueryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VMQueryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VMQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScAddress = append(m.ScAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ScAddress == nil {
+				m.ScAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FuncName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FuncName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallerAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallerAddr = append(m.CallerAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.CallerAddr == nil {
+				m.CallerAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.CallValue = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arguments = append(m.Arguments, make([]byte, postIndex-iNdEx))
+			copy(m.Arguments[len(m.Arguments)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShouldBeSynced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ShouldBeSynced = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasBlockNonce", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasBlockNonce = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNonce", wireType)
+			}
+			m.BlockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VMQueryReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VMQueryReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VMQueryReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnData = append(m.ReturnData, make([]byte, postIndex-iNdEx))
+			copy(m.ReturnData[len(m.ReturnData)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRemaining", wireType)
+			}
+			m.GasRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasRemaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockInfo == nil {
+				m.BlockInfo = &BlockInfo{}
+			}
+			if err := m.BlockInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeBlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeBlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasFromNonce", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasFromNonce = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromNonce", wireType)
+			}
+			m.FromNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockNotification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockNotification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockNotification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevHash = append(m.PrevHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrevHash == nil {
+				m.PrevHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRootHash = append(m.StateRootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateRootHash == nil {
+				m.StateRootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTxs", wireType)
+			}
+			m.NumTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTxs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipNodeApi(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthNodeApi
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupNodeApi
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthNodeApi
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthNodeApi        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowNodeApi          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupNodeApi = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,264 @@
+package grpc
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared/logging"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/outport/subscriptions"
+	"github.com/multiversx/mx-chain-go/process"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func validationError(apiErr error, err error) error {
+	return status.Errorf(codes.InvalidArgument, "%s: %s", apiErr.Error(), err.Error())
+}
+
+func internalError(apiErr error, err error) error {
+	return status.Errorf(codes.Internal, "%s: %s", apiErr.Error(), err.Error())
+}
+
+// GetAccount returns the account with the provided address
+func (s *server) GetAccount(_ context.Context, request *AccountRequest) (*AccountReply, error) {
+	if len(request.Address) == 0 {
+		return nil, validationError(errors.ErrCouldNotGetAccount, errors.ErrValidationEmptyAddress)
+	}
+
+	account, blockInfo, err := s.getFacade().GetAccount(request.Address, convertAccountQueryOptions(request.Options))
+	if err != nil {
+		return nil, internalError(errors.ErrCouldNotGetAccount, err)
+	}
+
+	account.Address = request.Address
+	reply, err := convertAccount(account, blockInfo)
+	if err != nil {
+		return nil, internalError(errors.ErrCouldNotGetAccount, err)
+	}
+
+	return reply, nil
+}
+
+// GetESDTTokens returns all the ESDT tokens held by the account with the provided address
+func (s *server) GetESDTTokens(_ context.Context, request *AccountRequest) (*ESDTTokensReply, error) {
+	if len(request.Address) == 0 {
+		return nil, validationError(errors.ErrGetESDTBalance, errors.ErrValidationEmptyAddress)
+	}
+
+	tokens, blockInfo, err := s.getFacade().GetAllESDTTokens(request.Address, convertAccountQueryOptions(request.Options))
+	if err != nil {
+		return nil, internalError(errors.ErrGetESDTBalance, err)
+	}
+
+	convertedBlockInfo, err := convertBlockInfo(blockInfo)
+	if err != nil {
+		return nil, internalError(errors.ErrGetESDTBalance, err)
+	}
+
+	return &ESDTTokensReply{
+		Tokens:    tokens,
+		BlockInfo: convertedBlockInfo,
+	}, nil
+}
+
+// GetBlockByNonce returns the block with the provided nonce
+func (s *server) GetBlockByNonce(_ context.Context, request *BlockByNonceRequest) (*BlockReply, error) {
+	options := api.BlockQueryOptions{
+		WithTransactions: request.WithTxs,
+		WithLogs:         request.WithLogs,
+	}
+
+	start := time.Now()
+	block, err := s.getFacade().GetBlockByNonce(request.Nonce, options)
+	logging.LogAPIActionDurationIfNeeded(start, "gRPC call: GetBlockByNonce")
+	if err != nil {
+		return nil, internalError(errors.ErrGetBlock, err)
+	}
+
+	return s.convertBlock(block)
+}
+
+// GetBlockByHash returns the block with the provided hash
+func (s *server) GetBlockByHash(_ context.Context, request *BlockByHashRequest) (*BlockReply, error) {
+	if len(request.Hash) == 0 {
+		return nil, validationError(errors.ErrGetBlock, errors.ErrValidationEmptyBlockHash)
+	}
+
+	options := api.BlockQueryOptions{
+		WithTransactions: request.WithTxs,
+		WithLogs:         request.WithLogs,
+	}
+
+	start := time.Now()
+	block, err := s.getFacade().GetBlockByHash(hex.EncodeToString(request.Hash), options)
+	logging.LogAPIActionDurationIfNeeded(start, "gRPC call: GetBlockByHash")
+	if err != nil {
+		return nil, internalError(errors.ErrGetBlock, err)
+	}
+
+	return s.convertBlock(block)
+}
+
+func (s *server) convertBlock(block *api.Block) (*BlockReply, error) {
+	reply, err := convertBlock(block, s.getFacade())
+	if err != nil {
+		return nil, internalError(errors.ErrGetBlock, err)
+	}
+
+	return reply, nil
+}
+
+// GetTransaction returns the transaction, smart contract result or reward transaction with the provided hash
+func (s *server) GetTransaction(_ context.Context, request *TransactionRequest) (*TransactionInfo, error) {
+	if len(request.Hash) == 0 {
+		return nil, validationError(errors.ErrGetTransaction, errors.ErrValidationEmptyTxHash)
+	}
+
+	start := time.Now()
+	tx, err := s.getFacade().GetTransaction(hex.EncodeToString(request.Hash), request.WithResults)
+	logging.LogAPIActionDurationIfNeeded(start, "gRPC call: GetTransaction")
+	if err != nil {
+		return nil, internalError(errors.ErrGetTransaction, err)
+	}
+
+	reply, err := convertTransaction(tx, s.getFacade())
+	if err != nil {
+		return nil, internalError(errors.ErrGetTransaction, err)
+	}
+
+	return reply, nil
+}
+
+// SendTransactions sends the valid transactions out of the provided ones. The invalid transactions are skipped, the
+// same way the REST API does for multiple transactions
+func (s *server) SendTransactions(_ context.Context, request *SendTransactionsRequest) (*SendTransactionsReply, error) {
+	facade := s.getFacade()
+	txs := make([]*transaction.Transaction, 0, len(request.Transactions))
+	txsHashes := make(map[uint32][]byte)
+	for idx, receivedTx := range request.Transactions {
+		if receivedTx == nil {
+			continue
+		}
+
+		txArgs, err := convertToArgsCreateTransaction(receivedTx, facade)
+		if err != nil {
+			continue
+		}
+
+		tx, txHash, err := facade.CreateTransaction(txArgs)
+		if err != nil {
+			continue
+		}
+
+		err = facade.ValidateTransaction(tx)
+		if err != nil {
+			continue
+		}
+
+		txs = append(txs, tx)
+		txsHashes[uint32(idx)] = txHash
+	}
+
+	start := time.Now()
+	numOfSentTxs, err := facade.SendBulkTransactions(txs)
+	logging.LogAPIActionDurationIfNeeded(start, "gRPC call: SendBulkTransactions")
+	if err != nil {
+		return nil, internalError(errors.ErrTxGenerationFailed, err)
+	}
+
+	return &SendTransactionsReply{
+		NumSent: numOfSentTxs,
+		Hashes:  txsHashes,
+	}, nil
+}
+
+// QueryVM executes the provided smart contract query
+func (s *server) QueryVM(_ context.Context, request *VMQueryRequest) (*VMQueryReply, error) {
+	if len(request.ScAddress) == 0 {
+		return nil, validationError(errors.ErrQueryError, errors.ErrValidationEmptyAddress)
+	}
+
+	query := &process.SCQuery{
+		ScAddress:      request.ScAddress,
+		FuncName:       request.FuncName,
+		CallerAddr:     request.CallerAddr,
+		CallValue:      request.CallValue,
+		Arguments:      request.Arguments,
+		ShouldBeSynced: request.ShouldBeSynced,
+		BlockNonce:     core.OptionalUint64{Value: request.BlockNonce, HasValue: request.HasBlockNonce},
+		BlockHash:      request.BlockHash,
+	}
+
+	vmOutput, blockInfo, err := s.getFacade().ExecuteSCQuery(query)
+	if err != nil {
+		return nil, internalError(errors.ErrQueryError, err)
+	}
+
+	convertedBlockInfo, err := convertBlockInfo(blockInfo)
+	if err != nil {
+		return nil, internalError(errors.ErrQueryError, err)
+	}
+
+	return &VMQueryReply{
+		ReturnData:    vmOutput.ReturnData,
+		ReturnCode:    vmOutput.ReturnCode,
+		ReturnMessage: vmOutput.ReturnMessage,
+		GasRemaining:  vmOutput.GasRemaining,
+		BlockInfo:     convertedBlockInfo,
+	}, nil
+}
+
+// SubscribeBlocks streams the notifications of the finalized blocks until the client cancels the stream
+func (s *server) SubscribeBlocks(request *SubscribeBlocksRequest, stream NodeAPI_SubscribeBlocksServer) error {
+	filter := common.SubscriptionFilter{
+		EventTypes:   []string{common.SubscriptionBlockEvent},
+		FromNonce:    request.FromNonce,
+		HasFromNonce: request.HasFromNonce,
+	}
+
+	subscription, err := s.getFacade().Subscribe(filter)
+	if err != nil {
+		return validationError(errors.ErrSubscribe, err)
+	}
+	defer subscription.Close()
+
+	ctx := stream.Context()
+	for {
+		events, errNext := subscription.NextEvents(ctx)
+		if errNext != nil {
+			if ctx.Err() != nil {
+				return status.FromContextError(ctx.Err()).Err()
+			}
+
+			return internalError(errors.ErrSubscribe, errNext)
+		}
+
+		for _, event := range events {
+			errSend := sendBlockNotification(stream, event)
+			if errSend != nil {
+				log.Debug("server.SubscribeBlocks", "error", errSend)
+				return errSend
+			}
+		}
+	}
+}
+
+func sendBlockNotification(stream NodeAPI_SubscribeBlocksServer, event *common.SubscriptionEvent) error {
+	blockEvent, ok := event.Data.(*subscriptions.BlockEvent)
+	if !ok {
+		return internalError(errors.ErrSubscribe, ErrInvalidEventData)
+	}
+
+	notification, err := convertBlockEvent(blockEvent)
+	if err != nil {
+		return internalError(errors.ErrSubscribe, err)
+	}
+
+	return stream.Send(notification)
+}
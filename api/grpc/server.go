@@ -0,0 +1,158 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf --gogoslick_out=plugins=grpc,Mgithub.com/multiversx/mx-chain-core-go/data/esdt/proto/esdt.proto=github.com/multiversx/mx-chain-core-go/data/esdt:. nodeApi.proto
+
+package grpc
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/config"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var log = logger.GetOrCreate("api/grpc")
+
+// ArgsNewServer holds the arguments needed to create a new instance of the gRPC server. The rate limiter is optional,
+// a nil one meaning the rate limiting is disabled
+type ArgsNewServer struct {
+	Facade        FacadeHandler
+	Config        config.ApiGRPCConfig
+	TLSConfig     *tls.Config
+	Authenticator Authenticator
+	RateLimiter   RateLimiter
+}
+
+type server struct {
+	mutFacade     sync.RWMutex
+	facade        FacadeHandler
+	config        config.ApiGRPCConfig
+	tlsConfig     *tls.Config
+	authenticator Authenticator
+	rateLimiter   RateLimiter
+	mutServer     sync.Mutex
+	grpcServer    *grpc.Server
+	address       string
+}
+
+// NewServer returns a new instance of the gRPC server. The server only listens after Start is called
+func NewServer(args ArgsNewServer) (*server, error) {
+	if check.IfNil(args.Facade) {
+		return nil, errors.ErrNilFacadeHandler
+	}
+	if check.IfNil(args.Authenticator) {
+		return nil, ErrNilAuthenticator
+	}
+	if len(args.Config.Interface) == 0 {
+		return nil, fmt.Errorf("%w: empty interface", ErrInvalidGRPCConfig)
+	}
+	if args.Config.MaxMessageSizeInBytes == 0 {
+		return nil, fmt.Errorf("%w: MaxMessageSizeInBytes should be greater than 0", ErrInvalidGRPCConfig)
+	}
+	if args.Config.MaxConcurrentStreams == 0 {
+		return nil, fmt.Errorf("%w: MaxConcurrentStreams should be greater than 0", ErrInvalidGRPCConfig)
+	}
+
+	return &server{
+		facade:        args.Facade,
+		config:        args.Config,
+		tlsConfig:     args.TLSConfig,
+		authenticator: args.Authenticator,
+		rateLimiter:   args.RateLimiter,
+	}, nil
+}
+
+// Start binds the configured interface and serves the gRPC requests on a separate go routine
+func (s *server) Start() error {
+	s.mutServer.Lock()
+	defer s.mutServer.Unlock()
+
+	if s.grpcServer != nil {
+		return ErrServerAlreadyStarted
+	}
+
+	listener, err := net.Listen("tcp", s.config.Interface)
+	if err != nil {
+		return err
+	}
+
+	grpcServer := grpc.NewServer(s.createServerOptions()...)
+	RegisterNodeAPIServer(grpcServer, s)
+	s.grpcServer = grpcServer
+	s.address = listener.Addr().String()
+
+	log.Debug("starting gRPC server", "interface", s.address, "TLS enabled", s.tlsConfig != nil)
+	go func() {
+		errServe := grpcServer.Serve(listener)
+		if errServe != nil {
+			log.Error("gRPC server stopped", "error", errServe)
+		}
+	}()
+
+	return nil
+}
+
+func (s *server) createServerOptions() []grpc.ServerOption {
+	options := []grpc.ServerOption{
+		grpc.ForceServerCodec(NewCodec()),
+		grpc.MaxRecvMsgSize(int(s.config.MaxMessageSizeInBytes)),
+		grpc.MaxSendMsgSize(int(s.config.MaxMessageSizeInBytes)),
+		grpc.MaxConcurrentStreams(s.config.MaxConcurrentStreams),
+		grpc.UnaryInterceptor(s.unaryInterceptor),
+		grpc.StreamInterceptor(s.streamInterceptor),
+	}
+	if s.tlsConfig != nil {
+		options = append(options, grpc.Creds(credentials.NewTLS(s.tlsConfig)))
+	}
+
+	return options
+}
+
+// UpdateFacade replaces the facade used by the gRPC requests
+func (s *server) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(FacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+	if check.IfNil(castFacade) {
+		return errors.ErrNilFacadeHandler
+	}
+
+	s.mutFacade.Lock()
+	s.facade = castFacade
+	s.mutFacade.Unlock()
+
+	return nil
+}
+
+func (s *server) getFacade() FacadeHandler {
+	s.mutFacade.RLock()
+	defer s.mutFacade.RUnlock()
+
+	return s.facade
+}
+
+// Close stops the gRPC server, closing the listener and all the open streams
+func (s *server) Close() error {
+	s.mutServer.Lock()
+	defer s.mutServer.Unlock()
+
+	if s.grpcServer != nil {
+		s.grpcServer.Stop()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *server) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,701 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/outport/subscriptions"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var expectedErr = errors.New("expected error")
+
+func createMockArgsNewServer(facade FacadeHandler) ArgsNewServer {
+	return ArgsNewServer{
+		Facade:        facade,
+		Authenticator: &mock.AuthenticatorStub{},
+		Config: config.ApiGRPCConfig{
+			Enabled:               true,
+			Interface:             "localhost:0",
+			MaxMessageSizeInBytes: 1024 * 1024,
+			MaxConcurrentStreams:  10,
+		},
+	}
+}
+
+func startServerAndConnect(t *testing.T, facade FacadeHandler) NodeAPIClient {
+	return startServerWithArgsAndConnect(t, createMockArgsNewServer(facade))
+}
+
+func startServerWithArgsAndConnect(t *testing.T, args ArgsNewServer) NodeAPIClient {
+	s, err := NewServer(args)
+	require.Nil(t, err)
+	require.Nil(t, s.Start())
+	t.Cleanup(func() {
+		_ = s.Close()
+	})
+
+	conn, err := grpc.Dial(
+		s.address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(NewCodec())),
+	)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return NewNodeAPIClient(conn)
+}
+
+func TestNewServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		s, err := NewServer(createMockArgsNewServer(nil))
+		assert.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+		assert.Nil(t, s)
+	})
+	t.Run("nil authenticator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewServer(&mock.FacadeStub{})
+		args.Authenticator = nil
+		s, err := NewServer(args)
+		assert.Equal(t, ErrNilAuthenticator, err)
+		assert.Nil(t, s)
+	})
+	t.Run("empty interface should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewServer(&mock.FacadeStub{})
+		args.Config.Interface = ""
+		s, err := NewServer(args)
+		assert.True(t, errors.Is(err, ErrInvalidGRPCConfig))
+		assert.Nil(t, s)
+	})
+	t.Run("zero max message size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewServer(&mock.FacadeStub{})
+		args.Config.MaxMessageSizeInBytes = 0
+		s, err := NewServer(args)
+		assert.True(t, errors.Is(err, ErrInvalidGRPCConfig))
+		assert.Nil(t, s)
+	})
+	t.Run("zero max concurrent streams should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewServer(&mock.FacadeStub{})
+		args.Config.MaxConcurrentStreams = 0
+		s, err := NewServer(args)
+		assert.True(t, errors.Is(err, ErrInvalidGRPCConfig))
+		assert.Nil(t, s)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		s, err := NewServer(createMockArgsNewServer(&mock.FacadeStub{}))
+		assert.Nil(t, err)
+		assert.False(t, s.IsInterfaceNil())
+	})
+}
+
+func TestServer_StartTwiceShouldError(t *testing.T) {
+	t.Parallel()
+
+	s, _ := NewServer(createMockArgsNewServer(&mock.FacadeStub{}))
+	require.Nil(t, s.Start())
+	defer func() {
+		_ = s.Close()
+	}()
+
+	assert.Equal(t, ErrServerAlreadyStarted, s.Start())
+}
+
+func TestServer_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	s, _ := NewServer(createMockArgsNewServer(&mock.FacadeStub{}))
+
+	assert.Equal(t, apiErrors.ErrNilFacadeHandler, s.UpdateFacade(nil))
+	assert.Equal(t, apiErrors.ErrFacadeWrongTypeAssertion, s.UpdateFacade("facade"))
+	var nilFacade *mock.FacadeStub
+	assert.Equal(t, apiErrors.ErrNilFacadeHandler, s.UpdateFacade(nilFacade))
+
+	newFacade := &mock.FacadeStub{}
+	assert.Nil(t, s.UpdateFacade(newFacade))
+	assert.True(t, s.getFacade() == newFacade)
+}
+
+func TestServer_GetAccount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty address should error", func(t *testing.T) {
+		t.Parallel()
+
+		client := startServerAndConnect(t, &mock.FacadeStub{})
+		reply, err := client.GetAccount(context.Background(), &AccountRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, reply)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountCalled: func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+				return api.AccountResponse{}, api.BlockInfo{}, expectedErr
+			},
+		}
+		client := startServerAndConnect(t, facade)
+		reply, err := client.GetAccount(context.Background(), &AccountRequest{Address: "erd1"})
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), expectedErr.Error())
+		assert.Nil(t, reply)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountCalled: func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+				assert.Equal(t, "erd1", address)
+				assert.True(t, options.OnFinalBlock)
+				assert.True(t, options.BlockNonce.HasValue)
+				assert.Equal(t, uint64(37), options.BlockNonce.Value)
+				assert.False(t, options.HintEpoch.HasValue)
+
+				return api.AccountResponse{
+					Nonce:           7,
+					Balance:         "1000000000000000000000",
+					Code:            hex.EncodeToString([]byte("code")),
+					DeveloperReward: "5",
+				}, api.BlockInfo{Nonce: 37, Hash: "aabb", RootHash: "ccdd"}, nil
+			},
+		}
+		client := startServerAndConnect(t, facade)
+		request := &AccountRequest{
+			Address: "erd1",
+			Options: &AccountQueryOptions{OnFinalBlock: true, HasBlockNonce: true, BlockNonce: 37},
+		}
+		reply, err := client.GetAccount(context.Background(), request)
+		require.Nil(t, err)
+
+		expectedBalance, _ := big.NewInt(0).SetString("1000000000000000000000", 10)
+		assert.Equal(t, "erd1", reply.Address)
+		assert.Equal(t, uint64(7), reply.Nonce)
+		assert.Equal(t, expectedBalance, reply.Balance)
+		assert.Equal(t, big.NewInt(5), reply.DeveloperReward)
+		assert.Equal(t, []byte("code"), reply.Code)
+		assert.Equal(t, &BlockInfo{Nonce: 37, Hash: []byte{0xaa, 0xbb}, RootHash: []byte{0xcc, 0xdd}}, reply.BlockInfo)
+	})
+}
+
+func TestServer_GetESDTTokens(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetAllESDTTokensCalled: func(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error) {
+			return map[string]*esdt.ESDigitalToken{
+				"TKN-abcdef": {Value: big.NewInt(100)},
+			}, api.BlockInfo{Nonce: 2}, nil
+		},
+	}
+	client := startServerAndConnect(t, facade)
+	reply, err := client.GetESDTTokens(context.Background(), &AccountRequest{Address: "erd1"})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(reply.Tokens))
+	assert.Equal(t, big.NewInt(100), reply.Tokens["TKN-abcdef"].Value)
+	assert.Equal(t, uint64(2), reply.BlockInfo.Nonce)
+}
+
+func TestServer_GetBlock(t *testing.T) {
+	t.Parallel()
+
+	block := &api.Block{
+		Nonce:         10,
+		Round:         11,
+		Hash:          "aa",
+		PrevBlockHash: "bb",
+		StateRootHash: "cc",
+		Timestamp:     12,
+		MiniBlocks: []*api.MiniBlock{
+			{
+				Hash: "dd",
+				Type: "TxBlock",
+				Transactions: []*transaction.ApiTransactionResult{
+					{
+						Tx:   &transaction.Transaction{Nonce: 3, Value: big.NewInt(4)},
+						Hash: "ee",
+						Type: "normal",
+						Logs: &transaction.ApiLogs{
+							Address: "0102",
+							Events:  []*transaction.Events{{Address: "0304", Identifier: "transfer", Topics: [][]byte{[]byte("topic")}}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("by nonce should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+				assert.Equal(t, uint64(10), nonce)
+				assert.Equal(t, api.BlockQueryOptions{WithTransactions: true, WithLogs: true}, options)
+				return block, nil
+			},
+		}
+		client := startServerAndConnect(t, facade)
+		reply, err := client.GetBlockByNonce(context.Background(), &BlockByNonceRequest{Nonce: 10, WithTxs: true, WithLogs: true})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(11), reply.Round)
+		assert.Equal(t, []byte{0xaa}, reply.Hash)
+		assert.Equal(t, []byte{0xbb}, reply.PrevBlockHash)
+		assert.Equal(t, int64(12), reply.Timestamp)
+		require.Equal(t, 1, len(reply.MiniBlocks))
+		require.Equal(t, 1, len(reply.MiniBlocks[0].Transactions))
+
+		tx := reply.MiniBlocks[0].Transactions[0]
+		assert.Equal(t, []byte{0xee}, tx.Hash)
+		assert.Equal(t, big.NewInt(4), tx.Transaction.Value)
+		assert.Nil(t, tx.SmartContractResult)
+		assert.Equal(t, []byte{0x01, 0x02}, tx.Log.Address)
+		require.Equal(t, 1, len(tx.Log.Events))
+		assert.Equal(t, []byte{0x03, 0x04}, tx.Log.Events[0].Address)
+		assert.Equal(t, []byte("transfer"), tx.Log.Events[0].Identifier)
+	})
+	t.Run("by hash should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBlockByHashCalled: func(hash string, options api.BlockQueryOptions) (*api.Block, error) {
+				assert.Equal(t, "aa", hash)
+				return block, nil
+			},
+		}
+		client := startServerAndConnect(t, facade)
+		reply, err := client.GetBlockByHash(context.Background(), &BlockByHashRequest{Hash: []byte{0xaa}})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(10), reply.Nonce)
+	})
+	t.Run("by hash without hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		client := startServerAndConnect(t, &mock.FacadeStub{})
+		reply, err := client.GetBlockByHash(context.Background(), &BlockByHashRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, reply)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+				return nil, expectedErr
+			},
+		}
+		client := startServerAndConnect(t, facade)
+		reply, err := client.GetBlockByNonce(context.Background(), &BlockByNonceRequest{Nonce: 10})
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, reply)
+	})
+}
+
+func TestServer_GetTransaction(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetTransactionHandler: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+			assert.Equal(t, "abcd", hash)
+			assert.True(t, withResults)
+			return &transaction.ApiTransactionResult{
+				Tx:     &rewardTx.RewardTx{Round: 5, Value: big.NewInt(6), RcvAddr: []byte("receiver")},
+				Hash:   "abcd",
+				Type:   "reward",
+				Status: transaction.TxStatusSuccess,
+				SmartContractResults: []*transaction.ApiSmartContractResult{
+					{Hash: "0a"},
+				},
+			}, nil
+		},
+	}
+	client := startServerAndConnect(t, facade)
+	reply, err := client.GetTransaction(context.Background(), &TransactionRequest{Hash: []byte{0xab, 0xcd}, WithResults: true})
+	require.Nil(t, err)
+	assert.Equal(t, "reward", reply.Type)
+	assert.Equal(t, string(transaction.TxStatusSuccess), reply.Status)
+	assert.Nil(t, reply.Transaction)
+	assert.Equal(t, &rewardTx.RewardTx{Round: 5, Value: big.NewInt(6), RcvAddr: []byte("receiver")}, reply.RewardTx)
+	assert.Equal(t, [][]byte{{0x0a}}, reply.SmartContractResultsHashes)
+}
+
+func TestServer_SendTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should skip the invalid transactions", func(t *testing.T) {
+		t.Parallel()
+
+		var sentTxs []*transaction.Transaction
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error) {
+				assert.Equal(t, hex.EncodeToString([]byte("sender")), txArgs.Sender)
+				assert.Equal(t, "0a0b", txArgs.SignatureHex)
+				assert.Empty(t, txArgs.Guardian)
+				if txArgs.Nonce == 1 {
+					return nil, nil, expectedErr
+				}
+
+				return &transaction.Transaction{Nonce: txArgs.Nonce}, []byte{byte(txArgs.Nonce)}, nil
+			},
+			ValidateTransactionHandler: func(tx *transaction.Transaction) error {
+				if tx.Nonce == 2 {
+					return expectedErr
+				}
+				return nil
+			},
+			SendBulkTransactionsHandler: func(txs []*transaction.Transaction) (uint64, error) {
+				sentTxs = txs
+				return uint64(len(txs)), nil
+			},
+		}
+		client := startServerAndConnect(t, facade)
+
+		txs := make([]*transaction.Transaction, 0, 4)
+		for nonce := uint64(0); nonce < 4; nonce++ {
+			txs = append(txs, &transaction.Transaction{
+				Nonce:     nonce,
+				SndAddr:   []byte("sender"),
+				RcvAddr:   []byte("receiver"),
+				Signature: []byte{0x0a, 0x0b},
+			})
+		}
+		reply, err := client.SendTransactions(context.Background(), &SendTransactionsRequest{Transactions: txs})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(2), reply.NumSent)
+		assert.Equal(t, map[uint32][]byte{0: {0}, 3: {3}}, reply.Hashes)
+		require.Equal(t, 2, len(sentTxs))
+	})
+	t.Run("send error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SendBulkTransactionsHandler: func(txs []*transaction.Transaction) (uint64, error) {
+				return 0, expectedErr
+			},
+		}
+		client := startServerAndConnect(t, facade)
+		reply, err := client.SendTransactions(context.Background(), &SendTransactionsRequest{})
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, reply)
+	})
+}
+
+func TestServer_QueryVM(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty address should error", func(t *testing.T) {
+		t.Parallel()
+
+		client := startServerAndConnect(t, &mock.FacadeStub{})
+		reply, err := client.QueryVM(context.Background(), &VMQueryRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, reply)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error) {
+				assert.Equal(t, []byte("sc"), query.ScAddress)
+				assert.Equal(t, "get", query.FuncName)
+				assert.Equal(t, big.NewInt(3), query.CallValue)
+				assert.Equal(t, [][]byte{[]byte("arg")}, query.Arguments)
+				assert.True(t, query.BlockNonce.HasValue)
+				assert.Equal(t, uint64(8), query.BlockNonce.Value)
+
+				return &vm.VMOutputApi{
+					ReturnData:   [][]byte{[]byte("result")},
+					ReturnCode:   "ok",
+					GasRemaining: 100,
+				}, api.BlockInfo{Nonce: 8}, nil
+			},
+		}
+		client := startServerAndConnect(t, facade)
+		request := &VMQueryRequest{
+			ScAddress:     []byte("sc"),
+			FuncName:      "get",
+			CallValue:     big.NewInt(3),
+			Arguments:     [][]byte{[]byte("arg")},
+			HasBlockNonce: true,
+			BlockNonce:    8,
+		}
+		reply, err := client.QueryVM(context.Background(), request)
+		require.Nil(t, err)
+		assert.Equal(t, [][]byte{[]byte("result")}, reply.ReturnData)
+		assert.Equal(t, "ok", reply.ReturnCode)
+		assert.Equal(t, uint64(100), reply.GasRemaining)
+		assert.Equal(t, uint64(8), reply.BlockInfo.Nonce)
+	})
+}
+
+func TestServer_SubscribeBlocks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("subscribe error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SubscribeCalled: func(filter common.SubscriptionFilter) (common.Subscription, error) {
+				return nil, expectedErr
+			},
+		}
+		client := startServerAndConnect(t, facade)
+		stream, err := client.SubscribeBlocks(context.Background(), &SubscribeBlocksRequest{})
+		require.Nil(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("should stream the block events", func(t *testing.T) {
+		t.Parallel()
+
+		chClosed := make(chan struct{})
+		numCalls := 0
+		subscription := &testscommon.SubscriptionStub{
+			NextEventsCalled: func(ctx context.Context) ([]*common.SubscriptionEvent, error) {
+				numCalls++
+				if numCalls > 1 {
+					<-ctx.Done()
+					return nil, subscriptions.ErrSubscriptionClosed
+				}
+
+				return []*common.SubscriptionEvent{
+					{Type: common.SubscriptionBlockEvent, Nonce: 5, Data: &subscriptions.BlockEvent{Hash: "aa", Nonce: 5, PrevHash: "bb", NumTxs: 2}},
+					{Type: common.SubscriptionBlockEvent, Nonce: 6, Data: &subscriptions.BlockEvent{Hash: "cc", Nonce: 6, PrevHash: "aa"}},
+				}, nil
+			},
+			CloseCalled: func() {
+				close(chClosed)
+			},
+		}
+		facade := &mock.FacadeStub{
+			SubscribeCalled: func(filter common.SubscriptionFilter) (common.Subscription, error) {
+				assert.Equal(t, []string{common.SubscriptionBlockEvent}, filter.EventTypes)
+				assert.True(t, filter.HasFromNonce)
+				assert.Equal(t, uint64(5), filter.FromNonce)
+				return subscription, nil
+			},
+		}
+		client := startServerAndConnect(t, facade)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		stream, err := client.SubscribeBlocks(ctx, &SubscribeBlocksRequest{HasFromNonce: true, FromNonce: 5})
+		require.Nil(t, err)
+
+		notification, err := stream.Recv()
+		require.Nil(t, err)
+		assert.Equal(t, &BlockNotification{Hash: []byte{0xaa}, Nonce: 5, PrevHash: []byte{0xbb}, NumTxs: 2}, notification)
+		notification, err = stream.Recv()
+		require.Nil(t, err)
+		assert.Equal(t, uint64(6), notification.Nonce)
+
+		cancel()
+		_, err = stream.Recv()
+		assert.NotEqual(t, io.EOF, err)
+
+		select {
+		case <-chClosed:
+		case <-time.After(time.Second * 5):
+			assert.Fail(t, "subscription was not closed")
+		}
+	})
+}
+
+func TestServer_Interceptors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid credentials should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewServer(&mock.FacadeStub{})
+		args.Authenticator = &mock.AuthenticatorStub{
+			AuthenticateCalled: func(header string, tlsState *tls.ConnectionState) (shared.AccessRole, string, bool) {
+				assert.Equal(t, "Bearer token", header)
+				assert.Nil(t, tlsState)
+				return shared.RolePublic, "", false
+			},
+		}
+		client := startServerWithArgsAndConnect(t, args)
+
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer token")
+		reply, err := client.GetAccount(ctx, &AccountRequest{Address: "erd1"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.Nil(t, reply)
+	})
+	t.Run("rate limited call should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewServer(&mock.FacadeStub{})
+		args.Authenticator = &mock.AuthenticatorStub{
+			AuthenticateCalled: func(header string, tlsState *tls.ConnectionState) (shared.AccessRole, string, bool) {
+				return shared.RolePublic, "identity", true
+			},
+		}
+		args.RateLimiter = &mock.RateLimiterStub{
+			ConsumeCallerCostCalled: func(identity string, remoteAddr string, forwardedFor []string, endpoint string) (bool, error) {
+				assert.Equal(t, "identity", identity)
+				assert.NotEmpty(t, remoteAddr)
+				assert.Equal(t, []string{"10.0.0.1"}, forwardedFor)
+				assert.Equal(t, "/address/:address", endpoint)
+				return false, nil
+			},
+		}
+		client := startServerWithArgsAndConnect(t, args)
+
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "x-forwarded-for", "10.0.0.1")
+		reply, err := client.GetAccount(ctx, &AccountRequest{Address: "erd1"})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Nil(t, reply)
+	})
+	t.Run("rate limiter error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewServer(&mock.FacadeStub{})
+		args.RateLimiter = &mock.RateLimiterStub{
+			ConsumeCallerCostCalled: func(identity string, remoteAddr string, forwardedFor []string, endpoint string) (bool, error) {
+				return false, expectedErr
+			},
+		}
+		client := startServerWithArgsAndConnect(t, args)
+
+		reply, err := client.GetAccount(context.Background(), &AccountRequest{Address: "erd1"})
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, reply)
+	})
+	t.Run("throttled call should error", func(t *testing.T) {
+		t.Parallel()
+
+		throttler := &mock.ThrottlerStub{
+			CanProcessCalled: func() bool {
+				return false
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+				assert.Equal(t, "/transaction/send-multiple", endpoint)
+				return throttler, true
+			},
+		}
+		client := startServerAndConnect(t, facade)
+
+		reply, err := client.SendTransactions(context.Background(), &SendTransactionsRequest{})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Nil(t, reply)
+		assert.False(t, throttler.StartWasCalled)
+	})
+	t.Run("throttled call should release the throttler", func(t *testing.T) {
+		t.Parallel()
+
+		throttler := &mock.ThrottlerStub{}
+		facade := &mock.FacadeStub{
+			GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+				return throttler, true
+			},
+			GetTransactionHandler: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return &transaction.ApiTransactionResult{Tx: &transaction.Transaction{}}, nil
+			},
+		}
+		client := startServerAndConnect(t, facade)
+
+		_, err := client.GetTransaction(context.Background(), &TransactionRequest{Hash: []byte{0xab}})
+		require.Nil(t, err)
+		assert.True(t, throttler.StartWasCalled)
+		assert.True(t, throttler.EndWasCalled)
+	})
+	t.Run("stream with invalid credentials should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewServer(&mock.FacadeStub{
+			SubscribeCalled: func(filter common.SubscriptionFilter) (common.Subscription, error) {
+				assert.Fail(t, "should not have subscribed")
+				return nil, expectedErr
+			},
+		})
+		args.Authenticator = &mock.AuthenticatorStub{
+			AuthenticateCalled: func(header string, tlsState *tls.ConnectionState) (shared.AccessRole, string, bool) {
+				return shared.RolePublic, "", false
+			},
+		}
+		client := startServerWithArgsAndConnect(t, args)
+
+		stream, err := client.SubscribeBlocks(context.Background(), &SubscribeBlocksRequest{})
+		require.Nil(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+	t.Run("rate limited stream should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewServer(&mock.FacadeStub{})
+		args.RateLimiter = &mock.RateLimiterStub{
+			ConsumeCallerCostCalled: func(identity string, remoteAddr string, forwardedFor []string, endpoint string) (bool, error) {
+				assert.Equal(t, "/subscriptions/ws", endpoint)
+				return false, nil
+			},
+		}
+		client := startServerWithArgsAndConnect(t, args)
+
+		stream, err := client.SubscribeBlocks(context.Background(), &SubscribeBlocksRequest{})
+		require.Nil(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+}
+
+func TestCodec(t *testing.T) {
+	t.Parallel()
+
+	codec := NewCodec()
+	assert.Equal(t, "proto", codec.Name())
+
+	_, err := codec.Marshal("not a message")
+	assert.True(t, errors.Is(err, ErrInvalidMessageType))
+	err = codec.Unmarshal(nil, "not a message")
+	assert.True(t, errors.Is(err, ErrInvalidMessageType))
+
+	notification := &BlockNotification{Hash: []byte("hash"), Nonce: 7}
+	buff, err := codec.Marshal(notification)
+	require.Nil(t, err)
+	recovered := &BlockNotification{Nonce: 1}
+	require.Nil(t, codec.Unmarshal(buff, recovered))
+	assert.Equal(t, notification, recovered)
+}
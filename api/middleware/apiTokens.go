@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/multiversx/mx-chain-go/api/shared"
+)
+
+const (
+	minAPITokenLength  = 32
+	commentPrefix      = "#"
+	tokenIDLength      = 8
+	numTokenLineTokens = 2
+)
+
+type apiToken struct {
+	hash []byte
+	id   string
+	role shared.AccessRole
+}
+
+// loadAPITokens reads the API tokens file, one "<role> <token>" pair per line, and returns the hashes of the tokens
+// together with their roles. Empty lines and lines starting with # are ignored. An empty file path means no token is
+// accepted
+func loadAPITokens(tokensFile string) ([]*apiToken, error) {
+	tokens := make([]*apiToken, 0)
+	if len(tokensFile) == 0 {
+		return tokens, nil
+	}
+
+	buff, err := os.ReadFile(tokensFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading the API tokens file %s", err, tokensFile)
+	}
+
+	for index, line := range strings.Split(string(buff), "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 || strings.HasPrefix(line, commentPrefix) {
+			continue
+		}
+
+		token, errParse := parseTokenLine(line)
+		if errParse != nil {
+			return nil, fmt.Errorf("%w, %s on line %d of file %s", ErrInvalidTokensFile, errParse.Error(), index+1, tokensFile)
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	log.Debug("loaded API tokens", "file", tokensFile, "num tokens", len(tokens))
+
+	return tokens, nil
+}
+
+func parseTokenLine(line string) (*apiToken, error) {
+	fields := strings.Fields(line)
+	if len(fields) != numTokenLineTokens {
+		return nil, fmt.Errorf("expected <role> <token>")
+	}
+
+	role, err := shared.ParseAccessRole(fields[0])
+	if err != nil {
+		return nil, err
+	}
+
+	token := fields[1]
+	if len(token) < minAPITokenLength {
+		return nil, fmt.Errorf("the token should have at least %d characters", minAPITokenLength)
+	}
+
+	tokenHash := sha256.Sum256([]byte(token))
+
+	return &apiToken{
+		hash: tokenHash[:],
+		id:   hex.EncodeToString(tokenHash[:tokenIDLength/2]),
+		role: role,
+	}, nil
+}
+
+func findAPIToken(tokens []*apiToken, token string) *apiToken {
+	tokenHash := sha256.Sum256([]byte(token))
+
+	var found *apiToken
+	for _, t := range tokens {
+		// compare all the hashes, in constant time, so the response time will not reveal anything about the tokens
+		if subtle.ConstantTimeCompare(t.hash, tokenHash[:]) == 1 {
+			found = t
+		}
+	}
+
+	return found
+}
@@ -0,0 +1,195 @@
+package middleware
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	authorizationHeader = "Authorization"
+	bearerPrefix        = "Bearer "
+	anonymousIdentity   = "anonymous"
+	tokenIdentityPrefix = "token "
+	certIdentityPrefix  = "certificate "
+	unknownIdentity     = "unknown"
+)
+
+// auditLog is a separate logger, so the operators can route the access records as they see fit
+var auditLog = logger.GetOrCreate("api/audit")
+
+// ArgsAuthenticator holds the arguments needed to create a new authenticator
+type ArgsAuthenticator struct {
+	TokensFile          string
+	UnauthenticatedRole shared.AccessRole
+}
+
+type authenticator struct {
+	tokens              []*apiToken
+	unauthenticatedRole shared.AccessRole
+}
+
+// NewAuthenticator returns a new instance of authenticator. The authenticator resolves the role of each caller
+// either from the provided API token (the "Authorization: Bearer <token>" header) or from the verified TLS client
+// certificate. Callers that do not provide any credentials get the unauthenticated role
+func NewAuthenticator(args ArgsAuthenticator) (*authenticator, error) {
+	tokens, err := loadAPITokens(args.TokensFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authenticator{
+		tokens:              tokens,
+		unauthenticatedRole: args.UnauthenticatedRole,
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the handler func that stores the caller's role and identity in the request context.
+// Requests providing invalid credentials are rejected
+func (a *authenticator) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role, identity, ok := a.authenticate(c.Request)
+		if !ok {
+			auditLog.Warn("rejected API request with invalid credentials",
+				"method", c.Request.Method, "path", c.Request.URL.Path, "remote address", c.ClientIP())
+			c.AbortWithStatusJSON(
+				http.StatusUnauthorized,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: errors.ErrUnauthorized.Error(),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		c.Set(shared.AccessRoleContextKey, role)
+		c.Set(shared.AccessIdentityContextKey, identity)
+		c.Next()
+	}
+}
+
+func (a *authenticator) authenticate(request *http.Request) (shared.AccessRole, string, bool) {
+	return a.Authenticate(request.Header.Get(authorizationHeader), request.TLS)
+}
+
+// Authenticate resolves the caller's role and identity from the provided authorization value, expected as
+// "Bearer <token>", or from the verified client certificate of the provided TLS connection state. It is used by the
+// servers not going through gin, such as the gRPC one. Returns false if the credentials are invalid
+func (a *authenticator) Authenticate(header string, tlsState *tls.ConnectionState) (shared.AccessRole, string, bool) {
+	if len(header) > 0 {
+		if !strings.HasPrefix(header, bearerPrefix) {
+			return shared.RolePublic, "", false
+		}
+
+		token := findAPIToken(a.tokens, strings.TrimSpace(strings.TrimPrefix(header, bearerPrefix)))
+		if token == nil {
+			return shared.RolePublic, "", false
+		}
+
+		return a.maxRole(token.role), tokenIdentityPrefix + token.id, true
+	}
+
+	if tlsState != nil && len(tlsState.VerifiedChains) > 0 && len(tlsState.VerifiedChains[0]) > 0 {
+		cert := tlsState.VerifiedChains[0][0]
+
+		return a.maxRole(roleFromCertificate(cert)), certIdentityPrefix + cert.Subject.CommonName, true
+	}
+
+	return a.unauthenticatedRole, anonymousIdentity, true
+}
+
+// roleFromCertificate returns the highest role found in the organizational units of the client certificate
+func roleFromCertificate(cert *x509.Certificate) shared.AccessRole {
+	role := shared.RolePublic
+	for _, unit := range cert.Subject.OrganizationalUnit {
+		unitRole, err := shared.ParseAccessRole(unit)
+		if err != nil {
+			continue
+		}
+		if unitRole > role {
+			role = unitRole
+		}
+	}
+
+	return role
+}
+
+func (a *authenticator) maxRole(role shared.AccessRole) shared.AccessRole {
+	if role > a.unauthenticatedRole {
+		return role
+	}
+
+	return a.unauthenticatedRole
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (a *authenticator) IsInterfaceNil() bool {
+	return a == nil
+}
+
+// CreateRoleChecker will create a middleware-type of handler that allows the request only if the caller's role,
+// as resolved by the authenticator, is at least the required role
+func CreateRoleChecker(requiredRole shared.AccessRole) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role := shared.RolePublic
+		value, exists := c.Get(shared.AccessRoleContextKey)
+		if exists {
+			role, _ = value.(shared.AccessRole)
+		}
+		identity := c.GetString(shared.AccessIdentityContextKey)
+
+		if !IsAccessGranted(c.Request.Method, c.Request.URL.Path, c.ClientIP(), identity, role, requiredRole) {
+			c.AbortWithStatusJSON(
+				http.StatusForbidden,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: errors.ErrForbidden.Error(),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// IsAccessGranted returns true if the caller's role is at least the required role. Every access is audit-logged, the
+// granted accesses to the public endpoints on the debug level as they make up most of the traffic. It is used by the
+// role checker and by the servers not going through gin, such as the gRPC one
+func IsAccessGranted(
+	method string,
+	path string,
+	remoteAddress string,
+	identity string,
+	role shared.AccessRole,
+	requiredRole shared.AccessRole,
+) bool {
+	if len(identity) == 0 {
+		identity = unknownIdentity
+	}
+
+	if role < requiredRole {
+		auditLog.Warn("denied API request",
+			"method", method, "path", path, "remote address", remoteAddress,
+			"identity", identity, "role", role.String(), "required role", requiredRole.String())
+		return false
+	}
+
+	logGranted := auditLog.Info
+	if requiredRole == shared.RolePublic {
+		logGranted = auditLog.Debug
+	}
+	logGranted("granted API request",
+		"method", method, "path", path, "remote address", remoteAddress,
+		"identity", identity, "role", role.String(), "required role", requiredRole.String())
+
+	return true
+}
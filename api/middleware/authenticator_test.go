@@ -0,0 +1,251 @@
+package middleware_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	operatorToken = strings.Repeat("o", 32)
+	adminToken    = strings.Repeat("a", 40)
+)
+
+func writeTokensFile(tb testing.TB, content string) string {
+	tokensFile := filepath.Join(tb.TempDir(), "apiTokens")
+	err := os.WriteFile(tokensFile, []byte(content), 0600)
+	require.NoError(tb, err)
+
+	return tokensFile
+}
+
+func createTestTokensFile(tb testing.TB) string {
+	content := fmt.Sprintf("# tokens used by the monitoring tools\noperator %s\n\n  admin   %s  \n", operatorToken, adminToken)
+
+	return writeTokensFile(tb, content)
+}
+
+func startNodeServerAuthenticator(tb testing.TB, unauthenticatedRole shared.AccessRole) *gin.Engine {
+	auth, err := middleware.NewAuthenticator(middleware.ArgsAuthenticator{
+		TokensFile:          createTestTokensFile(tb),
+		UnauthenticatedRole: unauthenticatedRole,
+	})
+	require.NoError(tb, err)
+
+	ws := gin.New()
+	ws.Use(auth.MiddlewareHandlerFunc())
+	ws.GET("/node/status", middleware.CreateRoleChecker(shared.RolePublic), func(c *gin.Context) {
+		c.JSON(http.StatusOK, "ok")
+	})
+	ws.POST("/node/debug", middleware.CreateRoleChecker(shared.RoleOperator), func(c *gin.Context) {
+		c.JSON(http.StatusOK, "ok")
+	})
+	ws.POST("/node/managed-keys/add", middleware.CreateRoleChecker(shared.RoleAdmin), func(c *gin.Context) {
+		c.JSON(http.StatusOK, "ok")
+	})
+
+	return ws
+}
+
+func doAuthenticatedRequest(ws *gin.Engine, method string, path string, authorizationHeader string, clientCert *x509.Certificate) int {
+	req, _ := http.NewRequest(method, path, nil)
+	if len(authorizationHeader) > 0 {
+		req.Header.Set("Authorization", authorizationHeader)
+	}
+	if clientCert != nil {
+		req.TLS = &tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{{clientCert}},
+		}
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp.Code
+}
+
+func TestNewAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing tokens file should error", func(t *testing.T) {
+		t.Parallel()
+
+		auth, err := middleware.NewAuthenticator(middleware.ArgsAuthenticator{
+			TokensFile: filepath.Join(t.TempDir(), "missing"),
+		})
+		assert.Error(t, err)
+		assert.True(t, check.IfNil(auth))
+	})
+	t.Run("unknown role should error", func(t *testing.T) {
+		t.Parallel()
+
+		auth, err := middleware.NewAuthenticator(middleware.ArgsAuthenticator{
+			TokensFile: writeTokensFile(t, "root "+adminToken),
+		})
+		assert.True(t, errors.Is(err, middleware.ErrInvalidTokensFile))
+		assert.True(t, check.IfNil(auth))
+	})
+	t.Run("missing role should error", func(t *testing.T) {
+		t.Parallel()
+
+		auth, err := middleware.NewAuthenticator(middleware.ArgsAuthenticator{
+			TokensFile: writeTokensFile(t, adminToken),
+		})
+		assert.True(t, errors.Is(err, middleware.ErrInvalidTokensFile))
+		assert.True(t, check.IfNil(auth))
+	})
+	t.Run("too short token should error", func(t *testing.T) {
+		t.Parallel()
+
+		auth, err := middleware.NewAuthenticator(middleware.ArgsAuthenticator{
+			TokensFile: writeTokensFile(t, "admin short"),
+		})
+		assert.True(t, errors.Is(err, middleware.ErrInvalidTokensFile))
+		assert.True(t, strings.Contains(err.Error(), "line 1"))
+		assert.True(t, check.IfNil(auth))
+	})
+	t.Run("no tokens file should work", func(t *testing.T) {
+		t.Parallel()
+
+		auth, err := middleware.NewAuthenticator(middleware.ArgsAuthenticator{})
+		assert.NoError(t, err)
+		assert.False(t, check.IfNil(auth))
+	})
+}
+
+func TestAuthenticator_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no credentials should get the unauthenticated role", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startNodeServerAuthenticator(t, shared.RolePublic)
+		assert.Equal(t, http.StatusOK, doAuthenticatedRequest(ws, http.MethodGet, "/node/status", "", nil))
+		assert.Equal(t, http.StatusForbidden, doAuthenticatedRequest(ws, http.MethodPost, "/node/debug", "", nil))
+		assert.Equal(t, http.StatusForbidden, doAuthenticatedRequest(ws, http.MethodPost, "/node/managed-keys/add", "", nil))
+
+		ws = startNodeServerAuthenticator(t, shared.RoleOperator)
+		assert.Equal(t, http.StatusOK, doAuthenticatedRequest(ws, http.MethodPost, "/node/debug", "", nil))
+		assert.Equal(t, http.StatusForbidden, doAuthenticatedRequest(ws, http.MethodPost, "/node/managed-keys/add", "", nil))
+	})
+	t.Run("invalid credentials should return unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startNodeServerAuthenticator(t, shared.RoleAdmin)
+		assert.Equal(t, http.StatusUnauthorized, doAuthenticatedRequest(ws, http.MethodGet, "/node/status", "Bearer invalid token", nil))
+		assert.Equal(t, http.StatusUnauthorized, doAuthenticatedRequest(ws, http.MethodGet, "/node/status", "Basic "+adminToken, nil))
+		assert.Equal(t, http.StatusUnauthorized, doAuthenticatedRequest(ws, http.MethodPost, "/node/managed-keys/add", "Bearer "+adminToken+"a", nil))
+	})
+	t.Run("operator token should access the operator endpoints", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startNodeServerAuthenticator(t, shared.RolePublic)
+		header := "Bearer " + operatorToken
+		assert.Equal(t, http.StatusOK, doAuthenticatedRequest(ws, http.MethodGet, "/node/status", header, nil))
+		assert.Equal(t, http.StatusOK, doAuthenticatedRequest(ws, http.MethodPost, "/node/debug", header, nil))
+		assert.Equal(t, http.StatusForbidden, doAuthenticatedRequest(ws, http.MethodPost, "/node/managed-keys/add", header, nil))
+	})
+	t.Run("admin token should access all the endpoints", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startNodeServerAuthenticator(t, shared.RolePublic)
+		header := "Bearer " + adminToken
+		assert.Equal(t, http.StatusOK, doAuthenticatedRequest(ws, http.MethodGet, "/node/status", header, nil))
+		assert.Equal(t, http.StatusOK, doAuthenticatedRequest(ws, http.MethodPost, "/node/debug", header, nil))
+		assert.Equal(t, http.StatusOK, doAuthenticatedRequest(ws, http.MethodPost, "/node/managed-keys/add", header, nil))
+	})
+	t.Run("client certificate should get the role from the organizational unit", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startNodeServerAuthenticator(t, shared.RolePublic)
+		operatorCert := &x509.Certificate{
+			Subject: pkix.Name{CommonName: "monitoring", OrganizationalUnit: []string{"observers", "operator"}},
+		}
+		assert.Equal(t, http.StatusOK, doAuthenticatedRequest(ws, http.MethodPost, "/node/debug", "", operatorCert))
+		assert.Equal(t, http.StatusForbidden, doAuthenticatedRequest(ws, http.MethodPost, "/node/managed-keys/add", "", operatorCert))
+
+		adminCert := &x509.Certificate{
+			Subject: pkix.Name{CommonName: "deployer", OrganizationalUnit: []string{"admin"}},
+		}
+		assert.Equal(t, http.StatusOK, doAuthenticatedRequest(ws, http.MethodPost, "/node/managed-keys/add", "", adminCert))
+
+		noRoleCert := &x509.Certificate{
+			Subject: pkix.Name{CommonName: "wallet"},
+		}
+		assert.Equal(t, http.StatusOK, doAuthenticatedRequest(ws, http.MethodGet, "/node/status", "", noRoleCert))
+		assert.Equal(t, http.StatusForbidden, doAuthenticatedRequest(ws, http.MethodPost, "/node/debug", "", noRoleCert))
+	})
+}
+
+func TestAuthenticator_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	auth, err := middleware.NewAuthenticator(middleware.ArgsAuthenticator{
+		TokensFile:          createTestTokensFile(t),
+		UnauthenticatedRole: shared.RolePublic,
+	})
+	require.NoError(t, err)
+
+	role, identity, ok := auth.Authenticate("", nil)
+	assert.True(t, ok)
+	assert.Equal(t, shared.RolePublic, role)
+	assert.Equal(t, "anonymous", identity)
+
+	_, _, ok = auth.Authenticate("Bearer "+strings.Repeat("x", 32), nil)
+	assert.False(t, ok)
+
+	role, identity, ok = auth.Authenticate("Bearer "+operatorToken, nil)
+	assert.True(t, ok)
+	assert.Equal(t, shared.RoleOperator, role)
+	assert.True(t, strings.HasPrefix(identity, "token "))
+
+	adminCert := &x509.Certificate{
+		Subject: pkix.Name{CommonName: "deployer", OrganizationalUnit: []string{"admin"}},
+	}
+	role, identity, ok = auth.Authenticate("", &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{adminCert}}})
+	assert.True(t, ok)
+	assert.Equal(t, shared.RoleAdmin, role)
+	assert.Equal(t, "certificate deployer", identity)
+}
+
+func TestIsAccessGranted(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, middleware.IsAccessGranted("gRPC", "/proto.NodeAPI/GetAccount", "1.2.3.4", "", shared.RolePublic, shared.RolePublic))
+	assert.True(t, middleware.IsAccessGranted("POST", "/node/debug", "1.2.3.4", "token abcd", shared.RoleAdmin, shared.RoleOperator))
+	assert.False(t, middleware.IsAccessGranted("POST", "/node/debug", "1.2.3.4", "anonymous", shared.RolePublic, shared.RoleOperator))
+}
+
+func TestCreateRoleChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing role should be treated as public", func(t *testing.T) {
+		t.Parallel()
+
+		ws := gin.New()
+		ws.POST("/node/debug", middleware.CreateRoleChecker(shared.RoleOperator), func(c *gin.Context) {
+			assert.Fail(t, "should have not been called")
+		})
+		req, _ := http.NewRequest(http.MethodPost, "/node/debug", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusForbidden, resp.Code)
+		assert.True(t, strings.Contains(resp.Body.String(), apiErrors.ErrForbidden.Error()))
+	})
+}
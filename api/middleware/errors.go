@@ -7,3 +7,12 @@ var ErrInvalidMaxNumRequests = errors.New("max number of requests value is inval
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrInvalidTokensFile signals that the API tokens file is invalid
+var ErrInvalidTokensFile = errors.New("invalid API tokens file")
+
+// ErrInvalidRateLimiterValue signals that an invalid value was provided in the rate limiter config
+var ErrInvalidRateLimiterValue = errors.New("invalid rate limiter value")
+
+// ErrInvalidTrustedProxy signals that an invalid trusted proxy address was provided
+var ErrInvalidTrustedProxy = errors.New("invalid trusted proxy")
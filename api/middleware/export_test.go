@@ -0,0 +1,16 @@
+package middleware
+
+import "time"
+
+// SetTimeHandler -
+func (tbl *tokenBucketLimiter) SetTimeHandler(handler func() time.Time) {
+	tbl.getTimeHandler = handler
+}
+
+// NumBuckets -
+func (tbl *tokenBucketLimiter) NumBuckets() int {
+	tbl.mutBuckets.Lock()
+	defer tbl.mutBuckets.Unlock()
+
+	return len(tbl.buckets)
+}
@@ -13,6 +13,10 @@ import (
 
 var log = logger.GetOrCreate("api/middleware")
 
+const globalThrottlerReleaseContextKey = "globalThrottlerRelease"
+
+type globalThrottlerRelease func()
+
 // globalThrottler is a middleware global limiter used to limit total number of simultaneous requests
 type globalThrottler struct {
 	queue            chan struct{}
@@ -57,12 +61,36 @@ func (gt *globalThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 			return
 		}
 
-		defer gt.finish(path)
+		var once sync.Once
+		release := globalThrottlerRelease(func() {
+			once.Do(func() {
+				gt.finish(path)
+			})
+		})
+		defer release()
 
+		c.Set(globalThrottlerReleaseContextKey, release)
 		c.Next()
 	}
 }
 
+// ReleaseGlobalThrottlerSlot frees the global throttler slot held by the request, if any, before the request ends. It
+// is used by the long-lived streaming requests, such as the subscriptions, once their stream is established, so they
+// do not keep the other requests from being served. Those requests are limited by their own subscribers limit
+func ReleaseGlobalThrottlerSlot(c *gin.Context) {
+	value, exists := c.Get(globalThrottlerReleaseContextKey)
+	if !exists {
+		return
+	}
+
+	release, ok := value.(globalThrottlerRelease)
+	if !ok {
+		return
+	}
+
+	release()
+}
+
 func (gt *globalThrottler) finish(path string) {
 	gt.mutDebugRequests.Lock()
 	gt.debugRequests[path]--
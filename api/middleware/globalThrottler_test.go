@@ -112,3 +112,54 @@ func makeRequestGlobalThrottler(ws *gin.Engine, mutResponses *sync.Mutex, respon
 	responses[resp.Code]++
 	mutResponses.Unlock()
 }
+
+func TestGlobalThrottler_ReleaseGlobalThrottlerSlot(t *testing.T) {
+	t.Parallel()
+
+	chStreamStarted := make(chan struct{})
+	chStopStream := make(chan struct{})
+	handlerFunc := func(c *gin.Context) {
+		if c.Query("stream") != "true" {
+			return
+		}
+
+		middleware.ReleaseGlobalThrottlerSlot(c)
+		// releasing again should not free a slot held by another request
+		middleware.ReleaseGlobalThrottlerSlot(c)
+		close(chStreamStarted)
+		<-chStopStream
+	}
+	ws := startNodeServerGlobalThrottler(handlerFunc, 1)
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		req, _ := http.NewRequest("GET", "/address/addr/balance?stream=true", nil)
+		ws.ServeHTTP(httptest.NewRecorder(), req)
+	}()
+	<-chStreamStarted
+
+	req, _ := http.NewRequest("GET", "/address/addr/balance", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	close(chStopStream)
+	wg.Wait()
+
+	req, _ = http.NewRequest("GET", "/address/addr/balance", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestReleaseGlobalThrottlerSlot_WithoutThrottlerShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	assert.NotPanics(t, func() {
+		middleware.ReleaseGlobalThrottlerSlot(c)
+	})
+}
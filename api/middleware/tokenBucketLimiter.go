@@ -0,0 +1,349 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-go/api/shared"
+)
+
+const (
+	forwardedForHeader       = "X-Forwarded-For"
+	rateLimitLimitHeader     = "X-RateLimit-Limit"
+	rateLimitRemainingHeader = "X-RateLimit-Remaining"
+	rateLimitResetHeader     = "X-RateLimit-Reset"
+	retryAfterHeader         = "Retry-After"
+
+	endpointCostConsumerContextKey = "endpointCostConsumer"
+)
+
+// endpointCostConsumer takes the cost of the provided endpoint out of the caller's bucket, returning false if there
+// are not enough tokens left
+type endpointCostConsumer func(endpoint string) bool
+
+// ArgsTokenBucketLimiter holds the arguments needed to create a new token bucket limiter
+type ArgsTokenBucketLimiter struct {
+	BucketCapacity        uint32
+	RefillTokensPerSecond uint32
+	DefaultCost           uint32
+	EndpointsCosts        map[string]uint32
+	TrustedProxies        []string
+}
+
+type bucket struct {
+	tokens     float64
+	lastRefill time.Time
+}
+
+// tokenBucketLimiter is a middleware limiter that gives each caller a bucket of tokens, refilled at a constant rate.
+// Each request consumes the cost of its endpoint, so the expensive endpoints can be called less often
+type tokenBucketLimiter struct {
+	mutBuckets     sync.Mutex
+	buckets        map[string]*bucket
+	capacity       float64
+	refillRate     float64
+	defaultCost    uint32
+	endpointsCosts map[string]uint32
+	trustedProxies []*net.IPNet
+	getTimeHandler func() time.Time
+}
+
+// NewTokenBucketLimiter creates a new instance of a tokenBucketLimiter
+func NewTokenBucketLimiter(args ArgsTokenBucketLimiter) (*tokenBucketLimiter, error) {
+	err := checkTokenBucketLimiterArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	trustedProxies, err := parseTrustedProxies(args.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+
+	endpointsCosts := make(map[string]uint32, len(args.EndpointsCosts))
+	for endpoint, cost := range args.EndpointsCosts {
+		endpointsCosts[endpoint] = cost
+	}
+
+	return &tokenBucketLimiter{
+		buckets:        make(map[string]*bucket),
+		capacity:       float64(args.BucketCapacity),
+		refillRate:     float64(args.RefillTokensPerSecond),
+		defaultCost:    args.DefaultCost,
+		endpointsCosts: endpointsCosts,
+		trustedProxies: trustedProxies,
+		getTimeHandler: time.Now,
+	}, nil
+}
+
+func checkTokenBucketLimiterArgs(args ArgsTokenBucketLimiter) error {
+	if args.BucketCapacity == 0 {
+		return fmt.Errorf("%w for the bucket capacity", ErrInvalidRateLimiterValue)
+	}
+	if args.RefillTokensPerSecond == 0 {
+		return fmt.Errorf("%w for the refill tokens per second", ErrInvalidRateLimiterValue)
+	}
+	if args.DefaultCost == 0 || args.DefaultCost > args.BucketCapacity {
+		return fmt.Errorf("%w for the default cost, it should be between 1 and the bucket capacity", ErrInvalidRateLimiterValue)
+	}
+	for endpoint, cost := range args.EndpointsCosts {
+		if cost == 0 || cost > args.BucketCapacity {
+			return fmt.Errorf("%w for the cost of endpoint %s, it should be between 1 and the bucket capacity",
+				ErrInvalidRateLimiterValue, endpoint)
+		}
+	}
+
+	return nil
+}
+
+func parseTrustedProxies(trustedProxies []string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(trustedProxies))
+	for _, proxy := range trustedProxies {
+		proxy = strings.TrimSpace(proxy)
+		if strings.Contains(proxy, "/") {
+			_, network, err := net.ParseCIDR(proxy)
+			if err != nil {
+				return nil, fmt.Errorf("%w %s: %s", ErrInvalidTrustedProxy, proxy, err.Error())
+			}
+
+			networks = append(networks, network)
+			continue
+		}
+
+		ip := net.ParseIP(proxy)
+		if ip == nil {
+			return nil, fmt.Errorf("%w %s", ErrInvalidTrustedProxy, proxy)
+		}
+
+		numBits := 8 * net.IPv6len
+		if ip.To4() != nil {
+			ip = ip.To4()
+			numBits = 8 * net.IPv4len
+		}
+		networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(numBits, numBits)})
+	}
+
+	return networks, nil
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+func (tbl *tokenBucketLimiter) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key, err := tbl.getCallerKey(c)
+		if err != nil {
+			c.AbortWithStatusJSON(
+				http.StatusInternalServerError,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: err.Error(),
+					Code:  shared.ReturnCodeInternalError,
+				},
+			)
+			return
+		}
+
+		cost := tbl.getCost(c.FullPath())
+		isAllowed, remaining := tbl.consume(key, cost)
+
+		c.Header(rateLimitLimitHeader, strconv.FormatUint(uint64(tbl.capacity), 10))
+		c.Header(rateLimitRemainingHeader, strconv.FormatUint(uint64(remaining), 10))
+		c.Header(rateLimitResetHeader, strconv.FormatUint(tbl.secondsToRefill(remaining, tbl.capacity), 10))
+
+		if !isAllowed {
+			c.Header(retryAfterHeader, strconv.FormatUint(tbl.secondsToRefill(remaining, float64(cost)), 10))
+			c.AbortWithStatusJSON(
+				http.StatusTooManyRequests,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s for %s", ErrTooManyRequests.Error(), key),
+					Code:  shared.ReturnCodeSystemBusy,
+				},
+			)
+			return
+		}
+
+		c.Set(endpointCostConsumerContextKey, endpointCostConsumer(func(endpoint string) bool {
+			isEndpointAllowed, _ := tbl.consume(key, tbl.getCost(endpoint))
+			return isEndpointAllowed
+		}))
+		c.Next()
+	}
+}
+
+// ConsumeEndpointCost takes the cost of the provided endpoint out of the bucket of the request's caller, returning
+// false if there are not enough tokens left. It is used by the handlers serving several calls within one request,
+// such as the JSON-RPC batches, each call being charged as the REST endpoint it mirrors. The calls are always allowed
+// if the rate limiter is disabled
+func ConsumeEndpointCost(c *gin.Context, endpoint string) bool {
+	value, exists := c.Get(endpointCostConsumerContextKey)
+	if !exists {
+		return true
+	}
+
+	consumer, ok := value.(endpointCostConsumer)
+	if !ok {
+		return true
+	}
+
+	return consumer(endpoint)
+}
+
+// ConsumeCallerCost takes the cost of the provided endpoint out of the bucket of the caller with the provided identity
+// and remote address, returning false if there are not enough tokens left. The forwarded for values are treated as the
+// X-Forwarded-For header values. It is used by the servers not going through gin, such as the gRPC one, so that their
+// calls are charged from the same buckets as the REST requests
+func (tbl *tokenBucketLimiter) ConsumeCallerCost(identity string, remoteAddr string, forwardedFor []string, endpoint string) (bool, error) {
+	key, err := tbl.createCallerKey(identity, remoteAddr, forwardedFor)
+	if err != nil {
+		return false, err
+	}
+
+	isAllowed, _ := tbl.consume(key, tbl.getCost(endpoint))
+
+	return isAllowed, nil
+}
+
+func (tbl *tokenBucketLimiter) getCallerKey(c *gin.Context) (string, error) {
+	return tbl.createCallerKey(
+		c.GetString(shared.AccessIdentityContextKey),
+		c.Request.RemoteAddr,
+		c.Request.Header.Values(forwardedForHeader),
+	)
+}
+
+// createCallerKey returns the identity of the authenticated callers or the IP address of the anonymous ones
+func (tbl *tokenBucketLimiter) createCallerKey(identity string, remoteAddr string, forwardedFor []string) (string, error) {
+	if len(identity) > 0 && identity != anonymousIdentity {
+		return identity, nil
+	}
+
+	remoteIP, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return "", err
+	}
+
+	return "address " + tbl.getClientIP(remoteIP, forwardedFor), nil
+}
+
+// getClientIP walks the X-Forwarded-For chain from right to left and returns the first address not belonging to a
+// trusted proxy. The header is used only if the request comes from a trusted proxy, as the callers can set it to any value
+func (tbl *tokenBucketLimiter) getClientIP(remoteAddr string, forwardedForValues []string) string {
+	if !tbl.isTrustedProxy(remoteAddr) {
+		return remoteAddr
+	}
+
+	forwardedFor := make([]string, 0)
+	for _, value := range forwardedForValues {
+		for _, address := range strings.Split(value, ",") {
+			address = strings.TrimSpace(address)
+			if len(address) > 0 {
+				forwardedFor = append(forwardedFor, address)
+			}
+		}
+	}
+
+	clientIP := remoteAddr
+	for i := len(forwardedFor) - 1; i >= 0; i-- {
+		clientIP = forwardedFor[i]
+		if !tbl.isTrustedProxy(clientIP) {
+			break
+		}
+	}
+
+	return clientIP
+}
+
+func (tbl *tokenBucketLimiter) isTrustedProxy(address string) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+
+	for _, network := range tbl.trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (tbl *tokenBucketLimiter) getCost(endpoint string) uint32 {
+	cost, ok := tbl.endpointsCosts[endpoint]
+	if !ok {
+		return tbl.defaultCost
+	}
+
+	return cost
+}
+
+// consume refills the caller's bucket and takes the request cost out of it, if there are enough tokens. Returns
+// whether the request is allowed and the number of tokens left in the bucket
+func (tbl *tokenBucketLimiter) consume(key string, cost uint32) (bool, float64) {
+	now := tbl.getTimeHandler()
+
+	tbl.mutBuckets.Lock()
+	defer tbl.mutBuckets.Unlock()
+
+	b, ok := tbl.buckets[key]
+	if !ok {
+		b = &bucket{
+			tokens:     tbl.capacity,
+			lastRefill: now,
+		}
+		tbl.buckets[key] = b
+	}
+	tbl.refill(b, now)
+
+	if b.tokens < float64(cost) {
+		return false, b.tokens
+	}
+
+	b.tokens -= float64(cost)
+
+	return true, b.tokens
+}
+
+func (tbl *tokenBucketLimiter) refill(b *bucket, now time.Time) {
+	elapsed := now.Sub(b.lastRefill).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+
+	b.tokens = math.Min(tbl.capacity, b.tokens+elapsed*tbl.refillRate)
+	b.lastRefill = now
+}
+
+func (tbl *tokenBucketLimiter) secondsToRefill(tokens float64, target float64) uint64 {
+	if tokens >= target {
+		return 0
+	}
+
+	return uint64(math.Ceil((target - tokens) / tbl.refillRate))
+}
+
+// Reset removes the buckets already refilled, as they are equivalent to new ones
+func (tbl *tokenBucketLimiter) Reset() {
+	now := tbl.getTimeHandler()
+
+	tbl.mutBuckets.Lock()
+	for key, b := range tbl.buckets {
+		tbl.refill(b, now)
+		if b.tokens >= tbl.capacity {
+			delete(tbl.buckets, key)
+		}
+	}
+	tbl.mutBuckets.Unlock()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tbl *tokenBucketLimiter) IsInterfaceNil() bool {
+	return tbl == nil
+}
@@ -0,0 +1,344 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type timeHandlerMock struct {
+	now time.Time
+}
+
+func (thm *timeHandlerMock) Now() time.Time {
+	return thm.now
+}
+
+func (thm *timeHandlerMock) Advance(duration time.Duration) {
+	thm.now = thm.now.Add(duration)
+}
+
+func createMockArgsTokenBucketLimiter() middleware.ArgsTokenBucketLimiter {
+	return middleware.ArgsTokenBucketLimiter{
+		BucketCapacity:        10,
+		RefillTokensPerSecond: 2,
+		DefaultCost:           1,
+		EndpointsCosts: map[string]uint32{
+			"/address/:address/keys": 4,
+		},
+		TrustedProxies: []string{"10.0.0.1", "192.168.0.0/16"},
+	}
+}
+
+func startNodeServerTokenBucketLimiter(tb testing.TB, args middleware.ArgsTokenBucketLimiter, identity string) (*gin.Engine, *timeHandlerMock) {
+	limiter, err := middleware.NewTokenBucketLimiter(args)
+	require.NoError(tb, err)
+
+	timeHandler := &timeHandlerMock{now: time.Unix(1700000000, 0)}
+	limiter.SetTimeHandler(timeHandler.Now)
+
+	ws := gin.New()
+	ws.Use(func(c *gin.Context) {
+		if len(identity) > 0 {
+			c.Set(shared.AccessIdentityContextKey, identity)
+		}
+		c.Next()
+	})
+	ws.Use(limiter.MiddlewareHandlerFunc())
+	handler := func(c *gin.Context) {
+		c.JSON(http.StatusOK, "ok")
+	}
+	ws.GET("/node/status", handler)
+	ws.GET("/address/:address/keys", handler)
+
+	return ws, timeHandler
+}
+
+func doRateLimitedRequest(ws *gin.Engine, path string, remoteAddr string, forwardedFor string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(http.MethodGet, path, nil)
+	req.RemoteAddr = remoteAddr
+	if len(forwardedFor) > 0 {
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewTokenBucketLimiter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("zero bucket capacity should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTokenBucketLimiter()
+		args.BucketCapacity = 0
+		limiter, err := middleware.NewTokenBucketLimiter(args)
+		assert.True(t, errors.Is(err, middleware.ErrInvalidRateLimiterValue))
+		assert.True(t, check.IfNil(limiter))
+	})
+	t.Run("zero refill rate should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTokenBucketLimiter()
+		args.RefillTokensPerSecond = 0
+		limiter, err := middleware.NewTokenBucketLimiter(args)
+		assert.True(t, errors.Is(err, middleware.ErrInvalidRateLimiterValue))
+		assert.True(t, check.IfNil(limiter))
+	})
+	t.Run("invalid default cost should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTokenBucketLimiter()
+		args.DefaultCost = 0
+		limiter, err := middleware.NewTokenBucketLimiter(args)
+		assert.True(t, errors.Is(err, middleware.ErrInvalidRateLimiterValue))
+		assert.True(t, check.IfNil(limiter))
+
+		args.DefaultCost = args.BucketCapacity + 1
+		limiter, err = middleware.NewTokenBucketLimiter(args)
+		assert.True(t, errors.Is(err, middleware.ErrInvalidRateLimiterValue))
+		assert.True(t, check.IfNil(limiter))
+	})
+	t.Run("endpoint cost higher than the capacity should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTokenBucketLimiter()
+		args.EndpointsCosts["/vm-values/query"] = args.BucketCapacity + 1
+		limiter, err := middleware.NewTokenBucketLimiter(args)
+		assert.True(t, errors.Is(err, middleware.ErrInvalidRateLimiterValue))
+		assert.True(t, strings.Contains(err.Error(), "/vm-values/query"))
+		assert.True(t, check.IfNil(limiter))
+	})
+	t.Run("invalid trusted proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTokenBucketLimiter()
+		args.TrustedProxies = []string{"proxy"}
+		limiter, err := middleware.NewTokenBucketLimiter(args)
+		assert.True(t, errors.Is(err, middleware.ErrInvalidTrustedProxy))
+		assert.True(t, check.IfNil(limiter))
+
+		args.TrustedProxies = []string{"10.0.0.0/40"}
+		limiter, err = middleware.NewTokenBucketLimiter(args)
+		assert.True(t, errors.Is(err, middleware.ErrInvalidTrustedProxy))
+		assert.True(t, check.IfNil(limiter))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		limiter, err := middleware.NewTokenBucketLimiter(createMockArgsTokenBucketLimiter())
+		assert.NoError(t, err)
+		assert.False(t, check.IfNil(limiter))
+	})
+}
+
+func TestTokenBucketLimiter_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should consume the endpoint cost and set the headers", func(t *testing.T) {
+		t.Parallel()
+
+		ws, timeHandler := startNodeServerTokenBucketLimiter(t, createMockArgsTokenBucketLimiter(), "")
+
+		resp := doRateLimitedRequest(ws, "/node/status", "1.2.3.4:1111", "")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "10", resp.Header().Get("X-RateLimit-Limit"))
+		assert.Equal(t, "9", resp.Header().Get("X-RateLimit-Remaining"))
+		assert.Equal(t, "1", resp.Header().Get("X-RateLimit-Reset"))
+		assert.Empty(t, resp.Header().Get("Retry-After"))
+
+		resp = doRateLimitedRequest(ws, "/address/erd1/keys", "1.2.3.4:1111", "")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "5", resp.Header().Get("X-RateLimit-Remaining"))
+		resp = doRateLimitedRequest(ws, "/address/erd1/keys", "1.2.3.4:2222", "")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "1", resp.Header().Get("X-RateLimit-Remaining"))
+		assert.Equal(t, "5", resp.Header().Get("X-RateLimit-Reset"))
+
+		resp = doRateLimitedRequest(ws, "/address/erd1/keys", "1.2.3.4:1111", "")
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+		assert.Equal(t, "2", resp.Header().Get("Retry-After"))
+		assert.True(t, strings.Contains(resp.Body.String(), middleware.ErrTooManyRequests.Error()))
+
+		// the cheap endpoints can still be called
+		resp = doRateLimitedRequest(ws, "/node/status", "1.2.3.4:1111", "")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "0", resp.Header().Get("X-RateLimit-Remaining"))
+
+		// other callers have their own buckets
+		resp = doRateLimitedRequest(ws, "/address/erd1/keys", "5.6.7.8:1111", "")
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		timeHandler.Advance(2 * time.Second)
+		resp = doRateLimitedRequest(ws, "/address/erd1/keys", "1.2.3.4:1111", "")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "0", resp.Header().Get("X-RateLimit-Remaining"))
+	})
+	t.Run("authenticated callers should be identified by their credentials", func(t *testing.T) {
+		t.Parallel()
+
+		ws, _ := startNodeServerTokenBucketLimiter(t, createMockArgsTokenBucketLimiter(), "token 0a1b2c3d")
+		for i := 0; i < 10; i++ {
+			resp := doRateLimitedRequest(ws, "/node/status", "1.2.3.4:1111", "")
+			assert.Equal(t, http.StatusOK, resp.Code)
+		}
+
+		resp := doRateLimitedRequest(ws, "/node/status", "5.6.7.8:1111", "")
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+		assert.True(t, strings.Contains(resp.Body.String(), "token 0a1b2c3d"))
+	})
+	t.Run("forwarded for header should be used only for trusted proxies", func(t *testing.T) {
+		t.Parallel()
+
+		ws, _ := startNodeServerTokenBucketLimiter(t, createMockArgsTokenBucketLimiter(), "")
+
+		// the untrusted caller can not spoof its address
+		for i := 0; i < 10; i++ {
+			resp := doRateLimitedRequest(ws, "/node/status", "1.2.3.4:1111", "5.5.5."+string(rune('0'+i)))
+			assert.Equal(t, http.StatusOK, resp.Code)
+		}
+		resp := doRateLimitedRequest(ws, "/node/status", "1.2.3.4:1111", "6.6.6.6")
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+
+		// behind the trusted proxies, the first untrusted address from the right is the caller
+		for i := 0; i < 10; i++ {
+			resp = doRateLimitedRequest(ws, "/node/status", "10.0.0.1:1111", "9.9.9.9, 7.7.7.7, 192.168.1.1")
+			assert.Equal(t, http.StatusOK, resp.Code)
+		}
+		resp = doRateLimitedRequest(ws, "/node/status", "10.0.0.1:2222", "7.7.7.7")
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+		assert.True(t, strings.Contains(resp.Body.String(), "7.7.7.7"))
+
+		resp = doRateLimitedRequest(ws, "/node/status", "10.0.0.1:2222", "9.9.9.9")
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+	t.Run("invalid remote address should error", func(t *testing.T) {
+		t.Parallel()
+
+		ws, _ := startNodeServerTokenBucketLimiter(t, createMockArgsTokenBucketLimiter(), "")
+		resp := doRateLimitedRequest(ws, "/node/status", "invalid", "")
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	})
+}
+
+func TestConsumeEndpointCost(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without the rate limiter the calls should be allowed", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		for i := 0; i < 100; i++ {
+			assert.True(t, middleware.ConsumeEndpointCost(c, "/address/:address/keys"))
+		}
+	})
+	t.Run("should charge the endpoint cost to the caller's bucket", func(t *testing.T) {
+		t.Parallel()
+
+		limiter, err := middleware.NewTokenBucketLimiter(createMockArgsTokenBucketLimiter())
+		require.NoError(t, err)
+		timeHandler := &timeHandlerMock{now: time.Unix(1700000000, 0)}
+		limiter.SetTimeHandler(timeHandler.Now)
+
+		results := make([]bool, 0)
+		ws := gin.New()
+		ws.Use(limiter.MiddlewareHandlerFunc())
+		ws.GET("/rpc", func(c *gin.Context) {
+			// the request itself already took 1 token, 9 are left
+			results = append(results, middleware.ConsumeEndpointCost(c, "/address/:address/keys"))
+			results = append(results, middleware.ConsumeEndpointCost(c, "/address/:address/keys"))
+			results = append(results, middleware.ConsumeEndpointCost(c, "/address/:address/keys"))
+			results = append(results, middleware.ConsumeEndpointCost(c, "/node/status"))
+			c.JSON(http.StatusOK, "ok")
+		})
+
+		resp := doRateLimitedRequest(ws, "/rpc", "1.2.3.4:1111", "")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, []bool{true, true, false, true}, results)
+
+		resp = doRateLimitedRequest(ws, "/rpc", "1.2.3.4:1111", "")
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	})
+}
+
+func TestTokenBucketLimiter_ConsumeCallerCost(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid remote address should error", func(t *testing.T) {
+		t.Parallel()
+
+		limiter, _ := middleware.NewTokenBucketLimiter(createMockArgsTokenBucketLimiter())
+		isAllowed, err := limiter.ConsumeCallerCost("", "invalid address", nil, "/node/status")
+		assert.NotNil(t, err)
+		assert.False(t, isAllowed)
+	})
+	t.Run("should charge the callers' buckets", func(t *testing.T) {
+		t.Parallel()
+
+		limiter, _ := middleware.NewTokenBucketLimiter(createMockArgsTokenBucketLimiter())
+		timeHandler := &timeHandlerMock{now: time.Unix(1700000000, 0)}
+		limiter.SetTimeHandler(timeHandler.Now)
+
+		consume := func(identity string, remoteAddr string, forwardedFor []string) bool {
+			isAllowed, err := limiter.ConsumeCallerCost(identity, remoteAddr, forwardedFor, "/address/:address/keys")
+			require.NoError(t, err)
+			return isAllowed
+		}
+
+		assert.True(t, consume("", "1.2.3.4:1111", nil))
+		assert.True(t, consume("anonymous", "1.2.3.4:2222", nil))
+		assert.False(t, consume("", "1.2.3.4:3333", nil))
+
+		// the forwarded for values are used only for the trusted proxies
+		assert.True(t, consume("", "10.0.0.1:1111", []string{"5.6.7.8"}))
+		assert.True(t, consume("", "10.0.0.1:1111", []string{"5.6.7.8"}))
+		assert.False(t, consume("", "5.6.7.8:1111", []string{"1.1.1.1"}))
+
+		// the authenticated callers have their own buckets
+		assert.True(t, consume("token abcd", "1.2.3.4:1111", nil))
+
+		timeHandler.Advance(2 * time.Second)
+		assert.True(t, consume("", "1.2.3.4:1111", nil))
+	})
+}
+
+func TestTokenBucketLimiter_Reset(t *testing.T) {
+	t.Parallel()
+
+	limiter, err := middleware.NewTokenBucketLimiter(createMockArgsTokenBucketLimiter())
+	require.NoError(t, err)
+
+	timeHandler := &timeHandlerMock{now: time.Unix(1700000000, 0)}
+	limiter.SetTimeHandler(timeHandler.Now)
+
+	ws := gin.New()
+	ws.Use(limiter.MiddlewareHandlerFunc())
+	ws.GET("/address/:address/keys", func(c *gin.Context) {
+		c.JSON(http.StatusOK, "ok")
+	})
+
+	_ = doRateLimitedRequest(ws, "/address/erd1/keys", "1.2.3.4:1111", "")
+	timeHandler.Advance(time.Second)
+	_ = doRateLimitedRequest(ws, "/address/erd1/keys", "5.6.7.8:1111", "")
+	assert.Equal(t, 2, limiter.NumBuckets())
+
+	timeHandler.Advance(time.Second)
+	limiter.Reset()
+	assert.Equal(t, 1, limiter.NumBuckets())
+
+	timeHandler.Advance(time.Second)
+	limiter.Reset()
+	assert.Equal(t, 0, limiter.NumBuckets())
+}
@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"crypto/tls"
+
+	"github.com/multiversx/mx-chain-go/api/shared"
+)
+
+// AuthenticatorStub -
+type AuthenticatorStub struct {
+	AuthenticateCalled func(header string, tlsState *tls.ConnectionState) (shared.AccessRole, string, bool)
+}
+
+// Authenticate -
+func (stub *AuthenticatorStub) Authenticate(header string, tlsState *tls.ConnectionState) (shared.AccessRole, string, bool) {
+	if stub.AuthenticateCalled != nil {
+		return stub.AuthenticateCalled(header, tlsState)
+	}
+
+	return shared.RolePublic, "", true
+}
+
+// IsInterfaceNil -
+func (stub *AuthenticatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -22,82 +22,97 @@ import (
 
 // FacadeStub is the mock implementation of a node router handler
 type FacadeStub struct {
-	ShouldErrorStart                            bool
-	ShouldErrorStop                             bool
-	GetHeartbeatsHandler                        func() ([]data.PubKeyHeartbeat, error)
-	GetBalanceCalled                            func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error)
-	GetAccountCalled                            func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
-	GetAccountsCalled                           func(addresses []string, options api.AccountQueryOptions) (map[string]*api.AccountResponse, api.BlockInfo, error)
-	GenerateTransactionHandler                  func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
-	GetTransactionHandler                       func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
-	CreateTransactionHandler                    func(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
-	ValidateTransactionHandler                  func(tx *transaction.Transaction) error
-	ValidateTransactionForSimulationHandler     func(tx *transaction.Transaction, bypassSignature bool) error
-	SendBulkTransactionsHandler                 func(txs []*transaction.Transaction) (uint64, error)
-	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error)
-	StatusMetricsHandler                        func() external.StatusMetricsHandler
-	ValidatorStatisticsHandler                  func() (map[string]*validator.ValidatorStatistics, error)
-	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
-	NodeConfigCalled                            func() map[string]interface{}
-	GetQueryHandlerCalled                       func(name string) (debug.QueryHandler, error)
-	GetValueForKeyCalled                        func(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
-	GetGuardianDataCalled                       func(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
-	GetPeerInfoCalled                           func(pid string) ([]core.QueryP2PPeerInfo, error)
-	GetConnectedPeersRatingsOnMainNetworkCalled func() (string, error)
-	GetEpochStartDataAPICalled                  func(epoch uint32) (*common.EpochStartDataAPI, error)
-	GetThrottlerForEndpointCalled               func(endpoint string) (core.Throttler, bool)
-	GetUsernameCalled                           func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
-	GetCodeHashCalled                           func(address string, options api.AccountQueryOptions) ([]byte, api.BlockInfo, error)
-	GetKeyValuePairsCalled                      func(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
-	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
-	GetESDTDataCalled                           func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error)
-	GetAllESDTTokensCalled                      func(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
-	GetESDTsWithRoleCalled                      func(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
-	GetESDTsRolesCalled                         func(address string, options api.AccountQueryOptions) (map[string][]string, api.BlockInfo, error)
-	GetNFTTokenIDsRegisteredByAddressCalled     func(address string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
-	GetBlockByHashCalled                        func(hash string, options api.BlockQueryOptions) (*api.Block, error)
-	GetBlockByNonceCalled                       func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
-	GetAlteredAccountsForBlockCalled            func(options api.GetAlteredAccountsForBlockOptions) ([]*alteredAccount.AlteredAccount, error)
-	GetBlockByRoundCalled                       func(round uint64, options api.BlockQueryOptions) (*api.Block, error)
-	GetInternalShardBlockByNonceCalled          func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
-	GetInternalShardBlockByHashCalled           func(format common.ApiOutputFormat, hash string) (interface{}, error)
-	GetInternalShardBlockByRoundCalled          func(format common.ApiOutputFormat, round uint64) (interface{}, error)
-	GetInternalMetaBlockByNonceCalled           func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
-	GetInternalMetaBlockByHashCalled            func(format common.ApiOutputFormat, hash string) (interface{}, error)
-	GetInternalMetaBlockByRoundCalled           func(format common.ApiOutputFormat, round uint64) (interface{}, error)
-	GetInternalStartOfEpochMetaBlockCalled      func(format common.ApiOutputFormat, epoch uint32) (interface{}, error)
-	GetInternalStartOfEpochValidatorsInfoCalled func(epoch uint32) ([]*state.ShardValidatorInfo, error)
-	GetInternalMiniBlockByHashCalled            func(format common.ApiOutputFormat, txHash string, epoch uint32) (interface{}, error)
-	GetTotalStakedValueHandler                  func() (*api.StakeValues, error)
-	GetAllIssuedESDTsCalled                     func(tokenType string) ([]string, error)
-	GetDirectStakedListHandler                  func() ([]*api.DirectStakedValue, error)
-	GetDelegatorsListHandler                    func() ([]*api.Delegator, error)
-	GetProofCalled                              func(string, string) (*common.GetProofResponse, error)
-	GetProofCurrentRootHashCalled               func(string) (*common.GetProofResponse, error)
-	GetProofDataTrieCalled                      func(string, string, string) (*common.GetProofResponse, *common.GetProofResponse, error)
-	VerifyProofCalled                           func(string, string, [][]byte) (bool, error)
-	GetTokenSupplyCalled                        func(token string) (*api.ESDTSupply, error)
-	GetGenesisNodesPubKeysCalled                func() (map[uint32][]string, map[uint32][]string, error)
-	GetGenesisBalancesCalled                    func() ([]*common.InitialAccountAPI, error)
-	GetTransactionsPoolCalled                   func(fields string) (*common.TransactionsPoolAPIResponse, error)
-	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
-	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
-	GetTransactionsPoolNonceGapsForSenderCalled func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
-	GetGasConfigsCalled                         func() (map[string]map[string]uint64, error)
-	RestApiInterfaceCalled                      func() string
-	RestAPIServerDebugModeCalled                func() bool
-	PprofEnabledCalled                          func() bool
-	DecodeAddressPubkeyCalled                   func(pk string) ([]byte, error)
-	IsDataTrieMigratedCalled                    func(address string, options api.AccountQueryOptions) (bool, error)
-	GetManagedKeysCountCalled                   func() int
-	GetManagedKeysCalled                        func() []string
-	GetLoadedKeysCalled                         func() []string
-	GetEligibleManagedKeysCalled                func() ([]string, error)
-	GetWaitingManagedKeysCalled                 func() ([]string, error)
-	GetWaitingEpochsLeftForPublicKeyCalled      func(publicKey string) (uint32, error)
-	P2PPrometheusMetricsEnabledCalled           func() bool
-	AuctionListHandler                          func() ([]*common.AuctionListValidatorAPIResponse, error)
-	GetSCRsByTxHashCalled                       func(txHash string, scrHash string) ([]*transaction.ApiSmartContractResult, error)
+	ShouldErrorStart                                         bool
+	ShouldErrorStop                                          bool
+	GetHeartbeatsHandler                                     func() ([]data.PubKeyHeartbeat, error)
+	GetBalanceCalled                                         func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error)
+	GetAccountCalled                                         func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
+	GetAccountsCalled                                        func(addresses []string, options api.AccountQueryOptions) (map[string]*api.AccountResponse, api.BlockInfo, error)
+	GenerateTransactionHandler                               func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
+	GetTransactionHandler                                    func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	CreateTransactionHandler                                 func(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
+	ValidateTransactionHandler                               func(tx *transaction.Transaction) error
+	ValidateTransactionForSimulationHandler                  func(tx *transaction.Transaction, bypassSignature bool) error
+	ValidateTransactionForSimulationWithStateOverridesCalled func(tx *transaction.Transaction, bypassSignature bool, overrides []*txSimData.StateOverride) error
+	SendBulkTransactionsHandler                              func(txs []*transaction.Transaction) (uint64, error)
+	ExecuteSCQueryHandler                                    func(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error)
+	ExecuteSCQueriesCalled                                   func(queries []*process.SCQuery) ([]*common.SCQueryResultAPI, api.BlockInfo, error)
+	StatusMetricsHandler                                     func() external.StatusMetricsHandler
+	ValidatorStatisticsHandler                               func() (map[string]*validator.ValidatorStatistics, error)
+	ComputeTransactionGasLimitHandler                        func(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	NodeConfigCalled                                         func() map[string]interface{}
+	GetQueryHandlerCalled                                    func(name string) (debug.QueryHandler, error)
+	GetValueForKeyCalled                                     func(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
+	GetGuardianDataCalled                                    func(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
+	GetPeerInfoCalled                                        func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetConnectedPeersRatingsOnMainNetworkCalled              func() (string, error)
+	GetEpochStartDataAPICalled                               func(epoch uint32) (*common.EpochStartDataAPI, error)
+	GetThrottlerForEndpointCalled                            func(endpoint string) (core.Throttler, bool)
+	GetUsernameCalled                                        func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
+	GetCodeHashCalled                                        func(address string, options api.AccountQueryOptions) ([]byte, api.BlockInfo, error)
+	GetKeyValuePairsCalled                                   func(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
+	SimulateTransactionExecutionHandler                      func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionExecutionWithStateOverridesCalled     func(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*txSimData.SimulationResultsWithVMOutput, error)
+	TraceTransactionExecutionCalled                          func(tx *transaction.Transaction, overrides []*txSimData.StateOverride, blockNonce core.OptionalUint64) (*txSimData.ExecutionTrace, error)
+	GetTransactionExecutionTraceCalled                       func(hash string) (*txSimData.ExecutionTrace, error)
+	ComputeTransactionGasLimitWithStateOverridesCalled       func(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*transaction.CostResponse, error)
+	GetESDTDataCalled                                        func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error)
+	GetAllESDTTokensCalled                                   func(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
+	GetESDTsWithRoleCalled                                   func(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
+	GetESDTsRolesCalled                                      func(address string, options api.AccountQueryOptions) (map[string][]string, api.BlockInfo, error)
+	GetNFTTokenIDsRegisteredByAddressCalled                  func(address string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
+	GetBlockByHashCalled                                     func(hash string, options api.BlockQueryOptions) (*api.Block, error)
+	GetBlockByNonceCalled                                    func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetAlteredAccountsForBlockCalled                         func(options api.GetAlteredAccountsForBlockOptions) ([]*alteredAccount.AlteredAccount, error)
+	GetBlockByRoundCalled                                    func(round uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetInternalShardBlockByNonceCalled                       func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
+	GetInternalShardBlockByHashCalled                        func(format common.ApiOutputFormat, hash string) (interface{}, error)
+	GetInternalShardBlockByRoundCalled                       func(format common.ApiOutputFormat, round uint64) (interface{}, error)
+	GetInternalMetaBlockByNonceCalled                        func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
+	GetInternalMetaBlockByHashCalled                         func(format common.ApiOutputFormat, hash string) (interface{}, error)
+	GetInternalMetaBlockByRoundCalled                        func(format common.ApiOutputFormat, round uint64) (interface{}, error)
+	GetInternalStartOfEpochMetaBlockCalled                   func(format common.ApiOutputFormat, epoch uint32) (interface{}, error)
+	GetInternalStartOfEpochValidatorsInfoCalled              func(epoch uint32) ([]*state.ShardValidatorInfo, error)
+	GetInternalMiniBlockByHashCalled                         func(format common.ApiOutputFormat, txHash string, epoch uint32) (interface{}, error)
+	GetTotalStakedValueHandler                               func() (*api.StakeValues, error)
+	GetAllIssuedESDTsCalled                                  func(tokenType string) ([]string, error)
+	GetDirectStakedListHandler                               func() ([]*api.DirectStakedValue, error)
+	GetDelegatorsListHandler                                 func() ([]*api.Delegator, error)
+	GetProofCalled                                           func(string, string) (*common.GetProofResponse, error)
+	GetProofCurrentRootHashCalled                            func(string) (*common.GetProofResponse, error)
+	GetProofDataTrieCalled                                   func(string, string, string) (*common.GetProofResponse, *common.GetProofResponse, error)
+	VerifyProofCalled                                        func(string, string, [][]byte) (bool, error)
+	GetTokenSupplyCalled                                     func(token string) (*api.ESDTSupply, error)
+	GetGenesisNodesPubKeysCalled                             func() (map[uint32][]string, map[uint32][]string, error)
+	GetGenesisBalancesCalled                                 func() ([]*common.InitialAccountAPI, error)
+	GetTransactionsPoolCalled                                func(fields string) (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionsPoolForSenderCalled                       func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
+	GetLastPoolNonceForSenderCalled                          func(sender string) (uint64, error)
+	GetTransactionsPoolNonceGapsForSenderCalled              func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsByAddressCalled                           func(address string, beforeNonce uint64, beforeEpoch uint32, pageSize uint32) (*common.AccountTransactionsAPIResponse, error)
+	GetGasConfigsCalled                                      func() (map[string]map[string]uint64, error)
+	RestApiInterfaceCalled                                   func() string
+	RestAPIServerDebugModeCalled                             func() bool
+	PprofEnabledCalled                                       func() bool
+	DecodeAddressPubkeyCalled                                func(pk string) ([]byte, error)
+	IsDataTrieMigratedCalled                                 func(address string, options api.AccountQueryOptions) (bool, error)
+	GetManagedKeysCountCalled                                func() int
+	GetManagedKeysCalled                                     func() []string
+	GetLoadedKeysCalled                                      func() []string
+	GetEligibleManagedKeysCalled                             func() ([]string, error)
+	GetWaitingManagedKeysCalled                              func() ([]string, error)
+	GetWaitingEpochsLeftForPublicKeyCalled                   func(publicKey string) (uint32, error)
+	SubscribeCalled                                          func(filter common.SubscriptionFilter) (common.Subscription, error)
+	GetEventsCalled                                          func(query common.EventsQuery) ([]*common.ApiEvent, error)
+	P2PPrometheusMetricsEnabledCalled                        func() bool
+	AuctionListHandler                                       func() ([]*common.AuctionListValidatorAPIResponse, error)
+	GetSCRsByTxHashCalled                                    func(txHash string, scrHash string) ([]*transaction.ApiSmartContractResult, error)
+	AddManagedKeyCalled                                      func(privateKey string) (string, error)
+	RemoveManagedKeyCalled                                   func(publicKey string) error
+	PauseManagedKeyCalled                                    func(publicKey string) error
+	ResumeManagedKeyCalled                                   func(publicKey string) error
+	StartTrieVerificationCalled                              func(rootHash string, heal bool) error
+	GetTrieVerificationStatusCalled                          func() (common.TrieVerificationStatus, error)
 }
 
 // GetSCRsByTxHash -
@@ -375,6 +390,15 @@ func (f *FacadeStub) ValidateTransactionForSimulation(tx *transaction.Transactio
 	return nil
 }
 
+// ValidateTransactionForSimulationWithStateOverrides -
+func (f *FacadeStub) ValidateTransactionForSimulationWithStateOverrides(tx *transaction.Transaction, bypassSignature bool, overrides []*txSimData.StateOverride) error {
+	if f.ValidateTransactionForSimulationWithStateOverridesCalled != nil {
+		return f.ValidateTransactionForSimulationWithStateOverridesCalled(tx, bypassSignature, overrides)
+	}
+
+	return nil
+}
+
 // ValidatorStatisticsApi is the mock implementation of a handler's ValidatorStatisticsApi method
 func (f *FacadeStub) ValidatorStatisticsApi() (map[string]*validator.ValidatorStatistics, error) {
 	if f.ValidatorStatisticsHandler != nil {
@@ -402,6 +426,15 @@ func (f *FacadeStub) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, ap
 	return nil, api.BlockInfo{}, nil
 }
 
+// ExecuteSCQueries -
+func (f *FacadeStub) ExecuteSCQueries(queries []*process.SCQuery) ([]*common.SCQueryResultAPI, api.BlockInfo, error) {
+	if f.ExecuteSCQueriesCalled != nil {
+		return f.ExecuteSCQueriesCalled(queries)
+	}
+
+	return nil, api.BlockInfo{}, nil
+}
+
 // StatusMetrics is the mock implementation for the StatusMetrics
 func (f *FacadeStub) StatusMetrics() external.StatusMetricsHandler {
 	if f.StatusMetricsHandler != nil {
@@ -438,6 +471,42 @@ func (f *FacadeStub) GetDelegatorsList() ([]*api.Delegator, error) {
 	return nil, nil
 }
 
+// SimulateTransactionExecutionWithStateOverrides -
+func (f *FacadeStub) SimulateTransactionExecutionWithStateOverrides(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*txSimData.SimulationResultsWithVMOutput, error) {
+	if f.SimulateTransactionExecutionWithStateOverridesCalled != nil {
+		return f.SimulateTransactionExecutionWithStateOverridesCalled(tx, overrides)
+	}
+
+	return nil, nil
+}
+
+// TraceTransactionExecution -
+func (f *FacadeStub) TraceTransactionExecution(tx *transaction.Transaction, overrides []*txSimData.StateOverride, blockNonce core.OptionalUint64) (*txSimData.ExecutionTrace, error) {
+	if f.TraceTransactionExecutionCalled != nil {
+		return f.TraceTransactionExecutionCalled(tx, overrides, blockNonce)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionExecutionTrace -
+func (f *FacadeStub) GetTransactionExecutionTrace(hash string) (*txSimData.ExecutionTrace, error) {
+	if f.GetTransactionExecutionTraceCalled != nil {
+		return f.GetTransactionExecutionTraceCalled(hash)
+	}
+
+	return nil, nil
+}
+
+// ComputeTransactionGasLimitWithStateOverrides -
+func (f *FacadeStub) ComputeTransactionGasLimitWithStateOverrides(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*transaction.CostResponse, error) {
+	if f.ComputeTransactionGasLimitWithStateOverridesCalled != nil {
+		return f.ComputeTransactionGasLimitWithStateOverridesCalled(tx, overrides)
+	}
+
+	return nil, nil
+}
+
 // ComputeTransactionGasLimit -
 func (f *FacadeStub) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
 	if f.ComputeTransactionGasLimitHandler != nil {
@@ -652,6 +721,15 @@ func (f *FacadeStub) GetTransactionsPoolNonceGapsForSender(sender string) (*comm
 	return nil, nil
 }
 
+// GetTransactionsByAddress -
+func (f *FacadeStub) GetTransactionsByAddress(address string, beforeNonce uint64, beforeEpoch uint32, pageSize uint32) (*common.AccountTransactionsAPIResponse, error) {
+	if f.GetTransactionsByAddressCalled != nil {
+		return f.GetTransactionsByAddressCalled(address, beforeNonce, beforeEpoch, pageSize)
+	}
+
+	return nil, nil
+}
+
 // GetGasConfigs -
 func (f *FacadeStub) GetGasConfigs() (map[string]map[string]uint64, error) {
 	if f.GetGasConfigsCalled != nil {
@@ -737,6 +815,70 @@ func (f *FacadeStub) GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32,
 	return 0, nil
 }
 
+// AddManagedKey -
+func (f *FacadeStub) AddManagedKey(privateKey string) (string, error) {
+	if f.AddManagedKeyCalled != nil {
+		return f.AddManagedKeyCalled(privateKey)
+	}
+	return "", nil
+}
+
+// RemoveManagedKey -
+func (f *FacadeStub) RemoveManagedKey(publicKey string) error {
+	if f.RemoveManagedKeyCalled != nil {
+		return f.RemoveManagedKeyCalled(publicKey)
+	}
+	return nil
+}
+
+// PauseManagedKey -
+func (f *FacadeStub) PauseManagedKey(publicKey string) error {
+	if f.PauseManagedKeyCalled != nil {
+		return f.PauseManagedKeyCalled(publicKey)
+	}
+	return nil
+}
+
+// ResumeManagedKey -
+func (f *FacadeStub) ResumeManagedKey(publicKey string) error {
+	if f.ResumeManagedKeyCalled != nil {
+		return f.ResumeManagedKeyCalled(publicKey)
+	}
+	return nil
+}
+
+// StartTrieVerification -
+func (f *FacadeStub) StartTrieVerification(rootHash string, heal bool) error {
+	if f.StartTrieVerificationCalled != nil {
+		return f.StartTrieVerificationCalled(rootHash, heal)
+	}
+	return nil
+}
+
+// GetTrieVerificationStatus -
+func (f *FacadeStub) GetTrieVerificationStatus() (common.TrieVerificationStatus, error) {
+	if f.GetTrieVerificationStatusCalled != nil {
+		return f.GetTrieVerificationStatusCalled()
+	}
+	return common.TrieVerificationStatus{}, nil
+}
+
+// Subscribe -
+func (f *FacadeStub) Subscribe(filter common.SubscriptionFilter) (common.Subscription, error) {
+	if f.SubscribeCalled != nil {
+		return f.SubscribeCalled(filter)
+	}
+	return nil, nil
+}
+
+// GetEvents -
+func (f *FacadeStub) GetEvents(query common.EventsQuery) ([]*common.ApiEvent, error) {
+	if f.GetEventsCalled != nil {
+		return f.GetEventsCalled(query)
+	}
+	return nil, nil
+}
+
 // P2PPrometheusMetricsEnabled -
 func (f *FacadeStub) P2PPrometheusMetricsEnabled() bool {
 	if f.P2PPrometheusMetricsEnabledCalled != nil {
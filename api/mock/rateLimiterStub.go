@@ -0,0 +1,20 @@
+package mock
+
+// RateLimiterStub -
+type RateLimiterStub struct {
+	ConsumeCallerCostCalled func(identity string, remoteAddr string, forwardedFor []string, endpoint string) (bool, error)
+}
+
+// ConsumeCallerCost -
+func (stub *RateLimiterStub) ConsumeCallerCost(identity string, remoteAddr string, forwardedFor []string, endpoint string) (bool, error) {
+	if stub.ConsumeCallerCostCalled != nil {
+		return stub.ConsumeCallerCostCalled(identity, remoteAddr, forwardedFor, endpoint)
+	}
+
+	return true, nil
+}
+
+// IsInterfaceNil -
+func (stub *RateLimiterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,70 @@
+package openapi
+
+// Document is the root object of an OpenAPI 3 specification
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+}
+
+// Info holds the metadata of the described API
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// PathItem holds the operations available on a path, keyed by the lowercase HTTP method
+type PathItem map[string]*Operation
+
+// Operation describes a single API operation on a path
+type Operation struct {
+	Tags        []string             `json:"tags,omitempty"`
+	Summary     string               `json:"summary,omitempty"`
+	OperationID string               `json:"operationId"`
+	Parameters  []*Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+// Parameter describes a single path or query parameter
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody describes the body of a request
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+// Response describes a response of an operation
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+// MediaType holds the schema of a request or response content
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components holds the reusable schemas of the document
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+// Schema describes a data type. Only the subset of the OpenAPI schema object needed by the node's API is supported
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+}
@@ -0,0 +1,9 @@
+package openapi
+
+import "errors"
+
+// ErrMissingEndpointDocs signals that an endpoint does not provide the docs needed for the OpenAPI document
+var ErrMissingEndpointDocs = errors.New("missing endpoint docs")
+
+// ErrDuplicatedEndpoint signals that the same endpoint was registered more than once
+var ErrDuplicatedEndpoint = errors.New("duplicated endpoint")
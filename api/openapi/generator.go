@@ -0,0 +1,200 @@
+package openapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/config"
+)
+
+const (
+	openAPIVersion  = "3.0.3"
+	jsonContentType = "application/json"
+	textContentType = "text/plain"
+	pathParamIn     = "path"
+	queryParamIn    = "query"
+)
+
+// ArgsGenerateDocument holds the arguments needed to generate the OpenAPI document
+type ArgsGenerateDocument struct {
+	Title     string
+	Version   string
+	Groups    map[string]shared.GroupHandler
+	ApiConfig config.ApiRoutesConfig
+}
+
+// GenerateDocument creates the OpenAPI document describing the open endpoints of the provided groups. All the
+// described endpoints must provide their docs
+func GenerateDocument(args ArgsGenerateDocument) (*Document, error) {
+	builder := newSchemaBuilder()
+	doc := &Document{
+		OpenAPI: openAPIVersion,
+		Info: Info{
+			Title:   args.Title,
+			Version: args.Version,
+		},
+		Paths: make(map[string]PathItem),
+		Components: Components{
+			Schemas: builder.schemas,
+		},
+	}
+
+	groupNames := make([]string, 0, len(args.Groups))
+	for groupName := range args.Groups {
+		groupNames = append(groupNames, groupName)
+	}
+	sort.Strings(groupNames)
+
+	for _, groupName := range groupNames {
+		for _, endpoint := range args.Groups[groupName].GetEndpoints() {
+			if !isEndpointOpen(args.ApiConfig, groupName, endpoint.Path) {
+				continue
+			}
+
+			err := addEndpoint(doc, builder, groupName, endpoint)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return doc, nil
+}
+
+func addEndpoint(doc *Document, builder *schemaBuilder, groupName string, endpoint *shared.EndpointHandlerData) error {
+	if endpoint.Docs == nil {
+		return fmt.Errorf("%w for %s /%s%s", ErrMissingEndpointDocs, endpoint.Method, groupName, endpoint.Path)
+	}
+
+	path, pathParams := convertPath("/" + groupName + endpoint.Path)
+	method := strings.ToLower(endpoint.Method)
+	pathItem, ok := doc.Paths[path]
+	if !ok {
+		pathItem = make(PathItem)
+		doc.Paths[path] = pathItem
+	}
+	if _, exists := pathItem[method]; exists {
+		return fmt.Errorf("%w for %s %s", ErrDuplicatedEndpoint, endpoint.Method, path)
+	}
+
+	operation := &Operation{
+		Tags:        []string{groupName},
+		Summary:     endpoint.Docs.Summary,
+		OperationID: endpoint.Method + " " + path,
+		Parameters:  make([]*Parameter, 0, len(pathParams)+len(endpoint.Docs.QueryParams)),
+		Responses:   createResponses(builder, endpoint.Docs),
+	}
+	for _, param := range pathParams {
+		operation.Parameters = append(operation.Parameters, &Parameter{
+			Name:     param,
+			In:       pathParamIn,
+			Required: true,
+			Schema:   &Schema{Type: shared.ParamTypeString},
+		})
+	}
+	for _, param := range endpoint.Docs.QueryParams {
+		operation.Parameters = append(operation.Parameters, &Parameter{
+			Name:        param.Name,
+			In:          queryParamIn,
+			Description: param.Description,
+			Required:    param.Required,
+			Schema:      &Schema{Type: param.Type},
+		})
+	}
+	if endpoint.Docs.RequestBody != nil {
+		operation.RequestBody = &RequestBody{
+			Required: true,
+			Content: map[string]*MediaType{
+				jsonContentType: {Schema: builder.schemaOf(endpoint.Docs.RequestBody)},
+			},
+		}
+	}
+
+	pathItem[method] = operation
+
+	return nil
+}
+
+// createResponses describes the success and the error responses, both wrapped in the generic API response unless the
+// endpoint responds outside of it
+func createResponses(builder *schemaBuilder, docs *shared.EndpointDocs) map[string]*Response {
+	dataSchema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	for field, value := range docs.ResponseData {
+		dataSchema.Properties[field] = builder.schemaOf(value)
+	}
+	if docs.ResponseDataType != nil {
+		dataSchema = builder.schemaOf(docs.ResponseDataType)
+	}
+
+	successContent := map[string]*MediaType{
+		jsonContentType: {Schema: createGenericResponseSchema(dataSchema)},
+	}
+	if docs.ResponseBody != nil {
+		successContent = map[string]*MediaType{
+			jsonContentType: {Schema: builder.schemaOf(docs.ResponseBody)},
+		}
+	}
+	if docs.PlainTextResponse {
+		successContent = map[string]*MediaType{
+			textContentType: {Schema: &Schema{Type: shared.ParamTypeString}},
+		}
+	}
+
+	return map[string]*Response{
+		"200": {
+			Description: "successful operation",
+			Content:     successContent,
+		},
+		"default": {
+			Description: "error",
+			Content: map[string]*MediaType{
+				jsonContentType: {Schema: createGenericResponseSchema(&Schema{})},
+			},
+		},
+	}
+}
+
+func createGenericResponseSchema(dataSchema *Schema) *Schema {
+	return &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"data":  dataSchema,
+			"error": {Type: shared.ParamTypeString},
+			"code":  {Type: shared.ParamTypeString},
+		},
+	}
+}
+
+// convertPath converts a gin path, like /address/:address/key/:key, to an OpenAPI path, like
+// /address/{address}/key/{key}, also returning the names of the path parameters
+func convertPath(ginPath string) (string, []string) {
+	params := make([]string, 0)
+	segments := strings.Split(ginPath, "/")
+	for i, segment := range segments {
+		if len(segment) < 2 || (segment[0] != ':' && segment[0] != '*') {
+			continue
+		}
+
+		params = append(params, segment[1:])
+		segments[i] = "{" + segment[1:] + "}"
+	}
+
+	return strings.Join(segments, "/"), params
+}
+
+func isEndpointOpen(apiConfig config.ApiRoutesConfig, groupName string, path string) bool {
+	group, ok := apiConfig.APIPackages[groupName]
+	if !ok {
+		return false
+	}
+
+	for _, route := range group.Routes {
+		if route.Name == path {
+			return route.Open
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,183 @@
+package openapi_test
+
+import (
+	"errors"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/api/openapi"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/testscommon/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testAccount struct {
+	Address string   `json:"address"`
+	Balance *big.Int `json:"balance"`
+	Nonce   uint64   `json:"nonce,omitempty"`
+	Hidden  string   `json:"-"`
+}
+
+type testRequest struct {
+	Accounts []*testAccount `json:"accounts"`
+}
+
+func createGroups(endpoints ...*shared.EndpointHandlerData) map[string]shared.GroupHandler {
+	return map[string]shared.GroupHandler{
+		"test": &api.GroupHandlerStub{
+			GetEndpointsCalled: func() []*shared.EndpointHandlerData {
+				return endpoints
+			},
+		},
+	}
+}
+
+func createApiConfig(routes ...string) config.ApiRoutesConfig {
+	routesConfig := make([]config.RouteConfig, 0, len(routes))
+	for _, route := range routes {
+		routesConfig = append(routesConfig, config.RouteConfig{Name: route, Open: true})
+	}
+
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"test": {Routes: routesConfig},
+		},
+	}
+}
+
+func TestGenerateDocument(t *testing.T) {
+	t.Parallel()
+
+	t.Run("endpoint without docs should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := openapi.ArgsGenerateDocument{
+			Groups:    createGroups(&shared.EndpointHandlerData{Path: "/account/:address", Method: http.MethodGet}),
+			ApiConfig: createApiConfig("/account/:address"),
+		}
+		doc, err := openapi.GenerateDocument(args)
+		assert.True(t, errors.Is(err, openapi.ErrMissingEndpointDocs))
+		assert.True(t, strings.Contains(err.Error(), "/test/account/:address"))
+		assert.Nil(t, doc)
+	})
+	t.Run("closed endpoint without docs should be skipped", func(t *testing.T) {
+		t.Parallel()
+
+		args := openapi.ArgsGenerateDocument{
+			Groups:    createGroups(&shared.EndpointHandlerData{Path: "/account/:address", Method: http.MethodGet}),
+			ApiConfig: createApiConfig(),
+		}
+		doc, err := openapi.GenerateDocument(args)
+		require.NoError(t, err)
+		assert.Empty(t, doc.Paths)
+	})
+	t.Run("duplicated endpoint should error", func(t *testing.T) {
+		t.Parallel()
+
+		endpoint := &shared.EndpointHandlerData{
+			Path:   "/account/:address",
+			Method: http.MethodGet,
+			Docs:   &shared.EndpointDocs{},
+		}
+		args := openapi.ArgsGenerateDocument{
+			Groups:    createGroups(endpoint, endpoint),
+			ApiConfig: createApiConfig("/account/:address"),
+		}
+		doc, err := openapi.GenerateDocument(args)
+		assert.True(t, errors.Is(err, openapi.ErrDuplicatedEndpoint))
+		assert.Nil(t, doc)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := openapi.ArgsGenerateDocument{
+			Title:   "title",
+			Version: "1.0.0",
+			Groups: createGroups(
+				&shared.EndpointHandlerData{
+					Path:   "/account/:address",
+					Method: http.MethodGet,
+					Docs: &shared.EndpointDocs{
+						Summary: "returns an account",
+						QueryParams: []shared.QueryParameter{
+							{Name: "onFinalBlock", Type: shared.ParamTypeBoolean, Description: "final"},
+						},
+						ResponseData: map[string]interface{}{"account": &testAccount{}, "blockInfo": nil},
+					},
+				},
+				&shared.EndpointHandlerData{
+					Path:   "/accounts",
+					Method: http.MethodPost,
+					Docs: &shared.EndpointDocs{
+						RequestBody:      testRequest{},
+						ResponseDataType: []testAccount{},
+					},
+				},
+				&shared.EndpointHandlerData{
+					Path:   "/metrics",
+					Method: http.MethodGet,
+					Docs: &shared.EndpointDocs{
+						PlainTextResponse: true,
+					},
+				},
+				&shared.EndpointHandlerData{
+					Path:   "/raw",
+					Method: http.MethodPost,
+					Docs: &shared.EndpointDocs{
+						ResponseBody: testAccount{},
+					},
+				},
+			),
+			ApiConfig: createApiConfig("/account/:address", "/accounts", "/metrics", "/raw"),
+		}
+		doc, err := openapi.GenerateDocument(args)
+		require.NoError(t, err)
+		assert.Equal(t, "3.0.3", doc.OpenAPI)
+		assert.Equal(t, "title", doc.Info.Title)
+		assert.Equal(t, "1.0.0", doc.Info.Version)
+		require.Equal(t, 4, len(doc.Paths))
+
+		getAccount := doc.Paths["/test/account/{address}"]["get"]
+		require.NotNil(t, getAccount)
+		assert.Equal(t, "returns an account", getAccount.Summary)
+		assert.Equal(t, []string{"test"}, getAccount.Tags)
+		require.Equal(t, 2, len(getAccount.Parameters))
+		assert.Equal(t, &openapi.Parameter{Name: "address", In: "path", Required: true, Schema: &openapi.Schema{Type: "string"}}, getAccount.Parameters[0])
+		assert.Equal(t, &openapi.Parameter{Name: "onFinalBlock", In: "query", Description: "final", Schema: &openapi.Schema{Type: "boolean"}}, getAccount.Parameters[1])
+		assert.Nil(t, getAccount.RequestBody)
+
+		responseSchema := getAccount.Responses["200"].Content["application/json"].Schema
+		dataSchema := responseSchema.Properties["data"]
+		assert.Equal(t, "#/components/schemas/openapi_test.testAccount", dataSchema.Properties["account"].Ref)
+		assert.Equal(t, &openapi.Schema{}, dataSchema.Properties["blockInfo"])
+		assert.Equal(t, "string", responseSchema.Properties["error"].Type)
+		assert.Equal(t, "string", responseSchema.Properties["code"].Type)
+		assert.NotNil(t, getAccount.Responses["default"])
+
+		accountSchema := doc.Components.Schemas["openapi_test.testAccount"]
+		require.NotNil(t, accountSchema)
+		assert.Equal(t, 3, len(accountSchema.Properties))
+		assert.Equal(t, "integer", accountSchema.Properties["balance"].Type)
+		assert.Equal(t, &openapi.Schema{Type: "integer", Format: "int64"}, accountSchema.Properties["nonce"])
+
+		postAccounts := doc.Paths["/test/accounts"]["post"]
+		require.NotNil(t, postAccounts)
+		requestSchema := postAccounts.RequestBody.Content["application/json"].Schema
+		assert.Equal(t, "#/components/schemas/openapi_test.testRequest", requestSchema.Ref)
+		dataSchema = postAccounts.Responses["200"].Content["application/json"].Schema.Properties["data"]
+		assert.Equal(t, "array", dataSchema.Type)
+		assert.Equal(t, "#/components/schemas/openapi_test.testAccount", dataSchema.Items.Ref)
+
+		getMetrics := doc.Paths["/test/metrics"]["get"]
+		require.NotNil(t, getMetrics)
+		assert.Equal(t, &openapi.Schema{Type: "string"}, getMetrics.Responses["200"].Content["text/plain"].Schema)
+
+		postRaw := doc.Paths["/test/raw"]["post"]
+		require.NotNil(t, postRaw)
+		assert.Equal(t, "#/components/schemas/openapi_test.testAccount", postRaw.Responses["200"].Content["application/json"].Schema.Ref)
+	})
+}
@@ -0,0 +1,168 @@
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+)
+
+const componentsSchemasPrefix = "#/components/schemas/"
+
+var (
+	bigIntType         = reflect.TypeOf(big.Int{})
+	timeType           = reflect.TypeOf(time.Time{})
+	jsonMarshalerType  = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType  = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	jsonRawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// schemaBuilder creates the schemas of the Go types by reflection, following the encoding/json rules. The named
+// struct types are stored as components and referenced, so each of them is described only once
+type schemaBuilder struct {
+	schemas map[string]*Schema
+}
+
+func newSchemaBuilder() *schemaBuilder {
+	return &schemaBuilder{
+		schemas: make(map[string]*Schema),
+	}
+}
+
+func (sb *schemaBuilder) schemaOf(value interface{}) *Schema {
+	if value == nil {
+		return &Schema{}
+	}
+
+	return sb.schemaOfType(reflect.TypeOf(value))
+}
+
+func (sb *schemaBuilder) schemaOfType(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t {
+	case bigIntType:
+		return &Schema{Type: "integer"}
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case jsonRawMessageType:
+		return &Schema{}
+	}
+
+	if t.Kind() != reflect.Struct || len(t.Name()) == 0 || implementsCustomMarshaling(t) {
+		return sb.inlineSchemaOfType(t)
+	}
+
+	name := schemaName(t)
+	_, exists := sb.schemas[name]
+	if !exists {
+		// registered before building, so the recursive types will just reference it
+		sb.schemas[name] = &Schema{}
+		*sb.schemas[name] = *sb.inlineSchemaOfType(t)
+	}
+
+	return &Schema{Ref: componentsSchemasPrefix + name}
+}
+
+func (sb *schemaBuilder) inlineSchemaOfType(t reflect.Type) *Schema {
+	if implementsCustomMarshaling(t) {
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Format: integerFormat(t)}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: sb.schemaOfType(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: sb.schemaOfType(t.Elem())}
+	case reflect.Struct:
+		schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+		sb.addStructFields(schema, t)
+		return schema
+	default:
+		// interfaces, functions and channels can hold anything
+		return &Schema{}
+	}
+}
+
+func (sb *schemaBuilder) addStructFields(schema *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, skip := jsonFieldName(field)
+		if skip {
+			continue
+		}
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && len(name) == 0 && fieldType.Kind() == reflect.Struct {
+			sb.addStructFields(schema, fieldType)
+			continue
+		}
+		if len(name) == 0 {
+			name = field.Name
+		}
+
+		schema.Properties[name] = sb.schemaOfType(field.Type)
+	}
+}
+
+// jsonFieldName returns the name set in the json tag and whether the field is not serialized at all
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", true
+	}
+	if !field.IsExported() && !field.Anonymous {
+		return "", true
+	}
+
+	name := strings.Split(tag, ",")[0]
+
+	return name, false
+}
+
+func implementsCustomMarshaling(t reflect.Type) bool {
+	pointerType := reflect.PtrTo(t)
+
+	return t.Implements(jsonMarshalerType) || pointerType.Implements(jsonMarshalerType) ||
+		t.Implements(textMarshalerType) || pointerType.Implements(textMarshalerType)
+}
+
+func integerFormat(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Int64, reflect.Uint64, reflect.Int, reflect.Uint:
+		return "int64"
+	default:
+		return "int32"
+	}
+}
+
+// schemaName returns the package qualified name of the type, as different packages define types with the same name
+func schemaName(t reflect.Type) string {
+	pkgPath := t.PkgPath()
+	pkgName := pkgPath[strings.LastIndex(pkgPath, "/")+1:]
+	name := strings.NewReplacer("[", "_", "]", "_", "*", "", " ", "", ",", "_", "/", "_").Replace(t.Name())
+	if len(pkgName) == 0 {
+		return name
+	}
+
+	return pkgName + "." + name
+}
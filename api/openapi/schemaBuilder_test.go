@@ -0,0 +1,78 @@
+package openapi
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type embeddedFields struct {
+	Epoch uint32 `json:"epoch"`
+}
+
+type customMarshaled struct {
+	value string
+}
+
+// MarshalJSON -
+func (cm customMarshaled) MarshalJSON() ([]byte, error) {
+	return json.Marshal(cm.value)
+}
+
+type recursiveNode struct {
+	Name     string           `json:"name"`
+	Children []*recursiveNode `json:"children"`
+}
+
+type testStruct struct {
+	embeddedFields
+	Name       string `json:"name"`
+	Untagged   bool
+	Ignored    string `json:"-"`
+	unexported string
+	Amount     *big.Int               `json:"amount"`
+	Timestamp  time.Time              `json:"timestamp"`
+	Data       []byte                 `json:"data"`
+	Ratio      float64                `json:"ratio"`
+	Small      uint8                  `json:"small"`
+	Values     map[string]interface{} `json:"values"`
+	Custom     customMarshaled        `json:"custom"`
+	Raw        json.RawMessage        `json:"raw"`
+	Tree       *recursiveNode         `json:"tree"`
+}
+
+func TestSchemaBuilder_schemaOf(t *testing.T) {
+	t.Parallel()
+
+	builder := newSchemaBuilder()
+	assert.Equal(t, &Schema{}, builder.schemaOf(nil))
+	assert.Equal(t, &Schema{Type: "string"}, builder.schemaOf(""))
+	assert.Equal(t, &Schema{Type: "array", Items: &Schema{Type: "string"}}, builder.schemaOf([]string{}))
+
+	schema := builder.schemaOf(&testStruct{unexported: "coverage"})
+	assert.Equal(t, &Schema{Ref: "#/components/schemas/openapi.testStruct"}, schema)
+
+	structSchema := builder.schemas["openapi.testStruct"]
+	expectedProperties := map[string]*Schema{
+		"epoch":     {Type: "integer", Format: "int32"},
+		"name":      {Type: "string"},
+		"Untagged":  {Type: "boolean"},
+		"amount":    {Type: "integer"},
+		"timestamp": {Type: "string", Format: "date-time"},
+		"data":      {Type: "string", Format: "byte"},
+		"ratio":     {Type: "number"},
+		"small":     {Type: "integer", Format: "int32"},
+		"values":    {Type: "object", AdditionalProperties: &Schema{}},
+		"custom":    {},
+		"raw":       {},
+		"tree":      {Ref: "#/components/schemas/openapi.recursiveNode"},
+	}
+	assert.Equal(t, &Schema{Type: "object", Properties: expectedProperties}, structSchema)
+
+	nodeSchema := builder.schemas["openapi.recursiveNode"]
+	assert.Equal(t, "#/components/schemas/openapi.recursiveNode", nodeSchema.Properties["children"].Items.Ref)
+	assert.Equal(t, 2, len(builder.schemas))
+}
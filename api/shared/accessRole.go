@@ -0,0 +1,57 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AccessRole defines the role a caller needs in order to access a REST API endpoint. The roles are ordered, a caller
+// with a higher role can access all the endpoints requiring a lower role
+type AccessRole uint8
+
+const (
+	// RolePublic is the role required by the endpoints that can be freely accessed
+	RolePublic AccessRole = iota
+
+	// RoleOperator is the role required by the endpoints exposing debugging and operational data
+	RoleOperator
+
+	// RoleAdmin is the role required by the endpoints changing the node's state, such as the hardfork trigger, the
+	// managed keys changes or the maintenance jobs
+	RoleAdmin
+)
+
+const (
+	// AccessRoleContextKey is the key used to store the caller's role in the gin context
+	AccessRoleContextKey = "accessRole"
+
+	// AccessIdentityContextKey is the key used to store the caller's identity in the gin context
+	AccessIdentityContextKey = "accessIdentity"
+)
+
+var accessRolesNames = map[AccessRole]string{
+	RolePublic:   "public",
+	RoleOperator: "operator",
+	RoleAdmin:    "admin",
+}
+
+// String returns the human-readable name of the role
+func (role AccessRole) String() string {
+	name, ok := accessRolesNames[role]
+	if !ok {
+		return fmt.Sprintf("unknown role %d", role)
+	}
+
+	return name
+}
+
+// ParseAccessRole returns the role with the provided name
+func ParseAccessRole(name string) (AccessRole, error) {
+	for role, roleName := range accessRolesNames {
+		if strings.EqualFold(roleName, strings.TrimSpace(name)) {
+			return role, nil
+		}
+	}
+
+	return RolePublic, fmt.Errorf("unknown access role %s", name)
+}
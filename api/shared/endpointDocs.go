@@ -0,0 +1,37 @@
+package shared
+
+const (
+	// ParamTypeString is the type of the parameters holding strings
+	ParamTypeString = "string"
+
+	// ParamTypeBoolean is the type of the parameters holding booleans
+	ParamTypeBoolean = "boolean"
+
+	// ParamTypeInteger is the type of the parameters holding integers
+	ParamTypeInteger = "integer"
+)
+
+// QueryParameter describes an URL query parameter accepted by an endpoint
+type QueryParameter struct {
+	Name        string
+	Type        string
+	Description string
+	Required    bool
+}
+
+// EndpointDocs holds the information used for describing an endpoint in the OpenAPI specification. The RequestBody
+// should be a value of the type the request body is bound to, while the ResponseData holds, for each field of the
+// response's data object, a value of the field's type. A nil value in ResponseData describes a field of any type.
+// The few endpoints responding with a data object that is not split into named fields should set the
+// ResponseDataType to a value of the data object's type instead, while the ones responding with plain text, outside
+// the generic API response, should set the PlainTextResponse flag. The endpoints responding with JSON outside the
+// generic API response should set the ResponseBody to a value of the response's type
+type EndpointDocs struct {
+	Summary           string
+	QueryParams       []QueryParameter
+	RequestBody       interface{}
+	ResponseData      map[string]interface{}
+	ResponseDataType  interface{}
+	ResponseBody      interface{}
+	PlainTextResponse bool
+}
@@ -28,6 +28,14 @@ type HttpServerCloser interface {
 	IsInterfaceNil() bool
 }
 
+// GRPCServerHandler defines the actions of the gRPC server started beside the web server
+type GRPCServerHandler interface {
+	Start() error
+	UpdateFacade(facade interface{}) error
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // MiddlewareProcessor defines a processor used internally by the web server when processing requests
 type MiddlewareProcessor interface {
 	MiddlewareHandlerFunc() gin.HandlerFunc
@@ -57,6 +65,7 @@ type GroupHandler interface {
 		ws *gin.RouterGroup,
 		apiConfig config.ApiRoutesConfig,
 	)
+	GetEndpoints() []*EndpointHandlerData
 	IsInterfaceNil() bool
 }
 
@@ -109,14 +118,20 @@ type FacadeHandler interface {
 	CreateTransaction(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
 	ValidateTransaction(tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
+	ValidateTransactionForSimulationWithStateOverrides(tx *transaction.Transaction, checkSignature bool, overrides []*txSimData.StateOverride) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionExecutionWithStateOverrides(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*txSimData.SimulationResultsWithVMOutput, error)
+	TraceTransactionExecution(tx *transaction.Transaction, overrides []*txSimData.StateOverride, blockNonce core.OptionalUint64) (*txSimData.ExecutionTrace, error)
+	GetTransactionExecutionTrace(hash string) (*txSimData.ExecutionTrace, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionGasLimitWithStateOverrides(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	ValidatorStatisticsApi() (map[string]*validator.ValidatorStatistics, error)
 	AuctionListApi() ([]*common.AuctionListValidatorAPIResponse, error)
 	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error)
+	ExecuteSCQueries(queries []*process.SCQuery) ([]*common.SCQueryResultAPI, api.BlockInfo, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	RestApiInterface() string
 	RestAPIServerDebugMode() bool
@@ -128,6 +143,7 @@ type FacadeHandler interface {
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsByAddress(address string, beforeNonce uint64, beforeEpoch uint32, pageSize uint32) (*common.AccountTransactionsAPIResponse, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
 	GetManagedKeysCount() int
 	GetManagedKeys() []string
@@ -135,6 +151,14 @@ type FacadeHandler interface {
 	GetEligibleManagedKeys() ([]string, error)
 	GetWaitingManagedKeys() ([]string, error)
 	GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32, error)
+	AddManagedKey(privateKey string) (string, error)
+	RemoveManagedKey(publicKey string) error
+	PauseManagedKey(publicKey string) error
+	ResumeManagedKey(publicKey string) error
+	Subscribe(filter common.SubscriptionFilter) (common.Subscription, error)
+	GetEvents(query common.EventsQuery) ([]*common.ApiEvent, error)
+	StartTrieVerification(rootHash string, heal bool) error
+	GetTrieVerificationStatus() (common.TrieVerificationStatus, error)
 	GetSCRsByTxHash(txHash string, scrHash string) ([]*transaction.ApiSmartContractResult, error)
 	P2PPrometheusMetricsEnabled() bool
 	IsInterfaceNil() bool
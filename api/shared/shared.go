@@ -24,12 +24,15 @@ type AdditionalMiddleware struct {
 	Position   MiddlewarePosition
 }
 
-// EndpointHandlerData holds the items needed for creating a new gin HTTP endpoint
+// EndpointHandlerData holds the items needed for creating a new gin HTTP endpoint. The RequiredRole is taken into
+// account only if it is higher than the role required by the endpoint's group
 type EndpointHandlerData struct {
 	Path                  string
 	Method                string
 	Handler               gin.HandlerFunc
 	AdditionalMiddlewares []AdditionalMiddleware
+	RequiredRole          AccessRole
+	Docs                  *EndpointDocs
 }
 
 // GenericAPIResponse defines the structure of all responses on API endpoints
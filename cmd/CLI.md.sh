@@ -2,10 +2,14 @@
 
 generate() {
     generateForAssessmentTool
+    generateForDbInspector
+    generateForDbMigrator
     generateForKeyGenerator
     generateForLogViewer
     generateForNode
+    generateForRemoteSigner
     generateForSeedNode
+    generateForSigningProtection
     generateForTermUi
 }
 
@@ -23,6 +27,34 @@ $(code)
     echo "$HELP" > ./assessment/CLI.md
 }
 
+generateForDbInspector() {
+    HELP="
+# MultiversX DbInspector CLI
+
+The **MultiversX DbInspector Tool** exposes the following Command Line Interface:
+$(code)
+\$ dbinspector --help
+
+$(./dbinspector/dbinspector --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./dbinspector/CLI.md
+}
+
+generateForDbMigrator() {
+    HELP="
+# MultiversX DbMigrator CLI
+
+The **MultiversX DbMigrator Tool** exposes the following Command Line Interface:
+$(code)
+\$ dbmigrator --help
+
+$(./dbmigrator/dbmigrator --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./dbmigrator/CLI.md
+}
+
 generateForKeyGenerator() {
     HELP="
 # Keygenerator CLI
@@ -65,6 +97,20 @@ $(code)
     echo "$HELP" > ./node/CLI.md
 }
 
+generateForRemoteSigner() {
+    HELP="
+# MultiversX RemoteSigner CLI
+
+The **MultiversX RemoteSigner** exposes the following Command Line Interface:
+$(code)
+\$ remotesigner --help
+
+$(./remotesigner/remotesigner --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./remotesigner/CLI.md
+}
+
 generateForSeedNode() {
     HELP="
 # MultiversX SeedNode CLI
@@ -79,6 +125,20 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForSigningProtection() {
+    HELP="
+# MultiversX SigningProtection CLI
+
+The **MultiversX SigningProtection Tool** exposes the following Command Line Interface:
+$(code)
+\$ signingprotection --help
+
+$(./signingprotection/signingprotection --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./signingprotection/CLI.md
+}
+
 generateForTermUi() {
     HELP="
 # MultiversX TermUI CLI
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/data/batch"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+const (
+	decodeAsRaw               = "raw"
+	decodeAsShardHeader       = "shardHeader"
+	decodeAsMetaBlock         = "metaBlock"
+	decodeAsMiniBlock         = "miniBlock"
+	decodeAsTransaction       = "transaction"
+	decodeAsSCR               = "scr"
+	decodeAsReward            = "reward"
+	decodeAsReceipts          = "receipts"
+	decodeAsMiniblockMetadata = "miniblockMetadata"
+	decodeAsEpochByHash       = "epochByHash"
+)
+
+type decodeFunc func(marshaller marshal.Marshalizer, buff []byte) (interface{}, error)
+
+var decoders = map[string]decodeFunc{
+	decodeAsRaw: func(_ marshal.Marshalizer, buff []byte) (interface{}, error) {
+		return hex.EncodeToString(buff), nil
+	},
+	decodeAsShardHeader: func(marshaller marshal.Marshalizer, buff []byte) (interface{}, error) {
+		return process.UnmarshalShardHeader(marshaller, buff)
+	},
+	decodeAsMetaBlock: func(marshaller marshal.Marshalizer, buff []byte) (interface{}, error) {
+		return process.UnmarshalMetaHeader(marshaller, buff)
+	},
+	decodeAsMiniBlock: func(marshaller marshal.Marshalizer, buff []byte) (interface{}, error) {
+		return unmarshal(marshaller, &block.MiniBlock{}, buff)
+	},
+	decodeAsTransaction: func(marshaller marshal.Marshalizer, buff []byte) (interface{}, error) {
+		return unmarshal(marshaller, &transaction.Transaction{}, buff)
+	},
+	decodeAsSCR: func(marshaller marshal.Marshalizer, buff []byte) (interface{}, error) {
+		return unmarshal(marshaller, &smartContractResult.SmartContractResult{}, buff)
+	},
+	decodeAsReward: func(marshaller marshal.Marshalizer, buff []byte) (interface{}, error) {
+		return unmarshal(marshaller, &rewardTx.RewardTx{}, buff)
+	},
+	decodeAsReceipts: decodeReceipts,
+	decodeAsMiniblockMetadata: func(marshaller marshal.Marshalizer, buff []byte) (interface{}, error) {
+		return unmarshal(marshaller, &dblookupext.MiniblockMetadata{}, buff)
+	},
+	decodeAsEpochByHash: func(marshaller marshal.Marshalizer, buff []byte) (interface{}, error) {
+		return unmarshal(marshaller, &dblookupext.EpochByHash{}, buff)
+	},
+}
+
+// decodeAsByUnit holds how the values of each storer are decoded, keyed by the FilePath options from config.toml
+var decodeAsByUnit = map[string]string{
+	"BlockHeaders":                          decodeAsShardHeader,
+	"MetaBlock":                             decodeAsMetaBlock,
+	"MiniBlocks":                            decodeAsMiniBlock,
+	"PeerBlocks":                            decodeAsMiniBlock,
+	"Transactions":                          decodeAsTransaction,
+	"UnsignedTransactions":                  decodeAsSCR,
+	"RewardTransactions":                    decodeAsReward,
+	"Receipts":                              decodeAsReceipts,
+	"DbLookupExtensions/MiniblocksMetadata": decodeAsMiniblockMetadata,
+	"DbLookupExtensions_EpochByHash":        decodeAsEpochByHash,
+}
+
+func unmarshal(marshaller marshal.Marshalizer, obj interface{}, buff []byte) (interface{}, error) {
+	err := marshaller.Unmarshal(obj, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// decodeReceipts decodes the receipts stored for a block, saved as a batch of marshalled miniblocks
+func decodeReceipts(marshaller marshal.Marshalizer, buff []byte) (interface{}, error) {
+	receiptsBatch := &batch.Batch{}
+	err := marshaller.Unmarshal(receiptsBatch, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	miniBlocks := make([]*block.MiniBlock, 0, len(receiptsBatch.Data))
+	for _, miniBlockBuff := range receiptsBatch.Data {
+		miniBlock := &block.MiniBlock{}
+		err = marshaller.Unmarshal(miniBlock, miniBlockBuff)
+		if err != nil {
+			return nil, err
+		}
+
+		miniBlocks = append(miniBlocks, miniBlock)
+	}
+
+	return miniBlocks, nil
+}
+
+// getDecoder returns the decoder explicitly requested or, if none, the one matching the storer
+func getDecoder(unit string, decodeAs string) (decodeFunc, error) {
+	if len(decodeAs) == 0 {
+		decodeAs = decodeAsByUnit[unit]
+	}
+	if len(decodeAs) == 0 {
+		decodeAs = decodeAsRaw
+	}
+
+	decoder, ok := decoders[decodeAs]
+	if !ok {
+		return nil, fmt.Errorf("%w %s, supported values are %s", errUnknownDecodeAs, decodeAs, supportedDecodeAs())
+	}
+
+	return decoder, nil
+}
+
+func supportedDecodeAs() string {
+	names := make([]string, 0, len(decoders))
+	for name := range decoders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return strings.Join(names, ", ")
+}
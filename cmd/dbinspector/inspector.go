@@ -0,0 +1,412 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state/snapshotFile"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/trie"
+)
+
+// accountsTrieUnit is the storer holding the nodes of the accounts trie and of its data tries
+const accountsTrieUnit = "AccountsTrie"
+
+var (
+	errKeyNotFoundInStorers = errors.New("key not found in any of the storers")
+	errNoStorerFound        = errors.New("no storer found")
+	errUnknownDecodeAs      = errors.New("unknown decode-as value")
+	errIncompleteTrie       = errors.New("the trie has missing or corrupted nodes")
+)
+
+// entry is the printed form of a storer entry
+type entry struct {
+	Location string      `json:"location"`
+	Key      string      `json:"key"`
+	Value    interface{} `json:"value,omitempty"`
+	Error    string      `json:"error,omitempty"`
+}
+
+// trieCheckReport is the printed form of a trie check
+type trieCheckReport struct {
+	RootHash       string   `json:"rootHash"`
+	NumNodes       uint64   `json:"numNodes"`
+	NumLeaves      uint64   `json:"numLeaves"`
+	MaxDepth       uint32   `json:"maxDepth"`
+	MissingNodes   []string `json:"missingNodes"`
+	CorruptedNodes []string `json:"corruptedNodes"`
+}
+
+// inspector runs the offline queries on a node's database directory, writing the results as JSON
+type inspector struct {
+	layout     *dbLayout
+	marshaller marshal.Marshalizer
+	hasher     hashing.Hasher
+	output     io.Writer
+}
+
+// printLayout writes the storers found on disk, grouped by epoch and shard
+func (insp *inspector) printLayout() error {
+	epochs, err := insp.layout.epochs()
+	if err != nil {
+		return err
+	}
+
+	allEpochs := []int64{staticEpoch}
+	for _, epoch := range epochs {
+		allEpochs = append(allEpochs, int64(epoch))
+	}
+
+	dbConfigHandler := factory.NewDBConfigHandler(factory.DefaultReadOnlyDBConfig())
+	for _, epoch := range allEpochs {
+		shards, errShards := insp.layout.shards(epoch)
+		if errShards != nil {
+			continue
+		}
+
+		for _, shard := range shards {
+			units, errUnits := insp.layout.units(epoch, shard)
+			if errUnits != nil {
+				return errUnits
+			}
+
+			for _, unit := range units {
+				location := storerLocation{epoch: epoch, shard: shard, unit: unit}
+				dbType := ""
+				dbConfig, errConfig := dbConfigHandler.GetDBConfig(insp.layout.storerPath(epoch, shard, unit))
+				if errConfig == nil {
+					dbType = dbConfig.Type
+				}
+
+				_, err = fmt.Fprintf(insp.output, "%s (%s)\n", location.String(), dbType)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// printValue searches the key in all the matching storers and writes the decoded values
+func (insp *inspector) printValue(unit string, shard string, epoch int64, key []byte, decodeAs string) error {
+	decoder, err := getDecoder(unit, decodeAs)
+	if err != nil {
+		return err
+	}
+
+	locations, err := insp.layout.locations(unit, shard, epoch)
+	if err != nil {
+		return err
+	}
+	if len(locations) == 0 {
+		return fmt.Errorf("%w for unit %s", errNoStorerFound, unit)
+	}
+
+	found := false
+	for _, location := range locations {
+		buff, errGet := insp.getFromLocation(location, key)
+		if errGet != nil {
+			log.Debug("key not found", "location", location.String(), "error", errGet)
+			continue
+		}
+
+		found = true
+		err = insp.printEntry(location, key, buff, decoder)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("%w, key %s", errKeyNotFoundInStorers, hex.EncodeToString(key))
+	}
+
+	return nil
+}
+
+func (insp *inspector) getFromLocation(location storerLocation, key []byte) ([]byte, error) {
+	persister, err := factory.NewReadOnlyPersister(location.path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		log.LogIfError(persister.Close())
+	}()
+
+	return persister.Get(key)
+}
+
+// printEntries writes up to limit decoded entries from each matching storer
+func (insp *inspector) printEntries(unit string, shard string, epoch int64, limit int, decodeAs string) error {
+	decoder, err := getDecoder(unit, decodeAs)
+	if err != nil {
+		return err
+	}
+
+	locations, err := insp.layout.locations(unit, shard, epoch)
+	if err != nil {
+		return err
+	}
+	if len(locations) == 0 {
+		return fmt.Errorf("%w for unit %s", errNoStorerFound, unit)
+	}
+
+	for _, location := range locations {
+		err = insp.printEntriesFromLocation(location, limit, decoder)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (insp *inspector) printEntriesFromLocation(location storerLocation, limit int, decoder decodeFunc) error {
+	persister, err := factory.NewReadOnlyPersister(location.path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(persister.Close())
+	}()
+
+	numPrinted := 0
+	persister.RangeKeys(func(key []byte, value []byte) bool {
+		// a sharded persister ranges over all its shards, even if the handler stopped the iteration of one of them
+		isLimitReached := limit > 0 && numPrinted >= limit
+		if isLimitReached || err != nil {
+			return false
+		}
+
+		err = insp.printEntry(location, key, value, decoder)
+		if err != nil {
+			return false
+		}
+
+		numPrinted++
+		return limit <= 0 || numPrinted < limit
+	})
+
+	return err
+}
+
+// printEntry writes the decoded value, or the decoding error, so the corrupted entries are visible
+func (insp *inspector) printEntry(location storerLocation, key []byte, buff []byte, decoder decodeFunc) error {
+	printed := entry{
+		Location: location.String(),
+		Key:      hex.EncodeToString(key),
+	}
+
+	value, err := decoder(insp.marshaller, buff)
+	if err != nil {
+		printed.Value = hex.EncodeToString(buff)
+		printed.Error = err.Error()
+	} else {
+		printed.Value = value
+	}
+
+	return insp.printJSON(printed)
+}
+
+// checkTrie walks the trie with the provided root hash over the trie storers of all the matching epochs
+func (insp *inspector) checkTrie(unit string, shard string, epoch int64, rootHash []byte) error {
+	storer, _, err := insp.openTrieStorer(unit, shard, epoch)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(storer.Close())
+	}()
+
+	checker, err := trie.NewTrieNodesChecker(trie.ArgsTrieNodesChecker{
+		Storer:      storer,
+		Marshalizer: insp.marshaller,
+		Hasher:      insp.hasher,
+	})
+	if err != nil {
+		return err
+	}
+
+	result := checker.Check(rootHash)
+	report := trieCheckReport{
+		RootHash:       hex.EncodeToString(rootHash),
+		NumNodes:       result.NumNodes,
+		NumLeaves:      result.NumLeaves,
+		MaxDepth:       result.MaxDepth,
+		MissingNodes:   encodeHashes(result.MissingNodes),
+		CorruptedNodes: encodeHashes(result.CorruptedNodes),
+	}
+	err = insp.printJSON(report)
+	if err != nil {
+		return err
+	}
+
+	if len(result.MissingNodes) > 0 || len(result.CorruptedNodes) > 0 {
+		return fmt.Errorf("%w: %d missing, %d corrupted", errIncompleteTrie, len(result.MissingNodes), len(result.CorruptedNodes))
+	}
+
+	return nil
+}
+
+// exportState writes the accounts trie with the provided root hash and all its data tries into a state snapshot file,
+// reading the trie nodes from the storers of all the matching epochs
+func (insp *inspector) exportState(shard string, epoch int64, rootHash []byte, outputPath string, maxChunkSize int) error {
+	shardID, err := common.ProcessDestinationShardAsObserver(shard)
+	if err != nil {
+		return err
+	}
+
+	storer, locations, err := insp.openTrieStorer(accountsTrieUnit, shard, epoch)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(storer.Close())
+	}()
+
+	writer, err := snapshotFile.NewSnapshotFileWriter(snapshotFile.ArgsSnapshotFileWriter{
+		Storer:       storer,
+		Marshalizer:  insp.marshaller,
+		Hasher:       insp.hasher,
+		MaxChunkSize: maxChunkSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(outputPath)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := writer.Write(file, rootHash, shardID, latestEpoch(locations))
+	errClose := file.Close()
+	if err == nil {
+		err = errClose
+	}
+	if err != nil {
+		log.LogIfError(os.Remove(outputPath))
+		return err
+	}
+
+	return insp.printJSON(manifest)
+}
+
+// openTrieStorer opens, in read-only mode, the storers of all the matching epochs as a single trie storer
+func (insp *inspector) openTrieStorer(unit string, shard string, epoch int64) (*multiEpochStorer, []storerLocation, error) {
+	locations, err := insp.layout.locations(unit, shard, epoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(locations) == 0 {
+		return nil, nil, fmt.Errorf("%w for unit %s", errNoStorerFound, unit)
+	}
+
+	storer := newMultiEpochStorer()
+	for _, location := range locations {
+		persister, errOpen := factory.NewReadOnlyPersister(location.path)
+		if errOpen != nil {
+			log.LogIfError(storer.Close())
+			return nil, nil, errOpen
+		}
+		storer.add(persister)
+	}
+
+	return storer, locations, nil
+}
+
+func latestEpoch(locations []storerLocation) uint32 {
+	epoch := int64(0)
+	for _, location := range locations {
+		if location.epoch > epoch {
+			epoch = location.epoch
+		}
+	}
+
+	return uint32(epoch)
+}
+
+func (insp *inspector) printJSON(obj interface{}) error {
+	buff, err := json.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(insp.output, string(buff))
+	return err
+}
+
+func encodeHashes(hashes [][]byte) []string {
+	encoded := make([]string, 0, len(hashes))
+	for _, hash := range hashes {
+		encoded = append(encoded, hex.EncodeToString(hash))
+	}
+
+	return encoded
+}
+
+// multiEpochStorer reads the trie nodes from the storers of several epochs, as a node reads them from its pruning
+// storer, in the order in which the storers were added
+type multiEpochStorer struct {
+	persisters []storage.Persister
+}
+
+func newMultiEpochStorer() *multiEpochStorer {
+	return &multiEpochStorer{
+		persisters: make([]storage.Persister, 0),
+	}
+}
+
+func (storer *multiEpochStorer) add(persister storage.Persister) {
+	storer.persisters = append(storer.persisters, persister)
+}
+
+// Put returns an error as the storers are opened in read-only mode
+func (storer *multiEpochStorer) Put(_, _ []byte) error {
+	return storage.ErrReadOnlyDB
+}
+
+// Get returns the value from the first storer holding the key
+func (storer *multiEpochStorer) Get(key []byte) ([]byte, error) {
+	for _, persister := range storer.persisters {
+		value, err := persister.Get(key)
+		if err == nil {
+			return value, nil
+		}
+	}
+
+	return nil, storage.ErrKeyNotFound
+}
+
+// Remove returns an error as the storers are opened in read-only mode
+func (storer *multiEpochStorer) Remove(_ []byte) error {
+	return storage.ErrReadOnlyDB
+}
+
+// Close closes all the storers
+func (storer *multiEpochStorer) Close() error {
+	var lastErr error
+	for _, persister := range storer.persisters {
+		err := persister.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (storer *multiEpochStorer) IsInterfaceNil() bool {
+	return storer == nil
+}
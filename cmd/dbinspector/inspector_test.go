@@ -0,0 +1,382 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/snapshotFile"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	storageMock "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createDBConfig(dbType storageunit.DBType, numShards int32) config.DBConfig {
+	return config.DBConfig{
+		Type:                string(dbType),
+		BatchDelaySeconds:   2,
+		MaxBatchSize:        100,
+		MaxOpenFiles:        10,
+		ShardIDProviderType: "BinarySplit",
+		NumShards:           numShards,
+	}
+}
+
+func writeEntries(t *testing.T, path string, dbConfig config.DBConfig, entries map[string][]byte) {
+	persisterFactory, err := factory.NewPersisterFactory(dbConfig)
+	require.Nil(t, err)
+
+	persister, err := persisterFactory.Create(path)
+	require.Nil(t, err)
+	for key, val := range entries {
+		require.Nil(t, persister.Put([]byte(key), val))
+	}
+	require.Nil(t, persister.Close())
+}
+
+func createTestInspector(t *testing.T, dbPath string) (*inspector, *bytes.Buffer) {
+	layout, err := newDBLayout(dbPath)
+	require.Nil(t, err)
+
+	output := &bytes.Buffer{}
+	return &inspector{
+		layout:     layout,
+		marshaller: &marshal.GogoProtoMarshalizer{},
+		hasher:     blake2b.NewBlake2b(),
+		output:     output,
+	}, output
+}
+
+func TestInspector_PrintLayout(t *testing.T) {
+	t.Parallel()
+
+	dbPath := t.TempDir()
+	layout, err := newDBLayout(dbPath)
+	require.Nil(t, err)
+
+	entries := map[string][]byte{"key": []byte("value")}
+	writeEntries(t, layout.storerPath(0, "0", "MiniBlocks"), createDBConfig(storageunit.LvlDBSerial, 1), entries)
+	writeEntries(t, layout.storerPath(1, "metachain", "DbLookupExtensions/MiniblocksMetadata"), createDBConfig(storageunit.BadgerDB, 1), entries)
+	writeEntries(t, layout.storerPath(staticEpoch, "0", "AccountsTrie"), createDBConfig(storageunit.LvlDBSerial, 4), entries)
+
+	insp, output := createTestInspector(t, dbPath)
+	err = insp.printLayout()
+	require.Nil(t, err)
+
+	expectedLines := []string{
+		"Static shard 0 AccountsTrie (LvlDBSerial)",
+		"Epoch 1 shard metachain DbLookupExtensions/MiniblocksMetadata (BadgerDB)",
+		"Epoch 0 shard 0 MiniBlocks (LvlDBSerial)",
+	}
+	assert.Equal(t, strings.Join(expectedLines, "\n")+"\n", output.String())
+}
+
+func TestInspector_PrintValue(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	miniBlock := &block.MiniBlock{
+		TxHashes:        [][]byte{[]byte("tx hash")},
+		ReceiverShardID: 1,
+		SenderShardID:   0,
+		Type:            block.TxBlock,
+	}
+	miniBlockBuff, err := marshaller.Marshal(miniBlock)
+	require.Nil(t, err)
+
+	dbPath := t.TempDir()
+	layout, err := newDBLayout(dbPath)
+	require.Nil(t, err)
+	writeEntries(t, layout.storerPath(3, "0", "MiniBlocks"), createDBConfig(storageunit.LvlDBSerial, 1), map[string][]byte{
+		"mb hash":   miniBlockBuff,
+		"corrupted": []byte("not a miniblock"),
+	})
+	writeEntries(t, layout.storerPath(4, "0", "MiniBlocks"), createDBConfig(storageunit.BadgerDB, 1), map[string][]byte{
+		"other hash": miniBlockBuff,
+	})
+
+	t.Run("empty unit should error", func(t *testing.T) {
+		t.Parallel()
+
+		insp, _ := createTestInspector(t, dbPath)
+		err := insp.printValue("Missing", "", -1, []byte("mb hash"), "")
+		assert.True(t, errors.Is(err, errNoStorerFound))
+	})
+	t.Run("unknown decode as should error", func(t *testing.T) {
+		t.Parallel()
+
+		insp, _ := createTestInspector(t, dbPath)
+		err := insp.printValue("MiniBlocks", "", -1, []byte("mb hash"), "unknown")
+		assert.True(t, errors.Is(err, errUnknownDecodeAs))
+	})
+	t.Run("missing key should error", func(t *testing.T) {
+		t.Parallel()
+
+		insp, _ := createTestInspector(t, dbPath)
+		err := insp.printValue("MiniBlocks", "", -1, []byte("missing"), "")
+		assert.True(t, errors.Is(err, errKeyNotFoundInStorers))
+	})
+	t.Run("should decode the value found in any epoch", func(t *testing.T) {
+		t.Parallel()
+
+		insp, output := createTestInspector(t, dbPath)
+		err := insp.printValue("MiniBlocks", "", -1, []byte("mb hash"), "")
+		require.Nil(t, err)
+
+		printed := &entry{Value: &block.MiniBlock{}}
+		require.Nil(t, json.Unmarshal(output.Bytes(), printed))
+		assert.Equal(t, "Epoch 3 shard 0 MiniBlocks", printed.Location)
+		assert.Equal(t, hex.EncodeToString([]byte("mb hash")), printed.Key)
+		assert.Equal(t, miniBlock, printed.Value)
+		assert.Empty(t, printed.Error)
+	})
+	t.Run("should print the raw value if requested", func(t *testing.T) {
+		t.Parallel()
+
+		insp, output := createTestInspector(t, dbPath)
+		err := insp.printValue("MiniBlocks", "0", 4, []byte("other hash"), decodeAsRaw)
+		require.Nil(t, err)
+
+		printed := &entry{}
+		require.Nil(t, json.Unmarshal(output.Bytes(), printed))
+		assert.Equal(t, "Epoch 4 shard 0 MiniBlocks", printed.Location)
+		assert.Equal(t, hex.EncodeToString(miniBlockBuff), printed.Value)
+	})
+	t.Run("should print the decoding error of a corrupted value", func(t *testing.T) {
+		t.Parallel()
+
+		insp, output := createTestInspector(t, dbPath)
+		err := insp.printValue("MiniBlocks", "0", 3, []byte("corrupted"), "")
+		require.Nil(t, err)
+
+		printed := &entry{}
+		require.Nil(t, json.Unmarshal(output.Bytes(), printed))
+		assert.Equal(t, hex.EncodeToString([]byte("not a miniblock")), printed.Value)
+		assert.NotEmpty(t, printed.Error)
+	})
+}
+
+func TestInspector_PrintEntries(t *testing.T) {
+	t.Parallel()
+
+	dbPath := t.TempDir()
+	layout, err := newDBLayout(dbPath)
+	require.Nil(t, err)
+	writeEntries(t, layout.storerPath(staticEpoch, "0", "ShardHdrHashNonce0"), createDBConfig(storageunit.LvlDBSerial, 2), map[string][]byte{
+		"a": []byte("1"),
+		"b": []byte("2"),
+		"c": []byte("3"),
+	})
+
+	insp, output := createTestInspector(t, dbPath)
+	err = insp.printEntries("ShardHdrHashNonce0", "0", -1, 2, "")
+	require.Nil(t, err)
+
+	decoder := json.NewDecoder(output)
+	numEntries := 0
+	for decoder.More() {
+		printed := &entry{}
+		require.Nil(t, decoder.Decode(printed))
+		assert.Equal(t, "Static shard 0 ShardHdrHashNonce0", printed.Location)
+		numEntries++
+	}
+	assert.Equal(t, 2, numEntries)
+}
+
+func TestInspector_CheckTrie(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	hasher := blake2b.NewBlake2b()
+	args := storageMock.GetStorageManagerArgs()
+	args.Marshalizer = marshaller
+	args.Hasher = hasher
+	trieStorage, err := trie.NewTrieStorageManager(args)
+	require.Nil(t, err)
+	tr, err := trie.NewTrie(trieStorage, marshaller, hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+	require.Nil(t, err)
+
+	numLeaves := 50
+	for i := 0; i < numLeaves; i++ {
+		key := hasher.Compute(string(rune(i)))
+		require.Nil(t, tr.Update(key, key))
+	}
+	require.Nil(t, tr.Commit())
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+	hashes, err := tr.GetAllHashes()
+	require.Nil(t, err)
+
+	// the trie nodes are spread over two epochs, as written by a node over time
+	oldEpochNodes := make(map[string][]byte)
+	newEpochNodes := make(map[string][]byte)
+	for i, hash := range hashes {
+		encodedNode, errGet := trieStorage.Get(hash)
+		require.Nil(t, errGet)
+
+		if i%2 == 0 {
+			oldEpochNodes[string(hash)] = encodedNode
+			continue
+		}
+		newEpochNodes[string(hash)] = encodedNode
+	}
+
+	dbPath := t.TempDir()
+	layout, err := newDBLayout(dbPath)
+	require.Nil(t, err)
+	writeEntries(t, layout.storerPath(0, "1", "AccountsTrie"), createDBConfig(storageunit.LvlDBSerial, 1), oldEpochNodes)
+	writeEntries(t, layout.storerPath(1, "1", "AccountsTrie"), createDBConfig(storageunit.BadgerDB, 4), newEpochNodes)
+
+	t.Run("complete trie", func(t *testing.T) {
+		t.Parallel()
+
+		insp, output := createTestInspector(t, dbPath)
+		err := insp.checkTrie("AccountsTrie", "1", -1, rootHash)
+		require.Nil(t, err)
+
+		report := &trieCheckReport{}
+		require.Nil(t, json.Unmarshal(output.Bytes(), report))
+		assert.Equal(t, hex.EncodeToString(rootHash), report.RootHash)
+		assert.Equal(t, uint64(len(hashes)), report.NumNodes)
+		assert.Equal(t, uint64(numLeaves), report.NumLeaves)
+		assert.Empty(t, report.MissingNodes)
+		assert.Empty(t, report.CorruptedNodes)
+	})
+	t.Run("trie with nodes missing from the selected epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		insp, output := createTestInspector(t, dbPath)
+		err := insp.checkTrie("AccountsTrie", "1", 1, rootHash)
+		assert.True(t, errors.Is(err, errIncompleteTrie))
+
+		report := &trieCheckReport{}
+		require.Nil(t, json.Unmarshal(output.Bytes(), report))
+		assert.Equal(t, []string{hex.EncodeToString(rootHash)}, report.MissingNodes)
+	})
+	t.Run("missing storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		insp, _ := createTestInspector(t, dbPath)
+		err := insp.checkTrie("AccountsTrie", "0", -1, rootHash)
+		assert.True(t, errors.Is(err, errNoStorerFound))
+	})
+}
+
+func TestInspector_ExportState(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	hasher := blake2b.NewBlake2b()
+	args := storageMock.GetStorageManagerArgs()
+	args.Marshalizer = marshaller
+	args.Hasher = hasher
+	trieStorage, err := trie.NewTrieStorageManager(args)
+	require.Nil(t, err)
+	mainTrie, err := trie.NewTrie(trieStorage, marshaller, hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+	require.Nil(t, err)
+
+	numAccounts := 20
+	allHashes := make([][]byte, 0)
+	for i := 0; i < numAccounts; i++ {
+		dataTrie, errNew := trie.NewTrie(trieStorage, marshaller, hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+		require.Nil(t, errNew)
+		require.Nil(t, dataTrie.Update([]byte("key"), []byte(fmt.Sprintf("value%d", i))))
+		require.Nil(t, dataTrie.Commit())
+		dataTrieHashes, errHashes := dataTrie.GetAllHashes()
+		require.Nil(t, errHashes)
+		allHashes = append(allHashes, dataTrieHashes...)
+
+		address := hasher.Compute(string(rune(i)))
+		dataTrieRootHash, _ := dataTrie.RootHash()
+		accountBytes, errMarshal := marshaller.Marshal(&accounts.UserAccountData{
+			Address:  address,
+			Balance:  big.NewInt(int64(i)),
+			RootHash: dataTrieRootHash,
+		})
+		require.Nil(t, errMarshal)
+		require.Nil(t, mainTrie.Update(address, accountBytes))
+	}
+	require.Nil(t, mainTrie.Commit())
+	rootHash, err := mainTrie.RootHash()
+	require.Nil(t, err)
+	mainTrieHashes, err := mainTrie.GetAllHashes()
+	require.Nil(t, err)
+	allHashes = append(allHashes, mainTrieHashes...)
+
+	nodes := make(map[string][]byte)
+	for _, hash := range allHashes {
+		encodedNode, errGet := trieStorage.Get(hash)
+		require.Nil(t, errGet)
+		nodes[string(hash)] = encodedNode
+	}
+
+	dbPath := t.TempDir()
+	layout, err := newDBLayout(dbPath)
+	require.Nil(t, err)
+	writeEntries(t, layout.storerPath(3, "metachain", accountsTrieUnit), createDBConfig(storageunit.LvlDBSerial, 1), nodes)
+
+	t.Run("should write a file importable for the root hash", func(t *testing.T) {
+		t.Parallel()
+
+		outputPath := filepath.Join(t.TempDir(), "state.bin")
+		insp, output := createTestInspector(t, dbPath)
+		err := insp.exportState("metachain", -1, rootHash, outputPath, 1024)
+		require.Nil(t, err)
+
+		printedManifest := &snapshotFile.Manifest{}
+		require.Nil(t, json.Unmarshal(output.Bytes(), printedManifest))
+		assert.Equal(t, core.MetachainShardId, printedManifest.ShardID)
+		assert.Equal(t, uint32(3), printedManifest.Epoch)
+		assert.Equal(t, uint64(numAccounts), printedManifest.NumDataTries)
+
+		file, err := os.Open(outputPath)
+		require.Nil(t, err)
+		defer func() {
+			_ = file.Close()
+		}()
+
+		storer := testscommon.NewMemDbMock()
+		importer, _ := snapshotFile.NewSnapshotFileImporter(snapshotFile.ArgsSnapshotFileImporter{
+			Storer: storer,
+			Hasher: hasher,
+		})
+		importedManifest, err := importer.Import(file, rootHash)
+		require.Nil(t, err)
+		assert.Equal(t, printedManifest, importedManifest)
+		for _, hash := range allHashes {
+			_, errGet := storer.Get(hash)
+			assert.Nil(t, errGet)
+		}
+	})
+	t.Run("incomplete trie should error and remove the file", func(t *testing.T) {
+		t.Parallel()
+
+		outputPath := filepath.Join(t.TempDir(), "state.bin")
+		insp, _ := createTestInspector(t, dbPath)
+		err := insp.exportState("metachain", 2, rootHash, outputPath, 1024)
+		assert.True(t, errors.Is(err, errNoStorerFound))
+
+		err = insp.exportState("metachain", -1, hasher.Compute("missing root"), outputPath, 1024)
+		assert.True(t, errors.Is(err, snapshotFile.ErrIncompleteTrie))
+		_, err = os.Stat(outputPath)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+)
+
+const (
+	// staticEpoch marks the storers which do not change with the epoch
+	staticEpoch = -1
+	// dbConfigFileName is the file in which the node stores the configuration of each database directory
+	dbConfigFileName = "config.toml"
+	// levelDBCurrentFileName is the file pointing to the current manifest, present in every LevelDB directory
+	levelDBCurrentFileName = "CURRENT"
+)
+
+// storerLocation identifies one storer directory in the node's database layout
+type storerLocation struct {
+	epoch int64
+	shard string
+	unit  string
+	path  string
+}
+
+func (location storerLocation) String() string {
+	if location.epoch == staticEpoch {
+		return fmt.Sprintf("%s shard %s %s", storage.DefaultStaticDbString, location.shard, location.unit)
+	}
+
+	return fmt.Sprintf("%s %d shard %s %s", storage.DefaultEpochString, location.epoch, location.shard, location.unit)
+}
+
+// dbLayout reads the epochs, shards and storers from a node's database directory, following the path manager templates
+type dbLayout struct {
+	dbPath      string
+	pathManager storage.PathManagerHandler
+}
+
+func newDBLayout(dbPath string) (*dbLayout, error) {
+	info, err := os.Stat(dbPath)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%w: %s", storage.ErrDatabaseNotFound, dbPath)
+	}
+
+	pathManager, err := factory.CreatePathManagerFromSinglePathString(dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dbLayout{
+		dbPath:      dbPath,
+		pathManager: pathManager,
+	}, nil
+}
+
+// epochs returns the epochs found on disk, in descending order
+func (layout *dbLayout) epochs() ([]uint32, error) {
+	names, err := listDirectories(layout.dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := storage.DefaultEpochString + "_"
+	epochs := make([]uint32, 0, len(names))
+	for _, name := range names {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+
+		epoch, errParse := strconv.ParseUint(strings.TrimPrefix(name, prefix), 10, 32)
+		if errParse != nil {
+			continue
+		}
+		epochs = append(epochs, uint32(epoch))
+	}
+
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] > epochs[j]
+	})
+
+	return epochs, nil
+}
+
+// shards returns the shards found on disk for the provided epoch, or for the static storers if the epoch is staticEpoch
+func (layout *dbLayout) shards(epoch int64) ([]string, error) {
+	names, err := listDirectories(layout.epochDirectory(epoch))
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := storage.DefaultShardString + "_"
+	shards := make([]string, 0, len(names))
+	for _, name := range names {
+		if strings.HasPrefix(name, prefix) {
+			shards = append(shards, strings.TrimPrefix(name, prefix))
+		}
+	}
+
+	return shards, nil
+}
+
+// units returns the storers found on disk for the provided epoch and shard. Nested storers, like the ones of the
+// database lookup extensions, are returned with their relative path
+func (layout *dbLayout) units(epoch int64, shard string) ([]string, error) {
+	shardPath := filepath.Join(layout.epochDirectory(epoch), fmt.Sprintf("%s_%s", storage.DefaultShardString, shard))
+
+	units := make([]string, 0)
+	err := filepath.WalkDir(shardPath, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() || path == shardPath {
+			return nil
+		}
+
+		if !isDatabaseDirectory(path) {
+			return nil
+		}
+
+		unit, errRel := filepath.Rel(shardPath, path)
+		if errRel != nil {
+			return errRel
+		}
+		units = append(units, filepath.ToSlash(unit))
+
+		return filepath.SkipDir
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return units, nil
+}
+
+// locations returns the existing directories of a storer, the static one first and then the epoch ones in
+// descending order. An empty shard matches all shards and a negative epoch matches all epochs
+func (layout *dbLayout) locations(unit string, shard string, epoch int64) ([]storerLocation, error) {
+	epochs := []int64{staticEpoch}
+	if epoch >= 0 {
+		epochs = append(epochs, epoch)
+	} else {
+		onDisk, err := layout.epochs()
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range onDisk {
+			epochs = append(epochs, int64(e))
+		}
+	}
+
+	locations := make([]storerLocation, 0)
+	for _, e := range epochs {
+		shards := []string{shard}
+		if len(shard) == 0 {
+			onDisk, err := layout.shards(e)
+			if err != nil && !os.IsNotExist(err) {
+				return nil, err
+			}
+			shards = onDisk
+		}
+
+		for _, s := range shards {
+			path := layout.storerPath(e, s, unit)
+			if !isDatabaseDirectory(path) {
+				continue
+			}
+
+			locations = append(locations, storerLocation{
+				epoch: e,
+				shard: s,
+				unit:  unit,
+				path:  path,
+			})
+		}
+	}
+
+	return locations, nil
+}
+
+func (layout *dbLayout) storerPath(epoch int64, shard string, unit string) string {
+	if epoch == staticEpoch {
+		return layout.pathManager.PathForStatic(shard, unit)
+	}
+
+	return layout.pathManager.PathForEpoch(shard, uint32(epoch), unit)
+}
+
+func (layout *dbLayout) epochDirectory(epoch int64) string {
+	if epoch == staticEpoch {
+		return filepath.Join(layout.dbPath, storage.DefaultStaticDbString)
+	}
+
+	return filepath.Join(layout.dbPath, fmt.Sprintf("%s_%d", storage.DefaultEpochString, epoch))
+}
+
+// isDatabaseDirectory returns true if the directory holds a database, identified by the configuration file the
+// node stores beside each database or by the files of a LevelDB database
+func isDatabaseDirectory(path string) bool {
+	for _, fileName := range []string{dbConfigFileName, levelDBCurrentFileName} {
+		info, err := os.Stat(filepath.Join(path, fileName))
+		if err == nil && !info.IsDir() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func listDirectories(path string) ([]string, error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+
+	return names, nil
+}
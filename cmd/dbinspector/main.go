@@ -0,0 +1,310 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"os"
+
+	hasherFactory "github.com/multiversx/mx-chain-core-go/hashing/factory"
+	marshalizerFactory "github.com/multiversx/mx-chain-core-go/marshal/factory"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const (
+	filePathPlaceholder = "[path]"
+)
+
+var (
+	dbInspectorHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}} command [command options]
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .Commands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// dbPath defines a flag for the path of the node's database directory
+	dbPath = cli.StringFlag{
+		Name: "db-path",
+		Usage: "The `" + filePathPlaceholder + "` of the node's database directory holding the Epoch_* and Static " +
+			"directories. It is the db directory of the node joined with the chain ID. The databases are opened " +
+			"read-only, so the node should be stopped while this tool runs.",
+		Value: "./db/1",
+	}
+	// marshallerType defines a flag for the marshaller used to decode the stored values
+	marshallerType = cli.StringFlag{
+		Name:  "marshaller-type",
+		Usage: "The `type` of the marshaller used by the node, as set in the Marshalizer section of config.toml.",
+		Value: marshalizerFactory.GogoProtobuf,
+	}
+	// hasherType defines a flag for the hasher used to verify the trie nodes
+	hasherType = cli.StringFlag{
+		Name:  "hasher-type",
+		Usage: "The `type` of the hasher used by the node, as set in the Hasher section of config.toml.",
+		Value: "blake2b",
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+
+	// unit defines a flag for the storer to be inspected
+	unit = cli.StringFlag{
+		Name: "unit",
+		Usage: "The `name` of the storer, as set in the FilePath option of its section in config.toml, for example " +
+			"BlockHeaders, MiniBlocks, Transactions, Receipts or DbLookupExtensions/MiniblocksMetadata.",
+		Value: "",
+	}
+	// shard defines a flag for the shard of the storer
+	shard = cli.StringFlag{
+		Name:  "shard",
+		Usage: "The `shard` of the storer, for example 0 or metachain. If empty, all the shards found on disk are used.",
+		Value: "",
+	}
+	// epoch defines a flag for the epoch of the storer
+	epoch = cli.Int64Flag{
+		Name:  "epoch",
+		Usage: "The `epoch` of the storer, searched after the static one. If negative, all the epochs found on disk are used.",
+		Value: -1,
+	}
+	// key defines a flag for the key to be read
+	key = cli.StringFlag{
+		Name:  "key",
+		Usage: "The hex encoded `key` to be read, usually a hash.",
+		Value: "",
+	}
+	// decodeAs defines a flag overriding the decoding of the stored values
+	decodeAs = cli.StringFlag{
+		Name: "decode-as",
+		Usage: "The `type` used to decode the stored values. If empty, it is chosen from the storer name. One of " +
+			supportedDecodeAs() + ".",
+		Value: "",
+	}
+	// limit defines a flag for the maximum number of listed entries
+	limit = cli.IntFlag{
+		Name:  "limit",
+		Usage: "The maximum `number` of entries listed from each storer. If not positive, all the entries are listed.",
+		Value: 10,
+	}
+	// rootHash defines a flag for the root hash of the checked trie
+	rootHash = cli.StringFlag{
+		Name:  "root-hash",
+		Usage: "The hex encoded `root hash` of the trie to be checked or exported.",
+		Value: "",
+	}
+	// trieUnit defines a flag for the storer holding the trie nodes
+	trieUnit = cli.StringFlag{
+		Name:  "unit",
+		Usage: "The `name` of the storer holding the trie nodes, AccountsTrie for the state or PeerAccountsTrie for the validators.",
+		Value: "AccountsTrie",
+	}
+	// outputFile defines a flag for the written state snapshot file
+	outputFile = cli.StringFlag{
+		Name:  "output-file",
+		Usage: "The `" + filePathPlaceholder + "` of the state snapshot file to be written. An existing file is overwritten.",
+		Value: "./state-snapshot.bin",
+	}
+	// maxChunkSizeInMB defines a flag for the maximum size of a state snapshot file chunk
+	maxChunkSizeInMB = cli.IntFlag{
+		Name:  "max-chunk-size-mb",
+		Usage: "The maximum `size` in MB of a state snapshot file chunk. A chunk is held in memory while written or imported.",
+		Value: 64,
+	}
+
+	errEmptyUnit     = errors.New("empty unit")
+	errEmptyKey      = errors.New("empty key")
+	errEmptyShard    = errors.New("empty shard, the trie nodes are read for one shard at a time")
+	errEmptyRootHash = errors.New("empty root hash")
+)
+
+var log = logger.GetOrCreate("main")
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = dbInspectorHelpTemplate
+	app.Name = "DbInspector CLI App"
+	app.Usage = "This tool opens the databases of a stopped node in read-only mode, decodes the stored data, checks the state tries and exports them, so broken databases can be diagnosed without resyncing"
+	app.Flags = []cli.Flag{
+		dbPath,
+		marshallerType,
+		hasherType,
+		logLevel,
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:   "layout",
+			Usage:  "lists the epochs, shards and storers found in the database directory",
+			Action: layoutAction,
+		},
+		{
+			Name:   "get",
+			Usage:  "reads and decodes the value of a key from all the matching storers",
+			Flags:  []cli.Flag{unit, shard, epoch, key, decodeAs},
+			Action: getAction,
+		},
+		{
+			Name:   "list",
+			Usage:  "lists and decodes the entries of the matching storers",
+			Flags:  []cli.Flag{unit, shard, epoch, limit, decodeAs},
+			Action: listAction,
+		},
+		{
+			Name:   "check-trie",
+			Usage:  "walks a trie from its root hash and reports the missing or corrupted trie nodes",
+			Flags:  []cli.Flag{trieUnit, shard, epoch, rootHash},
+			Action: checkTrieAction,
+		},
+		{
+			Name:   "export-state",
+			Usage:  "writes the accounts trie and its data tries into a state snapshot file from which a new node can start",
+			Flags:  []cli.Flag{shard, epoch, rootHash, outputFile, maxChunkSizeInMB},
+			Action: exportStateAction,
+		},
+	}
+	app.Version = "v0.0.1"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func createInspector(ctx *cli.Context) (*inspector, error) {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	layout, err := newDBLayout(ctx.GlobalString(dbPath.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	marshaller, err := marshalizerFactory.NewMarshalizer(ctx.GlobalString(marshallerType.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	hasher, err := hasherFactory.NewHasher(ctx.GlobalString(hasherType.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	return &inspector{
+		layout:     layout,
+		marshaller: marshaller,
+		hasher:     hasher,
+		output:     os.Stdout,
+	}, nil
+}
+
+func layoutAction(ctx *cli.Context) error {
+	insp, err := createInspector(ctx)
+	if err != nil {
+		return err
+	}
+
+	return insp.printLayout()
+}
+
+func getAction(ctx *cli.Context) error {
+	insp, err := createInspector(ctx)
+	if err != nil {
+		return err
+	}
+
+	unitName := ctx.String(unit.Name)
+	if len(unitName) == 0 {
+		return errEmptyUnit
+	}
+	keyBytes, err := decodeHexFlag(ctx, key.Name, errEmptyKey)
+	if err != nil {
+		return err
+	}
+
+	return insp.printValue(unitName, ctx.String(shard.Name), ctx.Int64(epoch.Name), keyBytes, ctx.String(decodeAs.Name))
+}
+
+func listAction(ctx *cli.Context) error {
+	insp, err := createInspector(ctx)
+	if err != nil {
+		return err
+	}
+
+	unitName := ctx.String(unit.Name)
+	if len(unitName) == 0 {
+		return errEmptyUnit
+	}
+
+	return insp.printEntries(unitName, ctx.String(shard.Name), ctx.Int64(epoch.Name), ctx.Int(limit.Name), ctx.String(decodeAs.Name))
+}
+
+func checkTrieAction(ctx *cli.Context) error {
+	insp, err := createInspector(ctx)
+	if err != nil {
+		return err
+	}
+
+	shardID := ctx.String(shard.Name)
+	if len(shardID) == 0 {
+		return errEmptyShard
+	}
+	rootHashBytes, err := decodeHexFlag(ctx, rootHash.Name, errEmptyRootHash)
+	if err != nil {
+		return err
+	}
+
+	return insp.checkTrie(ctx.String(trieUnit.Name), shardID, ctx.Int64(epoch.Name), rootHashBytes)
+}
+
+func exportStateAction(ctx *cli.Context) error {
+	insp, err := createInspector(ctx)
+	if err != nil {
+		return err
+	}
+
+	shardID := ctx.String(shard.Name)
+	if len(shardID) == 0 {
+		return errEmptyShard
+	}
+	rootHashBytes, err := decodeHexFlag(ctx, rootHash.Name, errEmptyRootHash)
+	if err != nil {
+		return err
+	}
+
+	maxChunkSize := ctx.Int(maxChunkSizeInMB.Name) * 1024 * 1024
+
+	return insp.exportState(shardID, ctx.Int64(epoch.Name), rootHashBytes, ctx.String(outputFile.Name), maxChunkSize)
+}
+
+func decodeHexFlag(ctx *cli.Context, flagName string, errEmpty error) ([]byte, error) {
+	value := ctx.String(flagName)
+	if len(value) == 0 {
+		return nil, errEmpty
+	}
+
+	return hex.DecodeString(value)
+}
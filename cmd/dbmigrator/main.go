@@ -0,0 +1,119 @@
+package main
+
+import (
+	"errors"
+	"os"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const (
+	filePathPlaceholder = "[path]"
+)
+
+var (
+	dbMigratorHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// source defines a flag for the path of the LevelDB data to be migrated
+	source = cli.StringFlag{
+		Name: "source",
+		Usage: "The `" + filePathPlaceholder + "` of the data to be migrated. It can be a single LevelDB database " +
+			"directory or a directory tree, like the db directory of the node, in which case every LevelDB database " +
+			"found is migrated and every other file is copied. The node should be stopped while this tool runs.",
+		Value: "./db",
+	}
+	// destination defines a flag for the path in which the migrated data is written
+	destination = cli.StringFlag{
+		Name: "destination",
+		Usage: "The `" + filePathPlaceholder + "` in which the migrated data is written, mirroring the source " +
+			"structure. It should not exist or be empty.",
+		Value: "",
+	}
+	// batchSize defines a flag for the number of entries written at once in the destination databases
+	batchSize = cli.IntFlag{
+		Name:  "batch-size",
+		Usage: "The `number` of entries written at once in the destination databases.",
+		Value: 10000,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+
+	errEmptySourcePath      = errors.New("empty source path")
+	errEmptyDestinationPath = errors.New("empty destination path")
+	errInvalidBatchSize     = errors.New("invalid batch size")
+)
+
+var log = logger.GetOrCreate("main")
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = dbMigratorHelpTemplate
+	app.Name = "DbMigrator CLI App"
+	app.Usage = "This tool copies the LevelDB databases of a stopped node into the BadgerDB format. The migrated databases remember their type, so the node will open them as BadgerDB regardless of the DB.Type options in config.toml"
+	app.Flags = []cli.Flag{
+		source,
+		destination,
+		batchSize,
+		logLevel,
+	}
+	app.Version = "v0.0.1"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+
+	app.Action = func(c *cli.Context) error {
+		return process(c)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func process(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	sourcePath := ctx.GlobalString(source.Name)
+	if len(sourcePath) == 0 {
+		return errEmptySourcePath
+	}
+	destinationPath := ctx.GlobalString(destination.Name)
+	if len(destinationPath) == 0 {
+		return errEmptyDestinationPath
+	}
+	numEntriesInBatch := ctx.GlobalInt(batchSize.Name)
+	if numEntriesInBatch < 1 {
+		return errInvalidBatchSize
+	}
+
+	return migrate(sourcePath, destinationPath, numEntriesInBatch)
+}
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage/database"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+)
+
+const (
+	// dbConfigFileName is the file in which the node stores the configuration of each database directory
+	dbConfigFileName = "config.toml"
+	// levelDBCurrentFileName is the file pointing to the current manifest, present in every LevelDB directory
+	levelDBCurrentFileName = "CURRENT"
+
+	// the values used by the node for the databases created before their configuration was stored
+	defaultBatchDelaySeconds = 2
+	defaultMaxBatchSize      = 100
+	defaultMaxOpenFiles      = 10
+
+	// read + write + execute for owner only
+	rwxOwner = 0700
+)
+
+var (
+	errSourceNotDirectory      = errors.New("the source is not a directory")
+	errDestinationNotEmpty     = errors.New("the destination already exists and is not empty")
+	errDestinationInsideSource = errors.New("the destination can not be inside the source")
+)
+
+// migrate copies the LevelDB databases found in the source into BadgerDB databases written under the destination
+func migrate(sourcePath string, destinationPath string, batchSize int) error {
+	err := checkPaths(sourcePath, destinationPath)
+	if err != nil {
+		return err
+	}
+
+	if isLevelDBDirectory(sourcePath) {
+		return migrateDatabase(sourcePath, destinationPath, batchSize)
+	}
+
+	numMigrated := 0
+	err = filepath.WalkDir(sourcePath, func(path string, entry fs.DirEntry, errWalk error) error {
+		if errWalk != nil {
+			return errWalk
+		}
+
+		relativePath, errRel := filepath.Rel(sourcePath, path)
+		if errRel != nil {
+			return errRel
+		}
+		targetPath := filepath.Join(destinationPath, relativePath)
+
+		if entry.IsDir() {
+			if !isLevelDBDirectory(path) {
+				return os.MkdirAll(targetPath, rwxOwner)
+			}
+
+			numMigrated++
+			errMigrate := migrateDatabase(path, targetPath, batchSize)
+			if errMigrate != nil {
+				return errMigrate
+			}
+
+			return filepath.SkipDir
+		}
+
+		if entry.Name() == dbConfigFileName {
+			return migrateDBConfig(path, targetPath)
+		}
+
+		return copyFile(path, targetPath)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("migration finished", "source", sourcePath, "destination", destinationPath, "num databases", numMigrated)
+
+	return nil
+}
+
+func checkPaths(sourcePath string, destinationPath string) error {
+	sourceInfo, err := os.Stat(sourcePath)
+	if err != nil {
+		return err
+	}
+	if !sourceInfo.IsDir() {
+		return fmt.Errorf("%w: %s", errSourceNotDirectory, sourcePath)
+	}
+
+	absoluteSource, err := filepath.Abs(sourcePath)
+	if err != nil {
+		return err
+	}
+	absoluteDestination, err := filepath.Abs(destinationPath)
+	if err != nil {
+		return err
+	}
+	relativePath, err := filepath.Rel(absoluteSource, absoluteDestination)
+	if err != nil {
+		return err
+	}
+	if relativePath == "." || !strings.HasPrefix(relativePath, "..") {
+		return errDestinationInsideSource
+	}
+
+	entries, err := os.ReadDir(destinationPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(entries) > 0 {
+		return fmt.Errorf("%w: %s", errDestinationNotEmpty, destinationPath)
+	}
+
+	return nil
+}
+
+func isLevelDBDirectory(path string) bool {
+	info, err := os.Stat(filepath.Join(path, levelDBCurrentFileName))
+	if err != nil {
+		return false
+	}
+
+	return !info.IsDir()
+}
+
+// migrateDatabase copies all the entries of a LevelDB database into a new BadgerDB database and stores the
+// database configuration beside it, so the node will open it with the new type
+func migrateDatabase(sourcePath string, destinationPath string, batchSize int) error {
+	startTime := time.Now()
+
+	dbConfig, err := loadDBConfig(filepath.Join(sourcePath, dbConfigFileName))
+	if err != nil {
+		return err
+	}
+
+	sourceDB, err := database.NewSerialDB(sourcePath, dbConfig.BatchDelaySeconds, dbConfig.MaxBatchSize, dbConfig.MaxOpenFiles)
+	if err != nil {
+		return fmt.Errorf("%w while opening the source database %s", err, sourcePath)
+	}
+	defer func() {
+		log.LogIfError(sourceDB.Close())
+	}()
+
+	destinationDB, err := database.NewBadgerDB(destinationPath, dbConfig.BatchDelaySeconds, batchSize, dbConfig.MaxOpenFiles)
+	if err != nil {
+		return fmt.Errorf("%w while opening the destination database %s", err, destinationPath)
+	}
+
+	numEntries := 0
+	var errPut error
+	sourceDB.RangeKeys(func(key []byte, value []byte) bool {
+		errPut = destinationDB.Put(key, value)
+		if errPut != nil {
+			return false
+		}
+
+		numEntries++
+		return true
+	})
+
+	// closing writes the last batch
+	err = destinationDB.Close()
+	if errPut != nil {
+		return fmt.Errorf("%w while writing in the destination database %s", errPut, destinationPath)
+	}
+	if err != nil {
+		return fmt.Errorf("%w while closing the destination database %s", err, destinationPath)
+	}
+
+	dbConfig.Type = string(storageunit.BadgerDB)
+	err = core.SaveTomlFile(dbConfig, filepath.Join(destinationPath, dbConfigFileName))
+	if err != nil {
+		return err
+	}
+
+	log.Info("database migrated",
+		"source", sourcePath,
+		"destination", destinationPath,
+		"num entries", numEntries,
+		"duration", time.Since(startTime),
+	)
+
+	return nil
+}
+
+// loadDBConfig reads the stored database configuration, falling back to the values the node uses for the
+// databases created before the configuration was stored
+func loadDBConfig(configPath string) (*config.DBConfig, error) {
+	dbConfig := &config.DBConfig{
+		Type:              string(storageunit.LvlDBSerial),
+		BatchDelaySeconds: defaultBatchDelaySeconds,
+		MaxBatchSize:      defaultMaxBatchSize,
+		MaxOpenFiles:      defaultMaxOpenFiles,
+	}
+
+	_, err := os.Stat(configPath)
+	if os.IsNotExist(err) {
+		return dbConfig, nil
+	}
+
+	err = core.LoadTomlFile(dbConfig, configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return dbConfig, nil
+}
+
+// migrateDBConfig switches the type stored in the configuration of a sharded LevelDB database, whose shards
+// are migrated as separate databases. Any other configuration file is copied as it is
+func migrateDBConfig(sourcePath string, destinationPath string) error {
+	dbConfig, err := loadDBConfig(sourcePath)
+	if err != nil {
+		return err
+	}
+
+	isLevelDB := dbConfig.Type == string(storageunit.LvlDB) || dbConfig.Type == string(storageunit.LvlDBSerial)
+	if !isLevelDB {
+		return copyFile(sourcePath, destinationPath)
+	}
+
+	dbConfig.Type = string(storageunit.BadgerDB)
+
+	return core.SaveTomlFile(dbConfig, destinationPath)
+}
+
+func copyFile(sourcePath string, destinationPath string) error {
+	info, err := os.Stat(sourcePath)
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(sourcePath)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(destinationPath, data, info.Mode().Perm())
+}
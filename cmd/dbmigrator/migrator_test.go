@@ -0,0 +1,207 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/database"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testBatchSize = 3
+
+func createLevelDBConfig(numShards int32) config.DBConfig {
+	return config.DBConfig{
+		Type:                string(storageunit.LvlDBSerial),
+		BatchDelaySeconds:   2,
+		MaxBatchSize:        100,
+		MaxOpenFiles:        10,
+		ShardIDProviderType: "BinarySplit",
+		NumShards:           numShards,
+	}
+}
+
+func createTestData(numEntries int) map[string][]byte {
+	data := make(map[string][]byte, numEntries)
+	for i := 0; i < numEntries; i++ {
+		data[fmt.Sprintf("key%d", i)] = []byte(fmt.Sprintf("value%d", i))
+	}
+
+	return data
+}
+
+func writeWithFactory(t *testing.T, path string, dbConfig config.DBConfig, data map[string][]byte) {
+	persisterFactory, err := factory.NewPersisterFactory(dbConfig)
+	require.Nil(t, err)
+
+	persister, err := persisterFactory.Create(path)
+	require.Nil(t, err)
+	for key, val := range data {
+		require.Nil(t, persister.Put([]byte(key), val))
+	}
+	require.Nil(t, persister.Close())
+}
+
+func openWithFactory(t *testing.T, path string) storage.Persister {
+	// the main config type is ignored as the migrated databases store their own configuration
+	persisterFactory, err := factory.NewPersisterFactory(createLevelDBConfig(1))
+	require.Nil(t, err)
+
+	persister, err := persisterFactory.Create(path)
+	require.Nil(t, err)
+
+	return persister
+}
+
+func readAll(persister storage.Persister) map[string][]byte {
+	data := make(map[string][]byte)
+	persister.RangeKeys(func(key []byte, value []byte) bool {
+		data[string(key)] = value
+		return true
+	})
+
+	return data
+}
+
+func TestMigrate_InvalidPathsShouldError(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing source", func(t *testing.T) {
+		t.Parallel()
+
+		err := migrate(filepath.Join(t.TempDir(), "missing"), t.TempDir(), testBatchSize)
+		assert.True(t, os.IsNotExist(err))
+	})
+	t.Run("source is a file", func(t *testing.T) {
+		t.Parallel()
+
+		sourcePath := filepath.Join(t.TempDir(), "file")
+		require.Nil(t, os.WriteFile(sourcePath, []byte("data"), 0600))
+
+		err := migrate(sourcePath, t.TempDir(), testBatchSize)
+		assert.True(t, errors.Is(err, errSourceNotDirectory))
+	})
+	t.Run("destination inside source", func(t *testing.T) {
+		t.Parallel()
+
+		sourcePath := t.TempDir()
+
+		err := migrate(sourcePath, filepath.Join(sourcePath, "migrated"), testBatchSize)
+		assert.Equal(t, errDestinationInsideSource, err)
+
+		err = migrate(sourcePath, sourcePath, testBatchSize)
+		assert.Equal(t, errDestinationInsideSource, err)
+	})
+	t.Run("destination not empty", func(t *testing.T) {
+		t.Parallel()
+
+		destinationPath := t.TempDir()
+		require.Nil(t, os.WriteFile(filepath.Join(destinationPath, "file"), []byte("data"), 0600))
+
+		err := migrate(t.TempDir(), destinationPath, testBatchSize)
+		assert.True(t, errors.Is(err, errDestinationNotEmpty))
+	})
+}
+
+func TestMigrate_SingleDatabase(t *testing.T) {
+	t.Parallel()
+
+	t.Run("with stored configuration", func(t *testing.T) {
+		t.Parallel()
+
+		sourcePath := filepath.Join(t.TempDir(), "BlockHeaders")
+		destinationPath := filepath.Join(t.TempDir(), "BlockHeaders")
+		data := createTestData(10)
+		writeWithFactory(t, sourcePath, createLevelDBConfig(1), data)
+
+		err := migrate(sourcePath, destinationPath, testBatchSize)
+		require.Nil(t, err)
+
+		persister := openWithFactory(t, destinationPath)
+		defer func() {
+			_ = persister.Close()
+		}()
+		assert.True(t, strings.Contains(fmt.Sprintf("%T", persister), "*badgerdb.DB"))
+		assert.Equal(t, data, readAll(persister))
+	})
+	t.Run("without stored configuration", func(t *testing.T) {
+		t.Parallel()
+
+		sourcePath := filepath.Join(t.TempDir(), "BlockHeaders")
+		destinationPath := filepath.Join(t.TempDir(), "BlockHeaders")
+		data := createTestData(10)
+
+		sourceDB, err := database.NewSerialDB(sourcePath, 2, 100, 10)
+		require.Nil(t, err)
+		for key, val := range data {
+			require.Nil(t, sourceDB.Put([]byte(key), val))
+		}
+		require.Nil(t, sourceDB.Close())
+
+		err = migrate(sourcePath, destinationPath, testBatchSize)
+		require.Nil(t, err)
+
+		persister := openWithFactory(t, destinationPath)
+		defer func() {
+			_ = persister.Close()
+		}()
+		assert.True(t, strings.Contains(fmt.Sprintf("%T", persister), "*badgerdb.DB"))
+		assert.Equal(t, data, readAll(persister))
+	})
+}
+
+func TestMigrate_DirectoryTree(t *testing.T) {
+	t.Parallel()
+
+	sourcePath := t.TempDir()
+	destinationPath := filepath.Join(t.TempDir(), "db")
+
+	headersPath := filepath.Join("Epoch_0", "Shard_0", "BlockHeaders")
+	headers := createTestData(10)
+	writeWithFactory(t, filepath.Join(sourcePath, headersPath), createLevelDBConfig(1), headers)
+
+	transactionsPath := filepath.Join("Epoch_0", "Shard_0", "Transactions")
+	transactions := createTestData(20)
+	writeWithFactory(t, filepath.Join(sourcePath, transactionsPath), createLevelDBConfig(4), transactions)
+
+	otherFilePath := filepath.Join("Epoch_0", "other.json")
+	require.Nil(t, os.WriteFile(filepath.Join(sourcePath, otherFilePath), []byte("{}"), 0600))
+
+	err := migrate(sourcePath, destinationPath, testBatchSize)
+	require.Nil(t, err)
+
+	headersPersister := openWithFactory(t, filepath.Join(destinationPath, headersPath))
+	defer func() {
+		_ = headersPersister.Close()
+	}()
+	assert.True(t, strings.Contains(fmt.Sprintf("%T", headersPersister), "*badgerdb.DB"))
+	assert.Equal(t, headers, readAll(headersPersister))
+
+	transactionsPersister := openWithFactory(t, filepath.Join(destinationPath, transactionsPath))
+	defer func() {
+		_ = transactionsPersister.Close()
+	}()
+	assert.True(t, strings.Contains(fmt.Sprintf("%T", transactionsPersister), "*sharded.shardedPersister"))
+	assert.Equal(t, transactions, readAll(transactionsPersister))
+
+	otherFile, err := os.ReadFile(filepath.Join(destinationPath, otherFilePath))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("{}"), otherFile)
+
+	// the source is left untouched
+	sourcePersister := openWithFactory(t, filepath.Join(sourcePath, headersPath))
+	defer func() {
+		_ = sourcePersister.Close()
+	}()
+	assert.True(t, strings.Contains(fmt.Sprintf("%T", sourcePersister), "*leveldb.SerialDB"))
+	assert.Equal(t, headers, readAll(sourcePersister))
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
 	"github.com/multiversx/mx-chain-crypto-go/signing/secp256k1"
 	"github.com/multiversx/mx-chain-go/cmd/keygenerator/converter"
+	"github.com/multiversx/mx-chain-go/keysManagement/keystore"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/urfave/cli"
 )
@@ -31,6 +32,10 @@ type cfg struct {
 	noSplit       bool
 	prefixPattern string
 	shardIDByte   int
+	encrypt       bool
+	kdf           string
+	passphraseFd  int
+	passphraseEnv string
 }
 
 const validatorType = "validator"
@@ -123,12 +128,49 @@ VERSION:
 		Value:       -1,
 		Destination: &argsConfig.shardIDByte,
 	}
+	// encrypt is the flag that, if active, will write the keys in passphrase encrypted JSON keystores
+	encrypt = cli.BoolFlag{
+		Name: "encrypt",
+		Usage: "Boolean option that will write the keys in passphrase encrypted JSON keystores instead of plaintext " +
+			"PEM files. The keystores can be provided to the node instead of the PEM files",
+		Destination: &argsConfig.encrypt,
+	}
+	// kdf defines a flag for the key derivation function used for the encrypted keystores
+	kdf = cli.StringFlag{
+		Name: "kdf",
+		Usage: fmt.Sprintf(
+			"The key derivation function used to derive the encryption key from the passphrase. Available options: %s, %s",
+			keystore.ScryptKDF,
+			keystore.Argon2idKDF),
+		Value:       keystore.ScryptKDF,
+		Destination: &argsConfig.kdf,
+	}
+	// passphraseFd defines a flag for the file descriptor from which the passphrase is read
+	passphraseFd = cli.IntFlag{
+		Name: "passphrase-fd",
+		Usage: "The file descriptor from which the keystores passphrase is read, up to the first new line. If not " +
+			"set, the passphrase is read from the environment variable named by the passphrase-env flag or, if that " +
+			"variable is not defined, from the interactive terminal",
+		Value:       keystore.NoFileDescriptor,
+		Destination: &argsConfig.passphraseFd,
+	}
+	// passphraseEnv defines a flag for the name of the environment variable holding the passphrase
+	passphraseEnv = cli.StringFlag{
+		Name:        "passphrase-env",
+		Usage:       "The name of the environment variable holding the keystores passphrase",
+		Value:       "MX_KEYS_PASSPHRASE",
+		Destination: &argsConfig.passphraseEnv,
+	}
 	argsConfig = &cfg{}
 
 	walletKeyFilenameTemplate    = "walletKey%s.pem"
 	validatorKeyFilenameTemplate = "validatorKey%s.pem"
 	p2pKeyFilenameTemplate       = "p2pKey%s.pem"
 
+	walletKeystoreFilenameTemplate    = "walletKey%s.json"
+	validatorKeystoreFilenameTemplate = "validatorKey%s.json"
+	p2pKeystoreFilenameTemplate       = "p2pKey%s.json"
+
 	log = logger.GetOrCreate("keygenerator")
 
 	validatorPubKeyConverter, _ = pubkeyConverter.NewHexPubkeyConverter(blsPubkeyLen)
@@ -155,6 +197,10 @@ func main() {
 		noSplit,
 		shardIDByte,
 		keyPrefix,
+		encrypt,
+		kdf,
+		passphraseFd,
+		passphraseEnv,
 	}
 
 	app.Action = func(_ *cli.Context) error {
@@ -170,14 +216,44 @@ func main() {
 }
 
 func process() error {
+	var passphrase []byte
+	var err error
+	if argsConfig.encrypt {
+		// read the passphrase first, the keys mining can take a while
+		passphrase, err = readPassphrase()
+		if err != nil {
+			return err
+		}
+	}
+
 	validatorKeys, walletKeys, p2pKeys, err := generateKeys(argsConfig.keyType, argsConfig.numKeys, argsConfig.prefixPattern, argsConfig.shardIDByte)
 	if err != nil {
 		return err
 	}
 
+	if argsConfig.encrypt {
+		return outputKeystores(validatorKeys, walletKeys, p2pKeys, argsConfig.consoleOut, argsConfig.noSplit, passphrase)
+	}
+
 	return outputKeys(validatorKeys, walletKeys, p2pKeys, argsConfig.consoleOut, argsConfig.noSplit)
 }
 
+func readPassphrase() ([]byte, error) {
+	_, err := keystore.DefaultKDFParams(argsConfig.kdf)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{
+		FileDescriptor: argsConfig.passphraseFd,
+		EnvVariable:    argsConfig.passphraseEnv,
+		PromptMessage:  "Enter the passphrase used to encrypt the keys: ",
+		ConfirmPrompt:  true,
+	})
+
+	return provider.Passphrase()
+}
+
 func generateKeys(typeKey string, numKeys int, prefix string, shardID int) ([]key, []key, []key, error) {
 	if numKeys < 1 {
 		return nil, nil, nil, fmt.Errorf("number of keys should be a number greater or equal to 1")
@@ -379,6 +455,118 @@ func writeKeyToStream(writer io.Writer, key key, converter pubKeyConverter) erro
 	return pem.Encode(writer, &blk)
 }
 
+func outputKeystores(
+	validatorKeys []key,
+	walletKeys []key,
+	p2pKeys []key,
+	consoleOut bool,
+	noSplit bool,
+	passphrase []byte,
+) error {
+	if len(validatorKeys)+len(walletKeys)+len(p2pKeys) == 0 {
+		return fmt.Errorf("internal error: no keys to save")
+	}
+
+	var errFound error
+	if len(validatorKeys) > 0 {
+		err := outputSliceKeystores(validatorKeystoreFilenameTemplate, keystore.ValidatorKind, validatorKeys, validatorPubKeyConverter, consoleOut, noSplit, passphrase)
+		if err != nil {
+			errFound = err
+		}
+	}
+	if len(walletKeys) > 0 {
+		err := outputSliceKeystores(walletKeystoreFilenameTemplate, keystore.WalletKind, walletKeys, walletPubKeyConverter, consoleOut, noSplit, passphrase)
+		if err != nil {
+			errFound = err
+		}
+	}
+	if len(p2pKeys) > 0 {
+		err := outputSliceKeystores(p2pKeystoreFilenameTemplate, keystore.P2pKind, p2pKeys, pidPubKeyConverter, consoleOut, noSplit, passphrase)
+		if err != nil {
+			errFound = err
+		}
+	}
+
+	return errFound
+}
+
+func outputSliceKeystores(
+	baseFilenameTemplate string,
+	kind string,
+	keys []key,
+	converter pubKeyConverter,
+	consoleOut bool,
+	noSplit bool,
+	passphrase []byte,
+) error {
+	entries := make([]keystore.KeyEntry, 0, len(keys))
+	for _, k := range keys {
+		pkString, err := converter.Encode(k.pkBytes)
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, keystore.KeyEntry{
+			PublicKey: pkString,
+			SecretKey: hex.EncodeToString(k.skBytes),
+		})
+	}
+
+	// each keystore holds all the keys when not split, otherwise one key
+	groups := [][]keystore.KeyEntry{entries}
+	if !noSplit {
+		groups = make([][]keystore.KeyEntry, 0, len(entries))
+		for _, entry := range entries {
+			groups = append(groups, []keystore.KeyEntry{entry})
+		}
+	}
+
+	for i, group := range groups {
+		ks, err := keystore.Encrypt(keystore.ArgsEncrypt{
+			Kind:       kind,
+			Keys:       group,
+			Passphrase: passphrase,
+			KDF:        argsConfig.kdf,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = outputKeystore(ks, i, len(groups), baseFilenameTemplate, consoleOut)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func outputKeystore(ks *keystore.KeyStore, index int, numKeystores int, baseFilenameTemplate string, consoleOut bool) error {
+	buff, err := ks.Marshal()
+	if err != nil {
+		return err
+	}
+
+	if consoleOut {
+		log.Info(fmt.Sprintf("Encrypted %s keys:\n%s", ks.Kind, string(buff)))
+		return nil
+	}
+
+	// the keystores are never appended to, so each one is handled as a split file
+	file, err := generateFile(index, numKeystores, false, baseFilenameTemplate, core.FileModeUserReadWrite)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(buff)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
 func saveKeys(validatorKeys, walletKeys, p2pKeys []key, noSplit bool) error {
 	if len(validatorKeys)+len(walletKeys)+len(p2pKeys) == 0 {
 		return fmt.Errorf("internal error: no keys to save")
@@ -413,7 +601,7 @@ func saveSliceKeys(baseFilenameTemplate string, keys []key, converter pubKeyConv
 	for i, k := range keys {
 		shouldCreateFile := !noSplit || i == 0
 		if shouldCreateFile {
-			file, err = generateFile(i, len(keys), noSplit, baseFilenameTemplate)
+			file, err = generateFile(i, len(keys), noSplit, baseFilenameTemplate, core.FileModeReadWrite)
 			if err != nil {
 				return err
 			}
@@ -438,7 +626,7 @@ func saveSliceKeys(baseFilenameTemplate string, keys []key, converter pubKeyConv
 	return nil
 }
 
-func generateFile(index int, numKeys int, noSplit bool, baseFilenameTemplate string) (*os.File, error) {
+func generateFile(index int, numKeys int, noSplit bool, baseFilenameTemplate string, perm os.FileMode) (*os.File, error) {
 	folder, err := generateFolder(index, numKeys, noSplit)
 	if err != nil {
 		return nil, err
@@ -453,7 +641,7 @@ func generateFile(index int, numKeys int, noSplit bool, baseFilenameTemplate str
 		return nil, err
 	}
 
-	return os.OpenFile(filename, os.O_CREATE|os.O_WRONLY, core.FileModeReadWrite)
+	return os.OpenFile(filename, os.O_CREATE|os.O_WRONLY, perm)
 }
 
 func generateFolder(index int, numKeys int, noSplit bool) (string, error) {
@@ -3,6 +3,7 @@ package main
 import (
 	"encoding/hex"
 	"fmt"
+	"net/http"
 	"net/url"
 	"os"
 	"os/signal"
@@ -24,6 +25,7 @@ const (
 	wsLogPath      = "/log"
 	ws             = "ws"
 	wss            = "wss"
+	bearerPrefix   = "Bearer "
 )
 
 type config struct {
@@ -34,6 +36,7 @@ type config struct {
 	useWss             bool
 	logWithCorrelation bool
 	logWithLoggerName  bool
+	apiToken           string
 }
 
 var (
@@ -93,6 +96,14 @@ VERSION:
 		Usage:       "Boolean option for logger name in the logs.",
 		Destination: &argsConfig.logWithLoggerName,
 	}
+	// apiToken defines the token sent to the node, needed to access the /log route
+	apiToken = cli.StringFlag{
+		Name: "api-token",
+		Usage: "The operator or admin API token sent in the Authorization header. Needed to access the node's /log " +
+			"route, which requires the operator role.",
+		EnvVar:      "MX_API_TOKEN",
+		Destination: &argsConfig.apiToken,
+	}
 	// workingDirectory defines a flag for the path for the working directory.
 	workingDirectory = cli.StringFlag{
 		Name:        "working-directory",
@@ -140,6 +151,7 @@ func initCliFlags() {
 		useWss,
 		logWithCorrelation,
 		logWithLoggerName,
+		apiToken,
 	}
 	cliApp.Authors = []cli.Author{
 		{
@@ -258,8 +270,19 @@ func openWebSocket(address string) (*websocket.Conn, error) {
 		Path:   wsLogPath,
 	}
 
-	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	var header http.Header
+	if len(argsConfig.apiToken) > 0 {
+		header = http.Header{}
+		header.Set("Authorization", bearerPrefix+argsConfig.apiToken)
+	}
+
+	conn, resp, err := websocket.DefaultDialer.Dial(u.String(), header)
 	if err != nil {
+		if resp != nil && (resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden) {
+			return nil, fmt.Errorf("%w: the node rejected the access to %s, provide an operator API token with the --%s flag",
+				err, wsLogPath, apiToken.Name)
+		}
+
 		return nil, err
 	}
 
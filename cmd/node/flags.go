@@ -10,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common/operationmodes"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/facade"
+	"github.com/multiversx/mx-chain-go/keysManagement/keystore"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/urfave/cli"
 )
@@ -221,16 +222,33 @@ var (
 		Name: "validator-key-pem-file",
 		Usage: "The `filepath` for the PEM file which contains the secret keys to be used by this node. If the file " +
 			"does not exists or can not be loaded, the node will autogenerate and use a random key. The key may or may not " +
-			"be registered to be a consensus validator.",
+			"be registered to be a consensus validator. An encrypted JSON keystore generated by the keygenerator tool can " +
+			"be provided instead of the PEM file.",
 		Value: "./config/validatorKey.pem",
 	}
 	// allValidatorKeysPemFile defines a flag for the path to the file that hold all validator keys used in block signing
 	// managed by the current node
 	allValidatorKeysPemFile = cli.StringFlag{
-		Name:  "all-validator-keys-pem-file",
-		Usage: "The `filepath` for the PEM file which contains all the secret keys managed by the current node.",
+		Name: "all-validator-keys-pem-file",
+		Usage: "The `filepath` for the PEM file which contains all the secret keys managed by the current node. An " +
+			"encrypted JSON keystore generated by the keygenerator tool can be provided instead of the PEM file.",
 		Value: "./config/allValidatorsKeys.pem",
 	}
+	// keysPassphraseFd defines a flag for the file descriptor from which the passphrase of the encrypted keystores is read
+	keysPassphraseFd = cli.IntFlag{
+		Name: "keys-passphrase-fd",
+		Usage: "The `file descriptor` from which the passphrase of the encrypted JSON keystores (validator, all " +
+			"validators and p2p keys) is read, up to the first new line. If not set, the passphrase is read from the " +
+			"environment variable named by the keys-passphrase-env flag or, if that variable is not defined, from the " +
+			"interactive terminal. Not used for the PEM files.",
+		Value: keystore.NoFileDescriptor,
+	}
+	// keysPassphraseEnv defines a flag for the name of the environment variable holding the passphrase of the encrypted keystores
+	keysPassphraseEnv = cli.StringFlag{
+		Name:  "keys-passphrase-env",
+		Usage: "The `name` of the environment variable holding the passphrase of the encrypted JSON keystores.",
+		Value: "MX_KEYS_PASSPHRASE",
+	}
 
 	// logLevel defines the logger level
 	logLevel = cli.StringFlag{
@@ -379,7 +397,7 @@ var (
 	// p2pKeyPemFile defines the flag for the path to the key pem file used for p2p signing
 	p2pKeyPemFile = cli.StringFlag{
 		Name:  "p2p-key-pem-file",
-		Usage: "The `filepath` for the PEM file which contains the secret keys for the p2p key. If this is not specified a new key will be generated (internally) by default.",
+		Usage: "The `filepath` for the PEM file which contains the secret keys for the p2p key. If this is not specified a new key will be generated (internally) by default. An encrypted JSON keystore generated by the keygenerator tool can be provided instead of the PEM file.",
 		Value: "./config/p2pKey.pem",
 	}
 
@@ -408,6 +426,17 @@ var (
 		Name:  "p2p-prometheus-metrics",
 		Usage: "Boolean option for enabling the /debug/metrics/prometheus route for p2p prometheus metrics",
 	}
+
+	// importStateSnapshot defines a flag for the state snapshot file from which the accounts state is imported when the
+	// node starts from the network
+	importStateSnapshot = cli.StringFlag{
+		Name: "import-state-snapshot",
+		Usage: "The `filepath` of a state snapshot file written by the dbinspector tool. When the node starts from the " +
+			"network, the accounts trie and its data tries are imported from this file instead of being synced from " +
+			"the peers, if the file was written for the epoch start root hash. The nodes missing from the file are " +
+			"still requested from the peers.",
+		Value: "",
+	}
 )
 
 func getFlags() []cli.Flag {
@@ -430,6 +459,8 @@ func getFlags() []cli.Flag {
 		validatorKeyIndex,
 		validatorKeyPemFile,
 		allValidatorKeysPemFile,
+		keysPassphraseFd,
+		keysPassphraseEnv,
 		port,
 		fullArchivePort,
 		profileMode,
@@ -470,6 +501,7 @@ func getFlags() []cli.Flag {
 		operationMode,
 		repopulateTokensSupplies,
 		p2pPrometheusMetrics,
+		importStateSnapshot,
 	}
 }
 
@@ -499,6 +531,9 @@ func getFlagsConfig(ctx *cli.Context, log logger.Logger) *config.ContextFlagsCon
 	flagsConfig.OperationMode = ctx.GlobalString(operationMode.Name)
 	flagsConfig.RepopulateTokensSupplies = ctx.GlobalBool(repopulateTokensSupplies.Name)
 	flagsConfig.P2PPrometheusMetricsEnabled = ctx.GlobalBool(p2pPrometheusMetrics.Name)
+	flagsConfig.KeysPassphraseFd = ctx.GlobalInt(keysPassphraseFd.Name)
+	flagsConfig.KeysPassphraseEnvVariable = ctx.GlobalString(keysPassphraseEnv.Name)
+	flagsConfig.ImportStateSnapshotFile = ctx.GlobalString(importStateSnapshot.Name)
 
 	if ctx.GlobalBool(noKey.Name) {
 		log.Warn("the provided -no-key option is deprecated and will soon be removed. To start a node without " +
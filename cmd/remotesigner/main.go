@@ -0,0 +1,239 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	mclSig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/consensus"
+	cryptoFactory "github.com/multiversx/mx-chain-go/factory/crypto"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const (
+	filePathPlaceholder = "[path]"
+	multiSigHasherType  = "blake2b"
+)
+
+var (
+	remoteSignerHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// validatorKeyPemFile defines a flag for the path to the validator key used in single-key mode
+	validatorKeyPemFile = cli.StringFlag{
+		Name:  "validator-key-pem-file",
+		Usage: "The `filepath` for the PEM file which contains the secret key of the node running in single-key mode. Leave empty if the node runs in multi-key mode.",
+		Value: "",
+	}
+	// allValidatorKeysPemFile defines a flag for the path to the validator keys managed in multi-key mode
+	allValidatorKeysPemFile = cli.StringFlag{
+		Name:  "all-validator-keys-pem-file",
+		Usage: "The `filepath` for the PEM file which contains all the secret keys managed by the node running in multi-key mode. Leave empty if the node runs in single-key mode.",
+		Value: "",
+	}
+	// authKeyFile defines a flag for the path to the key used to authenticate the messages exchanged with the node
+	authKeyFile = cli.StringFlag{
+		Name: "auth-key-file",
+		Usage: "The `" + filePathPlaceholder + "` for the file which contains the hex encoded key, of at least 32 bytes, used " +
+			"to authenticate the messages exchanged with the node. The same file should be set in the RemoteSigner section " +
+			"of the node's config.toml. Such a key can be generated with openssl rand -hex 32",
+		Value: "./config/remoteSignerAuth.key",
+	}
+	// network defines a flag for the network type on which the remote signer listens
+	network = cli.StringFlag{
+		Name:  "network",
+		Usage: "The `network` type on which the remote signer listens. Can be unix or tcp. The tcp network should only be used on the loopback interface.",
+		Value: remoteSigner.UnixNetwork,
+	}
+	// address defines a flag for the address on which the remote signer listens
+	address = cli.StringFlag{
+		Name:  "address",
+		Usage: "The `address` on which the remote signer listens: the socket file path for the unix network or the host:port for the tcp network",
+		Value: "./remoteSigner.sock",
+	}
+	// epochConfigurationFile defines a flag for the path to the toml file containing the epoch configurations
+	epochConfigurationFile = cli.StringFlag{
+		Name:  "epoch-config",
+		Usage: "The `" + filePathPlaceholder + "` for the epoch configuration file of the node. The BLS multi-signers are created based on this file.",
+		Value: "./config/enableEpochs.toml",
+	}
+	// requestTimeout defines a flag for the maximum duration of a request
+	requestTimeout = cli.IntFlag{
+		Name:  "request-timeout",
+		Usage: "The maximum `duration in milliseconds` allowed for reading a request and writing its response",
+		Value: 2000,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+)
+
+var log = logger.GetOrCreate("main")
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = remoteSignerHelpTemplate
+	app.Name = "RemoteSigner CLI App"
+	app.Usage = "This is the entry point for starting a reference remote signer - the app holds the validator BLS keys and signs on behalf of the node"
+	app.Flags = []cli.Flag{
+		validatorKeyPemFile,
+		allValidatorKeysPemFile,
+		authKeyFile,
+		network,
+		address,
+		epochConfigurationFile,
+		requestTimeout,
+		logLevel,
+	}
+	app.Version = "v0.0.1"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+
+	app.Action = func(c *cli.Context) error {
+		return startRemoteSigner(c)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func startRemoteSigner(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	authKey, err := remoteSigner.LoadAuthKey(ctx.GlobalString(authKeyFile.Name))
+	if err != nil {
+		return err
+	}
+
+	epochConfig, err := common.LoadEpochConfig(ctx.GlobalString(epochConfigurationFile.Name))
+	if err != nil {
+		return err
+	}
+
+	nodePrivateKey, err := loadNodePrivateKey(ctx.GlobalString(validatorKeyPemFile.Name))
+	if err != nil {
+		return err
+	}
+
+	managedPrivateKeys, err := loadManagedPrivateKeys(ctx.GlobalString(allValidatorKeysPemFile.Name))
+	if err != nil {
+		return err
+	}
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	multiSignerContainer, err := cryptoFactory.NewMultiSignerContainer(
+		cryptoFactory.MultiSigArgs{
+			MultiSigHasherType: multiSigHasherType,
+			BlSignKeyGen:       keyGen,
+			ConsensusType:      consensus.BlsConsensusType,
+		},
+		epochConfig.EnableEpochs.BLSMultiSignerEnableEpoch,
+	)
+	if err != nil {
+		return err
+	}
+
+	server, err := remoteSigner.NewSignerServer(remoteSigner.ArgsSignerServer{
+		Network:              ctx.GlobalString(network.Name),
+		Address:              ctx.GlobalString(address.Name),
+		AuthKey:              authKey,
+		RequestTimeout:       time.Millisecond * time.Duration(ctx.GlobalInt(requestTimeout.Name)),
+		KeyGenerator:         keyGen,
+		SingleSigner:         &mclSig.BlsSingleSigner{},
+		MultiSignerContainer: multiSignerContainer,
+		NodePrivateKey:       nodePrivateKey,
+		ManagedPrivateKeys:   managedPrivateKeys,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("application is now running...")
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	<-sigs
+
+	log.Info("terminating at user's signal...")
+
+	return server.Close()
+}
+
+func loadNodePrivateKey(pemFile string) ([]byte, error) {
+	if len(pemFile) == 0 {
+		return nil, nil
+	}
+
+	encodedSk, pkString, err := core.NewKeyLoader().LoadKey(pemFile, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	skBytes, err := hex.DecodeString(string(encodedSk))
+	if err != nil {
+		return nil, fmt.Errorf("%w for encoded secret key of %s", err, pkString)
+	}
+
+	return skBytes, nil
+}
+
+func loadManagedPrivateKeys(pemFile string) ([][]byte, error) {
+	if len(pemFile) == 0 {
+		return nil, nil
+	}
+
+	encodedKeys, pkStrings, err := core.NewKeyLoader().LoadAllKeys(pemFile)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeys := make([][]byte, 0, len(encodedKeys))
+	for idx, encodedSk := range encodedKeys {
+		skBytes, errDecode := hex.DecodeString(string(encodedSk))
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w for encoded secret key of %s", errDecode, pkStrings[idx])
+		}
+
+		privateKeys = append(privateKeys, skBytes)
+	}
+
+	return privateKeys, nil
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"errors"
+	"os"
+
+	"github.com/multiversx/mx-chain-go/consensus/signingProtection"
+	"github.com/multiversx/mx-chain-go/storage/database"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const (
+	filePathPlaceholder = "[path]"
+	batchDelayInSeconds = 2
+	maxBatchSize        = 1
+	maxOpenFiles        = 10
+)
+
+var (
+	signingProtectionHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// dbPath defines a flag for the path to the signing protection database
+	dbPath = cli.StringFlag{
+		Name: "db-path",
+		Usage: "The `" + filePathPlaceholder + "` for the signing protection database. It is the db directory of the " +
+			"node joined with the FilePath option of the SigningProtection section in config.toml. The node should be " +
+			"stopped while this tool runs.",
+		Value: "./db/SigningProtectionDB",
+	}
+	// exportFile defines a flag for the path of the file in which the signing history is exported
+	exportFile = cli.StringFlag{
+		Name:  "export-file",
+		Usage: "The `" + filePathPlaceholder + "` for the JSON file in which the signing history will be exported",
+		Value: "",
+	}
+	// importFile defines a flag for the path of the file from which the signing history is imported
+	importFile = cli.StringFlag{
+		Name: "import-file",
+		Usage: "The `" + filePathPlaceholder + "` for the JSON file from which the signing history will be imported. " +
+			"For each key, the record with the highest round between the existing and the imported ones is kept.",
+		Value: "",
+	}
+	// chainID defines a flag for the chain ID written on export and checked on import
+	chainID = cli.StringFlag{
+		Name:  "chain-id",
+		Usage: "The `chain ID` written in the exported file. On import, if not empty, it should match the one in the file.",
+		Value: "",
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+
+	errNoOperation        = errors.New("one of the export-file or import-file flags should be provided")
+	errTooManyOperations  = errors.New("the export-file and import-file flags can not be provided at the same time")
+	errEmptyDatabasePath  = errors.New("empty signing protection database path")
+	errDatabaseNotCreated = errors.New("the signing protection database does not exist")
+)
+
+var log = logger.GetOrCreate("main")
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = signingProtectionHelpTemplate
+	app.Name = "SigningProtection CLI App"
+	app.Usage = "This tool exports and imports the signing history kept by the node to prevent double signing, so it can be moved along with the validator keys"
+	app.Flags = []cli.Flag{
+		dbPath,
+		exportFile,
+		importFile,
+		chainID,
+		logLevel,
+	}
+	app.Version = "v0.0.1"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+
+	app.Action = func(c *cli.Context) error {
+		return process(c)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func process(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	exportFilePath := ctx.GlobalString(exportFile.Name)
+	importFilePath := ctx.GlobalString(importFile.Name)
+	if len(exportFilePath) == 0 && len(importFilePath) == 0 {
+		return errNoOperation
+	}
+	if len(exportFilePath) > 0 && len(importFilePath) > 0 {
+		return errTooManyOperations
+	}
+
+	path := ctx.GlobalString(dbPath.Name)
+	if len(path) == 0 {
+		return errEmptyDatabasePath
+	}
+	if len(exportFilePath) > 0 {
+		// do not create an empty database when exporting from a wrong path
+		_, err = os.Stat(path)
+		if err != nil {
+			return errDatabaseNotCreated
+		}
+	}
+
+	persister, err := database.NewSerialDB(path, batchDelayInSeconds, maxBatchSize, maxOpenFiles)
+	if err != nil {
+		return err
+	}
+
+	protector, err := signingProtection.NewSigningProtector(signingProtection.ArgsSigningProtector{
+		Persister: persister,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		errClose := protector.Close()
+		log.LogIfError(errClose)
+	}()
+
+	if len(exportFilePath) > 0 {
+		return exportHistory(protector, exportFilePath, ctx.GlobalString(chainID.Name))
+	}
+
+	return importHistory(protector, importFilePath, ctx.GlobalString(chainID.Name))
+}
+
+func exportHistory(protector signingProtection.SigningProtector, filePath string, chain string) error {
+	data, err := protector.Export(chain)
+	if err != nil {
+		return err
+	}
+
+	err = signingProtection.WriteInterchangeFile(filePath, data)
+	if err != nil {
+		return err
+	}
+
+	log.Info("signing history exported", "file", filePath, "num keys", len(data.Data))
+
+	return nil
+}
+
+func importHistory(protector signingProtection.SigningProtector, filePath string, chain string) error {
+	data, err := signingProtection.ReadInterchangeFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	err = protector.Import(data, chain)
+	if err != nil {
+		return err
+	}
+
+	log.Info("signing history imported", "file", filePath)
+
+	return nil
+}
@@ -21,6 +21,7 @@ type config struct {
 	interval           int
 	address            string
 	logLevel           string
+	apiToken           string
 }
 
 var (
@@ -83,6 +84,14 @@ VERSION:
 		Usage:       "Will use wss instead of ws when creating the web socket",
 		Destination: &argsConfig.useWss,
 	}
+	// apiToken defines the token sent to the node, needed to access the /log route
+	apiToken = cli.StringFlag{
+		Name: "api-token",
+		Usage: "The operator or admin API token sent in the Authorization header. Needed to access the node's /log " +
+			"route, which requires the operator role.",
+		EnvVar:      "MX_API_TOKEN",
+		Destination: &argsConfig.apiToken,
+	}
 	argsConfig = &config{}
 
 	log    = logger.GetOrCreate("termui")
@@ -139,6 +148,7 @@ func startTermuiViewer(ctx *cli.Context) error {
 		ChanNodeIsStarting: chanNodeIsStarting,
 		UseWss:             argsConfig.useWss,
 		CustomLogProfile:   customLogProfile,
+		APIToken:           argsConfig.apiToken,
 	}
 	err = provider.InitLogHandler(argsLogHandler)
 	if err != nil {
@@ -168,6 +178,7 @@ func initCliFlags() {
 		logWithLoggerName,
 		fetchIntervalInMilliseconds,
 		useWss,
+		apiToken,
 	}
 	cliApp.Authors = []cli.Author{
 		{
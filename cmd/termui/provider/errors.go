@@ -17,5 +17,8 @@ var ErrNilTermuiPresenter = errors.New("nil termui presenter")
 // ErrNilChanNodeIsStarting signals that a nil channel for node starting has been provided
 var ErrNilChanNodeIsStarting = errors.New("nil node starting channel")
 
+// ErrLogAccessDenied signals that the node rejected the access to the /log route
+var ErrLogAccessDenied = errors.New("the node rejected the access to the /log route, an operator API token is needed")
+
 // ErrEmptyNodeURL signals that an empty URL for the node has been provided
 var ErrEmptyNodeURL = errors.New("empty node URL")
@@ -2,7 +2,9 @@ package provider
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 	"time"
@@ -32,6 +34,7 @@ type LogHandlerArgs struct {
 	ChanNodeIsStarting chan struct{}
 	UseWss             bool
 	CustomLogProfile   bool
+	APIToken           string
 }
 
 // InitLogHandler will open the websocket and start listening to logs
@@ -53,9 +56,9 @@ func InitLogHandler(args LogHandlerArgs) error {
 	}
 	go func() {
 		for {
-			webSocket, err = openWebSocket(scheme, args.NodeURL)
+			webSocket, err = openWebSocket(scheme, args.NodeURL, args.APIToken)
 			if err != nil {
-				_, _ = args.Presenter.Write([]byte(fmt.Sprintf("termui websocket error, retrying in %v...", retryDuration)))
+				_, _ = args.Presenter.Write([]byte(createWebSocketErrorMessage(err)))
 				time.Sleep(retryDuration)
 				continue
 			}
@@ -75,14 +78,33 @@ func InitLogHandler(args LogHandlerArgs) error {
 	return nil
 }
 
-func openWebSocket(scheme string, address string) (*websocket.Conn, error) {
+func createWebSocketErrorMessage(err error) string {
+	if errors.Is(err, ErrLogAccessDenied) {
+		return fmt.Sprintf("termui websocket error: %s, retrying in %v...", err.Error(), retryDuration)
+	}
+
+	return fmt.Sprintf("termui websocket error, retrying in %v...", retryDuration)
+}
+
+func openWebSocket(scheme string, address string, apiToken string) (*websocket.Conn, error) {
 	u := url.URL{
 		Scheme: scheme,
 		Host:   address,
 		Path:   "/log",
 	}
-	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+
+	var header http.Header
+	if len(apiToken) > 0 {
+		header = http.Header{}
+		header.Set("Authorization", "Bearer "+apiToken)
+	}
+
+	conn, resp, err := websocket.DefaultDialer.Dial(u.String(), header)
 	if err != nil {
+		if resp != nil && (resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden) {
+			return nil, ErrLogAccessDenied
+		}
+
 		return nil, err
 	}
 
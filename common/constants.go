@@ -94,6 +94,9 @@ const ConnectionTopic = "connection"
 // ValidatorInfoTopic is the topic used for validatorInfo signaling
 const ValidatorInfoTopic = "validatorInfo"
 
+// RedundancyLeaseTopic is the topic used by the main and the back-up machines to exchange liveness leases
+const RedundancyLeaseTopic = "redundancyLease"
+
 // MetricCurrentRound is the metric for monitoring the current round of a node
 const MetricCurrentRound = "erd_current_round"
 
@@ -323,6 +326,18 @@ const MetricRedundancyIsMainActive = "erd_redundancy_is_main_active"
 // MetricRedundancyStepInReason is the metric that specifies why the back-up machine stepped in
 const MetricRedundancyStepInReason = "erd_redundancy_step_in_reason"
 
+// MetricRedundancyFailoverState is the metric that specifies the state of the current machine as computed by the
+// liveness leases exchanged between the main and the back-up machines
+const MetricRedundancyFailoverState = "erd_redundancy_failover_state"
+
+// MetricRedundancyFailoverTransitions is the metric that specifies the number of active/standby transitions of the
+// current machine
+const MetricRedundancyFailoverTransitions = "erd_redundancy_failover_transitions"
+
+// MetricRedundancyFailoverLastTransition is the metric that specifies the reason of the last active/standby
+// transition of the current machine
+const MetricRedundancyFailoverLastTransition = "erd_redundancy_failover_last_transition"
+
 // MetricValueNA represents the value to be used when a metric is not available/applicable
 const MetricValueNA = "N/A"
 
@@ -1107,6 +1122,15 @@ const MetricTrieSyncNumReceivedBytes = "erd_trie_sync_num_bytes_received"
 // MetricTrieSyncNumProcessedNodes is the metric that outputs the number of trie nodes processed for accounts during trie sync
 const MetricTrieSyncNumProcessedNodes = "erd_trie_sync_num_nodes_processed"
 
+// MetricHistoricalTriesPoolHits is the metric that outputs the number of historical state queries served by an already recreated trie
+const MetricHistoricalTriesPoolHits = "erd_historical_tries_pool_hits"
+
+// MetricHistoricalTriesPoolRecreations is the metric that outputs the number of tries recreated for historical state queries
+const MetricHistoricalTriesPoolRecreations = "erd_historical_tries_pool_recreations"
+
+// MetricHistoricalTriesPoolSizeInBytes is the metric that outputs the estimated size of the tries held by the historical tries pool
+const MetricHistoricalTriesPoolSizeInBytes = "erd_historical_tries_pool_size_in_bytes"
+
 // FullArchiveMetricSuffix is the suffix added to metrics specific for full archive network
 const FullArchiveMetricSuffix = "_full_archive"
 
@@ -1236,3 +1260,12 @@ const (
 	FixRelayedMoveBalanceToNonPayableSCFlag            core.EnableEpochFlag = "FixRelayedMoveBalanceToNonPayableSCFlag"
 	// all new flags must be added to createAllFlagsMap method, as part of enableEpochsHandler allFlagsDefined
 )
+
+const (
+	// SubscriptionBlockEvent is the type of the events streamed for each finalized block
+	SubscriptionBlockEvent = "block"
+	// SubscriptionTransactionEvent is the type of the events streamed when the status of a transaction changes
+	SubscriptionTransactionEvent = "transaction"
+	// SubscriptionLogEvent is the type of the events streamed for each smart contract event of a finalized block
+	SubscriptionLogEvent = "log"
+)
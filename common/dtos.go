@@ -2,6 +2,8 @@ package common
 
 import (
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
 )
 
 // GetProofResponse is a struct that stores the response of a GetProof API request
@@ -42,6 +44,22 @@ type TransactionsPoolNonceGapsForSenderApiResponse struct {
 	Gaps   []NonceGapApiResponse `json:"gaps"`
 }
 
+// AccountTransactionsAPIResponse is a struct that holds a page of the transactions of an account, to be returned on API calls.
+// If HasMore is set, the next page can be fetched by using NextBeforeNonce and NextBeforeEpoch as cursor. As the records
+// scanned for a page are limited, a page can be empty while HasMore is set
+type AccountTransactionsAPIResponse struct {
+	Transactions    []*transaction.ApiTransactionResult `json:"transactions"`
+	HasMore         bool                                `json:"hasMore"`
+	NextBeforeNonce uint64                              `json:"nextBeforeNonce,omitempty"`
+	NextBeforeEpoch uint32                              `json:"nextBeforeEpoch,omitempty"`
+}
+
+// SCQueryResultAPI holds the outcome of a smart contract query executed as part of a batch, in the format used on API calls
+type SCQueryResultAPI struct {
+	VMOutput *vm.VMOutputApi
+	Error    error
+}
+
 // DelegationDataAPI will be used when requesting the genesis balances from API
 type DelegationDataAPI struct {
 	Address string `json:"address"`
@@ -91,3 +109,61 @@ type AuctionListValidatorAPIResponse struct {
 	QualifiedTopUp string         `json:"qualifiedTopUp"`
 	Nodes          []*AuctionNode `json:"nodes"`
 }
+
+// SubscriptionFilter holds the filters applied on the events streamed to a subscriber. An empty list matches everything
+type SubscriptionFilter struct {
+	EventTypes   []string
+	Addresses    []string
+	Identifiers  []string
+	FromNonce    uint64
+	HasFromNonce bool
+}
+
+// SubscriptionEvent is an event streamed to the subscribers
+type SubscriptionEvent struct {
+	Type  string      `json:"type"`
+	Nonce uint64      `json:"nonce"`
+	Data  interface{} `json:"data"`
+}
+
+// EventsQuery holds the criteria of an events query. An event matches if it was emitted by any of the addresses,
+// has any of the identifiers and, for each position of the topics, holds any of the provided values at that position.
+// Empty criteria match everything
+type EventsQuery struct {
+	FromNonce   uint64
+	ToNonce     uint64
+	Addresses   []string
+	Identifiers []string
+	Topics      [][][]byte
+}
+
+// ApiEvent is an event returned by an events query
+type ApiEvent struct {
+	TxHash         string   `json:"txHash"`
+	BlockNonce     uint64   `json:"blockNonce"`
+	BlockHash      string   `json:"blockHash"`
+	EventIndex     int      `json:"eventIndex"`
+	Address        string   `json:"address"`
+	Identifier     string   `json:"identifier"`
+	Topics         [][]byte `json:"topics"`
+	Data           []byte   `json:"data"`
+	AdditionalData [][]byte `json:"additionalData,omitempty"`
+}
+
+// TrieVerificationStatus holds the progress and the outcome of a state trie verification. The missing and corrupted
+// nodes lists are truncated, while their counters hold the total values
+type TrieVerificationStatus struct {
+	RootHash            string   `json:"rootHash"`
+	InProgress          bool     `json:"inProgress"`
+	Heal                bool     `json:"heal"`
+	StartTimestamp      int64    `json:"startTimestamp"`
+	EndTimestamp        int64    `json:"endTimestamp"`
+	NumCheckedNodes     uint64   `json:"numCheckedNodes"`
+	NumCheckedDataTries uint64   `json:"numCheckedDataTries"`
+	NumMissingNodes     uint64   `json:"numMissingNodes"`
+	NumCorruptedNodes   uint64   `json:"numCorruptedNodes"`
+	NumHealedNodes      uint64   `json:"numHealedNodes"`
+	MissingNodes        []string `json:"missingNodes"`
+	CorruptedNodes      []string `json:"corruptedNodes"`
+	Error               string   `json:"error,omitempty"`
+}
@@ -306,6 +306,7 @@ type EnableEpochsHandler interface {
 // ManagedPeersHolder defines the operations of an entity that holds managed identities for a node
 type ManagedPeersHolder interface {
 	AddManagedPeer(privateKeyBytes []byte) error
+	AddManagedPeerFromPrivateKey(privateKey crypto.PrivateKey) error
 	GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error)
 	GetP2PIdentity(pkBytes []byte) ([]byte, core.PeerID, error)
 	GetMachineID(pkBytes []byte) (string, error)
@@ -323,6 +324,12 @@ type ManagedPeersHolder interface {
 	SetNextPeerAuthenticationTime(pkBytes []byte, nextTime time.Time)
 	IsMultiKeyMode() bool
 	GetRedundancyStepInReason() string
+	ScheduleAddManagedPeer(privateKeyBytes []byte) ([]byte, error)
+	ScheduleRemoveManagedPeer(pkBytes []byte) error
+	SchedulePauseManagedPeer(pkBytes []byte) error
+	ScheduleResumeManagedPeer(pkBytes []byte) error
+	ApplyPendingChanges()
+	IsKeyPaused(pkBytes []byte) bool
 	IsInterfaceNil() bool
 }
 
@@ -346,9 +353,26 @@ type ManagedPeersMonitor interface {
 	GetLoadedKeys() [][]byte
 	GetEligibleManagedKeys() ([][]byte, error)
 	GetWaitingManagedKeys() ([][]byte, error)
+	AddManagedKey(privateKeyBytes []byte) ([]byte, error)
+	RemoveManagedKey(pkBytes []byte) error
+	PauseManagedKey(pkBytes []byte) error
+	ResumeManagedKey(pkBytes []byte) error
 	IsInterfaceNil() bool
 }
 
+// SubscriptionsHandler defines the operations of an entity that streams the node's events to subscribers
+type SubscriptionsHandler interface {
+	Subscribe(filter SubscriptionFilter) (Subscription, error)
+	IsInterfaceNil() bool
+}
+
+// Subscription defines a stream of events. NextEvents blocks until new events are available, the context is done
+// or the subscription is closed
+type Subscription interface {
+	NextEvents(ctx context.Context) ([]*SubscriptionEvent, error)
+	Close()
+}
+
 // TxExecutionOrderHandler is used to collect and provide the order of transactions execution
 type TxExecutionOrderHandler interface {
 	Add(txHash []byte)
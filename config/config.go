@@ -104,6 +104,15 @@ type BlockSizeThrottleConfig struct {
 	MaxSizeInBytes uint32
 }
 
+// TxSelectionStrategyConfig will hold the configuration of the policy used when selecting the pool transactions
+// into the miniblocks created from self shard
+type TxSelectionStrategyConfig struct {
+	Type                   string
+	MaxTxsPerSender        uint32
+	MaxTrackedTransactions uint32
+	PriorityReceivers      []string
+}
+
 // SoftwareVersionConfig will hold the configuration for software version checker
 type SoftwareVersionConfig struct {
 	StableTagLocation        string
@@ -209,6 +218,7 @@ type Config struct {
 	NTPConfig               NTPConfig
 	HeadersPoolConfig       HeadersPoolConfig
 	BlockSizeThrottleConfig BlockSizeThrottleConfig
+	TxSelectionStrategy     TxSelectionStrategyConfig
 	VirtualMachine          VirtualMachineServicesConfig
 	BuiltInFunctions        BuiltInFunctionsConfig
 
@@ -228,6 +238,10 @@ type Config struct {
 	PeersRatingConfig   PeersRatingConfig
 	PoolsCleanersConfig PoolsCleanersConfig
 	Redundancy          RedundancyConfig
+	RemoteSigner        RemoteSignerConfig
+	SigningProtection   SigningProtectionConfig
+
+	ManagedKeysAdministration ManagedKeysAdministrationConfig
 }
 
 // PeersRatingConfig will hold settings related to peers rating
@@ -312,6 +326,8 @@ type StateTriesConfig struct {
 	MaxStateTrieLevelInMemory   uint
 	MaxPeerTrieLevelInMemory    uint
 	StateStatisticsEnabled      bool
+	MaxHistoricalTries          uint32
+	MaxHistoricalTriesSizeInMB  uint64
 }
 
 // TrieStorageManagerConfig will hold config information about trie storage manager
@@ -337,6 +353,24 @@ type WebServerAntifloodConfig struct {
 	GetAddressesBulkMaxSize            uint32
 	VmQueryDelayAfterStartInSec        uint32
 	EndpointsThrottlers                []EndpointsThrottlersConfig
+	RateLimiter                        WebServerRateLimiterConfig
+}
+
+// WebServerRateLimiterConfig will hold the parameters of the web server token-bucket rate limiter
+type WebServerRateLimiterConfig struct {
+	Enabled                         bool
+	BucketCapacity                  uint32
+	RefillTokensPerSecond           uint32
+	DefaultCost                     uint32
+	IdleBucketsCleanupIntervalInSec uint32
+	TrustedProxies                  []string
+	EndpointsCosts                  []EndpointCostConfig
+}
+
+// EndpointCostConfig will hold the number of tokens consumed by a request on an endpoint
+type EndpointCostConfig struct {
+	Endpoint string
+	Cost     uint32
 }
 
 // BlackListConfig will hold the p2p peer black list threshold values
@@ -478,6 +512,8 @@ type DbLookupExtensionsConfig struct {
 	ResultsHashesByTxHashStorageConfig StorageConfig
 	ESDTSuppliesStorageConfig          StorageConfig
 	RoundHashStorageConfig             StorageConfig
+	LogsIndexStorageConfig             StorageConfig
+	AccountTransactionsStorageConfig   StorageConfig
 }
 
 // DebugConfig will hold debugging configuration
@@ -541,8 +577,39 @@ type ProcessDebugConfig struct {
 
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
-	Logging     ApiLoggingConfig
-	APIPackages map[string]APIPackageConfig
+	Logging        ApiLoggingConfig
+	Authentication ApiAuthenticationConfig
+	GRPC           ApiGRPCConfig
+	Subscriptions  ApiSubscriptionsConfig
+	APIPackages    map[string]APIPackageConfig
+}
+
+// ApiSubscriptionsConfig holds the configuration related to the subscriptions API routes
+type ApiSubscriptionsConfig struct {
+	AllowedOrigins []string
+}
+
+// ApiGRPCConfig holds the configuration related to the gRPC API served beside the REST API
+type ApiGRPCConfig struct {
+	Enabled               bool
+	Interface             string
+	MaxMessageSizeInBytes uint32
+	MaxConcurrentStreams  uint32
+}
+
+// ApiAuthenticationConfig holds the configuration related to the authentication of the REST API callers
+type ApiAuthenticationConfig struct {
+	TokensFile          string
+	UnauthenticatedRole string
+	TLS                 ApiTLSConfig
+}
+
+// ApiTLSConfig holds the configuration related to serving the REST API over TLS
+type ApiTLSConfig struct {
+	Enabled         bool
+	CertificateFile string
+	KeyFile         string
+	ClientCAFile    string
 }
 
 // ApiLoggingConfig holds the configuration related to API requests logging
@@ -639,4 +706,36 @@ type PoolsCleanersConfig struct {
 // RedundancyConfig represents the config options to be used when setting the redundancy configuration
 type RedundancyConfig struct {
 	MaxRoundsOfInactivityAccepted int
+	FailoverCoordination          FailoverCoordinationConfig
+}
+
+// FailoverCoordinationConfig represents the config options for the liveness leases exchanged between the main and
+// the back-up machines
+type FailoverCoordinationConfig struct {
+	Enabled                     bool
+	LeaseDurationInMilliseconds uint32
+	RenewIntervalInMilliseconds uint32
+}
+
+// SigningProtectionConfig represents the config options for the database that prevents the managed keys from
+// signing conflicting consensus data
+type SigningProtectionConfig struct {
+	Enabled      bool
+	FilePath     string
+	MaxOpenFiles int
+}
+
+// ManagedKeysAdministrationConfig represents the config options for changing the managed keys at runtime
+type ManagedKeysAdministrationConfig struct {
+	WatchAllValidatorsKeysFile bool
+	WatchIntervalInSeconds     uint32
+}
+
+// RemoteSignerConfig represents the config options to be used when the validator keys are held by a remote signer
+type RemoteSignerConfig struct {
+	Enabled                      bool
+	Network                      string
+	Address                      string
+	AuthKeyFile                  string
+	RequestTimeoutInMilliseconds uint32
 }
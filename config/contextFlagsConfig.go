@@ -28,6 +28,9 @@ type ContextFlagsConfig struct {
 	OperationMode                string
 	RepopulateTokensSupplies     bool
 	P2PPrometheusMetricsEnabled  bool
+	KeysPassphraseFd             int
+	KeysPassphraseEnvVariable    string
+	ImportStateSnapshotFile      string
 }
 
 // ImportDbConfig will hold the import-db parameters
@@ -5,6 +5,7 @@ type ExternalConfig struct {
 	ElasticSearchConnector ElasticSearchConfig
 	EventNotifierConnector EventNotifierConfig
 	HostDriversConfig      []HostDriversConfig
+	SubscriptionsConnector SubscriptionsConfig
 }
 
 // ElasticSearchConfig will hold the configuration for the elastic search
@@ -51,3 +52,11 @@ type HostDriversConfig struct {
 	AcknowledgeTimeoutInSec    int
 	Version                    uint32
 }
+
+// SubscriptionsConfig will hold the configuration for the driver that streams the node's events to the API subscribers
+type SubscriptionsConfig struct {
+	Enabled             bool
+	MaxSubscribers      uint32
+	EventsBufferSize    uint32
+	HistorySizeInBlocks uint32
+}
@@ -147,6 +147,27 @@ func TestTomlParser(t *testing.T) {
 		},
 		Redundancy: RedundancyConfig{
 			MaxRoundsOfInactivityAccepted: 3,
+			FailoverCoordination: FailoverCoordinationConfig{
+				Enabled:                     true,
+				LeaseDurationInMilliseconds: 6000,
+				RenewIntervalInMilliseconds: 1000,
+			},
+		},
+		RemoteSigner: RemoteSignerConfig{
+			Enabled:                      true,
+			Network:                      "unix",
+			Address:                      "./remoteSigner.sock",
+			AuthKeyFile:                  "./config/remoteSignerAuth.key",
+			RequestTimeoutInMilliseconds: 2000,
+		},
+		SigningProtection: SigningProtectionConfig{
+			Enabled:      true,
+			FilePath:     "SigningProtectionDB",
+			MaxOpenFiles: 10,
+		},
+		ManagedKeysAdministration: ManagedKeysAdministrationConfig{
+			WatchAllValidatorsKeysFile: true,
+			WatchIntervalInSeconds:     10,
 		},
 	}
 	testString := `
@@ -250,6 +271,27 @@ func TestTomlParser(t *testing.T) {
     # MaxRoundsOfInactivityAccepted defines the number of rounds missed by a main or higher level backup machine before
     # the current machine will take over and propose/sign blocks. Used in both single-key and multi-key modes.
     MaxRoundsOfInactivityAccepted = 3
+
+    [Redundancy.FailoverCoordination]
+        Enabled = true
+        LeaseDurationInMilliseconds = 6000
+        RenewIntervalInMilliseconds = 1000
+
+[RemoteSigner]
+    Enabled = true
+    Network = "unix"
+    Address = "./remoteSigner.sock"
+    AuthKeyFile = "./config/remoteSignerAuth.key"
+    RequestTimeoutInMilliseconds = 2000
+
+[SigningProtection]
+    Enabled = true
+    FilePath = "SigningProtectionDB"
+    MaxOpenFiles = 10
+
+[ManagedKeysAdministration]
+    WatchAllValidatorsKeysFile = true
+    WatchIntervalInSeconds = 10
 `
 	cfg := Config{}
 
@@ -433,6 +475,16 @@ func TestAPIRoutesToml(t *testing.T) {
 			LoggingEnabled:          true,
 			ThresholdInMicroSeconds: loggingThreshold,
 		},
+		Authentication: ApiAuthenticationConfig{
+			TokensFile:          "apiTokens.txt",
+			UnauthenticatedRole: "public",
+			TLS: ApiTLSConfig{
+				Enabled:         true,
+				CertificateFile: "cert.pem",
+				KeyFile:         "key.pem",
+				ClientCAFile:    "clientCA.pem",
+			},
+		},
 		APIPackages: map[string]APIPackageConfig{
 			package0: {
 				Routes: []RouteConfig{
@@ -453,6 +505,16 @@ func TestAPIRoutesToml(t *testing.T) {
     LoggingEnabled = true
     ThresholdInMicroSeconds = 10
 
+[Authentication]
+    TokensFile = "apiTokens.txt"
+    UnauthenticatedRole = "public"
+
+    [Authentication.TLS]
+        Enabled = true
+        CertificateFile = "cert.pem"
+        KeyFile = "key.pem"
+        ClientCAFile = "clientCA.pem"
+
      # API routes configuration
 [APIPackages]
 
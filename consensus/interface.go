@@ -191,5 +191,6 @@ type KeysHandler interface {
 	IsOriginalPublicKeyOfTheNode(pkBytes []byte) bool
 	ResetRoundsWithoutReceivedMessages(pkBytes []byte, pid core.PeerID)
 	GetRedundancyStepInReason() string
+	ApplyPendingChanges()
 	IsInterfaceNil() bool
 }
@@ -0,0 +1,29 @@
+package disabled
+
+type signingProtector struct {
+}
+
+// NewSigningProtector returns a new disabled signing protector instance
+func NewSigningProtector() *signingProtector {
+	return &signingProtector{}
+}
+
+// CheckAndRecordSignatureShare returns nil
+func (sp *signingProtector) CheckAndRecordSignatureShare(_ []byte, _ uint32, _ int64, _ []byte) error {
+	return nil
+}
+
+// CheckAndRecordBlockSignature returns nil
+func (sp *signingProtector) CheckAndRecordBlockSignature(_ []byte, _ uint32, _ int64, _ []byte) error {
+	return nil
+}
+
+// Close returns nil
+func (sp *signingProtector) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sp *signingProtector) IsInterfaceNil() bool {
+	return sp == nil
+}
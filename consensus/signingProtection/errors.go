@@ -0,0 +1,27 @@
+package signingProtection
+
+import "errors"
+
+// ErrNilPersister signals that a nil persister was provided
+var ErrNilPersister = errors.New("nil persister")
+
+// ErrEmptyPublicKey signals that an empty public key was provided
+var ErrEmptyPublicKey = errors.New("empty public key")
+
+// ErrEmptyHeaderHash signals that an empty header hash was provided
+var ErrEmptyHeaderHash = errors.New("empty header hash")
+
+// ErrDoubleSigningPrevented signals that a signature was refused because it conflicts with the signing history of the key
+var ErrDoubleSigningPrevented = errors.New("double signing prevented")
+
+// ErrUnsupportedFormatVersion signals that the interchange data has an unsupported format version
+var ErrUnsupportedFormatVersion = errors.New("unsupported interchange format version")
+
+// ErrChainIDMismatch signals that the interchange data was exported for a different chain
+var ErrChainIDMismatch = errors.New("chain ID mismatch")
+
+// ErrNilInterchangeData signals that nil interchange data was provided
+var ErrNilInterchangeData = errors.New("nil interchange data")
+
+// ErrInvalidSignedData signals that invalid signed data was provided
+var ErrInvalidSignedData = errors.New("invalid signed data")
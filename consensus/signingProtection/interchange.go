@@ -0,0 +1,79 @@
+package signingProtection
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// InterchangeFormatVersion is the version of the interchange format produced by this package
+const InterchangeFormatVersion = uint32(1)
+
+// SignedData holds the last data signed with a key in a consensus subround
+type SignedData struct {
+	Epoch      uint32 `json:"epoch"`
+	Round      int64  `json:"round"`
+	HeaderHash string `json:"headerHash"`
+}
+
+// KeyHistory holds the signing history of a key
+type KeyHistory struct {
+	PublicKey      string      `json:"publicKey"`
+	SignatureShare *SignedData `json:"signatureShare,omitempty"`
+	BlockSignature *SignedData `json:"blockSignature,omitempty"`
+}
+
+// InterchangeMetadata holds the metadata of the interchange data
+type InterchangeMetadata struct {
+	FormatVersion uint32 `json:"formatVersion"`
+	ChainID       string `json:"chainID"`
+}
+
+// InterchangeData is the JSON document used to move the signing history of the keys between machines
+type InterchangeData struct {
+	Metadata InterchangeMetadata `json:"metadata"`
+	Data     []*KeyHistory       `json:"data"`
+}
+
+// WriteInterchangeFile writes the provided interchange data as an indented JSON file
+func WriteInterchangeFile(filePath string, data *InterchangeData) error {
+	if data == nil {
+		return ErrNilInterchangeData
+	}
+
+	buff, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filePath, buff, 0600)
+}
+
+// ReadInterchangeFile reads the interchange data from the provided JSON file
+func ReadInterchangeFile(filePath string) (*InterchangeData, error) {
+	buff, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &InterchangeData{}
+	err = json.Unmarshal(buff, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func (sd *SignedData) headerHashBytes() ([]byte, error) {
+	headerHash, err := hex.DecodeString(sd.HeaderHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w, header hash: %s", ErrInvalidSignedData, err.Error())
+	}
+	if len(headerHash) == 0 {
+		return nil, fmt.Errorf("%w, %s", ErrInvalidSignedData, ErrEmptyHeaderHash.Error())
+	}
+
+	return headerHash, nil
+}
@@ -0,0 +1,11 @@
+package signingProtection
+
+// SigningProtector defines the operations of a component that prevents the managed keys from signing conflicting data
+type SigningProtector interface {
+	CheckAndRecordSignatureShare(publicKey []byte, epoch uint32, round int64, headerHash []byte) error
+	CheckAndRecordBlockSignature(publicKey []byte, epoch uint32, round int64, headerHash []byte) error
+	Export(chainID string) (*InterchangeData, error)
+	Import(data *InterchangeData, chainID string) error
+	Close() error
+	IsInterfaceNil() bool
+}
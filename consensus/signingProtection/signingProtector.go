@@ -0,0 +1,274 @@
+package signingProtection
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("consensus/signingProtection")
+
+const (
+	signatureShareKeyPrefix = byte('s')
+	blockSignatureKeyPrefix = byte('b')
+)
+
+// ArgsSigningProtector holds the arguments needed to create a new signing protector
+type ArgsSigningProtector struct {
+	Persister storage.Persister
+}
+
+// signingProtector keeps, for each key, the last (epoch, round, header hash) signed in the signature subround and
+// in the end round subround and refuses to sign data conflicting with this history. Each record is persisted before
+// the signature is allowed, so the persister should write synchronously. The persister should report the missing keys
+// with storage.ErrKeyNotFound, any other read error preventing the signature
+type signingProtector struct {
+	mut       sync.Mutex
+	persister storage.Persister
+}
+
+// NewSigningProtector creates a new signing protector instance
+func NewSigningProtector(args ArgsSigningProtector) (*signingProtector, error) {
+	if check.IfNil(args.Persister) {
+		return nil, ErrNilPersister
+	}
+
+	return &signingProtector{
+		persister: args.Persister,
+	}, nil
+}
+
+// CheckAndRecordSignatureShare checks that a signature share over the provided header hash does not conflict with
+// the history of the provided key and records it
+func (sp *signingProtector) CheckAndRecordSignatureShare(publicKey []byte, epoch uint32, round int64, headerHash []byte) error {
+	return sp.checkAndRecord(signatureShareKeyPrefix, publicKey, epoch, round, headerHash)
+}
+
+// CheckAndRecordBlockSignature checks that a block signature over the provided header hash does not conflict with
+// the history of the provided key and records it
+func (sp *signingProtector) CheckAndRecordBlockSignature(publicKey []byte, epoch uint32, round int64, headerHash []byte) error {
+	return sp.checkAndRecord(blockSignatureKeyPrefix, publicKey, epoch, round, headerHash)
+}
+
+func (sp *signingProtector) checkAndRecord(prefix byte, publicKey []byte, epoch uint32, round int64, headerHash []byte) error {
+	if len(publicKey) == 0 {
+		return ErrEmptyPublicKey
+	}
+	if len(headerHash) == 0 {
+		return ErrEmptyHeaderHash
+	}
+
+	sp.mut.Lock()
+	defer sp.mut.Unlock()
+
+	key := createKey(prefix, publicKey)
+	lastSigned, err := sp.getSignedData(key)
+	if err != nil {
+		return err
+	}
+
+	if lastSigned != nil {
+		lastHeaderHash, errDecode := lastSigned.headerHashBytes()
+		if errDecode != nil {
+			return errDecode
+		}
+
+		isSameData := round == lastSigned.Round && bytes.Equal(headerHash, lastHeaderHash)
+		if isSameData {
+			return nil
+		}
+		if round <= lastSigned.Round {
+			return fmt.Errorf("%w for key %s: requested round %d, header hash %s, last signed round %d, header hash %s",
+				ErrDoubleSigningPrevented, hex.EncodeToString(publicKey), round, hex.EncodeToString(headerHash),
+				lastSigned.Round, lastSigned.HeaderHash)
+		}
+	}
+
+	return sp.putSignedData(key, &SignedData{
+		Epoch:      epoch,
+		Round:      round,
+		HeaderHash: hex.EncodeToString(headerHash),
+	})
+}
+
+// Export returns the signing history of all the keys in the interchange format
+func (sp *signingProtector) Export(chainID string) (*InterchangeData, error) {
+	sp.mut.Lock()
+	defer sp.mut.Unlock()
+
+	histories := make(map[string]*KeyHistory)
+	var errFound error
+	sp.persister.RangeKeys(func(key []byte, value []byte) bool {
+		if len(key) < 2 {
+			return true
+		}
+
+		signedData := &SignedData{}
+		errFound = json.Unmarshal(value, signedData)
+		if errFound != nil {
+			return false
+		}
+
+		publicKey := hex.EncodeToString(key[1:])
+		history, found := histories[publicKey]
+		if !found {
+			history = &KeyHistory{
+				PublicKey: publicKey,
+			}
+			histories[publicKey] = history
+		}
+
+		switch key[0] {
+		case signatureShareKeyPrefix:
+			history.SignatureShare = signedData
+		case blockSignatureKeyPrefix:
+			history.BlockSignature = signedData
+		}
+
+		return true
+	})
+	if errFound != nil {
+		return nil, errFound
+	}
+
+	data := &InterchangeData{
+		Metadata: InterchangeMetadata{
+			FormatVersion: InterchangeFormatVersion,
+			ChainID:       chainID,
+		},
+		Data: make([]*KeyHistory, 0, len(histories)),
+	}
+	for _, history := range histories {
+		data.Data = append(data.Data, history)
+	}
+	sort.Slice(data.Data, func(i, j int) bool {
+		return data.Data[i].PublicKey < data.Data[j].PublicKey
+	})
+
+	return data, nil
+}
+
+// Import merges the provided signing history with the existing one. For each key, the record with the highest round
+// is kept. If the provided chain ID is not empty, it should match the chain ID of the interchange data
+func (sp *signingProtector) Import(data *InterchangeData, chainID string) error {
+	if data == nil {
+		return ErrNilInterchangeData
+	}
+	if data.Metadata.FormatVersion != InterchangeFormatVersion {
+		return fmt.Errorf("%w, provided %d, supported %d", ErrUnsupportedFormatVersion,
+			data.Metadata.FormatVersion, InterchangeFormatVersion)
+	}
+	if len(chainID) > 0 && data.Metadata.ChainID != chainID {
+		return fmt.Errorf("%w, provided %s, expected %s", ErrChainIDMismatch, data.Metadata.ChainID, chainID)
+	}
+
+	sp.mut.Lock()
+	defer sp.mut.Unlock()
+
+	for _, history := range data.Data {
+		if history == nil {
+			continue
+		}
+
+		publicKey, err := hex.DecodeString(history.PublicKey)
+		if err != nil {
+			return fmt.Errorf("%w for public key %s", err, history.PublicKey)
+		}
+		if len(publicKey) == 0 {
+			return ErrEmptyPublicKey
+		}
+
+		err = sp.merge(createKey(signatureShareKeyPrefix, publicKey), history.SignatureShare)
+		if err != nil {
+			return fmt.Errorf("%w for the signature share of the public key %s", err, history.PublicKey)
+		}
+
+		err = sp.merge(createKey(blockSignatureKeyPrefix, publicKey), history.BlockSignature)
+		if err != nil {
+			return fmt.Errorf("%w for the block signature of the public key %s", err, history.PublicKey)
+		}
+	}
+
+	log.Info("imported signing protection data", "chain ID", data.Metadata.ChainID, "num keys", len(data.Data))
+
+	return nil
+}
+
+func (sp *signingProtector) merge(key []byte, imported *SignedData) error {
+	if imported == nil {
+		return nil
+	}
+
+	_, err := imported.headerHashBytes()
+	if err != nil {
+		return err
+	}
+
+	existing, err := sp.getSignedData(key)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.Round >= imported.Round {
+		return nil
+	}
+
+	return sp.putSignedData(key, imported)
+}
+
+func (sp *signingProtector) getSignedData(key []byte) (*SignedData, error) {
+	err := sp.persister.Has(key)
+	if errors.Is(err, storage.ErrKeyNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		// the signing history can not be checked, so nothing should be signed
+		return nil, fmt.Errorf("%w while reading the signing history", err)
+	}
+
+	buff, err := sp.persister.Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading the signing history", err)
+	}
+
+	signedData := &SignedData{}
+	err = json.Unmarshal(buff, signedData)
+	if err != nil {
+		return nil, err
+	}
+
+	return signedData, nil
+}
+
+func (sp *signingProtector) putSignedData(key []byte, signedData *SignedData) error {
+	buff, err := json.Marshal(signedData)
+	if err != nil {
+		return err
+	}
+
+	return sp.persister.Put(key, buff)
+}
+
+// Close closes the underlying persister
+func (sp *signingProtector) Close() error {
+	return sp.persister.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sp *signingProtector) IsInterfaceNil() bool {
+	return sp == nil
+}
+
+func createKey(prefix byte, publicKey []byte) []byte {
+	key := make([]byte, 0, len(publicKey)+1)
+	key = append(key, prefix)
+
+	return append(key, publicKey...)
+}
@@ -0,0 +1,263 @@
+package signingProtection_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/consensus/signingProtection"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/database"
+	storageMock "github.com/multiversx/mx-chain-go/storage/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	pk1   = []byte("public key 1")
+	pk2   = []byte("public key 2")
+	hashA = []byte("header hash A")
+	hashB = []byte("header hash B")
+)
+
+func createSigningProtector(t *testing.T) signingProtection.SigningProtector {
+	persister, err := database.NewSerialDB(filepath.Join(t.TempDir(), "SigningProtectionDB"), 2, 1, 10)
+	require.Nil(t, err)
+	sp, _ := signingProtection.NewSigningProtector(signingProtection.ArgsSigningProtector{
+		Persister: persister,
+	})
+	t.Cleanup(func() {
+		_ = sp.Close()
+	})
+
+	return sp
+}
+
+func TestNewSigningProtector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil persister should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := signingProtection.NewSigningProtector(signingProtection.ArgsSigningProtector{})
+		assert.Equal(t, signingProtection.ErrNilPersister, err)
+		assert.Nil(t, sp)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := signingProtection.NewSigningProtector(signingProtection.ArgsSigningProtector{
+			Persister: database.NewMemDB(),
+		})
+		assert.Nil(t, err)
+		assert.False(t, sp.IsInterfaceNil())
+		assert.Nil(t, sp.Close())
+	})
+}
+
+func TestSigningProtector_CheckAndRecordSignatureShare(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp := createSigningProtector(t)
+		err := sp.CheckAndRecordSignatureShare(nil, 1, 10, hashA)
+		assert.Equal(t, signingProtection.ErrEmptyPublicKey, err)
+	})
+	t.Run("empty header hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp := createSigningProtector(t)
+		err := sp.CheckAndRecordSignatureShare(pk1, 1, 10, nil)
+		assert.Equal(t, signingProtection.ErrEmptyHeaderHash, err)
+	})
+	t.Run("persister put error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		persister := &storageMock.PersisterStub{
+			HasCalled: func(key []byte) error {
+				return storage.ErrKeyNotFound
+			},
+			PutCalled: func(key, val []byte) error {
+				return expectedErr
+			},
+		}
+		sp, _ := signingProtection.NewSigningProtector(signingProtection.ArgsSigningProtector{
+			Persister: persister,
+		})
+
+		err := sp.CheckAndRecordSignatureShare(pk1, 1, 10, hashA)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("persister read error should refuse to sign", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		persister := &storageMock.PersisterStub{
+			HasCalled: func(key []byte) error {
+				return expectedErr
+			},
+			PutCalled: func(key, val []byte) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		sp, _ := signingProtection.NewSigningProtector(signingProtection.ArgsSigningProtector{
+			Persister: persister,
+		})
+
+		err := sp.CheckAndRecordSignatureShare(pk1, 1, 10, hashA)
+		assert.ErrorIs(t, err, expectedErr)
+	})
+	t.Run("should refuse conflicting data", func(t *testing.T) {
+		t.Parallel()
+
+		sp := createSigningProtector(t)
+		assert.Nil(t, sp.CheckAndRecordSignatureShare(pk1, 1, 10, hashA))
+		// same data can be signed again
+		assert.Nil(t, sp.CheckAndRecordSignatureShare(pk1, 1, 10, hashA))
+
+		// different header in the same round
+		err := sp.CheckAndRecordSignatureShare(pk1, 1, 10, hashB)
+		assert.ErrorIs(t, err, signingProtection.ErrDoubleSigningPrevented)
+
+		// older round
+		err = sp.CheckAndRecordSignatureShare(pk1, 1, 9, hashB)
+		assert.ErrorIs(t, err, signingProtection.ErrDoubleSigningPrevented)
+
+		// other keys and the block signatures are not affected
+		assert.Nil(t, sp.CheckAndRecordSignatureShare(pk2, 1, 10, hashB))
+		assert.Nil(t, sp.CheckAndRecordBlockSignature(pk1, 1, 10, hashB))
+
+		// newer round
+		assert.Nil(t, sp.CheckAndRecordSignatureShare(pk1, 2, 11, hashB))
+		err = sp.CheckAndRecordSignatureShare(pk1, 1, 10, hashA)
+		assert.ErrorIs(t, err, signingProtection.ErrDoubleSigningPrevented)
+	})
+}
+
+func TestSigningProtector_CheckAndRecordBlockSignature(t *testing.T) {
+	t.Parallel()
+
+	sp := createSigningProtector(t)
+	assert.Nil(t, sp.CheckAndRecordBlockSignature(pk1, 1, 10, hashA))
+	assert.Nil(t, sp.CheckAndRecordBlockSignature(pk1, 1, 10, hashA))
+
+	err := sp.CheckAndRecordBlockSignature(pk1, 1, 10, hashB)
+	assert.ErrorIs(t, err, signingProtection.ErrDoubleSigningPrevented)
+	assert.Nil(t, sp.CheckAndRecordSignatureShare(pk1, 1, 10, hashB))
+}
+
+func TestSigningProtector_ShouldPersistTheHistory(t *testing.T) {
+	t.Parallel()
+
+	dbPath := filepath.Join(t.TempDir(), "SigningProtectionDB")
+	persister, err := database.NewSerialDB(dbPath, 2, 1, 10)
+	require.Nil(t, err)
+	sp, _ := signingProtection.NewSigningProtector(signingProtection.ArgsSigningProtector{
+		Persister: persister,
+	})
+	require.Nil(t, sp.CheckAndRecordSignatureShare(pk1, 1, 10, hashA))
+	require.Nil(t, sp.Close())
+
+	persister, err = database.NewSerialDB(dbPath, 2, 1, 10)
+	require.Nil(t, err)
+	sp, _ = signingProtection.NewSigningProtector(signingProtection.ArgsSigningProtector{
+		Persister: persister,
+	})
+	defer func() {
+		_ = sp.Close()
+	}()
+
+	err = sp.CheckAndRecordSignatureShare(pk1, 1, 10, hashB)
+	assert.ErrorIs(t, err, signingProtection.ErrDoubleSigningPrevented)
+}
+
+func TestSigningProtector_ExportImport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil data should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp := createSigningProtector(t)
+		err := sp.Import(nil, "")
+		assert.Equal(t, signingProtection.ErrNilInterchangeData, err)
+	})
+	t.Run("unsupported format version should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp := createSigningProtector(t)
+		err := sp.Import(&signingProtection.InterchangeData{
+			Metadata: signingProtection.InterchangeMetadata{
+				FormatVersion: 2,
+			},
+		}, "")
+		assert.ErrorIs(t, err, signingProtection.ErrUnsupportedFormatVersion)
+	})
+	t.Run("chain ID mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp := createSigningProtector(t)
+		err := sp.Import(&signingProtection.InterchangeData{
+			Metadata: signingProtection.InterchangeMetadata{
+				FormatVersion: signingProtection.InterchangeFormatVersion,
+				ChainID:       "D",
+			},
+		}, "1")
+		assert.ErrorIs(t, err, signingProtection.ErrChainIDMismatch)
+	})
+	t.Run("invalid header hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp := createSigningProtector(t)
+		err := sp.Import(&signingProtection.InterchangeData{
+			Metadata: signingProtection.InterchangeMetadata{
+				FormatVersion: signingProtection.InterchangeFormatVersion,
+			},
+			Data: []*signingProtection.KeyHistory{
+				{
+					PublicKey: "aabb",
+					SignatureShare: &signingProtection.SignedData{
+						Round:      10,
+						HeaderHash: "not hex",
+					},
+				},
+			},
+		}, "")
+		assert.ErrorIs(t, err, signingProtection.ErrInvalidSignedData)
+	})
+	t.Run("should move the history to another protector", func(t *testing.T) {
+		t.Parallel()
+
+		source := createSigningProtector(t)
+		require.Nil(t, source.CheckAndRecordSignatureShare(pk1, 1, 10, hashA))
+		require.Nil(t, source.CheckAndRecordBlockSignature(pk1, 1, 10, hashA))
+		require.Nil(t, source.CheckAndRecordSignatureShare(pk2, 1, 12, hashB))
+
+		exported, err := source.Export("1")
+		require.Nil(t, err)
+		require.Equal(t, "1", exported.Metadata.ChainID)
+		require.Equal(t, 2, len(exported.Data))
+
+		filePath := filepath.Join(t.TempDir(), "history.json")
+		require.Nil(t, signingProtection.WriteInterchangeFile(filePath, exported))
+		readData, err := signingProtection.ReadInterchangeFile(filePath)
+		require.Nil(t, err)
+		require.Equal(t, exported, readData)
+
+		destination := createSigningProtector(t)
+		// the destination already signed a newer round for pk2, which should be kept
+		require.Nil(t, destination.CheckAndRecordSignatureShare(pk2, 1, 20, hashA))
+		require.Nil(t, destination.Import(readData, "1"))
+
+		err = destination.CheckAndRecordSignatureShare(pk1, 1, 10, hashB)
+		assert.ErrorIs(t, err, signingProtection.ErrDoubleSigningPrevented)
+		err = destination.CheckAndRecordBlockSignature(pk1, 1, 10, hashB)
+		assert.ErrorIs(t, err, signingProtection.ErrDoubleSigningPrevented)
+		assert.Nil(t, destination.CheckAndRecordSignatureShare(pk2, 1, 20, hashA))
+		err = destination.CheckAndRecordSignatureShare(pk2, 1, 15, hashB)
+		assert.ErrorIs(t, err, signingProtection.ErrDoubleSigningPrevented)
+	})
+}
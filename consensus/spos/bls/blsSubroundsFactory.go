@@ -19,6 +19,7 @@ type factory struct {
 	appStatusHandler      core.AppStatusHandler
 	outportHandler        outport.OutportHandler
 	sentSignaturesTracker spos.SentSignaturesTracker
+	signingProtector      spos.SigningProtector
 	chainID               []byte
 	currentPid            core.PeerID
 }
@@ -32,6 +33,7 @@ func NewSubroundsFactory(
 	currentPid core.PeerID,
 	appStatusHandler core.AppStatusHandler,
 	sentSignaturesTracker spos.SentSignaturesTracker,
+	signingProtector spos.SigningProtector,
 ) (*factory, error) {
 	err := checkNewFactoryParams(
 		consensusDataContainer,
@@ -40,6 +42,7 @@ func NewSubroundsFactory(
 		chainID,
 		appStatusHandler,
 		sentSignaturesTracker,
+		signingProtector,
 	)
 	if err != nil {
 		return nil, err
@@ -53,6 +56,7 @@ func NewSubroundsFactory(
 		chainID:               chainID,
 		currentPid:            currentPid,
 		sentSignaturesTracker: sentSignaturesTracker,
+		signingProtector:      signingProtector,
 	}
 
 	return &fct, nil
@@ -65,6 +69,7 @@ func checkNewFactoryParams(
 	chainID []byte,
 	appStatusHandler core.AppStatusHandler,
 	sentSignaturesTracker spos.SentSignaturesTracker,
+	signingProtector spos.SigningProtector,
 ) error {
 	err := spos.ValidateConsensusCore(container)
 	if err != nil {
@@ -82,6 +87,9 @@ func checkNewFactoryParams(
 	if check.IfNil(sentSignaturesTracker) {
 		return ErrNilSentSignatureTracker
 	}
+	if check.IfNil(signingProtector) {
+		return ErrNilSigningProtector
+	}
 	if len(chainID) == 0 {
 		return spos.ErrInvalidChainID
 	}
@@ -231,6 +239,7 @@ func (fct *factory) generateSignatureSubround() error {
 		fct.worker.Extend,
 		fct.appStatusHandler,
 		fct.sentSignaturesTracker,
+		fct.signingProtector,
 	)
 	if err != nil {
 		return err
@@ -269,6 +278,7 @@ func (fct *factory) generateEndRoundSubround() error {
 		fct.worker.DisplayStatistics,
 		fct.appStatusHandler,
 		fct.sentSignaturesTracker,
+		fct.signingProtector,
 	)
 	if err != nil {
 		return err
@@ -4,3 +4,6 @@ import "errors"
 
 // ErrNilSentSignatureTracker defines the error for setting a nil SentSignatureTracker
 var ErrNilSentSignatureTracker = errors.New("nil sent signature tracker")
+
+// ErrNilSigningProtector defines the error for setting a nil SigningProtector
+var ErrNilSigningProtector = errors.New("nil signing protector")
@@ -25,6 +25,7 @@ type subroundEndRound struct {
 	appStatusHandler              core.AppStatusHandler
 	mutProcessingEndRound         sync.Mutex
 	sentSignatureTracker          spos.SentSignaturesTracker
+	signingProtector              spos.SigningProtector
 }
 
 // NewSubroundEndRound creates a subroundEndRound object
@@ -35,6 +36,7 @@ func NewSubroundEndRound(
 	displayStatistics func(),
 	appStatusHandler core.AppStatusHandler,
 	sentSignatureTracker spos.SentSignaturesTracker,
+	signingProtector spos.SigningProtector,
 ) (*subroundEndRound, error) {
 	err := checkNewSubroundEndRoundParams(
 		baseSubround,
@@ -51,6 +53,9 @@ func NewSubroundEndRound(
 	if check.IfNil(sentSignatureTracker) {
 		return nil, ErrNilSentSignatureTracker
 	}
+	if check.IfNil(signingProtector) {
+		return nil, ErrNilSigningProtector
+	}
 
 	srEndRound := subroundEndRound{
 		Subround:                      baseSubround,
@@ -59,6 +64,7 @@ func NewSubroundEndRound(
 		appStatusHandler:              appStatusHandler,
 		mutProcessingEndRound:         sync.Mutex{},
 		sentSignatureTracker:          sentSignatureTracker,
+		signingProtector:              signingProtector,
 	}
 	srEndRound.Job = srEndRound.doEndRoundJob
 	srEndRound.Check = srEndRound.doEndRoundConsensusCheck
@@ -803,6 +809,16 @@ func (sr *subroundEndRound) signBlockHeader() ([]byte, error) {
 		return nil, errGetLeader
 	}
 
+	err = sr.signingProtector.CheckAndRecordBlockSignature(
+		[]byte(leader),
+		sr.Header.GetEpoch(),
+		int64(sr.Header.GetRound()),
+		sr.GetData(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return sr.SigningHandler().CreateSignatureForPublicKey(marshalizedHdr, []byte(leader))
 }
 
@@ -56,6 +56,7 @@ func initSubroundEndRoundWithContainer(
 		displayStatistics,
 		appStatusHandler,
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SigningProtectorStub{},
 	)
 
 	return srEndRound
@@ -98,6 +99,7 @@ func TestNewSubroundEndRound(t *testing.T) {
 			displayStatistics,
 			&statusHandler.AppStatusHandlerStub{},
 			&testscommon.SentSignatureTrackerStub{},
+			&testscommon.SigningProtectorStub{},
 		)
 
 		assert.Nil(t, srEndRound)
@@ -113,6 +115,7 @@ func TestNewSubroundEndRound(t *testing.T) {
 			displayStatistics,
 			&statusHandler.AppStatusHandlerStub{},
 			&testscommon.SentSignatureTrackerStub{},
+			&testscommon.SigningProtectorStub{},
 		)
 
 		assert.Nil(t, srEndRound)
@@ -128,6 +131,7 @@ func TestNewSubroundEndRound(t *testing.T) {
 			displayStatistics,
 			nil,
 			&testscommon.SentSignatureTrackerStub{},
+			&testscommon.SigningProtectorStub{},
 		)
 
 		assert.Nil(t, srEndRound)
@@ -143,11 +147,28 @@ func TestNewSubroundEndRound(t *testing.T) {
 			displayStatistics,
 			&statusHandler.AppStatusHandlerStub{},
 			nil,
+			&testscommon.SigningProtectorStub{},
 		)
 
 		assert.Nil(t, srEndRound)
 		assert.Equal(t, bls.ErrNilSentSignatureTracker, err)
 	})
+	t.Run("nil signing protector should error", func(t *testing.T) {
+		t.Parallel()
+
+		srEndRound, err := bls.NewSubroundEndRound(
+			sr,
+			extend,
+			bls.ProcessingThresholdPercent,
+			displayStatistics,
+			&statusHandler.AppStatusHandlerStub{},
+			&testscommon.SentSignatureTrackerStub{},
+			nil,
+		)
+
+		assert.Nil(t, srEndRound)
+		assert.Equal(t, bls.ErrNilSigningProtector, err)
+	})
 }
 
 func TestSubroundEndRound_NewSubroundEndRoundNilBlockChainShouldFail(t *testing.T) {
@@ -180,6 +201,7 @@ func TestSubroundEndRound_NewSubroundEndRoundNilBlockChainShouldFail(t *testing.
 		displayStatistics,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SigningProtectorStub{},
 	)
 
 	assert.True(t, check.IfNil(srEndRound))
@@ -216,6 +238,7 @@ func TestSubroundEndRound_NewSubroundEndRoundNilBlockProcessorShouldFail(t *test
 		displayStatistics,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SigningProtectorStub{},
 	)
 
 	assert.True(t, check.IfNil(srEndRound))
@@ -253,6 +276,7 @@ func TestSubroundEndRound_NewSubroundEndRoundNilConsensusStateShouldFail(t *test
 		displayStatistics,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SigningProtectorStub{},
 	)
 
 	assert.True(t, check.IfNil(srEndRound))
@@ -289,6 +313,7 @@ func TestSubroundEndRound_NewSubroundEndRoundNilMultiSignerContainerShouldFail(t
 		displayStatistics,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SigningProtectorStub{},
 	)
 
 	assert.True(t, check.IfNil(srEndRound))
@@ -325,6 +350,7 @@ func TestSubroundEndRound_NewSubroundEndRoundNilRoundHandlerShouldFail(t *testin
 		displayStatistics,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SigningProtectorStub{},
 	)
 
 	assert.True(t, check.IfNil(srEndRound))
@@ -361,6 +387,7 @@ func TestSubroundEndRound_NewSubroundEndRoundNilSyncTimerShouldFail(t *testing.T
 		displayStatistics,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SigningProtectorStub{},
 	)
 
 	assert.True(t, check.IfNil(srEndRound))
@@ -397,6 +424,7 @@ func TestSubroundEndRound_NewSubroundEndRoundShouldWork(t *testing.T) {
 		displayStatistics,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SigningProtectorStub{},
 	)
 
 	assert.False(t, check.IfNil(srEndRound))
@@ -617,6 +645,63 @@ func TestSubroundEndRound_DoEndRoundJobAllOK(t *testing.T) {
 	assert.True(t, r)
 }
 
+func TestSubroundEndRound_DoEndRoundJobRefusedBySigningProtectorShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetSigningHandler(&consensusMocks.SigningHandlerStub{
+		CreateSignatureForPublicKeyCalled: func(publicKeyBytes []byte, msg []byte) ([]byte, error) {
+			assert.Fail(t, "should have not signed the block header")
+			return nil, nil
+		},
+	})
+	ch := make(chan bool, 1)
+	consensusState := initConsensusState()
+	sr, _ := spos.NewSubround(
+		bls.SrSignature,
+		bls.SrEndRound,
+		-1,
+		int64(85*roundTimeDuration/100),
+		int64(95*roundTimeDuration/100),
+		"(END_ROUND)",
+		consensusState,
+		ch,
+		executeStoredMessages,
+		container,
+		chainID,
+		currentPid,
+		&statusHandler.AppStatusHandlerStub{},
+	)
+
+	checkCalled := false
+	srEndRound, _ := bls.NewSubroundEndRound(
+		sr,
+		extend,
+		bls.ProcessingThresholdPercent,
+		displayStatistics,
+		&statusHandler.AppStatusHandlerStub{},
+		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SigningProtectorStub{
+			CheckAndRecordBlockSignatureCalled: func(publicKey []byte, epoch uint32, round int64, headerHash []byte) error {
+				checkCalled = true
+				assert.Equal(t, []byte("A"), publicKey)
+				assert.Equal(t, uint32(2), epoch)
+				assert.Equal(t, int64(37), round)
+				assert.Equal(t, []byte("X"), headerHash)
+
+				return errors.New("expected error")
+			},
+		},
+	)
+	srEndRound.SetSelfPubKey("A")
+	srEndRound.Header = &block.Header{Epoch: 2, Round: 37}
+	srEndRound.Data = []byte("X")
+
+	r := srEndRound.DoEndRoundJob()
+	assert.False(t, r)
+	assert.True(t, checkCalled)
+}
+
 func TestSubroundEndRound_CheckIfSignatureIsFilled(t *testing.T) {
 	t.Parallel()
 
@@ -1365,6 +1450,7 @@ func TestSubroundEndRound_ReceivedInvalidSignersInfo(t *testing.T) {
 			displayStatistics,
 			&statusHandler.AppStatusHandlerStub{},
 			&testscommon.SentSignatureTrackerStub{},
+			&testscommon.SigningProtectorStub{},
 		)
 
 		srEndRound.SetSelfPubKey("A")
@@ -1736,6 +1822,7 @@ func TestSubroundEndRound_getMinConsensusGroupIndexOfManagedKeys(t *testing.T) {
 		displayStatistics,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SigningProtectorStub{},
 	)
 
 	t.Run("no managed keys from consensus group", func(t *testing.T) {
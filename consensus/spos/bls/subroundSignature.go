@@ -17,6 +17,7 @@ type subroundSignature struct {
 	*spos.Subround
 	appStatusHandler     core.AppStatusHandler
 	sentSignatureTracker spos.SentSignaturesTracker
+	signingProtector     spos.SigningProtector
 }
 
 // NewSubroundSignature creates a subroundSignature object
@@ -25,6 +26,7 @@ func NewSubroundSignature(
 	extend func(subroundId int),
 	appStatusHandler core.AppStatusHandler,
 	sentSignatureTracker spos.SentSignaturesTracker,
+	signingProtector spos.SigningProtector,
 ) (*subroundSignature, error) {
 	err := checkNewSubroundSignatureParams(
 		baseSubround,
@@ -41,11 +43,15 @@ func NewSubroundSignature(
 	if check.IfNil(sentSignatureTracker) {
 		return nil, ErrNilSentSignatureTracker
 	}
+	if check.IfNil(signingProtector) {
+		return nil, ErrNilSigningProtector
+	}
 
 	srSignature := subroundSignature{
 		Subround:             baseSubround,
 		appStatusHandler:     appStatusHandler,
 		sentSignatureTracker: sentSignatureTracker,
+		signingProtector:     signingProtector,
 	}
 	srSignature.Job = srSignature.doSignatureJob
 	srSignature.Check = srSignature.doSignatureConsensusCheck
@@ -89,6 +95,12 @@ func (sr *subroundSignature) doSignatureJob(_ context.Context) bool {
 			return false
 		}
 
+		err = sr.checkAndRecordSignatureShare([]byte(sr.SelfPubKey()))
+		if err != nil {
+			log.Error("doSignatureJob.checkAndRecordSignatureShare", "error", err.Error())
+			return false
+		}
+
 		signatureShare, err := sr.SigningHandler().CreateSignatureShareForPublicKey(
 			sr.GetData(),
 			uint16(selfIndex),
@@ -367,6 +379,12 @@ func (sr *subroundSignature) doSignatureJobForManagedKeys() bool {
 			continue
 		}
 
+		err = sr.checkAndRecordSignatureShare(pkBytes)
+		if err != nil {
+			log.Error("doSignatureJobForManagedKeys.checkAndRecordSignatureShare", "error", err.Error())
+			continue
+		}
+
 		signatureShare, err := sr.SigningHandler().CreateSignatureShareForPublicKey(
 			sr.GetData(),
 			uint16(selfIndex),
@@ -402,6 +420,15 @@ func (sr *subroundSignature) doSignatureJobForManagedKeys() bool {
 	return true
 }
 
+func (sr *subroundSignature) checkAndRecordSignatureShare(pkBytes []byte) error {
+	return sr.signingProtector.CheckAndRecordSignatureShare(
+		pkBytes,
+		sr.Header.GetEpoch(),
+		int64(sr.Header.GetRound()),
+		sr.GetData(),
+	)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sr *subroundSignature) IsInterfaceNil() bool {
 	return sr == nil
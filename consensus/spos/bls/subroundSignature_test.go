@@ -42,6 +42,7 @@ func initSubroundSignatureWithContainer(container *mock.ConsensusCoreMock) bls.S
 		extend,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SigningProtectorStub{},
 	)
 
 	return srSignature
@@ -83,6 +84,7 @@ func TestNewSubroundSignature(t *testing.T) {
 			extend,
 			&statusHandler.AppStatusHandlerStub{},
 			&testscommon.SentSignatureTrackerStub{},
+			&testscommon.SigningProtectorStub{},
 		)
 
 		assert.Nil(t, srSignature)
@@ -96,6 +98,7 @@ func TestNewSubroundSignature(t *testing.T) {
 			nil,
 			&statusHandler.AppStatusHandlerStub{},
 			&testscommon.SentSignatureTrackerStub{},
+			&testscommon.SigningProtectorStub{},
 		)
 
 		assert.Nil(t, srSignature)
@@ -109,6 +112,7 @@ func TestNewSubroundSignature(t *testing.T) {
 			extend,
 			nil,
 			&testscommon.SentSignatureTrackerStub{},
+			&testscommon.SigningProtectorStub{},
 		)
 
 		assert.Nil(t, srSignature)
@@ -122,11 +126,26 @@ func TestNewSubroundSignature(t *testing.T) {
 			extend,
 			&statusHandler.AppStatusHandlerStub{},
 			nil,
+			&testscommon.SigningProtectorStub{},
 		)
 
 		assert.Nil(t, srSignature)
 		assert.Equal(t, bls.ErrNilSentSignatureTracker, err)
 	})
+	t.Run("nil signing protector should error", func(t *testing.T) {
+		t.Parallel()
+
+		srSignature, err := bls.NewSubroundSignature(
+			sr,
+			extend,
+			&statusHandler.AppStatusHandlerStub{},
+			&testscommon.SentSignatureTrackerStub{},
+			nil,
+		)
+
+		assert.Nil(t, srSignature)
+		assert.Equal(t, bls.ErrNilSigningProtector, err)
+	})
 }
 
 func TestSubroundSignature_NewSubroundSignatureNilConsensusStateShouldFail(t *testing.T) {
@@ -158,6 +177,7 @@ func TestSubroundSignature_NewSubroundSignatureNilConsensusStateShouldFail(t *te
 		extend,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SigningProtectorStub{},
 	)
 
 	assert.True(t, check.IfNil(srSignature))
@@ -192,6 +212,7 @@ func TestSubroundSignature_NewSubroundSignatureNilHasherShouldFail(t *testing.T)
 		extend,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SigningProtectorStub{},
 	)
 
 	assert.True(t, check.IfNil(srSignature))
@@ -226,6 +247,7 @@ func TestSubroundSignature_NewSubroundSignatureNilMultiSignerContainerShouldFail
 		extend,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SigningProtectorStub{},
 	)
 
 	assert.True(t, check.IfNil(srSignature))
@@ -261,6 +283,7 @@ func TestSubroundSignature_NewSubroundSignatureNilRoundHandlerShouldFail(t *test
 		extend,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SigningProtectorStub{},
 	)
 
 	assert.True(t, check.IfNil(srSignature))
@@ -295,6 +318,7 @@ func TestSubroundSignature_NewSubroundSignatureNilSyncTimerShouldFail(t *testing
 		extend,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SigningProtectorStub{},
 	)
 
 	assert.True(t, check.IfNil(srSignature))
@@ -329,6 +353,7 @@ func TestSubroundSignature_NewSubroundSignatureShouldWork(t *testing.T) {
 		extend,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SigningProtectorStub{},
 	)
 
 	assert.False(t, check.IfNil(srSignature))
@@ -416,6 +441,7 @@ func TestSubroundSignature_DoSignatureJobWithMultikey(t *testing.T) {
 				signatureSentForPks[string(pkBytes)] = struct{}{}
 			},
 		},
+		&testscommon.SigningProtectorStub{},
 	)
 
 	srSignature.Header = &block.Header{}
@@ -466,6 +492,129 @@ func TestSubroundSignature_DoSignatureJobWithMultikey(t *testing.T) {
 	assert.Equal(t, expectedMap, signatureSentForPks)
 }
 
+func TestSubroundSignature_DoSignatureJobRefusedBySigningProtector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("refused self key should not sign", func(t *testing.T) {
+		t.Parallel()
+
+		container := mock.InitConsensusCore()
+		consensusState := initConsensusState()
+		ch := make(chan bool, 1)
+
+		sr, _ := spos.NewSubround(
+			bls.SrBlock,
+			bls.SrSignature,
+			bls.SrEndRound,
+			int64(70*roundTimeDuration/100),
+			int64(85*roundTimeDuration/100),
+			"(SIGNATURE)",
+			consensusState,
+			ch,
+			executeStoredMessages,
+			container,
+			chainID,
+			currentPid,
+			&statusHandler.AppStatusHandlerStub{},
+		)
+
+		expectedErr := errors.New("expected error")
+		srSignature, _ := bls.NewSubroundSignature(
+			sr,
+			extend,
+			&statusHandler.AppStatusHandlerStub{},
+			&testscommon.SentSignatureTrackerStub{},
+			&testscommon.SigningProtectorStub{
+				CheckAndRecordSignatureShareCalled: func(publicKey []byte, epoch uint32, round int64, headerHash []byte) error {
+					assert.Equal(t, []byte(sr.SelfPubKey()), publicKey)
+					assert.Equal(t, uint32(2), epoch)
+					assert.Equal(t, int64(37), round)
+					assert.Equal(t, []byte("X"), headerHash)
+
+					return expectedErr
+				},
+			},
+		)
+		container.SetSigningHandler(&consensusMocks.SigningHandlerStub{
+			CreateSignatureShareForPublicKeyCalled: func(msg []byte, index uint16, epoch uint32, publicKeyBytes []byte) ([]byte, error) {
+				assert.Fail(t, "should have not created the signature share")
+				return nil, nil
+			},
+		})
+
+		srSignature.Header = &block.Header{Epoch: 2, Round: 37}
+		srSignature.Data = []byte("X")
+		r := srSignature.DoSignatureJob()
+		assert.False(t, r)
+	})
+	t.Run("refused managed key should not sign with that key", func(t *testing.T) {
+		t.Parallel()
+
+		container := mock.InitConsensusCore()
+		consensusState := initConsensusStateWithKeysHandler(
+			&testscommon.KeysHandlerStub{
+				IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+					return true
+				},
+			},
+		)
+		ch := make(chan bool, 1)
+
+		sr, _ := spos.NewSubround(
+			bls.SrBlock,
+			bls.SrSignature,
+			bls.SrEndRound,
+			int64(70*roundTimeDuration/100),
+			int64(85*roundTimeDuration/100),
+			"(SIGNATURE)",
+			consensusState,
+			ch,
+			executeStoredMessages,
+			container,
+			chainID,
+			currentPid,
+			&statusHandler.AppStatusHandlerStub{},
+		)
+
+		signatureSentForPks := make(map[string]struct{})
+		srSignature, _ := bls.NewSubroundSignature(
+			sr,
+			extend,
+			&statusHandler.AppStatusHandlerStub{},
+			&testscommon.SentSignatureTrackerStub{
+				SignatureSentCalled: func(pkBytes []byte) {
+					signatureSentForPks[string(pkBytes)] = struct{}{}
+				},
+			},
+			&testscommon.SigningProtectorStub{
+				CheckAndRecordSignatureShareCalled: func(publicKey []byte, epoch uint32, round int64, headerHash []byte) error {
+					if string(publicKey) == "C" {
+						return errors.New("expected error")
+					}
+
+					return nil
+				},
+			},
+		)
+		container.SetSigningHandler(&consensusMocks.SigningHandlerStub{
+			CreateSignatureShareForPublicKeyCalled: func(msg []byte, index uint16, epoch uint32, publicKeyBytes []byte) ([]byte, error) {
+				assert.NotEqual(t, []byte("C"), publicKeyBytes)
+				return []byte("SIG"), nil
+			},
+		})
+
+		srSignature.Header = &block.Header{}
+		srSignature.Data = []byte("X")
+		r := srSignature.DoSignatureJob()
+		assert.True(t, r)
+
+		_, found := signatureSentForPks["C"]
+		assert.False(t, found)
+		_, found = signatureSentForPks["D"]
+		assert.True(t, found)
+	})
+}
+
 func TestSubroundSignature_ReceivedSignature(t *testing.T) {
 	t.Parallel()
 
@@ -104,6 +104,8 @@ func (sr *subroundStartRound) SetOutportHandler(outportHandler outport.OutportHa
 
 // doStartRoundJob method does the job of the subround StartRound
 func (sr *subroundStartRound) doStartRoundJob(_ context.Context) bool {
+	// managed keys changes are applied only here, so that the set of keys remains the same during the whole round
+	sr.ApplyPendingKeysChanges()
 	sr.ResetConsensusState()
 	sr.RoundIndex = sr.RoundHandler().Index()
 	sr.RoundTimeStamp = sr.RoundHandler().TimeStamp()
@@ -385,6 +385,11 @@ func (cns *ConsensusState) GetMultikeyRedundancyStepInReason() string {
 	return cns.keysHandler.GetRedundancyStepInReason()
 }
 
+// ApplyPendingKeysChanges applies the managed keys changes scheduled since the previous round
+func (cns *ConsensusState) ApplyPendingKeysChanges() {
+	cns.keysHandler.ApplyPendingChanges()
+}
+
 // ResetRoundsWithoutReceivedMessages will reset the rounds received without a message for a specified public key by
 // providing also the peer ID from the received message
 func (cns *ConsensusState) ResetRoundsWithoutReceivedMessages(pkBytes []byte, pid core.PeerID) {
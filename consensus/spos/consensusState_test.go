@@ -617,3 +617,18 @@ func TestConsensusState_ResetRoundsWithoutReceivedMessages(t *testing.T) {
 	cns.ResetRoundsWithoutReceivedMessages(testPkBytes, testPid)
 	assert.True(t, resetRoundsWithoutReceivedMessagesCalled)
 }
+
+func TestConsensusState_ApplyPendingKeysChanges(t *testing.T) {
+	t.Parallel()
+
+	applyPendingChangesCalled := false
+	keysHandler := &testscommon.KeysHandlerStub{
+		ApplyPendingChangesCalled: func() {
+			applyPendingChangesCalled = true
+		},
+	}
+	cns := internalInitConsensusStateWithKeysHandler(keysHandler)
+
+	cns.ApplyPendingKeysChanges()
+	assert.True(t, applyPendingChangesCalled)
+}
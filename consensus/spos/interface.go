@@ -177,3 +177,10 @@ type SentSignaturesTracker interface {
 	SignatureSent(pkBytes []byte)
 	IsInterfaceNil() bool
 }
+
+// SigningProtector defines a component able to prevent the double signing with the keys handled by the node
+type SigningProtector interface {
+	CheckAndRecordSignatureShare(publicKey []byte, epoch uint32, round int64, headerHash []byte) error
+	CheckAndRecordBlockSignature(publicKey []byte, epoch uint32, round int64, headerHash []byte) error
+	IsInterfaceNil() bool
+}
@@ -24,6 +24,7 @@ func GetSubroundsFactory(
 	appStatusHandler core.AppStatusHandler,
 	outportHandler outport.OutportHandler,
 	sentSignatureTracker spos.SentSignaturesTracker,
+	signingProtector spos.SigningProtector,
 	chainID []byte,
 	currentPid core.PeerID,
 ) (spos.SubroundsFactory, error) {
@@ -37,6 +38,7 @@ func GetSubroundsFactory(
 			currentPid,
 			appStatusHandler,
 			sentSignatureTracker,
+			signingProtector,
 		)
 		if err != nil {
 			return nil, err
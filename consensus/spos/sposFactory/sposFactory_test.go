@@ -53,6 +53,7 @@ func TestGetSubroundsFactory_BlsNilConsensusCoreShouldErr(t *testing.T) {
 		statusHandler,
 		indexer,
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SigningProtectorStub{},
 		chainID,
 		currentPid,
 	)
@@ -77,6 +78,7 @@ func TestGetSubroundsFactory_BlsNilStatusHandlerShouldErr(t *testing.T) {
 		nil,
 		indexer,
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SigningProtectorStub{},
 		chainID,
 		currentPid,
 	)
@@ -102,6 +104,7 @@ func TestGetSubroundsFactory_BlsShouldWork(t *testing.T) {
 		statusHandler,
 		indexer,
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SigningProtectorStub{},
 		chainID,
 		currentPid,
 	)
@@ -122,6 +125,7 @@ func TestGetSubroundsFactory_InvalidConsensusTypeShouldErr(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		currentPid,
 	)
 
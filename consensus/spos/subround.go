@@ -209,16 +209,16 @@ func (sr *Subround) GetAssociatedPid(pkBytes []byte) core.PeerID {
 	return sr.keysHandler.GetAssociatedPid(pkBytes)
 }
 
-// ShouldConsiderSelfKeyInConsensus returns true if current machine is the main one, or it is a backup machine but the main
-// machine failed
+// ShouldConsiderSelfKeyInConsensus returns true if current machine is the main one (and it did not wait for a backup
+// machine to hand back), or it is a backup machine but the main machine failed
 func (sr *Subround) ShouldConsiderSelfKeyInConsensus() bool {
 	isMainMachine := !sr.NodeRedundancyHandler().IsRedundancyNode()
+	isMainMachineActive := sr.NodeRedundancyHandler().IsMainMachineActive()
 	if isMainMachine {
-		return true
+		return isMainMachineActive
 	}
-	isMainMachineInactive := !sr.NodeRedundancyHandler().IsMainMachineActive()
 
-	return isMainMachineInactive
+	return !isMainMachineActive
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
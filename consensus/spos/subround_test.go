@@ -971,3 +971,49 @@ func TestSubround_GetAssociatedPid(t *testing.T) {
 	assert.Equal(t, pid, subround.GetAssociatedPid(providedPkBytes))
 	assert.True(t, wasCalled)
 }
+
+func TestSubround_ShouldConsiderSelfKeyInConsensus(t *testing.T) {
+	t.Parallel()
+
+	testData := []struct {
+		isRedundancyNode    bool
+		isMainMachineActive bool
+		expectedResult      bool
+	}{
+		{isRedundancyNode: false, isMainMachineActive: true, expectedResult: true},
+		{isRedundancyNode: false, isMainMachineActive: false, expectedResult: false},
+		{isRedundancyNode: true, isMainMachineActive: true, expectedResult: false},
+		{isRedundancyNode: true, isMainMachineActive: false, expectedResult: true},
+	}
+
+	for _, td := range testData {
+		container := mock.InitConsensusCore()
+		container.SetNodeRedundancyHandler(&mock.NodeRedundancyHandlerStub{
+			IsRedundancyNodeCalled: func() bool {
+				return td.isRedundancyNode
+			},
+			IsMainMachineActiveCalled: func() bool {
+				return td.isMainMachineActive
+			},
+		})
+
+		subround, _ := spos.NewSubround(
+			bls.SrStartRound,
+			bls.SrBlock,
+			bls.SrSignature,
+			int64(5*roundTimeDuration/100),
+			int64(25*roundTimeDuration/100),
+			"(BLOCK)",
+			initConsensusState(),
+			make(chan bool, 1),
+			executeStoredMessages,
+			container,
+			chainID,
+			currentPid,
+			&statusHandler.AppStatusHandlerStub{},
+		)
+
+		assert.Equal(t, td.expectedResult, subround.ShouldConsiderSelfKeyInConsensus(),
+			"is redundancy node %v, is main machine active %v", td.isRedundancyNode, td.isMainMachineActive)
+	}
+}
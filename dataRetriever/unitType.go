@@ -49,6 +49,10 @@ const (
 	PeerAccountsUnit UnitType = 21
 	// ScheduledSCRsUnit is the scheduled SCRs storage unit identifier
 	ScheduledSCRsUnit UnitType = 22
+	// LogsIndexUnit is the logs index storage unit identifier
+	LogsIndexUnit UnitType = 23
+	// AccountTransactionsUnit is the account transactions index storage unit identifier
+	AccountTransactionsUnit UnitType = 24
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -110,6 +114,10 @@ func (ut UnitType) String() string {
 		return "PeerAccountsUnit"
 	case ScheduledSCRsUnit:
 		return "ScheduledSCRsUnit"
+	case LogsIndexUnit:
+		return "LogsIndexUnit"
+	case AccountTransactionsUnit:
+		return "AccountTransactionsUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
@@ -0,0 +1,501 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=. accountTxsIndex.proto
+
+package dblookupext
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+const (
+	accountTxsIndexHeadPrefix       = 'h'
+	accountTxsIndexBlockPrefix      = 'b'
+	accountTxsIndexEpochHeadPrefix  = 'e'
+	accountTxsIndexNonceRangePrefix = 'n'
+
+	// defaultMaxScannedRecords bounds the number of index records read while serving a page
+	defaultMaxScannedRecords = 1000
+	// defaultNonceRangeSize is the number of consecutive nonces sharing a nonce range head
+	defaultNonceRangeSize = 1000
+)
+
+// AccountTransaction holds a transaction sent or received by an account, as recorded by the account transactions index
+type AccountTransaction struct {
+	TxHash     []byte
+	Type       transaction.TxType
+	BlockNonce uint64
+	BlockHash  []byte
+	Epoch      uint32
+}
+
+// AccountTransactionsPage holds a page of transactions of an account, ordered from the newest to the oldest block.
+// If HasMore is set, the next page can be fetched using NextBeforeNonce and NextBeforeEpoch as cursor. A page can be
+// empty while HasMore is set, if the records scanned for it did not hold any transaction matching the cursor
+type AccountTransactionsPage struct {
+	Transactions    []*AccountTransaction
+	HasMore         bool
+	NextBeforeNonce uint64
+	NextBeforeEpoch uint32
+}
+
+// accountTxsIndex keeps, for each account, the transactions (regular, invalid, rewards and smart contract results)
+// sent or received by that account. For each block, the transactions of an account are saved in a record linked to the
+// previous block holding transactions of the same account, while the head record points to the latest such block.
+// Records of blocks that are not on the canonical chain (anymore) are skipped when reading.
+// The latest block holding transactions of an account is also recorded for each epoch and for each range of nonces, so
+// a cursor can be positioned without walking the list from its head.
+type accountTxsIndex struct {
+	storer                   storage.Storer
+	blockHashByNonce         storage.Storer
+	marshalizer              marshal.Marshalizer
+	uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	maxScannedRecords        int
+	nonceRangeSize           uint64
+}
+
+func newAccountTxsIndex(
+	storer storage.Storer,
+	blockHashByNonce storage.Storer,
+	marshalizer marshal.Marshalizer,
+	uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter,
+) *accountTxsIndex {
+	return &accountTxsIndex{
+		storer:                   storer,
+		blockHashByNonce:         blockHashByNonce,
+		marshalizer:              marshalizer,
+		uint64ByteSliceConverter: uint64ByteSliceConverter,
+		maxScannedRecords:        defaultMaxScannedRecords,
+		nonceRangeSize:           defaultNonceRangeSize,
+	}
+}
+
+type accountTxsOfBlock struct {
+	addresses     [][]byte
+	txsByAddress  map[string][]*AccountTxsIndexEntry
+	seenByAddress map[string]map[string]struct{}
+	txsFromPool   map[string]data.TransactionHandler
+	scrsFromPool  map[string]data.TransactionHandler
+}
+
+func (a *accountTxsOfBlock) add(address []byte, txHash []byte, txType transaction.TxType) {
+	if len(address) == 0 {
+		return
+	}
+
+	seen, ok := a.seenByAddress[string(address)]
+	if !ok {
+		seen = make(map[string]struct{})
+		a.seenByAddress[string(address)] = seen
+		a.addresses = append(a.addresses, address)
+	}
+	_, found := seen[string(txHash)]
+	if found {
+		return
+	}
+
+	seen[string(txHash)] = struct{}{}
+	a.txsByAddress[string(address)] = append(a.txsByAddress[string(address)], &AccountTxsIndexEntry{
+		TxHash: txHash,
+		Type:   string(txType),
+	})
+}
+
+func (ati *accountTxsIndex) saveBlock(
+	headerHash []byte,
+	header data.HeaderHandler,
+	miniblocks []*block.MiniBlock,
+	txsFromPool map[string]data.TransactionHandler,
+	scrsFromPool map[string]data.TransactionHandler,
+) error {
+	accountTxs := &accountTxsOfBlock{
+		addresses:     make([][]byte, 0),
+		txsByAddress:  make(map[string][]*AccountTxsIndexEntry),
+		seenByAddress: make(map[string]map[string]struct{}),
+		txsFromPool:   txsFromPool,
+		scrsFromPool:  scrsFromPool,
+	}
+
+	for _, miniblock := range miniblocks {
+		txType, pool, ok := accountTxs.getTypeAndPool(miniblock)
+		if !ok {
+			continue
+		}
+
+		for _, txHash := range miniblock.TxHashes {
+			tx, found := pool[string(txHash)]
+			if !found || check.IfNil(tx) {
+				continue
+			}
+
+			accountTxs.add(tx.GetSndAddr(), txHash, txType)
+			accountTxs.add(tx.GetRcvAddr(), txHash, txType)
+		}
+	}
+
+	for _, address := range accountTxs.addresses {
+		err := ati.saveAccountBlock(address, headerHash, header, accountTxs.txsByAddress[string(address)])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *accountTxsOfBlock) getTypeAndPool(miniblock *block.MiniBlock) (transaction.TxType, map[string]data.TransactionHandler, bool) {
+	if miniblock == nil {
+		return "", nil, false
+	}
+
+	switch miniblock.Type {
+	case block.TxBlock:
+		return transaction.TxTypeNormal, a.txsFromPool, true
+	case block.InvalidBlock:
+		return transaction.TxTypeInvalid, a.txsFromPool, true
+	case block.RewardsBlock:
+		return transaction.TxTypeReward, a.txsFromPool, true
+	case block.SmartContractResultBlock:
+		return transaction.TxTypeUnsigned, a.scrsFromPool, true
+	default:
+		return "", nil, false
+	}
+}
+
+func (ati *accountTxsIndex) saveAccountBlock(address []byte, headerHash []byte, header data.HeaderHandler, txs []*AccountTxsIndexEntry) error {
+	err := ati.linkAccountBlock(address, headerHash, header, txs)
+	if err != nil {
+		return err
+	}
+
+	err = ati.updateHead(createAccountTxsIndexEpochHeadKey(address, header.GetEpoch()), header.GetNonce())
+	if err != nil {
+		return err
+	}
+
+	return ati.updateHead(createAccountTxsIndexNonceRangeKey(address, header.GetNonce()/ati.nonceRangeSize), header.GetNonce())
+}
+
+func (ati *accountTxsIndex) linkAccountBlock(address []byte, headerHash []byte, header data.HeaderHandler, txs []*AccountTxsIndexEntry) error {
+	nonce := header.GetNonce()
+	record := &AccountTxsIndexBlock{
+		HeaderHash:   headerHash,
+		Nonce:        nonce,
+		Epoch:        header.GetEpoch(),
+		Transactions: txs,
+	}
+
+	head, hasHead := ati.getHead(address)
+	if !hasHead || head.Nonce < nonce {
+		record.HasPrevious = hasHead
+		record.PreviousNonce = head.Nonce
+		err := ati.put(createAccountTxsIndexBlockKey(address, nonce), record)
+		if err != nil {
+			return err
+		}
+
+		return ati.put(createAccountTxsIndexHeadKey(address), &AccountTxsIndexHead{Nonce: nonce})
+	}
+
+	// the block is not on top of the list (e.g. it is recorded again after a fork), so it is linked in place
+	current := head.Nonce
+	for {
+		existing, found := ati.getBlock(address, current)
+		if !found {
+			return ati.put(createAccountTxsIndexBlockKey(address, nonce), record)
+		}
+
+		if existing.Nonce == nonce {
+			record.HasPrevious = existing.HasPrevious
+			record.PreviousNonce = existing.PreviousNonce
+			return ati.put(createAccountTxsIndexBlockKey(address, nonce), record)
+		}
+
+		if !existing.HasPrevious || existing.PreviousNonce < nonce {
+			record.HasPrevious = existing.HasPrevious
+			record.PreviousNonce = existing.PreviousNonce
+			err := ati.put(createAccountTxsIndexBlockKey(address, nonce), record)
+			if err != nil {
+				return err
+			}
+
+			existing.HasPrevious = true
+			existing.PreviousNonce = nonce
+			return ati.put(createAccountTxsIndexBlockKey(address, existing.Nonce), existing)
+		}
+
+		current = existing.PreviousNonce
+	}
+}
+
+// updateHead records the provided nonce in the head stored at the provided key, if it is newer than the recorded one
+func (ati *accountTxsIndex) updateHead(key []byte, nonce uint64) error {
+	head := &AccountTxsIndexHead{}
+	found := ati.get(key, head)
+	if found && head.Nonce >= nonce {
+		return nil
+	}
+
+	return ati.put(key, &AccountTxsIndexHead{Nonce: nonce})
+}
+
+// getTransactions returns the transactions of the account, starting with the newest block lower than beforeNonce and
+// from an epoch lower than beforeEpoch, a 0 value disabling the corresponding condition. Blocks are never split across
+// pages, so a page can hold more than maxTransactions transactions. At most maxScannedRecords records are read, the
+// returned cursor allowing to continue from where the scan stopped.
+func (ati *accountTxsIndex) getTransactions(address []byte, beforeNonce uint64, beforeEpoch uint32, maxTransactions int) *AccountTransactionsPage {
+	page := &AccountTransactionsPage{
+		Transactions:    make([]*AccountTransaction, 0),
+		NextBeforeNonce: beforeNonce,
+		NextBeforeEpoch: beforeEpoch,
+	}
+
+	scan := &accountTxsScan{}
+	current, hasCurrent := ati.findStartNonce(address, beforeNonce, beforeEpoch, page, scan)
+	for hasCurrent {
+		if len(page.Transactions) >= maxTransactions {
+			page.HasMore = true
+			return page
+		}
+
+		record, found := ati.getBlock(address, current)
+		scan.numScanned++
+		if !found {
+			break
+		}
+
+		// the records are linked from the newest to the oldest block, so the epochs never increase along the walk
+		isBeforeEpoch := beforeEpoch == 0 || record.Epoch < beforeEpoch
+		if isBeforeEpoch && ati.isCanonical(record) {
+			for _, entry := range record.Transactions {
+				page.Transactions = append(page.Transactions, &AccountTransaction{
+					TxHash:     entry.TxHash,
+					Type:       transaction.TxType(entry.Type),
+					BlockNonce: record.Nonce,
+					BlockHash:  record.HeaderHash,
+					Epoch:      record.Epoch,
+				})
+			}
+		}
+
+		page.NextBeforeNonce = record.Nonce
+		page.NextBeforeEpoch = record.Epoch + 1
+		if !isBeforeEpoch {
+			page.NextBeforeEpoch = beforeEpoch
+		}
+		current, hasCurrent = record.PreviousNonce, record.HasPrevious
+
+		// the check follows the read, so each page moves the cursor past at least one record
+		if hasCurrent && scan.numScanned >= ati.maxScannedRecords {
+			page.HasMore = true
+			return page
+		}
+	}
+
+	return page
+}
+
+// accountTxsScan counts the records read while serving a page
+type accountTxsScan struct {
+	numScanned int
+}
+
+// findStartNonce returns the nonce of the newest block lower than beforeNonce and from an epoch lower than beforeEpoch.
+// If the scan limit is reached before finding it, the page's cursor is moved past the checked nonces or epochs and the
+// page is marked as having more transactions
+func (ati *accountTxsIndex) findStartNonce(
+	address []byte,
+	beforeNonce uint64,
+	beforeEpoch uint32,
+	page *AccountTransactionsPage,
+	scan *accountTxsScan,
+) (uint64, bool) {
+	head, hasHead := ati.getHead(address)
+	scan.numScanned++
+	if !hasHead {
+		return 0, false
+	}
+
+	start, hasStart := head.Nonce, true
+	if beforeNonce > 0 {
+		start, hasStart = ati.seekBeforeNonce(address, beforeNonce, page, scan)
+	}
+	if !hasStart || beforeEpoch == 0 {
+		return start, hasStart
+	}
+
+	record, found := ati.getBlock(address, start)
+	scan.numScanned++
+	if found && record.Epoch < beforeEpoch {
+		return start, true
+	}
+
+	epochStart, hasEpochStart := ati.seekBeforeEpoch(address, beforeEpoch, page, scan)
+	if !hasEpochStart {
+		return 0, false
+	}
+
+	if epochStart < start {
+		return epochStart, true
+	}
+
+	return start, true
+}
+
+// seekBeforeNonce returns the nonce of the newest block lower than beforeNonce, found through the nonce range heads
+func (ati *accountTxsIndex) seekBeforeNonce(address []byte, beforeNonce uint64, page *AccountTransactionsPage, scan *accountTxsScan) (uint64, bool) {
+	// fast path: the provided nonce is a cursor returned by a previous page
+	record, found := ati.getBlock(address, beforeNonce)
+	scan.numScanned++
+	if found {
+		return record.PreviousNonce, record.HasPrevious
+	}
+
+	rangeIndex := (beforeNonce - 1) / ati.nonceRangeSize
+	for {
+		if scan.numScanned >= ati.maxScannedRecords {
+			page.HasMore = true
+			page.NextBeforeNonce = (rangeIndex + 1) * ati.nonceRangeSize
+			return 0, false
+		}
+
+		rangeHead := &AccountTxsIndexHead{}
+		found = ati.get(createAccountTxsIndexNonceRangeKey(address, rangeIndex), rangeHead)
+		scan.numScanned++
+		if found {
+			return ati.findPreviousNonceInRange(address, rangeHead.Nonce, beforeNonce, scan)
+		}
+		if rangeIndex == 0 {
+			return 0, false
+		}
+
+		rangeIndex--
+	}
+}
+
+// findPreviousNonceInRange walks the list from the provided nonce, the latest one of its nonce range, until reaching a
+// nonce lower than beforeNonce. As beforeNonce is within the same range, the walk is bounded by the range size
+func (ati *accountTxsIndex) findPreviousNonceInRange(address []byte, current uint64, beforeNonce uint64, scan *accountTxsScan) (uint64, bool) {
+	for current >= beforeNonce {
+		record, found := ati.getBlock(address, current)
+		scan.numScanned++
+		if !found || !record.HasPrevious {
+			return 0, false
+		}
+
+		current = record.PreviousNonce
+	}
+
+	return current, true
+}
+
+// seekBeforeEpoch returns the nonce of the newest block from an epoch lower than beforeEpoch, found through the epoch heads
+func (ati *accountTxsIndex) seekBeforeEpoch(address []byte, beforeEpoch uint32, page *AccountTransactionsPage, scan *accountTxsScan) (uint64, bool) {
+	epoch := beforeEpoch - 1
+	for {
+		if scan.numScanned >= ati.maxScannedRecords {
+			page.HasMore = true
+			page.NextBeforeEpoch = epoch + 1
+			return 0, false
+		}
+
+		epochHead := &AccountTxsIndexHead{}
+		found := ati.get(createAccountTxsIndexEpochHeadKey(address, epoch), epochHead)
+		scan.numScanned++
+		if found {
+			return epochHead.Nonce, true
+		}
+		if epoch == 0 {
+			return 0, false
+		}
+
+		epoch--
+	}
+}
+
+// isCanonical returns false if the block was replaced on the canonical chain. The genesis block is always canonical, while
+// blocks whose canonical hash is not known cannot be invalidated
+func (ati *accountTxsIndex) isCanonical(record *AccountTxsIndexBlock) bool {
+	if record.Nonce == 0 {
+		return true
+	}
+
+	canonicalHash, err := ati.blockHashByNonce.Get(ati.uint64ByteSliceConverter.ToByteSlice(record.Nonce))
+	if err != nil {
+		return true
+	}
+
+	return bytes.Equal(canonicalHash, record.HeaderHash)
+}
+
+func (ati *accountTxsIndex) getHead(address []byte) (*AccountTxsIndexHead, bool) {
+	head := &AccountTxsIndexHead{}
+	found := ati.get(createAccountTxsIndexHeadKey(address), head)
+
+	return head, found
+}
+
+func (ati *accountTxsIndex) getBlock(address []byte, nonce uint64) (*AccountTxsIndexBlock, bool) {
+	record := &AccountTxsIndexBlock{}
+	found := ati.get(createAccountTxsIndexBlockKey(address, nonce), record)
+
+	return record, found
+}
+
+func (ati *accountTxsIndex) get(key []byte, obj interface{}) bool {
+	buff, err := ati.storer.Get(key)
+	if err != nil {
+		return false
+	}
+
+	err = ati.marshalizer.Unmarshal(obj, buff)
+	if err != nil {
+		log.Debug("accountTxsIndex.get: cannot unmarshal record", "key", key, "error", err)
+		return false
+	}
+
+	return true
+}
+
+func (ati *accountTxsIndex) put(key []byte, obj interface{}) error {
+	buff, err := ati.marshalizer.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	return ati.storer.Put(key, buff)
+}
+
+func createAccountTxsIndexBlockKey(address []byte, nonce uint64) []byte {
+	key := make([]byte, 0, len(address)+9)
+	key = append(key, accountTxsIndexBlockPrefix)
+	key = append(key, address...)
+	return binary.BigEndian.AppendUint64(key, nonce)
+}
+
+func createAccountTxsIndexEpochHeadKey(address []byte, epoch uint32) []byte {
+	key := make([]byte, 0, len(address)+5)
+	key = append(key, accountTxsIndexEpochHeadPrefix)
+	key = append(key, address...)
+	return binary.BigEndian.AppendUint32(key, epoch)
+}
+
+func createAccountTxsIndexNonceRangeKey(address []byte, rangeIndex uint64) []byte {
+	key := make([]byte, 0, len(address)+9)
+	key = append(key, accountTxsIndexNonceRangePrefix)
+	key = append(key, address...)
+	return binary.BigEndian.AppendUint64(key, rangeIndex)
+}
+
+func createAccountTxsIndexHeadKey(address []byte) []byte {
+	key := make([]byte, 0, len(address)+1)
+	key = append(key, accountTxsIndexHeadPrefix)
+	return append(key, address...)
+}
@@ -0,0 +1,1106 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: accountTxsIndex.proto
+
+package dblookupext
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AccountTxsIndexHead is used to store the nonce of the last block holding transactions of an account, overall, within
+// an epoch or within a range of nonces
+type AccountTxsIndexHead struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+}
+
+func (m *AccountTxsIndexHead) Reset()      { *m = AccountTxsIndexHead{} }
+func (*AccountTxsIndexHead) ProtoMessage() {}
+func (*AccountTxsIndexHead) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b7e6568769bf14f, []int{0}
+}
+func (m *AccountTxsIndexHead) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountTxsIndexHead) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountTxsIndexHead) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountTxsIndexHead.Merge(m, src)
+}
+func (m *AccountTxsIndexHead) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountTxsIndexHead) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountTxsIndexHead.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountTxsIndexHead proto.InternalMessageInfo
+
+func (m *AccountTxsIndexHead) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// AccountTxsIndexEntry is used to store a transaction of an account
+type AccountTxsIndexEntry struct {
+	TxHash []byte `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+	Type   string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+}
+
+func (m *AccountTxsIndexEntry) Reset()      { *m = AccountTxsIndexEntry{} }
+func (*AccountTxsIndexEntry) ProtoMessage() {}
+func (*AccountTxsIndexEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b7e6568769bf14f, []int{1}
+}
+func (m *AccountTxsIndexEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountTxsIndexEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountTxsIndexEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountTxsIndexEntry.Merge(m, src)
+}
+func (m *AccountTxsIndexEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountTxsIndexEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountTxsIndexEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountTxsIndexEntry proto.InternalMessageInfo
+
+func (m *AccountTxsIndexEntry) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *AccountTxsIndexEntry) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+// AccountTxsIndexBlock is used to store the transactions of an account within a block, together with the nonce of
+// the previous block holding transactions of the same account
+type AccountTxsIndexBlock struct {
+	HeaderHash    []byte                  `protobuf:"bytes,1,opt,name=HeaderHash,proto3" json:"HeaderHash,omitempty"`
+	Nonce         uint64                  `protobuf:"varint,2,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Epoch         uint32                  `protobuf:"varint,3,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	HasPrevious   bool                    `protobuf:"varint,4,opt,name=HasPrevious,proto3" json:"HasPrevious,omitempty"`
+	PreviousNonce uint64                  `protobuf:"varint,5,opt,name=PreviousNonce,proto3" json:"PreviousNonce,omitempty"`
+	Transactions  []*AccountTxsIndexEntry `protobuf:"bytes,6,rep,name=Transactions,proto3" json:"Transactions,omitempty"`
+}
+
+func (m *AccountTxsIndexBlock) Reset()      { *m = AccountTxsIndexBlock{} }
+func (*AccountTxsIndexBlock) ProtoMessage() {}
+func (*AccountTxsIndexBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b7e6568769bf14f, []int{2}
+}
+func (m *AccountTxsIndexBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountTxsIndexBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountTxsIndexBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountTxsIndexBlock.Merge(m, src)
+}
+func (m *AccountTxsIndexBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountTxsIndexBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountTxsIndexBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountTxsIndexBlock proto.InternalMessageInfo
+
+func (m *AccountTxsIndexBlock) GetHeaderHash() []byte {
+	if m != nil {
+		return m.HeaderHash
+	}
+	return nil
+}
+
+func (m *AccountTxsIndexBlock) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *AccountTxsIndexBlock) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *AccountTxsIndexBlock) GetHasPrevious() bool {
+	if m != nil {
+		return m.HasPrevious
+	}
+	return false
+}
+
+func (m *AccountTxsIndexBlock) GetPreviousNonce() uint64 {
+	if m != nil {
+		return m.PreviousNonce
+	}
+	return 0
+}
+
+func (m *AccountTxsIndexBlock) GetTransactions() []*AccountTxsIndexEntry {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AccountTxsIndexHead)(nil), "proto.AccountTxsIndexHead")
+	proto.RegisterType((*AccountTxsIndexEntry)(nil), "proto.AccountTxsIndexEntry")
+	proto.RegisterType((*AccountTxsIndexBlock)(nil), "proto.AccountTxsIndexBlock")
+}
+
+func init() { proto.RegisterFile("accountTxsIndex.proto", fileDescriptor_8b7e6568769bf14f) }
+
+var fileDescriptor_8b7e6568769bf14f = []byte{
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xcd, 0x4a, 0xfb, 0x50,
+	0x10, 0xc5, 0x33, 0xfd, 0xe2, 0xff, 0xbf, 0x6d, 0x37, 0xd7, 0x2a, 0x41, 0x61, 0x08, 0xc5, 0x45,
+	0x40, 0x6c, 0x41, 0x1f, 0x40, 0x2c, 0x14, 0xea, 0x46, 0x24, 0x64, 0xe5, 0x2e, 0xb9, 0xbd, 0xb6,
+	0xa5, 0x35, 0x13, 0xf2, 0x21, 0xe9, 0xce, 0x47, 0xf0, 0x31, 0x7c, 0x14, 0x97, 0x5d, 0x76, 0x69,
+	0x6f, 0x37, 0x82, 0x9b, 0x3e, 0x82, 0xf4, 0x46, 0x31, 0x55, 0x57, 0x73, 0xce, 0x61, 0xe6, 0x37,
+	0xc3, 0xb0, 0x7d, 0x4f, 0x08, 0x4a, 0x83, 0xc4, 0xcd, 0xe2, 0xab, 0x60, 0x28, 0xb3, 0x4e, 0x18,
+	0x51, 0x42, 0xbc, 0xaa, 0xcb, 0xe1, 0xe9, 0x68, 0x92, 0x8c, 0x53, 0xbf, 0x23, 0xe8, 0xbe, 0x3b,
+	0xa2, 0x11, 0x75, 0x75, 0xec, 0xa7, 0x77, 0xda, 0x69, 0xa3, 0x55, 0x3e, 0xd5, 0x3e, 0x61, 0x7b,
+	0x97, 0xbb, 0xb8, 0x81, 0xf4, 0x86, 0xbc, 0xc5, 0xaa, 0xd7, 0x14, 0x08, 0x69, 0x82, 0x05, 0x76,
+	0xc5, 0xc9, 0x4d, 0xbb, 0xc7, 0x5a, 0x3f, 0x9a, 0xfb, 0x41, 0x12, 0xcd, 0xf9, 0x01, 0xab, 0xb9,
+	0xd9, 0xc0, 0x8b, 0xc7, 0xba, 0xbd, 0xe1, 0x7c, 0x3a, 0xce, 0x59, 0xc5, 0x9d, 0x87, 0xd2, 0x2c,
+	0x59, 0x60, 0xff, 0x77, 0xb4, 0x6e, 0xbf, 0xc3, 0x2f, 0x48, 0x6f, 0x46, 0x62, 0xca, 0x91, 0xb1,
+	0xed, 0x6a, 0x19, 0x15, 0x40, 0x85, 0xe4, 0xfb, 0xa4, 0x52, 0xe1, 0xa4, 0x6d, 0xda, 0x0f, 0x49,
+	0x8c, 0xcd, 0xb2, 0x05, 0x76, 0xd3, 0xc9, 0x0d, 0xb7, 0x58, 0x7d, 0xe0, 0xc5, 0x37, 0x91, 0x7c,
+	0x98, 0x50, 0x1a, 0x9b, 0x15, 0x0b, 0xec, 0x7f, 0x4e, 0x31, 0xe2, 0xc7, 0xac, 0xf9, 0xa5, 0x73,
+	0x6a, 0x55, 0x53, 0x77, 0x43, 0x7e, 0xc1, 0x1a, 0x6e, 0xe4, 0x05, 0xb1, 0x27, 0x92, 0x09, 0x05,
+	0xb1, 0x59, 0xb3, 0xca, 0x76, 0xfd, 0xec, 0x28, 0xff, 0x5d, 0xe7, 0xaf, 0x5f, 0x38, 0x3b, 0x03,
+	0xbd, 0xfe, 0x62, 0x85, 0xc6, 0x72, 0x85, 0xc6, 0x66, 0x85, 0xf0, 0xa8, 0x10, 0x9e, 0x15, 0xc2,
+	0x8b, 0x42, 0x58, 0x28, 0x84, 0xa5, 0x42, 0x78, 0x55, 0x08, 0x6f, 0x0a, 0x8d, 0x8d, 0x42, 0x78,
+	0x5a, 0xa3, 0xb1, 0x58, 0xa3, 0xb1, 0x5c, 0xa3, 0x71, 0x5b, 0x1f, 0xfa, 0x33, 0xa2, 0x69, 0x1a,
+	0xca, 0x2c, 0xf1, 0x6b, 0x7a, 0xe1, 0xf9, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0x00, 0x54, 0x54,
+	0xab, 0xfb, 0x01, 0x00, 0x00,
+}
+
+func (this *AccountTxsIndexHead) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountTxsIndexHead)
+	if !ok {
+		that2, ok := that.(AccountTxsIndexHead)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	return true
+}
+func (this *AccountTxsIndexEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountTxsIndexEntry)
+	if !ok {
+		that2, ok := that.(AccountTxsIndexEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.TxHash, that1.TxHash) {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	return true
+}
+func (this *AccountTxsIndexBlock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountTxsIndexBlock)
+	if !ok {
+		that2, ok := that.(AccountTxsIndexBlock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.HeaderHash, that1.HeaderHash) {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.HasPrevious != that1.HasPrevious {
+		return false
+	}
+	if this.PreviousNonce != that1.PreviousNonce {
+		return false
+	}
+	if len(this.Transactions) != len(that1.Transactions) {
+		return false
+	}
+	for i := range this.Transactions {
+		if !this.Transactions[i].Equal(that1.Transactions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AccountTxsIndexHead) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&dblookupext.AccountTxsIndexHead{")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountTxsIndexEntry) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&dblookupext.AccountTxsIndexEntry{")
+	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountTxsIndexBlock) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&dblookupext.AccountTxsIndexBlock{")
+	s = append(s, "HeaderHash: "+fmt.Sprintf("%#v", this.HeaderHash)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "HasPrevious: "+fmt.Sprintf("%#v", this.HasPrevious)+",\n")
+	s = append(s, "PreviousNonce: "+fmt.Sprintf("%#v", this.PreviousNonce)+",\n")
+	if this.Transactions != nil {
+		s = append(s, "Transactions: "+fmt.Sprintf("%#v", this.Transactions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringAccountTxsIndex(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *AccountTxsIndexHead) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountTxsIndexHead) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountTxsIndexHead) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintAccountTxsIndex(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountTxsIndexEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountTxsIndexEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountTxsIndexEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintAccountTxsIndex(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintAccountTxsIndex(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountTxsIndexBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountTxsIndexBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountTxsIndexBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccountTxsIndex(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.PreviousNonce != 0 {
+		i = encodeVarintAccountTxsIndex(dAtA, i, uint64(m.PreviousNonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.HasPrevious {
+		i--
+		if m.HasPrevious {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintAccountTxsIndex(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintAccountTxsIndex(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.HeaderHash) > 0 {
+		i -= len(m.HeaderHash)
+		copy(dAtA[i:], m.HeaderHash)
+		i = encodeVarintAccountTxsIndex(dAtA, i, uint64(len(m.HeaderHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAccountTxsIndex(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAccountTxsIndex(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccountTxsIndexHead) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovAccountTxsIndex(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *AccountTxsIndexEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovAccountTxsIndex(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovAccountTxsIndex(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountTxsIndexBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HeaderHash)
+	if l > 0 {
+		n += 1 + l + sovAccountTxsIndex(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovAccountTxsIndex(uint64(m.Nonce))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovAccountTxsIndex(uint64(m.Epoch))
+	}
+	if m.HasPrevious {
+		n += 2
+	}
+	if m.PreviousNonce != 0 {
+		n += 1 + sovAccountTxsIndex(uint64(m.PreviousNonce))
+	}
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovAccountTxsIndex(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAccountTxsIndex(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAccountTxsIndex(x uint64) (n int) {
+	return sovAccountTxsIndex(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AccountTxsIndexHead) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountTxsIndexHead{`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountTxsIndexEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountTxsIndexEntry{`,
+		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountTxsIndexBlock) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTransactions := "[]*AccountTxsIndexEntry{"
+	for _, f := range this.Transactions {
+		repeatedStringForTransactions += strings.Replace(f.String(), "AccountTxsIndexEntry", "AccountTxsIndexEntry", 1) + ","
+	}
+	repeatedStringForTransactions += "}"
+	s := strings.Join([]string{`&AccountTxsIndexBlock{`,
+		`HeaderHash:` + fmt.Sprintf("%v", this.HeaderHash) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`HasPrevious:` + fmt.Sprintf("%v", this.HasPrevious) + `,`,
+		`PreviousNonce:` + fmt.Sprintf("%v", this.PreviousNonce) + `,`,
+		`Transactions:` + repeatedStringForTransactions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringAccountTxsIndex(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AccountTxsIndexHead) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountTxsIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountTxsIndexHead: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountTxsIndexHead: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTxsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountTxsIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccountTxsIndex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAccountTxsIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountTxsIndexEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountTxsIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountTxsIndexEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountTxsIndexEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTxsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountTxsIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountTxsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTxsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccountTxsIndex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountTxsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountTxsIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccountTxsIndex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAccountTxsIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountTxsIndexBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountTxsIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountTxsIndexBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountTxsIndexBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTxsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountTxsIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountTxsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderHash = append(m.HeaderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeaderHash == nil {
+				m.HeaderHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTxsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTxsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasPrevious", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTxsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasPrevious = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousNonce", wireType)
+			}
+			m.PreviousNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTxsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTxsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccountTxsIndex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountTxsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &AccountTxsIndexEntry{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountTxsIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccountTxsIndex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAccountTxsIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAccountTxsIndex(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAccountTxsIndex
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccountTxsIndex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccountTxsIndex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAccountTxsIndex
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAccountTxsIndex
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAccountTxsIndex
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAccountTxsIndex        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAccountTxsIndex          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAccountTxsIndex = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,291 @@
+package dblookupext
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
+	"github.com/multiversx/mx-chain-go/common/mock"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/require"
+)
+
+type accountTxsIndexTestContext struct {
+	index            *accountTxsIndex
+	blockHashByNonce *genericMocks.StorerMock
+}
+
+func createAccountTxsIndexTestContext() *accountTxsIndexTestContext {
+	blockHashByNonce := genericMocks.NewStorerMockWithEpoch(0)
+
+	return &accountTxsIndexTestContext{
+		index: newAccountTxsIndex(
+			genericMocks.NewStorerMockWithEpoch(0),
+			blockHashByNonce,
+			&mock.MarshalizerMock{},
+			uint64ByteSlice.NewBigEndianConverter(),
+		),
+		blockHashByNonce: blockHashByNonce,
+	}
+}
+
+func (tc *accountTxsIndexTestContext) saveBlock(
+	t *testing.T,
+	headerHash string,
+	nonce uint64,
+	txs map[string]data.TransactionHandler,
+	scrs map[string]data.TransactionHandler,
+	miniblocks ...*block.MiniBlock,
+) {
+	err := tc.blockHashByNonce.Put(uint64ByteSlice.NewBigEndianConverter().ToByteSlice(nonce), []byte(headerHash))
+	require.Nil(t, err)
+	err = tc.index.saveBlock([]byte(headerHash), &block.Header{Nonce: nonce, Epoch: uint32(nonce / 100)}, miniblocks, txs, scrs)
+	require.Nil(t, err)
+}
+
+func (tc *accountTxsIndexTestContext) saveTransfer(t *testing.T, nonce uint64, sender string, receiver string) {
+	txHash := fmt.Sprintf("tx-%d", nonce)
+	tc.saveBlock(
+		t,
+		fmt.Sprintf("header-%d", nonce),
+		nonce,
+		map[string]data.TransactionHandler{txHash: &transaction.Transaction{SndAddr: []byte(sender), RcvAddr: []byte(receiver)}},
+		nil,
+		&block.MiniBlock{Type: block.TxBlock, TxHashes: [][]byte{[]byte(txHash)}},
+	)
+}
+
+func getTxHashes(page *AccountTransactionsPage) []string {
+	hashes := make([]string, 0, len(page.Transactions))
+	for _, tx := range page.Transactions {
+		hashes = append(hashes, string(tx.TxHash))
+	}
+
+	return hashes
+}
+
+func TestAccountTxsIndex_SaveBlockShouldIndexAllTransactionTypes(t *testing.T) {
+	t.Parallel()
+
+	tc := createAccountTxsIndexTestContext()
+	txs := map[string]data.TransactionHandler{
+		"tx":      &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+		"invalid": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("carol")},
+		"reward":  &rewardTx.RewardTx{RcvAddr: []byte("alice")},
+		"self":    &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("alice")},
+	}
+	scrs := map[string]data.TransactionHandler{
+		"scr": &smartContractResult.SmartContractResult{SndAddr: []byte("carol"), RcvAddr: []byte("alice")},
+	}
+	tc.saveBlock(t, "header", 205, txs, scrs,
+		&block.MiniBlock{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx"), []byte("self"), []byte("missing")}},
+		&block.MiniBlock{Type: block.InvalidBlock, TxHashes: [][]byte{[]byte("invalid")}},
+		&block.MiniBlock{Type: block.RewardsBlock, TxHashes: [][]byte{[]byte("reward")}},
+		&block.MiniBlock{Type: block.SmartContractResultBlock, TxHashes: [][]byte{[]byte("scr")}},
+		&block.MiniBlock{Type: block.PeerBlock, TxHashes: [][]byte{[]byte("tx")}},
+		nil,
+	)
+
+	page := tc.index.getTransactions([]byte("alice"), 0, 0, 100)
+	require.False(t, page.HasMore)
+	require.Equal(t, []*AccountTransaction{
+		{TxHash: []byte("tx"), Type: transaction.TxTypeNormal, BlockNonce: 205, BlockHash: []byte("header"), Epoch: 2},
+		{TxHash: []byte("self"), Type: transaction.TxTypeNormal, BlockNonce: 205, BlockHash: []byte("header"), Epoch: 2},
+		{TxHash: []byte("invalid"), Type: transaction.TxTypeInvalid, BlockNonce: 205, BlockHash: []byte("header"), Epoch: 2},
+		{TxHash: []byte("reward"), Type: transaction.TxTypeReward, BlockNonce: 205, BlockHash: []byte("header"), Epoch: 2},
+		{TxHash: []byte("scr"), Type: transaction.TxTypeUnsigned, BlockNonce: 205, BlockHash: []byte("header"), Epoch: 2},
+	}, page.Transactions)
+
+	require.Equal(t, []string{"tx"}, getTxHashes(tc.index.getTransactions([]byte("bob"), 0, 0, 100)))
+	require.Equal(t, []string{"invalid", "scr"}, getTxHashes(tc.index.getTransactions([]byte("carol"), 0, 0, 100)))
+	require.Empty(t, tc.index.getTransactions([]byte("dave"), 0, 0, 100).Transactions)
+}
+
+func TestAccountTxsIndex_GetTransactionsWithPaging(t *testing.T) {
+	t.Parallel()
+
+	tc := createAccountTxsIndexTestContext()
+	tc.saveTransfer(t, 1, "alice", "bob")
+	tc.saveTransfer(t, 2, "bob", "carol")
+	tc.saveTransfer(t, 3, "carol", "alice")
+	tc.saveTransfer(t, 7, "alice", "carol")
+	tc.saveTransfer(t, 9, "alice", "bob")
+
+	page := tc.index.getTransactions([]byte("alice"), 0, 0, 2)
+	require.Equal(t, []string{"tx-9", "tx-7"}, getTxHashes(page))
+	require.True(t, page.HasMore)
+	require.Equal(t, uint64(7), page.NextBeforeNonce)
+
+	page = tc.index.getTransactions([]byte("alice"), page.NextBeforeNonce, 0, 2)
+	require.Equal(t, []string{"tx-3", "tx-1"}, getTxHashes(page))
+	require.False(t, page.HasMore)
+
+	// arbitrary cursors are also supported
+	require.Equal(t, []string{"tx-7", "tx-3", "tx-1"}, getTxHashes(tc.index.getTransactions([]byte("alice"), 8, 0, 10)))
+	require.Equal(t, []string{"tx-3", "tx-1"}, getTxHashes(tc.index.getTransactions([]byte("alice"), 4, 0, 10)))
+	require.Empty(t, getTxHashes(tc.index.getTransactions([]byte("alice"), 1, 0, 10)))
+	require.Equal(t, []string{"tx-9", "tx-2", "tx-1"}, getTxHashes(tc.index.getTransactions([]byte("bob"), 100, 0, 10)))
+}
+
+func TestAccountTxsIndex_GetTransactionsWithEpochCursor(t *testing.T) {
+	t.Parallel()
+
+	tc := createAccountTxsIndexTestContext()
+	tc.saveTransfer(t, 50, "alice", "bob")
+	tc.saveTransfer(t, 150, "alice", "bob")
+	tc.saveTransfer(t, 160, "alice", "bob")
+	tc.saveTransfer(t, 250, "alice", "bob")
+
+	require.Equal(t, []string{"tx-160", "tx-150", "tx-50"}, getTxHashes(tc.index.getTransactions([]byte("alice"), 0, 2, 10)))
+	require.Equal(t, []string{"tx-50"}, getTxHashes(tc.index.getTransactions([]byte("alice"), 0, 1, 10)))
+	require.Equal(t, []string{"tx-150", "tx-50"}, getTxHashes(tc.index.getTransactions([]byte("alice"), 160, 2, 10)))
+
+	page := tc.index.getTransactions([]byte("alice"), 0, 2, 1)
+	require.Equal(t, []string{"tx-160"}, getTxHashes(page))
+	require.True(t, page.HasMore)
+	require.Equal(t, uint64(160), page.NextBeforeNonce)
+	require.Equal(t, uint32(2), page.NextBeforeEpoch)
+
+	page = tc.index.getTransactions([]byte("alice"), page.NextBeforeNonce, page.NextBeforeEpoch, 1)
+	require.Equal(t, []string{"tx-150"}, getTxHashes(page))
+	require.True(t, page.HasMore)
+
+	page = tc.index.getTransactions([]byte("alice"), page.NextBeforeNonce, page.NextBeforeEpoch, 1)
+	require.Equal(t, []string{"tx-50"}, getTxHashes(page))
+	require.False(t, page.HasMore)
+	require.Equal(t, uint64(50), page.NextBeforeNonce)
+	require.Equal(t, uint32(1), page.NextBeforeEpoch)
+}
+
+func TestAccountTxsIndex_SaveBlockOutOfOrderShouldKeepTheLinks(t *testing.T) {
+	t.Parallel()
+
+	tc := createAccountTxsIndexTestContext()
+	tc.saveTransfer(t, 5, "alice", "bob")
+	tc.saveTransfer(t, 1, "alice", "bob")
+	tc.saveTransfer(t, 3, "alice", "bob")
+
+	require.Equal(t, []string{"tx-5", "tx-3", "tx-1"}, getTxHashes(tc.index.getTransactions([]byte("alice"), 0, 0, 10)))
+}
+
+func TestAccountTxsIndex_GetTransactionsShouldSkipBlocksReplacedOnFork(t *testing.T) {
+	t.Parallel()
+
+	tc := createAccountTxsIndexTestContext()
+	tc.saveTransfer(t, 1, "alice", "bob")
+	tc.saveBlock(
+		t,
+		"fork-2",
+		2,
+		map[string]data.TransactionHandler{"fork-tx": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("carol")}},
+		nil,
+		&block.MiniBlock{Type: block.TxBlock, TxHashes: [][]byte{[]byte("fork-tx")}},
+	)
+	// the canonical block with nonce 2 holds no transactions of alice
+	_ = tc.blockHashByNonce.Put(uint64ByteSlice.NewBigEndianConverter().ToByteSlice(2), []byte("header-2"))
+	tc.saveTransfer(t, 3, "bob", "alice")
+
+	require.Equal(t, []string{"tx-3", "tx-1"}, getTxHashes(tc.index.getTransactions([]byte("alice"), 0, 0, 10)))
+	require.Empty(t, getTxHashes(tc.index.getTransactions([]byte("carol"), 0, 0, 10)))
+
+	// re-recording the same nonce on the canonical chain overwrites the record of the fork
+	tc.saveTransfer(t, 2, "alice", "dave")
+	require.Equal(t, []string{"tx-3", "tx-2", "tx-1"}, getTxHashes(tc.index.getTransactions([]byte("alice"), 0, 0, 10)))
+}
+
+func TestAccountTxsIndex_GetTransactionsShouldSeekThroughTheNonceRanges(t *testing.T) {
+	t.Parallel()
+
+	tc := createAccountTxsIndexTestContext()
+	tc.index.nonceRangeSize = 10
+	for nonce := uint64(1); nonce <= 40; nonce++ {
+		tc.saveTransfer(t, nonce, "bob", "carol")
+	}
+	tc.saveTransfer(t, 5, "alice", "bob")
+	tc.saveTransfer(t, 23, "alice", "bob")
+	tc.saveTransfer(t, 27, "alice", "bob")
+
+	require.Equal(t, []string{"tx-27", "tx-23", "tx-5"}, getTxHashes(tc.index.getTransactions([]byte("alice"), 100, 0, 10)))
+	require.Equal(t, []string{"tx-23", "tx-5"}, getTxHashes(tc.index.getTransactions([]byte("alice"), 25, 0, 10)))
+	require.Equal(t, []string{"tx-5"}, getTxHashes(tc.index.getTransactions([]byte("alice"), 20, 0, 10)))
+	require.Empty(t, getTxHashes(tc.index.getTransactions([]byte("alice"), 5, 0, 10)))
+	require.Equal(t, []string{"tx-24", "tx-23", "tx-22"}, getTxHashes(tc.index.getTransactions([]byte("bob"), 25, 0, 3)))
+}
+
+func TestAccountTxsIndex_GetTransactionsShouldLimitTheScannedRecords(t *testing.T) {
+	t.Parallel()
+
+	t.Run("records not matching the cursor", func(t *testing.T) {
+		t.Parallel()
+
+		tc := createAccountTxsIndexTestContext()
+		tc.index.maxScannedRecords = 5
+		tc.saveTransfer(t, 1, "alice", "bob")
+		tc.saveBlock(
+			t,
+			"fork-2",
+			2,
+			map[string]data.TransactionHandler{"fork-tx": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("carol")}},
+			nil,
+			&block.MiniBlock{Type: block.TxBlock, TxHashes: [][]byte{[]byte("fork-tx")}},
+		)
+		for nonce := uint64(3); nonce <= 20; nonce++ {
+			tc.saveBlock(
+				t,
+				fmt.Sprintf("fork-%d", nonce),
+				nonce,
+				map[string]data.TransactionHandler{"fork-tx": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("carol")}},
+				nil,
+				&block.MiniBlock{Type: block.TxBlock, TxHashes: [][]byte{[]byte("fork-tx")}},
+			)
+			// the canonical blocks hold no transactions of alice
+			_ = tc.blockHashByNonce.Put(uint64ByteSlice.NewBigEndianConverter().ToByteSlice(nonce), []byte("canonical"))
+		}
+		_ = tc.blockHashByNonce.Put(uint64ByteSlice.NewBigEndianConverter().ToByteSlice(2), []byte("canonical"))
+
+		hashes := make([]string, 0)
+		numPages := 0
+		beforeNonce := uint64(0)
+		for {
+			page := tc.index.getTransactions([]byte("alice"), beforeNonce, 0, 10)
+			hashes = append(hashes, getTxHashes(page)...)
+			numPages++
+			if !page.HasMore {
+				break
+			}
+
+			require.Less(t, page.NextBeforeNonce, uint64(21))
+			if beforeNonce > 0 {
+				require.Less(t, page.NextBeforeNonce, beforeNonce)
+			}
+			beforeNonce = page.NextBeforeNonce
+		}
+
+		require.Equal(t, []string{"tx-1"}, hashes)
+		require.Greater(t, numPages, 1)
+	})
+	t.Run("epochs without records", func(t *testing.T) {
+		t.Parallel()
+
+		tc := createAccountTxsIndexTestContext()
+		tc.index.maxScannedRecords = 5
+		tc.saveTransfer(t, 50, "alice", "bob")
+		tc.saveTransfer(t, 2050, "alice", "bob")
+
+		page := tc.index.getTransactions([]byte("alice"), 0, 20, 10)
+		require.Empty(t, page.Transactions)
+		require.True(t, page.HasMore)
+		require.Less(t, page.NextBeforeEpoch, uint32(20))
+
+		for page.HasMore {
+			require.Empty(t, page.Transactions)
+			page = tc.index.getTransactions([]byte("alice"), page.NextBeforeNonce, page.NextBeforeEpoch, 10)
+		}
+		require.Equal(t, []string{"tx-50"}, getTxHashes(page))
+	})
+}
@@ -20,7 +20,7 @@ func NewNilHistoryRepository() (*nilHistoryRepository, error) {
 }
 
 // RecordBlock returns a not implemented error
-func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _ map[string]data.TransactionHandler, _ []*block.MiniBlock, _ []*data.LogData) error {
+func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _, _ map[string]data.TransactionHandler, _ []*block.MiniBlock, _ []*data.LogData) error {
 	return nil
 }
 
@@ -53,11 +53,21 @@ func (nhr *nilHistoryRepository) GetESDTSupply(_ string) (*esdtSupply.SupplyESDT
 	return nil, errorDisabledHistoryRepository
 }
 
+// GetAccountTransactions returns a not implemented error
+func (nhr *nilHistoryRepository) GetAccountTransactions(_ []byte, _ uint64, _ uint32, _ int) (*dblookupext.AccountTransactionsPage, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
 // GetResultsHashesByTxHash -
 func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*dblookupext.ResultsHashesByTxHash, error) {
 	return nil, nil
 }
 
+// FilterLogsIndex returns a not implemented error
+func (nhr *nilHistoryRepository) FilterLogsIndex(_ *dblookupext.LogsIndexFilter) ([]*dblookupext.LogsIndexBlock, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nhr *nilHistoryRepository) IsInterfaceNil() bool {
 	return nhr == nil
@@ -9,6 +9,21 @@ import (
 // ErrNotFoundInStorage signals that an item was not found in storage
 var ErrNotFoundInStorage = errors.New("not found in storage")
 
+// ErrInvalidNoncesRange signals that an invalid nonces range has been provided
+var ErrInvalidNoncesRange = errors.New("invalid nonces range")
+
+// ErrNoncesRangeTooLarge signals that the provided nonces range is too large
+var ErrNoncesRangeTooLarge = errors.New("nonces range too large")
+
+// ErrNilLogsIndexFilter signals that a nil logs index filter has been provided
+var ErrNilLogsIndexFilter = errors.New("nil logs index filter")
+
+// ErrEmptyAddress signals that an empty address has been provided
+var ErrEmptyAddress = errors.New("empty address")
+
+// ErrInvalidPageSize signals that an invalid page size has been provided
+var ErrInvalidPageSize = errors.New("invalid page size")
+
 var errCannotCastToBlockBody = errors.New("cannot cast to block body")
 
 var errNilESDTSuppliesHandler = errors.New("nil esdt supplies handler")
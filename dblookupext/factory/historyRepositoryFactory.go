@@ -109,6 +109,21 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		return nil, err
 	}
 
+	logsIndexStorer, err := hpf.store.GetStorer(dataRetriever.LogsIndexUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	accountTxsIndexStorer, err := hpf.store.GetStorer(dataRetriever.AccountTransactionsUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHashByNonceStorer, err := hpf.store.GetStorer(dataRetriever.GetHdrNonceHashDataUnit(hpf.selfShardID))
+	if err != nil {
+		return nil, err
+	}
+
 	historyRepArgs := dblookupext.HistoryRepositoryArguments{
 		SelfShardID:                 hpf.selfShardID,
 		Hasher:                      hpf.hasher,
@@ -119,6 +134,9 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		EpochByHashStorer:           epochByHashStorer,
 		MiniblockHashByTxHashStorer: miniblockHashByTxHashStorer,
 		EventsHashesByTxHashStorer:  resultsHashesByTxHashStorer,
+		LogsIndexStorer:             logsIndexStorer,
+		AccountTxsIndexStorer:       accountTxsIndexStorer,
+		BlockHashByNonce:            blockHashByNonceStorer,
 		ESDTSuppliesHandler:         esdtSuppliesHandler,
 	}
 	return dblookupext.NewHistoryRepository(historyRepArgs)
@@ -87,6 +87,9 @@ func TestHistoryRepositoryFactory_CreateMissingStorersReturnsError(t *testing.T)
 	t.Run("missing EpochByHashUnit", testWithMissingStorer(dataRetriever.EpochByHashUnit))
 	t.Run("missing MiniblockHashByTxHashUnit", testWithMissingStorer(dataRetriever.MiniblockHashByTxHashUnit))
 	t.Run("missing ResultsHashesByTxHashUnit", testWithMissingStorer(dataRetriever.ResultsHashesByTxHashUnit))
+	t.Run("missing LogsIndexUnit", testWithMissingStorer(dataRetriever.LogsIndexUnit))
+	t.Run("missing AccountTransactionsUnit", testWithMissingStorer(dataRetriever.AccountTransactionsUnit))
+	t.Run("missing ShardHdrNonceHashDataUnit", testWithMissingStorer(dataRetriever.ShardHdrNonceHashDataUnit))
 }
 
 func testWithMissingStorer(missingUnit dataRetriever.UnitType) func(t *testing.T) {
@@ -35,6 +35,9 @@ type HistoryRepositoryArguments struct {
 	Uint64ByteSliceConverter    typeConverters.Uint64ByteSliceConverter
 	EpochByHashStorer           storage.Storer
 	EventsHashesByTxHashStorer  storage.Storer
+	LogsIndexStorer             storage.Storer
+	AccountTxsIndexStorer       storage.Storer
+	BlockHashByNonce            storage.Storer
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
 	ESDTSuppliesHandler         SuppliesHandler
@@ -48,6 +51,8 @@ type historyRepository struct {
 	uint64ByteSliceConverter   typeConverters.Uint64ByteSliceConverter
 	epochByHashIndex           *epochByHashIndex
 	eventsHashesByTxHashIndex  *eventsHashesByTxHash
+	logsIndex                  *logsIndex
+	accountTxsIndex            *accountTxsIndex
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 	esdtSuppliesHandler        SuppliesHandler
@@ -91,6 +96,15 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	if check.IfNil(arguments.EventsHashesByTxHashStorer) {
 		return nil, core.ErrNilStore
 	}
+	if check.IfNil(arguments.LogsIndexStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(arguments.AccountTxsIndexStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(arguments.BlockHashByNonce) {
+		return nil, core.ErrNilStore
+	}
 	if check.IfNil(arguments.ESDTSuppliesHandler) {
 		return nil, errNilESDTSuppliesHandler
 	}
@@ -102,6 +116,20 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	deduplicationCacheForInsertMiniblockMetadata, _ := cache.NewLRUCache(sizeOfDeduplicationCache)
 
 	eventsHashesToTxHashIndex := newEventsHashesByTxHash(arguments.EventsHashesByTxHashStorer, arguments.Marshalizer)
+	logsIndexInstance := newLogsIndex(
+		arguments.LogsIndexStorer,
+		arguments.BlockHashByNonce,
+		hashToEpochIndex,
+		arguments.Marshalizer,
+		arguments.Hasher,
+		arguments.Uint64ByteSliceConverter,
+	)
+	accountTxsIndexInstance := newAccountTxsIndex(
+		arguments.AccountTxsIndexStorer,
+		arguments.BlockHashByNonce,
+		arguments.Marshalizer,
+		arguments.Uint64ByteSliceConverter,
+	)
 
 	return &historyRepository{
 		selfShardID:                           arguments.SelfShardID,
@@ -116,6 +144,8 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		pendingNotarizedAtBothNotifications:          container.NewMutexMap(),
 		deduplicationCacheForInsertMiniblockMetadata: deduplicationCacheForInsertMiniblockMetadata,
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
+		logsIndex:                                    logsIndexInstance,
+		accountTxsIndex:                              accountTxsIndexInstance,
 		esdtSuppliesHandler:                          arguments.ESDTSuppliesHandler,
 		uint64ByteSliceConverter:                     arguments.Uint64ByteSliceConverter,
 	}, nil
@@ -126,6 +156,7 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 	blockHeader data.HeaderHandler,
 	blockBody data.BodyHandler,
+	txsFromPool map[string]data.TransactionHandler,
 	scrResultsFromPool map[string]data.TransactionHandler,
 	receiptsFromPool map[string]data.TransactionHandler,
 	createdIntraShardMiniBlocks []*block.MiniBlock,
@@ -178,6 +209,17 @@ func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 		return err
 	}
 
+	err = hr.logsIndex.saveBlock(blockHeaderHash, blockHeader, logs)
+	if err != nil {
+		return err
+	}
+
+	miniblocks := append(append(make([]*block.MiniBlock, 0), body.MiniBlocks...), createdIntraShardMiniBlocks...)
+	err = hr.accountTxsIndex.saveBlock(blockHeaderHash, blockHeader, miniblocks, txsFromPool, scrResultsFromPool)
+	if err != nil {
+		return err
+	}
+
 	err = hr.putHashByRound(blockHeaderHash, blockHeader)
 	if err != nil {
 		return err
@@ -473,6 +515,30 @@ func (hr *historyRepository) GetResultsHashesByTxHash(txHash []byte, epoch uint3
 	return hr.eventsHashesByTxHashIndex.getEventsHashesByTxHash(txHash, epoch)
 }
 
+// FilterLogsIndex returns the blocks of the canonical chain that might hold events matching the provided filter.
+// The returned blocks hold the keys of their logs, so that the events can be loaded and filtered accurately
+func (hr *historyRepository) FilterLogsIndex(filter *LogsIndexFilter) ([]*LogsIndexBlock, error) {
+	if filter == nil {
+		return nil, ErrNilLogsIndexFilter
+	}
+
+	return hr.logsIndex.filterBlocks(filter)
+}
+
+// GetAccountTransactions returns a page of the transactions sent or received by the provided address, starting with
+// the newest block lower than beforeNonce and from an epoch lower than beforeEpoch. A 0 value disables the corresponding
+// condition
+func (hr *historyRepository) GetAccountTransactions(address []byte, beforeNonce uint64, beforeEpoch uint32, maxTransactions int) (*AccountTransactionsPage, error) {
+	if len(address) == 0 {
+		return nil, ErrEmptyAddress
+	}
+	if maxTransactions <= 0 {
+		return nil, ErrInvalidPageSize
+	}
+
+	return hr.accountTxsIndex.getTransactions(address, beforeNonce, beforeEpoch, maxTransactions), nil
+}
+
 // IsEnabled will always return true
 func (hr *historyRepository) IsEnabled() bool {
 	return true
@@ -8,6 +8,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/common/mock"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 	epochStartMocks "github.com/multiversx/mx-chain-go/epochStart/mock"
@@ -34,6 +35,9 @@ func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
 		EpochByHashStorer:           genericMocks.NewStorerMockWithEpoch(epoch),
 		EventsHashesByTxHashStorer:  genericMocks.NewStorerMockWithEpoch(epoch),
 		BlockHashByRound:            genericMocks.NewStorerMockWithEpoch(epoch),
+		LogsIndexStorer:             genericMocks.NewStorerMockWithEpoch(epoch),
+		AccountTxsIndexStorer:       genericMocks.NewStorerMockWithEpoch(epoch),
+		BlockHashByNonce:            genericMocks.NewStorerMockWithEpoch(epoch),
 		Marshalizer:                 &mock.MarshalizerMock{},
 		Hasher:                      &hashingMocks.HasherMock{},
 		ESDTSuppliesHandler:         sp,
@@ -70,6 +74,24 @@ func TestNewHistoryRepository(t *testing.T) {
 	require.Nil(t, repo)
 	require.Equal(t, core.ErrNilStore, err)
 
+	args = createMockHistoryRepoArgs(0)
+	args.LogsIndexStorer = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.AccountTxsIndexStorer = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.BlockHashByNonce = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
 	args = createMockHistoryRepoArgs(0)
 	args.Hasher = nil
 	repo, err = NewHistoryRepository(args)
@@ -108,7 +130,7 @@ func TestHistoryRepository_RecordBlockInvalidBlockRoundByHashStorerExpectError(t
 	repo, err := NewHistoryRepository(args)
 	require.Nil(t, err)
 
-	err = repo.RecordBlock([]byte("headerHash"), &block.Header{}, &block.Body{}, nil, nil, nil, nil)
+	err = repo.RecordBlock([]byte("headerHash"), &block.Header{}, &block.Body{}, nil, nil, nil, nil, nil)
 	require.Equal(t, err, errPut)
 }
 
@@ -140,7 +162,7 @@ func TestHistoryRepository_RecordBlock(t *testing.T) {
 		},
 	}
 
-	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil, nil, nil)
+	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil, nil, nil, nil)
 	require.Nil(t, err)
 	// Two miniblocks
 	require.Equal(t, 2, repo.miniblocksMetadataStorer.(*genericMocks.StorerMock).GetCurrentEpochData().Len())
@@ -152,6 +174,43 @@ func TestHistoryRepository_RecordBlock(t *testing.T) {
 	require.Equal(t, 1, repo.blockHashByRound.(*genericMocks.StorerMock).GetCurrentEpochData().Len())
 }
 
+func TestHistoryRepository_GetAccountTransactions(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(0)
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	page, err := repo.GetAccountTransactions(nil, 0, 0, 10)
+	require.Equal(t, ErrEmptyAddress, err)
+	require.Nil(t, page)
+
+	page, err = repo.GetAccountTransactions([]byte("alice"), 0, 0, 0)
+	require.Equal(t, ErrInvalidPageSize, err)
+	require.Nil(t, page)
+
+	blockBody := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				Type:     block.TxBlock,
+				TxHashes: [][]byte{[]byte("txA")},
+			},
+		},
+	}
+	txs := map[string]data.TransactionHandler{
+		"txA": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+	}
+	err = repo.RecordBlock([]byte("headerHash"), &block.Header{Nonce: 4}, blockBody, txs, nil, nil, nil, nil)
+	require.Nil(t, err)
+
+	page, err = repo.GetAccountTransactions([]byte("bob"), 0, 0, 10)
+	require.Nil(t, err)
+	require.Len(t, page.Transactions, 1)
+	require.Equal(t, []byte("txA"), page.Transactions[0].TxHash)
+	require.Equal(t, []byte("headerHash"), page.Transactions[0].BlockHash)
+	require.Equal(t, uint64(4), page.Transactions[0].BlockNonce)
+}
+
 func TestHistoryRepository_GetMiniblockMetadata(t *testing.T) {
 	t.Parallel()
 
@@ -176,7 +235,7 @@ func TestHistoryRepository_GetMiniblockMetadata(t *testing.T) {
 				miniblockB,
 			},
 		},
-		nil, nil, nil, nil,
+		nil, nil, nil, nil, nil,
 	)
 
 	metadata, err := repo.GetMiniblockMetadataByTxHash([]byte("txA"))
@@ -214,7 +273,7 @@ func TestHistoryRepository_GetEpochForHash(t *testing.T) {
 			miniblockA,
 			miniblockB,
 		},
-	}, nil, nil, nil, nil)
+	}, nil, nil, nil, nil, nil)
 
 	// Get epoch by block hash
 	epoch, err := repo.GetEpochByHash([]byte("fooblock"))
@@ -272,7 +331,7 @@ func TestHistoryRepository_OnNotarizedBlocks(t *testing.T) {
 				miniblockB,
 				miniblockC,
 			},
-		}, nil, nil, nil, nil,
+		}, nil, nil, nil, nil, nil,
 	)
 
 	// Check "notarization coordinates"
@@ -472,7 +531,7 @@ func TestHistoryRepository_OnNotarizedBlocksAtSourceBeforeCommittingAtDestinatio
 			MiniBlocks: []*block.MiniBlock{
 				miniblockA,
 			},
-		}, nil, nil, nil, nil,
+		}, nil, nil, nil, nil, nil,
 	)
 	_ = repo.RecordBlock([]byte("barBlock"),
 		&block.Header{Epoch: 42, Round: 4322},
@@ -480,7 +539,7 @@ func TestHistoryRepository_OnNotarizedBlocksAtSourceBeforeCommittingAtDestinatio
 			MiniBlocks: []*block.MiniBlock{
 				miniblockB,
 			},
-		}, nil, nil, nil, nil,
+		}, nil, nil, nil, nil, nil,
 	)
 
 	// Notifications have not been cleared after record block
@@ -527,7 +586,7 @@ func TestHistoryRepository_OnNotarizedBlocksCrossEpoch(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblockA,
 			},
-		}, nil, nil, nil, nil,
+		}, nil, nil, nil, nil, nil,
 	)
 
 	// Now let's receive a metablock and the "notarized" notification, in the next epoch
@@ -584,7 +643,7 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblock,
 			},
-		}, nil, nil, nil, nil,
+		}, nil, nil, nil, nil, nil,
 	)
 
 	// Let's go to next epoch
@@ -597,7 +656,7 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblock,
 			},
-		}, nil, nil, nil, nil,
+		}, nil, nil, nil, nil, nil,
 	)
 
 	// Now let's receive a metablock and the "notarized" notification
@@ -711,7 +770,7 @@ func TestHistoryRepository_ConcurrentlyRecordAndNotarizeSameBlockMultipleTimes(t
 					MiniBlocks: []*block.MiniBlock{
 						miniblock,
 					},
-				}, nil, nil, nil, nil,
+				}, nil, nil, nil, nil, nil,
 			)
 		}
 
@@ -17,6 +17,7 @@ type HistoryRepository interface {
 	RecordBlock(blockHeaderHash []byte,
 		blockHeader data.HeaderHandler,
 		blockBody data.BodyHandler,
+		txsFromPool map[string]data.TransactionHandler,
 		scrResultsFromPool map[string]data.TransactionHandler,
 		receiptsFromPool map[string]data.TransactionHandler,
 		createdIntraShardMiniBlocks []*block.MiniBlock,
@@ -27,6 +28,8 @@ type HistoryRepository interface {
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
 	RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
+	FilterLogsIndex(filter *LogsIndexFilter) ([]*LogsIndexBlock, error)
+	GetAccountTransactions(address []byte, beforeNonce uint64, beforeEpoch uint32, maxTransactions int) (*AccountTransactionsPage, error)
 	IsEnabled() bool
 	IsInterfaceNil() bool
 }
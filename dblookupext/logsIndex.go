@@ -0,0 +1,437 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=. logsIndex.proto
+
+package dblookupext
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common/logging"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+const (
+	logsBloomSizeInBytes = 256
+	logsBloomNumBits     = logsBloomSizeInBytes * 8
+	logsBloomNumHashes   = 3
+
+	// logsIndexBucketSize is the number of consecutive nonces covered by a record of the secondary indexes
+	logsIndexBucketSize = 1000
+
+	// maxLogsIndexNoncesRange is the maximum number of blocks that can be filtered at once
+	maxLogsIndexNoncesRange = 10000
+)
+
+const (
+	logsIndexBlockPrefix      = 'b'
+	logsIndexAddressPrefix    = 'a'
+	logsIndexIdentifierPrefix = 'i'
+	logsIndexTopicPrefix      = 't'
+)
+
+// LogsIndexFilter holds the criteria used for selecting the blocks from the logs index.
+// A block matches if it holds an event emitted by any of the addresses, having any of the identifiers and, for each
+// position of the topics, any of the provided values. Empty criteria match everything.
+type LogsIndexFilter struct {
+	FromNonce   uint64
+	ToNonce     uint64
+	Addresses   [][]byte
+	Identifiers [][]byte
+	Topics      [][][]byte
+}
+
+// logsIndex keeps, for each block holding events, a bloom filter of the emitters, identifiers and topics together with
+// the keys of the block's logs. It also keeps secondary indexes from emitters and identifiers to the nonces of the
+// blocks holding such events. All records are saved in the epoch of the block, so they are pruned with the rest of
+// the storage.
+type logsIndex struct {
+	storer                   storage.Storer
+	blockHashByNonce         storage.Storer
+	epochByHashIndex         *epochByHashIndex
+	marshalizer              marshal.Marshalizer
+	hasher                   hashing.Hasher
+	uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+}
+
+func newLogsIndex(
+	storer storage.Storer,
+	blockHashByNonce storage.Storer,
+	epochByHashIndex *epochByHashIndex,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter,
+) *logsIndex {
+	return &logsIndex{
+		storer:                   storer,
+		blockHashByNonce:         blockHashByNonce,
+		epochByHashIndex:         epochByHashIndex,
+		marshalizer:              marshalizer,
+		hasher:                   hasher,
+		uint64ByteSliceConverter: uint64ByteSliceConverter,
+	}
+}
+
+func (li *logsIndex) saveBlock(headerHash []byte, header data.HeaderHandler, logs []*data.LogData) error {
+	nonce := header.GetNonce()
+	epoch := header.GetEpoch()
+
+	indexBlock := &LogsIndexBlock{
+		HeaderHash: headerHash,
+		Nonce:      nonce,
+		Epoch:      epoch,
+		Bloom:      make([]byte, logsBloomSizeInBytes),
+		LogsKeys:   make([][]byte, 0, len(logs)),
+	}
+	addresses := make(map[string]struct{})
+	identifiers := make(map[string]struct{})
+
+	for _, logData := range logs {
+		if logData == nil || logData.LogHandler == nil || logData.LogHandler.IsInterfaceNil() {
+			continue
+		}
+
+		events := logData.GetLogEvents()
+		if len(events) == 0 {
+			continue
+		}
+
+		indexBlock.LogsKeys = append(indexBlock.LogsKeys, []byte(logData.TxHash))
+		for _, event := range events {
+			if event == nil || event.IsInterfaceNil() {
+				continue
+			}
+
+			li.addToBloom(indexBlock.Bloom, logsIndexAddressPrefix, event.GetAddress())
+			li.addToBloom(indexBlock.Bloom, logsIndexIdentifierPrefix, event.GetIdentifier())
+			for _, topic := range event.GetTopics() {
+				li.addToBloom(indexBlock.Bloom, logsIndexTopicPrefix, topic)
+			}
+
+			addresses[string(event.GetAddress())] = struct{}{}
+			identifiers[string(event.GetIdentifier())] = struct{}{}
+		}
+	}
+
+	if len(indexBlock.LogsKeys) == 0 {
+		return nil
+	}
+
+	buff, err := li.marshalizer.Marshal(indexBlock)
+	if err != nil {
+		return err
+	}
+
+	err = li.storer.PutInEpoch(createLogsIndexKey(logsIndexBlockPrefix, headerHash), buff, epoch)
+	if err != nil {
+		return err
+	}
+
+	for address := range addresses {
+		li.addNonce(createLogsIndexBucketKey(logsIndexAddressPrefix, []byte(address), nonce), nonce, epoch)
+	}
+	for identifier := range identifiers {
+		li.addNonce(createLogsIndexBucketKey(logsIndexIdentifierPrefix, []byte(identifier), nonce), nonce, epoch)
+	}
+
+	return nil
+}
+
+// addNonce updates a record of the secondary indexes. The records are only hints, so the errors are just logged
+func (li *logsIndex) addNonce(key []byte, nonce uint64, epoch uint32) {
+	record := li.getNonces(key, epoch)
+	idx := sort.Search(len(record.Nonces), func(i int) bool {
+		return record.Nonces[i] >= nonce
+	})
+	if idx < len(record.Nonces) && record.Nonces[idx] == nonce {
+		return
+	}
+
+	record.Nonces = append(record.Nonces, 0)
+	copy(record.Nonces[idx+1:], record.Nonces[idx:])
+	record.Nonces[idx] = nonce
+
+	buff, err := li.marshalizer.Marshal(record)
+	if err != nil {
+		log.Warn("logsIndex.addNonce: cannot marshal record", "error", err)
+		return
+	}
+
+	err = li.storer.PutInEpoch(key, buff, epoch)
+	if err != nil {
+		logging.LogErrAsWarnExceptAsDebugIfClosingError(log, err, "logsIndex.addNonce: cannot save record",
+			"nonce", nonce, "epoch", epoch, "err", err)
+	}
+}
+
+func (li *logsIndex) getNonces(key []byte, epoch uint32) *LogsIndexNonces {
+	record := &LogsIndexNonces{}
+
+	buff, err := li.storer.GetFromEpoch(key, epoch)
+	if err != nil {
+		return record
+	}
+
+	err = li.marshalizer.Unmarshal(record, buff)
+	if err != nil {
+		return &LogsIndexNonces{}
+	}
+
+	return record
+}
+
+// filterBlocks returns the indexed blocks of the canonical chain that might hold events matching the filter
+func (li *logsIndex) filterBlocks(filter *LogsIndexFilter) ([]*LogsIndexBlock, error) {
+	if filter.FromNonce > filter.ToNonce {
+		return nil, fmt.Errorf("%w: from nonce %d is greater than to nonce %d", ErrInvalidNoncesRange, filter.FromNonce, filter.ToNonce)
+	}
+	if filter.ToNonce-filter.FromNonce >= maxLogsIndexNoncesRange {
+		return nil, fmt.Errorf("%w: maximum %d blocks", ErrNoncesRangeTooLarge, maxLogsIndexNoncesRange)
+	}
+
+	candidates, err := li.computeCandidateNonces(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make([]*LogsIndexBlock, 0)
+	for _, nonce := range candidates {
+		indexBlock, found := li.getBlockByNonce(nonce)
+		if !found {
+			continue
+		}
+
+		if li.bloomMatches(indexBlock.Bloom, filter) {
+			blocks = append(blocks, indexBlock)
+		}
+	}
+
+	return blocks, nil
+}
+
+func (li *logsIndex) computeCandidateNonces(filter *LogsIndexFilter) ([]uint64, error) {
+	if len(filter.Addresses) == 0 && len(filter.Identifiers) == 0 {
+		return noncesInRange(filter.FromNonce, filter.ToNonce), nil
+	}
+
+	epochs, found := li.computeEpochsOfRange(filter.FromNonce, filter.ToNonce)
+	if !found {
+		return make([]uint64, 0), nil
+	}
+
+	var candidates map[uint64]struct{}
+	if len(filter.Addresses) > 0 {
+		candidates = li.collectNonces(logsIndexAddressPrefix, filter.Addresses, filter, epochs)
+	}
+	if len(filter.Identifiers) > 0 {
+		noncesByIdentifiers := li.collectNonces(logsIndexIdentifierPrefix, filter.Identifiers, filter, epochs)
+		candidates = intersectNonces(candidates, noncesByIdentifiers)
+	}
+
+	result := make([]uint64, 0, len(candidates))
+	for nonce := range candidates {
+		result = append(result, nonce)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
+
+	return result, nil
+}
+
+func (li *logsIndex) collectNonces(prefix byte, values [][]byte, filter *LogsIndexFilter, epochs []uint32) map[uint64]struct{} {
+	nonces := make(map[uint64]struct{})
+	firstBucket := filter.FromNonce / logsIndexBucketSize
+	lastBucket := filter.ToNonce / logsIndexBucketSize
+
+	for _, value := range values {
+		for bucket := firstBucket; bucket <= lastBucket; bucket++ {
+			key := createLogsIndexBucketKey(prefix, value, bucket*logsIndexBucketSize)
+			for _, epoch := range epochs {
+				for _, nonce := range li.getNonces(key, epoch).Nonces {
+					if nonce >= filter.FromNonce && nonce <= filter.ToNonce {
+						nonces[nonce] = struct{}{}
+					}
+				}
+			}
+		}
+	}
+
+	return nonces
+}
+
+// computeEpochsOfRange returns the epochs of the blocks in the provided range, based on the first and the last blocks
+// of the range found in storage
+func (li *logsIndex) computeEpochsOfRange(fromNonce uint64, toNonce uint64) ([]uint32, bool) {
+	firstEpoch, found := uint32(0), false
+	for nonce := fromNonce; nonce <= toNonce && !found; nonce++ {
+		firstEpoch, found = li.getEpochByNonce(nonce)
+	}
+	if !found {
+		return nil, false
+	}
+
+	lastEpoch, found := uint32(0), false
+	for nonce := toNonce; nonce >= fromNonce && !found; nonce-- {
+		lastEpoch, found = li.getEpochByNonce(nonce)
+		if nonce == 0 {
+			break
+		}
+	}
+	if !found || lastEpoch < firstEpoch {
+		lastEpoch = firstEpoch
+	}
+
+	epochs := make([]uint32, 0, lastEpoch-firstEpoch+1)
+	for epoch := firstEpoch; epoch <= lastEpoch; epoch++ {
+		epochs = append(epochs, epoch)
+	}
+
+	return epochs, true
+}
+
+func (li *logsIndex) getEpochByNonce(nonce uint64) (uint32, bool) {
+	headerHash, err := li.blockHashByNonce.Get(li.uint64ByteSliceConverter.ToByteSlice(nonce))
+	if err != nil {
+		return 0, false
+	}
+
+	epoch, err := li.epochByHashIndex.getEpochByHash(headerHash)
+	if err != nil {
+		return 0, false
+	}
+
+	return epoch, true
+}
+
+func (li *logsIndex) getBlockByNonce(nonce uint64) (*LogsIndexBlock, bool) {
+	headerHash, err := li.blockHashByNonce.Get(li.uint64ByteSliceConverter.ToByteSlice(nonce))
+	if err != nil {
+		return nil, false
+	}
+
+	epoch, err := li.epochByHashIndex.getEpochByHash(headerHash)
+	if err != nil {
+		return nil, false
+	}
+
+	buff, err := li.storer.GetFromEpoch(createLogsIndexKey(logsIndexBlockPrefix, headerHash), epoch)
+	if err != nil {
+		return nil, false
+	}
+
+	indexBlock := &LogsIndexBlock{}
+	err = li.marshalizer.Unmarshal(indexBlock, buff)
+	if err != nil {
+		log.Debug("logsIndex.getBlockByNonce: cannot unmarshal record", "nonce", nonce, "error", err)
+		return nil, false
+	}
+
+	return indexBlock, true
+}
+
+func (li *logsIndex) bloomMatches(bloom []byte, filter *LogsIndexFilter) bool {
+	if !li.bloomContainsAny(bloom, logsIndexAddressPrefix, filter.Addresses) {
+		return false
+	}
+	if !li.bloomContainsAny(bloom, logsIndexIdentifierPrefix, filter.Identifiers) {
+		return false
+	}
+	for _, topicValues := range filter.Topics {
+		if !li.bloomContainsAny(bloom, logsIndexTopicPrefix, topicValues) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (li *logsIndex) bloomContainsAny(bloom []byte, prefix byte, values [][]byte) bool {
+	if len(values) == 0 {
+		return true
+	}
+
+	for _, value := range values {
+		if li.bloomContains(bloom, prefix, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (li *logsIndex) addToBloom(bloom []byte, prefix byte, value []byte) {
+	for _, bit := range li.computeBloomBits(prefix, value) {
+		bloom[bit/8] |= 1 << (bit % 8)
+	}
+}
+
+func (li *logsIndex) bloomContains(bloom []byte, prefix byte, value []byte) bool {
+	if len(bloom) != logsBloomSizeInBytes {
+		return true
+	}
+
+	for _, bit := range li.computeBloomBits(prefix, value) {
+		if bloom[bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (li *logsIndex) computeBloomBits(prefix byte, value []byte) [logsBloomNumHashes]uint16 {
+	hash := li.hasher.Compute(string(createLogsIndexKey(prefix, value)))
+
+	bits := [logsBloomNumHashes]uint16{}
+	for i := 0; i < logsBloomNumHashes; i++ {
+		bits[i] = binary.BigEndian.Uint16(hash[2*i:]) % logsBloomNumBits
+	}
+
+	return bits
+}
+
+func createLogsIndexKey(prefix byte, value []byte) []byte {
+	key := make([]byte, 0, len(value)+1)
+	key = append(key, prefix)
+	return append(key, value...)
+}
+
+func createLogsIndexBucketKey(prefix byte, value []byte, nonce uint64) []byte {
+	key := make([]byte, 0, len(value)+9)
+	key = append(key, prefix)
+	key = binary.BigEndian.AppendUint64(key, nonce/logsIndexBucketSize)
+	return append(key, value...)
+}
+
+func noncesInRange(fromNonce uint64, toNonce uint64) []uint64 {
+	nonces := make([]uint64, 0, toNonce-fromNonce+1)
+	for nonce := fromNonce; ; nonce++ {
+		nonces = append(nonces, nonce)
+		if nonce == toNonce {
+			break
+		}
+	}
+
+	return nonces
+}
+
+func intersectNonces(first map[uint64]struct{}, second map[uint64]struct{}) map[uint64]struct{} {
+	if first == nil {
+		return second
+	}
+
+	result := make(map[uint64]struct{})
+	for nonce := range first {
+		_, found := second[nonce]
+		if found {
+			result[nonce] = struct{}{}
+		}
+	}
+
+	return result
+}
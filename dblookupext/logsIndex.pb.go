@@ -0,0 +1,866 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: logsIndex.proto
+
+package dblookupext
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LogsIndexBlock is used to store the bloom filter and the logs keys of a block
+type LogsIndexBlock struct {
+	HeaderHash []byte   `protobuf:"bytes,1,opt,name=HeaderHash,proto3" json:"HeaderHash,omitempty"`
+	Nonce      uint64   `protobuf:"varint,2,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Epoch      uint32   `protobuf:"varint,3,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	Bloom      []byte   `protobuf:"bytes,4,opt,name=Bloom,proto3" json:"Bloom,omitempty"`
+	LogsKeys   [][]byte `protobuf:"bytes,5,rep,name=LogsKeys,proto3" json:"LogsKeys,omitempty"`
+}
+
+func (m *LogsIndexBlock) Reset()      { *m = LogsIndexBlock{} }
+func (*LogsIndexBlock) ProtoMessage() {}
+func (*LogsIndexBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505aca59a81bc846, []int{0}
+}
+func (m *LogsIndexBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogsIndexBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LogsIndexBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogsIndexBlock.Merge(m, src)
+}
+func (m *LogsIndexBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogsIndexBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogsIndexBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogsIndexBlock proto.InternalMessageInfo
+
+func (m *LogsIndexBlock) GetHeaderHash() []byte {
+	if m != nil {
+		return m.HeaderHash
+	}
+	return nil
+}
+
+func (m *LogsIndexBlock) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *LogsIndexBlock) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *LogsIndexBlock) GetBloom() []byte {
+	if m != nil {
+		return m.Bloom
+	}
+	return nil
+}
+
+func (m *LogsIndexBlock) GetLogsKeys() [][]byte {
+	if m != nil {
+		return m.LogsKeys
+	}
+	return nil
+}
+
+// LogsIndexNonces is used to store the nonces of the blocks holding events for a given address or identifier
+type LogsIndexNonces struct {
+	Nonces []uint64 `protobuf:"varint,1,rep,packed,name=Nonces,proto3" json:"Nonces,omitempty"`
+}
+
+func (m *LogsIndexNonces) Reset()      { *m = LogsIndexNonces{} }
+func (*LogsIndexNonces) ProtoMessage() {}
+func (*LogsIndexNonces) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505aca59a81bc846, []int{1}
+}
+func (m *LogsIndexNonces) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogsIndexNonces) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LogsIndexNonces) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogsIndexNonces.Merge(m, src)
+}
+func (m *LogsIndexNonces) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogsIndexNonces) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogsIndexNonces.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogsIndexNonces proto.InternalMessageInfo
+
+func (m *LogsIndexNonces) GetNonces() []uint64 {
+	if m != nil {
+		return m.Nonces
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*LogsIndexBlock)(nil), "proto.LogsIndexBlock")
+	proto.RegisterType((*LogsIndexNonces)(nil), "proto.LogsIndexNonces")
+}
+
+func init() { proto.RegisterFile("logsIndex.proto", fileDescriptor_505aca59a81bc846) }
+
+var fileDescriptor_505aca59a81bc846 = []byte{
+	// 277 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xb1, 0x4a, 0xc3, 0x50,
+	0x14, 0x86, 0xef, 0x31, 0x49, 0x91, 0x6b, 0xb5, 0x10, 0x44, 0x2e, 0x1d, 0x0e, 0xa1, 0x53, 0x1c,
+	0x6c, 0x07, 0xdf, 0x20, 0x50, 0xa8, 0x28, 0x0e, 0x19, 0xdd, 0x9a, 0xe4, 0x9a, 0x48, 0xd3, 0x9e,
+	0xd0, 0x24, 0x50, 0x37, 0x9f, 0x40, 0x7c, 0x0c, 0x1f, 0xc5, 0x31, 0x63, 0x46, 0x73, 0xb3, 0x38,
+	0xf6, 0x11, 0x24, 0x37, 0x5a, 0x3a, 0xdd, 0xff, 0xfb, 0xe0, 0xfe, 0xe7, 0x70, 0xf8, 0x28, 0xa5,
+	0x38, 0xbf, 0xdb, 0x44, 0x72, 0x37, 0xcd, 0xb6, 0x54, 0x90, 0x6d, 0xe9, 0x67, 0x7c, 0x13, 0xbf,
+	0x14, 0x49, 0x19, 0x4c, 0x43, 0x5a, 0xcf, 0x62, 0x8a, 0x69, 0xa6, 0x75, 0x50, 0x3e, 0x6b, 0xd2,
+	0xa0, 0x53, 0xff, 0x6b, 0xf2, 0x0e, 0xfc, 0xe2, 0xe1, 0xbf, 0xc9, 0x4b, 0x29, 0x5c, 0xd9, 0xc8,
+	0xf9, 0x42, 0x2e, 0x23, 0xb9, 0x5d, 0x2c, 0xf3, 0x44, 0x80, 0x03, 0xee, 0xd0, 0x3f, 0x32, 0xf6,
+	0x25, 0xb7, 0x1e, 0x69, 0x13, 0x4a, 0x71, 0xe2, 0x80, 0x6b, 0xfa, 0x3d, 0x74, 0x76, 0x9e, 0x51,
+	0x98, 0x08, 0xc3, 0x01, 0xf7, 0xdc, 0xef, 0xa1, 0xb3, 0x5e, 0x4a, 0xb4, 0x16, 0xa6, 0xae, 0xe9,
+	0xc1, 0x1e, 0xf3, 0xd3, 0x6e, 0xe6, 0xbd, 0x7c, 0xcd, 0x85, 0xe5, 0x18, 0xee, 0xd0, 0x3f, 0xf0,
+	0xe4, 0x9a, 0x8f, 0x0e, 0xfb, 0xe8, 0xe6, 0xdc, 0xbe, 0xe2, 0x83, 0x3e, 0x09, 0x70, 0x0c, 0xd7,
+	0xf4, 0xff, 0xc8, 0x9b, 0x57, 0x0d, 0xb2, 0xba, 0x41, 0xb6, 0x6f, 0x10, 0xde, 0x14, 0xc2, 0xa7,
+	0x42, 0xf8, 0x52, 0x08, 0x95, 0x42, 0xa8, 0x15, 0xc2, 0xb7, 0x42, 0xf8, 0x51, 0xc8, 0xf6, 0x0a,
+	0xe1, 0xa3, 0x45, 0x56, 0xb5, 0xc8, 0xea, 0x16, 0xd9, 0xd3, 0x59, 0x14, 0xa4, 0x44, 0xab, 0x32,
+	0x93, 0xbb, 0x22, 0x18, 0xe8, 0x4b, 0xdc, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xea, 0x0c,
+	0x6e, 0x52, 0x01, 0x00, 0x00,
+}
+
+func (this *LogsIndexBlock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LogsIndexBlock)
+	if !ok {
+		that2, ok := that.(LogsIndexBlock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.HeaderHash, that1.HeaderHash) {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if !bytes.Equal(this.Bloom, that1.Bloom) {
+		return false
+	}
+	if len(this.LogsKeys) != len(that1.LogsKeys) {
+		return false
+	}
+	for i := range this.LogsKeys {
+		if !bytes.Equal(this.LogsKeys[i], that1.LogsKeys[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *LogsIndexNonces) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LogsIndexNonces)
+	if !ok {
+		that2, ok := that.(LogsIndexNonces)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Nonces) != len(that1.Nonces) {
+		return false
+	}
+	for i := range this.Nonces {
+		if this.Nonces[i] != that1.Nonces[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *LogsIndexBlock) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&dblookupext.LogsIndexBlock{")
+	s = append(s, "HeaderHash: "+fmt.Sprintf("%#v", this.HeaderHash)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "Bloom: "+fmt.Sprintf("%#v", this.Bloom)+",\n")
+	s = append(s, "LogsKeys: "+fmt.Sprintf("%#v", this.LogsKeys)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *LogsIndexNonces) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&dblookupext.LogsIndexNonces{")
+	s = append(s, "Nonces: "+fmt.Sprintf("%#v", this.Nonces)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringLogsIndex(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *LogsIndexBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogsIndexBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogsIndexBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LogsKeys) > 0 {
+		for iNdEx := len(m.LogsKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LogsKeys[iNdEx])
+			copy(dAtA[i:], m.LogsKeys[iNdEx])
+			i = encodeVarintLogsIndex(dAtA, i, uint64(len(m.LogsKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Bloom) > 0 {
+		i -= len(m.Bloom)
+		copy(dAtA[i:], m.Bloom)
+		i = encodeVarintLogsIndex(dAtA, i, uint64(len(m.Bloom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintLogsIndex(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintLogsIndex(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.HeaderHash) > 0 {
+		i -= len(m.HeaderHash)
+		copy(dAtA[i:], m.HeaderHash)
+		i = encodeVarintLogsIndex(dAtA, i, uint64(len(m.HeaderHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogsIndexNonces) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogsIndexNonces) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogsIndexNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nonces) > 0 {
+		dAtA2 := make([]byte, len(m.Nonces)*10)
+		var j1 int
+		for _, num := range m.Nonces {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintLogsIndex(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLogsIndex(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLogsIndex(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LogsIndexBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HeaderHash)
+	if l > 0 {
+		n += 1 + l + sovLogsIndex(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovLogsIndex(uint64(m.Nonce))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovLogsIndex(uint64(m.Epoch))
+	}
+	l = len(m.Bloom)
+	if l > 0 {
+		n += 1 + l + sovLogsIndex(uint64(l))
+	}
+	if len(m.LogsKeys) > 0 {
+		for _, b := range m.LogsKeys {
+			l = len(b)
+			n += 1 + l + sovLogsIndex(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LogsIndexNonces) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nonces) > 0 {
+		l = 0
+		for _, e := range m.Nonces {
+			l += sovLogsIndex(uint64(e))
+		}
+		n += 1 + sovLogsIndex(uint64(l)) + l
+	}
+	return n
+}
+
+func sovLogsIndex(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLogsIndex(x uint64) (n int) {
+	return sovLogsIndex(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *LogsIndexBlock) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LogsIndexBlock{`,
+		`HeaderHash:` + fmt.Sprintf("%v", this.HeaderHash) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`Bloom:` + fmt.Sprintf("%v", this.Bloom) + `,`,
+		`LogsKeys:` + fmt.Sprintf("%v", this.LogsKeys) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LogsIndexNonces) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LogsIndexNonces{`,
+		`Nonces:` + fmt.Sprintf("%v", this.Nonces) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringLogsIndex(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *LogsIndexBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogsIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogsIndexBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogsIndexBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLogsIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderHash = append(m.HeaderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeaderHash == nil {
+				m.HeaderHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bloom", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLogsIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bloom = append(m.Bloom[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bloom == nil {
+				m.Bloom = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogsKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLogsIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogsKeys = append(m.LogsKeys, make([]byte, postIndex-iNdEx))
+			copy(m.LogsKeys[len(m.LogsKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogsIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLogsIndex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLogsIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogsIndexNonces) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogsIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogsIndexNonces: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogsIndexNonces: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLogsIndex
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Nonces = append(m.Nonces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLogsIndex
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLogsIndex
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLogsIndex
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Nonces) == 0 {
+					m.Nonces = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogsIndex
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Nonces = append(m.Nonces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogsIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLogsIndex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLogsIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLogsIndex(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLogsIndex
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLogsIndex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLogsIndex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLogsIndex
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLogsIndex
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLogsIndex
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLogsIndex        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLogsIndex          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLogsIndex = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,190 @@
+package dblookupext
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
+	"github.com/multiversx/mx-chain-go/common/mock"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/stretchr/testify/require"
+)
+
+type logsIndexTestContext struct {
+	index            *logsIndex
+	blockHashByNonce *genericMocks.StorerMock
+	epochByHash      *epochByHashIndex
+	storer           *genericMocks.StorerMock
+}
+
+func createLogsIndexTestContext() *logsIndexTestContext {
+	marshalizer := &mock.MarshalizerMock{}
+	storer := genericMocks.NewStorerMockWithEpoch(0)
+	blockHashByNonce := genericMocks.NewStorerMockWithEpoch(0)
+	epochByHash := newHashToEpochIndex(genericMocks.NewStorerMockWithEpoch(0), marshalizer)
+
+	return &logsIndexTestContext{
+		index: newLogsIndex(
+			storer,
+			blockHashByNonce,
+			epochByHash,
+			marshalizer,
+			&hashingMocks.HasherMock{},
+			uint64ByteSlice.NewBigEndianConverter(),
+		),
+		blockHashByNonce: blockHashByNonce,
+		epochByHash:      epochByHash,
+		storer:           storer,
+	}
+}
+
+func (tc *logsIndexTestContext) saveBlock(t *testing.T, nonce uint64, epoch uint32, logs []*data.LogData) []byte {
+	headerHash := []byte(fmt.Sprintf("header-%d", nonce))
+	header := &block.Header{Nonce: nonce, Epoch: epoch}
+
+	err := tc.epochByHash.saveEpochByHash(headerHash, epoch)
+	require.Nil(t, err)
+	err = tc.blockHashByNonce.Put(uint64ByteSlice.NewBigEndianConverter().ToByteSlice(nonce), headerHash)
+	require.Nil(t, err)
+	err = tc.index.saveBlock(headerHash, header, logs)
+	require.Nil(t, err)
+
+	return headerHash
+}
+
+func createLogData(txHash string, events ...*transaction.Event) *data.LogData {
+	return &data.LogData{
+		LogHandler: &transaction.Log{Events: events},
+		TxHash:     txHash,
+	}
+}
+
+func TestLogsIndex_SaveBlockWithoutEventsShouldNotSaveRecord(t *testing.T) {
+	t.Parallel()
+
+	tc := createLogsIndexTestContext()
+	headerHash := tc.saveBlock(t, 1, 0, []*data.LogData{
+		nil,
+		{TxHash: "tx"},
+		createLogData("tx"),
+	})
+
+	_, err := tc.storer.GetFromEpoch(createLogsIndexKey(logsIndexBlockPrefix, headerHash), 0)
+	require.NotNil(t, err)
+}
+
+func TestLogsIndex_SaveBlockShouldSaveRecordAndPostings(t *testing.T) {
+	t.Parallel()
+
+	tc := createLogsIndexTestContext()
+	event := &transaction.Event{Address: []byte("alice"), Identifier: []byte("transfer"), Topics: [][]byte{[]byte("bob")}}
+	headerHash := tc.saveBlock(t, 1001, 2, []*data.LogData{createLogData("tx1", event), createLogData("tx2", event)})
+
+	blocks, err := tc.index.filterBlocks(&LogsIndexFilter{FromNonce: 1001, ToNonce: 1001})
+	require.Nil(t, err)
+	require.Len(t, blocks, 1)
+	require.Equal(t, headerHash, blocks[0].HeaderHash)
+	require.Equal(t, uint64(1001), blocks[0].Nonce)
+	require.Equal(t, uint32(2), blocks[0].Epoch)
+	require.Equal(t, [][]byte{[]byte("tx1"), []byte("tx2")}, blocks[0].LogsKeys)
+
+	nonces := tc.index.getNonces(createLogsIndexBucketKey(logsIndexAddressPrefix, []byte("alice"), 1001), 2)
+	require.Equal(t, []uint64{1001}, nonces.Nonces)
+	nonces = tc.index.getNonces(createLogsIndexBucketKey(logsIndexIdentifierPrefix, []byte("transfer"), 1001), 2)
+	require.Equal(t, []uint64{1001}, nonces.Nonces)
+}
+
+func TestLogsIndex_AddNonceShouldKeepPostingsSortedAndUnique(t *testing.T) {
+	t.Parallel()
+
+	tc := createLogsIndexTestContext()
+	key := createLogsIndexBucketKey(logsIndexAddressPrefix, []byte("alice"), 0)
+	for _, nonce := range []uint64{5, 2, 9, 5, 2, 7} {
+		tc.index.addNonce(key, nonce, 0)
+	}
+
+	require.Equal(t, []uint64{2, 5, 7, 9}, tc.index.getNonces(key, 0).Nonces)
+}
+
+func TestLogsIndex_FilterBlocks(t *testing.T) {
+	t.Parallel()
+
+	tc := createLogsIndexTestContext()
+	transferFromAlice := &transaction.Event{Address: []byte("alice"), Identifier: []byte("transfer"), Topics: [][]byte{[]byte("t1"), []byte("t2")}}
+	transferFromBob := &transaction.Event{Address: []byte("bob"), Identifier: []byte("transfer"), Topics: [][]byte{[]byte("t3")}}
+	burnFromAlice := &transaction.Event{Address: []byte("alice"), Identifier: []byte("burn")}
+
+	tc.saveBlock(t, 10, 0, []*data.LogData{createLogData("tx10", transferFromAlice)})
+	tc.saveBlock(t, 11, 0, []*data.LogData{createLogData("tx11", transferFromBob)})
+	tc.saveBlock(t, 12, 0, nil)
+	tc.saveBlock(t, 999, 1, []*data.LogData{createLogData("tx999", burnFromAlice)})
+	tc.saveBlock(t, 1000, 1, []*data.LogData{createLogData("tx1000", transferFromBob, burnFromAlice)})
+
+	filterNonces := func(filter *LogsIndexFilter) []uint64 {
+		blocks, err := tc.index.filterBlocks(filter)
+		require.Nil(t, err)
+
+		nonces := make([]uint64, 0, len(blocks))
+		for _, indexBlock := range blocks {
+			nonces = append(nonces, indexBlock.Nonce)
+		}
+		return nonces
+	}
+
+	t.Run("invalid range should error", func(t *testing.T) {
+		blocks, err := tc.index.filterBlocks(&LogsIndexFilter{FromNonce: 2, ToNonce: 1})
+		require.ErrorIs(t, err, ErrInvalidNoncesRange)
+		require.Nil(t, blocks)
+	})
+	t.Run("range too large should error", func(t *testing.T) {
+		blocks, err := tc.index.filterBlocks(&LogsIndexFilter{FromNonce: 0, ToNonce: maxLogsIndexNoncesRange})
+		require.ErrorIs(t, err, ErrNoncesRangeTooLarge)
+		require.Nil(t, blocks)
+	})
+	t.Run("empty filter should return all blocks with events", func(t *testing.T) {
+		require.Equal(t, []uint64{10, 11, 999, 1000}, filterNonces(&LogsIndexFilter{FromNonce: 0, ToNonce: 2000}))
+		require.Equal(t, []uint64{11}, filterNonces(&LogsIndexFilter{FromNonce: 11, ToNonce: 998}))
+	})
+	t.Run("by address", func(t *testing.T) {
+		require.Equal(t, []uint64{10, 999, 1000}, filterNonces(&LogsIndexFilter{FromNonce: 0, ToNonce: 2000, Addresses: [][]byte{[]byte("alice")}}))
+		require.Equal(t, []uint64{10, 11, 999, 1000}, filterNonces(&LogsIndexFilter{FromNonce: 0, ToNonce: 2000, Addresses: [][]byte{[]byte("alice"), []byte("bob")}}))
+		require.Empty(t, filterNonces(&LogsIndexFilter{FromNonce: 0, ToNonce: 2000, Addresses: [][]byte{[]byte("carol")}}))
+	})
+	t.Run("by address and identifier", func(t *testing.T) {
+		filter := &LogsIndexFilter{
+			FromNonce:   0,
+			ToNonce:     2000,
+			Addresses:   [][]byte{[]byte("bob")},
+			Identifiers: [][]byte{[]byte("transfer")},
+		}
+		require.Equal(t, []uint64{11, 1000}, filterNonces(filter))
+
+		filter.Identifiers = [][]byte{[]byte("burn")}
+		// block 1000 holds a burn event from alice, the exact match is done by the caller when loading the logs
+		require.Equal(t, []uint64{1000}, filterNonces(filter))
+	})
+	t.Run("by topics", func(t *testing.T) {
+		filter := &LogsIndexFilter{
+			FromNonce: 0,
+			ToNonce:   2000,
+			Topics:    [][][]byte{{[]byte("t1")}, {[]byte("t2")}},
+		}
+		require.Equal(t, []uint64{10}, filterNonces(filter))
+
+		filter.Topics = [][][]byte{{[]byte("t1"), []byte("t3")}}
+		require.Equal(t, []uint64{10, 11, 1000}, filterNonces(filter))
+	})
+	t.Run("non canonical block should be skipped", func(t *testing.T) {
+		tcFork := createLogsIndexTestContext()
+		tcFork.saveBlock(t, 5, 0, []*data.LogData{createLogData("tx5", transferFromAlice)})
+		_ = tcFork.blockHashByNonce.Put(uint64ByteSlice.NewBigEndianConverter().ToByteSlice(5), []byte("other header"))
+
+		blocks, err := tcFork.index.filterBlocks(&LogsIndexFilter{FromNonce: 0, ToNonce: 10, Addresses: [][]byte{[]byte("alice")}})
+		require.Nil(t, err)
+		require.Empty(t, blocks)
+	})
+}
@@ -1109,6 +1109,12 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 	trieStorageManager := e.trieStorageManagers[dataRetriever.UserAccountsUnit.String()]
 	e.mutTrieStorageManagers.RUnlock()
 
+	checkNodesOnDisk := e.checkNodesOnDisk
+	if e.importStateSnapshot(rootHash, trieStorageManager) {
+		// the syncer walks the imported tries from the disk, requesting only the nodes not found there
+		checkNodesOnDisk = true
+	}
+
 	argsUserAccountsSyncer := syncer.ArgsNewUserAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:                            e.coreComponentsHolder.Hasher(),
@@ -1120,7 +1126,7 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 			MaxTrieLevelInMemory:              e.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
 			MaxHardCapForMissingNodes:         e.maxHardCapForMissingNodes,
 			TrieSyncerVersion:                 e.trieSyncerVersion,
-			CheckNodesOnDisk:                  e.checkNodesOnDisk,
+			CheckNodesOnDisk:                  checkNodesOnDisk,
 			UserAccountsSyncStatisticsHandler: e.trieSyncStatisticsProvider,
 			AppStatusHandler:                  e.statusHandler,
 			EnableEpochsHandler:               e.coreComponentsHolder.EnableEpochsHandler(),
@@ -0,0 +1,51 @@
+package bootstrap
+
+import (
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state/snapshotFile"
+)
+
+// importStateSnapshot imports the accounts trie and its data tries from the state snapshot file provided by the
+// operator, if any, returning true if the import succeeded. An unusable file is only logged, as the state can still
+// be synced from the network
+func (e *epochStartBootstrap) importStateSnapshot(rootHash []byte, trieStorageManager common.StorageManager) bool {
+	filePath := e.flagsConfig.ImportStateSnapshotFile
+	if len(filePath) == 0 {
+		return false
+	}
+
+	log.Info("start in epoch bootstrap: importing the state snapshot file", "file", filePath, "rootHash", rootHash)
+	err := importStateSnapshotFile(filePath, rootHash, trieStorageManager, e.coreComponentsHolder.Hasher())
+	if err != nil {
+		log.Warn("could not import the state snapshot file, the state will be synced from the network",
+			"file", filePath, "rootHash", rootHash, "error", err)
+		return false
+	}
+
+	return true
+}
+
+func importStateSnapshotFile(filePath string, rootHash []byte, storer common.BaseStorer, hasher hashing.Hasher) error {
+	importer, err := snapshotFile.NewSnapshotFileImporter(snapshotFile.ArgsSnapshotFileImporter{
+		Storer: storer,
+		Hasher: hasher,
+	})
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(file.Close())
+	}()
+
+	_, err = importer.Import(file, rootHash)
+
+	return err
+}
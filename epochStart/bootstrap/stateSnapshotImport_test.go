@@ -0,0 +1,109 @@
+package bootstrap
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/epochStart/mock"
+	"github.com/multiversx/mx-chain-go/state/snapshotFile"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	storageMock "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/testscommon/storageManager"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestStateSnapshotFile(t *testing.T) (string, []byte) {
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	hasher := &hashingMocks.HasherMock{}
+	trieStorage, err := trie.NewTrieStorageManager(storageMock.GetStorageManagerArgs())
+	require.Nil(t, err)
+	tr, err := trie.NewTrie(trieStorage, marshaller, hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+	require.Nil(t, err)
+	require.Nil(t, tr.Update([]byte("key1"), []byte("value1")))
+	require.Nil(t, tr.Update([]byte("key2"), []byte("value2")))
+	require.Nil(t, tr.Commit())
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+
+	writer, err := snapshotFile.NewSnapshotFileWriter(snapshotFile.ArgsSnapshotFileWriter{
+		Storer:       trieStorage,
+		Marshalizer:  marshaller,
+		Hasher:       hasher,
+		MaxChunkSize: 1024,
+	})
+	require.Nil(t, err)
+
+	filePath := filepath.Join(t.TempDir(), "state.bin")
+	file, err := os.Create(filePath)
+	require.Nil(t, err)
+	_, err = writer.Write(file, rootHash, 0, 1)
+	require.Nil(t, err)
+	require.Nil(t, file.Close())
+
+	return filePath, rootHash
+}
+
+func createEpochStartBootstrapForStateImport(filePath string) *epochStartBootstrap {
+	return &epochStartBootstrap{
+		flagsConfig: config.ContextFlagsConfig{
+			ImportStateSnapshotFile: filePath,
+		},
+		coreComponentsHolder: &mock.CoreComponentsMock{
+			Hash: &hashingMocks.HasherMock{},
+		},
+	}
+}
+
+func TestEpochStartBootstrap_ImportStateSnapshot(t *testing.T) {
+	t.Parallel()
+
+	filePath, rootHash := writeTestStateSnapshotFile(t)
+
+	t.Run("no file provided should not import", func(t *testing.T) {
+		t.Parallel()
+
+		e := createEpochStartBootstrapForStateImport("")
+		trieStorageManager := &storageManager.StorageManagerStub{
+			PutCalled: func(_ []byte, _ []byte) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		assert.False(t, e.importStateSnapshot(rootHash, trieStorageManager))
+	})
+	t.Run("missing file should not import", func(t *testing.T) {
+		t.Parallel()
+
+		e := createEpochStartBootstrapForStateImport(filepath.Join(t.TempDir(), "missing.bin"))
+		assert.False(t, e.importStateSnapshot(rootHash, &storageManager.StorageManagerStub{}))
+	})
+	t.Run("file written for another root hash should not import", func(t *testing.T) {
+		t.Parallel()
+
+		storer := testscommon.NewMemDbMock()
+		e := createEpochStartBootstrapForStateImport(filePath)
+		assert.False(t, e.importStateSnapshot([]byte("another root hash"), &storageManager.StorageManagerStub{
+			PutCalled: storer.Put,
+		}))
+		_, err := storer.Get(rootHash)
+		assert.NotNil(t, err)
+	})
+	t.Run("should import", func(t *testing.T) {
+		t.Parallel()
+
+		storer := testscommon.NewMemDbMock()
+		e := createEpochStartBootstrapForStateImport(filePath)
+		assert.True(t, e.importStateSnapshot(rootHash, &storageManager.StorageManagerStub{
+			PutCalled: storer.Put,
+		}))
+		_, err := storer.Get(rootHash)
+		assert.Nil(t, err)
+	})
+}
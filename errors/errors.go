@@ -598,3 +598,6 @@ var ErrNilSentSignatureTracker = errors.New("nil sent signature tracker")
 
 // ErrNilEpochSystemSCProcessor defines the error for setting a nil EpochSystemSCProcessor
 var ErrNilEpochSystemSCProcessor = errors.New("nil epoch system SC processor")
+
+// ErrNilSubscriptionsHandler signals that a nil subscriptions handler has been provided
+var ErrNilSubscriptionsHandler = errors.New("nil subscriptions handler")
@@ -151,6 +151,11 @@ func (inf *initialNodeFacade) ValidateTransactionForSimulation(_ *transaction.Tr
 	return errNodeStarting
 }
 
+// ValidateTransactionForSimulationWithStateOverrides returns error
+func (inf *initialNodeFacade) ValidateTransactionForSimulationWithStateOverrides(_ *transaction.Transaction, _ bool, _ []*txSimData.StateOverride) error {
+	return errNodeStarting
+}
+
 // ValidatorStatisticsApi returns nil and error
 func (inf *initialNodeFacade) ValidatorStatisticsApi() (map[string]*validator.ValidatorStatistics, error) {
 	return nil, errNodeStarting
@@ -171,6 +176,21 @@ func (inf *initialNodeFacade) SimulateTransactionExecution(_ *transaction.Transa
 	return nil, errNodeStarting
 }
 
+// SimulateTransactionExecutionWithStateOverrides returns nil and error
+func (inf *initialNodeFacade) SimulateTransactionExecutionWithStateOverrides(_ *transaction.Transaction, _ []*txSimData.StateOverride) (*txSimData.SimulationResultsWithVMOutput, error) {
+	return nil, errNodeStarting
+}
+
+// TraceTransactionExecution returns nil and error
+func (inf *initialNodeFacade) TraceTransactionExecution(_ *transaction.Transaction, _ []*txSimData.StateOverride, _ core.OptionalUint64) (*txSimData.ExecutionTrace, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionExecutionTrace returns nil and error
+func (inf *initialNodeFacade) GetTransactionExecutionTrace(_ string) (*txSimData.ExecutionTrace, error) {
+	return nil, errNodeStarting
+}
+
 // GetTransaction returns nil and error
 func (inf *initialNodeFacade) GetTransaction(_ string, _ bool) (*transaction.ApiTransactionResult, error) {
 	return nil, errNodeStarting
@@ -181,6 +201,11 @@ func (inf *initialNodeFacade) ComputeTransactionGasLimit(_ *transaction.Transact
 	return nil, errNodeStarting
 }
 
+// ComputeTransactionGasLimitWithStateOverrides returns nil and error
+func (inf *initialNodeFacade) ComputeTransactionGasLimitWithStateOverrides(_ *transaction.Transaction, _ []*txSimData.StateOverride) (*transaction.CostResponse, error) {
+	return nil, errNodeStarting
+}
+
 // GetAccount returns nil and error
 func (inf *initialNodeFacade) GetAccount(_ string, _ api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
 	return api.AccountResponse{}, api.BlockInfo{}, errNodeStarting
@@ -221,6 +246,11 @@ func (inf *initialNodeFacade) ExecuteSCQuery(_ *process.SCQuery) (*vm.VMOutputAp
 	return nil, api.BlockInfo{}, errNodeStarting
 }
 
+// ExecuteSCQueries returns nil and error
+func (inf *initialNodeFacade) ExecuteSCQueries(_ []*process.SCQuery) ([]*common.SCQueryResultAPI, api.BlockInfo, error) {
+	return nil, api.BlockInfo{}, errNodeStarting
+}
+
 // PprofEnabled returns false
 func (inf *initialNodeFacade) PprofEnabled() bool {
 	return inf.pprofEnabled
@@ -406,6 +436,11 @@ func (inf *initialNodeFacade) GetTransactionsPoolNonceGapsForSender(_ string) (*
 	return nil, errNodeStarting
 }
 
+// GetTransactionsByAddress returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionsByAddress(_ string, _ uint64, _ uint32, _ uint32) (*common.AccountTransactionsAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
 // GetTransactionsPoolForSender returns a nil structure and error
 func (inf *initialNodeFacade) GetTransactionsPoolForSender(_, _ string) (*common.TransactionsPoolForSenderApiResponse, error) {
 	return nil, errNodeStarting
@@ -456,6 +491,46 @@ func (inf *initialNodeFacade) GetWaitingEpochsLeftForPublicKey(_ string) (uint32
 	return 0, errNodeStarting
 }
 
+// AddManagedKey returns empty string and error
+func (inf *initialNodeFacade) AddManagedKey(_ string) (string, error) {
+	return "", errNodeStarting
+}
+
+// RemoveManagedKey returns error
+func (inf *initialNodeFacade) RemoveManagedKey(_ string) error {
+	return errNodeStarting
+}
+
+// PauseManagedKey returns error
+func (inf *initialNodeFacade) PauseManagedKey(_ string) error {
+	return errNodeStarting
+}
+
+// ResumeManagedKey returns error
+func (inf *initialNodeFacade) ResumeManagedKey(_ string) error {
+	return errNodeStarting
+}
+
+// StartTrieVerification returns error
+func (inf *initialNodeFacade) StartTrieVerification(_ string, _ bool) error {
+	return errNodeStarting
+}
+
+// GetTrieVerificationStatus returns empty status and error
+func (inf *initialNodeFacade) GetTrieVerificationStatus() (common.TrieVerificationStatus, error) {
+	return common.TrieVerificationStatus{}, errNodeStarting
+}
+
+// Subscribe returns nil and error
+func (inf *initialNodeFacade) Subscribe(_ common.SubscriptionFilter) (common.Subscription, error) {
+	return nil, errNodeStarting
+}
+
+// GetEvents returns nil and error
+func (inf *initialNodeFacade) GetEvents(_ common.EventsQuery) ([]*common.ApiEvent, error) {
+	return nil, errNodeStarting
+}
+
 // P2PPrometheusMetricsEnabled returns either the p2p prometheus metrics are enabled or not
 func (inf *initialNodeFacade) P2PPrometheusMetricsEnabled() bool {
 	return inf.p2pPrometheusMetricsEnabled
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/facade"
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/testscommon"
@@ -91,6 +93,9 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	err = inf.ValidateTransactionForSimulation(nil, false)
 	assert.Equal(t, errNodeStarting, err)
 
+	err = inf.ValidateTransactionForSimulationWithStateOverrides(nil, false, nil)
+	assert.Equal(t, errNodeStarting, err)
+
 	v1, err := inf.ValidatorStatisticsApi()
 	assert.Nil(t, v1)
 	assert.Equal(t, errNodeStarting, err)
@@ -115,6 +120,22 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, resp)
 	assert.Equal(t, errNodeStarting, err)
 
+	u3, err := inf.SimulateTransactionExecutionWithStateOverrides(nil, nil)
+	assert.Nil(t, u3)
+	assert.Equal(t, errNodeStarting, err)
+
+	resp, err = inf.ComputeTransactionGasLimitWithStateOverrides(nil, nil)
+	assert.Nil(t, resp)
+	assert.Equal(t, errNodeStarting, err)
+
+	trace, err := inf.TraceTransactionExecution(nil, nil, core.OptionalUint64{})
+	assert.Nil(t, trace)
+	assert.Equal(t, errNodeStarting, err)
+
+	trace, err = inf.GetTransactionExecutionTrace("")
+	assert.Nil(t, trace)
+	assert.Equal(t, errNodeStarting, err)
+
 	uac, _, err := inf.GetAccount("", api.AccountQueryOptions{})
 	assert.Equal(t, api.AccountResponse{}, uac)
 	assert.Equal(t, errNodeStarting, err)
@@ -130,6 +151,10 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, vo)
 	assert.Equal(t, errNodeStarting, err)
 
+	vos, _, err := inf.ExecuteSCQueries(nil)
+	assert.Nil(t, vos)
+	assert.Equal(t, errNodeStarting, err)
+
 	b = inf.PprofEnabled()
 	assert.True(t, b)
 
@@ -354,6 +379,21 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Zero(t, left)
 	assert.Equal(t, errNodeStarting, err)
 
+	events, err := inf.GetEvents(common.EventsQuery{})
+	assert.Nil(t, events)
+	assert.Equal(t, errNodeStarting, err)
+
+	err = inf.StartTrieVerification("", true)
+	assert.Equal(t, errNodeStarting, err)
+
+	trieVerificationStatus, err := inf.GetTrieVerificationStatus()
+	assert.Equal(t, common.TrieVerificationStatus{}, trieVerificationStatus)
+	assert.Equal(t, errNodeStarting, err)
+
+	accountTxs, err := inf.GetTransactionsByAddress("", 0, 0, 0)
+	assert.Nil(t, accountTxs)
+	assert.Equal(t, errNodeStarting, err)
+
 	assert.NotNil(t, inf)
 }
 
@@ -68,10 +68,18 @@ type NodeHandler interface {
 	// ValidateTransaction will validate a transaction
 	ValidateTransaction(tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
+	ValidateTransactionForSimulationWithStateOverrides(tx *transaction.Transaction, checkSignature bool, overrides []*txSimData.StateOverride) error
 
 	// SendBulkTransactions will send a bulk of transactions on the 'send transactions pipe' channel
 	SendBulkTransactions(txs []*transaction.Transaction) (uint64, error)
 
+	// TraceTransactionExecution executes a transaction in trace mode, optionally within a past block
+	TraceTransactionExecution(tx *transaction.Transaction, overrides []*txSimData.StateOverride, blockNonce core.OptionalUint64) (*txSimData.ExecutionTrace, error)
+
+	// TraceTransactionExecutionByHash executes an executed or pending transaction in trace mode, within the block it was
+	// executed in, after re-applying the transactions executed before it within that block
+	TraceTransactionExecutionByHash(hash string, provider external.ExecutedTransactionsProvider) (*txSimData.ExecutionTrace, error)
+
 	// GetAccount returns an accountResponse containing information
 	//  about the account correlated with provided address
 	GetAccount(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
@@ -120,8 +128,11 @@ type TransactionSimulatorProcessor interface {
 // ApiResolver defines a structure capable of resolving REST API requests
 type ApiResolver interface {
 	ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
+	ExecuteSCQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionGasLimitWithStateOverrides(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*transaction.CostResponse, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionExecutionWithStateOverrides(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*txSimData.SimulationResultsWithVMOutput, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetTotalStakedValue(ctx context.Context) (*api.StakeValues, error)
 	GetDirectStakedList(ctx context.Context) ([]*api.DirectStakedValue, error)
@@ -132,6 +143,7 @@ type ApiResolver interface {
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsByAddress(address string, beforeNonce uint64, beforeEpoch uint32, pageSize uint32) (*common.AccountTransactionsAPIResponse, error)
 	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByRound(round uint64, options api.BlockQueryOptions) (*api.Block, error)
@@ -154,6 +166,14 @@ type ApiResolver interface {
 	GetEligibleManagedKeys() ([]string, error)
 	GetWaitingManagedKeys() ([]string, error)
 	GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32, error)
+	AddManagedKey(privateKey string) (string, error)
+	RemoveManagedKey(publicKey string) error
+	PauseManagedKey(publicKey string) error
+	ResumeManagedKey(publicKey string) error
+	Subscribe(filter common.SubscriptionFilter) (common.Subscription, error)
+	GetEvents(query common.EventsQuery) ([]*common.ApiEvent, error)
+	StartTrieVerification(rootHash string, heal bool) error
+	GetTrieVerificationStatus() common.TrieVerificationStatus
 	Close() error
 	IsInterfaceNil() bool
 }
@@ -16,41 +16,53 @@ import (
 
 // ApiResolverStub -
 type ApiResolverStub struct {
-	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
-	StatusMetricsHandler                        func() external.StatusMetricsHandler
-	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
-	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
-	GetTotalStakedValueHandler                  func(ctx context.Context) (*api.StakeValues, error)
-	GetDirectStakedListHandler                  func(ctx context.Context) ([]*api.DirectStakedValue, error)
-	GetDelegatorsListHandler                    func(ctx context.Context) ([]*api.Delegator, error)
-	GetBlockByHashCalled                        func(hash string, options api.BlockQueryOptions) (*api.Block, error)
-	GetBlockByNonceCalled                       func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
-	GetBlockByRoundCalled                       func(round uint64, options api.BlockQueryOptions) (*api.Block, error)
-	GetAlteredAccountsForBlockCalled            func(options api.GetAlteredAccountsForBlockOptions) ([]*alteredAccount.AlteredAccount, error)
-	GetTransactionHandler                       func(hash string, withEvents bool) (*transaction.ApiTransactionResult, error)
-	GetInternalShardBlockByNonceCalled          func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
-	GetInternalShardBlockByHashCalled           func(format common.ApiOutputFormat, hash string) (interface{}, error)
-	GetInternalShardBlockByRoundCalled          func(format common.ApiOutputFormat, round uint64) (interface{}, error)
-	GetInternalMetaBlockByNonceCalled           func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
-	GetInternalMetaBlockByHashCalled            func(format common.ApiOutputFormat, hash string) (interface{}, error)
-	GetInternalMetaBlockByRoundCalled           func(format common.ApiOutputFormat, round uint64) (interface{}, error)
-	GetInternalMiniBlockCalled                  func(format common.ApiOutputFormat, hash string, epoch uint32) (interface{}, error)
-	GetInternalStartOfEpochMetaBlockCalled      func(format common.ApiOutputFormat, epoch uint32) (interface{}, error)
-	GetInternalStartOfEpochValidatorsInfoCalled func(epoch uint32) ([]*state.ShardValidatorInfo, error)
-	GetGenesisNodesPubKeysCalled                func() (map[uint32][]string, map[uint32][]string)
-	GetTransactionsPoolCalled                   func(fields string) (*common.TransactionsPoolAPIResponse, error)
-	GetGenesisBalancesCalled                    func() ([]*common.InitialAccountAPI, error)
-	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
-	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
-	GetTransactionsPoolNonceGapsForSenderCalled func(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
-	GetGasConfigsCalled                         func() map[string]map[string]uint64
-	GetManagedKeysCountCalled                   func() int
-	GetManagedKeysCalled                        func() []string
-	GetLoadedKeysCalled                         func() []string
-	GetEligibleManagedKeysCalled                func() ([]string, error)
-	GetWaitingManagedKeysCalled                 func() ([]string, error)
-	GetWaitingEpochsLeftForPublicKeyCalled      func(publicKey string) (uint32, error)
-	GetSCRsByTxHashCalled                       func(txHash string, scrHash string) ([]*transaction.ApiSmartContractResult, error)
+	ExecuteSCQueryHandler                                func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
+	ExecuteSCQueriesCalled                               func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error)
+	StatusMetricsHandler                                 func() external.StatusMetricsHandler
+	ComputeTransactionGasLimitHandler                    func(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	SimulateTransactionExecutionHandler                  func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	ComputeTransactionGasLimitWithStateOverridesCalled   func(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*transaction.CostResponse, error)
+	SimulateTransactionExecutionWithStateOverridesCalled func(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*txSimData.SimulationResultsWithVMOutput, error)
+	GetTotalStakedValueHandler                           func(ctx context.Context) (*api.StakeValues, error)
+	GetDirectStakedListHandler                           func(ctx context.Context) ([]*api.DirectStakedValue, error)
+	GetDelegatorsListHandler                             func(ctx context.Context) ([]*api.Delegator, error)
+	GetBlockByHashCalled                                 func(hash string, options api.BlockQueryOptions) (*api.Block, error)
+	GetBlockByNonceCalled                                func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetBlockByRoundCalled                                func(round uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetAlteredAccountsForBlockCalled                     func(options api.GetAlteredAccountsForBlockOptions) ([]*alteredAccount.AlteredAccount, error)
+	GetTransactionHandler                                func(hash string, withEvents bool) (*transaction.ApiTransactionResult, error)
+	GetInternalShardBlockByNonceCalled                   func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
+	GetInternalShardBlockByHashCalled                    func(format common.ApiOutputFormat, hash string) (interface{}, error)
+	GetInternalShardBlockByRoundCalled                   func(format common.ApiOutputFormat, round uint64) (interface{}, error)
+	GetInternalMetaBlockByNonceCalled                    func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
+	GetInternalMetaBlockByHashCalled                     func(format common.ApiOutputFormat, hash string) (interface{}, error)
+	GetInternalMetaBlockByRoundCalled                    func(format common.ApiOutputFormat, round uint64) (interface{}, error)
+	GetInternalMiniBlockCalled                           func(format common.ApiOutputFormat, hash string, epoch uint32) (interface{}, error)
+	GetInternalStartOfEpochMetaBlockCalled               func(format common.ApiOutputFormat, epoch uint32) (interface{}, error)
+	GetInternalStartOfEpochValidatorsInfoCalled          func(epoch uint32) ([]*state.ShardValidatorInfo, error)
+	GetGenesisNodesPubKeysCalled                         func() (map[uint32][]string, map[uint32][]string)
+	GetTransactionsPoolCalled                            func(fields string) (*common.TransactionsPoolAPIResponse, error)
+	GetGenesisBalancesCalled                             func() ([]*common.InitialAccountAPI, error)
+	GetTransactionsPoolForSenderCalled                   func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
+	GetLastPoolNonceForSenderCalled                      func(sender string) (uint64, error)
+	GetTransactionsPoolNonceGapsForSenderCalled          func(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsByAddressCalled                       func(address string, beforeNonce uint64, beforeEpoch uint32, pageSize uint32) (*common.AccountTransactionsAPIResponse, error)
+	GetGasConfigsCalled                                  func() map[string]map[string]uint64
+	GetManagedKeysCountCalled                            func() int
+	GetManagedKeysCalled                                 func() []string
+	GetLoadedKeysCalled                                  func() []string
+	GetEligibleManagedKeysCalled                         func() ([]string, error)
+	GetWaitingManagedKeysCalled                          func() ([]string, error)
+	GetWaitingEpochsLeftForPublicKeyCalled               func(publicKey string) (uint32, error)
+	SubscribeCalled                                      func(filter common.SubscriptionFilter) (common.Subscription, error)
+	GetEventsCalled                                      func(query common.EventsQuery) ([]*common.ApiEvent, error)
+	GetSCRsByTxHashCalled                                func(txHash string, scrHash string) ([]*transaction.ApiSmartContractResult, error)
+	AddManagedKeyCalled                                  func(privateKey string) (string, error)
+	RemoveManagedKeyCalled                               func(publicKey string) error
+	PauseManagedKeyCalled                                func(publicKey string) error
+	ResumeManagedKeyCalled                               func(publicKey string) error
+	StartTrieVerificationCalled                          func(rootHash string, heal bool) error
+	GetTrieVerificationStatusCalled                      func() common.TrieVerificationStatus
 }
 
 // GetSCRsByTxHash -
@@ -116,6 +128,15 @@ func (ars *ApiResolverStub) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VM
 	return nil, nil, nil
 }
 
+// ExecuteSCQueries -
+func (ars *ApiResolverStub) ExecuteSCQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+	if ars.ExecuteSCQueriesCalled != nil {
+		return ars.ExecuteSCQueriesCalled(queries)
+	}
+
+	return nil, nil, nil
+}
+
 // StatusMetrics -
 func (ars *ApiResolverStub) StatusMetrics() external.StatusMetricsHandler {
 	if ars.StatusMetricsHandler != nil {
@@ -134,6 +155,24 @@ func (ars *ApiResolverStub) ComputeTransactionGasLimit(tx *transaction.Transacti
 	return nil, nil
 }
 
+// ComputeTransactionGasLimitWithStateOverrides -
+func (ars *ApiResolverStub) ComputeTransactionGasLimitWithStateOverrides(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*transaction.CostResponse, error) {
+	if ars.ComputeTransactionGasLimitWithStateOverridesCalled != nil {
+		return ars.ComputeTransactionGasLimitWithStateOverridesCalled(tx, overrides)
+	}
+
+	return nil, nil
+}
+
+// SimulateTransactionExecutionWithStateOverrides -
+func (ars *ApiResolverStub) SimulateTransactionExecutionWithStateOverrides(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*txSimData.SimulationResultsWithVMOutput, error) {
+	if ars.SimulateTransactionExecutionWithStateOverridesCalled != nil {
+		return ars.SimulateTransactionExecutionWithStateOverridesCalled(tx, overrides)
+	}
+
+	return nil, nil
+}
+
 // SimulateTransactionExecution -
 func (ars *ApiResolverStub) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
 	if ars.SimulateTransactionExecutionHandler != nil {
@@ -237,6 +276,15 @@ func (ars *ApiResolverStub) GetTransactionsPoolNonceGapsForSender(sender string,
 	return nil, nil
 }
 
+// GetTransactionsByAddress -
+func (ars *ApiResolverStub) GetTransactionsByAddress(address string, beforeNonce uint64, beforeEpoch uint32, pageSize uint32) (*common.AccountTransactionsAPIResponse, error) {
+	if ars.GetTransactionsByAddressCalled != nil {
+		return ars.GetTransactionsByAddressCalled(address, beforeNonce, beforeEpoch, pageSize)
+	}
+
+	return nil, nil
+}
+
 // GetInternalMetaBlockByHash -
 func (ars *ApiResolverStub) GetInternalMetaBlockByHash(format common.ApiOutputFormat, hash string) (interface{}, error) {
 	if ars.GetInternalMetaBlockByHashCalled != nil {
@@ -352,6 +400,70 @@ func (ars *ApiResolverStub) GetWaitingEpochsLeftForPublicKey(publicKey string) (
 	return 0, nil
 }
 
+// AddManagedKey -
+func (ars *ApiResolverStub) AddManagedKey(privateKey string) (string, error) {
+	if ars.AddManagedKeyCalled != nil {
+		return ars.AddManagedKeyCalled(privateKey)
+	}
+	return "", nil
+}
+
+// RemoveManagedKey -
+func (ars *ApiResolverStub) RemoveManagedKey(publicKey string) error {
+	if ars.RemoveManagedKeyCalled != nil {
+		return ars.RemoveManagedKeyCalled(publicKey)
+	}
+	return nil
+}
+
+// PauseManagedKey -
+func (ars *ApiResolverStub) PauseManagedKey(publicKey string) error {
+	if ars.PauseManagedKeyCalled != nil {
+		return ars.PauseManagedKeyCalled(publicKey)
+	}
+	return nil
+}
+
+// ResumeManagedKey -
+func (ars *ApiResolverStub) ResumeManagedKey(publicKey string) error {
+	if ars.ResumeManagedKeyCalled != nil {
+		return ars.ResumeManagedKeyCalled(publicKey)
+	}
+	return nil
+}
+
+// StartTrieVerification -
+func (ars *ApiResolverStub) StartTrieVerification(rootHash string, heal bool) error {
+	if ars.StartTrieVerificationCalled != nil {
+		return ars.StartTrieVerificationCalled(rootHash, heal)
+	}
+	return nil
+}
+
+// GetTrieVerificationStatus -
+func (ars *ApiResolverStub) GetTrieVerificationStatus() common.TrieVerificationStatus {
+	if ars.GetTrieVerificationStatusCalled != nil {
+		return ars.GetTrieVerificationStatusCalled()
+	}
+	return common.TrieVerificationStatus{}
+}
+
+// Subscribe -
+func (ars *ApiResolverStub) Subscribe(filter common.SubscriptionFilter) (common.Subscription, error) {
+	if ars.SubscribeCalled != nil {
+		return ars.SubscribeCalled(filter)
+	}
+	return nil, nil
+}
+
+// GetEvents -
+func (ars *ApiResolverStub) GetEvents(query common.EventsQuery) ([]*common.ApiEvent, error) {
+	if ars.GetEventsCalled != nil {
+		return ars.GetEventsCalled(query)
+	}
+	return nil, nil
+}
+
 // Close -
 func (ars *ApiResolverStub) Close() error {
 	return nil
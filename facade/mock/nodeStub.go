@@ -14,48 +14,52 @@ import (
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/heartbeat/data"
 	"github.com/multiversx/mx-chain-go/node/external"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 )
 
 // NodeStub -
 type NodeStub struct {
-	ConnectToAddressesHandler                      func([]string) error
-	GetBalanceCalled                               func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error)
-	GenerateTransactionHandler                     func(sender string, receiver string, amount string, code string) (*transaction.Transaction, error)
-	CreateTransactionHandler                       func(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
-	ValidateTransactionHandler                     func(tx *transaction.Transaction) error
-	ValidateTransactionForSimulationCalled         func(tx *transaction.Transaction, bypassSignature bool) error
-	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
-	GetAccountCalled                               func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
-	GetAccountWithKeysCalled                       func(address string, options api.AccountQueryOptions, ctx context.Context) (api.AccountResponse, api.BlockInfo, error)
-	GetCodeCalled                                  func(codeHash []byte, options api.AccountQueryOptions) ([]byte, api.BlockInfo)
-	GetCurrentPublicKeyHandler                     func() string
-	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
-	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
-	GetHeartbeatsHandler                           func() []data.PubKeyHeartbeat
-	ValidatorStatisticsApiCalled                   func() (map[string]*validator.ValidatorStatistics, error)
-	DirectTriggerCalled                            func(epoch uint32, withEarlyEndOfEpoch bool) error
-	IsSelfTriggerCalled                            func() bool
-	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
-	GetValueForKeyCalled                           func(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
-	GetGuardianDataCalled                          func(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
-	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
-	GetConnectedPeersRatingsOnMainNetworkCalled    func() (string, error)
-	GetEpochStartDataAPICalled                     func(epoch uint32) (*common.EpochStartDataAPI, error)
-	GetUsernameCalled                              func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
-	GetCodeHashCalled                              func(address string, options api.AccountQueryOptions) ([]byte, api.BlockInfo, error)
-	GetESDTDataCalled                              func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error)
-	GetAllESDTTokensCalled                         func(address string, options api.AccountQueryOptions, ctx context.Context) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
-	GetNFTTokenIDsRegisteredByAddressCalled        func(address string, options api.AccountQueryOptions, ctx context.Context) ([]string, api.BlockInfo, error)
-	GetESDTsWithRoleCalled                         func(address string, role string, options api.AccountQueryOptions, ctx context.Context) ([]string, api.BlockInfo, error)
-	GetESDTsRolesCalled                            func(address string, options api.AccountQueryOptions, ctx context.Context) (map[string][]string, api.BlockInfo, error)
-	GetKeyValuePairsCalled                         func(address string, options api.AccountQueryOptions, ctx context.Context) (map[string]string, api.BlockInfo, error)
-	GetAllIssuedESDTsCalled                        func(tokenType string, ctx context.Context) ([]string, error)
-	GetProofCalled                                 func(rootHash string, key string) (*common.GetProofResponse, error)
-	GetProofDataTrieCalled                         func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
-	VerifyProofCalled                              func(rootHash string, address string, proof [][]byte) (bool, error)
-	GetTokenSupplyCalled                           func(token string) (*api.ESDTSupply, error)
-	IsDataTrieMigratedCalled                       func(address string, options api.AccountQueryOptions) (bool, error)
-	AuctionListApiCalled                           func() ([]*common.AuctionListValidatorAPIResponse, error)
+	ConnectToAddressesHandler                                func([]string) error
+	GetBalanceCalled                                         func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error)
+	GenerateTransactionHandler                               func(sender string, receiver string, amount string, code string) (*transaction.Transaction, error)
+	CreateTransactionHandler                                 func(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
+	ValidateTransactionHandler                               func(tx *transaction.Transaction) error
+	ValidateTransactionForSimulationCalled                   func(tx *transaction.Transaction, bypassSignature bool) error
+	ValidateTransactionForSimulationWithStateOverridesCalled func(tx *transaction.Transaction, bypassSignature bool, overrides []*txSimData.StateOverride) error
+	SendBulkTransactionsHandler                              func(txs []*transaction.Transaction) (uint64, error)
+	GetAccountCalled                                         func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
+	GetAccountWithKeysCalled                                 func(address string, options api.AccountQueryOptions, ctx context.Context) (api.AccountResponse, api.BlockInfo, error)
+	GetCodeCalled                                            func(codeHash []byte, options api.AccountQueryOptions) ([]byte, api.BlockInfo)
+	GetCurrentPublicKeyHandler                               func() string
+	GenerateAndSendBulkTransactionsHandler                   func(destination string, value *big.Int, nrTransactions uint64) error
+	GenerateAndSendBulkTransactionsOneByOneHandler           func(destination string, value *big.Int, nrTransactions uint64) error
+	GetHeartbeatsHandler                                     func() []data.PubKeyHeartbeat
+	ValidatorStatisticsApiCalled                             func() (map[string]*validator.ValidatorStatistics, error)
+	DirectTriggerCalled                                      func(epoch uint32, withEarlyEndOfEpoch bool) error
+	IsSelfTriggerCalled                                      func() bool
+	GetQueryHandlerCalled                                    func(name string) (debug.QueryHandler, error)
+	GetValueForKeyCalled                                     func(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
+	GetGuardianDataCalled                                    func(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
+	GetPeerInfoCalled                                        func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetConnectedPeersRatingsOnMainNetworkCalled              func() (string, error)
+	GetEpochStartDataAPICalled                               func(epoch uint32) (*common.EpochStartDataAPI, error)
+	GetUsernameCalled                                        func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
+	GetCodeHashCalled                                        func(address string, options api.AccountQueryOptions) ([]byte, api.BlockInfo, error)
+	GetESDTDataCalled                                        func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error)
+	GetAllESDTTokensCalled                                   func(address string, options api.AccountQueryOptions, ctx context.Context) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
+	GetNFTTokenIDsRegisteredByAddressCalled                  func(address string, options api.AccountQueryOptions, ctx context.Context) ([]string, api.BlockInfo, error)
+	GetESDTsWithRoleCalled                                   func(address string, role string, options api.AccountQueryOptions, ctx context.Context) ([]string, api.BlockInfo, error)
+	GetESDTsRolesCalled                                      func(address string, options api.AccountQueryOptions, ctx context.Context) (map[string][]string, api.BlockInfo, error)
+	GetKeyValuePairsCalled                                   func(address string, options api.AccountQueryOptions, ctx context.Context) (map[string]string, api.BlockInfo, error)
+	GetAllIssuedESDTsCalled                                  func(tokenType string, ctx context.Context) ([]string, error)
+	GetProofCalled                                           func(rootHash string, key string) (*common.GetProofResponse, error)
+	GetProofDataTrieCalled                                   func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
+	VerifyProofCalled                                        func(rootHash string, address string, proof [][]byte) (bool, error)
+	GetTokenSupplyCalled                                     func(token string) (*api.ESDTSupply, error)
+	IsDataTrieMigratedCalled                                 func(address string, options api.AccountQueryOptions) (bool, error)
+	AuctionListApiCalled                                     func() ([]*common.AuctionListValidatorAPIResponse, error)
+	TraceTransactionExecutionCalled                          func(tx *transaction.Transaction, overrides []*txSimData.StateOverride, blockNonce core.OptionalUint64) (*txSimData.ExecutionTrace, error)
+	TraceTransactionExecutionByHashCalled                    func(hash string, provider external.ExecutedTransactionsProvider) (*txSimData.ExecutionTrace, error)
 }
 
 // GetProof -
@@ -172,6 +176,15 @@ func (ns *NodeStub) ValidateTransactionForSimulation(tx *transaction.Transaction
 	return nil
 }
 
+// ValidateTransactionForSimulationWithStateOverrides -
+func (ns *NodeStub) ValidateTransactionForSimulationWithStateOverrides(tx *transaction.Transaction, bypassSignature bool, overrides []*txSimData.StateOverride) error {
+	if ns.ValidateTransactionForSimulationWithStateOverridesCalled != nil {
+		return ns.ValidateTransactionForSimulationWithStateOverridesCalled(tx, bypassSignature, overrides)
+	}
+
+	return nil
+}
+
 // SendBulkTransactions -
 func (ns *NodeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	if ns.SendBulkTransactionsHandler != nil {
@@ -208,6 +221,24 @@ func (ns *NodeStub) GetCode(codeHash []byte, options api.AccountQueryOptions) ([
 	return nil, api.BlockInfo{}
 }
 
+// TraceTransactionExecution -
+func (ns *NodeStub) TraceTransactionExecution(tx *transaction.Transaction, overrides []*txSimData.StateOverride, blockNonce core.OptionalUint64) (*txSimData.ExecutionTrace, error) {
+	if ns.TraceTransactionExecutionCalled != nil {
+		return ns.TraceTransactionExecutionCalled(tx, overrides, blockNonce)
+	}
+
+	return nil, nil
+}
+
+// TraceTransactionExecutionByHash -
+func (ns *NodeStub) TraceTransactionExecutionByHash(hash string, provider external.ExecutedTransactionsProvider) (*txSimData.ExecutionTrace, error) {
+	if ns.TraceTransactionExecutionByHashCalled != nil {
+		return ns.TraceTransactionExecutionByHashCalled(hash, provider)
+	}
+
+	return nil, nil
+}
+
 // GetHeartbeats -
 func (ns *NodeStub) GetHeartbeats() []data.PubKeyHeartbeat {
 	if ns.GetHeartbeatsHandler != nil {
@@ -95,7 +95,6 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 	}
 
 	throttlersMap := computeEndpointsNumGoRoutinesThrottlers(arg.WsAntifloodConfig)
-
 	nf := &nodeFacade{
 		node:                   arg.Node,
 		apiResolver:            arg.ApiResolver,
@@ -279,6 +278,12 @@ func (nf *nodeFacade) ValidateTransactionForSimulation(tx *transaction.Transacti
 	return nf.node.ValidateTransactionForSimulation(tx, checkSignature)
 }
 
+// ValidateTransactionForSimulationWithStateOverrides will validate a transaction for the simulation process against
+// the state altered by the provided overrides
+func (nf *nodeFacade) ValidateTransactionForSimulationWithStateOverrides(tx *transaction.Transaction, checkSignature bool, overrides []*txSimData.StateOverride) error {
+	return nf.node.ValidateTransactionForSimulationWithStateOverrides(tx, checkSignature, overrides)
+}
+
 // ValidatorStatisticsApi will return the statistics for all validators
 func (nf *nodeFacade) ValidatorStatisticsApi() (map[string]*validator.ValidatorStatistics, error) {
 	return nf.node.ValidatorStatisticsApi()
@@ -299,6 +304,28 @@ func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction)
 	return nf.apiResolver.SimulateTransactionExecution(tx)
 }
 
+// SimulateTransactionExecutionWithStateOverrides will simulate a transaction's execution against the current state
+// altered by the provided overrides and will return the results
+func (nf *nodeFacade) SimulateTransactionExecutionWithStateOverrides(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*txSimData.SimulationResultsWithVMOutput, error) {
+	return nf.apiResolver.SimulateTransactionExecutionWithStateOverrides(tx, overrides)
+}
+
+// TraceTransactionExecution will execute a transaction in trace mode, within the block with the provided nonce (if any),
+// and will return its execution trace
+func (nf *nodeFacade) TraceTransactionExecution(
+	tx *transaction.Transaction,
+	overrides []*txSimData.StateOverride,
+	blockNonce core.OptionalUint64,
+) (*txSimData.ExecutionTrace, error) {
+	return nf.node.TraceTransactionExecution(tx, overrides, blockNonce)
+}
+
+// GetTransactionExecutionTrace will re-execute, in trace mode, the transaction with the provided hash within the block
+// it was executed in, after the transactions executed before it within that block, and will return its execution trace
+func (nf *nodeFacade) GetTransactionExecutionTrace(hash string) (*txSimData.ExecutionTrace, error) {
+	return nf.node.TraceTransactionExecutionByHash(hash, nf.apiResolver)
+}
+
 // GetTransaction gets the transaction with a specified hash
 func (nf *nodeFacade) GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	return nf.apiResolver.GetTransaction(hash, withResults)
@@ -334,11 +361,22 @@ func (nf *nodeFacade) GetTransactionsPoolNonceGapsForSender(sender string) (*com
 	return nf.apiResolver.GetTransactionsPoolNonceGapsForSender(sender, accountResponse.Nonce)
 }
 
+// GetTransactionsByAddress will return a page of the transactions sent or received by the provided address
+func (nf *nodeFacade) GetTransactionsByAddress(address string, beforeNonce uint64, beforeEpoch uint32, pageSize uint32) (*common.AccountTransactionsAPIResponse, error) {
+	return nf.apiResolver.GetTransactionsByAddress(address, beforeNonce, beforeEpoch, pageSize)
+}
+
 // ComputeTransactionGasLimit will estimate how many gas a transaction will consume
 func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
 	return nf.apiResolver.ComputeTransactionGasLimit(tx)
 }
 
+// ComputeTransactionGasLimitWithStateOverrides will estimate how many gas a transaction will consume when executed
+// against the current state altered by the provided overrides
+func (nf *nodeFacade) ComputeTransactionGasLimitWithStateOverrides(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*transaction.CostResponse, error) {
+	return nf.apiResolver.ComputeTransactionGasLimitWithStateOverrides(tx, overrides)
+}
+
 // GetAccount returns a response containing information about the account correlated with provided address
 func (nf *nodeFacade) GetAccount(address string, options apiData.AccountQueryOptions) (apiData.AccountResponse, apiData.BlockInfo, error) {
 	var accountResponse apiData.AccountResponse
@@ -449,6 +487,28 @@ func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, a
 	return nf.convertVmOutputToApiResponse(vmOutput), queryBlockInfoToApiResource(blockInfo), nil
 }
 
+// ExecuteSCQueries executes the provided queries against the same block and returns the outcome of each query
+func (nf *nodeFacade) ExecuteSCQueries(queries []*process.SCQuery) ([]*common.SCQueryResultAPI, apiData.BlockInfo, error) {
+	results, blockInfo, err := nf.apiResolver.ExecuteSCQueries(queries)
+	if err != nil {
+		return nil, apiData.BlockInfo{}, err
+	}
+
+	apiResults := make([]*common.SCQueryResultAPI, 0, len(results))
+	for _, result := range results {
+		apiResult := &common.SCQueryResultAPI{
+			Error: result.Error,
+		}
+		if result.VMOutput != nil {
+			apiResult.VMOutput = nf.convertVmOutputToApiResponse(result.VMOutput)
+		}
+
+		apiResults = append(apiResults, apiResult)
+	}
+
+	return apiResults, queryBlockInfoToApiResource(blockInfo), nil
+}
+
 // PprofEnabled returns if profiling mode should be active or not on the application
 func (nf *nodeFacade) PprofEnabled() bool {
 	return nf.config.PprofEnabled
@@ -603,6 +663,27 @@ func (nf *nodeFacade) GetProofCurrentRootHash(address string) (*common.GetProofR
 	return nf.node.GetProof(hexRootHash, address)
 }
 
+// StartTrieVerification starts, in background, the verification of the state trie with the provided hex encoded root
+// hash and of all its data tries, optionally fetching the missing nodes from the peers. An empty root hash selects the
+// root hash of the current block
+func (nf *nodeFacade) StartTrieVerification(rootHash string, heal bool) error {
+	if len(rootHash) == 0 {
+		currentRootHash := nf.blockchain.GetCurrentBlockRootHash()
+		if len(currentRootHash) == 0 {
+			return ErrEmptyRootHash
+		}
+
+		rootHash = hex.EncodeToString(currentRootHash)
+	}
+
+	return nf.apiResolver.StartTrieVerification(rootHash, heal)
+}
+
+// GetTrieVerificationStatus returns the status of the current or of the last state trie verification
+func (nf *nodeFacade) GetTrieVerificationStatus() (common.TrieVerificationStatus, error) {
+	return nf.apiResolver.GetTrieVerificationStatus(), nil
+}
+
 // VerifyProof verifies the given Merkle proof
 func (nf *nodeFacade) VerifyProof(rootHash string, address string, proof [][]byte) (bool, error) {
 	return nf.node.VerifyProof(rootHash, address, proof)
@@ -638,11 +719,43 @@ func (nf *nodeFacade) GetWaitingManagedKeys() ([]string, error) {
 	return nf.apiResolver.GetWaitingManagedKeys()
 }
 
+// Subscribe creates a new subscription to the finalized blocks, the transactions status changes and the smart
+// contract events
+func (nf *nodeFacade) Subscribe(filter common.SubscriptionFilter) (common.Subscription, error) {
+	return nf.apiResolver.Subscribe(filter)
+}
+
+// GetEvents returns the events matching the provided query
+func (nf *nodeFacade) GetEvents(query common.EventsQuery) ([]*common.ApiEvent, error) {
+	return nf.apiResolver.GetEvents(query)
+}
+
 // GetWaitingEpochsLeftForPublicKey returns the number of epochs left for the public key until it becomes eligible
 func (nf *nodeFacade) GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32, error) {
 	return nf.apiResolver.GetWaitingEpochsLeftForPublicKey(publicKey)
 }
 
+// AddManagedKey schedules the addition of the provided hex encoded private key as a managed key, returning its
+// public key. The change is applied at the start of the next round
+func (nf *nodeFacade) AddManagedKey(privateKey string) (string, error) {
+	return nf.apiResolver.AddManagedKey(privateKey)
+}
+
+// RemoveManagedKey schedules the removal of the provided managed key. The change is applied at the start of the next round
+func (nf *nodeFacade) RemoveManagedKey(publicKey string) error {
+	return nf.apiResolver.RemoveManagedKey(publicKey)
+}
+
+// PauseManagedKey schedules the pausing of the provided managed key. The change is applied at the start of the next round
+func (nf *nodeFacade) PauseManagedKey(publicKey string) error {
+	return nf.apiResolver.PauseManagedKey(publicKey)
+}
+
+// ResumeManagedKey schedules the resuming of the provided managed key. The change is applied at the start of the next round
+func (nf *nodeFacade) ResumeManagedKey(publicKey string) error {
+	return nf.apiResolver.ResumeManagedKey(publicKey)
+}
+
 func (nf *nodeFacade) convertVmOutputToApiResponse(input *vmcommon.VMOutput) *vm.VMOutputApi {
 	outputAccounts := make(map[string]*vm.OutputAccountApi)
 	for key, acc := range input.OutputAccounts {
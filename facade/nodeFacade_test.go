@@ -21,6 +21,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/validator"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/facade/mock"
@@ -1035,6 +1036,25 @@ func TestNodeFacade_ValidateTransactionForSimulation(t *testing.T) {
 	require.True(t, called)
 }
 
+func TestNodeFacade_ValidateTransactionForSimulationWithStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	providedOverrides := []*txSimData.StateOverride{{Address: []byte("alice")}}
+	var receivedOverrides []*txSimData.StateOverride
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		ValidateTransactionForSimulationWithStateOverridesCalled: func(tx *transaction.Transaction, bypassSignature bool, overrides []*txSimData.StateOverride) error {
+			receivedOverrides = overrides
+			return nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	err := nf.ValidateTransactionForSimulationWithStateOverrides(&transaction.Transaction{}, false, providedOverrides)
+	require.NoError(t, err)
+	require.Equal(t, providedOverrides, receivedOverrides)
+}
+
 func TestNodeFacade_GetTotalStakedValue(t *testing.T) {
 	t.Parallel()
 
@@ -1354,6 +1374,50 @@ func TestNodeFacade_GetWaitingEpochsLeftForPublicKey(t *testing.T) {
 	assert.Equal(t, expectedResult, epochsLeft)
 }
 
+func TestNodeFacade_GetEvents(t *testing.T) {
+	t.Parallel()
+
+	providedQuery := common.EventsQuery{FromNonce: 10, ToNonce: 20}
+	expectedEvents := []*common.ApiEvent{{TxHash: "aa"}}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetEventsCalled: func(query common.EventsQuery) ([]*common.ApiEvent, error) {
+			assert.Equal(t, providedQuery, query)
+			return expectedEvents, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	assert.NotNil(t, nf)
+
+	events, err := nf.GetEvents(providedQuery)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedEvents, events)
+}
+
+func TestNodeFacade_GetTransactionsByAddress(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &common.AccountTransactionsAPIResponse{HasMore: true, NextBeforeNonce: 7}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetTransactionsByAddressCalled: func(address string, beforeNonce uint64, beforeEpoch uint32, pageSize uint32) (*common.AccountTransactionsAPIResponse, error) {
+			assert.Equal(t, "erd1alice", address)
+			assert.Equal(t, uint64(10), beforeNonce)
+			assert.Equal(t, uint32(3), beforeEpoch)
+			assert.Equal(t, uint32(5), pageSize)
+			return expectedResponse, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	assert.NotNil(t, nf)
+
+	response, err := nf.GetTransactionsByAddress("erd1alice", 10, 3, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedResponse, response)
+}
+
 func TestNodeFacade_ExecuteSCQuery(t *testing.T) {
 	t.Parallel()
 
@@ -1412,6 +1476,59 @@ func TestNodeFacade_ExecuteSCQuery(t *testing.T) {
 	})
 }
 
+func TestNodeFacade_ExecuteSCQueries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.ApiResolver = &mock.ApiResolverStub{
+			ExecuteSCQueriesCalled: func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+				return nil, nil, expectedErr
+			},
+		}
+
+		nf, _ := NewNodeFacade(arg)
+
+		results, _, err := nf.ExecuteSCQueries([]*process.SCQuery{{}})
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, results)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		expectedVmOutput := &vmcommon.VMOutput{
+			ReturnData: [][]byte{[]byte("test return data")},
+			ReturnCode: vmcommon.Ok,
+		}
+		arg.ApiResolver = &mock.ApiResolverStub{
+			ExecuteSCQueriesCalled: func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+				require.Len(t, queries, 2)
+				results := []*process.SCQueryResult{
+					{VMOutput: expectedVmOutput},
+					{Error: expectedErr},
+				}
+				return results, holders.NewBlockInfo([]byte("hash"), 7, []byte("root hash")), nil
+			},
+		}
+
+		nf, _ := NewNodeFacade(arg)
+
+		results, blockInfo, err := nf.ExecuteSCQueries([]*process.SCQuery{{}, {}})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		require.Nil(t, results[0].Error)
+		require.Equal(t, expectedVmOutput.ReturnData, results[0].VMOutput.ReturnData)
+		require.Equal(t, expectedVmOutput.ReturnCode.String(), results[0].VMOutput.ReturnCode)
+		require.Equal(t, expectedErr, results[1].Error)
+		require.Nil(t, results[1].VMOutput)
+		require.Equal(t, uint64(7), blockInfo.Nonce)
+		require.Equal(t, hex.EncodeToString([]byte("hash")), blockInfo.Hash)
+	})
+}
+
 func TestNodeFacade_GetBlockByRoundShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -2229,6 +2346,95 @@ func TestNodeFacade_ComputeTransactionGasLimit(t *testing.T) {
 	require.Equal(t, providedResponse, response)
 }
 
+func TestNodeFacade_SimulateTransactionExecutionWithStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	providedOverrides := []*txSimData.StateOverride{{Address: []byte("alice")}}
+	providedResponse := &txSimData.SimulationResultsWithVMOutput{
+		SimulationResults: transaction.SimulationResults{
+			Status: "ok",
+			Hash:   "hash",
+		},
+	}
+	args := createMockArguments()
+	args.ApiResolver = &mock.ApiResolverStub{
+		SimulateTransactionExecutionWithStateOverridesCalled: func(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*txSimData.SimulationResultsWithVMOutput, error) {
+			require.Equal(t, providedOverrides, overrides)
+			return providedResponse, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(args)
+
+	response, err := nf.SimulateTransactionExecutionWithStateOverrides(&transaction.Transaction{}, providedOverrides)
+	require.NoError(t, err)
+	require.Equal(t, providedResponse, response)
+}
+
+func TestNodeFacade_ComputeTransactionGasLimitWithStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	providedOverrides := []*txSimData.StateOverride{{Address: []byte("alice")}}
+	providedResponse := &transaction.CostResponse{
+		GasUnits: 10,
+	}
+	args := createMockArguments()
+	args.ApiResolver = &mock.ApiResolverStub{
+		ComputeTransactionGasLimitWithStateOverridesCalled: func(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*transaction.CostResponse, error) {
+			require.Equal(t, providedOverrides, overrides)
+			return providedResponse, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(args)
+
+	response, err := nf.ComputeTransactionGasLimitWithStateOverrides(&transaction.Transaction{}, providedOverrides)
+	require.NoError(t, err)
+	require.Equal(t, providedResponse, response)
+}
+
+func TestNodeFacade_TraceTransactionExecution(t *testing.T) {
+	t.Parallel()
+
+	providedOverrides := []*txSimData.StateOverride{{Address: []byte("alice")}}
+	providedBlockNonce := core.OptionalUint64{Value: 10, HasValue: true}
+	providedTrace := &txSimData.ExecutionTrace{GasUsed: 10}
+	args := createMockArguments()
+	args.Node = &mock.NodeStub{
+		TraceTransactionExecutionCalled: func(tx *transaction.Transaction, overrides []*txSimData.StateOverride, blockNonce core.OptionalUint64) (*txSimData.ExecutionTrace, error) {
+			require.Equal(t, providedOverrides, overrides)
+			require.Equal(t, providedBlockNonce, blockNonce)
+			return providedTrace, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(args)
+
+	trace, err := nf.TraceTransactionExecution(&transaction.Transaction{}, providedOverrides, providedBlockNonce)
+	require.NoError(t, err)
+	require.Equal(t, providedTrace, trace)
+}
+
+func TestNodeFacade_GetTransactionExecutionTrace(t *testing.T) {
+	t.Parallel()
+
+	providedTrace := &txSimData.ExecutionTrace{GasUsed: 10}
+	args := createMockArguments()
+	apiResolver := args.ApiResolver
+	args.Node = &mock.NodeStub{
+		TraceTransactionExecutionByHashCalled: func(hash string, provider external.ExecutedTransactionsProvider) (*txSimData.ExecutionTrace, error) {
+			require.Equal(t, "hash", hash)
+			require.True(t, provider == apiResolver)
+			return providedTrace, nil
+		},
+	}
+	nf, _ := NewNodeFacade(args)
+
+	trace, err := nf.GetTransactionExecutionTrace("hash")
+	require.NoError(t, err)
+	require.Equal(t, providedTrace, trace)
+}
+
 func TestNodeFacade_GetEpochStartDataAPI(t *testing.T) {
 	t.Parallel()
 
@@ -2363,3 +2569,123 @@ func TestNodeFacade_IsInterfaceNil(t *testing.T) {
 	nf, _ = NewNodeFacade(createMockArguments())
 	require.False(t, nf.IsInterfaceNil())
 }
+
+func TestNodeFacade_StartTrieVerification(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty root hash and empty current root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.Blockchain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockRootHashCalled: func() []byte {
+				return nil
+			},
+		}
+		arg.ApiResolver = &mock.ApiResolverStub{
+			StartTrieVerificationCalled: func(rootHash string, heal bool) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		nf, _ := NewNodeFacade(arg)
+
+		err := nf.StartTrieVerification("", true)
+		require.Equal(t, ErrEmptyRootHash, err)
+	})
+	t.Run("empty root hash should use the current root hash", func(t *testing.T) {
+		t.Parallel()
+
+		currentRootHash := []byte("current root hash")
+		arg := createMockArguments()
+		arg.Blockchain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockRootHashCalled: func() []byte {
+				return currentRootHash
+			},
+		}
+		arg.ApiResolver = &mock.ApiResolverStub{
+			StartTrieVerificationCalled: func(rootHash string, heal bool) error {
+				assert.Equal(t, hex.EncodeToString(currentRootHash), rootHash)
+				assert.False(t, heal)
+				return expectedErr
+			},
+		}
+		nf, _ := NewNodeFacade(arg)
+
+		err := nf.StartTrieVerification("", false)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("provided root hash should be forwarded", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.ApiResolver = &mock.ApiResolverStub{
+			StartTrieVerificationCalled: func(rootHash string, heal bool) error {
+				assert.Equal(t, "726f6f742068617368", rootHash)
+				assert.True(t, heal)
+				return nil
+			},
+		}
+		nf, _ := NewNodeFacade(arg)
+
+		err := nf.StartTrieVerification("726f6f742068617368", true)
+		require.NoError(t, err)
+	})
+}
+
+func TestNodeFacade_GetTrieVerificationStatus(t *testing.T) {
+	t.Parallel()
+
+	providedStatus := common.TrieVerificationStatus{
+		RootHash:        "726f6f742068617368",
+		NumCheckedNodes: 100,
+	}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetTrieVerificationStatusCalled: func() common.TrieVerificationStatus {
+			return providedStatus
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	status, err := nf.GetTrieVerificationStatus()
+	require.NoError(t, err)
+	require.Equal(t, providedStatus, status)
+}
+
+func TestNodeFacade_ManagedKeysChanges(t *testing.T) {
+	t.Parallel()
+
+	providedPrivateKey := "private key"
+	providedPubKey := "public key"
+	calledMethods := make(map[string]int)
+	handler := func(name string) func(publicKey string) error {
+		return func(publicKey string) error {
+			assert.Equal(t, providedPubKey, publicKey)
+			calledMethods[name]++
+			return nil
+		}
+	}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		AddManagedKeyCalled: func(privateKey string) (string, error) {
+			assert.Equal(t, providedPrivateKey, privateKey)
+			calledMethods["add"]++
+			return providedPubKey, nil
+		},
+		RemoveManagedKeyCalled: handler("remove"),
+		PauseManagedKeyCalled:  handler("pause"),
+		ResumeManagedKeyCalled: handler("resume"),
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	publicKey, err := nf.AddManagedKey(providedPrivateKey)
+	assert.NoError(t, err)
+	assert.Equal(t, providedPubKey, publicKey)
+	assert.NoError(t, nf.RemoveManagedKey(providedPubKey))
+	assert.NoError(t, nf.PauseManagedKey(providedPubKey))
+	assert.NoError(t, nf.ResumeManagedKey(providedPubKey))
+
+	expectedCalls := map[string]int{"add": 1, "remove": 1, "pause": 1, "resume": 1}
+	assert.Equal(t, expectedCalls, calledMethods)
+}
@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/throttler"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
@@ -42,9 +43,11 @@ import (
 	"github.com/multiversx/mx-chain-go/state/storagePruningManager"
 	"github.com/multiversx/mx-chain-go/state/storagePruningManager/evictionWaitingList"
 	"github.com/multiversx/mx-chain-go/state/syncer"
+	"github.com/multiversx/mx-chain-go/state/trieVerifier"
 	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	trieFactory "github.com/multiversx/mx-chain-go/trie/factory"
+	trieStatistics "github.com/multiversx/mx-chain-go/trie/statistics"
 	"github.com/multiversx/mx-chain-go/vm"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
@@ -262,6 +265,11 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		return nil, err
 	}
 
+	trieVerifierInstance, err := createTrieVerifier(args)
+	if err != nil {
+		return nil, err
+	}
+
 	argsApiResolver := external.ArgNodeApiResolver{
 		SCQueryService:           scQueryService,
 		StatusMetricsHandler:     args.StatusCoreComponents.StatusMetrics(),
@@ -277,9 +285,12 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		AccountsParser:           args.ProcessComponents.AccountsParser(),
 		GasScheduleNotifier:      args.GasScheduleNotifier,
 		ManagedPeersMonitor:      args.StatusComponents.ManagedPeersMonitor(),
+		SubscriptionsHandler:     args.StatusComponents.SubscriptionsHandler(),
+		LogsFacade:               logsFacade,
 		PublicKey:                args.CryptoComponents.PublicKeyString(),
 		NodesCoordinator:         args.ProcessComponents.NodesCoordinator(),
 		StorageManagers:          storageManagers,
+		TrieVerifier:             trieVerifierInstance,
 	}
 
 	return external.NewNodeApiResolver(argsApiResolver)
@@ -736,10 +747,56 @@ func createAPIBlockProcessorArgs(args *ApiResolverArgs, apiTransactionHandler ex
 	return blockApiArgs, nil
 }
 
+func createTrieVerifier(args *ApiResolverArgs) (external.TrieVerifier, error) {
+	generalConfig := args.Configs.GeneralConfig
+	userTrie := args.StateComponents.TriesContainer().Get([]byte(dataRetriever.UserAccountsUnit.String()))
+	storageManager := userTrie.GetStorageManager()
+
+	thr, err := throttler.NewNumGoRoutinesThrottler(int32(generalConfig.TrieSync.NumConcurrentTrieSyncers))
+	if err != nil {
+		return nil, err
+	}
+
+	// the verifier uses its own syncer, so the healing does not compete with the snapshot's missing nodes syncing
+	argsSyncer := syncer.ArgsNewUserAccountsSyncer{
+		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
+			Hasher:                            args.CoreComponents.Hasher(),
+			Marshalizer:                       args.CoreComponents.InternalMarshalizer(),
+			TrieStorageManager:                storageManager,
+			RequestHandler:                    args.ProcessComponents.RequestHandler(),
+			Timeout:                           common.TimeoutGettingTrieNodes,
+			Cacher:                            args.DataComponents.Datapool().TrieNodes(),
+			MaxTrieLevelInMemory:              generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
+			MaxHardCapForMissingNodes:         generalConfig.TrieSync.MaxHardCapForMissingNodes,
+			TrieSyncerVersion:                 generalConfig.TrieSync.TrieSyncerVersion,
+			CheckNodesOnDisk:                  true,
+			UserAccountsSyncStatisticsHandler: trieStatistics.NewTrieSyncStatistics(),
+			AppStatusHandler:                  disabled.NewAppStatusHandler(),
+			EnableEpochsHandler:               args.CoreComponents.EnableEpochsHandler(),
+		},
+		ShardId:                args.ProcessComponents.ShardCoordinator().SelfId(),
+		Throttler:              thr,
+		AddressPubKeyConverter: args.CoreComponents.AddressPubKeyConverter(),
+	}
+	userAccountsSyncer, err := syncer.NewUserAccountsSyncer(argsSyncer)
+	if err != nil {
+		return nil, err
+	}
+
+	return trieVerifier.NewTrieVerifier(trieVerifier.ArgsTrieVerifier{
+		TrieStorageManager: storageManager,
+		Marshalizer:        args.CoreComponents.InternalMarshalizer(),
+		Hasher:             args.CoreComponents.Hasher(),
+		Syncer:             userAccountsSyncer,
+		DataTrieSyncer:     userAccountsSyncer,
+	})
+}
+
 func createLogsFacade(args *ApiResolverArgs) (factory.LogsFacade, error) {
 	return logs.NewLogsFacade(logs.ArgsNewLogsFacade{
-		StorageService:  args.DataComponents.StorageService(),
-		Marshaller:      args.CoreComponents.InternalMarshalizer(),
-		PubKeyConverter: args.CoreComponents.AddressPubKeyConverter(),
+		StorageService:    args.DataComponents.StorageService(),
+		Marshaller:        args.CoreComponents.InternalMarshalizer(),
+		PubKeyConverter:   args.CoreComponents.AddressPubKeyConverter(),
+		HistoryRepository: args.ProcessComponents.HistoryRepository(),
 	})
 }
@@ -2,6 +2,7 @@ package consensus
 
 import (
 	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -15,6 +16,8 @@ import (
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/blacklist"
 	"github.com/multiversx/mx-chain-go/consensus/chronology"
+	"github.com/multiversx/mx-chain-go/consensus/signingProtection"
+	disabledSigningProtection "github.com/multiversx/mx-chain-go/consensus/signingProtection/disabled"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	"github.com/multiversx/mx-chain-go/consensus/spos/sposFactory"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
@@ -26,6 +29,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/sync/storageBootstrap"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/state/syncer"
+	"github.com/multiversx/mx-chain-go/storage/database"
 	"github.com/multiversx/mx-chain-go/trie/statistics"
 	"github.com/multiversx/mx-chain-go/update"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -34,7 +38,17 @@ import (
 
 var log = logger.GetOrCreate("factory")
 
-const defaultSpan = 300 * time.Second
+const (
+	defaultSpan                          = 300 * time.Second
+	signingProtectionBatchDelayInSeconds = 2
+	// signingProtectionMaxBatchSize of 1 makes each record be written to the disk before the signature is allowed
+	signingProtectionMaxBatchSize = 1
+)
+
+type closableSigningProtector interface {
+	spos.SigningProtector
+	Close() error
+}
 
 // ConsensusComponentsFactoryArgs holds the arguments needed to create a consensus components factory
 type ConsensusComponentsFactoryArgs struct {
@@ -77,6 +91,7 @@ type consensusComponents struct {
 	broadcastMessenger   consensus.BroadcastMessenger
 	worker               factory.ConsensusWorker
 	peerBlacklistHandler consensus.PeerBlacklistHandler
+	signingProtector     closableSigningProtector
 	consensusTopic       string
 	consensusGroupSize   int
 }
@@ -261,6 +276,11 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		return nil, err
 	}
 
+	cc.signingProtector, err = ccf.createSigningProtector()
+	if err != nil {
+		return nil, err
+	}
+
 	fct, err := sposFactory.GetSubroundsFactory(
 		consensusDataContainer,
 		consensusState,
@@ -269,6 +289,7 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		ccf.statusCoreComponents.AppStatusHandler(),
 		ccf.statusComponents.OutportHandler(),
 		ccf.processComponents.SentSignaturesTracker(),
+		cc.signingProtector,
 		[]byte(ccf.coreComponents.ChainID()),
 		ccf.networkComponents.NetworkMessenger().ID(),
 	)
@@ -310,10 +331,48 @@ func (cc *consensusComponents) Close() error {
 	if err != nil {
 		return err
 	}
+	if !check.IfNil(cc.signingProtector) {
+		err = cc.signingProtector.Close()
+		if err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
+func (ccf *consensusComponentsFactory) createSigningProtector() (closableSigningProtector, error) {
+	if !ccf.config.SigningProtection.Enabled {
+		log.Warn("signing protection is disabled, the managed keys are not protected against double signing")
+		return disabledSigningProtection.NewSigningProtector(), nil
+	}
+	if ccf.isInImportMode {
+		return disabledSigningProtection.NewSigningProtector(), nil
+	}
+
+	dbPath := filepath.Join(ccf.flagsConfig.DbDir, ccf.config.SigningProtection.FilePath)
+	persister, err := database.NewSerialDB(
+		dbPath,
+		signingProtectionBatchDelayInSeconds,
+		signingProtectionMaxBatchSize,
+		ccf.config.SigningProtection.MaxOpenFiles,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w while opening the signing protection database %s", err, dbPath)
+	}
+
+	log.Debug("signing protection database opened", "path", dbPath)
+
+	protector, err := signingProtection.NewSigningProtector(signingProtection.ArgsSigningProtector{
+		Persister: persister,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return protector, nil
+}
+
 func (ccf *consensusComponentsFactory) createChronology() (consensus.ChronologyHandler, error) {
 	wd := ccf.coreComponents.Watchdog()
 	if ccf.statusComponents.OutportHandler().HasDrivers() {
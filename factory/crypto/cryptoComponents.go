@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"io"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -26,6 +28,8 @@ import (
 	"github.com/multiversx/mx-chain-go/factory/peerSignatureHandler"
 	"github.com/multiversx/mx-chain-go/genesis/process/disabled"
 	"github.com/multiversx/mx-chain-go/keysManagement"
+	"github.com/multiversx/mx-chain-go/keysManagement/keystore"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
 	p2pFactory "github.com/multiversx/mx-chain-go/p2p/factory"
 	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
@@ -77,6 +81,7 @@ type cryptoParams struct {
 	publicKeyString    string
 	publicKeyBytes     []byte
 	handledPrivateKeys [][]byte
+	remoteHandledKeys  []crypto.PrivateKey
 }
 
 // p2pCryptoParams holds the p2p public/private key data
@@ -99,6 +104,7 @@ type cryptoComponents struct {
 	consensusSigningHandler consensus.SigningHandler
 	managedPeersHolder      common.ManagedPeersHolder
 	keysHandler             consensus.KeysHandler
+	managedKeysFileWatcher  io.Closer
 	cryptoParams
 	p2pCryptoParams
 }
@@ -146,8 +152,13 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		return nil, err
 	}
 
+	remoteSignerClient, err := ccf.createRemoteSignerClient()
+	if err != nil {
+		return nil, err
+	}
+
 	blockSignKeyGen := signing.NewKeyGenerator(suite)
-	cp, err := ccf.createCryptoParams(blockSignKeyGen)
+	cp, err := ccf.createCryptoParams(blockSignKeyGen, remoteSignerClient)
 	if err != nil {
 		return nil, err
 	}
@@ -164,6 +175,13 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		return nil, err
 	}
 
+	if !check.IfNil(remoteSignerClient) {
+		interceptSingleSigner, err = remoteSigner.NewRemoteSingleSigner(interceptSingleSigner, remoteSignerClient)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	p2pSingleSigner := &secp256k1SinglerSig.Secp256k1Signer{}
 
 	multiSigner, err := ccf.createMultiSignerContainer(blockSignKeyGen, ccf.importModeNoSigCheck)
@@ -221,6 +239,12 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 			return nil, errAddManagedPeer
 		}
 	}
+	for _, remoteKey := range cp.remoteHandledKeys {
+		errAddManagedPeer := managedPeersHolder.AddManagedPeerFromPrivateKey(remoteKey)
+		if errAddManagedPeer != nil {
+			return nil, errAddManagedPeer
+		}
+	}
 
 	log.Debug("block sign pubkey", "value", cp.publicKeyString)
 
@@ -246,7 +270,12 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		SingleSigner:         interceptSingleSigner,
 		KeysHandler:          keysHandler,
 	}
-	consensusSigningHandler, err := NewSigningHandler(signingHandlerArgs)
+	consensusSigningHandler, err := createConsensusSigningHandler(signingHandlerArgs, remoteSignerClient)
+	if err != nil {
+		return nil, err
+	}
+
+	managedKeysFileWatcher, err := ccf.createManagedKeysFileWatcher(managedPeersHolder, blockSignKeyGen, remoteSignerClient)
 	if err != nil {
 		return nil, err
 	}
@@ -263,12 +292,84 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		consensusSigningHandler: consensusSigningHandler,
 		managedPeersHolder:      managedPeersHolder,
 		keysHandler:             keysHandler,
+		managedKeysFileWatcher:  managedKeysFileWatcher,
 		cryptoParams:            *cp,
 		p2pCryptoParams:         *p2pCryptoParamsInstance,
 		p2pSingleSigner:         p2pSingleSigner,
 	}, nil
 }
 
+func (ccf *cryptoComponentsFactory) createManagedKeysFileWatcher(
+	managedPeersHolder common.ManagedPeersHolder,
+	keyGenerator crypto.KeyGenerator,
+	remoteSignerClient remoteSigner.SignerClient,
+) (io.Closer, error) {
+	administrationConfig := ccf.config.ManagedKeysAdministration
+	if !administrationConfig.WatchAllValidatorsKeysFile {
+		return nil, nil
+	}
+	if ccf.isInImportMode {
+		log.Warn("the allValidatorsKeys file is not watched in import-db mode")
+		return nil, nil
+	}
+	if !check.IfNil(remoteSignerClient) {
+		log.Warn("the allValidatorsKeys file is not watched when the keys are held by a remote signer")
+		return nil, nil
+	}
+
+	argsWatcher := keysManagement.ArgsManagedKeysFileWatcher{
+		ManagedPeersHolder: managedPeersHolder,
+		KeysLoader:         ccf.keyLoader,
+		KeyGenerator:       keyGenerator,
+		FilePath:           ccf.allValidatorKeysPemFileName,
+		WatchInterval:      time.Duration(administrationConfig.WatchIntervalInSeconds) * time.Second,
+	}
+	watcher, err := keysManagement.NewManagedKeysFileWatcher(argsWatcher)
+	if err != nil {
+		return nil, err
+	}
+
+	return watcher, nil
+}
+
+func (ccf *cryptoComponentsFactory) createRemoteSignerClient() (remoteSigner.SignerClient, error) {
+	remoteSignerConfig := ccf.config.RemoteSigner
+	if !remoteSignerConfig.Enabled {
+		return nil, nil
+	}
+	if ccf.isInImportMode {
+		log.Warn("the remote signer is not used in import-db mode")
+		return nil, nil
+	}
+
+	authKey, err := remoteSigner.LoadAuthKey(remoteSignerConfig.AuthKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the remote signer authentication key", err)
+	}
+
+	args := remoteSigner.ArgsSignerClient{
+		Network:        remoteSignerConfig.Network,
+		Address:        remoteSignerConfig.Address,
+		AuthKey:        authKey,
+		RequestTimeout: time.Millisecond * time.Duration(remoteSignerConfig.RequestTimeoutInMilliseconds),
+	}
+
+	return remoteSigner.NewSignerClient(args)
+}
+
+func createConsensusSigningHandler(args ArgsSigningHandler, remoteSignerClient remoteSigner.SignerClient) (consensus.SigningHandler, error) {
+	if check.IfNil(remoteSignerClient) {
+		return NewSigningHandler(args)
+	}
+
+	remoteArgs := ArgsRemoteSigningHandler{
+		ArgsSigningHandler: args,
+		SignerClient:       remoteSignerClient,
+	}
+
+	return NewRemoteSigningHandler(remoteArgs)
+}
+
 func (ccf *cryptoComponentsFactory) createSingleSigner(importModeNoSigCheck bool) (crypto.SingleSigner, error) {
 	if importModeNoSigCheck {
 		log.Warn("using disabled single signer because the node is running in import-db 'turbo mode'")
@@ -314,7 +415,11 @@ func (ccf *cryptoComponentsFactory) getSuite() (crypto.Suite, error) {
 
 func (ccf *cryptoComponentsFactory) createCryptoParams(
 	keygen crypto.KeyGenerator,
+	remoteSignerClient remoteSigner.SignerClient,
 ) (*cryptoParams, error) {
+	if !check.IfNil(remoteSignerClient) {
+		return ccf.createRemoteCryptoParams(keygen, remoteSignerClient)
+	}
 
 	handledPrivateKeys, err := ccf.processAllHandledKeys(keygen)
 	if err != nil {
@@ -342,11 +447,81 @@ func (ccf *cryptoComponentsFactory) createCryptoParams(
 		return cp, nil
 	}
 
+	if keystore.IsKeystoreFile(ccf.validatorKeyPemFileName) {
+		// an encrypted keystore was explicitly provided, a wrong passphrase should not turn the validator into an observer
+		return nil, fmt.Errorf("%w while loading the validator key keystore", err)
+	}
+
 	log.Debug("failure while reading the BLS key, will autogenerate one", "error", err)
 
 	return ccf.generateCryptoParams(keygen, handledKeysInfo, handledPrivateKeys)
 }
 
+func (ccf *cryptoComponentsFactory) createRemoteCryptoParams(
+	keygen crypto.KeyGenerator,
+	remoteSignerClient remoteSigner.SignerClient,
+) (*cryptoParams, error) {
+	publicKeys, err := remoteSignerClient.GetPublicKeys()
+	if err != nil {
+		return nil, fmt.Errorf("%w while fetching the public keys held by the remote signer", err)
+	}
+
+	remoteHandledKeys := make([]crypto.PrivateKey, 0, len(publicKeys.ManagedPublicKeys))
+	for _, pkBytes := range publicKeys.ManagedPublicKeys {
+		remoteKey, errCreate := createRemotePrivateKey(keygen, pkBytes)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		log.Debug("using remote handled node key", "public key", ccf.validatorPubKeyConverter.SilentEncode(pkBytes, log))
+		remoteHandledKeys = append(remoteHandledKeys, remoteKey)
+	}
+
+	handledKeysInfo := "running in single-key mode"
+	if len(remoteHandledKeys) > 0 {
+		handledKeysInfo = fmt.Sprintf("running in multi-key mode, managing %d keys held by the remote signer", len(remoteHandledKeys))
+	}
+
+	if len(publicKeys.NodePublicKey) == 0 {
+		cp, errGenerate := ccf.generateCryptoParams(keygen, handledKeysInfo, make([][]byte, 0))
+		if errGenerate != nil {
+			return nil, errGenerate
+		}
+		cp.remoteHandledKeys = remoteHandledKeys
+
+		return cp, nil
+	}
+
+	cp := &cryptoParams{
+		handledPrivateKeys: make([][]byte, 0),
+		remoteHandledKeys:  remoteHandledKeys,
+	}
+	cp.privateKey, err = createRemotePrivateKey(keygen, publicKeys.NodePublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	cp.publicKey = cp.privateKey.GeneratePublic()
+	cp.publicKeyBytes = publicKeys.NodePublicKey
+	cp.publicKeyString, err = ccf.validatorPubKeyConverter.Encode(cp.publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info(fmt.Sprintf("the node uses the key held by the remote signer and is %s", handledKeysInfo))
+
+	return cp, nil
+}
+
+func createRemotePrivateKey(keygen crypto.KeyGenerator, pkBytes []byte) (crypto.PrivateKey, error) {
+	publicKey, err := keygen.PublicKeyFromByteArray(pkBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the public key %s provided by the remote signer", err, hex.EncodeToString(pkBytes))
+	}
+
+	return remoteSigner.NewRemotePrivateKey(publicKey)
+}
+
 func (ccf *cryptoComponentsFactory) readCryptoParams(keygen crypto.KeyGenerator) (*cryptoParams, error) {
 	cp := &cryptoParams{}
 	sk, readPk, err := ccf.getSkPk()
@@ -425,6 +600,10 @@ func (ccf *cryptoComponentsFactory) getSkPk() ([]byte, []byte, error) {
 func (ccf *cryptoComponentsFactory) createP2pCryptoParams(
 	keygen crypto.KeyGenerator,
 ) (*p2pCryptoParams, error) {
+	if keystore.IsKeystoreFile(ccf.p2pKeyPemFileName) {
+		return ccf.createP2pCryptoParamsFromKeystore(keygen)
+	}
+
 	privKey, pubKey, err := CreateP2pKeyPair(ccf.p2pKeyPemFileName, keygen, log)
 	if err != nil {
 		return nil, err
@@ -436,6 +615,32 @@ func (ccf *cryptoComponentsFactory) createP2pCryptoParams(
 	}, nil
 }
 
+func (ccf *cryptoComponentsFactory) createP2pCryptoParamsFromKeystore(
+	keygen crypto.KeyGenerator,
+) (*p2pCryptoParams, error) {
+	encodedSk, _, err := ccf.keyLoader.LoadKey(ccf.p2pKeyPemFileName, 0)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the p2p key keystore", err)
+	}
+
+	skBytes, err := hex.DecodeString(string(encodedSk))
+	if err != nil {
+		return nil, fmt.Errorf("%w for encoded p2p secret key", err)
+	}
+
+	privKey, err := keygen.PrivateKeyFromByteArray(skBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("p2p private key: using the provided encrypted private key for p2p signing")
+
+	return &p2pCryptoParams{
+		p2pPrivateKey: privKey,
+		p2pPublicKey:  privKey.GeneratePublic(),
+	}, nil
+}
+
 // CreateP2pKeyPair will create a set of key pair for p2p based on provided pem file. If
 // the provided key is empty it will generate a new one
 func CreateP2pKeyPair(
@@ -469,6 +674,10 @@ func CreateP2pKeyPair(
 func (ccf *cryptoComponentsFactory) processAllHandledKeys(keygen crypto.KeyGenerator) ([][]byte, error) {
 	privateKeys, publicKeys, err := ccf.keyLoader.LoadAllKeys(ccf.allValidatorKeysPemFileName)
 	if err != nil {
+		if keystore.IsKeystoreFile(ccf.allValidatorKeysPemFileName) {
+			return nil, fmt.Errorf("%w while loading the allValidatorsKeys keystore", err)
+		}
+
 		log.Debug("allValidatorsKeys could not be loaded", "reason", err)
 		return make([][]byte, 0), nil
 	}
@@ -527,5 +736,9 @@ func (ccf *cryptoComponentsFactory) processPrivatePublicKey(keygen crypto.KeyGen
 
 // Close closes all underlying components that need closing
 func (cc *cryptoComponents) Close() error {
+	if cc.managedKeysFileWatcher != nil {
+		return cc.managedKeysFileWatcher.Close()
+	}
+
 	return nil
 }
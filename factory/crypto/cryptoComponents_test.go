@@ -3,15 +3,21 @@ package crypto_test
 import (
 	"encoding/hex"
 	"errors"
+	"path/filepath"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/secp256k1"
 	"github.com/multiversx/mx-chain-go/config"
 	errErd "github.com/multiversx/mx-chain-go/errors"
 	cryptoComp "github.com/multiversx/mx-chain-go/factory/crypto"
 	"github.com/multiversx/mx-chain-go/factory/mock"
 	integrationTestsMock "github.com/multiversx/mx-chain-go/integrationTests/mock"
+	"github.com/multiversx/mx-chain-go/keysManagement/keystore"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
 	componentsMock "github.com/multiversx/mx-chain-go/testscommon/components"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -536,3 +542,204 @@ func createBLSPrivatePublicKeys() ([][]byte, []string) {
 
 	return privateKeys, publicKeys
 }
+
+func TestCryptoComponentsFactory_CreateRemoteCryptoParams(t *testing.T) {
+	t.Parallel()
+
+	coreComponents := componentsMock.GetCoreComponents()
+	args := componentsMock.GetCryptoArgs(coreComponents)
+	ccf, _ := cryptoComp.NewCryptoComponentsFactory(args)
+
+	suite, _ := ccf.GetSuite()
+	blockSignKeyGen := signing.NewKeyGenerator(suite)
+	_, nodePublicKey := blockSignKeyGen.GeneratePair()
+	nodePublicKeyBytes, _ := nodePublicKey.ToByteArray()
+	_, managedPublicKey := blockSignKeyGen.GeneratePair()
+	managedPublicKeyBytes, _ := managedPublicKey.ToByteArray()
+
+	t.Run("get public keys fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		client := &cryptoMocks.RemoteSignerClientStub{
+			GetPublicKeysCalled: func() (*remoteSigner.PublicKeys, error) {
+				return nil, expectedErr
+			},
+		}
+
+		cryptoParams, err := ccf.CreateRemoteCryptoParams(blockSignKeyGen, client)
+		require.ErrorIs(t, err, expectedErr)
+		require.Nil(t, cryptoParams)
+	})
+	t.Run("invalid public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		client := &cryptoMocks.RemoteSignerClientStub{
+			GetPublicKeysCalled: func() (*remoteSigner.PublicKeys, error) {
+				return &remoteSigner.PublicKeys{
+					NodePublicKey: []byte("invalid public key"),
+				}, nil
+			},
+		}
+
+		cryptoParams, err := ccf.CreateRemoteCryptoParams(blockSignKeyGen, client)
+		require.NotNil(t, err)
+		require.Nil(t, cryptoParams)
+	})
+	t.Run("remote node key should work", func(t *testing.T) {
+		t.Parallel()
+
+		client := &cryptoMocks.RemoteSignerClientStub{
+			GetPublicKeysCalled: func() (*remoteSigner.PublicKeys, error) {
+				return &remoteSigner.PublicKeys{
+					NodePublicKey: nodePublicKeyBytes,
+				}, nil
+			},
+		}
+
+		cryptoParams, err := ccf.CreateRemoteCryptoParams(blockSignKeyGen, client)
+		require.Nil(t, err)
+		require.Equal(t, nodePublicKeyBytes, cryptoParams.PublicKeyBytes())
+		require.Empty(t, cryptoParams.RemoteHandledKeys())
+
+		remotePublicKey, isRemote := remoteSigner.GetRemotePublicKey(cryptoParams.PrivateKey())
+		require.True(t, isRemote)
+		require.Equal(t, nodePublicKeyBytes, remotePublicKey)
+	})
+	t.Run("remote managed keys should generate the node key", func(t *testing.T) {
+		t.Parallel()
+
+		client := &cryptoMocks.RemoteSignerClientStub{
+			GetPublicKeysCalled: func() (*remoteSigner.PublicKeys, error) {
+				return &remoteSigner.PublicKeys{
+					ManagedPublicKeys: [][]byte{managedPublicKeyBytes},
+				}, nil
+			},
+		}
+
+		cryptoParams, err := ccf.CreateRemoteCryptoParams(blockSignKeyGen, client)
+		require.Nil(t, err)
+		require.NotEqual(t, managedPublicKeyBytes, cryptoParams.PublicKeyBytes())
+		_, isRemote := remoteSigner.GetRemotePublicKey(cryptoParams.PrivateKey())
+		require.False(t, isRemote)
+
+		require.Equal(t, 1, len(cryptoParams.RemoteHandledKeys()))
+		remotePublicKey, isRemote := remoteSigner.GetRemotePublicKey(cryptoParams.RemoteHandledKeys()[0])
+		require.True(t, isRemote)
+		require.Equal(t, managedPublicKeyBytes, remotePublicKey)
+	})
+}
+
+func createTestKeystoreFile(t *testing.T, fileName string, kind string, skBytes []byte, passphrase []byte) string {
+	ks, err := keystore.Encrypt(keystore.ArgsEncrypt{
+		Kind: kind,
+		Keys: []keystore.KeyEntry{
+			{
+				PublicKey: "public key",
+				SecretKey: hex.EncodeToString(skBytes),
+			},
+		},
+		Passphrase: passphrase,
+		KDF:        keystore.ScryptKDF,
+		KDFParams: &keystore.KDFParams{
+			KeyLen: 32,
+			N:      1 << 10,
+			R:      8,
+			P:      1,
+		},
+	})
+	require.Nil(t, err)
+
+	filePath := filepath.Join(t.TempDir(), fileName)
+	require.Nil(t, keystore.WriteFile(filePath, ks))
+
+	return filePath
+}
+
+func TestCryptoComponentsFactory_EncryptedKeystores(t *testing.T) {
+	t.Parallel()
+
+	passphrase := []byte("passphrase")
+	expectedErr := errors.New("expected error")
+
+	t.Run("validator keystore that can not be loaded should error", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents := componentsMock.GetCoreComponents()
+		args := componentsMock.GetCryptoArgs(coreComponents)
+		args.ValidatorKeyPemFileName = createTestKeystoreFile(t, "validatorKey.json", keystore.ValidatorKind, []byte("sk"), passphrase)
+		args.KeyLoader = &mock.KeyLoaderStub{
+			LoadKeyCalled: componentsMock.DummyLoadSkPkFromPemFile(nil, "", expectedErr),
+		}
+		ccf, _ := cryptoComp.NewCryptoComponentsFactory(args)
+
+		suite, _ := ccf.GetSuite()
+		cryptoParams, err := ccf.CreateCryptoParams(signing.NewKeyGenerator(suite))
+		require.ErrorIs(t, err, expectedErr)
+		require.Nil(t, cryptoParams)
+	})
+	t.Run("all validators keystore that can not be loaded should error", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents := componentsMock.GetCoreComponents()
+		args := componentsMock.GetCryptoArgs(coreComponents)
+		args.AllValidatorKeysPemFileName = createTestKeystoreFile(t, "allValidatorsKeys.json", keystore.ValidatorKind, []byte("sk"), passphrase)
+		args.KeyLoader = &mock.KeyLoaderStub{
+			LoadAllKeysCalled: func(path string) ([][]byte, []string, error) {
+				return nil, nil, expectedErr
+			},
+		}
+		ccf, _ := cryptoComp.NewCryptoComponentsFactory(args)
+
+		suite, _ := ccf.GetSuite()
+		cryptoParams, err := ccf.CreateCryptoParams(signing.NewKeyGenerator(suite))
+		require.ErrorIs(t, err, expectedErr)
+		require.Nil(t, cryptoParams)
+	})
+	t.Run("p2p keystore should work", func(t *testing.T) {
+		t.Parallel()
+
+		p2pKeyGen := signing.NewKeyGenerator(secp256k1.NewSecp256k1())
+		p2pPrivateKey, _ := p2pKeyGen.GeneratePair()
+		p2pSkBytes, _ := p2pPrivateKey.ToByteArray()
+
+		coreComponents := componentsMock.GetCoreComponents()
+		args := componentsMock.GetCryptoArgs(coreComponents)
+		args.P2pKeyPemFileName = createTestKeystoreFile(t, "p2pKey.json", keystore.P2pKind, p2pSkBytes, passphrase)
+		args.KeyLoader, _ = keystore.NewKeyLoader(keystore.ArgsKeyLoader{
+			PemKeyLoader: args.KeyLoader,
+			PassphraseProvider: &cryptoMocks.PassphraseProviderStub{
+				PassphraseCalled: func() ([]byte, error) {
+					return passphrase, nil
+				},
+			},
+		})
+		ccf, _ := cryptoComp.NewCryptoComponentsFactory(args)
+		managedCryptoComponents, _ := cryptoComp.NewManagedCryptoComponents(ccf)
+
+		err := managedCryptoComponents.Create()
+		require.Nil(t, err)
+		loadedSkBytes, _ := managedCryptoComponents.P2pPrivateKey().ToByteArray()
+		require.Equal(t, p2pSkBytes, loadedSkBytes)
+	})
+	t.Run("p2p keystore with wrong passphrase should error", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents := componentsMock.GetCoreComponents()
+		args := componentsMock.GetCryptoArgs(coreComponents)
+		args.P2pKeyPemFileName = createTestKeystoreFile(t, "p2pKey.json", keystore.P2pKind, []byte("sk"), passphrase)
+		args.KeyLoader, _ = keystore.NewKeyLoader(keystore.ArgsKeyLoader{
+			PemKeyLoader: core.NewKeyLoader(),
+			PassphraseProvider: &cryptoMocks.PassphraseProviderStub{
+				PassphraseCalled: func() ([]byte, error) {
+					return []byte("wrong passphrase"), nil
+				},
+			},
+		})
+		ccf, _ := cryptoComp.NewCryptoComponentsFactory(args)
+
+		cc, err := ccf.Create()
+		require.ErrorIs(t, err, keystore.ErrDecryptionFailed)
+		require.Nil(t, cc)
+	})
+}
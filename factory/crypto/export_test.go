@@ -4,6 +4,7 @@ import (
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-go/common"
 	cryptoCommon "github.com/multiversx/mx-chain-go/common/crypto"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
 )
 
 // GetSkPk -
@@ -23,7 +24,27 @@ func (ccf *cryptoComponentsFactory) CreateDummyCryptoParams() *cryptoParams {
 
 // CreateCryptoParams -
 func (ccf *cryptoComponentsFactory) CreateCryptoParams(blockSignKeyGen crypto.KeyGenerator) (*cryptoParams, error) {
-	return ccf.createCryptoParams(blockSignKeyGen)
+	return ccf.createCryptoParams(blockSignKeyGen, nil)
+}
+
+// CreateRemoteCryptoParams -
+func (ccf *cryptoComponentsFactory) CreateRemoteCryptoParams(blockSignKeyGen crypto.KeyGenerator, client remoteSigner.SignerClient) (*cryptoParams, error) {
+	return ccf.createCryptoParams(blockSignKeyGen, client)
+}
+
+// PublicKeyBytes -
+func (cp *cryptoParams) PublicKeyBytes() []byte {
+	return cp.publicKeyBytes
+}
+
+// PrivateKey -
+func (cp *cryptoParams) PrivateKey() crypto.PrivateKey {
+	return cp.privateKey
+}
+
+// RemoteHandledKeys -
+func (cp *cryptoParams) RemoteHandledKeys() []crypto.PrivateKey {
+	return cp.remoteHandledKeys
 }
 
 // CreateMultiSignerContainer -
@@ -0,0 +1,71 @@
+package crypto
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+)
+
+// ArgsRemoteSigningHandler defines the arguments needed to create a new remote signing handler component
+type ArgsRemoteSigningHandler struct {
+	ArgsSigningHandler
+	SignerClient remoteSigner.SignerClient
+}
+
+// remoteSigningHandler is a signing handler that sends the signing requests of the keys held by the remote signer
+// to the remote signer, while verifying and aggregating the signatures locally. The single signer provided in the
+// arguments should also be aware of the remote keys, so the single signatures are created by the remote signer
+type remoteSigningHandler struct {
+	*signingHandler
+	signerClient remoteSigner.SignerClient
+}
+
+// NewRemoteSigningHandler will create a new remote signing handler component
+func NewRemoteSigningHandler(args ArgsRemoteSigningHandler) (*remoteSigningHandler, error) {
+	if check.IfNil(args.SignerClient) {
+		return nil, remoteSigner.ErrNilSignerClient
+	}
+
+	localSigningHandler, err := NewSigningHandler(args.ArgsSigningHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	return &remoteSigningHandler{
+		signingHandler: localSigningHandler,
+		signerClient:   args.SignerClient,
+	}, nil
+}
+
+// CreateSignatureShareForPublicKey returns a signature share over a message created by the remote signer, if the
+// key of the provided publicKeyBytes is held by the remote signer, or created locally otherwise
+func (rsh *remoteSigningHandler) CreateSignatureShareForPublicKey(message []byte, index uint16, epoch uint32, publicKeyBytes []byte) ([]byte, error) {
+	if message == nil {
+		return nil, ErrNilMessage
+	}
+
+	privateKey := rsh.keysHandler.GetHandledPrivateKey(publicKeyBytes)
+	remotePublicKey, isRemote := remoteSigner.GetRemotePublicKey(privateKey)
+	if !isRemote {
+		return rsh.signingHandler.CreateSignatureShareForPublicKey(message, index, epoch, publicKeyBytes)
+	}
+
+	sigShareBytes, err := rsh.signerClient.SignShare(remotePublicKey, message, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	rsh.mutSigningData.Lock()
+	defer rsh.mutSigningData.Unlock()
+
+	if int(index) >= len(rsh.data.sigShares) {
+		return nil, ErrIndexOutOfBounds
+	}
+	rsh.data.sigShares[index] = sigShareBytes
+
+	return sigShareBytes, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rsh *remoteSigningHandler) IsInterfaceNil() bool {
+	return rsh == nil
+}
@@ -0,0 +1,180 @@
+package crypto_test
+
+import (
+	"errors"
+	"testing"
+
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	cryptoFactory "github.com/multiversx/mx-chain-go/factory/crypto"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsRemoteSigningHandler() cryptoFactory.ArgsRemoteSigningHandler {
+	return cryptoFactory.ArgsRemoteSigningHandler{
+		ArgsSigningHandler: createMockArgsSigningHandler(),
+		SignerClient:       &cryptoMocks.RemoteSignerClientStub{},
+	}
+}
+
+func createRemotePrivateKey(pkBytes []byte) crypto.PrivateKey {
+	remoteKey, _ := remoteSigner.NewRemotePrivateKey(&cryptoMocks.PublicKeyStub{
+		ToByteArrayStub: func() ([]byte, error) {
+			return pkBytes, nil
+		},
+	})
+
+	return remoteKey
+}
+
+func TestNewRemoteSigningHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil signer client", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRemoteSigningHandler()
+		args.SignerClient = nil
+
+		signer, err := cryptoFactory.NewRemoteSigningHandler(args)
+		require.Nil(t, signer)
+		require.Equal(t, remoteSigner.ErrNilSignerClient, err)
+	})
+	t.Run("invalid signing handler args", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRemoteSigningHandler()
+		args.MultiSignerContainer = nil
+
+		signer, err := cryptoFactory.NewRemoteSigningHandler(args)
+		require.Nil(t, signer)
+		require.Equal(t, cryptoFactory.ErrNilMultiSignerContainer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		signer, err := cryptoFactory.NewRemoteSigningHandler(createMockArgsRemoteSigningHandler())
+		require.Nil(t, err)
+		require.False(t, signer.IsInterfaceNil())
+	})
+}
+
+func TestRemoteSigningHandler_CreateSignatureShareForPublicKey(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(37)
+	pkBytes := []byte("public key bytes")
+	message := []byte("message")
+
+	t.Run("nil message", func(t *testing.T) {
+		t.Parallel()
+
+		signer, _ := cryptoFactory.NewRemoteSigningHandler(createMockArgsRemoteSigningHandler())
+		sigShare, err := signer.CreateSignatureShareForPublicKey(nil, 0, epoch, pkBytes)
+		require.Nil(t, sigShare)
+		require.Equal(t, cryptoFactory.ErrNilMessage, err)
+	})
+	t.Run("local key should create the signature share locally", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRemoteSigningHandler()
+		args.KeysHandler = &testscommon.KeysHandlerStub{
+			GetHandledPrivateKeyCalled: func(providedPkBytes []byte) crypto.PrivateKey {
+				return &cryptoMocks.PrivateKeyStub{}
+			},
+		}
+		args.MultiSignerContainer = cryptoMocks.NewMultiSignerContainerMock(&cryptoMocks.MultiSignerStub{
+			CreateSignatureShareCalled: func(privateKeyBytes, message []byte) ([]byte, error) {
+				return []byte("local sigShare"), nil
+			},
+		})
+		args.SignerClient = &cryptoMocks.RemoteSignerClientStub{
+			SignShareCalled: func(publicKey []byte, message []byte, epoch uint32) ([]byte, error) {
+				require.Fail(t, "should have not called the remote signer")
+				return nil, nil
+			},
+		}
+
+		signer, _ := cryptoFactory.NewRemoteSigningHandler(args)
+		sigShare, err := signer.CreateSignatureShareForPublicKey(message, 0, epoch, pkBytes)
+		require.Nil(t, err)
+		require.Equal(t, []byte("local sigShare"), sigShare)
+	})
+	t.Run("remote signer fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsRemoteSigningHandler()
+		args.KeysHandler = &testscommon.KeysHandlerStub{
+			GetHandledPrivateKeyCalled: func(providedPkBytes []byte) crypto.PrivateKey {
+				return createRemotePrivateKey(pkBytes)
+			},
+		}
+		args.SignerClient = &cryptoMocks.RemoteSignerClientStub{
+			SignShareCalled: func(publicKey []byte, message []byte, epoch uint32) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+
+		signer, _ := cryptoFactory.NewRemoteSigningHandler(args)
+		sigShare, err := signer.CreateSignatureShareForPublicKey(message, 0, epoch, pkBytes)
+		require.Nil(t, sigShare)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("index out of bounds should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRemoteSigningHandler()
+		args.KeysHandler = &testscommon.KeysHandlerStub{
+			GetHandledPrivateKeyCalled: func(providedPkBytes []byte) crypto.PrivateKey {
+				return createRemotePrivateKey(pkBytes)
+			},
+		}
+		args.SignerClient = &cryptoMocks.RemoteSignerClientStub{
+			SignShareCalled: func(publicKey []byte, message []byte, epoch uint32) ([]byte, error) {
+				return []byte("remote sigShare"), nil
+			},
+		}
+
+		signer, _ := cryptoFactory.NewRemoteSigningHandler(args)
+		sigShare, err := signer.CreateSignatureShareForPublicKey(message, 1, epoch, pkBytes)
+		require.Nil(t, sigShare)
+		require.Equal(t, cryptoFactory.ErrIndexOutOfBounds, err)
+	})
+	t.Run("remote key should create the signature share through the remote signer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRemoteSigningHandler()
+		args.KeysHandler = &testscommon.KeysHandlerStub{
+			GetHandledPrivateKeyCalled: func(providedPkBytes []byte) crypto.PrivateKey {
+				return createRemotePrivateKey(pkBytes)
+			},
+		}
+		args.MultiSignerContainer = cryptoMocks.NewMultiSignerContainerMock(&cryptoMocks.MultiSignerStub{
+			CreateSignatureShareCalled: func(privateKeyBytes, message []byte) ([]byte, error) {
+				require.Fail(t, "should have not created the signature share locally")
+				return nil, nil
+			},
+		})
+		args.SignerClient = &cryptoMocks.RemoteSignerClientStub{
+			SignShareCalled: func(publicKey []byte, providedMessage []byte, providedEpoch uint32) ([]byte, error) {
+				require.Equal(t, pkBytes, publicKey)
+				require.Equal(t, message, providedMessage)
+				require.Equal(t, epoch, providedEpoch)
+
+				return []byte("remote sigShare"), nil
+			},
+		}
+
+		signer, _ := cryptoFactory.NewRemoteSigningHandler(args)
+		sigShare, err := signer.CreateSignatureShareForPublicKey(message, 0, epoch, pkBytes)
+		require.Nil(t, err)
+		require.Equal(t, []byte("remote sigShare"), sigShare)
+
+		storedSigShare, err := signer.SignatureShare(0)
+		require.Nil(t, err)
+		require.Equal(t, sigShare, storedSigShare)
+	})
+}
@@ -263,7 +263,10 @@ type NetworkComponentsHandler interface {
 // TransactionEvaluator defines the transaction evaluator actions
 type TransactionEvaluator interface {
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionExecutionWithStateOverrides(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*txSimData.SimulationResultsWithVMOutput, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionGasLimitWithStateOverrides(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*transaction.CostResponse, error)
+	TraceTransactionExecution(tx *transaction.Transaction, options txSimData.TraceOptions) (*txSimData.ExecutionTrace, error)
 	IsInterfaceNil() bool
 }
 
@@ -344,6 +347,7 @@ type StatusComponentsHolder interface {
 	OutportHandler() outport.OutportHandler
 	SoftwareVersionChecker() statistics.SoftwareVersionChecker
 	ManagedPeersMonitor() common.ManagedPeersMonitor
+	SubscriptionsHandler() common.SubscriptionsHandler
 	IsInterfaceNil() bool
 }
 
@@ -500,6 +504,7 @@ type EconomicsHandler interface {
 type LogsFacade interface {
 	GetLog(logKey []byte, epoch uint32) (*transaction.ApiLogs, error)
 	IncludeLogsInTransactions(txs []*transaction.ApiTransactionResult, logsKeys [][]byte, epoch uint32) error
+	GetEvents(query common.EventsQuery) ([]*common.ApiEvent, error)
 	IsInterfaceNil() bool
 }
 
@@ -121,6 +121,7 @@ type processComponents struct {
 	requestedItemsHandler            dataRetriever.RequestedItemsHandler
 	importHandler                    update.ImportHandler
 	nodeRedundancyHandler            consensus.NodeRedundancyHandler
+	failoverCoordinator              redundancy.FailoverCoordinator
 	currentEpochProvider             dataRetriever.CurrentNetworkEpochProviderHandler
 	vmFactoryForTxSimulator          process.VirtualMachinesContainerFactory
 	vmFactoryForProcessing           process.VirtualMachinesContainerFactory
@@ -682,11 +683,17 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 			"if the node is in backup mode and the main node is active", "hex public key", observerBLSPublicKeyBuff)
 	}
 
+	failoverCoordinator, err := pcf.createFailoverCoordinator()
+	if err != nil {
+		return nil, err
+	}
+
 	maxRoundsOfInactivity := int(pcf.prefConfigs.Preferences.RedundancyLevel) * pcf.config.Redundancy.MaxRoundsOfInactivityAccepted
 	nodeRedundancyArg := redundancy.ArgNodeRedundancy{
 		MaxRoundsOfInactivity: maxRoundsOfInactivity,
 		Messenger:             pcf.network.NetworkMessenger(),
 		ObserverPrivateKey:    observerBLSPrivateKey,
+		FailoverCoordinator:   failoverCoordinator,
 	}
 	nodeRedundancyHandler, err := redundancy.NewNodeRedundancy(nodeRedundancyArg)
 	if err != nil {
@@ -750,6 +757,7 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		requestedItemsHandler:            pcf.requestedItemsHandler,
 		importHandler:                    pcf.importHandler,
 		nodeRedundancyHandler:            nodeRedundancyHandler,
+		failoverCoordinator:              failoverCoordinator,
 		currentEpochProvider:             currentEpochProvider,
 		vmFactoryForTxSimulator:          vmFactoryForTxSimulate,
 		vmFactoryForProcessing:           blockProcessorComponents.vmFactoryForProcessing,
@@ -1200,6 +1208,7 @@ func (pcf *processComponentsFactory) indexGenesisBlocks(
 			genesisBlockHash,
 			originalGenesisBlockHeader,
 			genesisBody,
+			wrapTxsInfo(txsPoolPerShard[currentShardID].Transactions),
 			wrapSCRsInfo(txsPoolPerShard[currentShardID].SmartContractResults),
 			wrapReceipts(txsPoolPerShard[currentShardID].Receipts),
 			intraShardMiniBlocks,
@@ -1268,6 +1277,7 @@ func (pcf *processComponentsFactory) saveAlteredGenesisHeaderToStorage(
 			genesisBlockHash,
 			genesisBlockHeader,
 			genesisBody,
+			wrapTxsInfo(txsPoolPerShard[currentShardId].Transactions),
 			wrapSCRsInfo(txsPoolPerShard[currentShardId].SmartContractResults),
 			wrapReceipts(txsPoolPerShard[currentShardId].Receipts),
 			intraShardMiniBlocks,
@@ -2012,6 +2022,30 @@ func checkProcessComponentsArgs(args ProcessComponentsFactoryArgs) error {
 	return nil
 }
 
+// createFailoverCoordinator returns nil if the failover coordination is disabled, the redundancy handler falling back
+// on the missed rounds counting
+func (pcf *processComponentsFactory) createFailoverCoordinator() (redundancy.FailoverCoordinator, error) {
+	failoverConfig := pcf.config.Redundancy.FailoverCoordination
+	if !failoverConfig.Enabled {
+		return nil, nil
+	}
+
+	args := redundancy.ArgsFailoverCoordinator{
+		Messenger:        pcf.network.NetworkMessenger(),
+		Marshaller:       pcf.coreData.InternalMarshalizer(),
+		SingleSigner:     pcf.crypto.BlockSigner(),
+		PrivateKey:       pcf.crypto.PrivateKey(),
+		SyncTimer:        pcf.coreData.SyncTimer(),
+		StatusMetrics:    pcf.statusCoreComponents.StatusMetrics(),
+		AppStatusHandler: pcf.statusCoreComponents.AppStatusHandler(),
+		RedundancyLevel:  pcf.prefConfigs.Preferences.RedundancyLevel,
+		LeaseDuration:    time.Duration(failoverConfig.LeaseDurationInMilliseconds) * time.Millisecond,
+		RenewInterval:    time.Duration(failoverConfig.RenewIntervalInMilliseconds) * time.Millisecond,
+	}
+
+	return redundancy.NewFailoverCoordinator(args)
+}
+
 // Close closes all underlying components that need closing
 func (pc *processComponents) Close() error {
 	if !check.IfNil(pc.blockProcessor) {
@@ -2045,6 +2079,9 @@ func (pc *processComponents) Close() error {
 	if !check.IfNil(pc.txsSender) {
 		log.LogIfError(pc.txsSender.Close())
 	}
+	if !check.IfNil(pc.failoverCoordinator) {
+		log.LogIfError(pc.failoverCoordinator.Close())
+	}
 
 	return nil
 }
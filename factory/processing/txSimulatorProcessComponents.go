@@ -28,7 +28,12 @@ import (
 )
 
 func (pcf *processComponentsFactory) createAPITransactionEvaluator() (factory.TransactionEvaluator, process.VirtualMachinesContainerFactory, error) {
-	simulationAccountsDB, err := transactionEvaluator.NewSimulationAccountsDB(pcf.state.AccountsAdapterAPI())
+	simulationAccountsDB, err := transactionEvaluator.NewSimulationAccountsDB(
+		pcf.state.AccountsAdapterAPI(),
+		pcf.state.AccountsRepository(),
+		pcf.coreData.InternalMarshalizer(),
+		pcf.coreData.Hasher(),
+	)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -73,13 +78,14 @@ func (pcf *processComponentsFactory) createAPITransactionEvaluator() (factory.Tr
 	}
 
 	apiTransactionEvaluator, err := transactionEvaluator.NewAPITransactionEvaluator(transactionEvaluator.ArgsApiTransactionEvaluator{
-		TxTypeHandler:       txTypeHandler,
-		FeeHandler:          pcf.coreData.EconomicsData(),
-		TxSimulator:         txSimulator,
-		Accounts:            simulationAccountsDB,
-		ShardCoordinator:    pcf.bootstrapComponents.ShardCoordinator(),
-		EnableEpochsHandler: pcf.coreData.EnableEpochsHandler(),
-		BlockChain:          pcf.data.Blockchain(),
+		TxTypeHandler:          txTypeHandler,
+		FeeHandler:             pcf.coreData.EconomicsData(),
+		TxSimulator:            txSimulator,
+		Accounts:               simulationAccountsDB,
+		ShardCoordinator:       pcf.bootstrapComponents.ShardCoordinator(),
+		EnableEpochsHandler:    pcf.coreData.EnableEpochsHandler(),
+		BlockChain:             pcf.data.Blockchain(),
+		AddressPubKeyConverter: pcf.coreData.AddressPubKeyConverter(),
 	})
 
 	return apiTransactionEvaluator, vmContainerFactory, err
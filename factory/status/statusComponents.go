@@ -20,6 +20,7 @@ import (
 	"github.com/multiversx/mx-chain-go/keysManagement"
 	"github.com/multiversx/mx-chain-go/outport"
 	outportDriverFactory "github.com/multiversx/mx-chain-go/outport/factory"
+	"github.com/multiversx/mx-chain-go/outport/subscriptions"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
@@ -30,6 +31,7 @@ type statusComponents struct {
 	nodesCoordinator    nodesCoordinator.NodesCoordinator
 	statusHandler       core.AppStatusHandler
 	outportHandler      outport.OutportHandler
+	subscriptionsHub    common.SubscriptionsHandler
 	softwareVersion     statistics.SoftwareVersionChecker
 	managedPeersMonitor common.ManagedPeersMonitor
 	cancelFunc          func()
@@ -148,6 +150,11 @@ func (scf *statusComponentsFactory) Create() (*statusComponents, error) {
 		return nil, err
 	}
 
+	subscriptionsHub, err := scf.createSubscriptionsHub(outportHandler)
+	if err != nil {
+		return nil, err
+	}
+
 	managedPeersMonitorArgs := keysManagement.ArgManagedPeersMonitor{
 		ManagedPeersHolder: scf.cryptoComponents.ManagedPeersHolder(),
 		NodesCoordinator:   scf.nodesCoordinator,
@@ -168,6 +175,7 @@ func (scf *statusComponentsFactory) Create() (*statusComponents, error) {
 		nodesCoordinator:    scf.nodesCoordinator,
 		softwareVersion:     softwareVersionChecker,
 		outportHandler:      outportHandler,
+		subscriptionsHub:    subscriptionsHub,
 		statusHandler:       scf.statusCoreComponents.AppStatusHandler(),
 		managedPeersMonitor: managedPeersMonitor,
 		cancelFunc:          cancelFunc,
@@ -243,6 +251,32 @@ func (scf *statusComponentsFactory) createOutportDriver() (outport.OutportHandle
 	return outportDriverFactory.CreateOutport(outportFactoryArgs)
 }
 
+// createSubscriptionsHub creates the hub streaming the node's events to the API subscribers and subscribes it to the
+// outport, so it receives the same data as the other outport drivers
+func (scf *statusComponentsFactory) createSubscriptionsHub(outportHandler outport.OutportHandler) (common.SubscriptionsHandler, error) {
+	subscriptionsConfig := scf.externalConfig.SubscriptionsConnector
+	if !subscriptionsConfig.Enabled {
+		return subscriptions.NewDisabledSubscriptionsHub(), nil
+	}
+
+	subscriptionsHub, err := outportDriverFactory.CreateSubscriptionsHub(outportDriverFactory.ArgsSubscriptionsHubFactory{
+		Config:           subscriptionsConfig,
+		Marshaller:       scf.coreComponents.InternalMarshalizer(),
+		AddressConverter: scf.coreComponents.AddressPubKeyConverter(),
+		ShardCoordinator: scf.shardCoordinator,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = outportHandler.SubscribeDriver(subscriptionsHub)
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriptionsHub, nil
+}
+
 func (scf *statusComponentsFactory) makeElasticIndexerArgs() indexerFactory.ArgsIndexerFactory {
 	elasticSearchConfig := scf.externalConfig.ElasticSearchConnector
 	return indexerFactory.ArgsIndexerFactory{
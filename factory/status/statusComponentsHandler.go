@@ -105,6 +105,9 @@ func (msc *managedStatusComponents) CheckSubcomponents() error {
 	if check.IfNil(msc.managedPeersMonitor) {
 		return errors.ErrNilManagedPeersMonitor
 	}
+	if check.IfNil(msc.subscriptionsHub) {
+		return errors.ErrNilSubscriptionsHandler
+	}
 
 	return nil
 }
@@ -156,6 +159,18 @@ func (msc *managedStatusComponents) OutportHandler() outport.OutportHandler {
 	return msc.statusComponents.outportHandler
 }
 
+// SubscriptionsHandler returns the handler of the API subscriptions
+func (msc *managedStatusComponents) SubscriptionsHandler() common.SubscriptionsHandler {
+	msc.mutStatusComponents.RLock()
+	defer msc.mutStatusComponents.RUnlock()
+
+	if msc.statusComponents == nil {
+		return nil
+	}
+
+	return msc.statusComponents.subscriptionsHub
+}
+
 // SoftwareVersionChecker returns the software version checker handler
 func (msc *managedStatusComponents) SoftwareVersionChecker() statistics.SoftwareVersionChecker {
 	msc.mutStatusComponents.RLock()
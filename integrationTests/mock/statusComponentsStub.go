@@ -8,9 +8,10 @@ import (
 
 // StatusComponentsStub -
 type StatusComponentsStub struct {
-	Outport                  outport.OutportHandler
-	SoftwareVersionCheck     statistics.SoftwareVersionChecker
-	ManagedPeersMonitorField common.ManagedPeersMonitor
+	Outport                   outport.OutportHandler
+	SoftwareVersionCheck      statistics.SoftwareVersionChecker
+	ManagedPeersMonitorField  common.ManagedPeersMonitor
+	SubscriptionsHandlerField common.SubscriptionsHandler
 }
 
 // Create -
@@ -43,6 +44,11 @@ func (scs *StatusComponentsStub) ManagedPeersMonitor() common.ManagedPeersMonito
 	return scs.ManagedPeersMonitorField
 }
 
+// SubscriptionsHandler -
+func (scs *StatusComponentsStub) SubscriptionsHandler() common.SubscriptionsHandler {
+	return scs.SubscriptionsHandlerField
+}
+
 // IsInterfaceNil -
 func (scs *StatusComponentsStub) IsInterfaceNil() bool {
 	return scs == nil
@@ -1774,6 +1774,7 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 		scheduledTxsExecutionHandler,
 		processedMiniBlocksTracker,
 		tpn.TxExecutionOrderHandler,
+		config.TxSelectionStrategyConfig{},
 	)
 	tpn.PreProcessorsContainer, _ = fact.Create()
 
@@ -2528,7 +2529,19 @@ func (tpn *TestProcessorNode) initNode() {
 	currentProvider, _ := blockInfoProviders.NewCurrentBlockInfo(dataComponents.BlockChain)
 	currentAccountsApi, _ := state.NewAccountsDBApi(tpn.AccntState, currentProvider)
 
-	historicalAccountsApi, _ := state.NewAccountsDBApiWithHistory(tpn.AccntState)
+	argsHistoricalAccountsApi := state.ArgsAccountsDBApiWithHistory{
+		AccountsAdapterCreator: &stateMock.AccountsAdapterCreatorStub{
+			CreateAccountsAdapterCalled: func() (state.AccountsAdapter, error) {
+				trieStorageManager := tpn.TrieStorageManagers[dataRetriever.UserAccountsUnit.String()]
+				accountsDB, _ := CreateAccountsDBWithEnableEpochsHandler(UserAccount, trieStorageManager, tpn.EnableEpochsHandler)
+				return accountsDB, nil
+			},
+		},
+		AppStatusHandler:             &statusHandlerMock.AppStatusHandlerStub{},
+		MaxRecreatedTries:            16,
+		MaxRecreatedTriesSizeInBytes: core.MegabyteSize,
+	}
+	historicalAccountsApi, _ := state.NewAccountsDBApiWithHistory(argsHistoricalAccountsApi)
 
 	argsAccountsRepo := state.ArgsAccountsRepository{
 		FinalStateAccountsWrapper:      finalAccountsApi,
@@ -186,17 +186,18 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 	txSimulator, err := transactionEvaluator.NewTransactionSimulator(argSimulator)
 	log.LogIfError(err)
 
-	wrappedAccounts, err := transactionEvaluator.NewSimulationAccountsDB(tpn.AccntState)
+	wrappedAccounts, err := transactionEvaluator.NewSimulationAccountsDB(tpn.AccntState, &state.AccountsRepositoryStub{}, TestMarshalizer, TestHasher)
 	log.LogIfError(err)
 
 	argsTransactionEvaluator := transactionEvaluator.ArgsApiTransactionEvaluator{
-		TxTypeHandler:       txTypeHandler,
-		FeeHandler:          tpn.EconomicsData,
-		TxSimulator:         txSimulator,
-		Accounts:            wrappedAccounts,
-		ShardCoordinator:    tpn.ShardCoordinator,
-		EnableEpochsHandler: tpn.EnableEpochsHandler,
-		BlockChain:          tpn.BlockChain,
+		TxTypeHandler:          txTypeHandler,
+		FeeHandler:             tpn.EconomicsData,
+		TxSimulator:            txSimulator,
+		Accounts:               wrappedAccounts,
+		ShardCoordinator:       tpn.ShardCoordinator,
+		EnableEpochsHandler:    tpn.EnableEpochsHandler,
+		BlockChain:             tpn.BlockChain,
+		AddressPubKeyConverter: TestAddressPubkeyConverter,
 	}
 	apiTransactionEvaluator, err := transactionEvaluator.NewAPITransactionEvaluator(argsTransactionEvaluator)
 	log.LogIfError(err)
@@ -283,7 +284,10 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 		AccountsParser:           &genesisMocks.AccountsParserStub{},
 		GasScheduleNotifier:      &testscommon.GasScheduleNotifierMock{},
 		ManagedPeersMonitor:      &testscommon.ManagedPeersMonitorStub{},
+		SubscriptionsHandler:     &testscommon.SubscriptionsHandlerStub{},
+		LogsFacade:               logsFacade,
 		NodesCoordinator:         tpn.NodesCoordinator,
+		TrieVerifier:             &testscommon.TrieVerifierStub{},
 	}
 
 	apiResolver, err := external.NewNodeApiResolver(argsApiResolver)
@@ -63,6 +63,7 @@ import (
 	"github.com/multiversx/mx-chain-go/testscommon/genesisMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/integrationtests"
 	"github.com/multiversx/mx-chain-go/testscommon/shardingMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
 	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
 	"github.com/multiversx/mx-chain-go/testscommon/txDataBuilder"
 	"github.com/multiversx/mx-chain-go/vm/systemSmartContracts/defaults"
@@ -919,7 +920,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 	}
 
 	// create transaction simulator
-	simulationAccountsDB, err := transactionEvaluator.NewSimulationAccountsDB(accnts)
+	simulationAccountsDB, err := transactionEvaluator.NewSimulationAccountsDB(accnts, &stateMock.AccountsRepositoryStub{}, integrationtests.TestMarshalizer, integrationtests.TestHasher)
 	if err != nil {
 		return nil, err
 	}
@@ -1011,13 +1012,14 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 	}
 
 	argsTransactionEvaluator := transactionEvaluator.ArgsApiTransactionEvaluator{
-		TxTypeHandler:       txTypeHandler,
-		FeeHandler:          economicsData,
-		TxSimulator:         txSimulator,
-		Accounts:            simulationAccountsDB,
-		ShardCoordinator:    shardCoordinator,
-		EnableEpochsHandler: argsNewSCProcessor.EnableEpochsHandler,
-		BlockChain:          chainHandler,
+		TxTypeHandler:          txTypeHandler,
+		FeeHandler:             economicsData,
+		TxSimulator:            txSimulator,
+		Accounts:               simulationAccountsDB,
+		ShardCoordinator:       shardCoordinator,
+		EnableEpochsHandler:    argsNewSCProcessor.EnableEpochsHandler,
+		BlockChain:             chainHandler,
+		AddressPubKeyConverter: pubkeyConv,
 	}
 	apiTransactionEvaluator, err := transactionEvaluator.NewAPITransactionEvaluator(argsTransactionEvaluator)
 	if err != nil {
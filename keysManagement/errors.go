@@ -35,5 +35,14 @@ var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
 // ErrNilShardProvider signals that a nil shard provider has been provided
 var ErrNilShardProvider = errors.New("nil shard provider")
 
+// ErrNodeNotInMultiKeyMode signals that the node was not started in multikey mode
+var ErrNodeNotInMultiKeyMode = errors.New("node not in multikey mode")
+
+// ErrPendingKeyChange signals that a change is already pending for the provided key
+var ErrPendingKeyChange = errors.New("pending key change")
+
+// ErrNilKeysLoader signals that a nil keys loader has been provided
+var ErrNilKeysLoader = errors.New("nil keys loader")
+
 // ErrNilEpochProvider signals that a nil epoch provider has been provided
 var ErrNilEpochProvider = errors.New("nil epoch provider")
@@ -125,6 +125,11 @@ func (handler *keysHandler) GetRedundancyStepInReason() string {
 	return handler.managedPeersHolder.GetRedundancyStepInReason()
 }
 
+// ApplyPendingChanges applies the scheduled managed keys changes
+func (handler *keysHandler) ApplyPendingChanges() {
+	handler.managedPeersHolder.ApplyPendingChanges()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (handler *keysHandler) IsInterfaceNil() bool {
 	return handler == nil
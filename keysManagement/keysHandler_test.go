@@ -283,3 +283,19 @@ func TestKeysHandler_GetRedundancyStepInReason(t *testing.T) {
 	handler, _ := keysManagement.NewKeysHandler(args)
 	assert.Equal(t, expectedString, handler.GetRedundancyStepInReason())
 }
+
+func TestKeysHandler_ApplyPendingChanges(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	args := createMockArgsKeysHandler()
+	args.ManagedPeersHolder = &testscommon.ManagedPeersHolderStub{
+		ApplyPendingChangesCalled: func() {
+			wasCalled = true
+		},
+	}
+
+	handler, _ := keysManagement.NewKeysHandler(args)
+	handler.ApplyPendingChanges()
+	assert.True(t, wasCalled)
+}
@@ -0,0 +1,42 @@
+package keystore
+
+import "errors"
+
+// ErrNoKeys signals that no keys were provided
+var ErrNoKeys = errors.New("no keys")
+
+// ErrEmptyPassphrase signals that an empty passphrase was provided
+var ErrEmptyPassphrase = errors.New("empty passphrase")
+
+// ErrUnknownKDF signals that an unknown key derivation function was provided
+var ErrUnknownKDF = errors.New("unknown key derivation function")
+
+// ErrInvalidKDFParams signals that invalid key derivation function parameters were provided
+var ErrInvalidKDFParams = errors.New("invalid key derivation function parameters")
+
+// ErrUnsupportedVersion signals that the keystore version is not supported
+var ErrUnsupportedVersion = errors.New("unsupported keystore version")
+
+// ErrUnsupportedCipher signals that the keystore cipher is not supported
+var ErrUnsupportedCipher = errors.New("unsupported keystore cipher")
+
+// ErrDecryptionFailed signals that the keystore could not be decrypted, usually because of a wrong passphrase
+var ErrDecryptionFailed = errors.New("keystore decryption failed, wrong passphrase or altered file")
+
+// ErrInvalidKeystore signals that the keystore content is not valid
+var ErrInvalidKeystore = errors.New("invalid keystore")
+
+// ErrInvalidKeyIndex signals that an invalid key index was provided
+var ErrInvalidKeyIndex = errors.New("invalid key index")
+
+// ErrNilPemKeyLoader signals that a nil PEM key loader was provided
+var ErrNilPemKeyLoader = errors.New("nil PEM key loader")
+
+// ErrNilPassphraseProvider signals that a nil passphrase provider was provided
+var ErrNilPassphraseProvider = errors.New("nil passphrase provider")
+
+// ErrNoPassphraseSource signals that no passphrase source is available
+var ErrNoPassphraseSource = errors.New("no passphrase source: provide a passphrase file descriptor, set the passphrase environment variable or run the app in an interactive terminal")
+
+// ErrPassphrasesMismatch signals that the passphrase and its confirmation do not match
+var ErrPassphrasesMismatch = errors.New("the passphrases do not match")
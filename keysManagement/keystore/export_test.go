@@ -0,0 +1,14 @@
+package keystore
+
+import "io"
+
+// SetTerminalHandlers -
+func (pp *passphraseProvider) SetTerminalHandlers(
+	isTerminal func(fd int) bool,
+	readPassword func(fd int) ([]byte, error),
+	promptOutput io.Writer,
+) {
+	pp.isTerminal = isTerminal
+	pp.readPassword = readPassword
+	pp.promptOutput = promptOutput
+}
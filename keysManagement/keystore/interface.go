@@ -0,0 +1,14 @@
+package keystore
+
+// PassphraseProvider defines the component able to provide the passphrase used to encrypt or decrypt the keystores
+type PassphraseProvider interface {
+	Passphrase() ([]byte, error)
+	IsInterfaceNil() bool
+}
+
+// PemKeyLoader defines the component able to load the keys from plaintext PEM files
+type PemKeyLoader interface {
+	LoadKey(relativePath string, skIndex int) ([]byte, string, error)
+	LoadAllKeys(path string) ([][]byte, []string, error)
+	IsInterfaceNil() bool
+}
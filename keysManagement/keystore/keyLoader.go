@@ -0,0 +1,102 @@
+package keystore
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("keysManagement/keystore")
+
+// ArgsKeyLoader holds the arguments needed to create a new key loader
+type ArgsKeyLoader struct {
+	PemKeyLoader       PemKeyLoader
+	PassphraseProvider PassphraseProvider
+}
+
+type keyLoader struct {
+	pemKeyLoader       PemKeyLoader
+	passphraseProvider PassphraseProvider
+}
+
+// NewKeyLoader creates a key loader able to read both the encrypted JSON keystores and the plaintext PEM files.
+// The keys are returned in the same format as the ones loaded from PEM files: hex encoded secret keys and
+// encoded public keys
+func NewKeyLoader(args ArgsKeyLoader) (*keyLoader, error) {
+	if check.IfNil(args.PemKeyLoader) {
+		return nil, ErrNilPemKeyLoader
+	}
+	if check.IfNil(args.PassphraseProvider) {
+		return nil, ErrNilPassphraseProvider
+	}
+
+	return &keyLoader{
+		pemKeyLoader:       args.PemKeyLoader,
+		passphraseProvider: args.PassphraseProvider,
+	}, nil
+}
+
+// LoadKey returns the key found at the provided index in the keystore or PEM file
+func (kl *keyLoader) LoadKey(relativePath string, skIndex int) ([]byte, string, error) {
+	if !IsKeystoreFile(relativePath) {
+		return kl.pemKeyLoader.LoadKey(relativePath, skIndex)
+	}
+
+	keys, err := kl.decryptFile(relativePath)
+	if err != nil {
+		return nil, "", err
+	}
+	if skIndex < 0 || skIndex >= len(keys) {
+		return nil, "", fmt.Errorf("%w: %d, the keystore %s holds %d key(s)", ErrInvalidKeyIndex, skIndex, relativePath, len(keys))
+	}
+
+	return []byte(keys[skIndex].SecretKey), keys[skIndex].PublicKey, nil
+}
+
+// LoadAllKeys returns all the keys found in the keystore or PEM file
+func (kl *keyLoader) LoadAllKeys(path string) ([][]byte, []string, error) {
+	if !IsKeystoreFile(path) {
+		return kl.pemKeyLoader.LoadAllKeys(path)
+	}
+
+	keys, err := kl.decryptFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	privateKeys := make([][]byte, 0, len(keys))
+	publicKeys := make([]string, 0, len(keys))
+	for _, entry := range keys {
+		privateKeys = append(privateKeys, []byte(entry.SecretKey))
+		publicKeys = append(publicKeys, entry.PublicKey)
+	}
+
+	return privateKeys, publicKeys, nil
+}
+
+func (kl *keyLoader) decryptFile(path string) ([]KeyEntry, error) {
+	ks, err := ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	passphrase, err := kl.passphraseProvider.Passphrase()
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading the passphrase for the keystore %s", err, path)
+	}
+
+	keys, err := ks.Decrypt(passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the keystore %s", err, path)
+	}
+
+	log.Debug("loaded keys from encrypted keystore", "file", path, "kind", ks.Kind, "num keys", len(keys))
+
+	return keys, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (kl *keyLoader) IsInterfaceNil() bool {
+	return kl == nil
+}
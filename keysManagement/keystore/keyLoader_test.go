@@ -0,0 +1,177 @@
+package keystore_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/factory/mock"
+	"github.com/multiversx/mx-chain-go/keysManagement/keystore"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createKeystoreFile(t *testing.T) string {
+	ks, err := keystore.Encrypt(createArgsEncrypt(keystore.ScryptKDF))
+	require.Nil(t, err)
+
+	filePath := filepath.Join(t.TempDir(), "allValidatorsKeys.json")
+	require.Nil(t, keystore.WriteFile(filePath, ks))
+
+	return filePath
+}
+
+func createArgsKeyLoader() keystore.ArgsKeyLoader {
+	return keystore.ArgsKeyLoader{
+		PemKeyLoader: &mock.KeyLoaderStub{},
+		PassphraseProvider: &cryptoMocks.PassphraseProviderStub{
+			PassphraseCalled: func() ([]byte, error) {
+				return testPassphrase, nil
+			},
+		},
+	}
+}
+
+func TestNewKeyLoader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil PEM key loader should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsKeyLoader()
+		args.PemKeyLoader = nil
+		loader, err := keystore.NewKeyLoader(args)
+		assert.Equal(t, keystore.ErrNilPemKeyLoader, err)
+		assert.Nil(t, loader)
+	})
+	t.Run("nil passphrase provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsKeyLoader()
+		args.PassphraseProvider = nil
+		loader, err := keystore.NewKeyLoader(args)
+		assert.Equal(t, keystore.ErrNilPassphraseProvider, err)
+		assert.Nil(t, loader)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		loader, err := keystore.NewKeyLoader(createArgsKeyLoader())
+		assert.Nil(t, err)
+		assert.False(t, loader.IsInterfaceNil())
+	})
+}
+
+func TestKeyLoader_LoadKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("PEM file should use the PEM loader", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsKeyLoader()
+		args.PemKeyLoader = &mock.KeyLoaderStub{
+			LoadKeyCalled: func(relativePath string, skIndex int) ([]byte, string, error) {
+				assert.Equal(t, "validatorKey.pem", relativePath)
+				assert.Equal(t, 1, skIndex)
+				return []byte("sk"), "pk", nil
+			},
+		}
+		args.PassphraseProvider = &cryptoMocks.PassphraseProviderStub{
+			PassphraseCalled: func() ([]byte, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		loader, _ := keystore.NewKeyLoader(args)
+
+		sk, pk, err := loader.LoadKey("validatorKey.pem", 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("sk"), sk)
+		assert.Equal(t, "pk", pk)
+	})
+	t.Run("passphrase error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createArgsKeyLoader()
+		args.PassphraseProvider = &cryptoMocks.PassphraseProviderStub{
+			PassphraseCalled: func() ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		loader, _ := keystore.NewKeyLoader(args)
+
+		sk, pk, err := loader.LoadKey(createKeystoreFile(t), 0)
+		assert.ErrorIs(t, err, expectedErr)
+		assert.Nil(t, sk)
+		assert.Empty(t, pk)
+	})
+	t.Run("invalid index should error", func(t *testing.T) {
+		t.Parallel()
+
+		loader, _ := keystore.NewKeyLoader(createArgsKeyLoader())
+
+		sk, pk, err := loader.LoadKey(createKeystoreFile(t), 2)
+		assert.ErrorIs(t, err, keystore.ErrInvalidKeyIndex)
+		assert.Nil(t, sk)
+		assert.Empty(t, pk)
+	})
+	t.Run("keystore should work", func(t *testing.T) {
+		t.Parallel()
+
+		loader, _ := keystore.NewKeyLoader(createArgsKeyLoader())
+
+		sk, pk, err := loader.LoadKey(createKeystoreFile(t), 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(testKeys[1].SecretKey), sk)
+		assert.Equal(t, testKeys[1].PublicKey, pk)
+	})
+}
+
+func TestKeyLoader_LoadAllKeys(t *testing.T) {
+	t.Parallel()
+
+	t.Run("PEM file should use the PEM loader", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsKeyLoader()
+		args.PemKeyLoader = &mock.KeyLoaderStub{
+			LoadAllKeysCalled: func(path string) ([][]byte, []string, error) {
+				return [][]byte{[]byte("sk")}, []string{"pk"}, nil
+			},
+		}
+		loader, _ := keystore.NewKeyLoader(args)
+
+		sks, pks, err := loader.LoadAllKeys("allValidatorsKeys.pem")
+		assert.Nil(t, err)
+		assert.Equal(t, [][]byte{[]byte("sk")}, sks)
+		assert.Equal(t, []string{"pk"}, pks)
+	})
+	t.Run("wrong passphrase should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsKeyLoader()
+		args.PassphraseProvider = &cryptoMocks.PassphraseProviderStub{
+			PassphraseCalled: func() ([]byte, error) {
+				return []byte("wrong passphrase"), nil
+			},
+		}
+		loader, _ := keystore.NewKeyLoader(args)
+
+		sks, pks, err := loader.LoadAllKeys(createKeystoreFile(t))
+		assert.ErrorIs(t, err, keystore.ErrDecryptionFailed)
+		assert.Nil(t, sks)
+		assert.Nil(t, pks)
+	})
+	t.Run("keystore should work", func(t *testing.T) {
+		t.Parallel()
+
+		loader, _ := keystore.NewKeyLoader(createArgsKeyLoader())
+
+		sks, pks, err := loader.LoadAllKeys(createKeystoreFile(t))
+		assert.Nil(t, err)
+		assert.Equal(t, [][]byte{[]byte(testKeys[0].SecretKey), []byte(testKeys[1].SecretKey)}, sks)
+		assert.Equal(t, []string{testKeys[0].PublicKey, testKeys[1].PublicKey}, pks)
+	})
+}
@@ -0,0 +1,334 @@
+package keystore
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// Version is the current version of the keystore format
+	Version = uint32(1)
+
+	// ScryptKDF is the identifier of the scrypt key derivation function
+	ScryptKDF = "scrypt"
+	// Argon2idKDF is the identifier of the argon2id key derivation function
+	Argon2idKDF = "argon2id"
+
+	// ValidatorKind is the kind of a keystore holding BLS validator keys
+	ValidatorKind = "validator"
+	// WalletKind is the kind of a keystore holding ed25519 wallet keys
+	WalletKind = "wallet"
+	// P2pKind is the kind of a keystore holding secp256k1 p2p keys
+	P2pKind = "p2p"
+
+	cipherAES256GCM = "aes-256-gcm"
+	derivedKeyLen   = 32
+	saltLen         = 32
+
+	defaultScryptN       = 1 << 18
+	defaultScryptR       = 8
+	defaultScryptP       = 1
+	maxScryptN           = 1 << 22
+	defaultArgon2Time    = 3
+	defaultArgon2Memory  = 64 * 1024
+	defaultArgon2Threads = 4
+	maxArgon2MemoryInKiB = 4 * 1024 * 1024
+	maxArgon2Time        = 255
+)
+
+// KeyEntry holds a key pair stored in a keystore. The public key is encoded in the same way as in the PEM files
+// header and the secret key is hex encoded
+type KeyEntry struct {
+	PublicKey string `json:"publicKey"`
+	SecretKey string `json:"secretKey"`
+}
+
+// KDFParams holds the parameters of the key derivation function. N, R and P are used by scrypt while Time,
+// MemoryInKiB and Threads are used by argon2id
+type KDFParams struct {
+	Salt        string `json:"salt"`
+	KeyLen      uint32 `json:"keyLen"`
+	N           int    `json:"n,omitempty"`
+	R           int    `json:"r,omitempty"`
+	P           int    `json:"p,omitempty"`
+	Time        uint32 `json:"time,omitempty"`
+	MemoryInKiB uint32 `json:"memoryInKiB,omitempty"`
+	Threads     uint8  `json:"threads,omitempty"`
+}
+
+// CryptoSection holds the encryption details of a keystore
+type CryptoSection struct {
+	KDF        string    `json:"kdf"`
+	KDFParams  KDFParams `json:"kdfParams"`
+	Cipher     string    `json:"cipher"`
+	Nonce      string    `json:"nonce"`
+	CipherText string    `json:"cipherText"`
+}
+
+// KeyStore is the JSON representation of a passphrase encrypted key file. The public keys are kept in clear so the
+// file content can be listed without the passphrase, but they are authenticated by the cipher
+type KeyStore struct {
+	Version    uint32        `json:"version"`
+	Kind       string        `json:"kind"`
+	PublicKeys []string      `json:"publicKeys"`
+	Crypto     CryptoSection `json:"crypto"`
+}
+
+// ArgsEncrypt holds the arguments needed to create a new keystore
+type ArgsEncrypt struct {
+	Kind       string
+	Keys       []KeyEntry
+	Passphrase []byte
+	KDF        string
+	// KDFParams is optional, the recommended parameters of the chosen KDF are used if nil
+	KDFParams *KDFParams
+}
+
+// DefaultKDFParams returns the recommended parameters of the provided key derivation function, without salt
+func DefaultKDFParams(kdf string) (*KDFParams, error) {
+	switch kdf {
+	case ScryptKDF:
+		return &KDFParams{
+			KeyLen: derivedKeyLen,
+			N:      defaultScryptN,
+			R:      defaultScryptR,
+			P:      defaultScryptP,
+		}, nil
+	case Argon2idKDF:
+		return &KDFParams{
+			KeyLen:      derivedKeyLen,
+			Time:        defaultArgon2Time,
+			MemoryInKiB: defaultArgon2Memory,
+			Threads:     defaultArgon2Threads,
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKDF, kdf)
+	}
+}
+
+// Encrypt creates a new keystore holding the provided keys, encrypted with AES-256-GCM under a key derived from
+// the passphrase
+func Encrypt(args ArgsEncrypt) (*KeyStore, error) {
+	if len(args.Keys) == 0 {
+		return nil, ErrNoKeys
+	}
+	if len(args.Passphrase) == 0 {
+		return nil, ErrEmptyPassphrase
+	}
+
+	params := args.KDFParams
+	if params == nil {
+		var err error
+		params, err = DefaultKDFParams(args.KDF)
+		if err != nil {
+			return nil, err
+		}
+	}
+	kdfParams := *params
+	salt, err := randomBytes(saltLen)
+	if err != nil {
+		return nil, err
+	}
+	kdfParams.Salt = hex.EncodeToString(salt)
+
+	ks := &KeyStore{
+		Version:    Version,
+		Kind:       args.Kind,
+		PublicKeys: make([]string, 0, len(args.Keys)),
+		Crypto: CryptoSection{
+			KDF:       args.KDF,
+			KDFParams: kdfParams,
+			Cipher:    cipherAES256GCM,
+		},
+	}
+	for _, entry := range args.Keys {
+		ks.PublicKeys = append(ks.PublicKeys, entry.PublicKey)
+	}
+
+	aead, err := ks.createAEAD(args.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := randomBytes(aead.NonceSize())
+	if err != nil {
+		return nil, err
+	}
+	ks.Crypto.Nonce = hex.EncodeToString(nonce)
+
+	plainText, err := json.Marshal(args.Keys)
+	if err != nil {
+		return nil, err
+	}
+	additionalData, err := ks.additionalData()
+	if err != nil {
+		return nil, err
+	}
+
+	cipherText := aead.Seal(nil, nonce, plainText, additionalData)
+	ks.Crypto.CipherText = hex.EncodeToString(cipherText)
+
+	return ks, nil
+}
+
+// Decrypt returns the keys held by the keystore
+func (ks *KeyStore) Decrypt(passphrase []byte) ([]KeyEntry, error) {
+	if ks.Version != Version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, ks.Version)
+	}
+	if ks.Crypto.Cipher != cipherAES256GCM {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedCipher, ks.Crypto.Cipher)
+	}
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyPassphrase
+	}
+
+	aead, err := ks.createAEAD(passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := hex.DecodeString(ks.Crypto.Nonce)
+	if err != nil || len(nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("%w: bad nonce", ErrInvalidKeystore)
+	}
+	cipherText, err := hex.DecodeString(ks.Crypto.CipherText)
+	if err != nil {
+		return nil, fmt.Errorf("%w: bad cipher text", ErrInvalidKeystore)
+	}
+	additionalData, err := ks.additionalData()
+	if err != nil {
+		return nil, err
+	}
+
+	plainText, err := aead.Open(nil, nonce, cipherText, additionalData)
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+
+	keys := make([]KeyEntry, 0)
+	err = json.Unmarshal(plainText, &keys)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidKeystore, err.Error())
+	}
+	if len(keys) != len(ks.PublicKeys) {
+		return nil, fmt.Errorf("%w: mismatch number of public and secret keys", ErrInvalidKeystore)
+	}
+	for i, entry := range keys {
+		if entry.PublicKey != ks.PublicKeys[i] {
+			return nil, fmt.Errorf("%w: public key mismatch at index %d", ErrInvalidKeystore, i)
+		}
+	}
+
+	return keys, nil
+}
+
+func (ks *KeyStore) createAEAD(passphrase []byte) (cipher.AEAD, error) {
+	derivedKey, err := deriveKey(ks.Crypto.KDF, &ks.Crypto.KDFParams, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(derivedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// additionalData returns the keystore content, except the cipher text, that is authenticated by the cipher
+func (ks *KeyStore) additionalData() ([]byte, error) {
+	header := *ks
+	header.Crypto.CipherText = ""
+
+	return json.Marshal(&header)
+}
+
+func deriveKey(kdf string, params *KDFParams, passphrase []byte) ([]byte, error) {
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil || len(salt) == 0 {
+		return nil, fmt.Errorf("%w: bad salt", ErrInvalidKDFParams)
+	}
+	if params.KeyLen != derivedKeyLen {
+		return nil, fmt.Errorf("%w: key length should be %d", ErrInvalidKDFParams, derivedKeyLen)
+	}
+
+	switch kdf {
+	case ScryptKDF:
+		isPowerOfTwo := params.N > 1 && params.N&(params.N-1) == 0
+		if !isPowerOfTwo || params.N > maxScryptN || params.R <= 0 || params.P <= 0 {
+			return nil, fmt.Errorf("%w for %s", ErrInvalidKDFParams, kdf)
+		}
+
+		return scrypt.Key(passphrase, salt, params.N, params.R, params.P, int(params.KeyLen))
+	case Argon2idKDF:
+		isInvalid := params.Time == 0 || params.Time > maxArgon2Time || params.Threads == 0 ||
+			params.MemoryInKiB == 0 || params.MemoryInKiB > maxArgon2MemoryInKiB
+		if isInvalid {
+			return nil, fmt.Errorf("%w for %s", ErrInvalidKDFParams, kdf)
+		}
+
+		return argon2.IDKey(passphrase, salt, params.Time, params.MemoryInKiB, params.Threads, params.KeyLen), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKDF, kdf)
+	}
+}
+
+func randomBytes(length int) ([]byte, error) {
+	buff := make([]byte, length)
+	_, err := rand.Read(buff)
+
+	return buff, err
+}
+
+// Marshal returns the indented JSON representation of the keystore
+func (ks *KeyStore) Marshal() ([]byte, error) {
+	return json.MarshalIndent(ks, "", "  ")
+}
+
+// WriteFile saves the keystore in the provided file, readable only by the owner
+func WriteFile(filePath string, ks *KeyStore) error {
+	buff, err := ks.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filePath, buff, core.FileModeUserReadWrite)
+}
+
+// ReadFile loads a keystore from the provided file
+func ReadFile(filePath string) (*KeyStore, error) {
+	buff, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	ks := &KeyStore{}
+	err = json.Unmarshal(buff, ks)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidKeystore, err.Error())
+	}
+
+	return ks, nil
+}
+
+// IsKeystoreFile returns true if the provided file holds a JSON keystore and not a PEM content
+func IsKeystoreFile(filePath string) bool {
+	buff, err := os.ReadFile(filePath)
+	if err != nil {
+		return false
+	}
+
+	return bytes.HasPrefix(bytes.TrimSpace(buff), []byte("{"))
+}
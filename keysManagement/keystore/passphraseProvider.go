@@ -0,0 +1,145 @@
+package keystore
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"golang.org/x/term"
+)
+
+// NoFileDescriptor is the value used when the passphrase should not be read from a file descriptor
+const NoFileDescriptor = -1
+
+const maxPassphraseLen = 1024
+
+// ArgsPassphraseProvider holds the arguments needed to create a new passphrase provider
+type ArgsPassphraseProvider struct {
+	// FileDescriptor, if not NoFileDescriptor, is the file descriptor from which the passphrase is read, up to the
+	// first new line or the end of the stream
+	FileDescriptor int
+	// EnvVariable is the name of the environment variable holding the passphrase
+	EnvVariable string
+	// PromptMessage is displayed when the passphrase is read from the interactive terminal
+	PromptMessage string
+	// ConfirmPrompt, if set, asks the passphrase twice when it is read from the interactive terminal
+	ConfirmPrompt bool
+}
+
+type passphraseProvider struct {
+	mut           sync.Mutex
+	passphrase    []byte
+	fd            int
+	envVariable   string
+	promptMessage string
+	confirmPrompt bool
+	isTerminal    func(fd int) bool
+	readPassword  func(fd int) ([]byte, error)
+	promptOutput  io.Writer
+}
+
+// NewPassphraseProvider creates a passphrase provider that reads the passphrase, in this order, from the file
+// descriptor, the environment variable or the interactive terminal. The passphrase is read once and then cached
+func NewPassphraseProvider(args ArgsPassphraseProvider) *passphraseProvider {
+	return &passphraseProvider{
+		fd:            args.FileDescriptor,
+		envVariable:   args.EnvVariable,
+		promptMessage: args.PromptMessage,
+		confirmPrompt: args.ConfirmPrompt,
+		isTerminal:    term.IsTerminal,
+		readPassword:  term.ReadPassword,
+		promptOutput:  os.Stderr,
+	}
+}
+
+// Passphrase returns the passphrase
+func (pp *passphraseProvider) Passphrase() ([]byte, error) {
+	pp.mut.Lock()
+	defer pp.mut.Unlock()
+
+	if len(pp.passphrase) > 0 {
+		return pp.passphrase, nil
+	}
+
+	passphrase, err := pp.readPassphrase()
+	if err != nil {
+		return nil, err
+	}
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyPassphrase
+	}
+
+	pp.passphrase = passphrase
+
+	return passphrase, nil
+}
+
+func (pp *passphraseProvider) readPassphrase() ([]byte, error) {
+	if pp.fd != NoFileDescriptor {
+		return readFromFileDescriptor(pp.fd)
+	}
+
+	if len(pp.envVariable) > 0 {
+		value, found := os.LookupEnv(pp.envVariable)
+		if found {
+			return []byte(value), nil
+		}
+	}
+
+	stdinFd := int(os.Stdin.Fd())
+	if !pp.isTerminal(stdinFd) {
+		return nil, ErrNoPassphraseSource
+	}
+
+	passphrase, err := pp.prompt(stdinFd, pp.promptMessage)
+	if err != nil {
+		return nil, err
+	}
+	if !pp.confirmPrompt {
+		return passphrase, nil
+	}
+
+	confirmation, err := pp.prompt(stdinFd, "Repeat the passphrase: ")
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(passphrase, confirmation) {
+		return nil, ErrPassphrasesMismatch
+	}
+
+	return passphrase, nil
+}
+
+func (pp *passphraseProvider) prompt(fd int, message string) ([]byte, error) {
+	_, _ = fmt.Fprint(pp.promptOutput, message)
+	passphrase, err := pp.readPassword(fd)
+	_, _ = fmt.Fprintln(pp.promptOutput)
+
+	return passphrase, err
+}
+
+func readFromFileDescriptor(fd int) ([]byte, error) {
+	file := os.NewFile(uintptr(fd), fmt.Sprintf("passphrase-fd-%d", fd))
+	if file == nil {
+		return nil, fmt.Errorf("%w: invalid file descriptor %d", ErrNoPassphraseSource, fd)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	reader := bufio.NewReader(io.LimitReader(file, maxPassphraseLen))
+	line, err := reader.ReadBytes('\n')
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	return bytes.TrimRight(line, "\r\n"), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pp *passphraseProvider) IsInterfaceNil() bool {
+	return pp == nil
+}
@@ -0,0 +1,142 @@
+package keystore_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/keysManagement/keystore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNonInteractiveProvider(args keystore.ArgsPassphraseProvider) keystore.PassphraseProvider {
+	provider := keystore.NewPassphraseProvider(args)
+	provider.SetTerminalHandlers(
+		func(fd int) bool {
+			return false
+		},
+		nil,
+		&bytes.Buffer{},
+	)
+
+	return provider
+}
+
+func TestPassphraseProvider_FileDescriptor(t *testing.T) {
+	t.Parallel()
+
+	reader, writer, err := os.Pipe()
+	require.Nil(t, err)
+	_, _ = writer.WriteString("passphrase from fd\nextra line")
+	_ = writer.Close()
+
+	provider := createNonInteractiveProvider(keystore.ArgsPassphraseProvider{
+		FileDescriptor: int(reader.Fd()),
+		EnvVariable:    "KEYSTORE_TEST_UNUSED_VARIABLE",
+	})
+
+	passphrase, err := provider.Passphrase()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("passphrase from fd"), passphrase)
+
+	// the passphrase is cached
+	passphrase, err = provider.Passphrase()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("passphrase from fd"), passphrase)
+}
+
+func TestPassphraseProvider_EnvVariable(t *testing.T) {
+	t.Setenv("KEYSTORE_TEST_PASSPHRASE", "passphrase from env")
+
+	provider := createNonInteractiveProvider(keystore.ArgsPassphraseProvider{
+		FileDescriptor: keystore.NoFileDescriptor,
+		EnvVariable:    "KEYSTORE_TEST_PASSPHRASE",
+	})
+
+	passphrase, err := provider.Passphrase()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("passphrase from env"), passphrase)
+}
+
+func TestPassphraseProvider_EmptyEnvVariableShouldError(t *testing.T) {
+	t.Setenv("KEYSTORE_TEST_EMPTY_PASSPHRASE", "")
+
+	provider := createNonInteractiveProvider(keystore.ArgsPassphraseProvider{
+		FileDescriptor: keystore.NoFileDescriptor,
+		EnvVariable:    "KEYSTORE_TEST_EMPTY_PASSPHRASE",
+	})
+
+	passphrase, err := provider.Passphrase()
+	assert.Equal(t, keystore.ErrEmptyPassphrase, err)
+	assert.Nil(t, passphrase)
+}
+
+func TestPassphraseProvider_NoSourceShouldError(t *testing.T) {
+	t.Parallel()
+
+	provider := createNonInteractiveProvider(keystore.ArgsPassphraseProvider{
+		FileDescriptor: keystore.NoFileDescriptor,
+		EnvVariable:    "KEYSTORE_TEST_MISSING_VARIABLE",
+	})
+
+	passphrase, err := provider.Passphrase()
+	assert.Equal(t, keystore.ErrNoPassphraseSource, err)
+	assert.Nil(t, passphrase)
+}
+
+func TestPassphraseProvider_Prompt(t *testing.T) {
+	t.Parallel()
+
+	createInteractiveProvider := func(confirm bool, answers ...string) (*bytes.Buffer, keystore.PassphraseProvider) {
+		output := &bytes.Buffer{}
+		provider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{
+			FileDescriptor: keystore.NoFileDescriptor,
+			PromptMessage:  "Enter the passphrase: ",
+			ConfirmPrompt:  confirm,
+		})
+		provider.SetTerminalHandlers(
+			func(fd int) bool {
+				return true
+			},
+			func(fd int) ([]byte, error) {
+				if len(answers) == 0 {
+					return nil, errors.New("no more answers")
+				}
+				answer := answers[0]
+				answers = answers[1:]
+				return []byte(answer), nil
+			},
+			output,
+		)
+
+		return output, provider
+	}
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		output, provider := createInteractiveProvider(false, "typed passphrase")
+		passphrase, err := provider.Passphrase()
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("typed passphrase"), passphrase)
+		assert.Contains(t, output.String(), "Enter the passphrase: ")
+	})
+	t.Run("confirmed passphrase should work", func(t *testing.T) {
+		t.Parallel()
+
+		_, provider := createInteractiveProvider(true, "typed passphrase", "typed passphrase")
+		passphrase, err := provider.Passphrase()
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("typed passphrase"), passphrase)
+	})
+	t.Run("mismatched confirmation should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, provider := createInteractiveProvider(true, "typed passphrase", "other passphrase")
+		passphrase, err := provider.Passphrase()
+		assert.Equal(t, keystore.ErrPassphrasesMismatch, err)
+		assert.Nil(t, passphrase)
+	})
+}
@@ -0,0 +1,205 @@
+package keysManagement
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const minWatchInterval = time.Second
+
+// ArgsManagedKeysFileWatcher represents the arguments for the managed keys file watcher
+type ArgsManagedKeysFileWatcher struct {
+	ManagedPeersHolder common.ManagedPeersHolder
+	KeysLoader         KeysLoader
+	KeyGenerator       crypto.KeyGenerator
+	FilePath           string
+	WatchInterval      time.Duration
+}
+
+// managedKeysFileWatcher periodically checks the file holding all the managed keys (allValidatorsKeys.pem or its
+// keystore equivalent) and, whenever the file changes, schedules the addition of the new keys and the removal of
+// the keys no longer present in the file
+type managedKeysFileWatcher struct {
+	managedPeersHolder common.ManagedPeersHolder
+	keysLoader         KeysLoader
+	keyGenerator       crypto.KeyGenerator
+	filePath           string
+	watchInterval      time.Duration
+	lastModTime        time.Time
+	lastSize           int64
+	cancelFunc         func()
+}
+
+// NewManagedKeysFileWatcher creates a new managed keys file watcher and starts watching the provided file
+func NewManagedKeysFileWatcher(args ArgsManagedKeysFileWatcher) (*managedKeysFileWatcher, error) {
+	err := checkManagedKeysFileWatcherArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	watcher := &managedKeysFileWatcher{
+		managedPeersHolder: args.ManagedPeersHolder,
+		keysLoader:         args.KeysLoader,
+		keyGenerator:       args.KeyGenerator,
+		filePath:           args.FilePath,
+		watchInterval:      args.WatchInterval,
+	}
+
+	// the keys existing at this point were already loaded by the node
+	info, errStat := os.Stat(args.FilePath)
+	if errStat == nil {
+		watcher.lastModTime = info.ModTime()
+		watcher.lastSize = info.Size()
+	}
+
+	var ctx context.Context
+	ctx, watcher.cancelFunc = context.WithCancel(context.Background())
+	go watcher.processLoop(ctx)
+
+	log.Info("watching the managed keys file", "file", args.FilePath, "interval", args.WatchInterval)
+
+	return watcher, nil
+}
+
+func checkManagedKeysFileWatcherArgs(args ArgsManagedKeysFileWatcher) error {
+	if check.IfNil(args.ManagedPeersHolder) {
+		return ErrNilManagedPeersHolder
+	}
+	if check.IfNil(args.KeysLoader) {
+		return ErrNilKeysLoader
+	}
+	if check.IfNil(args.KeyGenerator) {
+		return ErrNilKeyGenerator
+	}
+	if len(args.FilePath) == 0 {
+		return fmt.Errorf("%w for the file path", ErrInvalidValue)
+	}
+	if args.WatchInterval < minWatchInterval {
+		return fmt.Errorf("%w for the watch interval, provided %v, minimum %v",
+			ErrInvalidValue, args.WatchInterval, minWatchInterval)
+	}
+
+	return nil
+}
+
+func (watcher *managedKeysFileWatcher) processLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("closing managedKeysFileWatcher.processLoop go routine")
+			return
+		case <-time.After(watcher.watchInterval):
+			watcher.checkFile()
+		}
+	}
+}
+
+func (watcher *managedKeysFileWatcher) checkFile() {
+	info, err := os.Stat(watcher.filePath)
+	if err != nil {
+		log.Debug("managedKeysFileWatcher: could not stat the managed keys file", "file", watcher.filePath, "error", err)
+		return
+	}
+
+	isChanged := !info.ModTime().Equal(watcher.lastModTime) || info.Size() != watcher.lastSize
+	if !isChanged {
+		return
+	}
+
+	err = watcher.scheduleChanges()
+	if err != nil {
+		// the file might be in the middle of a write operation, the check will be retried
+		log.Error("managedKeysFileWatcher: could not load the managed keys file", "file", watcher.filePath, "error", err)
+		return
+	}
+
+	watcher.lastModTime = info.ModTime()
+	watcher.lastSize = info.Size()
+}
+
+func (watcher *managedKeysFileWatcher) scheduleChanges() error {
+	encodedPrivateKeys, _, err := watcher.keysLoader.LoadAllKeys(watcher.filePath)
+	if err != nil {
+		return err
+	}
+
+	fileKeys := make(map[string][]byte, len(encodedPrivateKeys))
+	for index, encodedSk := range encodedPrivateKeys {
+		skBytes, errDecode := hex.DecodeString(string(encodedSk))
+		if errDecode != nil {
+			return fmt.Errorf("%w for encoded secret key, key index %d", errDecode, index)
+		}
+
+		pkBytes, errGenerate := watcher.generatePublicKeyBytes(skBytes)
+		if errGenerate != nil {
+			return fmt.Errorf("%w for secret key, key index %d", errGenerate, index)
+		}
+
+		fileKeys[string(pkBytes)] = skBytes
+	}
+
+	loadedKeys := make(map[string]struct{})
+	numRemoved := 0
+	for _, pkBytes := range watcher.managedPeersHolder.GetLoadedKeysByCurrentNode() {
+		loadedKeys[string(pkBytes)] = struct{}{}
+		_, found := fileKeys[string(pkBytes)]
+		if found {
+			continue
+		}
+
+		err = watcher.managedPeersHolder.ScheduleRemoveManagedPeer(pkBytes)
+		if err != nil {
+			log.Warn("managedKeysFileWatcher: could not schedule the key removal", "error", err)
+			continue
+		}
+		numRemoved++
+	}
+
+	numAdded := 0
+	for pk, skBytes := range fileKeys {
+		_, found := loadedKeys[pk]
+		if found {
+			continue
+		}
+
+		_, err = watcher.managedPeersHolder.ScheduleAddManagedPeer(skBytes)
+		if err != nil {
+			log.Warn("managedKeysFileWatcher: could not schedule the key addition", "error", err)
+			continue
+		}
+		numAdded++
+	}
+
+	log.Info("managed keys file changed", "file", watcher.filePath,
+		"num keys in file", len(fileKeys), "scheduled additions", numAdded, "scheduled removals", numRemoved)
+
+	return nil
+}
+
+func (watcher *managedKeysFileWatcher) generatePublicKeyBytes(skBytes []byte) ([]byte, error) {
+	sk, err := watcher.keyGenerator.PrivateKeyFromByteArray(skBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return sk.GeneratePublic().ToByteArray()
+}
+
+// Close stops watching the managed keys file
+func (watcher *managedKeysFileWatcher) Close() error {
+	watcher.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (watcher *managedKeysFileWatcher) IsInterfaceNil() bool {
+	return watcher == nil
+}
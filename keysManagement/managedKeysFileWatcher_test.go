@@ -0,0 +1,217 @@
+package keysManagement_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/factory/mock"
+	"github.com/multiversx/mx-chain-go/keysManagement"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsManagedKeysFileWatcher(tb testing.TB) keysManagement.ArgsManagedKeysFileWatcher {
+	filePath := filepath.Join(tb.TempDir(), "allValidatorsKeys.pem")
+	err := os.WriteFile(filePath, []byte("initial content"), 0600)
+	require.Nil(tb, err)
+
+	return keysManagement.ArgsManagedKeysFileWatcher{
+		ManagedPeersHolder: &testscommon.ManagedPeersHolderStub{},
+		KeysLoader:         &mock.KeyLoaderStub{},
+		KeyGenerator:       createMockKeyGenerator(),
+		FilePath:           filePath,
+		WatchInterval:      time.Hour,
+	}
+}
+
+func changeFile(tb testing.TB, filePath string, content string) {
+	err := os.WriteFile(filePath, []byte(content), 0600)
+	require.Nil(tb, err)
+}
+
+func TestNewManagedKeysFileWatcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil managed peers holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysFileWatcher(t)
+		args.ManagedPeersHolder = nil
+		watcher, err := keysManagement.NewManagedKeysFileWatcher(args)
+		assert.Equal(t, keysManagement.ErrNilManagedPeersHolder, err)
+		assert.True(t, check.IfNil(watcher))
+	})
+	t.Run("nil keys loader should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysFileWatcher(t)
+		args.KeysLoader = nil
+		watcher, err := keysManagement.NewManagedKeysFileWatcher(args)
+		assert.Equal(t, keysManagement.ErrNilKeysLoader, err)
+		assert.True(t, check.IfNil(watcher))
+	})
+	t.Run("nil key generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysFileWatcher(t)
+		args.KeyGenerator = nil
+		watcher, err := keysManagement.NewManagedKeysFileWatcher(args)
+		assert.Equal(t, keysManagement.ErrNilKeyGenerator, err)
+		assert.True(t, check.IfNil(watcher))
+	})
+	t.Run("empty file path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysFileWatcher(t)
+		args.FilePath = ""
+		watcher, err := keysManagement.NewManagedKeysFileWatcher(args)
+		assert.True(t, errors.Is(err, keysManagement.ErrInvalidValue))
+		assert.True(t, check.IfNil(watcher))
+	})
+	t.Run("invalid watch interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysFileWatcher(t)
+		args.WatchInterval = time.Millisecond
+		watcher, err := keysManagement.NewManagedKeysFileWatcher(args)
+		assert.True(t, errors.Is(err, keysManagement.ErrInvalidValue))
+		assert.True(t, check.IfNil(watcher))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		watcher, err := keysManagement.NewManagedKeysFileWatcher(createMockArgsManagedKeysFileWatcher(t))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(watcher))
+		assert.Nil(t, watcher.Close())
+	})
+}
+
+func TestManagedKeysFileWatcher_CheckFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unchanged file should not reload the keys", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysFileWatcher(t)
+		args.KeysLoader = &mock.KeyLoaderStub{
+			LoadAllKeysCalled: func(path string) ([][]byte, []string, error) {
+				assert.Fail(t, "should have not called LoadAllKeys")
+				return nil, nil, nil
+			},
+		}
+		watcher, _ := keysManagement.NewManagedKeysFileWatcher(args)
+		defer func() {
+			_ = watcher.Close()
+		}()
+
+		watcher.CheckFile()
+	})
+	t.Run("changed file should schedule the additions and the removals", func(t *testing.T) {
+		t.Parallel()
+
+		skBytes2 := []byte("private key 2")
+		pkBytes2 := []byte("public key 2")
+		args := createMockArgsManagedKeysFileWatcher(t)
+		args.KeysLoader = &mock.KeyLoaderStub{
+			LoadAllKeysCalled: func(path string) ([][]byte, []string, error) {
+				assert.Equal(t, args.FilePath, path)
+				encodedKeys := [][]byte{
+					[]byte(hex.EncodeToString(skBytes1)),
+					[]byte(hex.EncodeToString(skBytes2)),
+				}
+
+				return encodedKeys, []string{"pk1", "pk2"}, nil
+			},
+		}
+		scheduledAdditions := make([][]byte, 0)
+		scheduledRemovals := make([][]byte, 0)
+		args.ManagedPeersHolder = &testscommon.ManagedPeersHolderStub{
+			GetLoadedKeysByCurrentNodeCalled: func() [][]byte {
+				return [][]byte{pkBytes0, pkBytes1}
+			},
+			ScheduleAddManagedPeerCalled: func(privateKeyBytes []byte) ([]byte, error) {
+				scheduledAdditions = append(scheduledAdditions, privateKeyBytes)
+				return pkBytes2, nil
+			},
+			ScheduleRemoveManagedPeerCalled: func(pkBytes []byte) error {
+				scheduledRemovals = append(scheduledRemovals, pkBytes)
+				return nil
+			},
+		}
+		watcher, _ := keysManagement.NewManagedKeysFileWatcher(args)
+		defer func() {
+			_ = watcher.Close()
+		}()
+
+		changeFile(t, args.FilePath, "changed file content")
+		watcher.CheckFile()
+		assert.Equal(t, [][]byte{skBytes2}, scheduledAdditions)
+		assert.Equal(t, [][]byte{pkBytes0}, scheduledRemovals)
+
+		// file not changed since the last check
+		watcher.CheckFile()
+		assert.Equal(t, 1, len(scheduledAdditions))
+		assert.Equal(t, 1, len(scheduledRemovals))
+	})
+	t.Run("loading error should retry on the next check", func(t *testing.T) {
+		t.Parallel()
+
+		numLoadCalls := 0
+		args := createMockArgsManagedKeysFileWatcher(t)
+		args.KeysLoader = &mock.KeyLoaderStub{
+			LoadAllKeysCalled: func(path string) ([][]byte, []string, error) {
+				numLoadCalls++
+				if numLoadCalls == 1 {
+					return nil, nil, errors.New("file being written")
+				}
+
+				return [][]byte{[]byte(hex.EncodeToString(skBytes0))}, []string{"pk0"}, nil
+			},
+		}
+		args.ManagedPeersHolder = &testscommon.ManagedPeersHolderStub{
+			GetLoadedKeysByCurrentNodeCalled: func() [][]byte {
+				return [][]byte{pkBytes0}
+			},
+		}
+		watcher, _ := keysManagement.NewManagedKeysFileWatcher(args)
+		defer func() {
+			_ = watcher.Close()
+		}()
+
+		changeFile(t, args.FilePath, "changed file content")
+		watcher.CheckFile()
+		watcher.CheckFile()
+		watcher.CheckFile()
+		assert.Equal(t, 2, numLoadCalls)
+	})
+	t.Run("invalid encoded key should not schedule changes", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysFileWatcher(t)
+		args.KeysLoader = &mock.KeyLoaderStub{
+			LoadAllKeysCalled: func(path string) ([][]byte, []string, error) {
+				return [][]byte{[]byte("not a hex string")}, []string{"pk0"}, nil
+			},
+		}
+		args.ManagedPeersHolder = &testscommon.ManagedPeersHolderStub{
+			ScheduleRemoveManagedPeerCalled: func(pkBytes []byte) error {
+				assert.Fail(t, "should have not called ScheduleRemoveManagedPeer")
+				return nil
+			},
+		}
+		watcher, _ := keysManagement.NewManagedKeysFileWatcher(args)
+		defer func() {
+			_ = watcher.Close()
+		}()
+
+		changeFile(t, args.FilePath, "changed file content")
+		watcher.CheckFile()
+	})
+}
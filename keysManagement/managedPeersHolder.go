@@ -25,6 +25,21 @@ const (
 	redundancyReasonForMultipleKeys = "multikey node stepped in with %d keys"
 )
 
+type keyChangeType string
+
+const (
+	addKeyChange    keyChangeType = "add"
+	removeKeyChange keyChangeType = "remove"
+	pauseKeyChange  keyChangeType = "pause"
+	resumeKeyChange keyChangeType = "resume"
+)
+
+type pendingKeyChange struct {
+	changeType keyChangeType
+	publicKey  []byte
+	privateKey crypto.PrivateKey
+}
+
 type managedPeersHolder struct {
 	mut                         sync.RWMutex
 	defaultPeerInfoCurrentIndex int
@@ -38,6 +53,10 @@ type managedPeersHolder struct {
 	defaultName                 string
 	defaultIdentity             string
 	p2pKeyConverter             p2p.P2PKeyConverter
+	isMultiKeyMode              bool
+
+	mutPendingChanges sync.Mutex
+	pendingChanges    []*pendingKeyChange
 }
 
 // ArgsManagedPeersHolder represents the argument for the managed peers holder
@@ -67,6 +86,7 @@ func NewManagedPeersHolder(args ArgsManagedPeersHolder) (*managedPeersHolder, er
 		defaultIdentity:             args.PrefsConfig.Preferences.Identity,
 		p2pKeyConverter:             args.P2PKeyConverter,
 		data:                        make(map[string]*peerInfo),
+		pendingChanges:              make([]*pendingKeyChange, 0),
 	}
 
 	holder.providedIdentities, err = holder.createProvidedIdentitiesMap(args.PrefsConfig.NamedIdentity)
@@ -139,10 +159,21 @@ func (holder *managedPeersHolder) AddManagedPeer(privateKeyBytes []byte) error {
 		return fmt.Errorf("%w for provided bytes %s", err, hex.EncodeToString(privateKeyBytes))
 	}
 
+	return holder.AddManagedPeerFromPrivateKey(privateKey)
+}
+
+// AddManagedPeerFromPrivateKey will try to add a new managed peer providing the private key.
+// It errors if the generated public key is already contained by the struct
+// It will auto-generate some fields like the machineID and pid
+func (holder *managedPeersHolder) AddManagedPeerFromPrivateKey(privateKey crypto.PrivateKey) error {
+	if check.IfNil(privateKey) {
+		return ErrNilPrivateKey
+	}
+
 	publicKey := privateKey.GeneratePublic()
 	publicKeyBytes, err := publicKey.ToByteArray()
 	if err != nil {
-		return fmt.Errorf("%w for provided bytes %s", err, hex.EncodeToString(privateKeyBytes))
+		return err
 	}
 
 	p2pPrivateKey, p2pPublicKey := holder.p2pKeyGenerator.GeneratePair()
@@ -162,8 +193,7 @@ func (holder *managedPeersHolder) AddManagedPeer(privateKeyBytes []byte) error {
 
 	pInfo, found := holder.data[string(publicKeyBytes)]
 	if found && len(pInfo.pid.Bytes()) != 0 {
-		return fmt.Errorf("%w for provided bytes %s and generated public key %s",
-			ErrDuplicatedKey, hex.EncodeToString(privateKeyBytes), hex.EncodeToString(publicKeyBytes))
+		return fmt.Errorf("%w for generated public key %s", ErrDuplicatedKey, hex.EncodeToString(publicKeyBytes))
 	}
 
 	pInfo, found = holder.providedIdentities[string(publicKeyBytes)]
@@ -180,8 +210,10 @@ func (holder *managedPeersHolder) AddManagedPeer(privateKeyBytes []byte) error {
 	pInfo.pid = pid
 	pInfo.p2pPrivateKeyBytes = p2pPrivateKeyBytes
 	pInfo.privateKey = privateKey
+	pInfo.setPaused(false)
 	holder.data[string(publicKeyBytes)] = pInfo
 	holder.pids[pid] = struct{}{}
+	holder.isMultiKeyMode = true
 
 	log.Debug("added new key definition",
 		"hex public key", hex.EncodeToString(publicKeyBytes),
@@ -193,6 +225,157 @@ func (holder *managedPeersHolder) AddManagedPeer(privateKeyBytes []byte) error {
 	return nil
 }
 
+// ScheduleAddManagedPeer validates the provided private key bytes and schedules the key to be added as a managed key
+// when the pending changes are applied. Returns the public key bytes of the scheduled key
+func (holder *managedPeersHolder) ScheduleAddManagedPeer(privateKeyBytes []byte) ([]byte, error) {
+	if !holder.IsMultiKeyMode() {
+		return nil, ErrNodeNotInMultiKeyMode
+	}
+
+	privateKey, err := holder.keyGenerator.PrivateKeyFromByteArray(privateKeyBytes)
+	if err != nil {
+		// do not output the provided bytes as they might be a valid private key
+		return nil, fmt.Errorf("%w, could not create the private key: %s", ErrInvalidKey, err.Error())
+	}
+
+	publicKeyBytes, err := privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	if holder.IsKeyRegistered(publicKeyBytes) {
+		return nil, fmt.Errorf("%w for public key %s", ErrDuplicatedKey, hex.EncodeToString(publicKeyBytes))
+	}
+
+	err = holder.schedule(&pendingKeyChange{
+		changeType: addKeyChange,
+		publicKey:  publicKeyBytes,
+		privateKey: privateKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return publicKeyBytes, nil
+}
+
+// ScheduleRemoveManagedPeer schedules the provided managed key to be removed when the pending changes are applied
+func (holder *managedPeersHolder) ScheduleRemoveManagedPeer(pkBytes []byte) error {
+	return holder.scheduleChangeForRegisteredKey(removeKeyChange, pkBytes)
+}
+
+// SchedulePauseManagedPeer schedules the provided managed key to be paused when the pending changes are applied.
+// A paused key remains loaded but the node will not sign and will not send heartbeat messages on its behalf
+func (holder *managedPeersHolder) SchedulePauseManagedPeer(pkBytes []byte) error {
+	return holder.scheduleChangeForRegisteredKey(pauseKeyChange, pkBytes)
+}
+
+// ScheduleResumeManagedPeer schedules the provided paused key to be resumed when the pending changes are applied
+func (holder *managedPeersHolder) ScheduleResumeManagedPeer(pkBytes []byte) error {
+	return holder.scheduleChangeForRegisteredKey(resumeKeyChange, pkBytes)
+}
+
+func (holder *managedPeersHolder) scheduleChangeForRegisteredKey(changeType keyChangeType, pkBytes []byte) error {
+	if !holder.IsKeyRegistered(pkBytes) {
+		return fmt.Errorf("%w for public key %s", ErrMissingPublicKeyDefinition, hex.EncodeToString(pkBytes))
+	}
+
+	return holder.schedule(&pendingKeyChange{
+		changeType: changeType,
+		publicKey:  pkBytes,
+	})
+}
+
+func (holder *managedPeersHolder) schedule(change *pendingKeyChange) error {
+	holder.mutPendingChanges.Lock()
+	defer holder.mutPendingChanges.Unlock()
+
+	for _, pending := range holder.pendingChanges {
+		if bytes.Equal(pending.publicKey, change.publicKey) {
+			return fmt.Errorf("%w, a %s operation is already pending for public key %s",
+				ErrPendingKeyChange, pending.changeType, hex.EncodeToString(change.publicKey))
+		}
+	}
+
+	holder.pendingChanges = append(holder.pendingChanges, change)
+	log.Info("scheduled managed key change", "change", change.changeType,
+		"public key", hex.EncodeToString(change.publicKey))
+
+	return nil
+}
+
+// ApplyPendingChanges applies all the scheduled managed keys changes, in the order they were scheduled. It should be
+// called at a round boundary so the consensus and the heartbeat senders work with the same set of keys during a round
+func (holder *managedPeersHolder) ApplyPendingChanges() {
+	holder.mutPendingChanges.Lock()
+	changes := holder.pendingChanges
+	holder.pendingChanges = make([]*pendingKeyChange, 0)
+	holder.mutPendingChanges.Unlock()
+
+	for _, change := range changes {
+		err := holder.applyChange(change)
+		if err != nil {
+			log.Error("could not apply managed key change", "change", change.changeType,
+				"public key", hex.EncodeToString(change.publicKey), "error", err)
+			continue
+		}
+
+		log.Info("applied managed key change", "change", change.changeType,
+			"public key", hex.EncodeToString(change.publicKey))
+	}
+}
+
+func (holder *managedPeersHolder) applyChange(change *pendingKeyChange) error {
+	switch change.changeType {
+	case addKeyChange:
+		return holder.AddManagedPeerFromPrivateKey(change.privateKey)
+	case removeKeyChange:
+		return holder.removeManagedPeer(change.publicKey)
+	case pauseKeyChange:
+		return holder.setPeerPaused(change.publicKey, true)
+	case resumeKeyChange:
+		return holder.setPeerPaused(change.publicKey, false)
+	default:
+		return fmt.Errorf("%w, unknown change type %s", ErrInvalidValue, change.changeType)
+	}
+}
+
+func (holder *managedPeersHolder) removeManagedPeer(pkBytes []byte) error {
+	holder.mut.Lock()
+	defer holder.mut.Unlock()
+
+	pInfo, found := holder.data[string(pkBytes)]
+	if !found {
+		return fmt.Errorf("%w for public key %s", ErrMissingPublicKeyDefinition, hex.EncodeToString(pkBytes))
+	}
+
+	delete(holder.data, string(pkBytes))
+	delete(holder.pids, pInfo.pid)
+
+	return nil
+}
+
+func (holder *managedPeersHolder) setPeerPaused(pkBytes []byte, paused bool) error {
+	pInfo := holder.getPeerInfo(pkBytes)
+	if pInfo == nil {
+		return fmt.Errorf("%w for public key %s", ErrMissingPublicKeyDefinition, hex.EncodeToString(pkBytes))
+	}
+
+	pInfo.setPaused(paused)
+
+	return nil
+}
+
+// IsKeyPaused returns true if the provided managed key was paused
+func (holder *managedPeersHolder) IsKeyPaused(pkBytes []byte) bool {
+	pInfo := holder.getPeerInfo(pkBytes)
+	if pInfo == nil {
+		return false
+	}
+
+	return pInfo.isKeyPaused()
+}
+
 func (holder *managedPeersHolder) getPeerInfo(pkBytes []byte) *peerInfo {
 	holder.mut.RLock()
 	defer holder.mut.RUnlock()
@@ -384,12 +567,13 @@ func (holder *managedPeersHolder) SetNextPeerAuthenticationTime(pkBytes []byte,
 	pInfo.setNextPeerAuthenticationTime(nextTime)
 }
 
-// IsMultiKeyMode returns true if the node has at least one managed key, regardless it was set as a main machine or a backup machine
+// IsMultiKeyMode returns true if the node managed at least one key, regardless it was set as a main machine or a backup machine.
+// The node remains in multikey mode even if all its managed keys are removed afterwards
 func (holder *managedPeersHolder) IsMultiKeyMode() bool {
 	holder.mut.RLock()
 	defer holder.mut.RUnlock()
 
-	return len(holder.data) > 0
+	return holder.isMultiKeyMode
 }
 
 // GetRedundancyStepInReason returns the reason if the current node stepped in as a redundancy node
@@ -351,6 +351,47 @@ func TestManagedPeersHolder_AddManagedPeer(t *testing.T) {
 	})
 }
 
+func TestManagedPeersHolder_AddManagedPeerFromPrivateKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil private key should error", func(t *testing.T) {
+		holder, _ := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+		err := holder.AddManagedPeerFromPrivateKey(nil)
+
+		assert.Equal(t, keysManagement.ErrNilPrivateKey, err)
+	})
+	t.Run("should work without using the key generator", func(t *testing.T) {
+		args := createMockArgsManagedPeersHolder()
+		args.KeyGenerator = &cryptoMocks.KeyGenStub{
+			PrivateKeyFromByteArrayStub: func(b []byte) (crypto.PrivateKey, error) {
+				assert.Fail(t, "should have not called PrivateKeyFromByteArray")
+				return nil, nil
+			},
+		}
+		sk := &cryptoMocks.PrivateKeyStub{
+			GeneratePublicStub: func() crypto.PublicKey {
+				return &cryptoMocks.PublicKeyStub{
+					ToByteArrayStub: func() ([]byte, error) {
+						return pkBytes0, nil
+					},
+				}
+			},
+		}
+
+		holder, _ := keysManagement.NewManagedPeersHolder(args)
+		err := holder.AddManagedPeerFromPrivateKey(sk)
+		assert.Nil(t, err)
+
+		pInfo := holder.GetPeerInfo(pkBytes0)
+		assert.NotNil(t, pInfo)
+		assert.True(t, pInfo.PrivateKey() == sk)
+		assert.Equal(t, pid, pInfo.Pid())
+
+		err = holder.AddManagedPeerFromPrivateKey(sk)
+		assert.True(t, errors.Is(err, keysManagement.ErrDuplicatedKey))
+	})
+}
+
 func TestManagedPeersHolder_GetPrivateKey(t *testing.T) {
 	t.Parallel()
 
@@ -1017,6 +1058,190 @@ func TestManagedPeersHolder_GetRedundancyStepInReason(t *testing.T) {
 	})
 }
 
+func TestManagedPeersHolder_ScheduleAddManagedPeer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("node not in multikey mode should error", func(t *testing.T) {
+		t.Parallel()
+
+		holder, _ := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+		pk, err := holder.ScheduleAddManagedPeer(skBytes0)
+		assert.Equal(t, keysManagement.ErrNodeNotInMultiKeyMode, err)
+		assert.Nil(t, pk)
+	})
+	t.Run("invalid private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedPeersHolder()
+		args.KeyGenerator = &cryptoMocks.KeyGenStub{
+			PrivateKeyFromByteArrayStub: func(b []byte) (crypto.PrivateKey, error) {
+				if bytes.Equal(b, skBytes1) {
+					return nil, errors.New("invalid key")
+				}
+
+				return createMockKeyGenerator().PrivateKeyFromByteArray(b)
+			},
+		}
+		holder, _ := keysManagement.NewManagedPeersHolder(args)
+		_ = holder.AddManagedPeer(skBytes0)
+
+		pk, err := holder.ScheduleAddManagedPeer(skBytes1)
+		assert.True(t, errors.Is(err, keysManagement.ErrInvalidKey))
+		assert.False(t, strings.Contains(err.Error(), hex.EncodeToString(skBytes1)))
+		assert.Nil(t, pk)
+	})
+	t.Run("already loaded key should error", func(t *testing.T) {
+		t.Parallel()
+
+		holder, _ := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+		_ = holder.AddManagedPeer(skBytes0)
+
+		pk, err := holder.ScheduleAddManagedPeer(skBytes0)
+		assert.True(t, errors.Is(err, keysManagement.ErrDuplicatedKey))
+		assert.Nil(t, pk)
+	})
+	t.Run("change already pending for the key should error", func(t *testing.T) {
+		t.Parallel()
+
+		holder, _ := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+		_ = holder.AddManagedPeer(skBytes0)
+
+		_, err := holder.ScheduleAddManagedPeer(skBytes1)
+		assert.Nil(t, err)
+
+		pk, err := holder.ScheduleAddManagedPeer(skBytes1)
+		assert.True(t, errors.Is(err, keysManagement.ErrPendingKeyChange))
+		assert.Nil(t, pk)
+	})
+	t.Run("should add the key only after the pending changes are applied", func(t *testing.T) {
+		t.Parallel()
+
+		holder, _ := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+		_ = holder.AddManagedPeer(skBytes0)
+
+		pk, err := holder.ScheduleAddManagedPeer(skBytes1)
+		assert.Nil(t, err)
+		assert.Equal(t, pkBytes1, pk)
+		assert.False(t, holder.IsKeyRegistered(pkBytes1))
+		testManagedKeys(t, holder.GetManagedKeysByCurrentNode(), pkBytes0)
+
+		holder.ApplyPendingChanges()
+		assert.True(t, holder.IsKeyRegistered(pkBytes1))
+		testManagedKeys(t, holder.GetManagedKeysByCurrentNode(), pkBytes0, pkBytes1)
+
+		_, err = holder.ScheduleAddManagedPeer(skBytes1)
+		assert.True(t, errors.Is(err, keysManagement.ErrDuplicatedKey))
+	})
+}
+
+func TestManagedPeersHolder_ScheduleRemoveManagedPeer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing key should error", func(t *testing.T) {
+		t.Parallel()
+
+		holder, _ := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+		err := holder.ScheduleRemoveManagedPeer(pkBytes0)
+		assert.True(t, errors.Is(err, keysManagement.ErrMissingPublicKeyDefinition))
+	})
+	t.Run("should remove the key only after the pending changes are applied", func(t *testing.T) {
+		t.Parallel()
+
+		holder, _ := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+		_ = holder.AddManagedPeer(skBytes0)
+
+		err := holder.ScheduleRemoveManagedPeer(pkBytes0)
+		assert.Nil(t, err)
+		assert.True(t, holder.IsKeyManagedByCurrentNode(pkBytes0))
+
+		err = holder.SchedulePauseManagedPeer(pkBytes0)
+		assert.True(t, errors.Is(err, keysManagement.ErrPendingKeyChange))
+
+		holder.ApplyPendingChanges()
+		assert.False(t, holder.IsKeyRegistered(pkBytes0))
+		assert.False(t, holder.IsPidManagedByCurrentNode(pid))
+		assert.Equal(t, 0, len(holder.GetLoadedKeysByCurrentNode()))
+		assert.True(t, holder.IsMultiKeyMode())
+
+		// the key can be added back
+		_, err = holder.ScheduleAddManagedPeer(skBytes0)
+		assert.Nil(t, err)
+		holder.ApplyPendingChanges()
+		assert.True(t, holder.IsKeyManagedByCurrentNode(pkBytes0))
+	})
+}
+
+func TestManagedPeersHolder_SchedulePauseAndResumeManagedPeer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing key should error", func(t *testing.T) {
+		t.Parallel()
+
+		holder, _ := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+		err := holder.SchedulePauseManagedPeer(pkBytes0)
+		assert.True(t, errors.Is(err, keysManagement.ErrMissingPublicKeyDefinition))
+
+		err = holder.ScheduleResumeManagedPeer(pkBytes0)
+		assert.True(t, errors.Is(err, keysManagement.ErrMissingPublicKeyDefinition))
+	})
+	t.Run("should pause and resume the key after the pending changes are applied", func(t *testing.T) {
+		t.Parallel()
+
+		holder, _ := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+		_ = holder.AddManagedPeer(skBytes0)
+		_ = holder.AddManagedPeer(skBytes1)
+
+		err := holder.SchedulePauseManagedPeer(pkBytes0)
+		assert.Nil(t, err)
+		assert.False(t, holder.IsKeyPaused(pkBytes0))
+
+		holder.ApplyPendingChanges()
+		assert.True(t, holder.IsKeyPaused(pkBytes0))
+		assert.True(t, holder.IsKeyRegistered(pkBytes0))
+		assert.False(t, holder.IsKeyManagedByCurrentNode(pkBytes0))
+		testManagedKeys(t, holder.GetManagedKeysByCurrentNode(), pkBytes1)
+		assert.Equal(t, 2, len(holder.GetLoadedKeysByCurrentNode()))
+
+		err = holder.ScheduleResumeManagedPeer(pkBytes0)
+		assert.Nil(t, err)
+		assert.True(t, holder.IsKeyPaused(pkBytes0))
+
+		holder.ApplyPendingChanges()
+		assert.False(t, holder.IsKeyPaused(pkBytes0))
+		testManagedKeys(t, holder.GetManagedKeysByCurrentNode(), pkBytes0, pkBytes1)
+	})
+}
+
+func TestManagedPeersHolder_ApplyPendingChanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no pending changes should not panic", func(t *testing.T) {
+		t.Parallel()
+
+		holder, _ := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+		holder.ApplyPendingChanges()
+		assert.False(t, holder.IsMultiKeyMode())
+	})
+	t.Run("failing change should not stop the rest of the changes", func(t *testing.T) {
+		t.Parallel()
+
+		holder, _ := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+		_ = holder.AddManagedPeer(skBytes0)
+
+		_, err := holder.ScheduleAddManagedPeer(skBytes1)
+		assert.Nil(t, err)
+		err = holder.ScheduleRemoveManagedPeer(pkBytes0)
+		assert.Nil(t, err)
+
+		// key added in the meantime, the scheduled addition will fail
+		_ = holder.AddManagedPeer(skBytes1)
+
+		holder.ApplyPendingChanges()
+		assert.False(t, holder.IsKeyRegistered(pkBytes0))
+		assert.True(t, holder.IsKeyManagedByCurrentNode(pkBytes1))
+	})
+}
+
 func TestManagedPeersHolder_ParallelOperationsShouldNotPanic(t *testing.T) {
 	defer func() {
 		r := recover()
@@ -1068,10 +1293,18 @@ func TestManagedPeersHolder_ParallelOperationsShouldNotPanic(t *testing.T) {
 				holder.SetNextPeerAuthenticationTime(pkBytes0, time.Now())
 			case 14:
 				_ = holder.GetRedundancyStepInReason()
+			case 15:
+				_, _ = holder.ScheduleAddManagedPeer(skBytes1)
+			case 16:
+				_ = holder.SchedulePauseManagedPeer(pkBytes0)
+			case 17:
+				holder.ApplyPendingChanges()
+			case 18:
+				_ = holder.IsKeyPaused(pkBytes0)
 			}
 
 			wg.Done()
-		}(i % 15)
+		}(i % 19)
 	}
 
 	wg.Wait()
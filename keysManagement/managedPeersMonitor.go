@@ -123,6 +123,27 @@ func (monitor *managedPeersMonitor) extractManagedIntraShardKeys(keysMap map[uin
 	return managedKeys, nil
 }
 
+// AddManagedKey schedules the addition of the provided private key as a managed key. The change is applied at the
+// start of the next round. Returns the public key bytes of the scheduled key
+func (monitor *managedPeersMonitor) AddManagedKey(privateKeyBytes []byte) ([]byte, error) {
+	return monitor.managedPeersHolder.ScheduleAddManagedPeer(privateKeyBytes)
+}
+
+// RemoveManagedKey schedules the removal of the provided managed key. The change is applied at the start of the next round
+func (monitor *managedPeersMonitor) RemoveManagedKey(pkBytes []byte) error {
+	return monitor.managedPeersHolder.ScheduleRemoveManagedPeer(pkBytes)
+}
+
+// PauseManagedKey schedules the pausing of the provided managed key. The change is applied at the start of the next round
+func (monitor *managedPeersMonitor) PauseManagedKey(pkBytes []byte) error {
+	return monitor.managedPeersHolder.SchedulePauseManagedPeer(pkBytes)
+}
+
+// ResumeManagedKey schedules the resuming of the provided managed key. The change is applied at the start of the next round
+func (monitor *managedPeersMonitor) ResumeManagedKey(pkBytes []byte) error {
+	return monitor.managedPeersHolder.ScheduleResumeManagedPeer(pkBytes)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (monitor *managedPeersMonitor) IsInterfaceNil() bool {
 	return monitor == nil
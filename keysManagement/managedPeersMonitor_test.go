@@ -298,3 +298,46 @@ func TestManagedPeersMonitor_GetLoadedKeys(t *testing.T) {
 	keys := monitor.GetLoadedKeys()
 	require.Equal(t, loadedKeys, keys)
 }
+
+func TestManagedPeersMonitor_ManagedKeysChanges(t *testing.T) {
+	t.Parallel()
+
+	providedSk := []byte("sk")
+	providedPk := []byte("pk")
+	calledMethods := make(map[string]int)
+	args := createMockArgManagedPeersMonitor()
+	args.ManagedPeersHolder = &testscommon.ManagedPeersHolderStub{
+		ScheduleAddManagedPeerCalled: func(privateKeyBytes []byte) ([]byte, error) {
+			require.Equal(t, providedSk, privateKeyBytes)
+			calledMethods["add"]++
+			return providedPk, nil
+		},
+		ScheduleRemoveManagedPeerCalled: func(pkBytes []byte) error {
+			require.Equal(t, providedPk, pkBytes)
+			calledMethods["remove"]++
+			return nil
+		},
+		SchedulePauseManagedPeerCalled: func(pkBytes []byte) error {
+			require.Equal(t, providedPk, pkBytes)
+			calledMethods["pause"]++
+			return nil
+		},
+		ScheduleResumeManagedPeerCalled: func(pkBytes []byte) error {
+			require.Equal(t, providedPk, pkBytes)
+			calledMethods["resume"]++
+			return nil
+		},
+	}
+	monitor, err := NewManagedPeersMonitor(args)
+	require.NoError(t, err)
+
+	pk, err := monitor.AddManagedKey(providedSk)
+	require.NoError(t, err)
+	require.Equal(t, providedPk, pk)
+	require.NoError(t, monitor.RemoveManagedKey(providedPk))
+	require.NoError(t, monitor.PauseManagedKey(providedPk))
+	require.NoError(t, monitor.ResumeManagedKey(providedPk))
+
+	expectedCalls := map[string]int{"add": 1, "remove": 1, "pause": 1, "resume": 1}
+	require.Equal(t, expectedCalls, calledMethods)
+}
@@ -27,6 +27,7 @@ type peerInfo struct {
 	handler                    redundancyHandler
 	nextPeerAuthenticationTime time.Time
 	isValidator                bool
+	isPaused                   bool
 }
 
 func (pInfo *peerInfo) incrementRoundsWithoutReceivedMessages() {
@@ -45,9 +46,27 @@ func (pInfo *peerInfo) shouldActAsValidator(maxRoundsOfInactivity int) bool {
 	pInfo.mutChangeableData.RLock()
 	defer pInfo.mutChangeableData.RUnlock()
 
+	if pInfo.isPaused {
+		return false
+	}
+
 	return pInfo.handler.ShouldActAsValidator(maxRoundsOfInactivity)
 }
 
+func (pInfo *peerInfo) isKeyPaused() bool {
+	pInfo.mutChangeableData.RLock()
+	defer pInfo.mutChangeableData.RUnlock()
+
+	return pInfo.isPaused
+}
+
+func (pInfo *peerInfo) setPaused(value bool) {
+	pInfo.mutChangeableData.Lock()
+	defer pInfo.mutChangeableData.Unlock()
+
+	pInfo.isPaused = value
+}
+
 func (pInfo *peerInfo) isNodeValidator() bool {
 	pInfo.mutChangeableData.RLock()
 	defer pInfo.mutChangeableData.RUnlock()
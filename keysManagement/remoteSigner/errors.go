@@ -0,0 +1,66 @@
+package remoteSigner
+
+import "errors"
+
+// ErrNilKeyGenerator signals that a nil key generator was provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilSingleSigner signals that a nil single signer was provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilMultiSignerContainer signals that a nil multi signer container was provided
+var ErrNilMultiSignerContainer = errors.New("nil multi signer container")
+
+// ErrNilSignerClient signals that a nil remote signer client was provided
+var ErrNilSignerClient = errors.New("nil remote signer client")
+
+// ErrNilPublicKey signals that a nil public key was provided
+var ErrNilPublicKey = errors.New("nil public key")
+
+// ErrInvalidNetwork signals that an unsupported network type was provided
+var ErrInvalidNetwork = errors.New("invalid network, supported values are unix and tcp")
+
+// ErrEmptyAddress signals that an empty address was provided
+var ErrEmptyAddress = errors.New("empty address")
+
+// ErrInvalidAuthKey signals that the provided authentication key is invalid
+var ErrInvalidAuthKey = errors.New("invalid authentication key")
+
+// ErrInvalidRequestTimeout signals that an invalid request timeout was provided
+var ErrInvalidRequestTimeout = errors.New("invalid request timeout")
+
+// ErrNoKeys signals that no keys were provided to the remote signer
+var ErrNoKeys = errors.New("no keys provided")
+
+// ErrDuplicatedKey signals that the same key was provided more than once
+var ErrDuplicatedKey = errors.New("duplicated key")
+
+// ErrMessageTooLarge signals that a message exceeding the maximum allowed size was received
+var ErrMessageTooLarge = errors.New("message too large")
+
+// ErrInvalidMAC signals that the authentication code of a message does not match
+var ErrInvalidMAC = errors.New("invalid message authentication code")
+
+// ErrRequestExpired signals that a request with a timestamp outside of the accepted window was received
+var ErrRequestExpired = errors.New("request expired")
+
+// ErrReplayedRequest signals that a request was received more than once
+var ErrReplayedRequest = errors.New("replayed request")
+
+// ErrRequestIDMismatch signals that a response does not match the sent request
+var ErrRequestIDMismatch = errors.New("request ID mismatch")
+
+// ErrUnknownRequestType signals that a request of an unknown type was received
+var ErrUnknownRequestType = errors.New("unknown request type")
+
+// ErrUnknownPublicKey signals that the requested public key is not held by the remote signer
+var ErrUnknownPublicKey = errors.New("unknown public key")
+
+// ErrNilMessage signals that a nil message was provided for signing
+var ErrNilMessage = errors.New("nil message")
+
+// ErrRemoteSigner signals that the remote signer could not fulfill a request
+var ErrRemoteSigner = errors.New("remote signer error")
+
+// ErrNotARemotePrivateKey signals that the provided private key is not held by the remote signer
+var ErrNotARemotePrivateKey = errors.New("not a remote private key")
@@ -0,0 +1,34 @@
+package remoteSigner
+
+import (
+	"io"
+	"time"
+)
+
+// MessageCodec -
+type MessageCodec = messageCodec
+
+// NewMessageCodec -
+func NewMessageCodec(authKey []byte) *messageCodec {
+	return newMessageCodec(authKey)
+}
+
+// Write -
+func (codec *messageCodec) Write(w io.Writer, message interface{}) error {
+	return codec.write(w, message)
+}
+
+// Read -
+func (codec *messageCodec) Read(r io.Reader, message interface{}) error {
+	return codec.read(r, message)
+}
+
+// SetTimeHandler -
+func (server *signerServer) SetTimeHandler(handler func() time.Time) {
+	server.getTimeHandler = handler
+}
+
+// ProcessRequest -
+func (server *signerServer) ProcessRequest(request *SignerRequest) *SignerResponse {
+	return server.processRequest(request)
+}
@@ -0,0 +1,9 @@
+package remoteSigner
+
+// SignerClient defines the operations supported by a client of the remote signer
+type SignerClient interface {
+	GetPublicKeys() (*PublicKeys, error)
+	Sign(publicKey []byte, message []byte) ([]byte, error)
+	SignShare(publicKey []byte, message []byte, epoch uint32) ([]byte, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,136 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=. remoteSigner.proto
+
+package remoteSigner
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/marshal"
+)
+
+const (
+	// UnixNetwork is the network type used to communicate over a Unix domain socket
+	UnixNetwork = "unix"
+	// TCPNetwork is the network type used to communicate over TCP, recommended only on the loopback interface
+	TCPNetwork = "tcp"
+
+	minAuthKeyLength = 32
+	frameHeaderSize  = 4
+	maxFrameSize     = 1 << 20
+)
+
+// messageCodec marshals, authenticates and frames the messages exchanged with the remote signer. Each message is sent
+// as a 4 bytes big endian length followed by a marshalled envelope holding the payload and its HMAC-SHA256 code
+type messageCodec struct {
+	authKey    []byte
+	marshaller marshal.Marshalizer
+}
+
+func newMessageCodec(authKey []byte) *messageCodec {
+	return &messageCodec{
+		authKey:    authKey,
+		marshaller: &marshal.GogoProtoMarshalizer{},
+	}
+}
+
+func (codec *messageCodec) write(w io.Writer, message interface{}) error {
+	payload, err := codec.marshaller.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	envelope := &SignerEnvelope{
+		Payload: payload,
+		MAC:     codec.computeMAC(payload),
+	}
+	envelopeBytes, err := codec.marshaller.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+	if len(envelopeBytes) > maxFrameSize {
+		return ErrMessageTooLarge
+	}
+
+	frame := make([]byte, frameHeaderSize, frameHeaderSize+len(envelopeBytes))
+	binary.BigEndian.PutUint32(frame, uint32(len(envelopeBytes)))
+	frame = append(frame, envelopeBytes...)
+
+	_, err = w.Write(frame)
+	return err
+}
+
+func (codec *messageCodec) read(r io.Reader, message interface{}) error {
+	header := make([]byte, frameHeaderSize)
+	_, err := io.ReadFull(r, header)
+	if err != nil {
+		return err
+	}
+
+	frameSize := binary.BigEndian.Uint32(header)
+	if frameSize > maxFrameSize {
+		return ErrMessageTooLarge
+	}
+
+	envelopeBytes := make([]byte, frameSize)
+	_, err = io.ReadFull(r, envelopeBytes)
+	if err != nil {
+		return err
+	}
+
+	envelope := &SignerEnvelope{}
+	err = codec.marshaller.Unmarshal(envelope, envelopeBytes)
+	if err != nil {
+		return err
+	}
+	if !hmac.Equal(envelope.MAC, codec.computeMAC(envelope.Payload)) {
+		return ErrInvalidMAC
+	}
+
+	return codec.marshaller.Unmarshal(message, envelope.Payload)
+}
+
+func (codec *messageCodec) computeMAC(payload []byte) []byte {
+	mac := hmac.New(sha256.New, codec.authKey)
+	_, _ = mac.Write(payload)
+
+	return mac.Sum(nil)
+}
+
+func checkConnectionArgs(network string, address string, authKey []byte) error {
+	if network != UnixNetwork && network != TCPNetwork {
+		return fmt.Errorf("%w, provided %s", ErrInvalidNetwork, network)
+	}
+	if len(address) == 0 {
+		return ErrEmptyAddress
+	}
+	if len(authKey) < minAuthKeyLength {
+		return fmt.Errorf("%w, the key should have at least %d bytes", ErrInvalidAuthKey, minAuthKeyLength)
+	}
+
+	return nil
+}
+
+// LoadAuthKey loads the hex encoded key used to authenticate the messages exchanged with the remote signer
+func LoadAuthKey(filePath string) ([]byte, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	authKey, err := hex.DecodeString(strings.TrimSpace(string(content)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAuthKey, err.Error())
+	}
+	if len(authKey) < minAuthKeyLength {
+		return nil, fmt.Errorf("%w, the key should have at least %d bytes", ErrInvalidAuthKey, minAuthKeyLength)
+	}
+
+	return authKey, nil
+}
@@ -0,0 +1,115 @@
+package remoteSigner_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+	"github.com/stretchr/testify/require"
+)
+
+var testAuthKey = bytes.Repeat([]byte{0xAB}, 32)
+
+func TestMessageCodec_WriteRead(t *testing.T) {
+	t.Parallel()
+
+	request := &remoteSigner.SignerRequest{
+		RequestID: []byte("request ID"),
+		Timestamp: 1234,
+		Type:      remoteSigner.SignShareRequest,
+		PublicKey: []byte("public key"),
+		Message:   []byte("message"),
+		Epoch:     7,
+	}
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		codec := remoteSigner.NewMessageCodec(testAuthKey)
+		buff := &bytes.Buffer{}
+		err := codec.Write(buff, request)
+		require.Nil(t, err)
+
+		recovered := &remoteSigner.SignerRequest{}
+		err = codec.Read(buff, recovered)
+		require.Nil(t, err)
+		require.Equal(t, request, recovered)
+	})
+	t.Run("different authentication key should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff := &bytes.Buffer{}
+		err := remoteSigner.NewMessageCodec(testAuthKey).Write(buff, request)
+		require.Nil(t, err)
+
+		otherKey := bytes.Repeat([]byte{0xCD}, 32)
+		err = remoteSigner.NewMessageCodec(otherKey).Read(buff, &remoteSigner.SignerRequest{})
+		require.Equal(t, remoteSigner.ErrInvalidMAC, err)
+	})
+	t.Run("tampered message should error", func(t *testing.T) {
+		t.Parallel()
+
+		codec := remoteSigner.NewMessageCodec(testAuthKey)
+		buff := &bytes.Buffer{}
+		err := codec.Write(buff, request)
+		require.Nil(t, err)
+
+		frame := buff.Bytes()
+		tamperedFrame := bytes.Replace(frame, []byte("message"), []byte("massage"), 1)
+		err = codec.Read(bytes.NewBuffer(tamperedFrame), &remoteSigner.SignerRequest{})
+		require.Equal(t, remoteSigner.ErrInvalidMAC, err)
+	})
+	t.Run("too large frame should error", func(t *testing.T) {
+		t.Parallel()
+
+		header := make([]byte, 4)
+		binary.BigEndian.PutUint32(header, 1<<30)
+		err := remoteSigner.NewMessageCodec(testAuthKey).Read(bytes.NewBuffer(header), &remoteSigner.SignerRequest{})
+		require.Equal(t, remoteSigner.ErrMessageTooLarge, err)
+	})
+}
+
+func TestLoadAuthKey(t *testing.T) {
+	t.Parallel()
+
+	writeFile := func(t *testing.T, content string) string {
+		filePath := filepath.Join(t.TempDir(), "auth.key")
+		err := os.WriteFile(filePath, []byte(content), 0600)
+		require.Nil(t, err)
+
+		return filePath
+	}
+
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		authKey, err := remoteSigner.LoadAuthKey(filepath.Join(t.TempDir(), "missing.key"))
+		require.NotNil(t, err)
+		require.Nil(t, authKey)
+	})
+	t.Run("invalid hex should error", func(t *testing.T) {
+		t.Parallel()
+
+		authKey, err := remoteSigner.LoadAuthKey(writeFile(t, "not hex"))
+		require.ErrorIs(t, err, remoteSigner.ErrInvalidAuthKey)
+		require.Nil(t, authKey)
+	})
+	t.Run("too short key should error", func(t *testing.T) {
+		t.Parallel()
+
+		authKey, err := remoteSigner.LoadAuthKey(writeFile(t, "abcd"))
+		require.ErrorIs(t, err, remoteSigner.ErrInvalidAuthKey)
+		require.Nil(t, authKey)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		authKey, err := remoteSigner.LoadAuthKey(writeFile(t, " "+hex.EncodeToString(testAuthKey)+"\n"))
+		require.Nil(t, err)
+		require.Equal(t, testAuthKey, authKey)
+	})
+}
@@ -0,0 +1,66 @@
+package remoteSigner
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+)
+
+// remotePrivateKey stands for a private key held by the remote signer. The node only knows the public key, so the
+// signatures with this key can only be created through the remote signer
+type remotePrivateKey struct {
+	publicKey      crypto.PublicKey
+	publicKeyBytes []byte
+}
+
+// NewRemotePrivateKey creates a new private key stand-in for the key held by the remote signer
+func NewRemotePrivateKey(publicKey crypto.PublicKey) (*remotePrivateKey, error) {
+	if check.IfNil(publicKey) {
+		return nil, ErrNilPublicKey
+	}
+
+	publicKeyBytes, err := publicKey.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	return &remotePrivateKey{
+		publicKey:      publicKey,
+		publicKeyBytes: publicKeyBytes,
+	}, nil
+}
+
+// ToByteArray returns the public key bytes, as the private key bytes never leave the remote signer. The returned
+// bytes can only be used to identify the key
+func (key *remotePrivateKey) ToByteArray() ([]byte, error) {
+	return key.publicKeyBytes, nil
+}
+
+// GeneratePublic returns the public key of the remote private key
+func (key *remotePrivateKey) GeneratePublic() crypto.PublicKey {
+	return key.publicKey
+}
+
+// Suite returns the suite of the public key
+func (key *remotePrivateKey) Suite() crypto.Suite {
+	return key.publicKey.Suite()
+}
+
+// Scalar returns nil, as the private key is not available
+func (key *remotePrivateKey) Scalar() crypto.Scalar {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (key *remotePrivateKey) IsInterfaceNil() bool {
+	return key == nil
+}
+
+// GetRemotePublicKey returns the public key bytes if the provided private key is held by the remote signer
+func GetRemotePublicKey(privateKey crypto.PrivateKey) ([]byte, bool) {
+	key, ok := privateKey.(*remotePrivateKey)
+	if !ok || key == nil {
+		return nil, false
+	}
+
+	return key.publicKeyBytes, true
+}
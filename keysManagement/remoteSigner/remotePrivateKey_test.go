@@ -0,0 +1,79 @@
+package remoteSigner_test
+
+import (
+	"errors"
+	"testing"
+
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRemotePrivateKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		key, err := remoteSigner.NewRemotePrivateKey(nil)
+		require.Equal(t, remoteSigner.ErrNilPublicKey, err)
+		require.Nil(t, key)
+	})
+	t.Run("public key bytes error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		key, err := remoteSigner.NewRemotePrivateKey(&cryptoMocks.PublicKeyStub{
+			ToByteArrayStub: func() ([]byte, error) {
+				return nil, expectedErr
+			},
+		})
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, key)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		suite := &cryptoMocks.SuiteMock{}
+		publicKey := &cryptoMocks.PublicKeyStub{
+			ToByteArrayStub: func() ([]byte, error) {
+				return []byte("pk"), nil
+			},
+			SuiteStub: func() crypto.Suite {
+				return suite
+			},
+		}
+		key, err := remoteSigner.NewRemotePrivateKey(publicKey)
+		require.Nil(t, err)
+		require.False(t, key.IsInterfaceNil())
+
+		keyBytes, err := key.ToByteArray()
+		require.Nil(t, err)
+		require.Equal(t, []byte("pk"), keyBytes)
+		require.True(t, key.GeneratePublic() == publicKey)
+		require.True(t, key.Suite() == suite)
+		require.Nil(t, key.Scalar())
+	})
+}
+
+func TestGetRemotePublicKey(t *testing.T) {
+	t.Parallel()
+
+	publicKeyBytes, isRemote := remoteSigner.GetRemotePublicKey(nil)
+	require.False(t, isRemote)
+	require.Nil(t, publicKeyBytes)
+
+	publicKeyBytes, isRemote = remoteSigner.GetRemotePublicKey(&cryptoMocks.PrivateKeyStub{})
+	require.False(t, isRemote)
+	require.Nil(t, publicKeyBytes)
+
+	key, _ := remoteSigner.NewRemotePrivateKey(&cryptoMocks.PublicKeyStub{
+		ToByteArrayStub: func() ([]byte, error) {
+			return []byte("pk"), nil
+		},
+	})
+	publicKeyBytes, isRemote = remoteSigner.GetRemotePublicKey(key)
+	require.True(t, isRemote)
+	require.Equal(t, []byte("pk"), publicKeyBytes)
+}
@@ -0,0 +1,1399 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: remoteSigner.proto
+
+package remoteSigner
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strconv "strconv"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SignerRequestType represents the type of a request sent to the remote signer
+type SignerRequestType int32
+
+const (
+	// InvalidRequest
+	InvalidRequest SignerRequestType = 0
+	// GetPublicKeysRequest asks for the public keys held by the remote signer
+	GetPublicKeysRequest SignerRequestType = 1
+	// SignRequest asks for a single signature (block signatures, randomness seeds, peer signatures)
+	SignRequest SignerRequestType = 2
+	// SignShareRequest asks for a multi-signature share, computed with the multi-signer active in the provided epoch
+	SignShareRequest SignerRequestType = 3
+)
+
+var SignerRequestType_name = map[int32]string{
+	0: "InvalidRequest",
+	1: "GetPublicKeysRequest",
+	2: "SignRequest",
+	3: "SignShareRequest",
+}
+
+var SignerRequestType_value = map[string]int32{
+	"InvalidRequest":       0,
+	"GetPublicKeysRequest": 1,
+	"SignRequest":          2,
+	"SignShareRequest":     3,
+}
+
+func (SignerRequestType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2f7acbcb6cbeed2c, []int{0}
+}
+
+// SignerRequest holds a request sent to the remote signer
+type SignerRequest struct {
+	RequestID []byte            `protobuf:"bytes,1,opt,name=RequestID,proto3" json:"RequestID,omitempty"`
+	Timestamp int64             `protobuf:"varint,2,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Type      SignerRequestType `protobuf:"varint,3,opt,name=Type,proto3,enum=proto.SignerRequestType" json:"Type,omitempty"`
+	PublicKey []byte            `protobuf:"bytes,4,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+	Message   []byte            `protobuf:"bytes,5,opt,name=Message,proto3" json:"Message,omitempty"`
+	Epoch     uint32            `protobuf:"varint,6,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+}
+
+func (m *SignerRequest) Reset()      { *m = SignerRequest{} }
+func (*SignerRequest) ProtoMessage() {}
+func (*SignerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f7acbcb6cbeed2c, []int{0}
+}
+func (m *SignerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SignerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerRequest.Merge(m, src)
+}
+func (m *SignerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerRequest proto.InternalMessageInfo
+
+func (m *SignerRequest) GetRequestID() []byte {
+	if m != nil {
+		return m.RequestID
+	}
+	return nil
+}
+
+func (m *SignerRequest) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *SignerRequest) GetType() SignerRequestType {
+	if m != nil {
+		return m.Type
+	}
+	return InvalidRequest
+}
+
+func (m *SignerRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *SignerRequest) GetMessage() []byte {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *SignerRequest) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// SignerResponse holds the response of the remote signer for a request
+type SignerResponse struct {
+	RequestID         []byte   `protobuf:"bytes,1,opt,name=RequestID,proto3" json:"RequestID,omitempty"`
+	Signature         []byte   `protobuf:"bytes,2,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	NodePublicKey     []byte   `protobuf:"bytes,3,opt,name=NodePublicKey,proto3" json:"NodePublicKey,omitempty"`
+	ManagedPublicKeys [][]byte `protobuf:"bytes,4,rep,name=ManagedPublicKeys,proto3" json:"ManagedPublicKeys,omitempty"`
+	Error             string   `protobuf:"bytes,5,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (m *SignerResponse) Reset()      { *m = SignerResponse{} }
+func (*SignerResponse) ProtoMessage() {}
+func (*SignerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f7acbcb6cbeed2c, []int{1}
+}
+func (m *SignerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SignerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerResponse.Merge(m, src)
+}
+func (m *SignerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerResponse proto.InternalMessageInfo
+
+func (m *SignerResponse) GetRequestID() []byte {
+	if m != nil {
+		return m.RequestID
+	}
+	return nil
+}
+
+func (m *SignerResponse) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *SignerResponse) GetNodePublicKey() []byte {
+	if m != nil {
+		return m.NodePublicKey
+	}
+	return nil
+}
+
+func (m *SignerResponse) GetManagedPublicKeys() [][]byte {
+	if m != nil {
+		return m.ManagedPublicKeys
+	}
+	return nil
+}
+
+func (m *SignerResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// SignerEnvelope wraps a marshalled request or response together with its authentication code
+type SignerEnvelope struct {
+	Payload []byte `protobuf:"bytes,1,opt,name=Payload,proto3" json:"Payload,omitempty"`
+	MAC     []byte `protobuf:"bytes,2,opt,name=MAC,proto3" json:"MAC,omitempty"`
+}
+
+func (m *SignerEnvelope) Reset()      { *m = SignerEnvelope{} }
+func (*SignerEnvelope) ProtoMessage() {}
+func (*SignerEnvelope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f7acbcb6cbeed2c, []int{2}
+}
+func (m *SignerEnvelope) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SignerEnvelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerEnvelope.Merge(m, src)
+}
+func (m *SignerEnvelope) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerEnvelope) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerEnvelope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerEnvelope proto.InternalMessageInfo
+
+func (m *SignerEnvelope) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *SignerEnvelope) GetMAC() []byte {
+	if m != nil {
+		return m.MAC
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("proto.SignerRequestType", SignerRequestType_name, SignerRequestType_value)
+	proto.RegisterType((*SignerRequest)(nil), "proto.SignerRequest")
+	proto.RegisterType((*SignerResponse)(nil), "proto.SignerResponse")
+	proto.RegisterType((*SignerEnvelope)(nil), "proto.SignerEnvelope")
+}
+
+func init() { proto.RegisterFile("remoteSigner.proto", fileDescriptor_2f7acbcb6cbeed2c) }
+
+var fileDescriptor_2f7acbcb6cbeed2c = []byte{
+	// 433 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x3d, 0x75, 0x52, 0xd4, 0x25, 0x09, 0xe9, 0x2a, 0x87, 0x15, 0x42, 0x2b, 0x2b, 0xe2,
+	0x60, 0xa1, 0x92, 0x4a, 0x70, 0xe5, 0xc2, 0x9f, 0x82, 0x2a, 0x14, 0x54, 0x6d, 0x7b, 0xe2, 0xb6,
+	0x49, 0x06, 0xc7, 0x52, 0xe2, 0x35, 0x6b, 0xbb, 0x52, 0x6e, 0x3c, 0x02, 0x8f, 0xc1, 0x13, 0xf0,
+	0x0a, 0x70, 0xcc, 0x31, 0x47, 0xb2, 0xb9, 0x70, 0xec, 0x23, 0xa0, 0x5d, 0xdb, 0x35, 0x55, 0x0e,
+	0x3d, 0x65, 0xbe, 0xdf, 0x37, 0x99, 0xf9, 0xc6, 0x5a, 0x42, 0x35, 0x2e, 0x55, 0x8e, 0x97, 0x71,
+	0x94, 0xa0, 0x1e, 0xa5, 0x5a, 0xe5, 0x8a, 0xb6, 0xdd, 0xcf, 0xe3, 0xe7, 0x51, 0x9c, 0xcf, 0x8b,
+	0xc9, 0x68, 0xaa, 0x96, 0xa7, 0x91, 0x8a, 0xd4, 0xa9, 0xc3, 0x93, 0xe2, 0x8b, 0x53, 0x4e, 0xb8,
+	0xaa, 0xfc, 0xd7, 0xf0, 0x17, 0x90, 0x6e, 0x39, 0x46, 0xe0, 0xd7, 0x02, 0xb3, 0x9c, 0x3e, 0x21,
+	0x47, 0x55, 0x79, 0xfe, 0x8e, 0x41, 0x00, 0x61, 0x47, 0x34, 0xc0, 0xba, 0x57, 0xf1, 0x12, 0xb3,
+	0x5c, 0x2e, 0x53, 0x76, 0x10, 0x40, 0xe8, 0x8b, 0x06, 0xd0, 0x13, 0xd2, 0xba, 0x5a, 0xa5, 0xc8,
+	0xfc, 0x00, 0xc2, 0xde, 0x0b, 0x56, 0xee, 0x18, 0xdd, 0x99, 0x6f, 0x7d, 0xe1, 0xba, 0xec, 0xac,
+	0x8b, 0x62, 0xb2, 0x88, 0xa7, 0x1f, 0x71, 0xc5, 0x5a, 0xe5, 0xa6, 0x5b, 0x40, 0x19, 0x79, 0x30,
+	0xc6, 0x2c, 0x93, 0x11, 0xb2, 0xb6, 0xf3, 0x6a, 0x49, 0x07, 0xa4, 0x7d, 0x96, 0xaa, 0xe9, 0x9c,
+	0x1d, 0x06, 0x10, 0x76, 0x45, 0x29, 0x86, 0x3f, 0x81, 0xf4, 0xea, 0x4d, 0x59, 0xaa, 0x92, 0x0c,
+	0xef, 0x3f, 0xc5, 0xf6, 0xcb, 0xbc, 0xd0, 0xe8, 0x4e, 0xe9, 0x88, 0x06, 0xd0, 0xa7, 0xa4, 0xfb,
+	0x49, 0xcd, 0xb0, 0x09, 0xe8, 0xbb, 0x8e, 0xbb, 0x90, 0x9e, 0x90, 0xe3, 0xb1, 0x4c, 0x64, 0x84,
+	0xb3, 0x5b, 0x96, 0xb1, 0x56, 0xe0, 0x87, 0x1d, 0xb1, 0x6f, 0xb8, 0xe0, 0x5a, 0x2b, 0xed, 0x0e,
+	0x3a, 0x12, 0xa5, 0x18, 0xbe, 0xaa, 0x73, 0x9f, 0x25, 0xd7, 0xb8, 0x50, 0x29, 0xda, 0xd3, 0x2f,
+	0xe4, 0x6a, 0xa1, 0xe4, 0xac, 0x4a, 0x5d, 0x4b, 0xda, 0x27, 0xfe, 0xf8, 0xf5, 0xdb, 0x2a, 0xad,
+	0x2d, 0x9f, 0xcd, 0xc9, 0xf1, 0xde, 0xf7, 0xa5, 0x94, 0xf4, 0xce, 0x93, 0x6b, 0xb9, 0x88, 0x67,
+	0x15, 0xed, 0x7b, 0x94, 0x91, 0xc1, 0x07, 0xcc, 0x9b, 0x34, 0xb5, 0x03, 0xf4, 0x11, 0x79, 0x68,
+	0x47, 0xd4, 0xe0, 0x80, 0x0e, 0x48, 0xdf, 0x82, 0xcb, 0xb9, 0xd4, 0x58, 0x53, 0xff, 0xcd, 0xfb,
+	0xf5, 0x96, 0x7b, 0x9b, 0x2d, 0xf7, 0x6e, 0xb6, 0x1c, 0xbe, 0x19, 0x0e, 0x3f, 0x0c, 0x87, 0xdf,
+	0x86, 0xc3, 0xda, 0x70, 0xd8, 0x18, 0x0e, 0x7f, 0x0c, 0x87, 0xbf, 0x86, 0x7b, 0x37, 0x86, 0xc3,
+	0xf7, 0x1d, 0xf7, 0xd6, 0x3b, 0xee, 0x6d, 0x76, 0xdc, 0xfb, 0xdc, 0xf9, 0xff, 0xb9, 0x4e, 0x0e,
+	0xdd, 0xab, 0x78, 0xf9, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x17, 0xb5, 0xa6, 0xd4, 0xc5, 0x02, 0x00,
+	0x00,
+}
+
+func (x SignerRequestType) String() string {
+	s, ok := SignerRequestType_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *SignerRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SignerRequest)
+	if !ok {
+		that2, ok := that.(SignerRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.RequestID, that1.RequestID) {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.PublicKey, that1.PublicKey) {
+		return false
+	}
+	if !bytes.Equal(this.Message, that1.Message) {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	return true
+}
+func (this *SignerResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SignerResponse)
+	if !ok {
+		that2, ok := that.(SignerResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.RequestID, that1.RequestID) {
+		return false
+	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	if !bytes.Equal(this.NodePublicKey, that1.NodePublicKey) {
+		return false
+	}
+	if len(this.ManagedPublicKeys) != len(that1.ManagedPublicKeys) {
+		return false
+	}
+	for i := range this.ManagedPublicKeys {
+		if !bytes.Equal(this.ManagedPublicKeys[i], that1.ManagedPublicKeys[i]) {
+			return false
+		}
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *SignerEnvelope) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SignerEnvelope)
+	if !ok {
+		that2, ok := that.(SignerEnvelope)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	if !bytes.Equal(this.MAC, that1.MAC) {
+		return false
+	}
+	return true
+}
+func (this *SignerRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&remoteSigner.SignerRequest{")
+	s = append(s, "RequestID: "+fmt.Sprintf("%#v", this.RequestID)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "PublicKey: "+fmt.Sprintf("%#v", this.PublicKey)+",\n")
+	s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SignerResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&remoteSigner.SignerResponse{")
+	s = append(s, "RequestID: "+fmt.Sprintf("%#v", this.RequestID)+",\n")
+	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
+	s = append(s, "NodePublicKey: "+fmt.Sprintf("%#v", this.NodePublicKey)+",\n")
+	s = append(s, "ManagedPublicKeys: "+fmt.Sprintf("%#v", this.ManagedPublicKeys)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SignerEnvelope) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&remoteSigner.SignerEnvelope{")
+	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
+	s = append(s, "MAC: "+fmt.Sprintf("%#v", this.MAC)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringRemoteSigner(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *SignerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RequestID) > 0 {
+		i -= len(m.RequestID)
+		copy(dAtA[i:], m.RequestID)
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(len(m.RequestID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ManagedPublicKeys) > 0 {
+		for iNdEx := len(m.ManagedPublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ManagedPublicKeys[iNdEx])
+			copy(dAtA[i:], m.ManagedPublicKeys[iNdEx])
+			i = encodeVarintRemoteSigner(dAtA, i, uint64(len(m.ManagedPublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.NodePublicKey) > 0 {
+		i -= len(m.NodePublicKey)
+		copy(dAtA[i:], m.NodePublicKey)
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(len(m.NodePublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RequestID) > 0 {
+		i -= len(m.RequestID)
+		copy(dAtA[i:], m.RequestID)
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(len(m.RequestID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerEnvelope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerEnvelope) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerEnvelope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MAC) > 0 {
+		i -= len(m.MAC)
+		copy(dAtA[i:], m.MAC)
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(len(m.MAC)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRemoteSigner(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRemoteSigner(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SignerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestID)
+	if l > 0 {
+		n += 1 + l + sovRemoteSigner(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovRemoteSigner(uint64(m.Timestamp))
+	}
+	if m.Type != 0 {
+		n += 1 + sovRemoteSigner(uint64(m.Type))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovRemoteSigner(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovRemoteSigner(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovRemoteSigner(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *SignerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestID)
+	if l > 0 {
+		n += 1 + l + sovRemoteSigner(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovRemoteSigner(uint64(l))
+	}
+	l = len(m.NodePublicKey)
+	if l > 0 {
+		n += 1 + l + sovRemoteSigner(uint64(l))
+	}
+	if len(m.ManagedPublicKeys) > 0 {
+		for _, b := range m.ManagedPublicKeys {
+			l = len(b)
+			n += 1 + l + sovRemoteSigner(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovRemoteSigner(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerEnvelope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovRemoteSigner(uint64(l))
+	}
+	l = len(m.MAC)
+	if l > 0 {
+		n += 1 + l + sovRemoteSigner(uint64(l))
+	}
+	return n
+}
+
+func sovRemoteSigner(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRemoteSigner(x uint64) (n int) {
+	return sovRemoteSigner(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *SignerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SignerRequest{`,
+		`RequestID:` + fmt.Sprintf("%v", this.RequestID) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`PublicKey:` + fmt.Sprintf("%v", this.PublicKey) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SignerResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SignerResponse{`,
+		`RequestID:` + fmt.Sprintf("%v", this.RequestID) + `,`,
+		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`NodePublicKey:` + fmt.Sprintf("%v", this.NodePublicKey) + `,`,
+		`ManagedPublicKeys:` + fmt.Sprintf("%v", this.ManagedPublicKeys) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SignerEnvelope) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SignerEnvelope{`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`MAC:` + fmt.Sprintf("%v", this.MAC) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringRemoteSigner(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *SignerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemoteSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestID = append(m.RequestID[:0], dAtA[iNdEx:postIndex]...)
+			if m.RequestID == nil {
+				m.RequestID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= SignerRequestType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = append(m.Message[:0], dAtA[iNdEx:postIndex]...)
+			if m.Message == nil {
+				m.Message = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemoteSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemoteSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestID = append(m.RequestID[:0], dAtA[iNdEx:postIndex]...)
+			if m.RequestID == nil {
+				m.RequestID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodePublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodePublicKey = append(m.NodePublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NodePublicKey == nil {
+				m.NodePublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedPublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagedPublicKeys = append(m.ManagedPublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ManagedPublicKeys[len(m.ManagedPublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemoteSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerEnvelope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemoteSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerEnvelope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerEnvelope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MAC", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MAC = append(m.MAC[:0], dAtA[iNdEx:postIndex]...)
+			if m.MAC == nil {
+				m.MAC = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemoteSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRemoteSigner(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRemoteSigner
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRemoteSigner
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRemoteSigner
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRemoteSigner
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRemoteSigner        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRemoteSigner          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRemoteSigner = fmt.Errorf("proto: unexpected end of group")
+)
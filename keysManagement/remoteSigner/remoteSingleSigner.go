@@ -0,0 +1,48 @@
+package remoteSigner
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+)
+
+// remoteSingleSigner creates the signatures of the keys held by the remote signer through the remote signer client,
+// while the signatures of the local keys and all the verifications are handled by the local single signer
+type remoteSingleSigner struct {
+	localSigner crypto.SingleSigner
+	client      SignerClient
+}
+
+// NewRemoteSingleSigner creates a new single signer aware of the keys held by the remote signer
+func NewRemoteSingleSigner(localSigner crypto.SingleSigner, client SignerClient) (*remoteSingleSigner, error) {
+	if check.IfNil(localSigner) {
+		return nil, ErrNilSingleSigner
+	}
+	if check.IfNil(client) {
+		return nil, ErrNilSignerClient
+	}
+
+	return &remoteSingleSigner{
+		localSigner: localSigner,
+		client:      client,
+	}, nil
+}
+
+// Sign signs the provided message with the provided private key
+func (signer *remoteSingleSigner) Sign(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+	publicKey, isRemote := GetRemotePublicKey(private)
+	if !isRemote {
+		return signer.localSigner.Sign(private, msg)
+	}
+
+	return signer.client.Sign(publicKey, msg)
+}
+
+// Verify verifies the signature of the provided message
+func (signer *remoteSingleSigner) Verify(public crypto.PublicKey, msg []byte, sig []byte) error {
+	return signer.localSigner.Verify(public, msg, sig)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (signer *remoteSingleSigner) IsInterfaceNil() bool {
+	return signer == nil
+}
@@ -0,0 +1,85 @@
+package remoteSigner_test
+
+import (
+	"testing"
+
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRemoteSingleSigner(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil local signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		signer, err := remoteSigner.NewRemoteSingleSigner(nil, &cryptoMocks.RemoteSignerClientStub{})
+		require.Equal(t, remoteSigner.ErrNilSingleSigner, err)
+		require.Nil(t, signer)
+	})
+	t.Run("nil client should error", func(t *testing.T) {
+		t.Parallel()
+
+		signer, err := remoteSigner.NewRemoteSingleSigner(&cryptoMocks.SingleSignerStub{}, nil)
+		require.Equal(t, remoteSigner.ErrNilSignerClient, err)
+		require.Nil(t, signer)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		signer, err := remoteSigner.NewRemoteSingleSigner(&cryptoMocks.SingleSignerStub{}, &cryptoMocks.RemoteSignerClientStub{})
+		require.Nil(t, err)
+		require.False(t, signer.IsInterfaceNil())
+	})
+}
+
+func TestRemoteSingleSigner_Sign(t *testing.T) {
+	t.Parallel()
+
+	localSigner := &cryptoMocks.SingleSignerStub{
+		SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			return []byte("local signature"), nil
+		},
+	}
+	client := &cryptoMocks.RemoteSignerClientStub{
+		SignCalled: func(publicKey []byte, message []byte) ([]byte, error) {
+			require.Equal(t, []byte("pk"), publicKey)
+			require.Equal(t, []byte("message"), message)
+
+			return []byte("remote signature"), nil
+		},
+	}
+	signer, _ := remoteSigner.NewRemoteSingleSigner(localSigner, client)
+
+	signature, err := signer.Sign(&cryptoMocks.PrivateKeyStub{}, []byte("message"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("local signature"), signature)
+
+	remoteKey, _ := remoteSigner.NewRemotePrivateKey(&cryptoMocks.PublicKeyStub{
+		ToByteArrayStub: func() ([]byte, error) {
+			return []byte("pk"), nil
+		},
+	})
+	signature, err = signer.Sign(remoteKey, []byte("message"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("remote signature"), signature)
+}
+
+func TestRemoteSingleSigner_VerifyShouldUseTheLocalSigner(t *testing.T) {
+	t.Parallel()
+
+	verifyCalled := false
+	localSigner := &cryptoMocks.SingleSignerStub{
+		VerifyCalled: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			verifyCalled = true
+			return nil
+		},
+	}
+	signer, _ := remoteSigner.NewRemoteSingleSigner(localSigner, &cryptoMocks.RemoteSignerClientStub{})
+
+	err := signer.Verify(&cryptoMocks.PublicKeyStub{}, []byte("message"), []byte("signature"))
+	require.Nil(t, err)
+	require.True(t, verifyCalled)
+}
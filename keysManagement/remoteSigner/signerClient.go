@@ -0,0 +1,150 @@
+package remoteSigner
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"net"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("keysManagement/remoteSigner")
+
+const requestIDLength = 16
+
+// PublicKeys holds the public keys of the validator keys held by the remote signer. The node public key is optional
+// and it is used in single-key mode, while the managed public keys are used in multi-key mode
+type PublicKeys struct {
+	NodePublicKey     []byte
+	ManagedPublicKeys [][]byte
+}
+
+// ArgsSignerClient holds the arguments needed to create a new remote signer client
+type ArgsSignerClient struct {
+	Network        string
+	Address        string
+	AuthKey        []byte
+	RequestTimeout time.Duration
+}
+
+type signerClient struct {
+	network        string
+	address        string
+	requestTimeout time.Duration
+	codec          *messageCodec
+}
+
+// NewSignerClient creates a new client of the remote signer. Each request is sent on a new connection, so the
+// client does not need to be closed and transparently recovers after a restart of the remote signer
+func NewSignerClient(args ArgsSignerClient) (*signerClient, error) {
+	err := checkConnectionArgs(args.Network, args.Address, args.AuthKey)
+	if err != nil {
+		return nil, err
+	}
+	if args.RequestTimeout <= 0 {
+		return nil, ErrInvalidRequestTimeout
+	}
+
+	return &signerClient{
+		network:        args.Network,
+		address:        args.Address,
+		requestTimeout: args.RequestTimeout,
+		codec:          newMessageCodec(args.AuthKey),
+	}, nil
+}
+
+// GetPublicKeys returns the public keys held by the remote signer
+func (client *signerClient) GetPublicKeys() (*PublicKeys, error) {
+	response, err := client.sendRequest(&SignerRequest{
+		Type: GetPublicKeysRequest,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &PublicKeys{
+		NodePublicKey:     response.NodePublicKey,
+		ManagedPublicKeys: response.ManagedPublicKeys,
+	}, nil
+}
+
+// Sign requests a single signature over the provided message, using the private key of the provided public key
+func (client *signerClient) Sign(publicKey []byte, message []byte) ([]byte, error) {
+	response, err := client.sendRequest(&SignerRequest{
+		Type:      SignRequest,
+		PublicKey: publicKey,
+		Message:   message,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Signature, nil
+}
+
+// SignShare requests a multi-signature share over the provided message, using the private key of the provided
+// public key and the multi-signer active in the provided epoch
+func (client *signerClient) SignShare(publicKey []byte, message []byte, epoch uint32) ([]byte, error) {
+	response, err := client.sendRequest(&SignerRequest{
+		Type:      SignShareRequest,
+		PublicKey: publicKey,
+		Message:   message,
+		Epoch:     epoch,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Signature, nil
+}
+
+func (client *signerClient) sendRequest(request *SignerRequest) (*SignerResponse, error) {
+	request.RequestID = make([]byte, requestIDLength)
+	_, err := rand.Read(request.RequestID)
+	if err != nil {
+		return nil, err
+	}
+	request.Timestamp = time.Now().Unix()
+
+	conn, err := net.DialTimeout(client.network, client.address, client.requestTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("%w while connecting to the remote signer", err)
+	}
+	defer func() {
+		errClose := conn.Close()
+		if errClose != nil {
+			log.Trace("signerClient.sendRequest: error closing connection", "error", errClose)
+		}
+	}()
+
+	err = conn.SetDeadline(time.Now().Add(client.requestTimeout))
+	if err != nil {
+		return nil, err
+	}
+
+	err = client.codec.write(conn, request)
+	if err != nil {
+		return nil, fmt.Errorf("%w while sending the %s request to the remote signer", err, request.Type)
+	}
+
+	response := &SignerResponse{}
+	err = client.codec.read(conn, response)
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading the %s response of the remote signer", err, request.Type)
+	}
+	if !bytes.Equal(response.RequestID, request.RequestID) {
+		return nil, ErrRequestIDMismatch
+	}
+	if len(response.Error) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrRemoteSigner, response.Error)
+	}
+
+	return response, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (client *signerClient) IsInterfaceNil() bool {
+	return client == nil
+}
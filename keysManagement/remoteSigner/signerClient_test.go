@@ -0,0 +1,118 @@
+package remoteSigner_test
+
+import (
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsSignerClient(t *testing.T) remoteSigner.ArgsSignerClient {
+	return remoteSigner.ArgsSignerClient{
+		Network:        remoteSigner.UnixNetwork,
+		Address:        filepath.Join(t.TempDir(), "signer.sock"),
+		AuthKey:        testAuthKey,
+		RequestTimeout: time.Second,
+	}
+}
+
+func TestNewSignerClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid network should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerClient(t)
+		args.Network = "udp"
+		client, err := remoteSigner.NewSignerClient(args)
+		require.ErrorIs(t, err, remoteSigner.ErrInvalidNetwork)
+		require.Nil(t, client)
+	})
+	t.Run("empty address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerClient(t)
+		args.Address = ""
+		client, err := remoteSigner.NewSignerClient(args)
+		require.Equal(t, remoteSigner.ErrEmptyAddress, err)
+		require.Nil(t, client)
+	})
+	t.Run("short authentication key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerClient(t)
+		args.AuthKey = []byte("short")
+		client, err := remoteSigner.NewSignerClient(args)
+		require.ErrorIs(t, err, remoteSigner.ErrInvalidAuthKey)
+		require.Nil(t, client)
+	})
+	t.Run("invalid request timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerClient(t)
+		args.RequestTimeout = 0
+		client, err := remoteSigner.NewSignerClient(args)
+		require.Equal(t, remoteSigner.ErrInvalidRequestTimeout, err)
+		require.Nil(t, client)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := remoteSigner.NewSignerClient(createMockArgsSignerClient(t))
+		require.Nil(t, err)
+		require.False(t, client.IsInterfaceNil())
+	})
+}
+
+func TestSignerClient_Sign(t *testing.T) {
+	t.Parallel()
+
+	t.Run("remote signer not started should error", func(t *testing.T) {
+		t.Parallel()
+
+		client, _ := remoteSigner.NewSignerClient(createMockArgsSignerClient(t))
+		signature, err := client.Sign([]byte("pk"), []byte("message"))
+		require.NotNil(t, err)
+		require.Contains(t, err.Error(), "while connecting to the remote signer")
+		require.Nil(t, signature)
+	})
+	t.Run("remote signer not responding should error after the request timeout", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerClient(t)
+		args.RequestTimeout = time.Millisecond * 100
+		args.Network = remoteSigner.TCPNetwork
+		listener, err := net.Listen(args.Network, "127.0.0.1:0")
+		require.Nil(t, err)
+		args.Address = listener.Addr().String()
+		defer func() {
+			_ = listener.Close()
+		}()
+
+		go func() {
+			conn, errAccept := listener.Accept()
+			if errAccept != nil {
+				return
+			}
+			// never answer, just wait for the client to give up
+			buff := make([]byte, 1024)
+			for {
+				_, errRead := conn.Read(buff)
+				if errRead != nil {
+					_ = conn.Close()
+					return
+				}
+			}
+		}()
+
+		client, _ := remoteSigner.NewSignerClient(args)
+		start := time.Now()
+		signature, err := client.Sign([]byte("pk"), []byte("message"))
+		require.NotNil(t, err)
+		require.Nil(t, signature)
+		require.Less(t, time.Since(start), time.Second)
+	})
+}
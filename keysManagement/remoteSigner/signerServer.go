@@ -0,0 +1,329 @@
+package remoteSigner
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/atomic"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	cryptoCommon "github.com/multiversx/mx-chain-go/common/crypto"
+)
+
+const (
+	maxRequestAgeInSeconds = 30
+	acceptRetryDelay       = 100 * time.Millisecond
+	unixSocketPermissions  = 0600
+)
+
+// ArgsSignerServer holds the arguments needed to create a new remote signer server
+type ArgsSignerServer struct {
+	Network              string
+	Address              string
+	AuthKey              []byte
+	RequestTimeout       time.Duration
+	KeyGenerator         crypto.KeyGenerator
+	SingleSigner         crypto.SingleSigner
+	MultiSignerContainer cryptoCommon.MultiSignerContainer
+	NodePrivateKey       []byte
+	ManagedPrivateKeys   [][]byte
+}
+
+type signerServer struct {
+	listener             net.Listener
+	requestTimeout       time.Duration
+	codec                *messageCodec
+	singleSigner         crypto.SingleSigner
+	multiSignerContainer cryptoCommon.MultiSignerContainer
+	privateKeys          map[string]crypto.PrivateKey
+	nodePublicKey        []byte
+	managedPublicKeys    [][]byte
+	mutSeenRequests      sync.Mutex
+	seenRequests         map[string]int64
+	closed               atomic.Flag
+	getTimeHandler       func() time.Time
+}
+
+// NewSignerServer creates a new remote signer server which holds the provided private keys and starts serving
+// the signing requests of the node on the provided address
+func NewSignerServer(args ArgsSignerServer) (*signerServer, error) {
+	err := checkSignerServerArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	server := &signerServer{
+		requestTimeout:       args.RequestTimeout,
+		codec:                newMessageCodec(args.AuthKey),
+		singleSigner:         args.SingleSigner,
+		multiSignerContainer: args.MultiSignerContainer,
+		privateKeys:          make(map[string]crypto.PrivateKey),
+		managedPublicKeys:    make([][]byte, 0, len(args.ManagedPrivateKeys)),
+		seenRequests:         make(map[string]int64),
+		getTimeHandler:       time.Now,
+	}
+
+	if len(args.NodePrivateKey) > 0 {
+		server.nodePublicKey, err = server.addPrivateKey(args.KeyGenerator, args.NodePrivateKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, privateKeyBytes := range args.ManagedPrivateKeys {
+		publicKey, errAdd := server.addPrivateKey(args.KeyGenerator, privateKeyBytes)
+		if errAdd != nil {
+			return nil, errAdd
+		}
+
+		server.managedPublicKeys = append(server.managedPublicKeys, publicKey)
+	}
+
+	server.listener, err = listen(args.Network, args.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("remote signer started", "network", args.Network, "address", args.Address,
+		"has node key", len(server.nodePublicKey) > 0, "num managed keys", len(server.managedPublicKeys))
+
+	go server.serve()
+
+	return server, nil
+}
+
+func checkSignerServerArgs(args ArgsSignerServer) error {
+	err := checkConnectionArgs(args.Network, args.Address, args.AuthKey)
+	if err != nil {
+		return err
+	}
+	if args.RequestTimeout <= 0 {
+		return ErrInvalidRequestTimeout
+	}
+	if check.IfNil(args.KeyGenerator) {
+		return ErrNilKeyGenerator
+	}
+	if check.IfNil(args.SingleSigner) {
+		return ErrNilSingleSigner
+	}
+	if check.IfNil(args.MultiSignerContainer) {
+		return ErrNilMultiSignerContainer
+	}
+	if len(args.NodePrivateKey) == 0 && len(args.ManagedPrivateKeys) == 0 {
+		return ErrNoKeys
+	}
+
+	return nil
+}
+
+func (server *signerServer) addPrivateKey(keyGenerator crypto.KeyGenerator, privateKeyBytes []byte) ([]byte, error) {
+	privateKey, err := keyGenerator.PrivateKeyFromByteArray(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, err := privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	_, found := server.privateKeys[string(publicKey)]
+	if found {
+		return nil, fmt.Errorf("%w %s", ErrDuplicatedKey, hex.EncodeToString(publicKey))
+	}
+
+	server.privateKeys[string(publicKey)] = privateKey
+
+	return publicKey, nil
+}
+
+func listen(network string, address string) (net.Listener, error) {
+	if network != UnixNetwork {
+		return net.Listen(network, address)
+	}
+
+	// a socket file left behind by a previous run would prevent the listener from being created
+	fileInfo, err := os.Lstat(address)
+	if err == nil && fileInfo.Mode()&os.ModeSocket != 0 {
+		err = os.Remove(address)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	listener, err := net.Listen(network, address)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.Chmod(address, unixSocketPermissions)
+	if err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+
+	return listener, nil
+}
+
+func (server *signerServer) serve() {
+	for {
+		conn, err := server.listener.Accept()
+		if err != nil {
+			if server.closed.IsSet() {
+				return
+			}
+
+			log.Debug("signerServer.serve: error accepting connection", "error", err)
+			time.Sleep(acceptRetryDelay)
+			continue
+		}
+
+		go server.handleConnection(conn)
+	}
+}
+
+func (server *signerServer) handleConnection(conn net.Conn) {
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	err := conn.SetDeadline(server.getTimeHandler().Add(server.requestTimeout))
+	if err != nil {
+		log.Debug("signerServer.handleConnection: cannot set deadline", "error", err)
+		return
+	}
+
+	request := &SignerRequest{}
+	err = server.codec.read(conn, request)
+	if err != nil {
+		// unauthenticated requests are not answered
+		log.Warn("signerServer.handleConnection: rejected request", "remote address", conn.RemoteAddr().String(), "error", err)
+		return
+	}
+
+	response := server.processRequest(request)
+	response.RequestID = request.RequestID
+	err = server.codec.write(conn, response)
+	if err != nil {
+		log.Debug("signerServer.handleConnection: cannot send response", "request type", request.Type, "error", err)
+	}
+}
+
+func (server *signerServer) processRequest(request *SignerRequest) *SignerResponse {
+	err := server.checkFreshness(request)
+	if err != nil {
+		return createErrorResponse(request, err)
+	}
+
+	switch request.Type {
+	case GetPublicKeysRequest:
+		return &SignerResponse{
+			NodePublicKey:     server.nodePublicKey,
+			ManagedPublicKeys: server.managedPublicKeys,
+		}
+	case SignRequest:
+		signature, errSign := server.sign(request.PublicKey, request.Message)
+		if errSign != nil {
+			return createErrorResponse(request, errSign)
+		}
+
+		return &SignerResponse{Signature: signature}
+	case SignShareRequest:
+		signature, errSign := server.signShare(request.PublicKey, request.Message, request.Epoch)
+		if errSign != nil {
+			return createErrorResponse(request, errSign)
+		}
+
+		return &SignerResponse{Signature: signature}
+	default:
+		return createErrorResponse(request, ErrUnknownRequestType)
+	}
+}
+
+func createErrorResponse(request *SignerRequest, err error) *SignerResponse {
+	log.Debug("signerServer: request failed", "type", request.Type,
+		"public key", hex.EncodeToString(request.PublicKey), "error", err)
+
+	return &SignerResponse{Error: err.Error()}
+}
+
+func (server *signerServer) checkFreshness(request *SignerRequest) error {
+	now := server.getTimeHandler().Unix()
+	age := now - request.Timestamp
+	if age > maxRequestAgeInSeconds || age < -maxRequestAgeInSeconds {
+		return ErrRequestExpired
+	}
+
+	server.mutSeenRequests.Lock()
+	defer server.mutSeenRequests.Unlock()
+
+	for requestID, timestamp := range server.seenRequests {
+		if now-timestamp > maxRequestAgeInSeconds {
+			delete(server.seenRequests, requestID)
+		}
+	}
+
+	_, found := server.seenRequests[string(request.RequestID)]
+	if found {
+		return ErrReplayedRequest
+	}
+	server.seenRequests[string(request.RequestID)] = request.Timestamp
+
+	return nil
+}
+
+func (server *signerServer) getPrivateKey(publicKey []byte) (crypto.PrivateKey, error) {
+	privateKey, found := server.privateKeys[string(publicKey)]
+	if !found {
+		return nil, fmt.Errorf("%w %s", ErrUnknownPublicKey, hex.EncodeToString(publicKey))
+	}
+
+	return privateKey, nil
+}
+
+func (server *signerServer) sign(publicKey []byte, message []byte) ([]byte, error) {
+	privateKey, err := server.getPrivateKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return server.singleSigner.Sign(privateKey, message)
+}
+
+func (server *signerServer) signShare(publicKey []byte, message []byte, epoch uint32) ([]byte, error) {
+	if message == nil {
+		return nil, ErrNilMessage
+	}
+
+	privateKey, err := server.getPrivateKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeyBytes, err := privateKey.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	multiSigner, err := server.multiSignerContainer.GetMultiSigner(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return multiSigner.CreateSignatureShare(privateKeyBytes, message)
+}
+
+// Close stops the server
+func (server *signerServer) Close() error {
+	server.closed.SetValue(true)
+
+	return server.listener.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (server *signerServer) IsInterfaceNil() bool {
+	return server == nil
+}
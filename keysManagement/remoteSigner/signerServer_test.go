@@ -0,0 +1,270 @@
+package remoteSigner_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	mclMultiSig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/multisig"
+	mclSig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	"github.com/multiversx/mx-chain-crypto-go/signing/multisig"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/require"
+)
+
+type testKey struct {
+	privateKey []byte
+	publicKey  crypto.PublicKey
+	pkBytes    []byte
+}
+
+func createTestKey(keyGen crypto.KeyGenerator) *testKey {
+	sk, pk := keyGen.GeneratePair()
+	skBytes, _ := sk.ToByteArray()
+	pkBytes, _ := pk.ToByteArray()
+
+	return &testKey{
+		privateKey: skBytes,
+		publicKey:  pk,
+		pkBytes:    pkBytes,
+	}
+}
+
+func createMockArgsSignerServer(t *testing.T, keyGen crypto.KeyGenerator) remoteSigner.ArgsSignerServer {
+	multiSigner, err := multisig.NewBLSMultisig(&mclMultiSig.BlsMultiSignerKOSK{}, keyGen)
+	require.Nil(t, err)
+
+	return remoteSigner.ArgsSignerServer{
+		Network:              remoteSigner.UnixNetwork,
+		Address:              filepath.Join(t.TempDir(), "signer.sock"),
+		AuthKey:              testAuthKey,
+		RequestTimeout:       time.Second,
+		KeyGenerator:         keyGen,
+		SingleSigner:         &mclSig.BlsSingleSigner{},
+		MultiSignerContainer: cryptoMocks.NewMultiSignerContainerMock(multiSigner),
+		NodePrivateKey:       createTestKey(keyGen).privateKey,
+	}
+}
+
+func createClient(t *testing.T, args remoteSigner.ArgsSignerServer, authKey []byte) remoteSigner.SignerClient {
+	client, err := remoteSigner.NewSignerClient(remoteSigner.ArgsSignerClient{
+		Network:        args.Network,
+		Address:        args.Address,
+		AuthKey:        authKey,
+		RequestTimeout: time.Second,
+	})
+	require.Nil(t, err)
+
+	return client
+}
+
+func TestNewSignerServer(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+
+	t.Run("invalid network should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerServer(t, keyGen)
+		args.Network = "udp"
+		server, err := remoteSigner.NewSignerServer(args)
+		require.ErrorIs(t, err, remoteSigner.ErrInvalidNetwork)
+		require.Nil(t, server)
+	})
+	t.Run("empty address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerServer(t, keyGen)
+		args.Address = ""
+		server, err := remoteSigner.NewSignerServer(args)
+		require.Equal(t, remoteSigner.ErrEmptyAddress, err)
+		require.Nil(t, server)
+	})
+	t.Run("short authentication key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerServer(t, keyGen)
+		args.AuthKey = []byte("short")
+		server, err := remoteSigner.NewSignerServer(args)
+		require.ErrorIs(t, err, remoteSigner.ErrInvalidAuthKey)
+		require.Nil(t, server)
+	})
+	t.Run("invalid request timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerServer(t, keyGen)
+		args.RequestTimeout = 0
+		server, err := remoteSigner.NewSignerServer(args)
+		require.Equal(t, remoteSigner.ErrInvalidRequestTimeout, err)
+		require.Nil(t, server)
+	})
+	t.Run("nil key generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerServer(t, keyGen)
+		args.KeyGenerator = nil
+		server, err := remoteSigner.NewSignerServer(args)
+		require.Equal(t, remoteSigner.ErrNilKeyGenerator, err)
+		require.Nil(t, server)
+	})
+	t.Run("nil single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerServer(t, keyGen)
+		args.SingleSigner = nil
+		server, err := remoteSigner.NewSignerServer(args)
+		require.Equal(t, remoteSigner.ErrNilSingleSigner, err)
+		require.Nil(t, server)
+	})
+	t.Run("nil multi signer container should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerServer(t, keyGen)
+		args.MultiSignerContainer = nil
+		server, err := remoteSigner.NewSignerServer(args)
+		require.Equal(t, remoteSigner.ErrNilMultiSignerContainer, err)
+		require.Nil(t, server)
+	})
+	t.Run("no keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerServer(t, keyGen)
+		args.NodePrivateKey = nil
+		server, err := remoteSigner.NewSignerServer(args)
+		require.Equal(t, remoteSigner.ErrNoKeys, err)
+		require.Nil(t, server)
+	})
+	t.Run("duplicated key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerServer(t, keyGen)
+		args.ManagedPrivateKeys = [][]byte{args.NodePrivateKey}
+		server, err := remoteSigner.NewSignerServer(args)
+		require.ErrorIs(t, err, remoteSigner.ErrDuplicatedKey)
+		require.Nil(t, server)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerServer(t, keyGen)
+		server, err := remoteSigner.NewSignerServer(args)
+		require.Nil(t, err)
+		require.False(t, server.IsInterfaceNil())
+		require.Nil(t, server.Close())
+	})
+}
+
+func TestSignerServer_ShouldServeTheSigningRequests(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	singleSigner := &mclSig.BlsSingleSigner{}
+	nodeKey := createTestKey(keyGen)
+	managedKeys := []*testKey{createTestKey(keyGen), createTestKey(keyGen)}
+
+	args := createMockArgsSignerServer(t, keyGen)
+	args.NodePrivateKey = nodeKey.privateKey
+	args.ManagedPrivateKeys = [][]byte{managedKeys[0].privateKey, managedKeys[1].privateKey}
+	providedEpoch := uint32(0)
+	multiSigner, _ := args.MultiSignerContainer.GetMultiSigner(0)
+	args.MultiSignerContainer = &cryptoMocks.MultiSignerContainerStub{
+		GetMultiSignerCalled: func(epoch uint32) (crypto.MultiSigner, error) {
+			providedEpoch = epoch
+			return multiSigner, nil
+		},
+	}
+	server, err := remoteSigner.NewSignerServer(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = server.Close()
+	}()
+
+	client := createClient(t, args, testAuthKey)
+	message := []byte("message to be signed")
+
+	publicKeys, err := client.GetPublicKeys()
+	require.Nil(t, err)
+	require.Equal(t, nodeKey.pkBytes, publicKeys.NodePublicKey)
+	require.Equal(t, [][]byte{managedKeys[0].pkBytes, managedKeys[1].pkBytes}, publicKeys.ManagedPublicKeys)
+
+	signature, err := client.Sign(managedKeys[1].pkBytes, message)
+	require.Nil(t, err)
+	require.Nil(t, singleSigner.Verify(managedKeys[1].publicKey, message, signature))
+
+	sigShare, err := client.SignShare(nodeKey.pkBytes, message, 5)
+	require.Nil(t, err)
+	require.Equal(t, uint32(5), providedEpoch)
+	require.Nil(t, multiSigner.VerifySignatureShare(nodeKey.pkBytes, message, sigShare))
+
+	unknownKey := createTestKey(keyGen)
+	signature, err = client.Sign(unknownKey.pkBytes, message)
+	require.ErrorIs(t, err, remoteSigner.ErrRemoteSigner)
+	require.Contains(t, err.Error(), remoteSigner.ErrUnknownPublicKey.Error())
+	require.Nil(t, signature)
+
+	// requests authenticated with a different key are not answered
+	otherClient := createClient(t, args, bytes.Repeat([]byte{0xCD}, 32))
+	signature, err = otherClient.Sign(nodeKey.pkBytes, message)
+	require.NotNil(t, err)
+	require.Nil(t, signature)
+}
+
+func TestSignerServer_ShouldRejectStaleAndReplayedRequests(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	server, err := remoteSigner.NewSignerServer(createMockArgsSignerServer(t, keyGen))
+	require.Nil(t, err)
+	defer func() {
+		_ = server.Close()
+	}()
+
+	now := time.Unix(100000, 0)
+	server.SetTimeHandler(func() time.Time {
+		return now
+	})
+
+	response := server.ProcessRequest(&remoteSigner.SignerRequest{
+		RequestID: []byte("request 1"),
+		Timestamp: now.Unix() - 31,
+		Type:      remoteSigner.GetPublicKeysRequest,
+	})
+	require.Equal(t, remoteSigner.ErrRequestExpired.Error(), response.Error)
+
+	response = server.ProcessRequest(&remoteSigner.SignerRequest{
+		RequestID: []byte("request 2"),
+		Timestamp: now.Unix() + 31,
+		Type:      remoteSigner.GetPublicKeysRequest,
+	})
+	require.Equal(t, remoteSigner.ErrRequestExpired.Error(), response.Error)
+
+	request := &remoteSigner.SignerRequest{
+		RequestID: []byte("request 3"),
+		Timestamp: now.Unix(),
+		Type:      remoteSigner.GetPublicKeysRequest,
+	}
+	response = server.ProcessRequest(request)
+	require.Empty(t, response.Error)
+	response = server.ProcessRequest(request)
+	require.Equal(t, remoteSigner.ErrReplayedRequest.Error(), response.Error)
+
+	response = server.ProcessRequest(&remoteSigner.SignerRequest{
+		RequestID: []byte("request 4"),
+		Timestamp: now.Unix(),
+		Type:      remoteSigner.InvalidRequest,
+	})
+	require.Equal(t, remoteSigner.ErrUnknownRequestType.Error(), response.Error)
+
+	response = server.ProcessRequest(&remoteSigner.SignerRequest{
+		RequestID: []byte("request 5"),
+		Timestamp: now.Unix(),
+		Type:      remoteSigner.SignShareRequest,
+	})
+	require.Equal(t, remoteSigner.ErrNilMessage.Error(), response.Error)
+}
@@ -77,6 +77,8 @@ type simulator struct {
 	validatorsPrivateKeys  []crypto.PrivateKey
 	nodes                  map[uint32]process.NodeHandler
 	numOfShards            uint32
+	snapshots              map[uint64]map[uint32]*dtos.NodeSnapshot
+	lastSnapshotID         uint64
 	mutex                  sync.RWMutex
 }
 
@@ -99,6 +101,7 @@ func NewBaseChainSimulator(args ArgsBaseChainSimulator) (*simulator, error) {
 		chanStopNodeProcess:    make(chan endProcess.ArgEndProcess),
 		mutex:                  sync.RWMutex{},
 		initialStakedKeys:      make(map[string]*dtos.BLSKey),
+		snapshots:              make(map[uint64]map[uint32]*dtos.NodeSnapshot),
 	}
 
 	err := instance.createChainHandlers(args)
@@ -225,8 +228,12 @@ func (s *simulator) GenerateBlocks(numOfBlocks int) error {
 	defer s.mutex.Unlock()
 
 	for idx := 0; idx < numOfBlocks; idx++ {
-		s.incrementRoundOnAllValidators()
-		err := s.allNodesCreateBlocks()
+		err := s.incrementRoundOnAllValidators()
+		if err != nil {
+			return err
+		}
+
+		err = s.allNodesCreateBlocks()
 		if err != nil {
 			return err
 		}
@@ -241,8 +248,12 @@ func (s *simulator) GenerateBlocksUntilEpochIsReached(targetEpoch int32) error {
 
 	maxNumberOfRounds := 10000
 	for idx := 0; idx < maxNumberOfRounds; idx++ {
-		s.incrementRoundOnAllValidators()
-		err := s.allNodesCreateBlocks()
+		err := s.incrementRoundOnAllValidators()
+		if err != nil {
+			return err
+		}
+
+		err = s.allNodesCreateBlocks()
 		if err != nil {
 			return err
 		}
@@ -289,10 +300,98 @@ func (s *simulator) isTargetEpochReached(targetEpoch int32) (bool, error) {
 	return true, nil
 }
 
-func (s *simulator) incrementRoundOnAllValidators() {
+func (s *simulator) incrementRoundOnAllValidators() error {
+	for _, node := range s.handlers {
+		err := node.IncrementRound()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AdvanceTime will move the chain clock forward by the provided duration, skipping the rounds that fit in it.
+// As the nodes check the headers timestamps against the genesis time and the rounds, the duration is rounded up to
+// a whole number of rounds. The next generated block will be proposed in the round following the new current round
+func (s *simulator) AdvanceTime(duration time.Duration) error {
+	if duration <= 0 {
+		return fmt.Errorf("%w: %v", chainSimulatorErrors.ErrInvalidTimeDuration, duration)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	roundHandler := s.nodes[core.MetachainShardId].GetCoreComponents().RoundHandler()
+	roundDuration := roundHandler.TimeDuration()
+	numRounds := int64(duration / roundDuration)
+	if duration%roundDuration != 0 {
+		numRounds++
+	}
+
+	err := s.setRoundOnAllValidators(roundHandler.Index() + numRounds)
+	if err != nil {
+		return err
+	}
+
+	log.Info("chain simulator time advanced",
+		"duration", duration,
+		"skipped rounds", numRounds,
+		"current round", roundHandler.Index(),
+		"current round timestamp", roundHandler.TimeStamp().Unix())
+
+	return nil
+}
+
+// SetNextBlockTimestamp will skip the rounds needed for the next generated block to have the provided unix timestamp.
+// Exact timestamps are not supported: the nodes derive the headers timestamps from the genesis time and the rounds,
+// and check them the same way, so the timestamp should be after the current round timestamp and should match the
+// start of a round. An unaligned timestamp is rejected with ErrTimestampNotAlignedWithRounds, the error containing
+// the closest valid timestamps. AdvanceTime should be used when the chain clock only needs to reach a given time
+func (s *simulator) SetNextBlockTimestamp(timestamp int64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	roundHandler := s.nodes[core.MetachainShardId].GetCoreComponents().RoundHandler()
+	currentRoundTimestamp := roundHandler.TimeStamp()
+	timeUntilNextBlock := time.Unix(timestamp, 0).Sub(currentRoundTimestamp)
+	if timeUntilNextBlock <= 0 {
+		return fmt.Errorf("%w, timestamp: %d, current round timestamp: %d",
+			chainSimulatorErrors.ErrTimestampNotInTheFuture, timestamp, currentRoundTimestamp.Unix())
+	}
+
+	roundDuration := roundHandler.TimeDuration()
+	numRounds := int64(timeUntilNextBlock / roundDuration)
+	if timeUntilNextBlock%roundDuration != 0 {
+		previousValidTimestamp := currentRoundTimestamp.Add(time.Duration(numRounds) * roundDuration)
+		nextValidTimestamp := previousValidTimestamp.Add(roundDuration)
+		return fmt.Errorf("%w, timestamp: %d, previous valid timestamp: %d, next valid timestamp: %d, round duration: %v",
+			chainSimulatorErrors.ErrTimestampNotAlignedWithRounds, timestamp,
+			previousValidTimestamp.Unix(), nextValidTimestamp.Unix(), roundDuration)
+	}
+
+	// the round is incremented once more when the next block is generated
+	err := s.setRoundOnAllValidators(roundHandler.Index() + numRounds - 1)
+	if err != nil {
+		return err
+	}
+
+	log.Info("chain simulator next block timestamp set",
+		"timestamp", timestamp,
+		"next block round", roundHandler.Index()+1)
+
+	return nil
+}
+
+func (s *simulator) setRoundOnAllValidators(round int64) error {
 	for _, node := range s.handlers {
-		node.IncrementRound()
+		err := node.SetRound(round)
+		if err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
 
 // ForceChangeOfEpoch will force the change of current epoch
@@ -325,6 +424,90 @@ func (s *simulator) allNodesCreateBlocks() error {
 	return nil
 }
 
+// TakeSnapshot will record the current state of all nodes and will return the identifier of the created snapshot
+func (s *simulator) TakeSnapshot() (uint64, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	nodesSnapshots := make(map[uint32]*dtos.NodeSnapshot, len(s.nodes))
+	for shardID, node := range s.nodes {
+		snapshot, err := node.TakeSnapshot()
+		if err != nil {
+			return 0, fmt.Errorf("%w for shard %d", err, shardID)
+		}
+
+		nodesSnapshots[shardID] = snapshot
+	}
+
+	s.lastSnapshotID++
+	s.snapshots[s.lastSnapshotID] = nodesSnapshots
+
+	log.Info("chain simulator snapshot taken",
+		"snapshot ID", s.lastSnapshotID,
+		"metachain nonce", nodesSnapshots[core.MetachainShardId].Nonce,
+		"round", nodesSnapshots[core.MetachainShardId].Round)
+
+	return s.lastSnapshotID, nil
+}
+
+// RevertToSnapshot will bring all nodes back to the state recorded by the snapshot with the provided identifier.
+// The snapshot remains available, so the chain can be reverted to it multiple times, but all the snapshots
+// taken after it are discarded as they are no longer part of the current chain
+func (s *simulator) RevertToSnapshot(snapshotID uint64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	nodesSnapshots, found := s.snapshots[snapshotID]
+	if !found {
+		return fmt.Errorf("%w, snapshot ID: %d", chainSimulatorErrors.ErrSnapshotNotFound, snapshotID)
+	}
+
+	shardsNonces := make(map[uint32]uint64, len(nodesSnapshots))
+	for shardID, snapshot := range nodesSnapshots {
+		shardsNonces[shardID] = snapshot.Nonce
+	}
+
+	// all nodes are checked before altering any of them, including the data needed to roll back each block and to
+	// restore the epoch, so a snapshot that can not be reached will leave the chain untouched
+	for shardID, node := range s.nodes {
+		err := node.CheckSnapshot(nodesSnapshots[shardID])
+		if err != nil {
+			return fmt.Errorf("%w for shard %d", err, shardID)
+		}
+	}
+
+	// a revert failing after the checks above is not expected, but as the nodes can not be brought forward again,
+	// it would leave the already reverted nodes at the snapshot and the chain should be discarded
+	revertedShards := make([]uint32, 0, len(s.nodes))
+	for shardID, node := range s.nodes {
+		err := node.RevertToSnapshot(nodesSnapshots[shardID], shardsNonces)
+		if err != nil {
+			log.Error("chain simulator partially reverted to snapshot",
+				"snapshot ID", snapshotID,
+				"failed shard", shardID,
+				"reverted shards", revertedShards,
+				"error", err)
+			return fmt.Errorf("%w for shard %d, %d other shard(s) already reverted",
+				err, shardID, len(revertedShards))
+		}
+
+		revertedShards = append(revertedShards, shardID)
+	}
+
+	for id := range s.snapshots {
+		if id > snapshotID {
+			delete(s.snapshots, id)
+		}
+	}
+
+	log.Info("chain simulator reverted to snapshot",
+		"snapshot ID", snapshotID,
+		"metachain nonce", nodesSnapshots[core.MetachainShardId].Nonce,
+		"round", nodesSnapshots[core.MetachainShardId].Round)
+
+	return nil
+}
+
 // GetNodeHandler returns the node handler from the provided shardID
 func (s *simulator) GetNodeHandler(shardID uint32) process.NodeHandler {
 	s.mutex.RLock()
@@ -1,6 +1,7 @@
 package chainSimulator
 
 import (
+	"fmt"
 	"github.com/multiversx/mx-chain-go/errors"
 	"math/big"
 	"strings"
@@ -9,11 +10,13 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	chainSimulatorCommon "github.com/multiversx/mx-chain-go/integrationTests/chainSimulator"
 	"github.com/multiversx/mx-chain-go/node/chainSimulator/components/api"
 	"github.com/multiversx/mx-chain-go/node/chainSimulator/configs"
 	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	chainSimulatorErrors "github.com/multiversx/mx-chain-go/node/chainSimulator/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -430,3 +433,261 @@ func TestSimulator_SentMoveBalanceNoGasForFee(t *testing.T) {
 	_, err = chainSimulator.sendTx(ftx)
 	require.True(t, strings.Contains(err.Error(), errors.ErrInsufficientFunds.Error()))
 }
+
+func TestSimulator_TakeSnapshotAndRevert(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	startTime := time.Now().Unix()
+	roundDurationInMillis := uint64(6000)
+	roundsPerEpoch := core.OptionalUint64{
+		HasValue: true,
+		Value:    100,
+	}
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: true,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       startTime,
+		RoundDurationInMillis:  roundDurationInMillis,
+		RoundsPerEpoch:         roundsPerEpoch,
+		ApiInterface:           api.NewNoApiInterface(),
+		MinNodesPerShard:       1,
+		MetaChainMinNodes:      1,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	facade, err := NewChainSimulatorFacade(chainSimulator)
+	require.Nil(t, err)
+
+	initialBalance := big.NewInt(0).Mul(big.NewInt(10), big.NewInt(1_000_000_000_000_000_000))
+	sender, err := chainSimulator.GenerateAndMintWalletAddress(0, initialBalance)
+	require.Nil(t, err)
+	receiver, err := chainSimulator.GenerateAndMintWalletAddress(1, big.NewInt(0))
+	require.Nil(t, err)
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	snapshotID, err := chainSimulator.TakeSnapshot()
+	require.Nil(t, err)
+
+	metaNode := chainSimulator.GetNodeHandler(core.MetachainShardId)
+	snapshotRound := metaNode.GetCoreComponents().RoundHandler().Index()
+	snapshotNonces := make(map[uint32]uint64)
+	for shardID, node := range chainSimulator.nodes {
+		snapshotNonces[shardID] = node.GetChainHandler().GetCurrentBlockHeader().GetNonce()
+	}
+
+	transferValue := big.NewInt(1_000_000_000_000_000_000)
+	tx := &transaction.Transaction{
+		Nonce:     0,
+		Value:     transferValue,
+		SndAddr:   sender.Bytes,
+		RcvAddr:   receiver.Bytes,
+		Data:      []byte(""),
+		GasLimit:  50_000,
+		GasPrice:  1_000_000_000,
+		ChainID:   []byte(configs.ChainID),
+		Version:   1,
+		Signature: []byte("010101"),
+	}
+
+	checkTransferExecuted := func() {
+		_, errSend := chainSimulator.SendTxAndGenerateBlockTilTxIsExecuted(tx, 10)
+		require.Nil(t, errSend)
+
+		// the cross shard transfer has to be completed on the destination shard as well
+		errGenerate := chainSimulator.GenerateBlocks(5)
+		require.Nil(t, errGenerate)
+
+		receiverAccount, errGet := facade.GetExistingAccountFromBech32AddressString(receiver.Bech32)
+		require.Nil(t, errGet)
+		require.Equal(t, transferValue, receiverAccount.GetBalance())
+	}
+
+	checkReverted := func() {
+		senderAccount, errGet := facade.GetExistingAccountFromBech32AddressString(sender.Bech32)
+		require.Nil(t, errGet)
+		require.Equal(t, initialBalance, senderAccount.GetBalance())
+		require.Zero(t, senderAccount.GetNonce())
+
+		receiverAccount, errGet := facade.GetExistingAccountFromBech32AddressString(receiver.Bech32)
+		require.Nil(t, errGet)
+		require.Zero(t, receiverAccount.GetBalance().Sign())
+
+		require.Equal(t, snapshotRound, metaNode.GetCoreComponents().RoundHandler().Index())
+		for shardID, node := range chainSimulator.nodes {
+			require.Equal(t, snapshotNonces[shardID], node.GetChainHandler().GetCurrentBlockHeader().GetNonce())
+		}
+	}
+
+	checkTransferExecuted()
+
+	secondSnapshotID, err := chainSimulator.TakeSnapshot()
+	require.Nil(t, err)
+
+	err = chainSimulator.RevertToSnapshot(snapshotID)
+	require.Nil(t, err)
+	checkReverted()
+
+	// the snapshots taken after the one reverted to are no longer part of the chain
+	err = chainSimulator.RevertToSnapshot(secondSnapshotID)
+	require.ErrorIs(t, err, chainSimulatorErrors.ErrSnapshotNotFound)
+
+	// the same transaction can be executed again on the reverted chain, and the snapshot can be reused
+	checkTransferExecuted()
+
+	err = chainSimulator.RevertToSnapshot(snapshotID)
+	require.Nil(t, err)
+	checkReverted()
+}
+
+func TestSimulator_RevertToSnapshotTakenInPreviousEpoch(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	roundsPerEpoch := core.OptionalUint64{
+		HasValue: true,
+		Value:    20,
+	}
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: true,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       time.Now().Unix(),
+		RoundDurationInMillis:  uint64(6000),
+		RoundsPerEpoch:         roundsPerEpoch,
+		ApiInterface:           api.NewNoApiInterface(),
+		MinNodesPerShard:       1,
+		MetaChainMinNodes:      1,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	snapshotID, err := chainSimulator.TakeSnapshot()
+	require.Nil(t, err)
+
+	snapshotEpochs := make(map[uint32]uint32)
+	snapshotNonces := make(map[uint32]uint64)
+	for shardID, node := range chainSimulator.nodes {
+		snapshotEpochs[shardID] = node.GetCoreComponents().EnableEpochsHandler().GetCurrentEpoch()
+		snapshotNonces[shardID] = node.GetChainHandler().GetCurrentBlockHeader().GetNonce()
+	}
+
+	err = chainSimulator.ForceChangeOfEpoch()
+	require.Nil(t, err)
+	err = chainSimulator.ForceChangeOfEpoch()
+	require.Nil(t, err)
+
+	err = chainSimulator.RevertToSnapshot(snapshotID)
+	require.Nil(t, err)
+
+	for shardID, node := range chainSimulator.nodes {
+		require.Equal(t, snapshotEpochs[shardID], node.GetCoreComponents().EnableEpochsHandler().GetCurrentEpoch())
+		require.Equal(t, snapshotEpochs[shardID], node.GetProcessComponents().EpochStartTrigger().Epoch())
+		require.Equal(t, snapshotNonces[shardID], node.GetChainHandler().GetCurrentBlockHeader().GetNonce())
+	}
+
+	// the reverted chain should be able to change the epoch again
+	err = chainSimulator.ForceChangeOfEpoch()
+	require.Nil(t, err)
+
+	metaNode := chainSimulator.GetNodeHandler(core.MetachainShardId)
+	require.Equal(t, snapshotEpochs[core.MetachainShardId]+1, metaNode.GetProcessComponents().EpochStartTrigger().Epoch())
+}
+
+func TestSimulator_AdvanceTimeAndSetNextBlockTimestamp(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	startTime := time.Now().Unix()
+	roundDurationInMillis := uint64(6000)
+	roundDuration := time.Duration(roundDurationInMillis) * time.Millisecond
+	roundsPerEpoch := core.OptionalUint64{
+		HasValue: true,
+		Value:    100,
+	}
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: true,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       startTime,
+		RoundDurationInMillis:  roundDurationInMillis,
+		RoundsPerEpoch:         roundsPerEpoch,
+		ApiInterface:           api.NewNoApiInterface(),
+		MinNodesPerShard:       1,
+		MetaChainMinNodes:      1,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	checkLastBlocksTimestamp := func(expectedTimestamp int64) {
+		for _, node := range chainSimulator.nodes {
+			require.Equal(t, uint64(expectedTimestamp), node.GetChainHandler().GetCurrentBlockHeader().GetTimeStamp())
+
+			networkMetrics, errGet := node.GetFacadeHandler().StatusMetrics().NetworkMetrics()
+			require.Nil(t, errGet)
+			require.Equal(t, uint64(expectedTimestamp), networkMetrics[common.MetricBlockTimestamp])
+			require.Equal(t, uint64(node.GetCoreComponents().RoundHandler().Index()), networkMetrics[common.MetricCurrentRound])
+		}
+	}
+
+	metaNode := chainSimulator.GetNodeHandler(core.MetachainShardId)
+	lastBlockTimestamp := int64(metaNode.GetChainHandler().GetCurrentBlockHeader().GetTimeStamp())
+
+	err = chainSimulator.AdvanceTime(0)
+	require.ErrorIs(t, err, chainSimulatorErrors.ErrInvalidTimeDuration)
+
+	// the duration is rounded up to a whole number of rounds
+	err = chainSimulator.AdvanceTime(time.Hour + time.Second)
+	require.Nil(t, err)
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	expectedTimestamp := lastBlockTimestamp + int64((time.Hour + roundDuration + roundDuration).Seconds())
+	checkLastBlocksTimestamp(expectedTimestamp)
+
+	err = chainSimulator.SetNextBlockTimestamp(expectedTimestamp)
+	require.ErrorIs(t, err, chainSimulatorErrors.ErrTimestampNotInTheFuture)
+
+	// exact timestamps are not supported, the error containing the closest valid ones
+	err = chainSimulator.SetNextBlockTimestamp(expectedTimestamp + 1)
+	require.ErrorIs(t, err, chainSimulatorErrors.ErrTimestampNotAlignedWithRounds)
+	require.Contains(t, err.Error(), fmt.Sprintf("previous valid timestamp: %d", expectedTimestamp))
+	require.Contains(t, err.Error(), fmt.Sprintf("next valid timestamp: %d", expectedTimestamp+int64(roundDuration.Seconds())))
+
+	expectedTimestamp += int64((24 * time.Hour).Seconds())
+	err = chainSimulator.SetNextBlockTimestamp(expectedTimestamp)
+	require.Nil(t, err)
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+	checkLastBlocksTimestamp(expectedTimestamp)
+
+	// the chain continues to produce blocks after the time travel
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+	checkLastBlocksTimestamp(expectedTimestamp + int64(roundDuration.Seconds()))
+}
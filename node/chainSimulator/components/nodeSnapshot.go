@@ -0,0 +1,678 @@
+package components
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+var (
+	errWrongRoundHandlerType     = errors.New("the round handler does not allow setting the round index")
+	errWrongBlockTrackerType     = errors.New("the block tracker does not allow cleaning up the headers above a nonce")
+	errWrongNodesCoordinatorType = errors.New("the nodes coordinator does not allow changing the epoch")
+	errNilSnapshot               = errors.New("nil snapshot")
+	errSnapshotNotOnChain        = errors.New("the snapshot header is not part of the current chain")
+	errNonceBehindSnapshotNonce  = errors.New("the current nonce is behind the snapshot nonce")
+)
+
+type roundIndexSetter interface {
+	SetIndex(index int64)
+}
+
+type headersAboveNonceCleaner interface {
+	CleanupHeadersAboveNonce(shardID uint32, nonce uint64)
+}
+
+type epochStartActionHandler interface {
+	EpochStartAction(hdr data.HeaderHandler)
+}
+
+// rollBackStep holds everything needed to roll back a single block, gathered before altering the node
+type rollBackStep struct {
+	header         data.HeaderHandler
+	headerHash     []byte
+	body           data.BodyHandler
+	prevHeader     data.HeaderHandler
+	prevHeaderHash []byte
+	prevRootHash   []byte
+}
+
+// TakeSnapshot will record all the information needed to bring the node back to its current state
+func (node *testOnlyProcessingNode) TakeSnapshot() (*dtos.NodeSnapshot, error) {
+	header, headerHash := node.getCurrentHeaderAndHash()
+
+	accountsRootHash, err := node.StateComponentsHolder.AccountsAdapter().RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	peerAccountsRootHash, err := node.StateComponentsHolder.PeerAccounts().RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &dtos.NodeSnapshot{
+		Epoch:                node.CoreComponentsHolder.EnableEpochsHandler().GetCurrentEpoch(),
+		Round:                node.CoreComponentsHolder.RoundHandler().Index(),
+		Nonce:                header.GetNonce(),
+		HeaderHash:           headerHash,
+		ChainRootHash:        node.ChainHandler.GetCurrentBlockRootHash(),
+		AccountsRootHash:     accountsRootHash,
+		PeerAccountsRootHash: peerAccountsRootHash,
+	}
+	snapshot.FinalBlockNonce, snapshot.FinalBlockHash, snapshot.FinalBlockRootHash = node.ChainHandler.GetFinalBlockInfo()
+
+	snapshot.Transactions, err = node.getShardedPoolEntries(node.DataPool.Transactions(), node.computeTransactionCacheID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot.UnsignedTransactions, err = node.getShardedPoolEntries(node.DataPool.UnsignedTransactions(), node.computeTransactionCacheID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot.RewardTransactions, err = node.getShardedPoolEntries(node.DataPool.RewardTransactions(), node.computeRewardTransactionCacheID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot.MiniBlocks, err = node.getCacherEntries(node.DataPool.MiniBlocks())
+	if err != nil {
+		return nil, err
+	}
+
+	node.recordNotarizedHeaders(snapshot)
+
+	return snapshot, nil
+}
+
+func (node *testOnlyProcessingNode) recordNotarizedHeaders(snapshot *dtos.NodeSnapshot) {
+	blockTracker := node.ProcessComponentsHolder.BlockTracker()
+	snapshot.CrossNotarized = make(map[uint32]*dtos.NotarizedHeader)
+	snapshot.SelfNotarized = make(map[uint32]*dtos.NotarizedHeader)
+
+	for _, shardID := range node.getAllShardIDs() {
+		header, hash, err := blockTracker.GetLastCrossNotarizedHeader(shardID)
+		if err == nil {
+			snapshot.CrossNotarized[shardID] = &dtos.NotarizedHeader{Header: header, Hash: hash}
+		}
+
+		header, hash, err = blockTracker.GetLastSelfNotarizedHeader(shardID)
+		if err == nil {
+			snapshot.SelfNotarized[shardID] = &dtos.NotarizedHeader{Header: header, Hash: hash}
+		}
+	}
+}
+
+func (node *testOnlyProcessingNode) getAllShardIDs() []uint32 {
+	numOfShards := node.GetShardCoordinator().NumberOfShards()
+	shardIDs := make([]uint32, 0, numOfShards+1)
+	for shardID := uint32(0); shardID < numOfShards; shardID++ {
+		shardIDs = append(shardIDs, shardID)
+	}
+
+	return append(shardIDs, core.MetachainShardId)
+}
+
+// CheckSnapshot returns nil if the node can be reverted to the provided snapshot. Everything the revert relies on is
+// checked without altering the node: the snapshot header being part of the current chain, the headers and tries
+// needed to roll back each block, the recorded tries, the nodes configuration of the snapshot epoch and the
+// components used during the revert
+func (node *testOnlyProcessingNode) CheckSnapshot(snapshot *dtos.NodeSnapshot) error {
+	_, err := node.prepareRollBack(snapshot)
+
+	return err
+}
+
+// prepareRollBack returns the steps needed to roll back the node to the provided snapshot, starting with the current block
+func (node *testOnlyProcessingNode) prepareRollBack(snapshot *dtos.NodeSnapshot) ([]*rollBackStep, error) {
+	if snapshot == nil {
+		return nil, errNilSnapshot
+	}
+
+	header, headerHash := node.getCurrentHeaderAndHash()
+	if header.GetNonce() < snapshot.Nonce {
+		return nil, fmt.Errorf("%w, snapshot nonce: %d, current nonce: %d", errNonceBehindSnapshotNonce, snapshot.Nonce, header.GetNonce())
+	}
+
+	steps := make([]*rollBackStep, 0, header.GetNonce()-snapshot.Nonce)
+	for header.GetNonce() > snapshot.Nonce {
+		step, err := node.prepareRollBackStep(header, headerHash)
+		if err != nil {
+			return nil, fmt.Errorf("%w while checking the roll back of the block with nonce %d", err, header.GetNonce())
+		}
+
+		steps = append(steps, step)
+		header, headerHash = step.prevHeader, step.prevHeaderHash
+	}
+	if !bytes.Equal(headerHash, snapshot.HeaderHash) {
+		return nil, fmt.Errorf("%w, snapshot nonce: %d, snapshot hash: %x, chain hash: %x",
+			errSnapshotNotOnChain, snapshot.Nonce, snapshot.HeaderHash, headerHash)
+	}
+
+	_, err := node.StateComponentsHolder.AccountsAdapter().GetTrie(snapshot.AccountsRootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while checking the accounts trie %x", err, snapshot.AccountsRootHash)
+	}
+
+	_, err = node.StateComponentsHolder.PeerAccounts().GetTrie(snapshot.PeerAccountsRootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while checking the peer accounts trie %x", err, snapshot.PeerAccountsRootHash)
+	}
+
+	_, ok := node.NodesCoordinator.(epochStartActionHandler)
+	if !ok {
+		return nil, errWrongNodesCoordinatorType
+	}
+
+	_, err = node.NodesCoordinator.GetAllEligibleValidatorsPublicKeys(header.GetEpoch())
+	if err != nil {
+		return nil, fmt.Errorf("%w while checking the nodes configuration of epoch %d", err, header.GetEpoch())
+	}
+
+	_, ok = node.ProcessComponentsHolder.BlockTracker().(headersAboveNonceCleaner)
+	if !ok {
+		return nil, errWrongBlockTrackerType
+	}
+
+	_, ok = node.CoreComponentsHolder.RoundHandler().(roundIndexSetter)
+	if !ok {
+		return nil, errWrongRoundHandlerType
+	}
+
+	return steps, nil
+}
+
+func (node *testOnlyProcessingNode) prepareRollBackStep(header data.HeaderHandler, headerHash []byte) (*rollBackStep, error) {
+	prevHeaderHash := header.GetPrevHash()
+	prevHeader, err := node.getHeader(prevHeaderHash)
+	if err != nil {
+		return nil, err
+	}
+
+	step := &rollBackStep{
+		header:         header,
+		headerHash:     headerHash,
+		prevHeader:     prevHeader,
+		prevHeaderHash: prevHeaderHash,
+		prevRootHash:   node.getRootHashFromBlock(prevHeader, prevHeaderHash),
+	}
+
+	_, err = node.StateComponentsHolder.AccountsAdapter().GetTrie(step.prevRootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while checking the accounts trie %x", err, step.prevRootHash)
+	}
+
+	if node.GetShardCoordinator().SelfId() == core.MetachainShardId {
+		err = node.checkMetaRollBackStep(step)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	body, errNotCritical := node.getBlockBody(header)
+	if errNotCritical != nil {
+		log.Debug("prepareRollBackStep getBlockBody", "error", errNotCritical)
+	}
+	step.body = body
+
+	return step, nil
+}
+
+// checkMetaRollBackStep checks the data a metachain node additionally needs when rolling back a block: the peer
+// accounts trie of the previous block and, for an epoch start block, the epoch start block of the previous epoch
+func (node *testOnlyProcessingNode) checkMetaRollBackStep(step *rollBackStep) error {
+	prevMetaHeader, ok := step.prevHeader.(data.MetaHeaderHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	validatorStatsRootHash := prevMetaHeader.GetValidatorStatsRootHash()
+	_, err := node.StateComponentsHolder.PeerAccounts().GetTrie(validatorStatsRootHash)
+	if err != nil {
+		return fmt.Errorf("%w while checking the peer accounts trie %x", err, validatorStatsRootHash)
+	}
+
+	if !step.header.IsStartOfEpochBlock() || step.header.GetEpoch() == 0 {
+		return nil
+	}
+
+	metaBlocksStorer, err := node.StoreService.GetStorer(dataRetriever.MetaBlockUnit)
+	if err != nil {
+		return err
+	}
+
+	prevEpoch := step.header.GetEpoch() - 1
+	_, err = metaBlocksStorer.SearchFirst([]byte(core.EpochStartIdentifier(prevEpoch)))
+	if err != nil {
+		return fmt.Errorf("%w while checking the epoch start block of epoch %d", err, prevEpoch)
+	}
+
+	return nil
+}
+
+// RevertToSnapshot will bring the node back to the state recorded in the provided snapshot. The shards nonces
+// map should contain the snapshot nonces for all the shards, as the headers received from the other shards
+// after the snapshot was taken will also be removed. A snapshot taken in a previous epoch is supported, the epoch
+// being restored along with the blocks
+func (node *testOnlyProcessingNode) RevertToSnapshot(snapshot *dtos.NodeSnapshot, shardsNonces map[uint32]uint64) error {
+	steps, err := node.prepareRollBack(snapshot)
+	if err != nil {
+		return err
+	}
+
+	for _, step := range steps {
+		err = node.rollBackOneBlock(step)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the headers restored into the pool during the roll back are notified to the block tracker on separate
+	// goroutines, so they should be handled before cleaning up the headers above the snapshot nonces
+	node.headersPool.WaitForNotifications()
+
+	currentHeader, currentHeaderHash := node.getCurrentHeaderAndHash()
+	if !bytes.Equal(currentHeaderHash, snapshot.HeaderHash) {
+		return fmt.Errorf("%w, snapshot nonce: %d, snapshot hash: %x, current hash: %x",
+			errSnapshotNotOnChain, snapshot.Nonce, snapshot.HeaderHash, currentHeaderHash)
+	}
+
+	err = node.restoreStateFromSnapshot(currentHeader, snapshot)
+	if err != nil {
+		return err
+	}
+
+	err = node.restoreEpoch(currentHeader)
+	if err != nil {
+		return err
+	}
+
+	err = node.cleanupHeadersAboveNonces(shardsNonces)
+	if err != nil {
+		return err
+	}
+
+	node.restoreNotarizedHeaders(snapshot)
+
+	node.restorePoolsFromSnapshot(snapshot)
+
+	roundHandler, ok := node.CoreComponentsHolder.RoundHandler().(roundIndexSetter)
+	if !ok {
+		return errWrongRoundHandlerType
+	}
+	roundHandler.SetIndex(snapshot.Round)
+
+	appStatusHandler := node.StatusCoreComponents.AppStatusHandler()
+	appStatusHandler.SetUInt64Value(common.MetricCurrentRound, uint64(snapshot.Round))
+	appStatusHandler.SetUInt64Value(common.MetricNonce, snapshot.Nonce)
+
+	log.Debug("node reverted to snapshot",
+		"shard", node.GetShardCoordinator().SelfId(),
+		"epoch", snapshot.Epoch,
+		"round", snapshot.Round,
+		"nonce", snapshot.Nonce,
+		"hash", snapshot.HeaderHash)
+
+	return nil
+}
+
+func (node *testOnlyProcessingNode) getCurrentHeaderAndHash() (data.HeaderHandler, []byte) {
+	header := node.ChainHandler.GetCurrentBlockHeader()
+	if check.IfNil(header) {
+		return node.ChainHandler.GetGenesisHeader(), node.ChainHandler.GetGenesisHeaderHash()
+	}
+
+	return header, node.ChainHandler.GetCurrentBlockHeaderHash()
+}
+
+// rollBackOneBlock mimics the roll back executed by the sync mechanism of a regular node
+func (node *testOnlyProcessingNode) rollBackOneBlock(step *rollBackStep) error {
+	var err error
+	isPrevHeaderGenesis := bytes.Equal(step.prevHeaderHash, node.ChainHandler.GetGenesisHeaderHash())
+	if isPrevHeaderGenesis {
+		err = node.ChainHandler.SetCurrentBlockHeaderAndRootHash(nil, nil)
+		node.ChainHandler.SetCurrentBlockHeaderHash(nil)
+	} else {
+		err = node.ChainHandler.SetCurrentBlockHeaderAndRootHash(step.prevHeader, step.prevRootHash)
+		node.ChainHandler.SetCurrentBlockHeaderHash(step.prevHeaderHash)
+	}
+	if err != nil {
+		return err
+	}
+
+	blockProcessor := node.ProcessComponentsHolder.BlockProcessor()
+	err = blockProcessor.RevertStateToBlock(step.prevHeader, step.prevRootHash)
+	if err != nil {
+		return err
+	}
+
+	blockProcessor.PruneStateOnRollback(step.header, step.headerHash, step.prevHeader, step.prevHeaderHash)
+
+	err = blockProcessor.RestoreBlockIntoPools(step.header, step.body)
+	if err != nil {
+		return err
+	}
+
+	err = node.cleanCachesAndStorageOnRollback(step.header, step.headerHash)
+	if err != nil {
+		return err
+	}
+
+	err = node.ProcessComponentsHolder.HistoryRepository().RevertBlock(step.header, step.body)
+	if err != nil {
+		return err
+	}
+
+	node.rollBackScheduledInfo(step.prevHeader, step.prevHeaderHash)
+
+	err = node.StatusComponentsHolder.OutportHandler().RevertIndexedBlock(&outportcore.HeaderDataWithBody{
+		Body:       step.body,
+		HeaderHash: step.headerHash,
+		Header:     step.header,
+	})
+	if err != nil {
+		log.Warn("rollBackOneBlock: cannot revert indexed block", "error", err)
+	}
+
+	return nil
+}
+
+// restoreEpoch brings the epoch dependent components back to the epoch of the provided header, if it changed. The
+// epoch start trigger was already reverted while rolling back the blocks
+func (node *testOnlyProcessingNode) restoreEpoch(header data.HeaderHandler) error {
+	epoch := header.GetEpoch()
+	if epoch == node.CoreComponentsHolder.EnableEpochsHandler().GetCurrentEpoch() {
+		return nil
+	}
+
+	epochStartHandler, ok := node.NodesCoordinator.(epochStartActionHandler)
+	if !ok {
+		return errWrongNodesCoordinatorType
+	}
+
+	node.CoreComponentsHolder.EpochNotifier().CheckEpoch(header)
+	epochStartHandler.EpochStartAction(header)
+	node.StatusCoreComponents.AppStatusHandler().SetUInt64Value(common.MetricEpochNumber, uint64(epoch))
+
+	log.Debug("node epoch restored", "shard", node.GetShardCoordinator().SelfId(), "epoch", epoch)
+
+	return nil
+}
+
+func (node *testOnlyProcessingNode) getHeader(headerHash []byte) (data.HeaderHandler, error) {
+	if bytes.Equal(headerHash, node.ChainHandler.GetGenesisHeaderHash()) {
+		return node.ChainHandler.GetGenesisHeader(), nil
+	}
+
+	return process.GetHeaderFromStorage(
+		node.GetShardCoordinator().SelfId(),
+		headerHash,
+		node.CoreComponentsHolder.InternalMarshalizer(),
+		node.StoreService,
+	)
+}
+
+func (node *testOnlyProcessingNode) getRootHashFromBlock(header data.HeaderHandler, headerHash []byte) []byte {
+	scheduledRootHash, err := node.ProcessComponentsHolder.ScheduledTxsExecutionHandler().GetScheduledRootHashForHeader(headerHash)
+	if err == nil {
+		return scheduledRootHash
+	}
+
+	return header.GetRootHash()
+}
+
+func (node *testOnlyProcessingNode) getBlockBody(header data.HeaderHandler) (data.BodyHandler, error) {
+	miniBlocksStorer, err := node.StoreService.GetStorer(dataRetriever.MiniBlockUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	marshaller := node.CoreComponentsHolder.InternalMarshalizer()
+	miniBlockHeaders := header.GetMiniBlockHeaderHandlers()
+	body := &block.Body{
+		MiniBlocks: make([]*block.MiniBlock, 0, len(miniBlockHeaders)),
+	}
+	for _, miniBlockHeader := range miniBlockHeaders {
+		buff, errGet := miniBlocksStorer.Get(miniBlockHeader.GetHash())
+		if errGet != nil {
+			return nil, fmt.Errorf("%w for miniblock %x", process.ErrMissingBody, miniBlockHeader.GetHash())
+		}
+
+		miniBlock := &block.MiniBlock{}
+		err = marshaller.Unmarshal(miniBlock, buff)
+		if err != nil {
+			return nil, err
+		}
+
+		body.MiniBlocks = append(body.MiniBlocks, miniBlock)
+	}
+
+	return body, nil
+}
+
+func (node *testOnlyProcessingNode) cleanCachesAndStorageOnRollback(header data.HeaderHandler, headerHash []byte) error {
+	node.DataPool.Headers().RemoveHeaderByHash(headerHash)
+	node.ProcessComponentsHolder.ForkDetector().RemoveHeader(header.GetNonce(), headerHash)
+
+	headerNonceHashStorer, err := node.StoreService.GetStorer(getHeaderNonceHashDataUnit(node.GetShardCoordinator().SelfId()))
+	if err != nil {
+		return err
+	}
+
+	nonceToByteSlice := node.CoreComponentsHolder.Uint64ByteSliceConverter().ToByteSlice(header.GetNonce())
+	_ = headerNonceHashStorer.Remove(nonceToByteSlice)
+
+	return nil
+}
+
+func getHeaderNonceHashDataUnit(shardID uint32) dataRetriever.UnitType {
+	if shardID == core.MetachainShardId {
+		return dataRetriever.MetaHdrNonceHashDataUnit
+	}
+
+	return dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(shardID)
+}
+
+func (node *testOnlyProcessingNode) rollBackScheduledInfo(header data.HeaderHandler, headerHash []byte) {
+	scheduledTxsExecutionHandler := node.ProcessComponentsHolder.ScheduledTxsExecutionHandler()
+	err := scheduledTxsExecutionHandler.RollBackToBlock(headerHash)
+	if err == nil {
+		return
+	}
+
+	scheduledTxsExecutionHandler.SetScheduledInfo(&process.ScheduledInfo{
+		RootHash:        header.GetRootHash(),
+		IntermediateTxs: make(map[block.Type][]data.TransactionHandler),
+		GasAndFees:      process.GetZeroGasAndFees(),
+		MiniBlocks:      make(block.MiniBlockSlice, 0),
+	})
+}
+
+func (node *testOnlyProcessingNode) restoreStateFromSnapshot(header data.HeaderHandler, snapshot *dtos.NodeSnapshot) error {
+	isGenesis := bytes.Equal(snapshot.HeaderHash, node.ChainHandler.GetGenesisHeaderHash())
+	if !isGenesis {
+		err := node.ChainHandler.SetCurrentBlockHeaderAndRootHash(header, snapshot.ChainRootHash)
+		if err != nil {
+			return err
+		}
+	}
+	node.ChainHandler.SetFinalBlockInfo(snapshot.FinalBlockNonce, snapshot.FinalBlockHash, snapshot.FinalBlockRootHash)
+
+	// the accounts might have been altered without producing a block, so the root hashes are restored separately
+	err := node.StateComponentsHolder.AccountsAdapter().RecreateTrie(holders.NewDefaultRootHashesHolder(snapshot.AccountsRootHash))
+	if err != nil {
+		return err
+	}
+
+	return node.StateComponentsHolder.PeerAccounts().RecreateTrie(holders.NewDefaultRootHashesHolder(snapshot.PeerAccountsRootHash))
+}
+
+func (node *testOnlyProcessingNode) cleanupHeadersAboveNonces(shardsNonces map[uint32]uint64) error {
+	blockTracker, ok := node.ProcessComponentsHolder.BlockTracker().(headersAboveNonceCleaner)
+	if !ok {
+		return errWrongBlockTrackerType
+	}
+
+	headersPool := node.DataPool.Headers()
+	for shardID, nonce := range shardsNonces {
+		for _, nonceInPool := range headersPool.Nonces(shardID) {
+			if nonceInPool > nonce {
+				headersPool.RemoveHeaderByNonceAndShardId(nonceInPool, shardID)
+			}
+		}
+
+		blockTracker.CleanupHeadersAboveNonce(shardID, nonce)
+
+		err := node.removeNonceHashEntriesAboveNonce(shardID, nonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeNonceHashEntriesAboveNonce removes the stored nonce-hash entries above the given nonce, as they would
+// otherwise be used to fetch the headers of the abandoned chain
+func (node *testOnlyProcessingNode) removeNonceHashEntriesAboveNonce(shardID uint32, nonce uint64) error {
+	headerNonceHashStorer, err := node.StoreService.GetStorer(getHeaderNonceHashDataUnit(shardID))
+	if err != nil {
+		return err
+	}
+
+	converter := node.CoreComponentsHolder.Uint64ByteSliceConverter()
+	for nonceToRemove := nonce + 1; ; nonceToRemove++ {
+		nonceToByteSlice := converter.ToByteSlice(nonceToRemove)
+		if headerNonceHashStorer.Has(nonceToByteSlice) != nil {
+			return nil
+		}
+
+		err = headerNonceHashStorer.Remove(nonceToByteSlice)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (node *testOnlyProcessingNode) restoreNotarizedHeaders(snapshot *dtos.NodeSnapshot) {
+	blockTracker := node.ProcessComponentsHolder.BlockTracker()
+	for shardID, notarized := range snapshot.CrossNotarized {
+		_, lastHash, err := blockTracker.GetLastCrossNotarizedHeader(shardID)
+		if err != nil || !bytes.Equal(lastHash, notarized.Hash) {
+			blockTracker.AddCrossNotarizedHeader(shardID, notarized.Header, notarized.Hash)
+		}
+	}
+
+	for shardID, notarized := range snapshot.SelfNotarized {
+		_, lastHash, err := blockTracker.GetLastSelfNotarizedHeader(shardID)
+		if err != nil || !bytes.Equal(lastHash, notarized.Hash) {
+			blockTracker.AddSelfNotarizedHeader(shardID, notarized.Header, notarized.Hash)
+		}
+	}
+}
+
+func (node *testOnlyProcessingNode) restorePoolsFromSnapshot(snapshot *dtos.NodeSnapshot) {
+	restoreShardedPool(node.DataPool.Transactions(), snapshot.Transactions)
+	restoreShardedPool(node.DataPool.UnsignedTransactions(), snapshot.UnsignedTransactions)
+	restoreShardedPool(node.DataPool.RewardTransactions(), snapshot.RewardTransactions)
+
+	miniBlocksPool := node.DataPool.MiniBlocks()
+	miniBlocksPool.Clear()
+	for _, entry := range snapshot.MiniBlocks {
+		_ = miniBlocksPool.Put(entry.Key, entry.Value, entry.Size)
+	}
+}
+
+func restoreShardedPool(pool dataRetriever.ShardedDataCacherNotifier, entries []*dtos.PoolEntry) {
+	pool.Clear()
+	for _, entry := range entries {
+		pool.AddData(entry.Key, entry.Value, entry.Size, entry.CacheID)
+	}
+}
+
+func (node *testOnlyProcessingNode) getShardedPoolEntries(
+	pool dataRetriever.ShardedDataCacherNotifier,
+	computeCacheID func(tx data.TransactionHandler) string,
+) ([]*dtos.PoolEntry, error) {
+	marshaller := node.CoreComponentsHolder.InternalMarshalizer()
+	keys := pool.Keys()
+	entries := make([]*dtos.PoolEntry, 0, len(keys))
+	for _, key := range keys {
+		value, found := pool.SearchFirstData(key)
+		if !found {
+			continue
+		}
+
+		tx, ok := value.(data.TransactionHandler)
+		if !ok {
+			return nil, fmt.Errorf("%w for pool entry with key %x", process.ErrWrongTypeAssertion, key)
+		}
+
+		buff, err := marshaller.Marshal(tx)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, &dtos.PoolEntry{
+			Key:     key,
+			Value:   value,
+			Size:    len(buff),
+			CacheID: computeCacheID(tx),
+		})
+	}
+
+	return entries, nil
+}
+
+func (node *testOnlyProcessingNode) computeTransactionCacheID(tx data.TransactionHandler) string {
+	shardCoordinator := node.GetShardCoordinator()
+	senderShardID := shardCoordinator.ComputeId(tx.GetSndAddr())
+	receiverShardID := shardCoordinator.ComputeId(tx.GetRcvAddr())
+
+	return process.ShardCacherIdentifier(senderShardID, receiverShardID)
+}
+
+func (node *testOnlyProcessingNode) computeRewardTransactionCacheID(tx data.TransactionHandler) string {
+	receiverShardID := node.GetShardCoordinator().ComputeId(tx.GetRcvAddr())
+
+	return process.ShardCacherIdentifier(core.MetachainShardId, receiverShardID)
+}
+
+func (node *testOnlyProcessingNode) getCacherEntries(cacher storage.Cacher) ([]*dtos.PoolEntry, error) {
+	marshaller := node.CoreComponentsHolder.InternalMarshalizer()
+	keys := cacher.Keys()
+	entries := make([]*dtos.PoolEntry, 0, len(keys))
+	for _, key := range keys {
+		value, found := cacher.Peek(key)
+		if !found {
+			continue
+		}
+
+		buff, err := marshaller.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, &dtos.PoolEntry{
+			Key:   key,
+			Value: value,
+			Size:  len(buff),
+		})
+	}
+
+	return entries, nil
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/multiversx/mx-chain-go/integrationTests/mock"
 	"github.com/multiversx/mx-chain-go/outport"
 	"github.com/multiversx/mx-chain-go/outport/factory"
+	"github.com/multiversx/mx-chain-go/outport/subscriptions"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/testscommon"
 )
@@ -27,6 +28,7 @@ type statusComponentsHolder struct {
 	outportHandler           outport.OutportHandler
 	softwareVersionChecker   statistics.SoftwareVersionChecker
 	managedPeerMonitor       common.ManagedPeersMonitor
+	subscriptionsHub         common.SubscriptionsHandler
 	appStatusHandler         core.AppStatusHandler
 	forkDetector             process.ForkDetector
 	statusPollingIntervalSec int
@@ -64,6 +66,7 @@ func CreateStatusComponents(shardID uint32, appStatusHandler core.AppStatusHandl
 	}
 	instance.softwareVersionChecker = &mock.SoftwareVersionCheckerMock{}
 	instance.managedPeerMonitor = &testscommon.ManagedPeersMonitorStub{}
+	instance.subscriptionsHub = subscriptions.NewDisabledSubscriptionsHub()
 
 	instance.collectClosableComponents()
 
@@ -127,6 +130,11 @@ func (s *statusComponentsHolder) ManagedPeersMonitor() common.ManagedPeersMonito
 	return s.managedPeerMonitor
 }
 
+// SubscriptionsHandler will return the subscriptions handler
+func (s *statusComponentsHolder) SubscriptionsHandler() common.SubscriptionsHandler {
+	return s.subscriptionsHub
+}
+
 func (s *statusComponentsHolder) collectClosableComponents() {
 	s.closeHandler.AddComponent(s.outportHandler)
 	s.closeHandler.AddComponent(s.softwareVersionChecker)
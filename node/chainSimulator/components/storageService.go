@@ -28,6 +28,8 @@ func CreateStore(numOfShards uint32) dataRetriever.StorageService {
 	store.AddStorer(dataRetriever.MiniblockHashByTxHashUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.EpochByHashUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.ResultsHashesByTxHashUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.LogsIndexUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.AccountTransactionsUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.TrieEpochRootHashUnit, CreateMemUnit())
 
 	for i := uint32(0); i < numOfShards; i++ {
@@ -35,6 +35,8 @@ func TestCreateStore(t *testing.T) {
 		dataRetriever.MiniblockHashByTxHashUnit,
 		dataRetriever.EpochByHashUnit,
 		dataRetriever.ResultsHashesByTxHashUnit,
+		dataRetriever.LogsIndexUnit,
+		dataRetriever.AccountTransactionsUnit,
 		dataRetriever.TrieEpochRootHashUnit,
 		dataRetriever.ShardHdrNonceHashDataUnit,
 		dataRetriever.UnitType(101), // shard 2
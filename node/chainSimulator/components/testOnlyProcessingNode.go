@@ -70,6 +70,7 @@ type testOnlyProcessingNode struct {
 	TransactionFeeHandler process.TransactionFeeHandler
 	StoreService          dataRetriever.StorageService
 	DataPool              dataRetriever.PoolsHolder
+	headersPool           *trackedHeadersPool
 	broadcastMessenger    consensus.BroadcastMessenger
 
 	httpServer    shared.UpgradeableHttpServerHandler
@@ -262,8 +263,6 @@ func (node *testOnlyProcessingNode) createBlockChain(selfShardID uint32) error {
 }
 
 func (node *testOnlyProcessingNode) createDataPool(args ArgsTestOnlyProcessingNode) error {
-	var err error
-
 	argsDataPool := dataRetrieverFactory.ArgsDataPool{
 		Config:           args.Configs.GeneralConfig,
 		EconomicsData:    node.CoreComponentsHolder.EconomicsData(),
@@ -272,9 +271,18 @@ func (node *testOnlyProcessingNode) createDataPool(args ArgsTestOnlyProcessingNo
 		PathManager:      node.CoreComponentsHolder.PathHandler(),
 	}
 
-	node.DataPool, err = dataRetrieverFactory.NewDataPoolFromConfig(argsDataPool)
+	dataPool, err := dataRetrieverFactory.NewDataPoolFromConfig(argsDataPool)
+	if err != nil {
+		return err
+	}
 
-	return err
+	node.headersPool = newTrackedHeadersPool(dataPool.Headers())
+	node.DataPool = &poolsHolderWithTrackedHeaders{
+		PoolsHolder: dataPool,
+		headersPool: node.headersPool,
+	}
+
+	return nil
 }
 
 func (node *testOnlyProcessingNode) createNodesCoordinator(pref config.PreferencesConfig, generalConfig config.Config) error {
@@ -0,0 +1,89 @@
+package components
+
+import (
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+)
+
+type trackedHeadersPool struct {
+	dataRetriever.HeadersPool
+	mutHandlers      sync.RWMutex
+	handlers         []func(headerHandler data.HeaderHandler, headerHash []byte)
+	runningNotifiers sync.WaitGroup
+}
+
+// newTrackedHeadersPool wraps the provided headers pool so that the notifications of the added headers, which are
+// still executed on separate goroutines, can be waited for
+func newTrackedHeadersPool(headersPool dataRetriever.HeadersPool) *trackedHeadersPool {
+	return &trackedHeadersPool{
+		HeadersPool: headersPool,
+		handlers:    make([]func(headerHandler data.HeaderHandler, headerHash []byte), 0),
+	}
+}
+
+// AddHeader adds the header in the wrapped pool and notifies the registered handlers if the header was not already there
+func (pool *trackedHeadersPool) AddHeader(headerHash []byte, header data.HeaderHandler) {
+	_, err := pool.HeadersPool.GetHeaderByHash(headerHash)
+	wasInPool := err == nil
+
+	pool.HeadersPool.AddHeader(headerHash, header)
+	if wasInPool {
+		return
+	}
+
+	_, err = pool.HeadersPool.GetHeaderByHash(headerHash)
+	if err != nil {
+		return
+	}
+
+	pool.mutHandlers.RLock()
+	defer pool.mutHandlers.RUnlock()
+
+	for _, handler := range pool.handlers {
+		pool.runningNotifiers.Add(1)
+		go func(handler func(headerHandler data.HeaderHandler, headerHash []byte)) {
+			defer pool.runningNotifiers.Done()
+
+			handler(header, headerHash)
+		}(handler)
+	}
+}
+
+// RegisterHandler registers a new handler to be called when a new header is added
+func (pool *trackedHeadersPool) RegisterHandler(handler func(headerHandler data.HeaderHandler, headerHash []byte)) {
+	if handler == nil {
+		log.Error("attempt to register a nil handler to a tracked headers pool")
+		return
+	}
+
+	pool.mutHandlers.Lock()
+	pool.handlers = append(pool.handlers, handler)
+	pool.mutHandlers.Unlock()
+}
+
+// WaitForNotifications blocks until all the notifications of the added headers have been handled
+func (pool *trackedHeadersPool) WaitForNotifications() {
+	pool.runningNotifiers.Wait()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pool *trackedHeadersPool) IsInterfaceNil() bool {
+	return pool == nil
+}
+
+type poolsHolderWithTrackedHeaders struct {
+	dataRetriever.PoolsHolder
+	headersPool *trackedHeadersPool
+}
+
+// Headers returns the tracked headers pool
+func (holder *poolsHolderWithTrackedHeaders) Headers() dataRetriever.HeadersPool {
+	return holder.headersPool
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (holder *poolsHolderWithTrackedHeaders) IsInterfaceNil() bool {
+	return holder == nil
+}
@@ -0,0 +1,115 @@
+package components
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever/dataPool/headersCache"
+	"github.com/stretchr/testify/require"
+)
+
+func createTrackedHeadersPool(t *testing.T) *trackedHeadersPool {
+	headersPool, err := headersCache.NewHeadersPool(config.HeadersPoolConfig{
+		MaxHeadersPerShard:            100,
+		NumElementsToRemoveOnEviction: 10,
+	})
+	require.Nil(t, err)
+
+	return newTrackedHeadersPool(headersPool)
+}
+
+func TestTrackedHeadersPool_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var pool *trackedHeadersPool
+	require.True(t, pool.IsInterfaceNil())
+
+	pool = createTrackedHeadersPool(t)
+	require.False(t, pool.IsInterfaceNil())
+}
+
+func TestTrackedHeadersPool_AddHeader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("new header should notify the handlers and wait for them", func(t *testing.T) {
+		t.Parallel()
+
+		pool := createTrackedHeadersPool(t)
+		pool.RegisterHandler(nil)
+
+		numCalls := uint32(0)
+		release := make(chan struct{})
+		pool.RegisterHandler(func(headerHandler data.HeaderHandler, headerHash []byte) {
+			<-release
+			atomic.AddUint32(&numCalls, 1)
+		})
+
+		header := &block.Header{Nonce: 1}
+		pool.AddHeader([]byte("hash"), header)
+
+		retrievedHeader, err := pool.GetHeaderByHash([]byte("hash"))
+		require.Nil(t, err)
+		require.Equal(t, header, retrievedHeader)
+
+		waitDone := make(chan struct{})
+		go func() {
+			pool.WaitForNotifications()
+			close(waitDone)
+		}()
+
+		select {
+		case <-waitDone:
+			require.Fail(t, "should have waited for the running notification")
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		close(release)
+		<-waitDone
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+	})
+	t.Run("header already in pool should not notify the handlers", func(t *testing.T) {
+		t.Parallel()
+
+		pool := createTrackedHeadersPool(t)
+		header := &block.Header{Nonce: 1}
+		pool.AddHeader([]byte("hash"), header)
+
+		numCalls := uint32(0)
+		pool.RegisterHandler(func(headerHandler data.HeaderHandler, headerHash []byte) {
+			atomic.AddUint32(&numCalls, 1)
+		})
+
+		pool.AddHeader([]byte("hash"), header)
+		pool.WaitForNotifications()
+		require.Zero(t, atomic.LoadUint32(&numCalls))
+	})
+	t.Run("header not added should not notify the handlers", func(t *testing.T) {
+		t.Parallel()
+
+		pool := createTrackedHeadersPool(t)
+
+		numCalls := uint32(0)
+		pool.RegisterHandler(func(headerHandler data.HeaderHandler, headerHash []byte) {
+			atomic.AddUint32(&numCalls, 1)
+		})
+
+		pool.AddHeader(nil, &block.Header{Nonce: 1})
+		pool.WaitForNotifications()
+		require.Zero(t, atomic.LoadUint32(&numCalls))
+	})
+}
+
+func TestPoolsHolderWithTrackedHeaders_Headers(t *testing.T) {
+	t.Parallel()
+
+	pool := createTrackedHeadersPool(t)
+	holder := &poolsHolderWithTrackedHeaders{
+		headersPool: pool,
+	}
+	require.False(t, holder.IsInterfaceNil())
+	require.True(t, holder.Headers() == pool)
+}
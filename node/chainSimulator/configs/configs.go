@@ -115,6 +115,9 @@ func CreateChainSimulatorConfigs(args ArgsChainSimulatorConfigs) (*ArgsConfigsSi
 
 	// set compatible trie configs
 	configs.GeneralConfig.StateTriesConfig.SnapshotsEnabled = false
+	// pruning is disabled so the chain simulator will be able to revert to older root hashes
+	configs.GeneralConfig.StateTriesConfig.AccountsStatePruningEnabled = false
+	configs.GeneralConfig.StateTriesConfig.PeerStatePruningEnabled = false
 
 	// enable db lookup extension
 	configs.GeneralConfig.DbLookupExtensions.Enabled = true
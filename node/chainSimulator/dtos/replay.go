@@ -0,0 +1,35 @@
+package dtos
+
+import "github.com/multiversx/mx-chain-core-go/data/transaction"
+
+// RecordedBlock holds the transactions originating in a recorded block, together with their execution outcome
+type RecordedBlock struct {
+	Nonce         uint64                              `json:"nonce"`
+	Round         uint64                              `json:"round"`
+	Hash          string                              `json:"hash"`
+	StateRootHash string                              `json:"stateRootHash"`
+	Timestamp     int64                               `json:"timestamp"`
+	Transactions  []*transaction.ApiTransactionResult `json:"transactions"`
+}
+
+// ReplayRecord holds a range of blocks recorded from a shard
+type ReplayRecord struct {
+	ShardID uint32           `json:"shardID"`
+	Blocks  []*RecordedBlock `json:"blocks"`
+}
+
+// ReplayDivergence holds a difference found between a recorded block or transaction and its replay
+type ReplayDivergence struct {
+	BlockNonce uint64 `json:"blockNonce"`
+	TxHash     string `json:"txHash,omitempty"`
+	Field      string `json:"field"`
+	Expected   string `json:"expected"`
+	Actual     string `json:"actual"`
+}
+
+// ReplayReport holds the outcome of a replay
+type ReplayReport struct {
+	NumBlocks       int                 `json:"numBlocks"`
+	NumTransactions int                 `json:"numTransactions"`
+	Divergences     []*ReplayDivergence `json:"divergences"`
+}
@@ -0,0 +1,37 @@
+package dtos
+
+import "github.com/multiversx/mx-chain-core-go/data"
+
+// PoolEntry holds an entry of a data pool together with the cache it belongs to
+type PoolEntry struct {
+	Key     []byte
+	Value   interface{}
+	Size    int
+	CacheID string
+}
+
+// NotarizedHeader holds a notarized header together with its hash
+type NotarizedHeader struct {
+	Header data.HeaderHandler
+	Hash   []byte
+}
+
+// NodeSnapshot holds the information needed to bring a node back to a previously recorded state
+type NodeSnapshot struct {
+	Epoch                uint32
+	Round                int64
+	Nonce                uint64
+	HeaderHash           []byte
+	ChainRootHash        []byte
+	AccountsRootHash     []byte
+	PeerAccountsRootHash []byte
+	FinalBlockNonce      uint64
+	FinalBlockHash       []byte
+	FinalBlockRootHash   []byte
+	Transactions         []*PoolEntry
+	UnsignedTransactions []*PoolEntry
+	RewardTransactions   []*PoolEntry
+	MiniBlocks           []*PoolEntry
+	CrossNotarized       map[uint32]*NotarizedHeader
+	SelfNotarized        map[uint32]*NotarizedHeader
+}
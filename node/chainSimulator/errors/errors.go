@@ -10,3 +10,15 @@ var ErrNilTransaction = errors.New("nil transaction")
 
 // ErrInvalidMaxNumOfBlocks signals that an invalid max numerof blocks has been provided
 var ErrInvalidMaxNumOfBlocks = errors.New("invalid max number of blocks to generate")
+
+// ErrSnapshotNotFound signals that the requested snapshot was not found
+var ErrSnapshotNotFound = errors.New("snapshot not found")
+
+// ErrInvalidTimeDuration signals that an invalid time duration has been provided
+var ErrInvalidTimeDuration = errors.New("invalid time duration")
+
+// ErrTimestampNotInTheFuture signals that the provided timestamp is not after the current round timestamp
+var ErrTimestampNotInTheFuture = errors.New("the timestamp should be after the current round timestamp")
+
+// ErrTimestampNotAlignedWithRounds signals that the provided timestamp does not match the start of a round
+var ErrTimestampNotAlignedWithRounds = errors.New("the timestamp does not match the start of a round")
@@ -4,7 +4,8 @@ import "github.com/multiversx/mx-chain-go/node/chainSimulator/process"
 
 // ChainHandler defines what a chain handler should be able to do
 type ChainHandler interface {
-	IncrementRound()
+	IncrementRound() error
+	SetRound(round int64) error
 	CreateNewBlock() error
 	IsInterfaceNil() bool
 }
@@ -4,3 +4,6 @@ import "errors"
 
 // ErrNilNodeHandler signals that a nil node handler has been provided
 var ErrNilNodeHandler = errors.New("nil node handler")
+
+// ErrWrongRoundHandlerType signals that the round handler does not allow changing the round index
+var ErrWrongRoundHandlerType = errors.New("the round handler does not allow changing the round index")
@@ -25,6 +25,9 @@ type NodeHandler interface {
 	SetStateForAddress(address []byte, state *dtos.AddressState) error
 	RemoveAccount(address []byte) error
 	ForceChangeOfEpoch() error
+	TakeSnapshot() (*dtos.NodeSnapshot, error)
+	CheckSnapshot(snapshot *dtos.NodeSnapshot) error
+	RevertToSnapshot(snapshot *dtos.NodeSnapshot, shardsNonces map[uint32]uint64) error
 	Close() error
 	IsInterfaceNil() bool
 }
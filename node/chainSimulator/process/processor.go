@@ -1,6 +1,8 @@
 package process
 
 import (
+	"fmt"
+
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-go/common"
@@ -13,6 +15,7 @@ var log = logger.GetOrCreate("process-block")
 
 type manualRoundHandler interface {
 	IncrementIndex()
+	SetIndex(index int64)
 }
 
 type blocksCreator struct {
@@ -31,12 +34,40 @@ func NewBlocksCreator(nodeHandler NodeHandler) (*blocksCreator, error) {
 }
 
 // IncrementRound will increment the current round
-func (creator *blocksCreator) IncrementRound() {
-	roundHandler := creator.nodeHandler.GetCoreComponents().RoundHandler()
-	manual := roundHandler.(manualRoundHandler)
+func (creator *blocksCreator) IncrementRound() error {
+	manual, err := creator.getManualRoundHandler()
+	if err != nil {
+		return err
+	}
 	manual.IncrementIndex()
 
+	roundHandler := creator.nodeHandler.GetCoreComponents().RoundHandler()
 	creator.nodeHandler.GetStatusCoreComponents().AppStatusHandler().SetUInt64Value(common.MetricCurrentRound, uint64(roundHandler.Index()))
+
+	return nil
+}
+
+// SetRound will set the current round to the provided value
+func (creator *blocksCreator) SetRound(round int64) error {
+	manual, err := creator.getManualRoundHandler()
+	if err != nil {
+		return err
+	}
+	manual.SetIndex(round)
+
+	creator.nodeHandler.GetStatusCoreComponents().AppStatusHandler().SetUInt64Value(common.MetricCurrentRound, uint64(round))
+
+	return nil
+}
+
+func (creator *blocksCreator) getManualRoundHandler() (manualRoundHandler, error) {
+	roundHandler := creator.nodeHandler.GetCoreComponents().RoundHandler()
+	manual, ok := roundHandler.(manualRoundHandler)
+	if !ok {
+		return nil, fmt.Errorf("%w, round handler type: %T", ErrWrongRoundHandlerType, roundHandler)
+	}
+
+	return manual, nil
 }
 
 // CreateNewBlock creates and process a new block
@@ -61,37 +61,122 @@ func TestBlocksCreator_IsInterfaceNil(t *testing.T) {
 func TestBlocksCreator_IncrementRound(t *testing.T) {
 	t.Parallel()
 
-	wasIncrementIndexCalled := false
-	wasSetUInt64ValueCalled := false
-	nodeHandler := &chainSimulator.NodeHandlerMock{
-		GetCoreComponentsCalled: func() factory.CoreComponentsHolder {
-			return &testsFactory.CoreComponentsHolderStub{
-				RoundHandlerCalled: func() consensus.RoundHandler {
-					return &testscommon.RoundHandlerMock{
-						IncrementIndexCalled: func() {
-							wasIncrementIndexCalled = true
+	t.Run("wrong round handler type should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodeHandler := &chainSimulator.NodeHandlerMock{
+			GetCoreComponentsCalled: func() factory.CoreComponentsHolder {
+				return &testsFactory.CoreComponentsHolderStub{
+					RoundHandlerCalled: func() consensus.RoundHandler {
+						return &mockConsensus.RoundHandlerMock{}
+					},
+				}
+			},
+		}
+		creator, err := chainSimulatorProcess.NewBlocksCreator(nodeHandler)
+		require.NoError(t, err)
+
+		err = creator.IncrementRound()
+		require.ErrorIs(t, err, chainSimulatorProcess.ErrWrongRoundHandlerType)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasIncrementIndexCalled := false
+		wasSetUInt64ValueCalled := false
+		nodeHandler := &chainSimulator.NodeHandlerMock{
+			GetCoreComponentsCalled: func() factory.CoreComponentsHolder {
+				return &testsFactory.CoreComponentsHolderStub{
+					RoundHandlerCalled: func() consensus.RoundHandler {
+						return &testscommon.RoundHandlerMock{
+							IncrementIndexCalled: func() {
+								wasIncrementIndexCalled = true
+							},
+						}
+					},
+				}
+			},
+			GetStatusCoreComponentsCalled: func() factory.StatusCoreComponentsHolder {
+				return &testsFactory.StatusCoreComponentsStub{
+					AppStatusHandlerField: &statusHandler.AppStatusHandlerStub{
+						SetUInt64ValueHandler: func(key string, value uint64) {
+							wasSetUInt64ValueCalled = true
+							require.Equal(t, common.MetricCurrentRound, key)
 						},
-					}
-				},
-			}
-		},
-		GetStatusCoreComponentsCalled: func() factory.StatusCoreComponentsHolder {
-			return &testsFactory.StatusCoreComponentsStub{
-				AppStatusHandlerField: &statusHandler.AppStatusHandlerStub{
-					SetUInt64ValueHandler: func(key string, value uint64) {
-						wasSetUInt64ValueCalled = true
-						require.Equal(t, common.MetricCurrentRound, key)
 					},
-				},
-			}
-		},
-	}
-	creator, err := chainSimulatorProcess.NewBlocksCreator(nodeHandler)
-	require.NoError(t, err)
+				}
+			},
+		}
+		creator, err := chainSimulatorProcess.NewBlocksCreator(nodeHandler)
+		require.NoError(t, err)
+
+		err = creator.IncrementRound()
+		require.NoError(t, err)
+		require.True(t, wasIncrementIndexCalled)
+		require.True(t, wasSetUInt64ValueCalled)
+	})
+}
+
+func TestBlocksCreator_SetRound(t *testing.T) {
+	t.Parallel()
+
+	t.Run("wrong round handler type should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodeHandler := &chainSimulator.NodeHandlerMock{
+			GetCoreComponentsCalled: func() factory.CoreComponentsHolder {
+				return &testsFactory.CoreComponentsHolderStub{
+					RoundHandlerCalled: func() consensus.RoundHandler {
+						return &mockConsensus.RoundHandlerMock{}
+					},
+				}
+			},
+		}
+		creator, err := chainSimulatorProcess.NewBlocksCreator(nodeHandler)
+		require.NoError(t, err)
+
+		err = creator.SetRound(123)
+		require.ErrorIs(t, err, chainSimulatorProcess.ErrWrongRoundHandlerType)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedRound := int64(123)
+		wasSetIndexCalled := false
+		wasSetUInt64ValueCalled := false
+		nodeHandler := &chainSimulator.NodeHandlerMock{
+			GetCoreComponentsCalled: func() factory.CoreComponentsHolder {
+				return &testsFactory.CoreComponentsHolderStub{
+					RoundHandlerCalled: func() consensus.RoundHandler {
+						return &testscommon.RoundHandlerMock{
+							SetIndexCalled: func(index int64) {
+								wasSetIndexCalled = true
+								require.Equal(t, providedRound, index)
+							},
+						}
+					},
+				}
+			},
+			GetStatusCoreComponentsCalled: func() factory.StatusCoreComponentsHolder {
+				return &testsFactory.StatusCoreComponentsStub{
+					AppStatusHandlerField: &statusHandler.AppStatusHandlerStub{
+						SetUInt64ValueHandler: func(key string, value uint64) {
+							wasSetUInt64ValueCalled = true
+							require.Equal(t, common.MetricCurrentRound, key)
+							require.Equal(t, uint64(providedRound), value)
+						},
+					},
+				}
+			},
+		}
+		creator, err := chainSimulatorProcess.NewBlocksCreator(nodeHandler)
+		require.NoError(t, err)
 
-	creator.IncrementRound()
-	require.True(t, wasIncrementIndexCalled)
-	require.True(t, wasSetUInt64ValueCalled)
+		err = creator.SetRound(providedRound)
+		require.NoError(t, err)
+		require.True(t, wasSetIndexCalled)
+		require.True(t, wasSetUInt64ValueCalled)
+	})
 }
 
 func TestBlocksCreator_CreateNewBlock(t *testing.T) {
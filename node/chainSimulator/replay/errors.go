@@ -0,0 +1,36 @@
+package replay
+
+import "errors"
+
+// ErrNilBlocksProvider signals that a nil blocks provider has been provided
+var ErrNilBlocksProvider = errors.New("nil blocks provider")
+
+// ErrNilChainSimulator signals that a nil chain simulator has been provided
+var ErrNilChainSimulator = errors.New("nil chain simulator")
+
+// ErrNilNodeHandler signals that a nil node handler has been provided
+var ErrNilNodeHandler = errors.New("nil node handler")
+
+// ErrNilReplayRecord signals that a nil replay record has been provided
+var ErrNilReplayRecord = errors.New("nil replay record")
+
+// ErrInvalidBlocksRange signals that an invalid range of blocks has been provided
+var ErrInvalidBlocksRange = errors.New("invalid blocks range")
+
+// ErrInvalidMaxNumOfBlocks signals that an invalid max number of blocks has been provided
+var ErrInvalidMaxNumOfBlocks = errors.New("invalid max number of blocks to generate")
+
+// ErrEmptyURL signals that an empty URL has been provided
+var ErrEmptyURL = errors.New("empty URL")
+
+// ErrRequestFailed signals that a request to the blocks provider has failed
+var ErrRequestFailed = errors.New("request failed")
+
+// ErrInvalidValue signals that an invalid value has been recorded for a transaction
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
+
+// ErrNilAddressConverter signals that a nil address converter has been provided
+var ErrNilAddressConverter = errors.New("nil address converter")
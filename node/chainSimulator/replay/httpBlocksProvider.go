@@ -0,0 +1,119 @@
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/api/shared"
+)
+
+const (
+	blockByNonceRouteFormat = "%s/block/by-nonce/%d?withTxs=%t&withLogs=%t"
+	transactionRouteFormat  = "%s/transaction/%s?withResults=%t"
+)
+
+type blockResponse struct {
+	Data struct {
+		Block *api.Block `json:"block"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type transactionResponse struct {
+	Data struct {
+		Transaction *transaction.ApiTransactionResult `json:"transaction"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type httpBlocksProvider struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+// NewHTTPBlocksProvider creates a blocks provider that fetches the blocks and transactions from the REST API of
+// an observer (or a proxy) located at the provided base URL
+func NewHTTPBlocksProvider(baseURL string, requestTimeout time.Duration) (*httpBlocksProvider, error) {
+	if len(baseURL) == 0 {
+		return nil, ErrEmptyURL
+	}
+
+	httpClient := &http.Client{}
+	httpClient.Timeout = requestTimeout
+
+	return &httpBlocksProvider{
+		httpClient: httpClient,
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+	}, nil
+}
+
+// GetBlockByNonce returns the block with the provided nonce
+func (provider *httpBlocksProvider) GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+	url := fmt.Sprintf(blockByNonceRouteFormat, provider.baseURL, nonce, options.WithTransactions, options.WithLogs)
+
+	response := &blockResponse{}
+	err := provider.get(url, response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Code != string(shared.ReturnCodeSuccess) || response.Data.Block == nil {
+		return nil, fmt.Errorf("%w, code: %s, error: %s", ErrRequestFailed, response.Code, response.Error)
+	}
+
+	return response.Data.Block, nil
+}
+
+// GetTransaction returns the transaction with the provided hash
+func (provider *httpBlocksProvider) GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+	url := fmt.Sprintf(transactionRouteFormat, provider.baseURL, hash, withResults)
+
+	response := &transactionResponse{}
+	err := provider.get(url, response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Code != string(shared.ReturnCodeSuccess) || response.Data.Transaction == nil {
+		return nil, fmt.Errorf("%w, code: %s, error: %s", ErrRequestFailed, response.Code, response.Error)
+	}
+
+	return response.Data.Transaction, nil
+}
+
+func (provider *httpBlocksProvider) get(url string, response interface{}) error {
+	resp, err := provider.httpClient.Get(url)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		bodyCloseErr := resp.Body.Close()
+		if bodyCloseErr != nil {
+			log.Warn("error while trying to close response body", "err", bodyCloseErr.Error())
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	// the error responses of the API are also json encoded, so the body is decoded regardless of the status code
+	err = json.Unmarshal(body, response)
+	if err != nil {
+		return fmt.Errorf("%w, HTTP status code: %d", err, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (provider *httpBlocksProvider) IsInterfaceNil() bool {
+	return provider == nil
+}
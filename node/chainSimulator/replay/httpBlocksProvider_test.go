@@ -0,0 +1,103 @@
+package replay
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHTTPBlocksProvider(t *testing.T) {
+	t.Parallel()
+
+	provider, err := NewHTTPBlocksProvider("", time.Second)
+	require.Equal(t, ErrEmptyURL, err)
+	require.Nil(t, provider)
+
+	provider, err = NewHTTPBlocksProvider("http://localhost:8080/", time.Second)
+	require.Nil(t, err)
+	require.False(t, provider.IsInterfaceNil())
+	require.Equal(t, "http://localhost:8080", provider.baseURL)
+}
+
+func TestHttpBlocksProvider_GetBlockByNonce(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "/block/by-nonce/37", r.URL.Path)
+			require.Equal(t, "true", r.URL.Query().Get("withTxs"))
+			require.Equal(t, "false", r.URL.Query().Get("withLogs"))
+
+			_, _ = w.Write([]byte(`{"data":{"block":{"nonce":37,"round":38,"hash":"aa","stateRootHash":"bb"}},"error":"","code":"successful"}`))
+		}))
+		defer server.Close()
+
+		provider, _ := NewHTTPBlocksProvider(server.URL, time.Second)
+		block, err := provider.GetBlockByNonce(37, api.BlockQueryOptions{WithTransactions: true})
+		require.Nil(t, err)
+		require.Equal(t, uint64(37), block.Nonce)
+		require.Equal(t, uint64(38), block.Round)
+		require.Equal(t, "aa", block.Hash)
+		require.Equal(t, "bb", block.StateRootHash)
+	})
+	t.Run("failed request should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"data":null,"error":"block not found","code":"bad_request"}`))
+		}))
+		defer server.Close()
+
+		provider, _ := NewHTTPBlocksProvider(server.URL, time.Second)
+		block, err := provider.GetBlockByNonce(37, api.BlockQueryOptions{})
+		require.True(t, errors.Is(err, ErrRequestFailed))
+		require.Contains(t, err.Error(), "block not found")
+		require.Nil(t, block)
+	})
+}
+
+func TestHttpBlocksProvider_GetTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "/transaction/abcd", r.URL.Path)
+			require.Equal(t, "true", r.URL.Query().Get("withResults"))
+
+			_, _ = w.Write([]byte(`{"data":{"transaction":{"hash":"abcd","nonce":4,"status":"success","gasUsed":50000}},"error":"","code":"successful"}`))
+		}))
+		defer server.Close()
+
+		provider, _ := NewHTTPBlocksProvider(server.URL, time.Second)
+		tx, err := provider.GetTransaction("abcd", true)
+		require.Nil(t, err)
+		require.Equal(t, "abcd", tx.Hash)
+		require.Equal(t, uint64(4), tx.Nonce)
+		require.Equal(t, uint64(50000), tx.GasUsed)
+	})
+	t.Run("invalid response should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte("bad gateway"))
+		}))
+		defer server.Close()
+
+		provider, _ := NewHTTPBlocksProvider(server.URL, time.Second)
+		tx, err := provider.GetTransaction("abcd", true)
+		require.NotNil(t, err)
+		require.Contains(t, err.Error(), "HTTP status code: 502")
+		require.Nil(t, tx)
+	})
+}
@@ -0,0 +1,25 @@
+package replay
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/process"
+)
+
+// BlocksProvider defines the source of the recorded blocks and transactions
+type BlocksProvider interface {
+	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	IsInterfaceNil() bool
+}
+
+// ChainSimulator defines what the chain simulator should be able to do in order to replay the recorded blocks
+type ChainSimulator interface {
+	GetNodeHandler(shardID uint32) process.NodeHandler
+	GenerateBlocks(numOfBlocks int) error
+	SendTxsAndGenerateBlocksTilAreExecuted(txsToSend []*transaction.Transaction, maxNumOfBlocksToGenerateWhenExecutingTx int) ([]*transaction.ApiTransactionResult, error)
+	AdvanceTime(duration time.Duration) error
+	IsInterfaceNil() bool
+}
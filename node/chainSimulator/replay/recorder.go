@@ -0,0 +1,144 @@
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	normalTransactionType = "normal"
+	recordFilePermissions = 0644
+)
+
+var log = logger.GetOrCreate("chainSimulator/replay")
+
+// ArgsRecorder holds the arguments needed to create a new recorder
+type ArgsRecorder struct {
+	BlocksProvider BlocksProvider
+	ShardID        uint32
+}
+
+type recorder struct {
+	blocksProvider BlocksProvider
+	shardID        uint32
+}
+
+// NewRecorder creates a recorder able to fetch a range of blocks of a shard, together with the outcome of the
+// transactions originating in them
+func NewRecorder(args ArgsRecorder) (*recorder, error) {
+	if check.IfNil(args.BlocksProvider) {
+		return nil, ErrNilBlocksProvider
+	}
+
+	return &recorder{
+		blocksProvider: args.BlocksProvider,
+		shardID:        args.ShardID,
+	}, nil
+}
+
+// Record will fetch the blocks between the provided nonces, inclusive
+func (r *recorder) Record(startNonce uint64, endNonce uint64) (*dtos.ReplayRecord, error) {
+	if startNonce > endNonce {
+		return nil, fmt.Errorf("%w, start nonce: %d, end nonce: %d", ErrInvalidBlocksRange, startNonce, endNonce)
+	}
+
+	record := &dtos.ReplayRecord{
+		ShardID: r.shardID,
+		Blocks:  make([]*dtos.RecordedBlock, 0, endNonce-startNonce+1),
+	}
+
+	// the same transaction can be found in two consecutive blocks when its execution is scheduled
+	recordedTxs := make(map[string]struct{})
+	for nonce := startNonce; nonce <= endNonce; nonce++ {
+		recordedBlock, err := r.recordBlock(nonce, recordedTxs)
+		if err != nil {
+			return nil, fmt.Errorf("%w while recording block with nonce %d", err, nonce)
+		}
+
+		record.Blocks = append(record.Blocks, recordedBlock)
+	}
+
+	log.Debug("blocks recorded",
+		"shard", r.shardID,
+		"start nonce", startNonce,
+		"end nonce", endNonce)
+
+	return record, nil
+}
+
+func (r *recorder) recordBlock(nonce uint64, recordedTxs map[string]struct{}) (*dtos.RecordedBlock, error) {
+	apiBlock, err := r.blocksProvider.GetBlockByNonce(nonce, api.BlockQueryOptions{WithTransactions: true})
+	if err != nil {
+		return nil, err
+	}
+
+	recordedBlock := &dtos.RecordedBlock{
+		Nonce:         apiBlock.Nonce,
+		Round:         apiBlock.Round,
+		Hash:          apiBlock.Hash,
+		StateRootHash: apiBlock.StateRootHash,
+		Timestamp:     int64(apiBlock.Timestamp),
+		Transactions:  make([]*transaction.ApiTransactionResult, 0),
+	}
+
+	for _, miniBlock := range apiBlock.MiniBlocks {
+		if miniBlock.SourceShard != r.shardID {
+			continue
+		}
+
+		for _, tx := range miniBlock.Transactions {
+			_, alreadyRecorded := recordedTxs[tx.Hash]
+			if tx.Type != normalTransactionType || alreadyRecorded {
+				continue
+			}
+
+			txWithResults, errGet := r.blocksProvider.GetTransaction(tx.Hash, true)
+			if errGet != nil {
+				return nil, fmt.Errorf("%w for transaction %s", errGet, tx.Hash)
+			}
+
+			recordedTxs[tx.Hash] = struct{}{}
+			recordedBlock.Transactions = append(recordedBlock.Transactions, txWithResults)
+		}
+	}
+
+	return recordedBlock, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (r *recorder) IsInterfaceNil() bool {
+	return r == nil
+}
+
+// SaveRecord will write the provided record in a json file
+func SaveRecord(record *dtos.ReplayRecord, filePath string) error {
+	if record == nil {
+		return ErrNilReplayRecord
+	}
+
+	recordBytes, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filePath, recordBytes, recordFilePermissions)
+}
+
+// LoadRecord will read a record from the provided json file
+func LoadRecord(filePath string) (*dtos.ReplayRecord, error) {
+	record := &dtos.ReplayRecord{}
+	err := core.LoadJsonFile(record, filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
@@ -0,0 +1,183 @@
+package replay
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	"github.com/multiversx/mx-chain-go/testscommon/chainSimulator"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRecorder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil blocks provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewRecorder(ArgsRecorder{})
+		require.Equal(t, ErrNilBlocksProvider, err)
+		require.Nil(t, instance)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewRecorder(ArgsRecorder{
+			BlocksProvider: &chainSimulator.BlocksProviderStub{},
+		})
+		require.Nil(t, err)
+		require.False(t, instance.IsInterfaceNil())
+	})
+}
+
+func TestRecorder_Record(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid range should error", func(t *testing.T) {
+		t.Parallel()
+
+		instance, _ := NewRecorder(ArgsRecorder{
+			BlocksProvider: &chainSimulator.BlocksProviderStub{},
+		})
+
+		record, err := instance.Record(10, 9)
+		require.True(t, errors.Is(err, ErrInvalidBlocksRange))
+		require.Nil(t, record)
+	})
+	t.Run("get block error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		instance, _ := NewRecorder(ArgsRecorder{
+			BlocksProvider: &chainSimulator.BlocksProviderStub{
+				GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+					return nil, expectedErr
+				},
+			},
+		})
+
+		record, err := instance.Record(10, 11)
+		require.True(t, errors.Is(err, expectedErr))
+		require.Nil(t, record)
+	})
+	t.Run("get transaction error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		instance, _ := NewRecorder(ArgsRecorder{
+			BlocksProvider: &chainSimulator.BlocksProviderStub{
+				GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+					return createApiBlock(nonce, 0, "tx"), nil
+				},
+				GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+					return nil, expectedErr
+				},
+			},
+		})
+
+		record, err := instance.Record(10, 11)
+		require.True(t, errors.Is(err, expectedErr))
+		require.Nil(t, record)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		requestedTxs := make([]string, 0)
+		instance, _ := NewRecorder(ArgsRecorder{
+			ShardID: 1,
+			BlocksProvider: &chainSimulator.BlocksProviderStub{
+				GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+					require.True(t, options.WithTransactions)
+
+					block := createApiBlock(nonce, 1, "scheduledTx", "tx"+string(rune('0'+nonce)))
+					block.MiniBlocks = append(block.MiniBlocks, &api.MiniBlock{
+						SourceShard: 0,
+						Transactions: []*transaction.ApiTransactionResult{
+							{Hash: "crossShardTx", Type: normalTransactionType},
+						},
+					}, &api.MiniBlock{
+						SourceShard: 1,
+						Transactions: []*transaction.ApiTransactionResult{
+							{Hash: "scr", Type: "unsigned"},
+						},
+					})
+
+					return block, nil
+				},
+				GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+					require.True(t, withResults)
+					requestedTxs = append(requestedTxs, hash)
+
+					return &transaction.ApiTransactionResult{Hash: hash, Status: transaction.TxStatusSuccess}, nil
+				},
+			},
+		})
+
+		record, err := instance.Record(1, 2)
+		require.Nil(t, err)
+		require.Equal(t, uint32(1), record.ShardID)
+		require.Equal(t, 2, len(record.Blocks))
+		require.Equal(t, []string{"scheduledTx", "tx1", "tx2"}, requestedTxs)
+
+		require.Equal(t, uint64(1), record.Blocks[0].Nonce)
+		require.Equal(t, 2, len(record.Blocks[0].Transactions))
+		require.Equal(t, uint64(2), record.Blocks[1].Nonce)
+		require.Equal(t, 1, len(record.Blocks[1].Transactions))
+		require.Equal(t, "tx2", record.Blocks[1].Transactions[0].Hash)
+		require.Equal(t, transaction.TxStatusSuccess, record.Blocks[1].Transactions[0].Status)
+	})
+}
+
+func TestSaveRecordAndLoadRecord(t *testing.T) {
+	t.Parallel()
+
+	filePath := filepath.Join(t.TempDir(), "record.json")
+	err := SaveRecord(nil, filePath)
+	require.Equal(t, ErrNilReplayRecord, err)
+
+	record := &dtos.ReplayRecord{
+		ShardID: 2,
+		Blocks: []*dtos.RecordedBlock{
+			{
+				Nonce:         37,
+				Round:         38,
+				Hash:          "hash",
+				StateRootHash: "root hash",
+				Timestamp:     1700000000,
+				Transactions: []*transaction.ApiTransactionResult{
+					{Hash: "tx", Nonce: 4, Value: "100", Data: []byte("data"), Status: transaction.TxStatusSuccess},
+				},
+			},
+		},
+	}
+	err = SaveRecord(record, filePath)
+	require.Nil(t, err)
+
+	loadedRecord, err := LoadRecord(filePath)
+	require.Nil(t, err)
+	require.Equal(t, record, loadedRecord)
+}
+
+func createApiBlock(nonce uint64, shardID uint32, txHashes ...string) *api.Block {
+	txs := make([]*transaction.ApiTransactionResult, 0, len(txHashes))
+	for _, txHash := range txHashes {
+		txs = append(txs, &transaction.ApiTransactionResult{
+			Hash: txHash,
+			Type: normalTransactionType,
+		})
+	}
+
+	return &api.Block{
+		Nonce: nonce,
+		Round: nonce + 1,
+		MiniBlocks: []*api.MiniBlock{
+			{
+				SourceShard:  shardID,
+				Transactions: txs,
+			},
+		},
+	}
+}
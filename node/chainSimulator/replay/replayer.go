@@ -0,0 +1,353 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/process"
+)
+
+const (
+	fieldStatus               = "status"
+	fieldGasUsed              = "gasUsed"
+	fieldSmartContractResults = "smartContractResults"
+	fieldLogs                 = "logs"
+	fieldReceipt              = "receipt"
+	fieldFee                  = "fee"
+	fieldExecution            = "execution"
+
+	txHashPlaceholder = "<txHash>"
+)
+
+// ArgsReplayer holds the arguments needed to create a new replayer
+type ArgsReplayer struct {
+	ChainSimulator ChainSimulator
+	// MaxNumOfBlocksToGenerateWhenExecutingTxs bounds the number of blocks generated while waiting for the
+	// transactions of a recorded block to be executed
+	MaxNumOfBlocksToGenerateWhenExecutingTxs int
+	// NumOfBlocksToGenerateAfterExecution is the number of blocks generated after the transactions of a recorded block
+	// were executed, so the cross shard smart contract results are also executed before comparing the outcome
+	NumOfBlocksToGenerateAfterExecution int
+}
+
+type replayer struct {
+	chainSimulator                   ChainSimulator
+	maxNumOfBlocksWhenExecutingTxs   int
+	numOfBlocksToGenerateAfterExecTx int
+}
+
+type replayedTransaction struct {
+	recorded *transaction.ApiTransactionResult
+	replayed *transaction.ApiTransactionResult
+}
+
+// NewReplayer creates a replayer able to re-execute, in order, the transactions of the recorded blocks on the chain
+// simulator and to report the differences between the recorded and the replayed outcome
+func NewReplayer(args ArgsReplayer) (*replayer, error) {
+	if check.IfNil(args.ChainSimulator) {
+		return nil, ErrNilChainSimulator
+	}
+	if args.MaxNumOfBlocksToGenerateWhenExecutingTxs <= 0 {
+		return nil, ErrInvalidMaxNumOfBlocks
+	}
+	if args.NumOfBlocksToGenerateAfterExecution < 0 {
+		return nil, fmt.Errorf("%w for the number of blocks to generate after execution", ErrInvalidMaxNumOfBlocks)
+	}
+
+	return &replayer{
+		chainSimulator:                   args.ChainSimulator,
+		maxNumOfBlocksWhenExecutingTxs:   args.MaxNumOfBlocksToGenerateWhenExecutingTxs,
+		numOfBlocksToGenerateAfterExecTx: args.NumOfBlocksToGenerateAfterExecution,
+	}, nil
+}
+
+// Replay will send, block by block, the recorded transactions and will compare the outcome with the recorded one.
+// The chain simulator is expected to be already seeded with the state the recorded blocks were executed on and to
+// have generated at least one block afterwards, so the seeded state is also used when validating the transactions.
+// The outcome is compared per transaction (status, gas used, fee, smart contract results, logs and receipt) and not
+// through the state root hash, as the chain simulator state also holds the accounts of its own genesis
+func (r *replayer) Replay(record *dtos.ReplayRecord) (*dtos.ReplayReport, error) {
+	if record == nil {
+		return nil, ErrNilReplayRecord
+	}
+
+	nodeHandler := r.chainSimulator.GetNodeHandler(record.ShardID)
+	if check.IfNil(nodeHandler) {
+		return nil, fmt.Errorf("%w for shard %d", ErrNilNodeHandler, record.ShardID)
+	}
+
+	report := &dtos.ReplayReport{
+		Divergences: make([]*dtos.ReplayDivergence, 0),
+	}
+	for _, recordedBlock := range record.Blocks {
+		divergences, err := r.replayBlock(nodeHandler, recordedBlock)
+		if err != nil {
+			return nil, fmt.Errorf("%w while replaying block with nonce %d", err, recordedBlock.Nonce)
+		}
+
+		report.NumBlocks++
+		report.NumTransactions += len(recordedBlock.Transactions)
+		report.Divergences = append(report.Divergences, divergences...)
+	}
+
+	log.Debug("replay finished",
+		"shard", record.ShardID,
+		"num blocks", report.NumBlocks,
+		"num transactions", report.NumTransactions,
+		"num divergences", len(report.Divergences))
+
+	return report, nil
+}
+
+func (r *replayer) replayBlock(nodeHandler process.NodeHandler, recordedBlock *dtos.RecordedBlock) ([]*dtos.ReplayDivergence, error) {
+	err := r.advanceTimeToBlock(nodeHandler, recordedBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	divergences := make([]*dtos.ReplayDivergence, 0)
+	if len(recordedBlock.Transactions) > 0 {
+		replayedTxs, errExecute := r.executeTransactions(nodeHandler, recordedBlock)
+		if errExecute != nil {
+			divergences = append(divergences, &dtos.ReplayDivergence{
+				BlockNonce: recordedBlock.Nonce,
+				Field:      fieldExecution,
+				Expected:   "executed transactions",
+				Actual:     errExecute.Error(),
+			})
+
+			return divergences, nil
+		}
+
+		for _, replayedTx := range replayedTxs {
+			divergences = append(divergences, compareTransactions(recordedBlock.Nonce, replayedTx.recorded, replayedTx.replayed)...)
+		}
+	}
+
+	return divergences, nil
+}
+
+// advanceTimeToBlock will move the chain simulator in time, so the next block will have the timestamp of the
+// recorded block, or the closest one after it
+func (r *replayer) advanceTimeToBlock(nodeHandler process.NodeHandler, recordedBlock *dtos.RecordedBlock) error {
+	roundHandler := nodeHandler.GetCoreComponents().RoundHandler()
+	roundDuration := roundHandler.TimeDuration()
+	currentRoundTimestamp := roundHandler.TimeStamp()
+
+	durationToAdvance := time.Unix(recordedBlock.Timestamp, 0).Sub(currentRoundTimestamp) - roundDuration
+	if durationToAdvance <= 0 {
+		return nil
+	}
+
+	return r.chainSimulator.AdvanceTime(durationToAdvance)
+}
+
+func (r *replayer) executeTransactions(nodeHandler process.NodeHandler, recordedBlock *dtos.RecordedBlock) ([]*replayedTransaction, error) {
+	txs := make([]*transaction.Transaction, 0, len(recordedBlock.Transactions))
+	for _, recordedTx := range recordedBlock.Transactions {
+		tx, err := r.createTransaction(nodeHandler, recordedTx)
+		if err != nil {
+			return nil, fmt.Errorf("%w for transaction %s", err, recordedTx.Hash)
+		}
+
+		txs = append(txs, tx)
+	}
+
+	results, err := r.chainSimulator.SendTxsAndGenerateBlocksTilAreExecuted(txs, r.maxNumOfBlocksWhenExecutingTxs)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.numOfBlocksToGenerateAfterExecTx > 0 {
+		err = r.chainSimulator.GenerateBlocks(r.numOfBlocksToGenerateAfterExecTx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	replayedTxs := make([]*replayedTransaction, 0, len(results))
+	for idx, result := range results {
+		replayedTx, errGet := r.getFinalResult(txs[idx], result)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		replayedTxs = append(replayedTxs, &replayedTransaction{
+			recorded: recordedBlock.Transactions[idx],
+			replayed: replayedTx,
+		})
+	}
+
+	return replayedTxs, nil
+}
+
+// getFinalResult fetches again the transaction, as its smart contract results and logs might have been completed
+// in the blocks generated after its execution
+func (r *replayer) getFinalResult(tx *transaction.Transaction, result *transaction.ApiTransactionResult) (*transaction.ApiTransactionResult, error) {
+	shardCoordinator := r.chainSimulator.GetNodeHandler(0).GetShardCoordinator()
+	destinationShardID := shardCoordinator.ComputeId(tx.RcvAddr)
+	contractDeployAddress := make([]byte, len(tx.RcvAddr))
+	if bytes.Equal(tx.RcvAddr, contractDeployAddress) {
+		destinationShardID = shardCoordinator.ComputeId(tx.SndAddr)
+	}
+
+	return r.chainSimulator.GetNodeHandler(destinationShardID).GetFacadeHandler().GetTransaction(result.Hash, true)
+}
+
+// createTransaction rebuilds the transaction out of its recorded form. The chain ID is replaced with the one of the
+// chain simulator, so the signature will not match and the chain simulator should bypass the signature checks
+func (r *replayer) createTransaction(nodeHandler process.NodeHandler, recordedTx *transaction.ApiTransactionResult) (*transaction.Transaction, error) {
+	addressConverter := nodeHandler.GetCoreComponents().AddressPubKeyConverter()
+	senderAddress, err := addressConverter.Decode(recordedTx.Sender)
+	if err != nil {
+		return nil, fmt.Errorf("%w for sender", err)
+	}
+	receiverAddress, err := addressConverter.Decode(recordedTx.Receiver)
+	if err != nil {
+		return nil, fmt.Errorf("%w for receiver", err)
+	}
+
+	value, ok := big.NewInt(0).SetString(recordedTx.Value, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w for value: %s", ErrInvalidValue, recordedTx.Value)
+	}
+
+	signature, err := hex.DecodeString(recordedTx.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w for signature", err)
+	}
+
+	tx := &transaction.Transaction{
+		Nonce:       recordedTx.Nonce,
+		Value:       value,
+		RcvAddr:     receiverAddress,
+		RcvUserName: recordedTx.ReceiverUsername,
+		SndAddr:     senderAddress,
+		SndUserName: recordedTx.SenderUsername,
+		GasPrice:    recordedTx.GasPrice,
+		GasLimit:    recordedTx.GasLimit,
+		Data:        recordedTx.Data,
+		ChainID:     []byte(nodeHandler.GetCoreComponents().ChainID()),
+		Version:     recordedTx.Version,
+		Signature:   signature,
+		Options:     recordedTx.Options,
+	}
+
+	if len(recordedTx.GuardianAddr) == 0 {
+		return tx, nil
+	}
+
+	tx.GuardianAddr, err = addressConverter.Decode(recordedTx.GuardianAddr)
+	if err != nil {
+		return nil, fmt.Errorf("%w for guardian", err)
+	}
+	tx.GuardianSignature, err = hex.DecodeString(recordedTx.GuardianSignature)
+	if err != nil {
+		return nil, fmt.Errorf("%w for guardian signature", err)
+	}
+
+	return tx, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (r *replayer) IsInterfaceNil() bool {
+	return r == nil
+}
+
+func compareTransactions(blockNonce uint64, recorded *transaction.ApiTransactionResult, replayed *transaction.ApiTransactionResult) []*dtos.ReplayDivergence {
+	divergences := make([]*dtos.ReplayDivergence, 0)
+	addDivergence := func(field string, expected string, actual string) {
+		if expected == actual {
+			return
+		}
+
+		divergences = append(divergences, &dtos.ReplayDivergence{
+			BlockNonce: blockNonce,
+			TxHash:     recorded.Hash,
+			Field:      field,
+			Expected:   expected,
+			Actual:     actual,
+		})
+	}
+
+	addDivergence(fieldStatus, string(recorded.Status), string(replayed.Status))
+	addDivergence(fieldGasUsed, fmt.Sprintf("%d", recorded.GasUsed), fmt.Sprintf("%d", replayed.GasUsed))
+	addDivergence(fieldSmartContractResults, smartContractResultsToString(recorded), smartContractResultsToString(replayed))
+	addDivergence(fieldLogs, logsToString(recorded), logsToString(replayed))
+	addDivergence(fieldReceipt, receiptToString(recorded), receiptToString(replayed))
+	addDivergence(fieldFee, recorded.Fee, replayed.Fee)
+
+	return divergences
+}
+
+// smartContractResultsToString returns a canonical form of the smart contract results, independent of their order
+// and of the hashes, which differ between the recorded and the replayed transactions
+func smartContractResultsToString(tx *transaction.ApiTransactionResult) string {
+	results := make([]string, 0, len(tx.SmartContractResults))
+	for _, scr := range tx.SmartContractResults {
+		value := "0"
+		if scr.Value != nil {
+			value = scr.Value.String()
+		}
+
+		results = append(results, fmt.Sprintf("%s:%s:%s", scr.RcvAddr, value, scr.Data))
+	}
+	sort.Strings(results)
+
+	return strings.Join(results, ";")
+}
+
+// receiptToString returns a canonical form of the receipt, without the transaction hash, which differs between the
+// recorded and the replayed transactions
+func receiptToString(tx *transaction.ApiTransactionResult) string {
+	if tx.Receipt == nil {
+		return ""
+	}
+
+	value := "0"
+	if tx.Receipt.Value != nil {
+		value = tx.Receipt.Value.String()
+	}
+
+	return fmt.Sprintf("%s:%s:%s", tx.Receipt.SndAddr, value, tx.Receipt.Data)
+}
+
+// logsToString returns a canonical form of the events of the transaction logs, replacing the topics holding the
+// transaction hash, as it differs between the recorded and the replayed transactions
+func logsToString(tx *transaction.ApiTransactionResult) string {
+	if tx.Logs == nil {
+		return ""
+	}
+
+	txHash, _ := hex.DecodeString(tx.Hash)
+	events := make([]string, 0, len(tx.Logs.Events))
+	for _, event := range tx.Logs.Events {
+		topics := make([]string, 0, len(event.Topics))
+		for _, topic := range event.Topics {
+			if len(txHash) > 0 && bytes.Equal(topic, txHash) {
+				topics = append(topics, txHashPlaceholder)
+				continue
+			}
+
+			topics = append(topics, hex.EncodeToString(topic))
+		}
+
+		events = append(events, fmt.Sprintf("%s:%s:%s:%s",
+			event.Address,
+			event.Identifier,
+			strings.Join(topics, ","),
+			base64.StdEncoding.EncodeToString(event.Data)),
+		)
+	}
+
+	return strings.Join(events, ";")
+}
@@ -0,0 +1,352 @@
+package replay
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/factory"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/components/api"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/process"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	chainSimulatorMocks "github.com/multiversx/mx-chain-go/testscommon/chainSimulator"
+	factoryMocks "github.com/multiversx/mx-chain-go/testscommon/factory"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	defaultPathToInitialConfig = "../../../cmd/node/config/"
+	oneEGLD                    = "1000000000000000000"
+)
+
+func createMockArgsReplayer() ArgsReplayer {
+	return ArgsReplayer{
+		ChainSimulator:                           &chainSimulatorMocks.ChainSimulatorMock{},
+		MaxNumOfBlocksToGenerateWhenExecutingTxs: 10,
+		NumOfBlocksToGenerateAfterExecution:      2,
+	}
+}
+
+func TestNewReplayer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil chain simulator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsReplayer()
+		args.ChainSimulator = nil
+		instance, err := NewReplayer(args)
+		require.Equal(t, ErrNilChainSimulator, err)
+		require.Nil(t, instance)
+	})
+	t.Run("invalid max num of blocks should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsReplayer()
+		args.MaxNumOfBlocksToGenerateWhenExecutingTxs = 0
+		instance, err := NewReplayer(args)
+		require.Equal(t, ErrInvalidMaxNumOfBlocks, err)
+		require.Nil(t, instance)
+	})
+	t.Run("invalid num of blocks after execution should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsReplayer()
+		args.NumOfBlocksToGenerateAfterExecution = -1
+		instance, err := NewReplayer(args)
+		require.True(t, errors.Is(err, ErrInvalidMaxNumOfBlocks))
+		require.Nil(t, instance)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewReplayer(createMockArgsReplayer())
+		require.Nil(t, err)
+		require.False(t, instance.IsInterfaceNil())
+	})
+}
+
+func TestReplayer_Replay(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil record should error", func(t *testing.T) {
+		t.Parallel()
+
+		instance, _ := NewReplayer(createMockArgsReplayer())
+		report, err := instance.Replay(nil)
+		require.Equal(t, ErrNilReplayRecord, err)
+		require.Nil(t, report)
+	})
+	t.Run("missing shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		instance, _ := NewReplayer(createMockArgsReplayer())
+		report, err := instance.Replay(&dtos.ReplayRecord{ShardID: 5})
+		require.True(t, errors.Is(err, ErrNilNodeHandler))
+		require.Nil(t, report)
+	})
+}
+
+func TestReplayer_CreateTransaction(t *testing.T) {
+	t.Parallel()
+
+	nodeHandler := &chainSimulatorMocks.NodeHandlerMock{
+		GetCoreComponentsCalled: func() factory.CoreComponentsHolder {
+			return &factoryMocks.CoreComponentsHolderStub{
+				AddressPubKeyConverterCalled: func() core.PubkeyConverter {
+					return testscommon.RealWorldBech32PubkeyConverter
+				},
+				ChainIDCalled: func() string {
+					return "chain"
+				},
+			}
+		},
+	}
+	instance, _ := NewReplayer(createMockArgsReplayer())
+
+	recordedTx := &transaction.ApiTransactionResult{
+		Nonce:             7,
+		Value:             oneEGLD,
+		Sender:            testscommon.TestAddressAlice,
+		Receiver:          testscommon.TestAddressBob,
+		GasPrice:          1000000000,
+		GasLimit:          50000,
+		Data:              []byte("data"),
+		Signature:         "aabb",
+		ChainID:           "1",
+		Version:           2,
+		Options:           2,
+		GuardianAddr:      testscommon.TestAddressBob,
+		GuardianSignature: "ccdd",
+	}
+	tx, err := instance.createTransaction(nodeHandler, recordedTx)
+	require.Nil(t, err)
+	require.Equal(t, uint64(7), tx.Nonce)
+	require.Equal(t, oneEGLD, tx.Value.String())
+	require.Equal(t, testscommon.TestPubKeyAlice, tx.SndAddr)
+	require.Equal(t, testscommon.TestPubKeyBob, tx.RcvAddr)
+	require.Equal(t, []byte("data"), tx.Data)
+	require.Equal(t, []byte("chain"), tx.ChainID)
+	require.Equal(t, []byte{0xaa, 0xbb}, tx.Signature)
+	require.Equal(t, uint32(2), tx.Version)
+	require.Equal(t, uint32(2), tx.Options)
+	require.Equal(t, testscommon.TestPubKeyBob, tx.GuardianAddr)
+	require.Equal(t, []byte{0xcc, 0xdd}, tx.GuardianSignature)
+
+	recordedTx.Value = "not a number"
+	tx, err = instance.createTransaction(nodeHandler, recordedTx)
+	require.True(t, errors.Is(err, ErrInvalidValue))
+	require.Nil(t, tx)
+}
+
+func TestCompareTransactions(t *testing.T) {
+	t.Parallel()
+
+	recordedHash := []byte("recorded hash")
+	replayedHash := []byte("replayed hash")
+	recorded := &transaction.ApiTransactionResult{
+		Hash:    hex.EncodeToString(recordedHash),
+		Status:  transaction.TxStatusSuccess,
+		GasUsed: 100,
+		Fee:     "1000",
+		SmartContractResults: []*transaction.ApiSmartContractResult{
+			{Hash: "scr1", RcvAddr: "alice", Value: big.NewInt(1), Data: "@6f6b"},
+			{Hash: "scr2", RcvAddr: "bob", Value: big.NewInt(2)},
+		},
+		Logs: &transaction.ApiLogs{
+			Events: []*transaction.Events{
+				{Address: "alice", Identifier: "completedTxEvent", Topics: [][]byte{recordedHash}},
+			},
+		},
+		Receipt: &transaction.ApiReceipt{Value: big.NewInt(10), SndAddr: "alice", Data: "refundedGas", TxHash: hex.EncodeToString(recordedHash)},
+	}
+	replayed := &transaction.ApiTransactionResult{
+		Hash:    hex.EncodeToString(replayedHash),
+		Status:  transaction.TxStatusSuccess,
+		GasUsed: 100,
+		Fee:     "1000",
+		SmartContractResults: []*transaction.ApiSmartContractResult{
+			{Hash: "scr3", RcvAddr: "bob", Value: big.NewInt(2)},
+			{Hash: "scr4", RcvAddr: "alice", Value: big.NewInt(1), Data: "@6f6b"},
+		},
+		Logs: &transaction.ApiLogs{
+			Events: []*transaction.Events{
+				{Address: "alice", Identifier: "completedTxEvent", Topics: [][]byte{replayedHash}},
+			},
+		},
+		Receipt: &transaction.ApiReceipt{Value: big.NewInt(10), SndAddr: "alice", Data: "refundedGas", TxHash: hex.EncodeToString(replayedHash)},
+	}
+
+	t.Run("same outcome should not report divergences", func(t *testing.T) {
+		t.Parallel()
+
+		divergences := compareTransactions(37, recorded, replayed)
+		require.Empty(t, divergences)
+	})
+	t.Run("different outcome should report divergences", func(t *testing.T) {
+		t.Parallel()
+
+		failedTx := &transaction.ApiTransactionResult{
+			Hash:    replayed.Hash,
+			Status:  transaction.TxStatusFail,
+			GasUsed: 200,
+			Fee:     "2000",
+			Logs: &transaction.ApiLogs{
+				Events: []*transaction.Events{
+					{Address: "alice", Identifier: "signalError", Topics: [][]byte{[]byte("alice"), []byte("out of gas")}},
+				},
+			},
+		}
+
+		divergences := compareTransactions(37, recorded, failedTx)
+		require.Equal(t, 6, len(divergences))
+		require.Equal(t, &dtos.ReplayDivergence{
+			BlockNonce: 37,
+			TxHash:     recorded.Hash,
+			Field:      fieldStatus,
+			Expected:   string(transaction.TxStatusSuccess),
+			Actual:     string(transaction.TxStatusFail),
+		}, divergences[0])
+		require.Equal(t, fieldGasUsed, divergences[1].Field)
+		require.Equal(t, "100", divergences[1].Expected)
+		require.Equal(t, "200", divergences[1].Actual)
+		require.Equal(t, fieldSmartContractResults, divergences[2].Field)
+		require.Equal(t, "", divergences[2].Actual)
+		require.Equal(t, fieldLogs, divergences[3].Field)
+		require.Equal(t, fieldReceipt, divergences[4].Field)
+		require.Equal(t, "alice:10:refundedGas", divergences[4].Expected)
+		require.Equal(t, "", divergences[4].Actual)
+		require.Equal(t, fieldFee, divergences[5].Field)
+		require.Equal(t, "1000", divergences[5].Expected)
+		require.Equal(t, "2000", divergences[5].Actual)
+	})
+}
+
+func TestReplayer_ReplayOnAnotherChainSimulator(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	sourceSimulator, err := chainSimulator.NewChainSimulator(createArgsChainSimulator(t))
+	require.Nil(t, err)
+	defer sourceSimulator.Close()
+
+	err = sourceSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	initialBalance, _ := big.NewInt(0).SetString(oneEGLD, 10)
+	initialBalance.Mul(initialBalance, big.NewInt(10))
+	sender, err := sourceSimulator.GenerateAndMintWalletAddress(0, initialBalance)
+	require.Nil(t, err)
+	receiver, err := sourceSimulator.GenerateAndMintWalletAddress(0, big.NewInt(0))
+	require.Nil(t, err)
+	crossShardReceiver, err := sourceSimulator.GenerateAndMintWalletAddress(1, big.NewInt(0))
+	require.Nil(t, err)
+
+	err = sourceSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	// the state the recorded blocks will be executed on
+	sourceNode := sourceSimulator.GetNodeHandler(0)
+	seedRootHash := sourceNode.GetChainHandler().GetCurrentBlockRootHash()
+	startNonce := sourceNode.GetChainHandler().GetCurrentBlockHeader().GetNonce() + 1
+
+	txs := []*transaction.Transaction{
+		createMoveBalanceTx(0, sender.Bytes, receiver.Bytes, sourceNode),
+		createMoveBalanceTx(1, sender.Bytes, crossShardReceiver.Bytes, sourceNode),
+	}
+	_, err = sourceSimulator.SendTxsAndGenerateBlocksTilAreExecuted(txs, 10)
+	require.Nil(t, err)
+
+	err = sourceSimulator.GenerateBlocks(3)
+	require.Nil(t, err)
+	endNonce := sourceNode.GetChainHandler().GetCurrentBlockHeader().GetNonce()
+
+	recorderInstance, err := NewRecorder(ArgsRecorder{
+		BlocksProvider: sourceNode.GetFacadeHandler(),
+		ShardID:        0,
+	})
+	require.Nil(t, err)
+
+	record, err := recorderInstance.Record(startNonce, endNonce)
+	require.Nil(t, err)
+
+	numRecordedTxs := 0
+	for _, recordedBlock := range record.Blocks {
+		numRecordedTxs += len(recordedBlock.Transactions)
+	}
+	require.Equal(t, len(txs), numRecordedTxs)
+
+	trieStorageManager := sourceNode.GetStateComponents().TrieStorageManagers()[dataRetriever.UserAccountsUnit.String()]
+	accountsAdapter, err := createAccountsAdapter(trieStorageManager, sourceNode)
+	require.Nil(t, err)
+	accountsState, err := GetStateFromAccounts(accountsAdapter, seedRootHash, sourceNode.GetCoreComponents().AddressPubKeyConverter())
+	require.Nil(t, err)
+
+	targetSimulator, err := chainSimulator.NewChainSimulator(createArgsChainSimulator(t))
+	require.Nil(t, err)
+	defer targetSimulator.Close()
+
+	err = targetSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+	err = targetSimulator.SetStateMultiple(accountsState)
+	require.Nil(t, err)
+	err = targetSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	replayerInstance, err := NewReplayer(ArgsReplayer{
+		ChainSimulator:                           targetSimulator,
+		MaxNumOfBlocksToGenerateWhenExecutingTxs: 10,
+		NumOfBlocksToGenerateAfterExecution:      3,
+	})
+	require.Nil(t, err)
+
+	report, err := replayerInstance.Replay(record)
+	require.Nil(t, err)
+	require.Equal(t, len(record.Blocks), report.NumBlocks)
+	require.Equal(t, len(txs), report.NumTransactions)
+
+	require.Empty(t, report.Divergences)
+
+	account, err := targetSimulator.GetAccount(dtos.WalletAddress{Bech32: receiver.Bech32})
+	require.Nil(t, err)
+	require.Equal(t, oneEGLD, account.Balance)
+}
+
+func createArgsChainSimulator(t *testing.T) chainSimulator.ArgsChainSimulator {
+	return chainSimulator.ArgsChainSimulator{
+		BypassTxSignatureCheck: true,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       time.Now().Unix(),
+		RoundDurationInMillis:  6000,
+		RoundsPerEpoch:         core.OptionalUint64{},
+		ApiInterface:           api.NewNoApiInterface(),
+		MinNodesPerShard:       1,
+		MetaChainMinNodes:      1,
+	}
+}
+
+func createMoveBalanceTx(nonce uint64, sender []byte, receiver []byte, nodeHandler process.NodeHandler) *transaction.Transaction {
+	value, _ := big.NewInt(0).SetString(oneEGLD, 10)
+
+	return &transaction.Transaction{
+		Nonce:     nonce,
+		Value:     value,
+		SndAddr:   sender,
+		RcvAddr:   receiver,
+		GasPrice:  1000000000,
+		GasLimit:  50000,
+		ChainID:   []byte(nodeHandler.GetCoreComponents().ChainID()),
+		Version:   1,
+		Signature: []byte("signature"),
+	}
+}
@@ -0,0 +1,372 @@
+package replay
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/disabled"
+	"github.com/multiversx/mx-chain-go/common/errChan"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	disabledStatistics "github.com/multiversx/mx-chain-go/common/statistics/disabled"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/process"
+	"github.com/multiversx/mx-chain-go/state"
+	disabledState "github.com/multiversx/mx-chain-go/state/disabled"
+	"github.com/multiversx/mx-chain-go/state/factory"
+	"github.com/multiversx/mx-chain-go/state/parsers"
+	disabledPruning "github.com/multiversx/mx-chain-go/state/storagePruningManager/disabled"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/multiversx/mx-chain-go/update/genesis"
+	"github.com/multiversx/mx-chain-go/update/storing"
+)
+
+const maxTrieLevelInMemory = uint(5)
+
+// ArgsHardforkExportStateLoader holds the arguments needed to load the accounts state from a hardfork export
+type ArgsHardforkExportStateLoader struct {
+	ImportFolder             string
+	ImportKeysStorageConfig  config.StorageConfig
+	ImportStateStorageConfig config.StorageConfig
+	ShardID                  uint32
+	NodeHandler              process.NodeHandler
+}
+
+// ArgsTrieStorageStateLoader holds the arguments needed to load the accounts state from the accounts trie storage
+// of a real node. The DB should contain the whole trie for the provided root hash (the directory of the epoch in which
+// the state snapshot was taken)
+type ArgsTrieStorageStateLoader struct {
+	DBPath        string
+	StorageConfig config.StorageConfig
+	RootHash      []byte
+	NodeHandler   process.NodeHandler
+}
+
+// LoadStateFromHardforkExport reads the accounts of the provided shard from a state exported by the update/genesis
+// exporter. The returned accounts can be directly applied on the chain simulator with SetStateMultiple
+func LoadStateFromHardforkExport(args ArgsHardforkExportStateLoader) ([]*dtos.AddressState, error) {
+	if check.IfNil(args.NodeHandler) {
+		return nil, ErrNilNodeHandler
+	}
+	if args.ShardID == core.MetachainShardId {
+		// the metachain accounts hold the system smart contracts state which is tied to the exported nodes setup
+		return nil, fmt.Errorf("%w, metachain state can not be loaded", ErrInvalidValue)
+	}
+
+	coreComponents := args.NodeHandler.GetCoreComponents()
+	keysStorer, err := createStorer(args.ImportKeysStorageConfig, args.ImportFolder)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the keys storer", err)
+	}
+
+	keyValueStorer, err := createStorer(args.ImportStateStorageConfig, args.ImportFolder)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the state storer", err)
+	}
+
+	hardforkStorer, err := storing.NewHardforkStorer(storing.ArgHardforkStorer{
+		KeysStore:   keysStorer,
+		KeyValue:    keyValueStorer,
+		Marshalizer: coreComponents.InternalMarshalizer(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stateImporter, err := genesis.NewStateImport(genesis.ArgsNewStateImport{
+		Hasher:              coreComponents.Hasher(),
+		Marshalizer:         coreComponents.InternalMarshalizer(),
+		ShardID:             args.ShardID,
+		StorageConfig:       args.ImportStateStorageConfig,
+		TrieStorageManagers: args.NodeHandler.GetStateComponents().TrieStorageManagers(),
+		HardforkStorer:      hardforkStorer,
+		AddressConverter:    coreComponents.AddressPubKeyConverter(),
+		EnableEpochsHandler: coreComponents.EnableEpochsHandler(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the hardfork storer is closed at the end of the import
+	err = stateImporter.ImportAll()
+	if err != nil {
+		return nil, err
+	}
+
+	accountsAdapter := stateImporter.GetAccountsDBForShard(args.ShardID)
+	if check.IfNil(accountsAdapter) {
+		return nil, fmt.Errorf("%w, no accounts were exported for shard %d", ErrInvalidValue, args.ShardID)
+	}
+
+	rootHash, err := accountsAdapter.RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	return GetStateFromAccounts(accountsAdapter, rootHash, coreComponents.AddressPubKeyConverter())
+}
+
+// LoadStateFromTrieStorage reads the accounts found under the provided root hash in the accounts trie storage of
+// a real node. The returned accounts can be directly applied on the chain simulator with SetStateMultiple
+func LoadStateFromTrieStorage(args ArgsTrieStorageStateLoader) ([]*dtos.AddressState, error) {
+	if check.IfNil(args.NodeHandler) {
+		return nil, ErrNilNodeHandler
+	}
+	if len(args.RootHash) == 0 {
+		return nil, fmt.Errorf("%w, empty root hash", ErrInvalidValue)
+	}
+
+	coreComponents := args.NodeHandler.GetCoreComponents()
+	// the source node DB is opened in read-only mode, so it can not be altered while loading the state
+	storer, err := createReadOnlyStorer(args.StorageConfig, args.DBPath)
+	if err != nil {
+		return nil, err
+	}
+
+	trieStorageManager, err := trie.NewTrieStorageManager(trie.NewTrieStorageManagerArgs{
+		MainStorer:     storer,
+		Marshalizer:    coreComponents.InternalMarshalizer(),
+		Hasher:         coreComponents.Hasher(),
+		GeneralConfig:  config.TrieStorageManagerConfig{SnapshotsGoroutineNum: 1},
+		IdleProvider:   disabled.NewProcessStatusHandler(),
+		Identifier:     dataRetriever.UserAccountsUnit.String(),
+		StatsCollector: disabledStatistics.NewStateStatistics(),
+	})
+	if err != nil {
+		closeStorer(storer)
+		return nil, err
+	}
+	// the trie storage manager closes the storer as well
+	defer func() {
+		errClose := trieStorageManager.Close()
+		if errClose != nil {
+			log.Warn("error while closing the trie storage manager", "error", errClose)
+		}
+	}()
+
+	accountsAdapter, err := createAccountsAdapter(trieStorageManager, args.NodeHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetStateFromAccounts(accountsAdapter, args.RootHash, coreComponents.AddressPubKeyConverter())
+}
+
+func createAccountsAdapter(trieStorageManager common.StorageManager, nodeHandler process.NodeHandler) (state.AccountsAdapter, error) {
+	coreComponents := nodeHandler.GetCoreComponents()
+	accountsTrie, err := trie.NewTrie(
+		trieStorageManager,
+		coreComponents.InternalMarshalizer(),
+		coreComponents.Hasher(),
+		coreComponents.EnableEpochsHandler(),
+		maxTrieLevelInMemory,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	accountFactory, err := factory.NewAccountCreator(factory.ArgsAccountCreator{
+		Hasher:              coreComponents.Hasher(),
+		Marshaller:          coreComponents.InternalMarshalizer(),
+		EnableEpochsHandler: coreComponents.EnableEpochsHandler(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return state.NewAccountsDB(state.ArgsAccountsDB{
+		Trie:                  accountsTrie,
+		Hasher:                coreComponents.Hasher(),
+		Marshaller:            coreComponents.InternalMarshalizer(),
+		AccountFactory:        accountFactory,
+		StoragePruningManager: disabledPruning.NewDisabledStoragePruningManager(),
+		AddressConverter:      coreComponents.AddressPubKeyConverter(),
+		SnapshotsManager:      disabledState.NewDisabledSnapshotsManager(),
+	})
+}
+
+// GetStateFromAccounts returns the state of all the accounts found under the provided root hash
+func GetStateFromAccounts(
+	accountsAdapter state.AccountsAdapter,
+	rootHash []byte,
+	addressConverter core.PubkeyConverter,
+) ([]*dtos.AddressState, error) {
+	if check.IfNil(accountsAdapter) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(addressConverter) {
+		return nil, ErrNilAddressConverter
+	}
+
+	err := accountsAdapter.RecreateTrie(holders.NewDefaultRootHashesHolder(rootHash))
+	if err != nil {
+		return nil, err
+	}
+
+	leavesChannels := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+	err = accountsAdapter.GetAllLeaves(leavesChannels, context.Background(), rootHash, parsers.NewMainTrieLeafParser())
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([][]byte, 0)
+	for leaf := range leavesChannels.LeavesChan {
+		addresses = append(addresses, leaf.Key())
+	}
+
+	err = leavesChannels.ErrChan.ReadFromChanNonBlocking()
+	if err != nil {
+		return nil, err
+	}
+
+	accountsState := make([]*dtos.AddressState, 0, len(addresses))
+	for _, address := range addresses {
+		addressState, errGet := getAddressState(accountsAdapter, address, addressConverter)
+		if errGet != nil {
+			return nil, fmt.Errorf("%w for address %s", errGet, hex.EncodeToString(address))
+		}
+
+		accountsState = append(accountsState, addressState)
+	}
+
+	log.Debug("loaded accounts state", "root hash", rootHash, "num accounts", len(accountsState))
+
+	return accountsState, nil
+}
+
+func getAddressState(
+	accountsAdapter state.AccountsAdapter,
+	address []byte,
+	addressConverter core.PubkeyConverter,
+) (*dtos.AddressState, error) {
+	account, err := accountsAdapter.GetExistingAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, fmt.Errorf("%w, not an user account", ErrInvalidValue)
+	}
+
+	encodedAddress, err := addressConverter.Encode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := userAccount.GetNonce()
+	addressState := &dtos.AddressState{
+		Address: encodedAddress,
+		Nonce:   &nonce,
+		Balance: userAccount.GetBalance().String(),
+	}
+
+	pairs, err := getAccountPairs(userAccount)
+	if err != nil {
+		return nil, err
+	}
+	if len(pairs) > 0 {
+		addressState.Pairs = pairs
+	}
+
+	if !core.IsSmartContractAddress(address) {
+		return addressState, nil
+	}
+
+	// the code hash and the root hash are not exported, as they will be recomputed from the code and the pairs
+	addressState.Code = hex.EncodeToString(accountsAdapter.GetCode(userAccount.GetCodeHash()))
+	addressState.CodeMetadata = base64.StdEncoding.EncodeToString(userAccount.GetCodeMetadata())
+	addressState.DeveloperRewards = userAccount.GetDeveloperReward().String()
+	if len(userAccount.GetOwnerAddress()) > 0 {
+		addressState.Owner, err = addressConverter.Encode(userAccount.GetOwnerAddress())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return addressState, nil
+}
+
+func getAccountPairs(userAccount state.UserAccountHandler) (map[string]string, error) {
+	pairs := make(map[string]string)
+	if common.IsEmptyTrie(userAccount.GetRootHash()) {
+		return pairs, nil
+	}
+
+	leavesChannels := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+	err := userAccount.GetAllLeaves(leavesChannels, context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	for leaf := range leavesChannels.LeavesChan {
+		pairs[hex.EncodeToString(leaf.Key())] = hex.EncodeToString(leaf.Value())
+	}
+
+	err = leavesChannels.ErrChan.ReadFromChanNonBlocking()
+	if err != nil {
+		return nil, err
+	}
+
+	return pairs, nil
+}
+
+func createStorer(storageConfig config.StorageConfig, folder string) (*storageunit.Unit, error) {
+	dbConfig := storageFactory.GetDBFromConfig(storageConfig.DB)
+	dbConfig.FilePath = path.Join(folder, storageConfig.DB.FilePath)
+
+	persisterFactory, err := storageFactory.NewPersisterFactory(storageConfig.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	return storageunit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(storageConfig.Cache),
+		dbConfig,
+		persisterFactory,
+	)
+}
+
+func createReadOnlyStorer(storageConfig config.StorageConfig, folder string) (*storageunit.Unit, error) {
+	persister, err := storageFactory.NewReadOnlyPersister(path.Join(folder, storageConfig.DB.FilePath))
+	if err != nil {
+		return nil, err
+	}
+
+	cacher, err := storageunit.NewCache(storageFactory.GetCacherFromConfig(storageConfig.Cache))
+	if err != nil {
+		closeStorer(persister)
+		return nil, err
+	}
+
+	storer, err := storageunit.NewStorageUnit(cacher, persister)
+	if err != nil {
+		closeStorer(persister)
+		return nil, err
+	}
+
+	return storer, nil
+}
+
+func closeStorer(storer io.Closer) {
+	err := storer.Close()
+	if err != nil {
+		log.Warn("error while closing the storer", "error", err)
+	}
+}
@@ -138,3 +138,12 @@ var ErrNilStatusHandler = errors.New("nil status handler")
 
 // ErrNilCreateTransactionArgs signals that create transaction args is nil
 var ErrNilCreateTransactionArgs = errors.New("nil args for create transaction")
+
+// ErrCannotTraceInGenesisBlock signals that a transaction cannot be traced within the genesis block
+var ErrCannotTraceInGenesisBlock = errors.New("cannot trace a transaction within the genesis block")
+
+// ErrTransactionNotTraceable signals that the requested transaction is not a regular transaction, so it cannot be traced
+var ErrTransactionNotTraceable = errors.New("only regular transactions can be traced")
+
+// ErrNilExecutedTransactionsProvider signals that a nil executed transactions provider has been provided
+var ErrNilExecutedTransactionsProvider = errors.New("nil executed transactions provider")
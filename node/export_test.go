@@ -54,3 +54,8 @@ func (n *Node) GetPendingAndActiveGuardians(
 ) (activeGuardian *api.Guardian, pendingGuardian *api.Guardian, err error) {
 	return n.getPendingAndActiveGuardians(userAccount)
 }
+
+// GetPrecedingTransactions -
+func GetPrecedingTransactions(txBlock *api.Block, txHash string) ([]*transaction.Transaction, bool) {
+	return getPrecedingTransactions(txBlock, txHash)
+}
@@ -46,3 +46,18 @@ var ErrNilManagedPeersMonitor = errors.New("nil managed peers monitor")
 
 // ErrNilNodesCoordinator signals a nil nodes coordinator has been provided
 var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
+
+// ErrNilSubscriptionsHandler signals that a nil subscriptions handler has been provided
+var ErrNilSubscriptionsHandler = errors.New("nil subscriptions handler")
+
+// ErrNilLogsFacade signals that a nil logs facade has been provided
+var ErrNilLogsFacade = errors.New("nil logs facade")
+
+// ErrInvalidPrivateKeyEncoding signals that a private key with an invalid encoding has been provided
+var ErrInvalidPrivateKeyEncoding = errors.New("invalid private key encoding, expected hex")
+
+// ErrNilTrieVerifier signals that a nil trie verifier has been provided
+var ErrNilTrieVerifier = errors.New("nil trie verifier")
+
+// ErrInvalidRootHashEncoding signals that a root hash with an invalid encoding has been provided
+var ErrInvalidRootHashEncoding = errors.New("invalid root hash encoding, expected hex")
@@ -11,9 +11,32 @@ import (
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
 
+// LogsFacade defines what a logs facade should be able to do
+type LogsFacade interface {
+	GetEvents(query common.EventsQuery) ([]*common.ApiEvent, error)
+	IsInterfaceNil() bool
+}
+
+// ExecutedTransactionsProvider defines what is needed in order to fetch a transaction together with the block it was
+// executed in
+type ExecutedTransactionsProvider interface {
+	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
+	IsInterfaceNil() bool
+}
+
+// TrieVerifier defines what a state trie verifier should be able to do
+type TrieVerifier interface {
+	StartVerification(rootHash []byte, heal bool) error
+	GetStatus() common.TrieVerificationStatus
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
 	ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
+	ExecuteQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error)
 	ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error)
 	Close() error
 	IsInterfaceNil() bool
@@ -36,7 +59,9 @@ type StatusMetricsHandler interface {
 // TransactionEvaluator defines the actions which should be handler by a transaction evaluator
 type TransactionEvaluator interface {
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionExecutionWithStateOverrides(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*txSimData.SimulationResultsWithVMOutput, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionGasLimitWithStateOverrides(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*transaction.CostResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -66,6 +91,7 @@ type APITransactionHandler interface {
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsByAddress(address string, beforeNonce uint64, beforeEpoch uint32, pageSize uint32) (*common.AccountTransactionsAPIResponse, error)
 	UnmarshalTransaction(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
 	PopulateComputedFields(tx *transaction.ApiTransactionResult)
 	UnmarshalReceipt(receiptBytes []byte) (*transaction.ApiReceipt, error)
@@ -5,13 +5,16 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/process"
 )
 
 // ArgsNewLogsFacade holds the arguments for constructing a logsFacade
 type ArgsNewLogsFacade struct {
-	StorageService  dataRetriever.StorageService
-	Marshaller      marshal.Marshalizer
-	PubKeyConverter core.PubkeyConverter
+	StorageService    dataRetriever.StorageService
+	Marshaller        marshal.Marshalizer
+	PubKeyConverter   core.PubkeyConverter
+	HistoryRepository dblookupext.HistoryRepository
 }
 
 func (args *ArgsNewLogsFacade) check() error {
@@ -24,6 +27,9 @@ func (args *ArgsNewLogsFacade) check() error {
 	if check.IfNil(args.PubKeyConverter) {
 		return core.ErrNilPubkeyConverter
 	}
+	if check.IfNil(args.HistoryRepository) {
+		return process.ErrNilHistoryRepository
+	}
 
 	return nil
 }
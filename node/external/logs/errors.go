@@ -5,3 +5,5 @@ import "errors"
 var errCannotCreateLogsFacade = errors.New("cannot create logs facade")
 var errCannotLoadLogs = errors.New("cannot load log(s)")
 var errCannotUnmarshalLog = errors.New("cannot unmarshal log")
+var errCannotDecodeAddress = errors.New("cannot decode address")
+var errTooManyEvents = errors.New("too many events")
@@ -1,8 +1,13 @@
 package logs
 
 import (
+	"encoding/hex"
+	"fmt"
+
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dblookupext"
 )
 
 type logsConverter struct {
@@ -38,6 +43,44 @@ func (converter *logsConverter) txLogToApiResource(logKey []byte, log *transacti
 	}
 }
 
+func (converter *logsConverter) eventToApiEvent(event *transaction.Event) *common.ApiEvent {
+	return &common.ApiEvent{
+		Address:        converter.encodeAddress(event.Address),
+		Identifier:     string(event.Identifier),
+		Topics:         event.Topics,
+		Data:           event.Data,
+		AdditionalData: event.AdditionalData,
+	}
+}
+
+func (converter *logsConverter) eventsQueryToLogsIndexFilter(query common.EventsQuery) (*dblookupext.LogsIndexFilter, error) {
+	filter := &dblookupext.LogsIndexFilter{
+		FromNonce:   query.FromNonce,
+		ToNonce:     query.ToNonce,
+		Addresses:   make([][]byte, 0, len(query.Addresses)),
+		Identifiers: make([][]byte, 0, len(query.Identifiers)),
+		Topics:      query.Topics,
+	}
+
+	for _, address := range query.Addresses {
+		pubkey, err := converter.pubKeyConverter.Decode(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v, address = %s", errCannotDecodeAddress, err, address)
+		}
+
+		filter.Addresses = append(filter.Addresses, pubkey)
+	}
+	for _, identifier := range query.Identifiers {
+		filter.Identifiers = append(filter.Identifiers, []byte(identifier))
+	}
+
+	return filter, nil
+}
+
+func (converter *logsConverter) encodeHash(hash []byte) string {
+	return hex.EncodeToString(hash)
+}
+
 func (converter *logsConverter) encodeAddress(pubkey []byte) string {
 	return converter.pubKeyConverter.SilentEncode(pubkey, log)
 }
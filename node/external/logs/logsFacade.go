@@ -1,17 +1,24 @@
 package logs
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dblookupext"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
+// maxEventsPerQuery is the maximum number of events returned by an events query
+const maxEventsPerQuery = 10000
+
 var log = logger.GetOrCreate("node/external/logs")
 
 type logsFacade struct {
-	repository *logsRepository
-	converter  *logsConverter
+	repository        *logsRepository
+	converter         *logsConverter
+	historyRepository dblookupext.HistoryRepository
 }
 
 // NewLogsFacade creates a new logs facade
@@ -25,8 +32,9 @@ func NewLogsFacade(args ArgsNewLogsFacade) (*logsFacade, error) {
 	converter := newLogsConverter(args.PubKeyConverter)
 
 	return &logsFacade{
-		repository: repository,
-		converter:  converter,
+		repository:        repository,
+		converter:         converter,
+		historyRepository: args.HistoryRepository,
 	}, nil
 }
 
@@ -61,6 +69,89 @@ func (facade *logsFacade) IncludeLogsInTransactions(txs []*transaction.ApiTransa
 	return nil
 }
 
+// GetEvents returns the events matching the provided query, ordered by block nonce and by their position within the block.
+// The candidate blocks are selected using the logs index, then their logs are loaded from storage and matched exactly.
+func (facade *logsFacade) GetEvents(query common.EventsQuery) ([]*common.ApiEvent, error) {
+	filter, err := facade.converter.eventsQueryToLogsIndexFilter(query)
+	if err != nil {
+		return nil, err
+	}
+
+	indexBlocks, err := facade.historyRepository.FilterLogsIndex(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*common.ApiEvent, 0)
+	for _, indexBlock := range indexBlocks {
+		logsByKey, errGet := facade.repository.getLogs(indexBlock.LogsKeys, indexBlock.Epoch)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		for _, logKey := range indexBlock.LogsKeys {
+			txLog, ok := logsByKey[string(logKey)]
+			if !ok {
+				continue
+			}
+
+			for eventIndex, event := range txLog.Events {
+				if !eventMatchesFilter(event, filter) {
+					continue
+				}
+				if len(events) == maxEventsPerQuery {
+					return nil, fmt.Errorf("%w: maximum %d events, narrow the query", errTooManyEvents, maxEventsPerQuery)
+				}
+
+				apiEvent := facade.converter.eventToApiEvent(event)
+				apiEvent.TxHash = facade.converter.encodeHash(logKey)
+				apiEvent.BlockNonce = indexBlock.Nonce
+				apiEvent.BlockHash = facade.converter.encodeHash(indexBlock.HeaderHash)
+				apiEvent.EventIndex = eventIndex
+				events = append(events, apiEvent)
+			}
+		}
+	}
+
+	return events, nil
+}
+
+func eventMatchesFilter(event *transaction.Event, filter *dblookupext.LogsIndexFilter) bool {
+	if event == nil {
+		return false
+	}
+	if !containsAny(filter.Addresses, event.Address) {
+		return false
+	}
+	if !containsAny(filter.Identifiers, event.Identifier) {
+		return false
+	}
+	for position, topicValues := range filter.Topics {
+		if len(topicValues) == 0 {
+			continue
+		}
+		if position >= len(event.Topics) || !containsAny(topicValues, event.Topics[position]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsAny(values [][]byte, value []byte) bool {
+	if len(values) == 0 {
+		return true
+	}
+
+	for _, v := range values {
+		if bytes.Equal(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (facade *logsFacade) IsInterfaceNil() bool {
 	return facade == nil
@@ -1,12 +1,17 @@
 package logs
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	dblookupextPkg "github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/dblookupext"
 	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	"github.com/stretchr/testify/require"
@@ -15,9 +20,10 @@ import (
 func TestNewLogsFacade(t *testing.T) {
 	t.Run("NilStorageService", func(t *testing.T) {
 		arguments := ArgsNewLogsFacade{
-			StorageService:  nil,
-			Marshaller:      marshallerMock.MarshalizerMock{},
-			PubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+			StorageService:    nil,
+			Marshaller:        marshallerMock.MarshalizerMock{},
+			PubKeyConverter:   testscommon.NewPubkeyConverterMock(32),
+			HistoryRepository: &dblookupext.HistoryRepositoryStub{},
 		}
 
 		facade, err := NewLogsFacade(arguments)
@@ -28,9 +34,10 @@ func TestNewLogsFacade(t *testing.T) {
 
 	t.Run("NilMarshaller", func(t *testing.T) {
 		arguments := ArgsNewLogsFacade{
-			StorageService:  genericMocks.NewChainStorerMock(7),
-			Marshaller:      nil,
-			PubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+			StorageService:    genericMocks.NewChainStorerMock(7),
+			Marshaller:        nil,
+			PubKeyConverter:   testscommon.NewPubkeyConverterMock(32),
+			HistoryRepository: &dblookupext.HistoryRepositoryStub{},
 		}
 
 		facade, err := NewLogsFacade(arguments)
@@ -41,9 +48,10 @@ func TestNewLogsFacade(t *testing.T) {
 
 	t.Run("NilPubKeyConverter", func(t *testing.T) {
 		arguments := ArgsNewLogsFacade{
-			StorageService:  genericMocks.NewChainStorerMock(7),
-			Marshaller:      marshallerMock.MarshalizerMock{},
-			PubKeyConverter: nil,
+			StorageService:    genericMocks.NewChainStorerMock(7),
+			Marshaller:        marshallerMock.MarshalizerMock{},
+			PubKeyConverter:   nil,
+			HistoryRepository: &dblookupext.HistoryRepositoryStub{},
 		}
 
 		facade, err := NewLogsFacade(arguments)
@@ -51,6 +59,20 @@ func TestNewLogsFacade(t *testing.T) {
 		require.ErrorContains(t, err, core.ErrNilPubkeyConverter.Error())
 		require.Nil(t, facade)
 	})
+
+	t.Run("NilHistoryRepository", func(t *testing.T) {
+		arguments := ArgsNewLogsFacade{
+			StorageService:    genericMocks.NewChainStorerMock(7),
+			Marshaller:        marshallerMock.MarshalizerMock{},
+			PubKeyConverter:   testscommon.NewPubkeyConverterMock(32),
+			HistoryRepository: nil,
+		}
+
+		facade, err := NewLogsFacade(arguments)
+		require.ErrorIs(t, err, errCannotCreateLogsFacade)
+		require.ErrorContains(t, err, process.ErrNilHistoryRepository.Error())
+		require.Nil(t, facade)
+	})
 }
 
 func TestLogsFacade_GetLogShouldWork(t *testing.T) {
@@ -58,9 +80,10 @@ func TestLogsFacade_GetLogShouldWork(t *testing.T) {
 	marshaller := &marshal.GogoProtoMarshalizer{}
 
 	arguments := ArgsNewLogsFacade{
-		StorageService:  storageService,
-		Marshaller:      marshaller,
-		PubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+		StorageService:    storageService,
+		Marshaller:        marshaller,
+		PubKeyConverter:   testscommon.NewPubkeyConverterMock(32),
+		HistoryRepository: &dblookupext.HistoryRepositoryStub{},
 	}
 
 	testLog := &transaction.Log{
@@ -96,9 +119,10 @@ func TestLogsFacade_IncludeLogsInTransactionsShouldWork(t *testing.T) {
 	marshaller := &marshal.GogoProtoMarshalizer{}
 
 	arguments := ArgsNewLogsFacade{
-		StorageService:  storageService,
-		Marshaller:      marshaller,
-		PubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+		StorageService:    storageService,
+		Marshaller:        marshaller,
+		PubKeyConverter:   testscommon.NewPubkeyConverterMock(32),
+		HistoryRepository: &dblookupext.HistoryRepositoryStub{},
 	}
 
 	facade, _ := NewLogsFacade(arguments)
@@ -145,6 +169,92 @@ func TestLogsFacade_IncludeLogsInTransactionsShouldWork(t *testing.T) {
 	require.Equal(t, "fourth", transactions[3].Logs.Events[0].Identifier)
 }
 
+func TestLogsFacade_GetEvents(t *testing.T) {
+	storageService := genericMocks.NewChainStorerMock(7)
+	marshaller := &marshal.GogoProtoMarshalizer{}
+
+	transferFromAlice := &transaction.Event{Address: []byte{0xaa}, Identifier: []byte("transfer"), Topics: [][]byte{[]byte("t1"), []byte("t2")}}
+	transferFromBob := &transaction.Event{Address: []byte{0xbb}, Identifier: []byte("transfer"), Topics: [][]byte{[]byte("t1")}}
+	burnFromAlice := &transaction.Event{Address: []byte{0xaa}, Identifier: []byte("burn"), Data: []byte("data")}
+	logOfFirst, _ := marshaller.Marshal(&transaction.Log{Events: []*transaction.Event{transferFromAlice, burnFromAlice}})
+	logOfSecond, _ := marshaller.Marshal(&transaction.Log{Events: []*transaction.Event{transferFromBob}})
+	_ = storageService.Logs.Put([]byte{0x01}, logOfFirst)
+	_ = storageService.Logs.Put([]byte{0x02}, logOfSecond)
+
+	var providedFilter *dblookupextPkg.LogsIndexFilter
+	arguments := ArgsNewLogsFacade{
+		StorageService:  storageService,
+		Marshaller:      marshaller,
+		PubKeyConverter: testscommon.NewPubkeyConverterMock(1),
+		HistoryRepository: &dblookupext.HistoryRepositoryStub{
+			FilterLogsIndexCalled: func(filter *dblookupextPkg.LogsIndexFilter) ([]*dblookupextPkg.LogsIndexBlock, error) {
+				providedFilter = filter
+				return []*dblookupextPkg.LogsIndexBlock{
+					{HeaderHash: []byte{0xf1}, Nonce: 5, Epoch: 7, LogsKeys: [][]byte{{0x01}, {0x03}}},
+					{HeaderHash: []byte{0xf2}, Nonce: 6, Epoch: 7, LogsKeys: [][]byte{{0x02}}},
+				}, nil
+			},
+		},
+	}
+	facade, _ := NewLogsFacade(arguments)
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		events, err := facade.GetEvents(common.EventsQuery{Addresses: []string{"not hex"}})
+		require.ErrorIs(t, err, errCannotDecodeAddress)
+		require.Nil(t, events)
+	})
+	t.Run("history repository error should error", func(t *testing.T) {
+		expectedErr := errors.New("expected error")
+		args := arguments
+		args.HistoryRepository = &dblookupext.HistoryRepositoryStub{
+			FilterLogsIndexCalled: func(filter *dblookupextPkg.LogsIndexFilter) ([]*dblookupextPkg.LogsIndexBlock, error) {
+				return nil, expectedErr
+			},
+		}
+		facadeWithError, _ := NewLogsFacade(args)
+
+		events, err := facadeWithError.GetEvents(common.EventsQuery{})
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, events)
+	})
+	t.Run("empty query should return all events of the blocks", func(t *testing.T) {
+		events, err := facade.GetEvents(common.EventsQuery{FromNonce: 1, ToNonce: 10})
+		require.Nil(t, err)
+		require.Equal(t, uint64(1), providedFilter.FromNonce)
+		require.Equal(t, uint64(10), providedFilter.ToNonce)
+		require.Len(t, events, 3)
+		require.Equal(t, &common.ApiEvent{
+			TxHash:     "01",
+			BlockNonce: 5,
+			BlockHash:  "f1",
+			EventIndex: 1,
+			Address:    "aa",
+			Identifier: "burn",
+			Data:       []byte("data"),
+		}, events[1])
+		require.Equal(t, "02", events[2].TxHash)
+		require.Equal(t, uint64(6), events[2].BlockNonce)
+	})
+	t.Run("should match the events exactly", func(t *testing.T) {
+		events, err := facade.GetEvents(common.EventsQuery{Addresses: []string{"aa"}, Identifiers: []string{"transfer"}})
+		require.Nil(t, err)
+		require.Equal(t, [][]byte{{0xaa}}, providedFilter.Addresses)
+		require.Equal(t, [][]byte{[]byte("transfer")}, providedFilter.Identifiers)
+		require.Len(t, events, 1)
+		require.Equal(t, "01", events[0].TxHash)
+		require.Equal(t, 0, events[0].EventIndex)
+
+		events, err = facade.GetEvents(common.EventsQuery{Topics: [][][]byte{{[]byte("t1")}, {[]byte("t2"), []byte("t3")}}})
+		require.Nil(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, "aa", events[0].Address)
+
+		events, err = facade.GetEvents(common.EventsQuery{Topics: [][][]byte{{[]byte("t1")}}})
+		require.Nil(t, err)
+		require.Len(t, events, 2)
+	})
+}
+
 func TestLogsFacade_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
@@ -152,9 +262,10 @@ func TestLogsFacade_IsInterfaceNil(t *testing.T) {
 	require.True(t, lf.IsInterfaceNil())
 
 	arguments := ArgsNewLogsFacade{
-		StorageService:  genericMocks.NewChainStorerMock(7),
-		Marshaller:      &marshal.GogoProtoMarshalizer{},
-		PubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+		StorageService:    genericMocks.NewChainStorerMock(7),
+		Marshaller:        &marshal.GogoProtoMarshalizer{},
+		PubKeyConverter:   testscommon.NewPubkeyConverterMock(32),
+		HistoryRepository: &dblookupext.HistoryRepositoryStub{},
 	}
 	lf, _ = NewLogsFacade(arguments)
 	require.False(t, lf.IsInterfaceNil())
@@ -3,6 +3,7 @@ package external
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -40,9 +41,12 @@ type ArgNodeApiResolver struct {
 	AccountsParser           genesis.AccountsParser
 	GasScheduleNotifier      common.GasScheduleNotifierAPI
 	ManagedPeersMonitor      common.ManagedPeersMonitor
+	SubscriptionsHandler     common.SubscriptionsHandler
+	LogsFacade               LogsFacade
 	PublicKey                string
 	NodesCoordinator         nodesCoordinator.NodesCoordinator
 	StorageManagers          []common.StorageManager
+	TrieVerifier             TrieVerifier
 }
 
 // nodeApiResolver can resolve API requests
@@ -61,9 +65,12 @@ type nodeApiResolver struct {
 	accountsParser           genesis.AccountsParser
 	gasScheduleNotifier      common.GasScheduleNotifierAPI
 	managedPeersMonitor      common.ManagedPeersMonitor
+	subscriptionsHandler     common.SubscriptionsHandler
+	logsFacade               LogsFacade
 	publicKey                string
 	nodesCoordinator         nodesCoordinator.NodesCoordinator
 	storageManagers          []common.StorageManager
+	trieVerifier             TrieVerifier
 }
 
 // NewNodeApiResolver creates a new nodeApiResolver instance
@@ -110,9 +117,18 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.ManagedPeersMonitor) {
 		return nil, ErrNilManagedPeersMonitor
 	}
+	if check.IfNil(arg.SubscriptionsHandler) {
+		return nil, ErrNilSubscriptionsHandler
+	}
+	if check.IfNil(arg.LogsFacade) {
+		return nil, ErrNilLogsFacade
+	}
 	if check.IfNil(arg.NodesCoordinator) {
 		return nil, ErrNilNodesCoordinator
 	}
+	if check.IfNil(arg.TrieVerifier) {
+		return nil, ErrNilTrieVerifier
+	}
 
 	return &nodeApiResolver{
 		scQueryService:           arg.SCQueryService,
@@ -129,17 +145,35 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 		accountsParser:           arg.AccountsParser,
 		gasScheduleNotifier:      arg.GasScheduleNotifier,
 		managedPeersMonitor:      arg.ManagedPeersMonitor,
+		subscriptionsHandler:     arg.SubscriptionsHandler,
+		logsFacade:               arg.LogsFacade,
 		publicKey:                arg.PublicKey,
 		nodesCoordinator:         arg.NodesCoordinator,
 		storageManagers:          arg.StorageManagers,
+		trieVerifier:             arg.TrieVerifier,
 	}, nil
 }
 
+// Subscribe creates a new subscription to the node's events
+func (nar *nodeApiResolver) Subscribe(filter common.SubscriptionFilter) (common.Subscription, error) {
+	return nar.subscriptionsHandler.Subscribe(filter)
+}
+
+// GetEvents returns the events matching the provided query
+func (nar *nodeApiResolver) GetEvents(query common.EventsQuery) ([]*common.ApiEvent, error) {
+	return nar.logsFacade.GetEvents(query)
+}
+
 // ExecuteSCQuery retrieves data stored in a SC account through a VM
 func (nar *nodeApiResolver) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
 	return nar.scQueryService.ExecuteQuery(query)
 }
 
+// ExecuteSCQueries executes the provided queries against the same block, sharing the recreated trie
+func (nar *nodeApiResolver) ExecuteSCQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+	return nar.scQueryService.ExecuteQueries(queries)
+}
+
 // StatusMetrics returns an implementation of the StatusMetricsHandler interface
 func (nar *nodeApiResolver) StatusMetrics() StatusMetricsHandler {
 	return nar.statusMetricsHandler
@@ -155,8 +189,23 @@ func (nar *nodeApiResolver) SimulateTransactionExecution(tx *transaction.Transac
 	return nar.apiTransactionEvaluator.SimulateTransactionExecution(tx)
 }
 
+// ComputeTransactionGasLimitWithStateOverrides will calculate how many gas a transaction will consume when executed
+// against the current state altered by the provided overrides
+func (nar *nodeApiResolver) ComputeTransactionGasLimitWithStateOverrides(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*transaction.CostResponse, error) {
+	return nar.apiTransactionEvaluator.ComputeTransactionGasLimitWithStateOverrides(tx, overrides)
+}
+
+// SimulateTransactionExecutionWithStateOverrides will simulate the provided transaction against the current state
+// altered by the provided overrides and return the simulation results
+func (nar *nodeApiResolver) SimulateTransactionExecutionWithStateOverrides(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*txSimData.SimulationResultsWithVMOutput, error) {
+	return nar.apiTransactionEvaluator.SimulateTransactionExecutionWithStateOverrides(tx, overrides)
+}
+
 // Close closes all underlying components
 func (nar *nodeApiResolver) Close() error {
+	err := nar.trieVerifier.Close()
+	log.LogIfError(err)
+
 	for _, sm := range nar.storageManagers {
 		if check.IfNil(sm) {
 			continue
@@ -214,6 +263,11 @@ func (nar *nodeApiResolver) GetTransactionsPoolNonceGapsForSender(sender string,
 	return nar.apiTransactionHandler.GetTransactionsPoolNonceGapsForSender(sender, senderAccountNonce)
 }
 
+// GetTransactionsByAddress will return a page of the transactions sent or received by the provided address
+func (nar *nodeApiResolver) GetTransactionsByAddress(address string, beforeNonce uint64, beforeEpoch uint32, pageSize uint32) (*common.AccountTransactionsAPIResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionsByAddress(address, beforeNonce, beforeEpoch, pageSize)
+}
+
 // GetBlockByHash will return the block with the given hash and optionally with transactions
 func (nar *nodeApiResolver) GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error) {
 	decodedHash, err := hex.DecodeString(hash)
@@ -421,6 +475,69 @@ func (nar *nodeApiResolver) GetWaitingEpochsLeftForPublicKey(publicKey string) (
 	return nar.nodesCoordinator.GetWaitingEpochsLeftForPublicKey(pkBytes)
 }
 
+// AddManagedKey schedules the addition of the provided hex encoded private key as a managed key. Returns the
+// encoded public key of the scheduled key
+func (nar *nodeApiResolver) AddManagedKey(privateKeyHex string) (string, error) {
+	skBytes, err := hex.DecodeString(privateKeyHex)
+	if err != nil {
+		// do not output the provided string as it might be a valid private key
+		return "", ErrInvalidPrivateKeyEncoding
+	}
+
+	pkBytes, err := nar.managedPeersMonitor.AddManagedKey(skBytes)
+	if err != nil {
+		return "", err
+	}
+
+	return nar.validatorPubKeyConverter.SilentEncode(pkBytes, log), nil
+}
+
+// RemoveManagedKey schedules the removal of the provided managed key
+func (nar *nodeApiResolver) RemoveManagedKey(publicKey string) error {
+	pkBytes, err := nar.validatorPubKeyConverter.Decode(publicKey)
+	if err != nil {
+		return err
+	}
+
+	return nar.managedPeersMonitor.RemoveManagedKey(pkBytes)
+}
+
+// PauseManagedKey schedules the pausing of the provided managed key
+func (nar *nodeApiResolver) PauseManagedKey(publicKey string) error {
+	pkBytes, err := nar.validatorPubKeyConverter.Decode(publicKey)
+	if err != nil {
+		return err
+	}
+
+	return nar.managedPeersMonitor.PauseManagedKey(pkBytes)
+}
+
+// ResumeManagedKey schedules the resuming of the provided managed key
+func (nar *nodeApiResolver) ResumeManagedKey(publicKey string) error {
+	pkBytes, err := nar.validatorPubKeyConverter.Decode(publicKey)
+	if err != nil {
+		return err
+	}
+
+	return nar.managedPeersMonitor.ResumeManagedKey(pkBytes)
+}
+
+// StartTrieVerification starts, in background, the verification of the state trie with the provided hex encoded root
+// hash and of all its data tries, optionally fetching the missing nodes from the peers
+func (nar *nodeApiResolver) StartTrieVerification(rootHash string, heal bool) error {
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return fmt.Errorf("%w for root hash %s", ErrInvalidRootHashEncoding, rootHash)
+	}
+
+	return nar.trieVerifier.StartVerification(rootHashBytes, heal)
+}
+
+// GetTrieVerificationStatus returns the status of the current or of the last state trie verification
+func (nar *nodeApiResolver) GetTrieVerificationStatus() common.TrieVerificationStatus {
+	return nar.trieVerifier.GetStatus()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nar *nodeApiResolver) IsInterfaceNil() bool {
 	return nar == nil
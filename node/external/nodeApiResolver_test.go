@@ -17,6 +17,7 @@ import (
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/node/mock"
 	"github.com/multiversx/mx-chain-go/process"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/genesisMocks"
@@ -44,7 +45,10 @@ func createMockArgs() external.ArgNodeApiResolver {
 		AccountsParser:           &genesisMocks.AccountsParserStub{},
 		GasScheduleNotifier:      &testscommon.GasScheduleNotifierMock{},
 		ManagedPeersMonitor:      &testscommon.ManagedPeersMonitorStub{},
+		SubscriptionsHandler:     &testscommon.SubscriptionsHandlerStub{},
+		LogsFacade:               &testscommon.LogsFacadeStub{},
 		NodesCoordinator:         &shardingMocks.NodesCoordinatorStub{},
+		TrieVerifier:             &testscommon.TrieVerifierStub{},
 	}
 }
 
@@ -136,6 +140,39 @@ func TestNewNodeApiResolver_NilNodesCoordinator(t *testing.T) {
 	assert.Equal(t, external.ErrNilNodesCoordinator, err)
 }
 
+func TestNewNodeApiResolver_NilSubscriptionsHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.SubscriptionsHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilSubscriptionsHandler, err)
+}
+
+func TestNewNodeApiResolver_NilLogsFacade(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.LogsFacade = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilLogsFacade, err)
+}
+
+func TestNewNodeApiResolver_NilTrieVerifier(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.TrieVerifier = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilTrieVerifier, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -158,11 +195,20 @@ func TestNodeApiResolver_CloseShouldReturnNil(t *testing.T) {
 			return nil
 		},
 	}
+	trieVerifierCloseCalled := false
+	args.TrieVerifier = &testscommon.TrieVerifierStub{
+		CloseCalled: func() error {
+			trieVerifierCloseCalled = true
+
+			return expectedErr
+		},
+	}
 	nar, _ := external.NewNodeApiResolver(args)
 
 	err := nar.Close()
 	assert.Nil(t, err)
 	assert.True(t, closeCalled)
+	assert.True(t, trieVerifierCloseCalled)
 }
 
 func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
@@ -186,6 +232,25 @@ func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_ExecuteSCQueriesShouldCall(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	wasCalled := false
+	arg.SCQueryService = &mock.SCQueryServiceStub{
+		ExecuteQueriesCalled: func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+			wasCalled = true
+			return make([]*process.SCQueryResult, len(queries)), nil, nil
+		},
+	}
+	nar, _ := external.NewNodeApiResolver(arg)
+
+	results, _, err := nar.ExecuteSCQueries([]*process.SCQuery{{}, {}})
+	assert.Nil(t, err)
+	assert.Len(t, results, 2)
+	assert.True(t, wasCalled)
+}
+
 func TestNodeApiResolver_StatusMetricsMapWithoutP2PShouldBeCalled(t *testing.T) {
 	t.Parallel()
 
@@ -373,6 +438,46 @@ func TestNodeApiResolver_APITransactionHandler(t *testing.T) {
 	require.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_GetTransactionsByAddress(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &common.AccountTransactionsAPIResponse{HasMore: true, NextBeforeNonce: 7}
+	arg := createMockArgs()
+	arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+		GetTransactionsByAddressCalled: func(address string, beforeNonce uint64, beforeEpoch uint32, pageSize uint32) (*common.AccountTransactionsAPIResponse, error) {
+			require.Equal(t, "erd1alice", address)
+			require.Equal(t, uint64(10), beforeNonce)
+			require.Equal(t, uint32(3), beforeEpoch)
+			require.Equal(t, uint32(5), pageSize)
+			return expectedResponse, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	response, err := nar.GetTransactionsByAddress("erd1alice", 10, 3, 5)
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, response)
+}
+
+func TestNodeApiResolver_GetEvents(t *testing.T) {
+	t.Parallel()
+
+	expectedQuery := common.EventsQuery{FromNonce: 1, ToNonce: 2, Identifiers: []string{"transfer"}}
+	expectedEvents := []*common.ApiEvent{{TxHash: "aa", Identifier: "transfer"}}
+	arg := createMockArgs()
+	arg.LogsFacade = &testscommon.LogsFacadeStub{
+		GetEventsCalled: func(query common.EventsQuery) ([]*common.ApiEvent, error) {
+			require.Equal(t, expectedQuery, query)
+			return expectedEvents, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	events, err := nar.GetEvents(expectedQuery)
+	require.NoError(t, err)
+	require.Equal(t, expectedEvents, events)
+}
+
 func TestNodeApiResolver_GetTransactionsPool(t *testing.T) {
 	t.Parallel()
 
@@ -492,6 +597,50 @@ func TestNodeApiResolver_GetTransactionsPoolForSender(t *testing.T) {
 	})
 }
 
+func TestNodeApiResolver_SimulateTransactionExecutionWithStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	providedTx := &transaction.Transaction{Nonce: 1}
+	providedOverrides := []*txSimData.StateOverride{{Address: []byte("alice"), Balance: big.NewInt(10)}}
+	expectedResults := &txSimData.SimulationResultsWithVMOutput{
+		SimulationResults: transaction.SimulationResults{Status: transaction.TxStatusSuccess},
+	}
+	arg := createMockArgs()
+	arg.APITransactionEvaluator = &mock.TransactionCostEstimatorMock{
+		SimulateTransactionExecutionWithStateOverridesCalled: func(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*txSimData.SimulationResultsWithVMOutput, error) {
+			require.Equal(t, providedTx, tx)
+			require.Equal(t, providedOverrides, overrides)
+			return expectedResults, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	results, err := nar.SimulateTransactionExecutionWithStateOverrides(providedTx, providedOverrides)
+	require.NoError(t, err)
+	require.Equal(t, expectedResults, results)
+}
+
+func TestNodeApiResolver_ComputeTransactionGasLimitWithStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	providedTx := &transaction.Transaction{Nonce: 1}
+	providedOverrides := []*txSimData.StateOverride{{Address: []byte("alice"), Balance: big.NewInt(10)}}
+	expectedCost := &transaction.CostResponse{GasUnits: 50000}
+	arg := createMockArgs()
+	arg.APITransactionEvaluator = &mock.TransactionCostEstimatorMock{
+		ComputeTransactionGasLimitWithStateOverridesCalled: func(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*transaction.CostResponse, error) {
+			require.Equal(t, providedTx, tx)
+			require.Equal(t, providedOverrides, overrides)
+			return expectedCost, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	cost, err := nar.ComputeTransactionGasLimitWithStateOverrides(providedTx, providedOverrides)
+	require.NoError(t, err)
+	require.Equal(t, expectedCost, cost)
+}
+
 func TestNodeApiResolver_GetLastPoolNonceForSender(t *testing.T) {
 	t.Parallel()
 
@@ -945,3 +1094,174 @@ func TestNodeApiResolver_IsInterfaceNil(t *testing.T) {
 	nar, _ = external.NewNodeApiResolver(arg)
 	require.False(t, nar.IsInterfaceNil())
 }
+
+func TestNodeApiResolver_AddManagedKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid private key encoding should error without leaking the key", func(t *testing.T) {
+		t.Parallel()
+
+		providedKeyStr := "not a hex private key"
+		args := createMockArgs()
+		args.ManagedPeersMonitor = &testscommon.ManagedPeersMonitorStub{
+			AddManagedKeyCalled: func(privateKeyBytes []byte) ([]byte, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		nar, err := external.NewNodeApiResolver(args)
+		require.NoError(t, err)
+
+		publicKey, err := nar.AddManagedKey(providedKeyStr)
+		require.Equal(t, external.ErrInvalidPrivateKeyEncoding, err)
+		require.Empty(t, publicKey)
+	})
+	t.Run("monitor error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgs()
+		args.ManagedPeersMonitor = &testscommon.ManagedPeersMonitorStub{
+			AddManagedKeyCalled: func(privateKeyBytes []byte) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		nar, err := external.NewNodeApiResolver(args)
+		require.NoError(t, err)
+
+		publicKey, err := nar.AddManagedKey("abcdef")
+		require.Equal(t, expectedErr, err)
+		require.Empty(t, publicKey)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedKeyStr := "abcdef"
+		providedPrivateKey, _ := hex.DecodeString(providedKeyStr)
+		args := createMockArgs()
+		args.ManagedPeersMonitor = &testscommon.ManagedPeersMonitorStub{
+			AddManagedKeyCalled: func(privateKeyBytes []byte) ([]byte, error) {
+				require.Equal(t, providedPrivateKey, privateKeyBytes)
+				return []byte("pk"), nil
+			},
+		}
+		nar, err := external.NewNodeApiResolver(args)
+		require.NoError(t, err)
+
+		publicKey, err := nar.AddManagedKey(providedKeyStr)
+		require.NoError(t, err)
+		require.Equal(t, hex.EncodeToString([]byte("pk")), publicKey)
+	})
+}
+
+func TestNodeApiResolver_RemovePauseResumeManagedKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		providedKeyStr := "abcde"
+		args := createMockArgs()
+		args.ManagedPeersMonitor = &testscommon.ManagedPeersMonitorStub{
+			RemoveManagedKeyCalled: func(pkBytes []byte) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+			PauseManagedKeyCalled: func(pkBytes []byte) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+			ResumeManagedKeyCalled: func(pkBytes []byte) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		nar, err := external.NewNodeApiResolver(args)
+		require.NoError(t, err)
+
+		require.Error(t, nar.RemoveManagedKey(providedKeyStr))
+		require.Error(t, nar.PauseManagedKey(providedKeyStr))
+		require.Error(t, nar.ResumeManagedKey(providedKeyStr))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedKeyStr := "abcdef"
+		providedPublicKey, _ := hex.DecodeString(providedKeyStr)
+		numCalls := 0
+		handler := func(pkBytes []byte) error {
+			require.Equal(t, providedPublicKey, pkBytes)
+			numCalls++
+			return nil
+		}
+		args := createMockArgs()
+		args.ManagedPeersMonitor = &testscommon.ManagedPeersMonitorStub{
+			RemoveManagedKeyCalled: handler,
+			PauseManagedKeyCalled:  handler,
+			ResumeManagedKeyCalled: handler,
+		}
+		nar, err := external.NewNodeApiResolver(args)
+		require.NoError(t, err)
+
+		require.NoError(t, nar.RemoveManagedKey(providedKeyStr))
+		require.NoError(t, nar.PauseManagedKey(providedKeyStr))
+		require.NoError(t, nar.ResumeManagedKey(providedKeyStr))
+		require.Equal(t, 3, numCalls)
+	})
+}
+
+func TestNodeApiResolver_StartTrieVerification(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.TrieVerifier = &testscommon.TrieVerifierStub{
+			StartVerificationCalled: func(rootHash []byte, heal bool) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		nar, _ := external.NewNodeApiResolver(args)
+
+		err := nar.StartTrieVerification("not hex", true)
+		assert.True(t, errors.Is(err, external.ErrInvalidRootHashEncoding))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedRootHash := []byte("root hash")
+		args := createMockArgs()
+		args.TrieVerifier = &testscommon.TrieVerifierStub{
+			StartVerificationCalled: func(rootHash []byte, heal bool) error {
+				assert.Equal(t, providedRootHash, rootHash)
+				assert.True(t, heal)
+				return expectedErr
+			},
+		}
+		nar, _ := external.NewNodeApiResolver(args)
+
+		err := nar.StartTrieVerification(hex.EncodeToString(providedRootHash), true)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestNodeApiResolver_GetTrieVerificationStatus(t *testing.T) {
+	t.Parallel()
+
+	providedStatus := common.TrieVerificationStatus{
+		RootHash:        "726f6f742068617368",
+		InProgress:      true,
+		NumCheckedNodes: 10,
+	}
+	args := createMockArgs()
+	args.TrieVerifier = &testscommon.TrieVerifierStub{
+		GetStatusCalled: func() common.TrieVerificationStatus {
+			return providedStatus
+		},
+	}
+	nar, _ := external.NewNodeApiResolver(args)
+
+	assert.Equal(t, providedStatus, nar.GetTrieVerificationStatus())
+}
@@ -175,6 +175,51 @@ func (atp *apiTransactionProcessor) doGetTransaction(hash []byte, withResults bo
 	return atp.getTransactionFromStorage(hash)
 }
 
+// GetTransactionsByAddress returns a page of the transactions (including rewards and smart contract results) sent or
+// received by the provided address, starting with the newest block lower than beforeNonce and from an epoch lower than
+// beforeEpoch, a 0 value disabling the corresponding condition. A pageSize of 0 means the default page size
+func (atp *apiTransactionProcessor) GetTransactionsByAddress(address string, beforeNonce uint64, beforeEpoch uint32, pageSize uint32) (*common.AccountTransactionsAPIResponse, error) {
+	decodedAddress, err := atp.addressPubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%s, %w", ErrInvalidAddress.Error(), err)
+	}
+
+	if pageSize == 0 {
+		pageSize = defaultAccountTransactionsPageSize
+	}
+	if pageSize > maxAccountTransactionsPageSize {
+		return nil, fmt.Errorf("%w: maximum is %d", ErrInvalidPageSize, maxAccountTransactionsPageSize)
+	}
+
+	if !atp.historyRepository.IsEnabled() {
+		return nil, fmt.Errorf("cannot return account transactions: %w", ErrDBLookExtensionIsNotEnabled)
+	}
+
+	page, err := atp.historyRepository.GetAccountTransactions(decodedAddress, beforeNonce, beforeEpoch, int(pageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.AccountTransactionsAPIResponse{
+		Transactions:    make([]*transaction.ApiTransactionResult, 0, len(page.Transactions)),
+		HasMore:         page.HasMore,
+		NextBeforeNonce: page.NextBeforeNonce,
+		NextBeforeEpoch: page.NextBeforeEpoch,
+	}
+	for _, accountTx := range page.Transactions {
+		txHash := hex.EncodeToString(accountTx.TxHash)
+		tx, errGet := atp.GetTransaction(txHash, false)
+		if errGet != nil {
+			log.Debug("GetTransactionsByAddress: cannot load transaction", "hash", txHash, "error", errGet)
+			continue
+		}
+
+		response.Transactions = append(response.Transactions, tx)
+	}
+
+	return response, nil
+}
+
 // PopulateComputedFields populates (computes) transaction fields such as processing type(s), initially paid fee etc.
 func (atp *apiTransactionProcessor) PopulateComputedFields(tx *transaction.ApiTransactionResult) {
 	atp.populateComputedFieldsProcessingType(tx)
@@ -746,6 +746,80 @@ func TestNode_lookupHistoricalTransaction(t *testing.T) {
 	require.Equal(t, transaction.TxStatusRewardReverted, actualH.Status)
 }
 
+func TestApiTransactionProcessor_GetTransactionsByAddress(t *testing.T) {
+	t.Parallel()
+
+	aliceHex := hex.EncodeToString([]byte("alice"))
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, _ := createAPITransactionProc(t, 42, true)
+		response, err := atp.GetTransactionsByAddress("not hex", 0, 0, 0)
+		require.Contains(t, err.Error(), ErrInvalidAddress.Error())
+		require.Nil(t, response)
+	})
+	t.Run("page size too large should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, _ := createAPITransactionProc(t, 42, true)
+		response, err := atp.GetTransactionsByAddress(aliceHex, 0, 0, maxAccountTransactionsPageSize+1)
+		require.ErrorIs(t, err, ErrInvalidPageSize)
+		require.Nil(t, response)
+	})
+	t.Run("db lookup extensions disabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, _ := createAPITransactionProc(t, 42, false)
+		response, err := atp.GetTransactionsByAddress(aliceHex, 0, 0, 0)
+		require.ErrorIs(t, err, ErrDBLookExtensionIsNotEnabled)
+		require.Nil(t, response)
+	})
+	t.Run("history repository error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		atp, _, _, historyRepo := createAPITransactionProc(t, 42, true)
+		historyRepo.GetAccountTransactionsCalled = func(address []byte, beforeNonce uint64, beforeEpoch uint32, maxTransactions int) (*dblookupext.AccountTransactionsPage, error) {
+			return nil, expectedErr
+		}
+
+		response, err := atp.GetTransactionsByAddress(aliceHex, 0, 0, 0)
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, response)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		atp, chainStorer, _, historyRepo := createAPITransactionProc(t, 42, true)
+		txA := &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice"), RcvAddr: []byte("alice")}
+		_ = chainStorer.Transactions.PutWithMarshalizer([]byte("a"), txA, atp.marshalizer)
+		setupGetMiniblockMetadataByTxHash(historyRepo, block.TxBlock, 1, 1, 42, nil, 0)
+		historyRepo.GetAccountTransactionsCalled = func(address []byte, beforeNonce uint64, beforeEpoch uint32, maxTransactions int) (*dblookupext.AccountTransactionsPage, error) {
+			require.Equal(t, []byte("alice"), address)
+			require.Equal(t, uint64(100), beforeNonce)
+			require.Equal(t, defaultAccountTransactionsPageSize, maxTransactions)
+
+			return &dblookupext.AccountTransactionsPage{
+				Transactions: []*dblookupext.AccountTransaction{
+					{TxHash: []byte("a"), Type: transaction.TxTypeNormal, BlockNonce: 90},
+					{TxHash: []byte("missing"), Type: transaction.TxTypeNormal, BlockNonce: 90},
+				},
+				HasMore:         true,
+				NextBeforeNonce: 90,
+			}, nil
+		}
+
+		response, err := atp.GetTransactionsByAddress(aliceHex, 100, 0, 0)
+		require.Nil(t, err)
+		require.True(t, response.HasMore)
+		require.Equal(t, uint64(90), response.NextBeforeNonce)
+		require.Len(t, response.Transactions, 1)
+		require.Equal(t, hex.EncodeToString([]byte("a")), response.Transactions[0].Hash)
+		require.Equal(t, txA.Nonce, response.Transactions[0].Nonce)
+	})
+}
+
 func TestNode_PutHistoryFieldsInTransaction(t *testing.T) {
 	tx := &transaction.ApiTransactionResult{}
 	metadata := &dblookupext.MiniblockMetadata{
@@ -3,4 +3,7 @@ package transactionAPI
 const (
 	okReturnCodeMarker                    = "@6f6b"
 	okReturnCodeMarkerBackwardsCompatible = "@ok"
+
+	defaultAccountTransactionsPageSize = 20
+	maxAccountTransactionsPageSize     = 100
 )
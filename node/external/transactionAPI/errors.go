@@ -32,5 +32,8 @@ var ErrCannotRetrieveTransactions = errors.New("transactions cannot be retrieved
 // ErrInvalidAddress signals that the address is invalid
 var ErrInvalidAddress = errors.New("invalid address")
 
+// ErrInvalidPageSize signals that the requested page size is invalid
+var ErrInvalidPageSize = errors.New("invalid page size")
+
 // ErrDBLookExtensionIsNotEnabled signals that the db look extension is not enabled
 var ErrDBLookExtensionIsNotEnabled = errors.New("db look extension is not enabled")
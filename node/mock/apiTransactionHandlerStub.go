@@ -12,6 +12,7 @@ type TransactionAPIHandlerStub struct {
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsByAddressCalled              func(address string, beforeNonce uint64, beforeEpoch uint32, pageSize uint32) (*common.AccountTransactionsAPIResponse, error)
 	UnmarshalTransactionCalled                  func(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
 	UnmarshalReceiptCalled                      func(receiptBytes []byte) (*transaction.ApiReceipt, error)
 	PopulateComputedFieldsCalled                func(tx *transaction.ApiTransactionResult)
@@ -72,6 +73,15 @@ func (tas *TransactionAPIHandlerStub) GetTransactionsPoolNonceGapsForSender(send
 	return nil, nil
 }
 
+// GetTransactionsByAddress -
+func (tas *TransactionAPIHandlerStub) GetTransactionsByAddress(address string, beforeNonce uint64, beforeEpoch uint32, pageSize uint32) (*common.AccountTransactionsAPIResponse, error) {
+	if tas.GetTransactionsByAddressCalled != nil {
+		return tas.GetTransactionsByAddressCalled(address, beforeNonce, beforeEpoch, pageSize)
+	}
+
+	return nil, nil
+}
+
 // UnmarshalTransaction -
 func (tas *TransactionAPIHandlerStub) UnmarshalTransaction(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error) {
 	if tas.UnmarshalTransactionCalled != nil {
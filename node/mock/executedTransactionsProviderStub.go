@@ -0,0 +1,35 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+// ExecutedTransactionsProviderStub -
+type ExecutedTransactionsProviderStub struct {
+	GetTransactionCalled func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetBlockByHashCalled func(hash string, options api.BlockQueryOptions) (*api.Block, error)
+}
+
+// GetTransaction -
+func (stub *ExecutedTransactionsProviderStub) GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+	if stub.GetTransactionCalled != nil {
+		return stub.GetTransactionCalled(hash, withResults)
+	}
+
+	return nil, nil
+}
+
+// GetBlockByHash -
+func (stub *ExecutedTransactionsProviderStub) GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error) {
+	if stub.GetBlockByHashCalled != nil {
+		return stub.GetBlockByHashCalled(hash, options)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *ExecutedTransactionsProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -9,6 +9,10 @@ import (
 type TransactionCostEstimatorMock struct {
 	ComputeTransactionGasLimitCalled   func(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	SimulateTransactionExecutionCalled func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+
+	ComputeTransactionGasLimitWithStateOverridesCalled   func(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*transaction.CostResponse, error)
+	SimulateTransactionExecutionWithStateOverridesCalled func(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*txSimData.SimulationResultsWithVMOutput, error)
+	TraceTransactionExecutionCalled                      func(tx *transaction.Transaction, options txSimData.TraceOptions) (*txSimData.ExecutionTrace, error)
 }
 
 // ComputeTransactionGasLimit -
@@ -28,6 +32,32 @@ func (tcem *TransactionCostEstimatorMock) SimulateTransactionExecution(tx *trans
 	return &txSimData.SimulationResultsWithVMOutput{}, nil
 }
 
+// ComputeTransactionGasLimitWithStateOverrides -
+func (tcem *TransactionCostEstimatorMock) ComputeTransactionGasLimitWithStateOverrides(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*transaction.CostResponse, error) {
+	if tcem.ComputeTransactionGasLimitWithStateOverridesCalled != nil {
+		return tcem.ComputeTransactionGasLimitWithStateOverridesCalled(tx, overrides)
+	}
+	return &transaction.CostResponse{}, nil
+}
+
+// SimulateTransactionExecutionWithStateOverrides -
+func (tcem *TransactionCostEstimatorMock) SimulateTransactionExecutionWithStateOverrides(tx *transaction.Transaction, overrides []*txSimData.StateOverride) (*txSimData.SimulationResultsWithVMOutput, error) {
+	if tcem.SimulateTransactionExecutionWithStateOverridesCalled != nil {
+		return tcem.SimulateTransactionExecutionWithStateOverridesCalled(tx, overrides)
+	}
+
+	return &txSimData.SimulationResultsWithVMOutput{}, nil
+}
+
+// TraceTransactionExecution -
+func (tcem *TransactionCostEstimatorMock) TraceTransactionExecution(tx *transaction.Transaction, options txSimData.TraceOptions) (*txSimData.ExecutionTrace, error) {
+	if tcem.TraceTransactionExecutionCalled != nil {
+		return tcem.TraceTransactionExecutionCalled(tx, options)
+	}
+
+	return &txSimData.ExecutionTrace{}, nil
+}
+
 // IsInterfaceNil -
 func (tcem *TransactionCostEstimatorMock) IsInterfaceNil() bool {
 	return tcem == nil
@@ -37,6 +37,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/dataValidators"
 	"github.com/multiversx/mx-chain-go/process/smartContract"
 	procTx "github.com/multiversx/mx-chain-go/process/transaction"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/trie"
 	"github.com/multiversx/mx-chain-go/vm"
@@ -747,6 +748,33 @@ func (n *Node) ValidateTransactionForSimulation(tx *transaction.Transaction, che
 	return err
 }
 
+// ValidateTransactionForSimulationWithStateOverrides will validate a transaction for use in transaction simulation
+// process. The nonce and balance of an overridden sender are known only by the simulation, so its account is not checked
+func (n *Node) ValidateTransactionForSimulationWithStateOverrides(
+	tx *transaction.Transaction,
+	checkSignature bool,
+	overrides []*txSimData.StateOverride,
+) error {
+	if !isAddressOverridden(tx.GetSndAddr(), overrides) {
+		return n.ValidateTransactionForSimulation(tx, checkSignature)
+	}
+
+	disabledWhiteListHandler := disabled.NewDisabledWhiteListDataVerifier()
+	_, _, err := n.commonTransactionValidation(tx, disabledWhiteListHandler, disabledWhiteListHandler, checkSignature)
+
+	return err
+}
+
+func isAddressOverridden(address []byte, overrides []*txSimData.StateOverride) bool {
+	for _, override := range overrides {
+		if bytes.Equal(override.Address, address) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (n *Node) commonTransactionValidation(
 	tx *transaction.Transaction,
 	whiteListerVerifiedTxs process.WhiteListHandler,
@@ -50,6 +50,7 @@ import (
 	"github.com/multiversx/mx-chain-go/genesis"
 	"github.com/multiversx/mx-chain-go/genesis/parsing"
 	"github.com/multiversx/mx-chain-go/health"
+	"github.com/multiversx/mx-chain-go/keysManagement/keystore"
 	"github.com/multiversx/mx-chain-go/node/metrics"
 	"github.com/multiversx/mx-chain-go/outport"
 	"github.com/multiversx/mx-chain-go/process"
@@ -831,6 +832,9 @@ func (nr *nodeRunner) createMetrics(
 	metrics.SaveStringMetric(statusCoreComponents.AppStatusHandler(), common.MetricRedundancyLevel, fmt.Sprintf("%d", nr.configs.PreferencesConfig.Preferences.RedundancyLevel))
 	metrics.SaveStringMetric(statusCoreComponents.AppStatusHandler(), common.MetricRedundancyIsMainActive, common.MetricValueNA)
 	metrics.SaveStringMetric(statusCoreComponents.AppStatusHandler(), common.MetricRedundancyStepInReason, "")
+	metrics.SaveStringMetric(statusCoreComponents.AppStatusHandler(), common.MetricRedundancyFailoverState, common.MetricValueNA)
+	metrics.SaveUint64Metric(statusCoreComponents.AppStatusHandler(), common.MetricRedundancyFailoverTransitions, 0)
+	metrics.SaveStringMetric(statusCoreComponents.AppStatusHandler(), common.MetricRedundancyFailoverLastTransition, "")
 	metrics.SaveStringMetric(statusCoreComponents.AppStatusHandler(), common.MetricChainId, coreComponents.ChainID())
 	metrics.SaveUint64Metric(statusCoreComponents.AppStatusHandler(), common.MetricGasPerDataByte, coreComponents.EconomicsData().GasPerDataByte())
 	metrics.SaveUint64Metric(statusCoreComponents.AppStatusHandler(), common.MetricMinGasPrice, coreComponents.EconomicsData().MinGasPrice())
@@ -1526,6 +1530,18 @@ func (nr *nodeRunner) CreateManagedCryptoComponents(
 	configs := nr.configs
 	validatorKeyPemFileName := configs.ConfigurationPathsHolder.ValidatorKey
 	allValidatorKeysPemFileName := configs.ConfigurationPathsHolder.AllValidatorKeys
+	keyLoader, err := keystore.NewKeyLoader(keystore.ArgsKeyLoader{
+		PemKeyLoader: core.NewKeyLoader(),
+		PassphraseProvider: keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{
+			FileDescriptor: configs.FlagsConfig.KeysPassphraseFd,
+			EnvVariable:    configs.FlagsConfig.KeysPassphraseEnvVariable,
+			PromptMessage:  "Enter the passphrase of the encrypted key files: ",
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	cryptoComponentsHandlerArgs := cryptoComp.CryptoComponentsFactoryArgs{
 		ValidatorKeyPemFileName:              validatorKeyPemFileName,
 		AllValidatorKeysPemFileName:          allValidatorKeysPemFileName,
@@ -1534,7 +1550,7 @@ func (nr *nodeRunner) CreateManagedCryptoComponents(
 		PrefsConfig:                          *configs.PreferencesConfig,
 		CoreComponentsHolder:                 coreComponents,
 		ActivateBLSPubKeyMessageVerification: configs.SystemSCConfig.StakingSystemSCConfig.ActivateBLSPubKeyMessageVerification,
-		KeyLoader:                            core.NewKeyLoader(),
+		KeyLoader:                            keyLoader,
 		ImportModeNoSigCheck:                 configs.ImportDbConfig.ImportDbNoSigCheckFlag,
 		IsInImportMode:                       configs.ImportDbConfig.IsImportDBMode,
 		EnableEpochs:                         configs.EpochConfig.EnableEpochs,
@@ -0,0 +1,163 @@
+package node
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/node/external"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+)
+
+// TraceTransactionExecution executes the provided transaction in trace mode and returns its execution trace. If the
+// block nonce is provided, the transaction is executed within that block, on the state resulted after its previous
+// block, as if it was the first transaction of the block.
+func (n *Node) TraceTransactionExecution(
+	tx *transaction.Transaction,
+	overrides []*txSimData.StateOverride,
+	blockNonce core.OptionalUint64,
+) (*txSimData.ExecutionTrace, error) {
+	options := txSimData.TraceOptions{
+		StateOverrides: overrides,
+	}
+
+	if blockNonce.HasValue {
+		err := n.addBlockToTraceOptions(&options, blockNonce.Value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return n.processComponents.APITransactionEvaluator().TraceTransactionExecution(tx, options)
+}
+
+// TraceTransactionExecutionByHash re-executes, in trace mode, the transaction with the provided hash within the block
+// it was executed in, after the transactions executed before it within that block, and returns its execution trace.
+// A transaction which is not executed yet is traced on the current state, with its own nonce
+func (n *Node) TraceTransactionExecutionByHash(hash string, provider external.ExecutedTransactionsProvider) (*txSimData.ExecutionTrace, error) {
+	if check.IfNil(provider) {
+		return nil, ErrNilExecutedTransactionsProvider
+	}
+
+	txResult, err := provider.GetTransaction(hash, false)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, ok := txResult.Tx.(*transaction.Transaction)
+	if !ok {
+		return nil, ErrTransactionNotTraceable
+	}
+
+	if txResult.BlockNonce == 0 {
+		senderNonce := tx.Nonce
+		overrides := []*txSimData.StateOverride{{Address: tx.SndAddr, Nonce: &senderNonce}}
+		return n.TraceTransactionExecution(tx, overrides, core.OptionalUint64{})
+	}
+
+	txBlock, err := provider.GetBlockByHash(txResult.BlockHash, api.BlockQueryOptions{WithTransactions: true})
+	if err != nil {
+		return nil, err
+	}
+
+	precedingTxs, isExactState := getPrecedingTransactions(txBlock, hash)
+	trace, err := n.traceTransactionExecutionInBlock(tx, precedingTxs, txResult.BlockNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	trace.InexactState = trace.InexactState || !isExactState
+
+	return trace, nil
+}
+
+// traceTransactionExecutionInBlock executes the provided transaction in trace mode within the block with the provided
+// nonce. The state resulted after the previous block is first brought to the one the transaction was executed on by
+// re-applying the provided transactions, executed before it within the block.
+func (n *Node) traceTransactionExecutionInBlock(
+	tx *transaction.Transaction,
+	precedingTxs []*transaction.Transaction,
+	blockNonce uint64,
+) (*txSimData.ExecutionTrace, error) {
+	options := txSimData.TraceOptions{
+		PrecedingTransactions: precedingTxs,
+	}
+
+	err := n.addBlockToTraceOptions(&options, blockNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.processComponents.APITransactionEvaluator().TraceTransactionExecution(tx, options)
+}
+
+// getPrecedingTransactions returns, in execution order, the transactions executed before the one with the provided
+// hash within the provided block. It also returns false if the state the transaction was executed on can not be
+// rebuilt by re-applying them, as the block executed other operations before it (such as smart contract results or
+// rewards) or the transaction was not found within the block
+func getPrecedingTransactions(txBlock *api.Block, txHash string) ([]*transaction.Transaction, bool) {
+	isExactState := true
+	processedMiniBlocks := make([]*api.MiniBlock, 0)
+	miniBlocks := make([]*api.MiniBlock, 0, len(txBlock.MiniBlocks))
+	for _, miniBlock := range txBlock.MiniBlocks {
+		switch {
+		case miniBlock.IsFromReceiptsStorage || miniBlock.Type == block.ReceiptBlock.String():
+			// the results of the block's own transactions, created again when re-applying them
+		case miniBlock.Type == block.InvalidBlock.String():
+			// the invalid transactions were executed interleaved with the others
+			isExactState = false
+		case miniBlock.Type != block.TxBlock.String():
+			if miniBlock.DestinationShard == txBlock.Shard {
+				isExactState = false
+			}
+		case miniBlock.ProcessingType == block.Processed.String():
+			// the transactions scheduled in the previous block are executed first, on a state which is not recorded
+			isExactState = false
+			processedMiniBlocks = append(processedMiniBlocks, miniBlock)
+		default:
+			miniBlocks = append(miniBlocks, miniBlock)
+		}
+	}
+
+	precedingTxs := make([]*transaction.Transaction, 0)
+	for _, miniBlock := range append(processedMiniBlocks, miniBlocks...) {
+		for _, apiTx := range miniBlock.Transactions {
+			if apiTx.Hash == txHash {
+				return precedingTxs, isExactState
+			}
+
+			tx, ok := apiTx.Tx.(*transaction.Transaction)
+			if !ok {
+				isExactState = false
+				continue
+			}
+			precedingTxs = append(precedingTxs, tx)
+		}
+	}
+
+	return nil, false
+}
+
+func (n *Node) addBlockToTraceOptions(options *txSimData.TraceOptions, blockNonce uint64) error {
+	if blockNonce == 0 {
+		return ErrCannotTraceInGenesisBlock
+	}
+
+	header, _, err := n.getBlockHeaderByNonce(blockNonce)
+	if err != nil {
+		return err
+	}
+
+	historicalState, err := n.addBlockCoordinatesToAccountQueryOptions(api.AccountQueryOptions{
+		BlockNonce: core.OptionalUint64{Value: blockNonce - 1, HasValue: true},
+	})
+	if err != nil {
+		return err
+	}
+
+	options.BlockHeader = header
+	options.HistoricalState = &historicalState
+
+	return nil
+}
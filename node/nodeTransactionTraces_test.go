@@ -0,0 +1,255 @@
+package node_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/node"
+	"github.com/multiversx/mx-chain-go/node/mock"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_TraceTransactionExecution(t *testing.T) {
+	t.Parallel()
+
+	t.Run("genesis block should error", func(t *testing.T) {
+		t.Parallel()
+
+		processComponents := getDefaultProcessComponents()
+		processComponents.TransactionEvaluator = &mock.TransactionCostEstimatorMock{
+			TraceTransactionExecutionCalled: func(tx *transaction.Transaction, options txSimData.TraceOptions) (*txSimData.ExecutionTrace, error) {
+				require.Fail(t, "should not have been called")
+				return nil, nil
+			},
+		}
+		n, _ := node.NewNode(node.WithProcessComponents(processComponents))
+
+		trace, err := n.TraceTransactionExecution(&transaction.Transaction{}, nil, core.OptionalUint64{Value: 0, HasValue: true})
+		require.Equal(t, node.ErrCannotTraceInGenesisBlock, err)
+		require.Nil(t, trace)
+	})
+	t.Run("without block nonce should trace on the current state", func(t *testing.T) {
+		t.Parallel()
+
+		providedTx := &transaction.Transaction{Nonce: 7}
+		providedOverrides := []*txSimData.StateOverride{{Address: []byte("address")}}
+		expectedTrace := &txSimData.ExecutionTrace{Status: transaction.TxStatusSuccess}
+		processComponents := getDefaultProcessComponents()
+		processComponents.TransactionEvaluator = &mock.TransactionCostEstimatorMock{
+			TraceTransactionExecutionCalled: func(tx *transaction.Transaction, options txSimData.TraceOptions) (*txSimData.ExecutionTrace, error) {
+				require.Equal(t, providedTx, tx)
+				require.Equal(t, txSimData.TraceOptions{StateOverrides: providedOverrides}, options)
+				return expectedTrace, nil
+			},
+		}
+		n, _ := node.NewNode(node.WithProcessComponents(processComponents))
+
+		trace, err := n.TraceTransactionExecution(providedTx, providedOverrides, core.OptionalUint64{})
+		require.Nil(t, err)
+		require.Equal(t, expectedTrace, trace)
+	})
+}
+
+func TestNode_TraceTransactionExecutionByHash(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	t.Run("nil provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(node.WithProcessComponents(getDefaultProcessComponents()))
+
+		trace, err := n.TraceTransactionExecutionByHash("hash", nil)
+		require.Equal(t, node.ErrNilExecutedTransactionsProvider, err)
+		require.Nil(t, trace)
+	})
+	t.Run("get transaction error should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(node.WithProcessComponents(getDefaultProcessComponents()))
+		provider := &mock.ExecutedTransactionsProviderStub{
+			GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return nil, expectedErr
+			},
+		}
+
+		trace, err := n.TraceTransactionExecutionByHash("hash", provider)
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, trace)
+	})
+	t.Run("not a regular transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(node.WithProcessComponents(getDefaultProcessComponents()))
+		provider := &mock.ExecutedTransactionsProviderStub{
+			GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return &transaction.ApiTransactionResult{Tx: &rewardTx.RewardTx{}}, nil
+			},
+		}
+
+		trace, err := n.TraceTransactionExecutionByHash("hash", provider)
+		require.Equal(t, node.ErrTransactionNotTraceable, err)
+		require.Nil(t, trace)
+	})
+	t.Run("pending transaction should be traced on the current state", func(t *testing.T) {
+		t.Parallel()
+
+		providedTx := &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice")}
+		expectedTrace := &txSimData.ExecutionTrace{GasUsed: 10}
+		processComponents := getDefaultProcessComponents()
+		processComponents.TransactionEvaluator = &mock.TransactionCostEstimatorMock{
+			TraceTransactionExecutionCalled: func(tx *transaction.Transaction, options txSimData.TraceOptions) (*txSimData.ExecutionTrace, error) {
+				require.Equal(t, providedTx, tx)
+				require.Nil(t, options.HistoricalState)
+				require.Len(t, options.StateOverrides, 1)
+				require.Equal(t, providedTx.SndAddr, options.StateOverrides[0].Address)
+				require.Equal(t, uint64(7), *options.StateOverrides[0].Nonce)
+				return expectedTrace, nil
+			},
+		}
+		n, _ := node.NewNode(node.WithProcessComponents(processComponents))
+		provider := &mock.ExecutedTransactionsProviderStub{
+			GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return &transaction.ApiTransactionResult{Tx: providedTx}, nil
+			},
+			GetBlockByHashCalled: func(hash string, options api.BlockQueryOptions) (*api.Block, error) {
+				require.Fail(t, "should not have been called")
+				return nil, nil
+			},
+		}
+
+		trace, err := n.TraceTransactionExecutionByHash("hash", provider)
+		require.Nil(t, err)
+		require.Equal(t, expectedTrace, trace)
+	})
+	t.Run("get block error should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(node.WithProcessComponents(getDefaultProcessComponents()))
+		provider := &mock.ExecutedTransactionsProviderStub{
+			GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return &transaction.ApiTransactionResult{Tx: &transaction.Transaction{}, BlockNonce: 37, BlockHash: "block hash"}, nil
+			},
+			GetBlockByHashCalled: func(hash string, options api.BlockQueryOptions) (*api.Block, error) {
+				require.Equal(t, "block hash", hash)
+				require.True(t, options.WithTransactions)
+				return nil, expectedErr
+			},
+		}
+
+		trace, err := n.TraceTransactionExecutionByHash("hash", provider)
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, trace)
+	})
+}
+
+func TestGetPrecedingTransactions(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice")}
+	tx1, tx2, tx3 := &transaction.Transaction{Nonce: 1}, &transaction.Transaction{Nonce: 2}, &transaction.Transaction{Nonce: 3}
+
+	t.Run("should return the transactions executed before, within the block", func(t *testing.T) {
+		t.Parallel()
+
+		txBlock := &api.Block{
+			Shard: 1,
+			MiniBlocks: []*api.MiniBlock{
+				{
+					Type:             block.TxBlock.String(),
+					ProcessingType:   block.Normal.String(),
+					SourceShard:      0,
+					DestinationShard: 1,
+					Transactions:     []*transaction.ApiTransactionResult{{Hash: "h1", Tx: tx1}},
+				},
+				// the smart contract results sent to other shards are not executed within the block
+				{Type: block.SmartContractResultBlock.String(), SourceShard: 1, DestinationShard: 2},
+				{
+					Type:             block.TxBlock.String(),
+					ProcessingType:   block.Normal.String(),
+					SourceShard:      1,
+					DestinationShard: 1,
+					Transactions:     []*transaction.ApiTransactionResult{{Hash: "h2", Tx: tx2}, {Hash: "hash", Tx: tx}, {Hash: "h3", Tx: tx3}},
+				},
+				{Type: block.SmartContractResultBlock.String(), SourceShard: 1, DestinationShard: 1, IsFromReceiptsStorage: true},
+			},
+		}
+
+		precedingTxs, isExactState := node.GetPrecedingTransactions(txBlock, "hash")
+		require.Equal(t, []*transaction.Transaction{tx1, tx2}, precedingTxs)
+		require.True(t, isExactState)
+	})
+	t.Run("preceding smart contract results should flag the state as inexact", func(t *testing.T) {
+		t.Parallel()
+
+		txBlock := &api.Block{
+			Shard: 1,
+			MiniBlocks: []*api.MiniBlock{
+				{Type: block.SmartContractResultBlock.String(), SourceShard: 0, DestinationShard: 1},
+				{
+					Type:             block.TxBlock.String(),
+					ProcessingType:   block.Normal.String(),
+					SourceShard:      1,
+					DestinationShard: 1,
+					Transactions:     []*transaction.ApiTransactionResult{{Hash: "h1", Tx: tx1}, {Hash: "hash", Tx: tx}},
+				},
+			},
+		}
+
+		precedingTxs, isExactState := node.GetPrecedingTransactions(txBlock, "hash")
+		require.Equal(t, []*transaction.Transaction{tx1}, precedingTxs)
+		require.False(t, isExactState)
+	})
+	t.Run("invalid transactions should flag the state as inexact", func(t *testing.T) {
+		t.Parallel()
+
+		txBlock := &api.Block{
+			MiniBlocks: []*api.MiniBlock{
+				{
+					Type:         block.TxBlock.String(),
+					Transactions: []*transaction.ApiTransactionResult{{Hash: "h1", Tx: tx1}, {Hash: "hash", Tx: tx}},
+				},
+				{Type: block.InvalidBlock.String(), Transactions: []*transaction.ApiTransactionResult{{Hash: "h2", Tx: tx2}}},
+			},
+		}
+
+		precedingTxs, isExactState := node.GetPrecedingTransactions(txBlock, "hash")
+		require.Equal(t, []*transaction.Transaction{tx1}, precedingTxs)
+		require.False(t, isExactState)
+	})
+	t.Run("transactions scheduled in the previous block should be re-applied first", func(t *testing.T) {
+		t.Parallel()
+
+		txBlock := &api.Block{
+			MiniBlocks: []*api.MiniBlock{
+				{
+					Type:           block.TxBlock.String(),
+					ProcessingType: block.Normal.String(),
+					Transactions:   []*transaction.ApiTransactionResult{{Hash: "h1", Tx: tx1}, {Hash: "hash", Tx: tx}},
+				},
+				{
+					Type:           block.TxBlock.String(),
+					ProcessingType: block.Processed.String(),
+					Transactions:   []*transaction.ApiTransactionResult{{Hash: "h3", Tx: tx3}},
+				},
+			},
+		}
+
+		precedingTxs, isExactState := node.GetPrecedingTransactions(txBlock, "hash")
+		require.Equal(t, []*transaction.Transaction{tx3, tx1}, precedingTxs)
+		require.False(t, isExactState)
+	})
+	t.Run("transaction not found within the block should flag the state as inexact", func(t *testing.T) {
+		t.Parallel()
+
+		precedingTxs, isExactState := node.GetPrecedingTransactions(&api.Block{}, "hash")
+		require.Nil(t, precedingTxs)
+		require.False(t, isExactState)
+	})
+}
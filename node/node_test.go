@@ -43,6 +43,7 @@ import (
 	"github.com/multiversx/mx-chain-go/node/mock"
 	nodeMockFactory "github.com/multiversx/mx-chain-go/node/mock/factory"
 	"github.com/multiversx/mx-chain-go/process"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/state/accounts"
 	"github.com/multiversx/mx-chain-go/state/parsers"
@@ -4026,6 +4027,79 @@ func TestNode_ValidateTransactionForSimulation_CheckSignatureFalse(t *testing.T)
 	require.NoError(t, err)
 }
 
+func TestNode_ValidateTransactionForSimulationWithStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.IntMarsh = getMarshalizer()
+	coreComponents.VmMarsh = getMarshalizer()
+	coreComponents.Hash = getHasher()
+	coreComponents.AddrPubKeyConv = testscommon.NewPubkeyConverterMock(3)
+	coreComponents.APIEconomicsHandler = &economicsmocks.EconomicsHandlerMock{
+		ComputeTxFeeCalled: func(tx data.TransactionWithFeeHandler) *big.Int {
+			return big.NewInt(100)
+		},
+	}
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			// the sender has no funds in the current state
+			return createAcc(address), nil
+		},
+	}
+
+	bootstrapComponents := getDefaultBootstrapComponents()
+	bootstrapComponents.ShCoordinator = &mock.ShardCoordinatorMock{}
+
+	processComponents := getDefaultProcessComponents()
+	processComponents.ShardCoord = bootstrapComponents.ShCoordinator
+	processComponents.WhiteListHandlerInternal = &testscommon.WhiteListHandlerStub{}
+	processComponents.WhiteListerVerifiedTxsInternal = &testscommon.WhiteListHandlerStub{}
+	processComponents.EpochTrigger = &mock.EpochStartTriggerStub{}
+
+	cryptoComponents := getDefaultCryptoComponents()
+	cryptoComponents.TxKeyGen = &mock.KeyGenMock{
+		PublicKeyFromByteArrayMock: func(b []byte) (crypto.PublicKey, error) {
+			return nil, nil
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithProcessComponents(processComponents),
+		node.WithBootstrapComponents(bootstrapComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithCryptoComponents(cryptoComponents),
+	)
+
+	tx := &transaction.Transaction{
+		Nonce:     11,
+		Value:     big.NewInt(25),
+		RcvAddr:   []byte("rec"),
+		SndAddr:   []byte("snd"),
+		GasPrice:  6,
+		GasLimit:  12,
+		Data:      []byte(""),
+		Signature: []byte("sig1"),
+		ChainID:   []byte(coreComponents.ChainID()),
+	}
+
+	t.Run("sender not overridden should check its account", func(t *testing.T) {
+		t.Parallel()
+
+		overrides := []*txSimData.StateOverride{{Address: []byte("rec"), Balance: big.NewInt(1000)}}
+		err := n.ValidateTransactionForSimulationWithStateOverrides(tx, false, overrides)
+		require.True(t, errors.Is(err, process.ErrInsufficientFunds))
+	})
+	t.Run("overridden sender should not check its account", func(t *testing.T) {
+		t.Parallel()
+
+		overrides := []*txSimData.StateOverride{{Address: []byte("snd"), Balance: big.NewInt(1000)}}
+		err := n.ValidateTransactionForSimulationWithStateOverrides(tx, false, overrides)
+		require.NoError(t, err)
+	})
+}
+
 func TestGetKeyValuePairs_CannotDecodeAddress(t *testing.T) {
 	t.Parallel()
 
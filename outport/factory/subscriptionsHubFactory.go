@@ -0,0 +1,41 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/outport/subscriptions"
+	"github.com/multiversx/mx-chain-go/sharding"
+)
+
+// ArgsSubscriptionsHubFactory holds the arguments needed to create a subscriptions hub
+type ArgsSubscriptionsHubFactory struct {
+	Config           config.SubscriptionsConfig
+	Marshaller       marshal.Marshalizer
+	AddressConverter core.PubkeyConverter
+	ShardCoordinator sharding.Coordinator
+}
+
+// CreateSubscriptionsHub will create a new instance of outport.SubscriptionsDriver
+func CreateSubscriptionsHub(args ArgsSubscriptionsHubFactory) (outport.SubscriptionsDriver, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, core.ErrNilMarshalizer
+	}
+
+	blockContainer, err := createBlockCreatorsContainer()
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriptions.NewSubscriptionsHub(subscriptions.ArgsSubscriptionsHub{
+		Marshaller:          args.Marshaller,
+		BlockContainer:      blockContainer,
+		AddressConverter:    args.AddressConverter,
+		ShardCoordinator:    args.ShardCoordinator,
+		MaxSubscribers:      args.Config.MaxSubscribers,
+		EventsBufferSize:    args.Config.EventsBufferSize,
+		HistorySizeInBlocks: args.Config.HistorySizeInBlocks,
+	})
+}
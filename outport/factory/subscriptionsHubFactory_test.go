@@ -0,0 +1,49 @@
+package factory_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/outport/factory"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockSubscriptionsHubFactoryArgs() factory.ArgsSubscriptionsHubFactory {
+	return factory.ArgsSubscriptionsHubFactory{
+		Config: config.SubscriptionsConfig{
+			Enabled:             true,
+			MaxSubscribers:      10,
+			EventsBufferSize:    100,
+			HistorySizeInBlocks: 10,
+		},
+		Marshaller:       &marshallerMock.MarshalizerMock{},
+		AddressConverter: testscommon.RealWorldBech32PubkeyConverter,
+		ShardCoordinator: testscommon.NewMultiShardsCoordinatorMock(1),
+	}
+}
+
+func TestCreateSubscriptionsHub(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscriptionsHubFactoryArgs()
+		args.Marshaller = nil
+
+		hub, err := factory.CreateSubscriptionsHub(args)
+		require.Nil(t, hub)
+		require.Equal(t, core.ErrNilMarshalizer, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hub, err := factory.CreateSubscriptionsHub(createMockSubscriptionsHubFactoryArgs())
+		require.Nil(t, err)
+		require.NotNil(t, hub)
+	})
+}
@@ -3,6 +3,7 @@ package outport
 import (
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/outport/process"
 )
 
@@ -24,6 +25,12 @@ type Driver interface {
 	IsInterfaceNil() bool
 }
 
+// SubscriptionsDriver defines a driver that streams the received data to the API subscribers
+type SubscriptionsDriver interface {
+	Driver
+	common.SubscriptionsHandler
+}
+
 // OutportHandler is interface that defines what a proxy implementation should be able to do
 // The node is able to talk only with this interface
 type OutportHandler interface {
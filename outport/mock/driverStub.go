@@ -15,6 +15,7 @@ type DriverStub struct {
 	SaveValidatorsRatingCalled  func(validatorsRating *outportcore.ValidatorsRating) error
 	SaveAccountsCalled          func(accounts *outportcore.Accounts) error
 	FinalizedBlockCalled        func(finalizedBlock *outportcore.FinalizedBlock) error
+	NewTransactionInPoolCalled  func(transaction interface{}) error
 	CloseCalled                 func() error
 	RegisterHandlerCalled       func(handlerFunction func() error, topic string) error
 	SetCurrentSettingsCalled    func(config outportcore.OutportConfig) error
@@ -83,6 +84,15 @@ func (d *DriverStub) FinalizedBlock(finalizedBlock *outportcore.FinalizedBlock)
 	return nil
 }
 
+// NewTransactionInPool -
+func (d *DriverStub) NewTransactionInPool(transaction interface{}) error {
+	if d.NewTransactionInPoolCalled != nil {
+		return d.NewTransactionInPoolCalled(transaction)
+	}
+
+	return nil
+}
+
 // GetMarshaller -
 func (d *DriverStub) GetMarshaller() marshal.Marshalizer {
 	return marshallerMock.MarshalizerMock{}
@@ -357,7 +357,8 @@ func (o *outport) finalizedBlockBlocking(finalizedBlock *outportcore.FinalizedBl
 	}
 }
 
-// NewTransactionHandlerInPool implements OutportHandler.
+// NewTransactionInPool sends the transaction added in pool to all the drivers. A failing driver does not prevent the
+// other drivers, such as the subscriptions hub, from receiving the transaction
 func (o *outport) NewTransactionInPool(key []byte, value interface{}) {
 	if check.IfNilReflect(value) {
 		return
@@ -388,10 +389,13 @@ func (o *outport) NewTransactionInPool(key []byte, value interface{}) {
 
 		log.Debug("Hey Tx ", "hash", "SndAddr", finalTx.TxHash, finalTx.Transaction.SndAddr)
 
+		o.mutex.RLock()
+		defer o.mutex.RUnlock()
+
 		for _, driver := range o.drivers {
 			err := driver.NewTransactionInPool(finalTx)
-			if err == nil {
-				return
+			if err != nil {
+				log.Debug("outport.NewTransactionInPool", "driver", driverString(driver), "error", err)
 			}
 		}
 	case *rewardTx.RewardTx:
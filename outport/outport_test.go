@@ -10,9 +10,13 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core/atomic"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/outport/mock"
+	"github.com/multiversx/mx-chain-go/storage/txcache"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -35,13 +39,13 @@ func TestNewOutport(t *testing.T) {
 	t.Parallel()
 
 	t.Run("invalid retrial time should error", func(t *testing.T) {
-		outportHandler, err := NewOutport(0, outportcore.OutportConfig{})
+		outportHandler, err := NewOutport(0, outportcore.OutportConfig{}, &testscommon.ChainHandlerStub{})
 
 		assert.True(t, errors.Is(err, ErrInvalidRetrialInterval))
 		assert.True(t, check.IfNil(outportHandler))
 	})
 	t.Run("should work", func(t *testing.T) {
-		outportHandler, err := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{})
+		outportHandler, err := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{}, &testscommon.ChainHandlerStub{})
 
 		assert.Nil(t, err)
 		assert.False(t, check.IfNil(outportHandler))
@@ -70,7 +74,7 @@ func TestOutport_SaveAccounts(t *testing.T) {
 			return nil
 		},
 	}
-	outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{})
+	outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{}, &testscommon.ChainHandlerStub{})
 	numLogDebugCalled := uint32(0)
 	outportHandler.logHandler = func(logLevel logger.LogLevel, message string, args ...interface{}) {
 		if logLevel == logger.LogError {
@@ -116,7 +120,7 @@ func TestOutport_SaveBlock(t *testing.T) {
 			return nil
 		},
 	}
-	outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{})
+	outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{}, &testscommon.ChainHandlerStub{})
 	numLogDebugCalled := uint32(0)
 	outportHandler.logHandler = func(logLevel logger.LogLevel, message string, args ...interface{}) {
 		if logLevel == logger.LogError {
@@ -162,7 +166,7 @@ func TestOutport_SaveRoundsInfo(t *testing.T) {
 			return nil
 		},
 	}
-	outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{})
+	outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{}, &testscommon.ChainHandlerStub{})
 	numLogDebugCalled := uint32(0)
 	outportHandler.logHandler = func(logLevel logger.LogLevel, message string, args ...interface{}) {
 		if logLevel == logger.LogError {
@@ -207,7 +211,7 @@ func TestOutport_SaveValidatorsPubKeys(t *testing.T) {
 			return nil
 		},
 	}
-	outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{})
+	outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{}, &testscommon.ChainHandlerStub{})
 	numLogDebugCalled := uint32(0)
 	outportHandler.logHandler = func(logLevel logger.LogLevel, message string, args ...interface{}) {
 		if logLevel == logger.LogError {
@@ -254,7 +258,7 @@ func TestOutport_SaveValidatorsRating(t *testing.T) {
 			return nil
 		},
 	}
-	outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{})
+	outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{}, &testscommon.ChainHandlerStub{})
 	numLogDebugCalled := uint32(0)
 	outportHandler.logHandler = func(logLevel logger.LogLevel, message string, args ...interface{}) {
 		if logLevel == logger.LogError {
@@ -301,7 +305,7 @@ func TestOutport_RevertIndexedBlock(t *testing.T) {
 			return nil
 		},
 	}
-	outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{})
+	outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{}, &testscommon.ChainHandlerStub{})
 	numLogDebugCalled := uint32(0)
 	outportHandler.logHandler = func(logLevel logger.LogLevel, message string, args ...interface{}) {
 		if logLevel == logger.LogError {
@@ -349,7 +353,7 @@ func TestOutport_FinalizedBlock(t *testing.T) {
 			return nil
 		},
 	}
-	outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{})
+	outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{}, &testscommon.ChainHandlerStub{})
 	numLogDebugCalled := uint32(0)
 	outportHandler.logHandler = func(logLevel logger.LogLevel, message string, args ...interface{}) {
 		if logLevel == logger.LogError {
@@ -374,11 +378,51 @@ func TestOutport_FinalizedBlock(t *testing.T) {
 	assert.Equal(t, uint32(4), atomicGo.LoadUint32(&numLogDebugCalled))
 }
 
+func TestOutport_NewTransactionInPoolShouldCallAllDrivers(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{Nonce: 7, SndAddr: []byte("sender")}
+	chainHandler := &testscommon.ChainHandlerStub{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.HeaderV2{Header: &block.Header{Nonce: 42}}
+		},
+	}
+	outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{}, chainHandler)
+
+	receivedTxs := make([]NewTransactionInPool, 0)
+	createDriver := func(err error) *mock.DriverStub {
+		return &mock.DriverStub{
+			NewTransactionInPoolCalled: func(transaction interface{}) error {
+				receivedTxs = append(receivedTxs, transaction.(NewTransactionInPool))
+				return err
+			},
+		}
+	}
+	_ = outportHandler.SubscribeDriver(createDriver(nil))
+	_ = outportHandler.SubscribeDriver(createDriver(errors.New("driver error")))
+	_ = outportHandler.SubscribeDriver(createDriver(nil))
+
+	outportHandler.NewTransactionInPool([]byte("hash"), &txcache.WrappedTransaction{
+		Tx:              tx,
+		SenderShardID:   1,
+		ReceiverShardID: 2,
+	})
+
+	require.Len(t, receivedTxs, 3)
+	for _, receivedTx := range receivedTxs {
+		assert.Equal(t, []byte("hash"), receivedTx.TxHash)
+		assert.Equal(t, uint64(42), receivedTx.CurrentBlockNonce)
+		assert.Equal(t, uint32(1), receivedTx.SenderShardID)
+		assert.Equal(t, uint32(2), receivedTx.ReceiverShardID)
+		assert.Equal(t, tx, receivedTx.Transaction)
+	}
+}
+
 func TestOutport_SubscribeDriver(t *testing.T) {
 	t.Parallel()
 
 	t.Run("nil driver should error", func(t *testing.T) {
-		outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{})
+		outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{}, &testscommon.ChainHandlerStub{})
 
 		require.False(t, outportHandler.HasDrivers())
 
@@ -387,7 +431,7 @@ func TestOutport_SubscribeDriver(t *testing.T) {
 		require.False(t, outportHandler.HasDrivers())
 	})
 	t.Run("should work", func(t *testing.T) {
-		outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{})
+		outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{}, &testscommon.ChainHandlerStub{})
 
 		require.False(t, outportHandler.HasDrivers())
 
@@ -400,7 +444,7 @@ func TestOutport_SubscribeDriver(t *testing.T) {
 func TestOutport_Close(t *testing.T) {
 	t.Parallel()
 
-	outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{})
+	outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{}, &testscommon.ChainHandlerStub{})
 
 	localErr := errors.New("local err")
 	driver1 := &mock.DriverStub{
@@ -424,7 +468,7 @@ func TestOutport_Close(t *testing.T) {
 func TestOutport_CloseWhileDriverIsStuckInContinuousErrors(t *testing.T) {
 	t.Parallel()
 
-	outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{})
+	outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{}, &testscommon.ChainHandlerStub{})
 
 	localErr := errors.New("driver stuck in error")
 	driver1 := &mock.DriverStub{
@@ -512,7 +556,7 @@ func TestOutport_SaveBlockDriverStuck(t *testing.T) {
 	t.Parallel()
 
 	currentCounter := uint64(778)
-	outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{})
+	outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{}, &testscommon.ChainHandlerStub{})
 	outportHandler.messageCounter = currentCounter
 	outportHandler.timeForDriverCall = time.Second
 	logErrorCalled := atomic.Flag{}
@@ -547,7 +591,7 @@ func TestOutport_SaveBlockDriverIsNotStuck(t *testing.T) {
 	t.Parallel()
 
 	currentCounter := uint64(778)
-	outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{})
+	outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{}, &testscommon.ChainHandlerStub{})
 	outportHandler.messageCounter = currentCounter
 	outportHandler.timeForDriverCall = time.Second
 	numLogDebugCalled := uint32(0)
@@ -595,7 +639,7 @@ func TestOutport_SettingsRequestAndReceive(t *testing.T) {
 			},
 		}
 
-		outportHandler, _ := NewOutport(time.Second, outportcore.OutportConfig{})
+		outportHandler, _ := NewOutport(time.Second, outportcore.OutportConfig{}, &testscommon.ChainHandlerStub{})
 		err := outportHandler.SubscribeDriver(driver)
 		assert.Equal(t, expectedErr, err)
 		require.False(t, outportHandler.HasDrivers())
@@ -624,7 +668,7 @@ func TestOutport_SettingsRequestAndReceive(t *testing.T) {
 			},
 		}
 
-		outportHandler, _ := NewOutport(time.Second, outportcore.OutportConfig{})
+		outportHandler, _ := NewOutport(time.Second, outportcore.OutportConfig{}, &testscommon.ChainHandlerStub{})
 		err := outportHandler.SubscribeDriver(driver)
 		assert.Nil(t, err)
 
@@ -654,7 +698,7 @@ func TestOutport_SettingsRequestAndReceive(t *testing.T) {
 		providedConfig := outportcore.OutportConfig{
 			IsInImportDBMode: true,
 		}
-		outportHandler, _ := NewOutport(time.Second, providedConfig)
+		outportHandler, _ := NewOutport(time.Second, providedConfig, &testscommon.ChainHandlerStub{})
 		err := outportHandler.SubscribeDriver(driver)
 		assert.Nil(t, err)
 		assert.True(t, outportHandler.HasDrivers())
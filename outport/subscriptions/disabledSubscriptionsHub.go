@@ -0,0 +1,23 @@
+package subscriptions
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+type disabledSubscriptionsHub struct {
+}
+
+// NewDisabledSubscriptionsHub returns a subscriptions hub that rejects all the subscriptions
+func NewDisabledSubscriptionsHub() *disabledSubscriptionsHub {
+	return &disabledSubscriptionsHub{}
+}
+
+// Subscribe returns ErrSubscriptionsDisabled
+func (hub *disabledSubscriptionsHub) Subscribe(_ common.SubscriptionFilter) (common.Subscription, error) {
+	return nil, ErrSubscriptionsDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hub *disabledSubscriptionsHub) IsInterfaceNil() bool {
+	return hub == nil
+}
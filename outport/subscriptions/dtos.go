@@ -0,0 +1,37 @@
+package subscriptions
+
+// BlockEvent holds the data streamed for a finalized block
+type BlockEvent struct {
+	Hash          string `json:"hash"`
+	Nonce         uint64 `json:"nonce"`
+	Round         uint64 `json:"round"`
+	Epoch         uint32 `json:"epoch"`
+	ShardID       uint32 `json:"shardID"`
+	Timestamp     uint64 `json:"timestamp"`
+	PrevHash      string `json:"prevHash"`
+	StateRootHash string `json:"stateRootHash"`
+	NumTxs        uint32 `json:"numTxs"`
+}
+
+// TransactionEvent holds the data streamed when the status of a transaction changes
+type TransactionEvent struct {
+	Hash       string `json:"hash"`
+	Nonce      uint64 `json:"nonce"`
+	Sender     string `json:"sender"`
+	Receiver   string `json:"receiver"`
+	Value      string `json:"value"`
+	Status     string `json:"status"`
+	BlockHash  string `json:"blockHash,omitempty"`
+	BlockNonce uint64 `json:"blockNonce,omitempty"`
+}
+
+// LogEvent holds the data streamed for a smart contract event
+type LogEvent struct {
+	TxHash     string   `json:"txHash"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+	BlockHash  string   `json:"blockHash"`
+	BlockNonce uint64   `json:"blockNonce"`
+}
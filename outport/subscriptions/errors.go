@@ -0,0 +1,42 @@
+package subscriptions
+
+import "errors"
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilBlockContainerHandler signals that a nil block container handler has been provided
+var ErrNilBlockContainerHandler = errors.New("nil block container handler")
+
+// ErrNilPubKeyConverter signals that a nil pub key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil pub key converter")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrInvalidMaxSubscribers signals that an invalid maximum number of subscribers has been provided
+var ErrInvalidMaxSubscribers = errors.New("invalid max subscribers")
+
+// ErrInvalidEventsBufferSize signals that an invalid events buffer size has been provided
+var ErrInvalidEventsBufferSize = errors.New("invalid events buffer size")
+
+// ErrTooManySubscribers signals that the maximum number of subscribers has been reached
+var ErrTooManySubscribers = errors.New("too many subscribers")
+
+// ErrUnknownEventType signals that an unknown event type has been requested
+var ErrUnknownEventType = errors.New("unknown event type")
+
+// ErrInvalidAddress signals that an invalid address has been provided in the filter
+var ErrInvalidAddress = errors.New("invalid address")
+
+// ErrNonceNotAvailable signals that the requested nonce is no longer held in the events history
+var ErrNonceNotAvailable = errors.New("nonce not available in the events history")
+
+// ErrSubscriberTooSlow signals that the subscriber did not consume the events fast enough and was dropped
+var ErrSubscriberTooSlow = errors.New("subscriber too slow, events buffer is full")
+
+// ErrSubscriptionClosed signals that the subscription has been closed
+var ErrSubscriptionClosed = errors.New("subscription closed")
+
+// ErrSubscriptionsDisabled signals that the subscriptions are not enabled on this node
+var ErrSubscriptionsDisabled = errors.New("subscriptions are disabled")
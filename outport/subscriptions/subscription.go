@@ -0,0 +1,167 @@
+package subscriptions
+
+import (
+	"context"
+	"sync"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// hubEvent is an event together with the fields the subscribers filter on
+type hubEvent struct {
+	event      *common.SubscriptionEvent
+	addresses  []string
+	identifier string
+}
+
+type eventsFilter struct {
+	eventTypes  map[string]struct{}
+	addresses   map[string]struct{}
+	identifiers map[string]struct{}
+}
+
+func (filter *eventsFilter) matches(event *hubEvent) bool {
+	if !containsOrEmpty(filter.eventTypes, event.event.Type) {
+		return false
+	}
+
+	switch event.event.Type {
+	case common.SubscriptionTransactionEvent:
+		return filter.matchesAddresses(event.addresses)
+	case common.SubscriptionLogEvent:
+		return filter.matchesAddresses(event.addresses) && containsOrEmpty(filter.identifiers, event.identifier)
+	default:
+		return true
+	}
+}
+
+func (filter *eventsFilter) matchesAddresses(addresses []string) bool {
+	if len(filter.addresses) == 0 {
+		return true
+	}
+
+	for _, address := range addresses {
+		_, found := filter.addresses[address]
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsOrEmpty(set map[string]struct{}, value string) bool {
+	if len(set) == 0 {
+		return true
+	}
+
+	_, found := set[value]
+	return found
+}
+
+type subscription struct {
+	id           uint64
+	filter       *eventsFilter
+	maxQueueSize int
+	onClose      func(id uint64)
+
+	mut      sync.Mutex
+	queue    []*common.SubscriptionEvent
+	closeErr error
+	chNotify chan struct{}
+}
+
+func newSubscription(id uint64, filter *eventsFilter, maxQueueSize int, onClose func(id uint64)) *subscription {
+	return &subscription{
+		id:           id,
+		filter:       filter,
+		maxQueueSize: maxQueueSize,
+		onClose:      onClose,
+		queue:        make([]*common.SubscriptionEvent, 0),
+		chNotify:     make(chan struct{}, 1),
+	}
+}
+
+// push queues the events matching the filter. If the queue size limit is enforced and the subscriber did not keep up,
+// the subscription is closed and false is returned
+func (sub *subscription) push(events []*hubEvent, enforceLimit bool) bool {
+	sub.mut.Lock()
+	defer sub.mut.Unlock()
+
+	if sub.closeErr != nil {
+		return false
+	}
+
+	numQueued := 0
+	for _, event := range events {
+		if !sub.filter.matches(event) {
+			continue
+		}
+
+		if enforceLimit && len(sub.queue) >= sub.maxQueueSize {
+			sub.closeErr = ErrSubscriberTooSlow
+			sub.notify()
+			return false
+		}
+
+		sub.queue = append(sub.queue, event.event)
+		numQueued++
+	}
+
+	if numQueued > 0 {
+		sub.notify()
+	}
+
+	return true
+}
+
+func (sub *subscription) notify() {
+	select {
+	case sub.chNotify <- struct{}{}:
+	default:
+	}
+}
+
+// closeWithError marks the subscription as closed. The events already queued can still be consumed
+func (sub *subscription) closeWithError(err error) {
+	sub.mut.Lock()
+	defer sub.mut.Unlock()
+
+	if sub.closeErr == nil {
+		sub.closeErr = err
+	}
+	sub.notify()
+}
+
+// NextEvents returns the queued events, blocking until new events are available, the context is done or the
+// subscription is closed
+func (sub *subscription) NextEvents(ctx context.Context) ([]*common.SubscriptionEvent, error) {
+	for {
+		sub.mut.Lock()
+		events := sub.queue
+		err := sub.closeErr
+		if len(events) > 0 {
+			sub.queue = make([]*common.SubscriptionEvent, 0)
+		}
+		sub.mut.Unlock()
+
+		if len(events) > 0 {
+			return events, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		select {
+		case <-sub.chNotify:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Close closes the subscription and removes it from the hub
+func (sub *subscription) Close() {
+	sub.closeWithError(ErrSubscriptionClosed)
+	sub.onClose(sub.id)
+}
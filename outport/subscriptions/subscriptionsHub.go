@@ -0,0 +1,581 @@
+package subscriptions
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/sharding"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("outport/subscriptions")
+
+// maxPendingBlocks bounds the number of blocks waiting to be finalized. The blocks get finalized within a few rounds, so
+// the limit is reached only if the finalization notifications are missed, in which case the oldest blocks are dropped
+const maxPendingBlocks = 100
+
+var knownEventTypes = map[string]struct{}{
+	common.SubscriptionBlockEvent:       {},
+	common.SubscriptionTransactionEvent: {},
+	common.SubscriptionLogEvent:         {},
+}
+
+// ArgsSubscriptionsHub holds the arguments needed to create a subscriptions hub
+type ArgsSubscriptionsHub struct {
+	Marshaller          marshal.Marshalizer
+	BlockContainer      BlockContainerHandler
+	AddressConverter    core.PubkeyConverter
+	ShardCoordinator    sharding.Coordinator
+	MaxSubscribers      uint32
+	EventsBufferSize    uint32
+	HistorySizeInBlocks uint32
+}
+
+// blockEvents holds the events generated by a block
+type blockEvents struct {
+	hash     []byte
+	prevHash []byte
+	nonce    uint64
+	events   []*hubEvent
+}
+
+// subscriptionsHub is an outport driver that streams the finalized blocks, the transactions status changes and the
+// smart contract events to the API subscribers. The events of a block are held until the block is finalized, and the
+// latest finalized blocks are kept in a history so that the subscribers are able to resume from a given nonce
+type subscriptionsHub struct {
+	marshaller          marshal.Marshalizer
+	blockContainer      BlockContainerHandler
+	addressConverter    core.PubkeyConverter
+	shardCoordinator    sharding.Coordinator
+	maxSubscribers      int
+	eventsBufferSize    int
+	historySizeInBlocks int
+
+	mut                sync.RWMutex
+	pendingBlocks      map[string]*blockEvents
+	history            []*blockEvents
+	hasFinalizedBlocks bool
+	lastFinalizedNonce uint64
+	subscriptions      map[uint64]*subscription
+	nextSubscriptionID uint64
+}
+
+// NewSubscriptionsHub creates a new subscriptions hub
+func NewSubscriptionsHub(args ArgsSubscriptionsHub) (*subscriptionsHub, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscriptionsHub{
+		marshaller:          args.Marshaller,
+		blockContainer:      args.BlockContainer,
+		addressConverter:    args.AddressConverter,
+		shardCoordinator:    args.ShardCoordinator,
+		maxSubscribers:      int(args.MaxSubscribers),
+		eventsBufferSize:    int(args.EventsBufferSize),
+		historySizeInBlocks: int(args.HistorySizeInBlocks),
+		pendingBlocks:       make(map[string]*blockEvents),
+		history:             make([]*blockEvents, 0, args.HistorySizeInBlocks),
+		subscriptions:       make(map[uint64]*subscription),
+	}, nil
+}
+
+func checkArgs(args ArgsSubscriptionsHub) error {
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if check.IfNilReflect(args.BlockContainer) {
+		return ErrNilBlockContainerHandler
+	}
+	if check.IfNil(args.AddressConverter) {
+		return ErrNilPubKeyConverter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if args.MaxSubscribers == 0 {
+		return ErrInvalidMaxSubscribers
+	}
+	if args.EventsBufferSize == 0 {
+		return ErrInvalidEventsBufferSize
+	}
+
+	return nil
+}
+
+// Subscribe creates a new subscription. If the filter holds a starting nonce, the events of the finalized blocks
+// starting with that nonce are queued first, as long as they are still held in the history
+func (hub *subscriptionsHub) Subscribe(filter common.SubscriptionFilter) (common.Subscription, error) {
+	eventsFilter, err := hub.createEventsFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	hub.mut.Lock()
+	defer hub.mut.Unlock()
+
+	if len(hub.subscriptions) >= hub.maxSubscribers {
+		return nil, fmt.Errorf("%w, maximum %d", ErrTooManySubscribers, hub.maxSubscribers)
+	}
+
+	sub := newSubscription(hub.nextSubscriptionID, eventsFilter, hub.eventsBufferSize, hub.unsubscribe)
+	if filter.HasFromNonce && hub.hasFinalizedBlocks && filter.FromNonce <= hub.lastFinalizedNonce {
+		oldestNonce := hub.lastFinalizedNonce + 1
+		if len(hub.history) > 0 {
+			oldestNonce = hub.history[0].nonce
+		}
+		if filter.FromNonce < oldestNonce {
+			return nil, fmt.Errorf("%w, requested nonce %d, oldest available %d", ErrNonceNotAvailable, filter.FromNonce, oldestNonce)
+		}
+
+		for _, blk := range hub.history {
+			if blk.nonce < filter.FromNonce {
+				continue
+			}
+
+			sub.push(blk.events, false)
+		}
+	}
+
+	hub.subscriptions[sub.id] = sub
+	hub.nextSubscriptionID++
+
+	return sub, nil
+}
+
+func (hub *subscriptionsHub) createEventsFilter(filter common.SubscriptionFilter) (*eventsFilter, error) {
+	result := &eventsFilter{
+		eventTypes:  make(map[string]struct{}),
+		addresses:   make(map[string]struct{}),
+		identifiers: make(map[string]struct{}),
+	}
+
+	for _, eventType := range filter.EventTypes {
+		_, isKnown := knownEventTypes[eventType]
+		if !isKnown {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownEventType, eventType)
+		}
+
+		result.eventTypes[eventType] = struct{}{}
+	}
+
+	for _, address := range filter.Addresses {
+		_, err := hub.addressConverter.Decode(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w %s: %s", ErrInvalidAddress, address, err.Error())
+		}
+
+		result.addresses[address] = struct{}{}
+	}
+
+	for _, identifier := range filter.Identifiers {
+		result.identifiers[identifier] = struct{}{}
+	}
+
+	return result, nil
+}
+
+func (hub *subscriptionsHub) unsubscribe(id uint64) {
+	hub.mut.Lock()
+	delete(hub.subscriptions, id)
+	hub.mut.Unlock()
+}
+
+// dispatch must be called under mutex protection
+func (hub *subscriptionsHub) dispatch(events []*hubEvent) {
+	for id, sub := range hub.subscriptions {
+		isActive := sub.push(events, true)
+		if !isActive {
+			log.Debug("subscriptionsHub: dropped subscriber", "id", id)
+			delete(hub.subscriptions, id)
+		}
+	}
+}
+
+// SaveBlock holds the events of the block until the block is finalized
+func (hub *subscriptionsHub) SaveBlock(outportBlock *outportcore.OutportBlock) error {
+	if outportBlock == nil || outportBlock.BlockData == nil {
+		return nil
+	}
+
+	blk, err := hub.createBlockEvents(outportBlock)
+	if err != nil {
+		// the error is not recoverable, so it is not returned in order to avoid the outport retrials
+		log.Warn("subscriptionsHub.SaveBlock: cannot create block events",
+			"hash", outportBlock.BlockData.HeaderHash,
+			"error", err)
+		return nil
+	}
+
+	hub.mut.Lock()
+	defer hub.mut.Unlock()
+
+	if hub.hasFinalizedBlocks && blk.nonce <= hub.lastFinalizedNonce {
+		// a block at or below the finalized nonce can not be finalized anymore
+		return nil
+	}
+
+	hub.pendingBlocks[string(blk.hash)] = blk
+	if len(hub.pendingBlocks) > maxPendingBlocks {
+		hub.dropOldestPendingBlock()
+	}
+
+	return nil
+}
+
+// dropOldestPendingBlock must be called under mutex protection
+func (hub *subscriptionsHub) dropOldestPendingBlock() {
+	var oldest *blockEvents
+	for _, blk := range hub.pendingBlocks {
+		if oldest == nil || blk.nonce < oldest.nonce {
+			oldest = blk
+		}
+	}
+	if oldest == nil {
+		return
+	}
+
+	log.Debug("subscriptionsHub: dropped not finalized block", "hash", oldest.hash, "nonce", oldest.nonce)
+	delete(hub.pendingBlocks, string(oldest.hash))
+}
+
+// RevertIndexedBlock drops the events of the reverted block
+func (hub *subscriptionsHub) RevertIndexedBlock(blockData *outportcore.BlockData) error {
+	if blockData == nil {
+		return nil
+	}
+
+	hub.mut.Lock()
+	delete(hub.pendingBlocks, string(blockData.HeaderHash))
+	hub.mut.Unlock()
+
+	return nil
+}
+
+// FinalizedBlock streams the events of the finalized block and of its not yet finalized ancestors
+func (hub *subscriptionsHub) FinalizedBlock(finalizedBlock *outportcore.FinalizedBlock) error {
+	if finalizedBlock == nil {
+		return nil
+	}
+
+	hub.mut.Lock()
+	defer hub.mut.Unlock()
+
+	finalBlock, found := hub.pendingBlocks[string(finalizedBlock.HeaderHash)]
+	if !found {
+		hub.prunePendingBlocksBelowChildOf(finalizedBlock.HeaderHash)
+		return nil
+	}
+
+	chain := make([]*blockEvents, 0)
+	for blk := finalBlock; blk != nil; blk = hub.pendingBlocks[string(blk.prevHash)] {
+		chain = append(chain, blk)
+	}
+	sort.Slice(chain, func(i, j int) bool {
+		return chain[i].nonce < chain[j].nonce
+	})
+
+	hub.prunePendingBlocks(finalBlock.nonce)
+
+	for _, blk := range chain {
+		if hub.hasFinalizedBlocks && blk.nonce <= hub.lastFinalizedNonce {
+			continue
+		}
+
+		hub.addToHistory(blk)
+		hub.dispatch(blk.events)
+		hub.hasFinalizedBlocks = true
+		hub.lastFinalizedNonce = blk.nonce
+	}
+
+	return nil
+}
+
+// prunePendingBlocksBelowChildOf drops the pending blocks at or below the nonce of the finalized block, when only its
+// child is held, e.g. the finalized block was saved before the hub got registered. Must be called under mutex protection
+func (hub *subscriptionsHub) prunePendingBlocksBelowChildOf(finalizedHash []byte) {
+	for _, blk := range hub.pendingBlocks {
+		if string(blk.prevHash) == string(finalizedHash) && blk.nonce > 0 {
+			hub.prunePendingBlocks(blk.nonce - 1)
+			return
+		}
+	}
+}
+
+// prunePendingBlocks must be called under mutex protection
+func (hub *subscriptionsHub) prunePendingBlocks(finalizedNonce uint64) {
+	for hash, blk := range hub.pendingBlocks {
+		if blk.nonce <= finalizedNonce {
+			delete(hub.pendingBlocks, hash)
+		}
+	}
+}
+
+func (hub *subscriptionsHub) addToHistory(blk *blockEvents) {
+	if hub.historySizeInBlocks == 0 {
+		return
+	}
+
+	if len(hub.history) >= hub.historySizeInBlocks {
+		hub.history = hub.history[1:]
+	}
+	hub.history = append(hub.history, blk)
+}
+
+// NewTransactionInPool streams the pending status of the transactions received in pool
+func (hub *subscriptionsHub) NewTransactionInPool(tx interface{}) error {
+	txInPool, ok := tx.(outport.NewTransactionInPool)
+	if !ok || txInPool.Transaction == nil {
+		return nil
+	}
+
+	txEvent := hub.createTransactionEvent(txInPool.TxHash, txInPool.Transaction, transaction.TxStatusPending)
+	event := &hubEvent{
+		event: &common.SubscriptionEvent{
+			Type:  common.SubscriptionTransactionEvent,
+			Nonce: txInPool.CurrentBlockNonce,
+			Data:  txEvent,
+		},
+		addresses: []string{txEvent.Sender, txEvent.Receiver},
+	}
+
+	hub.mut.Lock()
+	hub.dispatch([]*hubEvent{event})
+	hub.mut.Unlock()
+
+	return nil
+}
+
+func (hub *subscriptionsHub) createBlockEvents(outportBlock *outportcore.OutportBlock) (*blockEvents, error) {
+	blockData := outportBlock.BlockData
+	blockCreator, err := hub.blockContainer.Get(core.HeaderType(blockData.HeaderType))
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := block.GetHeaderFromBytes(hub.marshaller, blockCreator, blockData.HeaderBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHash := hex.EncodeToString(blockData.HeaderHash)
+	blk := &blockEvents{
+		hash:     blockData.HeaderHash,
+		prevHash: header.GetPrevHash(),
+		nonce:    header.GetNonce(),
+		events:   make([]*hubEvent, 0),
+	}
+
+	blk.events = append(blk.events, &hubEvent{
+		event: &common.SubscriptionEvent{
+			Type:  common.SubscriptionBlockEvent,
+			Nonce: header.GetNonce(),
+			Data:  createBlockEvent(blockHash, header),
+		},
+	})
+
+	pool := outportBlock.TransactionPool
+	if pool == nil {
+		return blk, nil
+	}
+
+	failedTxs := make(map[string]struct{})
+	logEvents := make([]*hubEvent, 0)
+	for _, logData := range pool.Logs {
+		if logData == nil || logData.Log == nil {
+			continue
+		}
+
+		for _, event := range logData.Log.Events {
+			if event == nil {
+				continue
+			}
+
+			identifier := string(event.Identifier)
+			if identifier == core.SignalErrorOperation {
+				failedTxs[logData.TxHash] = struct{}{}
+			}
+
+			address := hub.addressConverter.SilentEncode(event.Address, log)
+			logEvents = append(logEvents, &hubEvent{
+				event: &common.SubscriptionEvent{
+					Type:  common.SubscriptionLogEvent,
+					Nonce: header.GetNonce(),
+					Data: &LogEvent{
+						TxHash:     logData.TxHash,
+						Address:    address,
+						Identifier: identifier,
+						Topics:     event.Topics,
+						Data:       event.Data,
+						BlockHash:  blockHash,
+						BlockNonce: header.GetNonce(),
+					},
+				},
+				addresses:  []string{address},
+				identifier: identifier,
+			})
+		}
+	}
+
+	txEvents := make([]*hubEvent, 0, len(pool.Transactions)+len(pool.InvalidTxs))
+	for txHash, txInfo := range pool.Transactions {
+		if txInfo == nil || txInfo.Transaction == nil {
+			continue
+		}
+
+		txEvents = append(txEvents, hub.createExecutedTransactionEvent(txHash, txInfo, hub.computeStatus(txHash, txInfo.Transaction, failedTxs), blockHash, header))
+	}
+	for txHash, txInfo := range pool.InvalidTxs {
+		if txInfo == nil || txInfo.Transaction == nil {
+			continue
+		}
+
+		txEvents = append(txEvents, hub.createExecutedTransactionEvent(txHash, txInfo, transaction.TxStatusInvalid, blockHash, header))
+	}
+	sort.SliceStable(txEvents, func(i, j int) bool {
+		return txEvents[i].event.Data.(*TransactionEvent).Hash < txEvents[j].event.Data.(*TransactionEvent).Hash
+	})
+
+	blk.events = append(blk.events, txEvents...)
+	blk.events = append(blk.events, logEvents...)
+
+	return blk, nil
+}
+
+func (hub *subscriptionsHub) computeStatus(txHash string, tx *transaction.Transaction, failedTxs map[string]struct{}) transaction.TxStatus {
+	_, isFailed := failedTxs[txHash]
+	if isFailed {
+		return transaction.TxStatusFail
+	}
+
+	selfShardID := hub.shardCoordinator.SelfId()
+	isCrossShardFromMe := hub.shardCoordinator.ComputeId(tx.SndAddr) == selfShardID &&
+		hub.shardCoordinator.ComputeId(tx.RcvAddr) != selfShardID
+	if isCrossShardFromMe {
+		// executed on source shard only, the destination shard will stream the final status
+		return transaction.TxStatusPending
+	}
+
+	return transaction.TxStatusSuccess
+}
+
+func (hub *subscriptionsHub) createExecutedTransactionEvent(
+	txHash string,
+	txInfo *outportcore.TxInfo,
+	status transaction.TxStatus,
+	blockHash string,
+	header data.HeaderHandler,
+) *hubEvent {
+	hashBytes, err := hex.DecodeString(txHash)
+	if err != nil {
+		hashBytes = []byte(txHash)
+	}
+
+	txEvent := hub.createTransactionEvent(hashBytes, txInfo.Transaction, status)
+	txEvent.BlockHash = blockHash
+	txEvent.BlockNonce = header.GetNonce()
+
+	return &hubEvent{
+		event: &common.SubscriptionEvent{
+			Type:  common.SubscriptionTransactionEvent,
+			Nonce: header.GetNonce(),
+			Data:  txEvent,
+		},
+		addresses: []string{txEvent.Sender, txEvent.Receiver},
+	}
+}
+
+func (hub *subscriptionsHub) createTransactionEvent(txHash []byte, tx *transaction.Transaction, status transaction.TxStatus) *TransactionEvent {
+	value := "0"
+	if tx.Value != nil {
+		value = tx.Value.String()
+	}
+
+	return &TransactionEvent{
+		Hash:     hex.EncodeToString(txHash),
+		Nonce:    tx.Nonce,
+		Sender:   hub.addressConverter.SilentEncode(tx.SndAddr, log),
+		Receiver: hub.addressConverter.SilentEncode(tx.RcvAddr, log),
+		Value:    value,
+		Status:   string(status),
+	}
+}
+
+func createBlockEvent(blockHash string, header data.HeaderHandler) *BlockEvent {
+	return &BlockEvent{
+		Hash:          blockHash,
+		Nonce:         header.GetNonce(),
+		Round:         header.GetRound(),
+		Epoch:         header.GetEpoch(),
+		ShardID:       header.GetShardID(),
+		Timestamp:     header.GetTimeStamp(),
+		PrevHash:      hex.EncodeToString(header.GetPrevHash()),
+		StateRootHash: hex.EncodeToString(header.GetRootHash()),
+		NumTxs:        header.GetTxCount(),
+	}
+}
+
+// SaveRoundsInfo does nothing
+func (hub *subscriptionsHub) SaveRoundsInfo(_ *outportcore.RoundsInfo) error {
+	return nil
+}
+
+// SaveValidatorsPubKeys does nothing
+func (hub *subscriptionsHub) SaveValidatorsPubKeys(_ *outportcore.ValidatorsPubKeys) error {
+	return nil
+}
+
+// SaveValidatorsRating does nothing
+func (hub *subscriptionsHub) SaveValidatorsRating(_ *outportcore.ValidatorsRating) error {
+	return nil
+}
+
+// SaveAccounts does nothing
+func (hub *subscriptionsHub) SaveAccounts(_ *outportcore.Accounts) error {
+	return nil
+}
+
+// GetMarshaller returns the internal marshaller
+func (hub *subscriptionsHub) GetMarshaller() marshal.Marshalizer {
+	return hub.marshaller
+}
+
+// SetCurrentSettings does nothing
+func (hub *subscriptionsHub) SetCurrentSettings(_ outportcore.OutportConfig) error {
+	return nil
+}
+
+// RegisterHandler does nothing
+func (hub *subscriptionsHub) RegisterHandler(_ func() error, _ string) error {
+	return nil
+}
+
+// Close closes all the subscriptions
+func (hub *subscriptionsHub) Close() error {
+	hub.mut.Lock()
+	defer hub.mut.Unlock()
+
+	for id, sub := range hub.subscriptions {
+		sub.closeWithError(ErrSubscriptionClosed)
+		delete(hub.subscriptions, id)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hub *subscriptionsHub) IsInterfaceNil() bool {
+	return hub == nil
+}
@@ -0,0 +1,473 @@
+package subscriptions
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var marshaller = &marshallerMock.MarshalizerMock{}
+
+func createMockArgsSubscriptionsHub() ArgsSubscriptionsHub {
+	container := block.NewEmptyBlockCreatorsContainer()
+	_ = container.Add(core.ShardHeaderV1, block.NewEmptyHeaderCreator())
+
+	return ArgsSubscriptionsHub{
+		Marshaller:          marshaller,
+		BlockContainer:      container,
+		AddressConverter:    testscommon.RealWorldBech32PubkeyConverter,
+		ShardCoordinator:    testscommon.NewMultiShardsCoordinatorMock(1),
+		MaxSubscribers:      10,
+		EventsBufferSize:    100,
+		HistorySizeInBlocks: 3,
+	}
+}
+
+func createOutportBlock(nonce uint64, prevHash []byte, pool *outportcore.TransactionPool) *outportcore.OutportBlock {
+	header := &block.Header{
+		Nonce:    nonce,
+		Round:    nonce,
+		PrevHash: prevHash,
+	}
+	headerBytes, _ := marshaller.Marshal(header)
+
+	return &outportcore.OutportBlock{
+		BlockData: &outportcore.BlockData{
+			HeaderBytes: headerBytes,
+			HeaderType:  string(core.ShardHeaderV1),
+			HeaderHash:  blockHash(nonce),
+		},
+		TransactionPool: pool,
+	}
+}
+
+func blockHash(nonce uint64) []byte {
+	return []byte{byte(nonce), 'h'}
+}
+
+func saveAndFinalize(t *testing.T, hub *subscriptionsHub, fromNonce uint64, toNonce uint64) {
+	for nonce := fromNonce; nonce <= toNonce; nonce++ {
+		require.Nil(t, hub.SaveBlock(createOutportBlock(nonce, blockHash(nonce-1), nil)))
+		require.Nil(t, hub.FinalizedBlock(&outportcore.FinalizedBlock{HeaderHash: blockHash(nonce)}))
+	}
+}
+
+func nextEvents(t *testing.T, sub common.Subscription) []*common.SubscriptionEvent {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	events, err := sub.NextEvents(ctx)
+	require.Nil(t, err)
+
+	return events
+}
+
+func requireNoEvents(t *testing.T, sub common.Subscription) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+
+	events, err := sub.NextEvents(ctx)
+	require.Equal(t, context.DeadlineExceeded, err)
+	require.Empty(t, events)
+}
+
+func blockNonces(events []*common.SubscriptionEvent) []uint64 {
+	nonces := make([]uint64, 0, len(events))
+	for _, event := range events {
+		if event.Type == common.SubscriptionBlockEvent {
+			nonces = append(nonces, event.Nonce)
+		}
+	}
+
+	return nonces
+}
+
+func TestNewSubscriptionsHub(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.Marshaller = nil
+		hub, err := NewSubscriptionsHub(args)
+		require.Nil(t, hub)
+		require.Equal(t, ErrNilMarshaller, err)
+	})
+	t.Run("nil block container should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.BlockContainer = nil
+		hub, err := NewSubscriptionsHub(args)
+		require.Nil(t, hub)
+		require.Equal(t, ErrNilBlockContainerHandler, err)
+	})
+	t.Run("nil address converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.AddressConverter = nil
+		hub, err := NewSubscriptionsHub(args)
+		require.Nil(t, hub)
+		require.Equal(t, ErrNilPubKeyConverter, err)
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.ShardCoordinator = nil
+		hub, err := NewSubscriptionsHub(args)
+		require.Nil(t, hub)
+		require.Equal(t, ErrNilShardCoordinator, err)
+	})
+	t.Run("invalid max subscribers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.MaxSubscribers = 0
+		hub, err := NewSubscriptionsHub(args)
+		require.Nil(t, hub)
+		require.Equal(t, ErrInvalidMaxSubscribers, err)
+	})
+	t.Run("invalid events buffer size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.EventsBufferSize = 0
+		hub, err := NewSubscriptionsHub(args)
+		require.Nil(t, hub)
+		require.Equal(t, ErrInvalidEventsBufferSize, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hub, err := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		require.Nil(t, err)
+		require.False(t, hub.IsInterfaceNil())
+		require.Equal(t, marshaller, hub.GetMarshaller())
+	})
+}
+
+func TestSubscriptionsHub_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown event type should error", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		sub, err := hub.Subscribe(common.SubscriptionFilter{EventTypes: []string{"unknown"}})
+		require.Nil(t, sub)
+		require.True(t, errors.Is(err, ErrUnknownEventType))
+	})
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		sub, err := hub.Subscribe(common.SubscriptionFilter{Addresses: []string{"invalid"}})
+		require.Nil(t, sub)
+		require.True(t, errors.Is(err, ErrInvalidAddress))
+	})
+	t.Run("too many subscribers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.MaxSubscribers = 1
+		hub, _ := NewSubscriptionsHub(args)
+
+		sub, err := hub.Subscribe(common.SubscriptionFilter{})
+		require.Nil(t, err)
+
+		_, err = hub.Subscribe(common.SubscriptionFilter{})
+		require.True(t, errors.Is(err, ErrTooManySubscribers))
+
+		sub.Close()
+		_, err = hub.Subscribe(common.SubscriptionFilter{})
+		require.Nil(t, err)
+	})
+	t.Run("nonce older than the history should error", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		saveAndFinalize(t, hub, 1, 5)
+
+		sub, err := hub.Subscribe(common.SubscriptionFilter{FromNonce: 2, HasFromNonce: true})
+		require.Nil(t, sub)
+		require.True(t, errors.Is(err, ErrNonceNotAvailable))
+	})
+	t.Run("should resume from nonce", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		saveAndFinalize(t, hub, 1, 5)
+
+		sub, err := hub.Subscribe(common.SubscriptionFilter{FromNonce: 4, HasFromNonce: true})
+		require.Nil(t, err)
+		require.Equal(t, []uint64{4, 5}, blockNonces(nextEvents(t, sub)))
+
+		saveAndFinalize(t, hub, 6, 6)
+		require.Equal(t, []uint64{6}, blockNonces(nextEvents(t, sub)))
+	})
+	t.Run("nonce in the future should only stream the new blocks", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		saveAndFinalize(t, hub, 1, 2)
+
+		sub, err := hub.Subscribe(common.SubscriptionFilter{FromNonce: 10, HasFromNonce: true})
+		require.Nil(t, err)
+		requireNoEvents(t, sub)
+
+		saveAndFinalize(t, hub, 3, 3)
+		require.Equal(t, []uint64{3}, blockNonces(nextEvents(t, sub)))
+	})
+}
+
+func TestSubscriptionsHub_FinalizedBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown block should not stream", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		sub, _ := hub.Subscribe(common.SubscriptionFilter{})
+
+		require.Nil(t, hub.FinalizedBlock(&outportcore.FinalizedBlock{HeaderHash: []byte("missing")}))
+		requireNoEvents(t, sub)
+	})
+	t.Run("should stream the not finalized ancestors in order", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		sub, _ := hub.Subscribe(common.SubscriptionFilter{})
+
+		for nonce := uint64(1); nonce <= 3; nonce++ {
+			require.Nil(t, hub.SaveBlock(createOutportBlock(nonce, blockHash(nonce-1), nil)))
+		}
+		requireNoEvents(t, sub)
+
+		require.Nil(t, hub.FinalizedBlock(&outportcore.FinalizedBlock{HeaderHash: blockHash(2)}))
+		require.Equal(t, []uint64{1, 2}, blockNonces(nextEvents(t, sub)))
+
+		require.Nil(t, hub.FinalizedBlock(&outportcore.FinalizedBlock{HeaderHash: blockHash(3)}))
+		require.Equal(t, []uint64{3}, blockNonces(nextEvents(t, sub)))
+	})
+	t.Run("reverted block should not stream", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		sub, _ := hub.Subscribe(common.SubscriptionFilter{})
+
+		require.Nil(t, hub.SaveBlock(createOutportBlock(1, blockHash(0), nil)))
+		require.Nil(t, hub.RevertIndexedBlock(&outportcore.BlockData{HeaderHash: blockHash(1)}))
+		require.Nil(t, hub.FinalizedBlock(&outportcore.FinalizedBlock{HeaderHash: blockHash(1)}))
+		requireNoEvents(t, sub)
+	})
+	t.Run("finalized block not held should prune the pending blocks below its child", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		sub, _ := hub.Subscribe(common.SubscriptionFilter{})
+
+		require.Nil(t, hub.SaveBlock(createOutportBlock(1, blockHash(0), nil)))
+		require.Nil(t, hub.SaveBlock(createOutportBlock(3, blockHash(2), nil)))
+		require.Nil(t, hub.FinalizedBlock(&outportcore.FinalizedBlock{HeaderHash: blockHash(2)}))
+		requireNoEvents(t, sub)
+
+		hub.mut.RLock()
+		require.Equal(t, 1, len(hub.pendingBlocks))
+		require.NotNil(t, hub.pendingBlocks[string(blockHash(3))])
+		hub.mut.RUnlock()
+	})
+	t.Run("pending blocks should be capped", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		for nonce := uint64(1); nonce <= maxPendingBlocks+10; nonce++ {
+			require.Nil(t, hub.SaveBlock(createOutportBlock(nonce, blockHash(nonce-1), nil)))
+		}
+
+		hub.mut.RLock()
+		require.Equal(t, maxPendingBlocks, len(hub.pendingBlocks))
+		require.Nil(t, hub.pendingBlocks[string(blockHash(10))])
+		require.NotNil(t, hub.pendingBlocks[string(blockHash(11))])
+		hub.mut.RUnlock()
+	})
+	t.Run("block below the finalized nonce should not be held", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		saveAndFinalize(t, hub, 1, 3)
+		require.Nil(t, hub.SaveBlock(createOutportBlock(2, []byte("fork"), nil)))
+
+		hub.mut.RLock()
+		require.Empty(t, hub.pendingBlocks)
+		hub.mut.RUnlock()
+	})
+	t.Run("slow subscriber should be dropped", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.EventsBufferSize = 2
+		hub, _ := NewSubscriptionsHub(args)
+		sub, _ := hub.Subscribe(common.SubscriptionFilter{})
+
+		saveAndFinalize(t, hub, 1, 3)
+
+		require.Equal(t, []uint64{1, 2}, blockNonces(nextEvents(t, sub)))
+		events, err := sub.NextEvents(context.Background())
+		require.Empty(t, events)
+		require.Equal(t, ErrSubscriberTooSlow, err)
+
+		hub.mut.RLock()
+		require.Empty(t, hub.subscriptions)
+		hub.mut.RUnlock()
+	})
+}
+
+func TestSubscriptionsHub_TransactionsAndLogs(t *testing.T) {
+	t.Parallel()
+
+	txHashOk := hex.EncodeToString([]byte("txOk"))
+	txHashFailed := hex.EncodeToString([]byte("txFailed"))
+	txHashInvalid := hex.EncodeToString([]byte("txInvalid"))
+	pool := &outportcore.TransactionPool{
+		Transactions: map[string]*outportcore.TxInfo{
+			txHashOk: {
+				Transaction: &transaction.Transaction{Nonce: 1, SndAddr: testscommon.TestPubKeyAlice, RcvAddr: testscommon.TestPubKeyBob, Value: big.NewInt(10)},
+			},
+			txHashFailed: {
+				Transaction: &transaction.Transaction{Nonce: 2, SndAddr: testscommon.TestPubKeyAlice, RcvAddr: testscommon.TestPubKeyAlice},
+			},
+		},
+		InvalidTxs: map[string]*outportcore.TxInfo{
+			txHashInvalid: {
+				Transaction: &transaction.Transaction{Nonce: 3, SndAddr: testscommon.TestPubKeyBob, RcvAddr: testscommon.TestPubKeyBob},
+			},
+		},
+		Logs: []*outportcore.LogData{
+			{
+				TxHash: txHashFailed,
+				Log: &transaction.Log{
+					Events: []*transaction.Event{
+						{Address: testscommon.TestPubKeyAlice, Identifier: []byte(core.SignalErrorOperation)},
+					},
+				},
+			},
+			{
+				TxHash: txHashOk,
+				Log: &transaction.Log{
+					Events: []*transaction.Event{
+						{Address: testscommon.TestPubKeyBob, Identifier: []byte("transfer"), Topics: [][]byte{[]byte("topic")}},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("should compute the transactions status", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		sub, _ := hub.Subscribe(common.SubscriptionFilter{EventTypes: []string{common.SubscriptionTransactionEvent}})
+
+		require.Nil(t, hub.SaveBlock(createOutportBlock(1, blockHash(0), pool)))
+		require.Nil(t, hub.FinalizedBlock(&outportcore.FinalizedBlock{HeaderHash: blockHash(1)}))
+
+		statuses := make(map[string]string)
+		for _, event := range nextEvents(t, sub) {
+			txEvent := event.Data.(*TransactionEvent)
+			statuses[txEvent.Hash] = txEvent.Status
+			assert.Equal(t, hex.EncodeToString(blockHash(1)), txEvent.BlockHash)
+		}
+
+		expectedStatuses := map[string]string{
+			txHashOk:      string(transaction.TxStatusSuccess),
+			txHashFailed:  string(transaction.TxStatusFail),
+			txHashInvalid: string(transaction.TxStatusInvalid),
+		}
+		require.Equal(t, expectedStatuses, statuses)
+	})
+	t.Run("should filter by address", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		sub, _ := hub.Subscribe(common.SubscriptionFilter{
+			EventTypes: []string{common.SubscriptionTransactionEvent},
+			Addresses:  []string{testscommon.TestAddressBob},
+		})
+
+		require.Nil(t, hub.SaveBlock(createOutportBlock(1, blockHash(0), pool)))
+		require.Nil(t, hub.FinalizedBlock(&outportcore.FinalizedBlock{HeaderHash: blockHash(1)}))
+
+		events := nextEvents(t, sub)
+		require.Len(t, events, 2)
+		require.Equal(t, txHashInvalid, events[0].Data.(*TransactionEvent).Hash)
+		require.Equal(t, txHashOk, events[1].Data.(*TransactionEvent).Hash)
+	})
+	t.Run("should filter the logs by address and identifier", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		sub, _ := hub.Subscribe(common.SubscriptionFilter{
+			EventTypes:  []string{common.SubscriptionLogEvent},
+			Addresses:   []string{testscommon.TestAddressBob},
+			Identifiers: []string{"transfer"},
+		})
+
+		require.Nil(t, hub.SaveBlock(createOutportBlock(1, blockHash(0), pool)))
+		require.Nil(t, hub.FinalizedBlock(&outportcore.FinalizedBlock{HeaderHash: blockHash(1)}))
+
+		events := nextEvents(t, sub)
+		require.Len(t, events, 1)
+		logEvent := events[0].Data.(*LogEvent)
+		require.Equal(t, txHashOk, logEvent.TxHash)
+		require.Equal(t, testscommon.TestAddressBob, logEvent.Address)
+		require.Equal(t, [][]byte{[]byte("topic")}, logEvent.Topics)
+	})
+	t.Run("new transaction in pool should stream pending status", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		sub, _ := hub.Subscribe(common.SubscriptionFilter{Addresses: []string{testscommon.TestAddressAlice}})
+
+		require.Nil(t, hub.NewTransactionInPool(outport.NewTransactionInPool{
+			TxHash:            []byte("txPending"),
+			Transaction:       &transaction.Transaction{SndAddr: testscommon.TestPubKeyAlice, RcvAddr: testscommon.TestPubKeyBob},
+			CurrentBlockNonce: 7,
+		}))
+		require.Nil(t, hub.NewTransactionInPool("not a transaction"))
+
+		events := nextEvents(t, sub)
+		require.Len(t, events, 1)
+		require.Equal(t, uint64(7), events[0].Nonce)
+		require.Equal(t, string(transaction.TxStatusPending), events[0].Data.(*TransactionEvent).Status)
+	})
+}
+
+func TestSubscriptionsHub_Close(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+	sub, _ := hub.Subscribe(common.SubscriptionFilter{})
+
+	require.Nil(t, hub.Close())
+
+	events, err := sub.NextEvents(context.Background())
+	require.Empty(t, events)
+	require.Equal(t, ErrSubscriptionClosed, err)
+}
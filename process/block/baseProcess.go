@@ -1700,12 +1700,13 @@ func (bp *baseProcessor) requestMiniBlocksIfNeeded(headerHandler data.HeaderHand
 }
 
 func (bp *baseProcessor) recordBlockInHistory(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler) {
+	txsFromPool := bp.getAllCurrentUsedTxs(block.TxBlock, block.InvalidBlock, block.RewardsBlock)
 	scrResultsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.SmartContractResultBlock)
 	receiptsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.ReceiptBlock)
 	logs := bp.txCoordinator.GetAllCurrentLogs()
 	intraMiniBlocks := bp.txCoordinator.GetCreatedInShardMiniBlocks()
 
-	err := bp.historyRepo.RecordBlock(blockHeaderHash, blockHeader, blockBody, scrResultsFromPool, receiptsFromPool, intraMiniBlocks, logs)
+	err := bp.historyRepo.RecordBlock(blockHeaderHash, blockHeader, blockBody, txsFromPool, scrResultsFromPool, receiptsFromPool, intraMiniBlocks, logs)
 	if err != nil {
 		logLevel := logger.LogError
 		if core.IsClosingError(err) {
@@ -1715,6 +1716,17 @@ func (bp *baseProcessor) recordBlockInHistory(blockHeaderHash []byte, blockHeade
 	}
 }
 
+func (bp *baseProcessor) getAllCurrentUsedTxs(blockTypes ...block.Type) map[string]data.TransactionHandler {
+	txs := make(map[string]data.TransactionHandler)
+	for _, blockType := range blockTypes {
+		for txHash, tx := range bp.txCoordinator.GetAllCurrentUsedTxs(blockType) {
+			txs[txHash] = tx
+		}
+	}
+
+	return txs
+}
+
 func (bp *baseProcessor) addHeaderIntoTrackerPool(nonce uint64, shardID uint32) {
 	headersPool := bp.dataPool.Headers()
 	headers, hashes, err := headersPool.GetHeadersByNonceAndShardId(nonce, shardID)
@@ -13,6 +13,14 @@ type SortedTransactionsProvider interface {
 	IsInterfaceNil() bool
 }
 
+// TxSelectionStrategy defines the policy used by the proposer when selecting the pool transactions into the miniblocks
+// created from self shard. The selected transactions must be sorted by sender and nonce, as the validators check the
+// proposed block in this order
+type TxSelectionStrategy interface {
+	SelectTransactions(candidates []*txcache.WrappedTransaction, gasBandwidth uint64, randomness []byte) ([]*txcache.WrappedTransaction, []*txcache.WrappedTransaction)
+	IsInterfaceNil() bool
+}
+
 // TxCache defines the functionality for the transactions cache
 type TxCache interface {
 	SelectTransactionsWithBandwidth(numRequested int, batchSizePerSender int, bandwidthPerSender uint64) []*txcache.WrappedTransaction
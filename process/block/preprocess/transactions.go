@@ -19,6 +19,7 @@ import (
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/block/helpers"
@@ -63,6 +64,7 @@ type transactions struct {
 	emptyAddress                 []byte
 	txTypeHandler                process.TxTypeHandler
 	scheduledTxsExecutionHandler process.ScheduledTxsExecutionHandler
+	txSelectionStrategy          TxSelectionStrategy
 }
 
 // ArgsTransactionPreProcessor holds the arguments to create a txs pre processor
@@ -87,6 +89,7 @@ type ArgsTransactionPreProcessor struct {
 	ScheduledTxsExecutionHandler process.ScheduledTxsExecutionHandler
 	ProcessedMiniBlocksTracker   process.ProcessedMiniBlocksTracker
 	TxExecutionOrderHandler      common.TxExecutionOrderHandler
+	TxSelectionConfig            config.TxSelectionStrategyConfig
 }
 
 // NewTransactionPreprocessor creates a new transaction preprocessor object
@@ -200,6 +203,11 @@ func NewTransactionPreprocessor(
 
 	txs.emptyAddress = make([]byte, txs.pubkeyConverter.Len())
 
+	txs.txSelectionStrategy, err = createTxSelectionStrategy(args.TxSelectionConfig, txs, txs.txPool, txs.pubkeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
 	return txs, nil
 }
 
@@ -1467,8 +1475,7 @@ func (txs *transactions) computeSortedTxs(
 	sortedTxs := sortedTransactionsProvider.GetSortedTransactions()
 
 	// TODO: this could be moved to SortedTransactionsProvider
-	selectedTxs, remainingTxs := txs.preFilterTransactionsWithMoveBalancePriority(sortedTxs, gasBandwidth)
-	txs.sortTransactionsBySenderAndNonce(selectedTxs, randomness)
+	selectedTxs, remainingTxs := txs.txSelectionStrategy.SelectTransactions(sortedTxs, gasBandwidth, randomness)
 
 	return selectedTxs, remainingTxs, nil
 }
@@ -0,0 +1,390 @@
+package preprocess
+
+import (
+	"container/list"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/storage/txcache"
+)
+
+const (
+	// DefaultTxSelectionStrategy prioritizes the move balance transactions, then fills the remaining gas bandwidth
+	DefaultTxSelectionStrategy = "default"
+	// FIFOTxSelectionStrategy selects the transactions in the order they were received by the node
+	FIFOTxSelectionStrategy = "fifo"
+	// SenderFairnessTxSelectionStrategy behaves as the default strategy, but caps the number of transactions selected
+	// for each sender
+	SenderFairnessTxSelectionStrategy = "sender-fairness"
+	// PriorityLanesTxSelectionStrategy selects the transactions of the senders calling the whitelisted contracts before
+	// all the others
+	PriorityLanesTxSelectionStrategy = "priority-lanes"
+)
+
+// txSelectionHelper holds the selection primitives of the transactions pre-processor, used by all the strategies
+type txSelectionHelper interface {
+	preFilterTransactionsWithMoveBalancePriority(transactions []*txcache.WrappedTransaction, gasBandwidth uint64) ([]*txcache.WrappedTransaction, []*txcache.WrappedTransaction)
+	prefilterTransactions(initialTxs []*txcache.WrappedTransaction, additionalTxs []*txcache.WrappedTransaction, initialTxsGasEstimation uint64, gasBandwidth uint64) ([]*txcache.WrappedTransaction, []*txcache.WrappedTransaction)
+	sortTransactionsBySenderAndNonce(transactions []*txcache.WrappedTransaction, randomness []byte)
+}
+
+// createTxSelectionStrategy is a "simple factory" for "TxSelectionStrategy" objects
+func createTxSelectionStrategy(
+	cfg config.TxSelectionStrategyConfig,
+	helper txSelectionHelper,
+	txPool dataRetriever.ShardedDataCacherNotifier,
+	pubkeyConverter core.PubkeyConverter,
+) (TxSelectionStrategy, error) {
+	switch cfg.Type {
+	case "", DefaultTxSelectionStrategy:
+		return &defaultTxSelectionStrategy{helper: helper}, nil
+	case FIFOTxSelectionStrategy:
+		return newFIFOTxSelectionStrategy(helper, txPool, cfg.MaxTrackedTransactions)
+	case SenderFairnessTxSelectionStrategy:
+		return newSenderFairnessTxSelectionStrategy(helper, cfg.MaxTxsPerSender)
+	case PriorityLanesTxSelectionStrategy:
+		return newPriorityLanesTxSelectionStrategy(helper, pubkeyConverter, cfg.PriorityReceivers)
+	default:
+		return nil, fmt.Errorf("%w, unknown type %s", process.ErrInvalidTxSelectionStrategy, cfg.Type)
+	}
+}
+
+// defaultTxSelectionStrategy prioritizes the move balance transactions and sorts the selected ones by sender and nonce
+type defaultTxSelectionStrategy struct {
+	helper txSelectionHelper
+}
+
+// SelectTransactions returns the transactions fitting the gas bandwidth, move balance transactions first, and the
+// remaining ones
+func (strategy *defaultTxSelectionStrategy) SelectTransactions(
+	candidates []*txcache.WrappedTransaction,
+	gasBandwidth uint64,
+	randomness []byte,
+) ([]*txcache.WrappedTransaction, []*txcache.WrappedTransaction) {
+	selectedTxs, remainingTxs := strategy.helper.preFilterTransactionsWithMoveBalancePriority(candidates, gasBandwidth)
+	strategy.helper.sortTransactionsBySenderAndNonce(selectedTxs, randomness)
+
+	return selectedTxs, remainingTxs
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (strategy *defaultTxSelectionStrategy) IsInterfaceNil() bool {
+	return strategy == nil
+}
+
+// numTrackedTxsCheckedOnArrival is the number of tracked transactions checked against the pool on each arrival, higher
+// than one so the removed transactions are pruned faster than new ones are tracked
+const numTrackedTxsCheckedOnArrival = 2
+
+type trackedArrival struct {
+	txHash       []byte
+	arrivalIndex uint64
+}
+
+// fifoTxSelectionStrategy selects the transactions in the order they were received by the node. The arrival order
+// can not be verified by the other nodes, so the selected transactions are still sorted by sender and nonce
+type fifoTxSelectionStrategy struct {
+	helper           txSelectionHelper
+	txPool           dataRetriever.ShardedDataCacherNotifier
+	mutArrivals      sync.RWMutex
+	arrivals         map[string]*list.Element
+	arrivalsOrder    *list.List
+	pruneCursor      *list.Element
+	nextArrivalIndex uint64
+	maxTrackedTxs    uint64
+}
+
+func newFIFOTxSelectionStrategy(
+	helper txSelectionHelper,
+	txPool dataRetriever.ShardedDataCacherNotifier,
+	maxTrackedTxs uint32,
+) (*fifoTxSelectionStrategy, error) {
+	if maxTrackedTxs == 0 {
+		return nil, fmt.Errorf("%w, invalid max tracked transactions for the %s strategy", process.ErrInvalidTxSelectionStrategy, FIFOTxSelectionStrategy)
+	}
+
+	strategy := &fifoTxSelectionStrategy{
+		helper:        helper,
+		txPool:        txPool,
+		arrivals:      make(map[string]*list.Element),
+		arrivalsOrder: list.New(),
+		maxTrackedTxs: uint64(maxTrackedTxs),
+	}
+	txPool.RegisterOnAdded(strategy.receivedTransaction)
+
+	return strategy, nil
+}
+
+// receivedTransaction records the arrival order of the transaction. The pool does not notify the removed or evicted
+// transactions, so a few tracked transactions are checked against the pool on each arrival and the ones no longer
+// there are forgotten. When the limit is still reached, the oldest tracked transaction is forgotten
+func (strategy *fifoTxSelectionStrategy) receivedTransaction(key []byte, _ interface{}) {
+	strategy.mutArrivals.Lock()
+	defer strategy.mutArrivals.Unlock()
+
+	_, isTracked := strategy.arrivals[string(key)]
+	if isTracked {
+		return
+	}
+
+	strategy.pruneRemovedTransactions()
+	if uint64(strategy.arrivalsOrder.Len()) >= strategy.maxTrackedTxs {
+		strategy.removeArrival(strategy.arrivalsOrder.Front())
+	}
+
+	strategy.arrivals[string(key)] = strategy.arrivalsOrder.PushBack(&trackedArrival{
+		txHash:       key,
+		arrivalIndex: strategy.nextArrivalIndex,
+	})
+	strategy.nextArrivalIndex++
+}
+
+// pruneRemovedTransactions checks the next tracked transactions, cycling through all of them across the calls, and
+// forgets the ones removed from the pool
+func (strategy *fifoTxSelectionStrategy) pruneRemovedTransactions() {
+	for i := 0; i < numTrackedTxsCheckedOnArrival && strategy.arrivalsOrder.Len() > 0; i++ {
+		if strategy.pruneCursor == nil {
+			strategy.pruneCursor = strategy.arrivalsOrder.Front()
+		}
+
+		element := strategy.pruneCursor
+		strategy.pruneCursor = element.Next()
+
+		arrival := element.Value.(*trackedArrival)
+		_, isInPool := strategy.txPool.SearchFirstData(arrival.txHash)
+		if !isInPool {
+			strategy.removeArrival(element)
+		}
+	}
+}
+
+func (strategy *fifoTxSelectionStrategy) removeArrival(element *list.Element) {
+	if element == strategy.pruneCursor {
+		strategy.pruneCursor = element.Next()
+	}
+
+	arrival := strategy.arrivalsOrder.Remove(element).(*trackedArrival)
+	delete(strategy.arrivals, string(arrival.txHash))
+}
+
+// SelectTransactions returns the earliest received transactions fitting the gas bandwidth and the remaining ones
+func (strategy *fifoTxSelectionStrategy) SelectTransactions(
+	candidates []*txcache.WrappedTransaction,
+	gasBandwidth uint64,
+	randomness []byte,
+) ([]*txcache.WrappedTransaction, []*txcache.WrappedTransaction) {
+	orderedTxs := strategy.orderByArrival(candidates)
+	selectedTxs, remainingTxs := strategy.helper.prefilterTransactions(nil, orderedTxs, 0, gasBandwidth)
+	strategy.helper.sortTransactionsBySenderAndNonce(selectedTxs, randomness)
+
+	return selectedTxs, remainingTxs
+}
+
+// orderByArrival returns the transactions ordered by their arrival, while keeping the transactions of each sender in
+// the nonce order. The transactions not tracked are placed at the end
+func (strategy *fifoTxSelectionStrategy) orderByArrival(candidates []*txcache.WrappedTransaction) []*txcache.WrappedTransaction {
+	type txWithArrival struct {
+		tx           *txcache.WrappedTransaction
+		arrivalIndex uint64
+	}
+
+	txsWithArrival := make([]txWithArrival, 0, len(candidates))
+	strategy.mutArrivals.RLock()
+	for _, tx := range candidates {
+		arrivalIndex := uint64(math.MaxUint64)
+		element, isTracked := strategy.arrivals[string(tx.TxHash)]
+		if isTracked {
+			arrivalIndex = element.Value.(*trackedArrival).arrivalIndex
+		}
+		txsWithArrival = append(txsWithArrival, txWithArrival{tx: tx, arrivalIndex: arrivalIndex})
+	}
+	strategy.mutArrivals.RUnlock()
+
+	sort.SliceStable(txsWithArrival, func(i, j int) bool {
+		return txsWithArrival[i].arrivalIndex < txsWithArrival[j].arrivalIndex
+	})
+
+	orderedTxs := make([]*txcache.WrappedTransaction, 0, len(candidates))
+	for _, item := range txsWithArrival {
+		orderedTxs = append(orderedTxs, item.tx)
+	}
+
+	// a transaction with a higher nonce might have arrived before the previous one of the same sender, so the
+	// positions taken by each sender are filled with its transactions in the nonce order
+	positionsBySender := make(map[string][]int)
+	txsBySender := make(map[string][]*txcache.WrappedTransaction)
+	for position, tx := range orderedTxs {
+		sender := string(tx.Tx.GetSndAddr())
+		positionsBySender[sender] = append(positionsBySender[sender], position)
+		txsBySender[sender] = append(txsBySender[sender], tx)
+	}
+
+	for sender, senderTxs := range txsBySender {
+		sort.SliceStable(senderTxs, func(i, j int) bool {
+			return senderTxs[i].Tx.GetNonce() < senderTxs[j].Tx.GetNonce()
+		})
+
+		for idx, position := range positionsBySender[sender] {
+			orderedTxs[position] = senderTxs[idx]
+		}
+	}
+
+	return orderedTxs
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (strategy *fifoTxSelectionStrategy) IsInterfaceNil() bool {
+	return strategy == nil
+}
+
+// senderFairnessTxSelectionStrategy behaves as the default strategy, but selects at most a fixed number of
+// transactions for each sender
+type senderFairnessTxSelectionStrategy struct {
+	*defaultTxSelectionStrategy
+	maxTxsPerSender uint32
+}
+
+func newSenderFairnessTxSelectionStrategy(helper txSelectionHelper, maxTxsPerSender uint32) (*senderFairnessTxSelectionStrategy, error) {
+	if maxTxsPerSender == 0 {
+		return nil, fmt.Errorf("%w, invalid max transactions per sender for the %s strategy", process.ErrInvalidTxSelectionStrategy, SenderFairnessTxSelectionStrategy)
+	}
+
+	return &senderFairnessTxSelectionStrategy{
+		defaultTxSelectionStrategy: &defaultTxSelectionStrategy{helper: helper},
+		maxTxsPerSender:            maxTxsPerSender,
+	}, nil
+}
+
+// SelectTransactions returns the transactions fitting the gas bandwidth, at most the configured number for each
+// sender, and the remaining ones
+func (strategy *senderFairnessTxSelectionStrategy) SelectTransactions(
+	candidates []*txcache.WrappedTransaction,
+	gasBandwidth uint64,
+	randomness []byte,
+) ([]*txcache.WrappedTransaction, []*txcache.WrappedTransaction) {
+	cappedTxs := make([]*txcache.WrappedTransaction, 0, len(candidates))
+	numTxsBySender := make(map[string]uint32)
+	for _, tx := range candidates {
+		sender := string(tx.Tx.GetSndAddr())
+		if numTxsBySender[sender] >= strategy.maxTxsPerSender {
+			continue
+		}
+
+		numTxsBySender[sender]++
+		cappedTxs = append(cappedTxs, tx)
+	}
+
+	return strategy.defaultTxSelectionStrategy.SelectTransactions(cappedTxs, gasBandwidth, randomness)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (strategy *senderFairnessTxSelectionStrategy) IsInterfaceNil() bool {
+	return strategy == nil
+}
+
+// priorityLanesTxSelectionStrategy selects the transactions of the senders whose first transaction calls one of the
+// whitelisted contracts before all the others. The lane is decided for the whole sender, so the transactions of a
+// sender remain in the nonce order. The selected transactions are still sorted by sender and nonce, as the validators
+// expect them in this order
+type priorityLanesTxSelectionStrategy struct {
+	helper            txSelectionHelper
+	priorityReceivers map[string]struct{}
+}
+
+func newPriorityLanesTxSelectionStrategy(
+	helper txSelectionHelper,
+	pubkeyConverter core.PubkeyConverter,
+	priorityReceivers []string,
+) (*priorityLanesTxSelectionStrategy, error) {
+	if len(priorityReceivers) == 0 {
+		return nil, fmt.Errorf("%w, no priority receivers provided for the %s strategy", process.ErrInvalidTxSelectionStrategy, PriorityLanesTxSelectionStrategy)
+	}
+
+	strategy := &priorityLanesTxSelectionStrategy{
+		helper:            helper,
+		priorityReceivers: make(map[string]struct{}, len(priorityReceivers)),
+	}
+	for _, receiver := range priorityReceivers {
+		receiverBytes, err := pubkeyConverter.Decode(receiver)
+		if err != nil {
+			return nil, fmt.Errorf("%w for priority receiver %s", err, receiver)
+		}
+
+		strategy.priorityReceivers[string(receiverBytes)] = struct{}{}
+	}
+
+	return strategy, nil
+}
+
+// SelectTransactions returns the transactions fitting the gas bandwidth, priority lane first, and the remaining ones
+func (strategy *priorityLanesTxSelectionStrategy) SelectTransactions(
+	candidates []*txcache.WrappedTransaction,
+	gasBandwidth uint64,
+	randomness []byte,
+) ([]*txcache.WrappedTransaction, []*txcache.WrappedTransaction) {
+	orderedTxs := make([]*txcache.WrappedTransaction, len(candidates))
+	copy(orderedTxs, candidates)
+	strategy.helper.sortTransactionsBySenderAndNonce(orderedTxs, randomness)
+	strategy.moveLaneInFront(orderedTxs)
+
+	selectedTxs, remainingTxs := strategy.helper.prefilterTransactions(nil, orderedTxs, 0, gasBandwidth)
+	strategy.helper.sortTransactionsBySenderAndNonce(selectedTxs, randomness)
+
+	return selectedTxs, remainingTxs
+}
+
+// moveLaneInFront moves the transactions of the priority senders in front, keeping the relative order in both lanes
+func (strategy *priorityLanesTxSelectionStrategy) moveLaneInFront(transactions []*txcache.WrappedTransaction) {
+	prioritySenders := strategy.computePrioritySenders(transactions)
+	if len(prioritySenders) == 0 {
+		return
+	}
+
+	priorityLane := make([]*txcache.WrappedTransaction, 0, len(transactions))
+	normalLane := make([]*txcache.WrappedTransaction, 0, len(transactions))
+	for _, tx := range transactions {
+		_, isPrioritySender := prioritySenders[string(tx.Tx.GetSndAddr())]
+		if isPrioritySender {
+			priorityLane = append(priorityLane, tx)
+			continue
+		}
+
+		normalLane = append(normalLane, tx)
+	}
+
+	copy(transactions, priorityLane)
+	copy(transactions[len(priorityLane):], normalLane)
+}
+
+// computePrioritySenders returns the senders whose lowest nonce transaction calls a whitelisted contract
+func (strategy *priorityLanesTxSelectionStrategy) computePrioritySenders(transactions []*txcache.WrappedTransaction) map[string]struct{} {
+	firstTxBySender := make(map[string]*txcache.WrappedTransaction)
+	for _, tx := range transactions {
+		sender := string(tx.Tx.GetSndAddr())
+		firstTx, found := firstTxBySender[sender]
+		if !found || tx.Tx.GetNonce() < firstTx.Tx.GetNonce() {
+			firstTxBySender[sender] = tx
+		}
+	}
+
+	prioritySenders := make(map[string]struct{})
+	for sender, firstTx := range firstTxBySender {
+		_, isPriorityReceiver := strategy.priorityReceivers[string(firstTx.Tx.GetRcvAddr())]
+		if isPriorityReceiver {
+			prioritySenders[sender] = struct{}{}
+		}
+	}
+
+	return prioritySenders
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (strategy *priorityLanesTxSelectionStrategy) IsInterfaceNil() bool {
+	return strategy == nil
+}
@@ -0,0 +1,394 @@
+package preprocess
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/economics"
+	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/storage/txcache"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/economicsmocks"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/epochNotifier"
+	"github.com/stretchr/testify/require"
+)
+
+func createTxSelectionHelper() *transactions {
+	return &transactions{
+		basePreProcess: &basePreProcess{
+			gasTracker: gasTracker{
+				shardCoordinator: mock.NewMultiShardsCoordinatorMock(3),
+				economicsFee: &economicsmocks.EconomicsHandlerStub{
+					MinGasLimitCalled: func() uint64 {
+						return 10
+					},
+				},
+				gasHandler: &mock.GasHandlerMock{
+					ComputeGasProvidedByTxCalled: func(txSenderShardId uint32, txReceiverSharedId uint32, txHandler data.TransactionHandler) (uint64, uint64, error) {
+						return txHandler.GetGasLimit(), txHandler.GetGasLimit(), nil
+					},
+				},
+			},
+			enableEpochsHandler: enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+		},
+	}
+}
+
+func createWrappedTx(sender string, nonce uint64, receiver string, gasLimit uint64) *txcache.WrappedTransaction {
+	return &txcache.WrappedTransaction{
+		Tx: &transaction.Transaction{
+			Nonce:    nonce,
+			GasLimit: gasLimit,
+			SndAddr:  []byte(sender),
+			RcvAddr:  []byte(receiver),
+			Data:     []byte("callfunc@@@"),
+		},
+		TxHash: []byte(fmt.Sprintf("%s-%d", sender, nonce)),
+	}
+}
+
+func txHashesOf(txs []*txcache.WrappedTransaction) []string {
+	hashes := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		hashes = append(hashes, string(tx.TxHash))
+	}
+
+	return hashes
+}
+
+func TestCreateTxSelectionStrategy(t *testing.T) {
+	t.Parallel()
+
+	helper := createTxSelectionHelper()
+	pool := &testscommon.ShardedDataStub{}
+	pubkeyConverter := createMockPubkeyConverter()
+
+	t.Run("unknown type should error", func(t *testing.T) {
+		t.Parallel()
+
+		strategy, err := createTxSelectionStrategy(config.TxSelectionStrategyConfig{Type: "unknown"}, helper, pool, pubkeyConverter)
+		require.True(t, errors.Is(err, process.ErrInvalidTxSelectionStrategy))
+		require.Nil(t, strategy)
+	})
+	t.Run("invalid fifo config should error", func(t *testing.T) {
+		t.Parallel()
+
+		strategy, err := createTxSelectionStrategy(config.TxSelectionStrategyConfig{Type: FIFOTxSelectionStrategy}, helper, pool, pubkeyConverter)
+		require.True(t, errors.Is(err, process.ErrInvalidTxSelectionStrategy))
+		require.Nil(t, strategy)
+	})
+	t.Run("invalid sender fairness config should error", func(t *testing.T) {
+		t.Parallel()
+
+		strategy, err := createTxSelectionStrategy(config.TxSelectionStrategyConfig{Type: SenderFairnessTxSelectionStrategy}, helper, pool, pubkeyConverter)
+		require.True(t, errors.Is(err, process.ErrInvalidTxSelectionStrategy))
+		require.Nil(t, strategy)
+	})
+	t.Run("invalid priority lanes config should error", func(t *testing.T) {
+		t.Parallel()
+
+		strategy, err := createTxSelectionStrategy(config.TxSelectionStrategyConfig{Type: PriorityLanesTxSelectionStrategy}, helper, pool, pubkeyConverter)
+		require.True(t, errors.Is(err, process.ErrInvalidTxSelectionStrategy))
+		require.Nil(t, strategy)
+
+		strategy, err = createTxSelectionStrategy(config.TxSelectionStrategyConfig{
+			Type:              PriorityLanesTxSelectionStrategy,
+			PriorityReceivers: []string{"not hex"},
+		}, helper, pool, pubkeyConverter)
+		require.NotNil(t, err)
+		require.Nil(t, strategy)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		strategy, err := createTxSelectionStrategy(config.TxSelectionStrategyConfig{}, helper, pool, pubkeyConverter)
+		require.Nil(t, err)
+		require.IsType(t, &defaultTxSelectionStrategy{}, strategy)
+
+		strategy, err = createTxSelectionStrategy(config.TxSelectionStrategyConfig{Type: DefaultTxSelectionStrategy}, helper, pool, pubkeyConverter)
+		require.Nil(t, err)
+		require.IsType(t, &defaultTxSelectionStrategy{}, strategy)
+
+		registered := false
+		strategy, err = createTxSelectionStrategy(config.TxSelectionStrategyConfig{
+			Type:                   FIFOTxSelectionStrategy,
+			MaxTrackedTransactions: 10,
+		}, helper, &testscommon.ShardedDataStub{
+			RegisterOnAddedCalled: func(f func(key []byte, value interface{})) {
+				registered = true
+			},
+		}, pubkeyConverter)
+		require.Nil(t, err)
+		require.IsType(t, &fifoTxSelectionStrategy{}, strategy)
+		require.True(t, registered)
+
+		strategy, err = createTxSelectionStrategy(config.TxSelectionStrategyConfig{
+			Type:            SenderFairnessTxSelectionStrategy,
+			MaxTxsPerSender: 10,
+		}, helper, pool, pubkeyConverter)
+		require.Nil(t, err)
+		require.IsType(t, &senderFairnessTxSelectionStrategy{}, strategy)
+
+		strategy, err = createTxSelectionStrategy(config.TxSelectionStrategyConfig{
+			Type:              PriorityLanesTxSelectionStrategy,
+			PriorityReceivers: []string{"aabb"},
+		}, helper, pool, pubkeyConverter)
+		require.Nil(t, err)
+		require.IsType(t, &priorityLanesTxSelectionStrategy{}, strategy)
+		require.False(t, strategy.IsInterfaceNil())
+	})
+}
+
+func TestFifoTxSelectionStrategy_SelectTransactions(t *testing.T) {
+	t.Parallel()
+
+	var onAdded func(key []byte, value interface{})
+	pool := &testscommon.ShardedDataStub{
+		RegisterOnAddedCalled: func(f func(key []byte, value interface{})) {
+			onAdded = f
+		},
+		SearchFirstDataCalled: func(key []byte) (interface{}, bool) {
+			return nil, true
+		},
+	}
+	strategy, _ := newFIFOTxSelectionStrategy(createTxSelectionHelper(), pool, 10)
+
+	candidates := []*txcache.WrappedTransaction{
+		createWrappedTx("a", 0, "receiver", 10),
+		createWrappedTx("a", 1, "receiver", 10),
+		createWrappedTx("b", 0, "receiver", 10),
+		createWrappedTx("c", 0, "receiver", 10),
+	}
+	// the higher nonce of sender a arrived first
+	onAdded([]byte("c-0"), nil)
+	onAdded([]byte("a-1"), nil)
+	onAdded([]byte("a-0"), nil)
+	onAdded([]byte("c-0"), nil)
+
+	selected, remaining := strategy.SelectTransactions(candidates, 30, nil)
+	require.Equal(t, []string{"a-0", "a-1", "c-0"}, txHashesOf(selected))
+	require.Equal(t, []string{"b-0"}, txHashesOf(remaining))
+}
+
+func requireTrackedArrivals(t *testing.T, strategy *fifoTxSelectionStrategy, expectedArrivals map[string]uint64) {
+	require.Equal(t, len(expectedArrivals), len(strategy.arrivals))
+	require.Equal(t, len(expectedArrivals), strategy.arrivalsOrder.Len())
+	for txHash, arrivalIndex := range expectedArrivals {
+		element, isTracked := strategy.arrivals[txHash]
+		require.True(t, isTracked)
+		require.Equal(t, arrivalIndex, element.Value.(*trackedArrival).arrivalIndex)
+	}
+}
+
+func TestFifoTxSelectionStrategy_ReceivedTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("limit reached should forget the oldest", func(t *testing.T) {
+		t.Parallel()
+
+		pool := &testscommon.ShardedDataStub{
+			SearchFirstDataCalled: func(key []byte) (interface{}, bool) {
+				return nil, true
+			},
+		}
+		strategy, _ := newFIFOTxSelectionStrategy(createTxSelectionHelper(), pool, 2)
+		strategy.receivedTransaction([]byte("tx0"), nil)
+		strategy.receivedTransaction([]byte("tx1"), nil)
+		strategy.receivedTransaction([]byte("tx2"), nil)
+
+		requireTrackedArrivals(t, strategy, map[string]uint64{"tx1": 1, "tx2": 2})
+	})
+	t.Run("transactions removed from the pool should be forgotten", func(t *testing.T) {
+		t.Parallel()
+
+		txsInPool := map[string]struct{}{"tx0": {}, "tx1": {}, "tx2": {}, "tx3": {}, "tx4": {}, "tx5": {}}
+		pool := &testscommon.ShardedDataStub{
+			SearchFirstDataCalled: func(key []byte) (interface{}, bool) {
+				_, found := txsInPool[string(key)]
+				return nil, found
+			},
+		}
+		strategy, _ := newFIFOTxSelectionStrategy(createTxSelectionHelper(), pool, 10)
+		strategy.receivedTransaction([]byte("tx0"), nil)
+		strategy.receivedTransaction([]byte("tx1"), nil)
+		strategy.receivedTransaction([]byte("tx2"), nil)
+		requireTrackedArrivals(t, strategy, map[string]uint64{"tx0": 0, "tx1": 1, "tx2": 2})
+
+		// tx0 was included in a block and tx2 was evicted
+		delete(txsInPool, "tx0")
+		delete(txsInPool, "tx2")
+		strategy.receivedTransaction([]byte("tx3"), nil)
+		requireTrackedArrivals(t, strategy, map[string]uint64{"tx1": 1, "tx2": 2, "tx3": 3})
+
+		strategy.receivedTransaction([]byte("tx4"), nil)
+		requireTrackedArrivals(t, strategy, map[string]uint64{"tx1": 1, "tx3": 3, "tx4": 4})
+
+		// after reaching the last tracked transaction, the checks continue from the first one
+		delete(txsInPool, "tx1")
+		strategy.receivedTransaction([]byte("tx5"), nil)
+		requireTrackedArrivals(t, strategy, map[string]uint64{"tx3": 3, "tx4": 4, "tx5": 5})
+	})
+}
+
+func TestSenderFairnessTxSelectionStrategy_SelectTransactions(t *testing.T) {
+	t.Parallel()
+
+	strategy, _ := newSenderFairnessTxSelectionStrategy(createTxSelectionHelper(), 2)
+
+	candidates := []*txcache.WrappedTransaction{
+		createWrappedTx("a", 0, "receiver", 10),
+		createWrappedTx("a", 1, "receiver", 10),
+		createWrappedTx("a", 2, "receiver", 10),
+		createWrappedTx("a", 3, "receiver", 10),
+		createWrappedTx("b", 0, "receiver", 10),
+	}
+
+	selected, remaining := strategy.SelectTransactions(candidates, 100, nil)
+	require.Equal(t, []string{"a-0", "a-1", "b-0"}, txHashesOf(selected))
+	require.Empty(t, remaining)
+}
+
+func TestPriorityLanesTxSelectionStrategy(t *testing.T) {
+	t.Parallel()
+
+	priorityReceiver := []byte{0xaa, 0xbb}
+	strategy, _ := newPriorityLanesTxSelectionStrategy(createTxSelectionHelper(), createMockPubkeyConverter(), []string{"aabb"})
+
+	candidates := []*txcache.WrappedTransaction{
+		createWrappedTx("a", 0, "receiver", 10),
+		createWrappedTx("b", 1, "receiver", 10),
+		createWrappedTx("c", 0, string(priorityReceiver), 10),
+		createWrappedTx("c", 1, "receiver", 10),
+		createWrappedTx("b", 0, string(priorityReceiver), 10),
+	}
+
+	t.Run("select should take the priority lane first", func(t *testing.T) {
+		t.Parallel()
+
+		selected, remaining := strategy.SelectTransactions(candidates, 30, nil)
+		require.Equal(t, []string{"b-0", "b-1", "c-0"}, txHashesOf(selected))
+		require.Equal(t, []string{"c-1", "a-0"}, txHashesOf(remaining))
+	})
+	t.Run("selected transactions should be sorted by sender and nonce", func(t *testing.T) {
+		t.Parallel()
+
+		selected, remaining := strategy.SelectTransactions(candidates, 50, nil)
+		require.Equal(t, []string{"a-0", "b-0", "b-1", "c-0", "c-1"}, txHashesOf(selected))
+		require.Empty(t, remaining)
+	})
+}
+
+func createBenchmarkTxs(numSenders int, numTxsPerSender int, priorityReceiver []byte) []*txcache.WrappedTransaction {
+	random := rand.New(rand.NewSource(1))
+	txs := make([]*txcache.WrappedTransaction, 0, numSenders*numTxsPerSender)
+	for i := 0; i < numSenders; i++ {
+		sender := fmt.Sprintf("sender-%d", i)
+		receiver := []byte("receiver")
+		gasLimit := uint64(50_000)
+		if i%10 == 0 {
+			receiver = priorityReceiver
+		}
+		if i%3 == 0 {
+			gasLimit = 5_000_000
+		}
+
+		// the first senders produce most of the transactions
+		numTxs := numTxsPerSender
+		if i >= numSenders/10 {
+			numTxs = numTxsPerSender / 10
+		}
+		for nonce := 0; nonce < numTxs; nonce++ {
+			tx := createWrappedTx(sender, uint64(nonce), string(receiver), gasLimit)
+			tx.Tx.(*transaction.Transaction).GasPrice = uint64(1_000_000_000 + random.Intn(1_000_000_000))
+			if gasLimit == 50_000 {
+				tx.Tx.(*transaction.Transaction).Data = nil
+			}
+			txs = append(txs, tx)
+		}
+	}
+
+	return txs
+}
+
+func createBenchmarkEconomicsData(b *testing.B) process.EconomicsDataHandler {
+	economicsConfig := testscommon.GetEconomicsConfig()
+	economicsData, err := economics.NewEconomicsData(economics.ArgsNewEconomicsData{
+		Economics: &economicsConfig,
+		EnableEpochsHandler: &enableEpochsHandlerMock.EnableEpochsHandlerStub{
+			IsFlagEnabledInEpochCalled: func(flag core.EnableEpochFlag, epoch uint32) bool {
+				return flag == common.GasPriceModifierFlag
+			},
+		},
+		TxVersionChecker: &testscommon.TxVersionCheckerStub{},
+		EpochNotifier:    &epochNotifier.EpochNotifierStub{},
+	})
+	require.Nil(b, err)
+
+	return economicsData
+}
+
+// BenchmarkTxSelectionStrategies compares the strategies on the same synthetic pool, reporting the number of the
+// selected transactions and the fees they pay, as computed by the economics fee handler
+func BenchmarkTxSelectionStrategies(b *testing.B) {
+	priorityReceiver := []byte{0xaa, 0xbb}
+	candidates := createBenchmarkTxs(1000, 100, priorityReceiver)
+	gasBandwidth := uint64(1_500_000_000)
+	economicsData := createBenchmarkEconomicsData(b)
+
+	configs := []config.TxSelectionStrategyConfig{
+		{Type: DefaultTxSelectionStrategy},
+		{Type: FIFOTxSelectionStrategy, MaxTrackedTransactions: uint32(len(candidates))},
+		{Type: SenderFairnessTxSelectionStrategy, MaxTxsPerSender: 10},
+		{Type: PriorityLanesTxSelectionStrategy, PriorityReceivers: []string{"aabb"}},
+	}
+
+	for _, cfg := range configs {
+		var onAdded func(key []byte, value interface{})
+		pool := &testscommon.ShardedDataStub{
+			RegisterOnAddedCalled: func(f func(key []byte, value interface{})) {
+				onAdded = f
+			},
+			SearchFirstDataCalled: func(key []byte) (interface{}, bool) {
+				return nil, true
+			},
+		}
+		strategy, err := createTxSelectionStrategy(cfg, createTxSelectionHelper(), pool, createMockPubkeyConverter())
+		require.Nil(b, err)
+
+		if onAdded != nil {
+			random := rand.New(rand.NewSource(2))
+			for _, idx := range random.Perm(len(candidates)) {
+				onAdded(candidates[idx].TxHash, nil)
+			}
+		}
+
+		b.Run(cfg.Type, func(b *testing.B) {
+			numSelected := 0
+			fees := big.NewInt(0)
+			for i := 0; i < b.N; i++ {
+				txs := make([]*txcache.WrappedTransaction, len(candidates))
+				copy(txs, candidates)
+				selected, _ := strategy.SelectTransactions(txs, gasBandwidth, []byte("randomness"))
+
+				numSelected += len(selected)
+				for _, tx := range selected {
+					fees.Add(fees, economicsData.ComputeTxFee(tx.Tx))
+				}
+			}
+
+			feesPerOp, _ := big.NewFloat(0).Quo(big.NewFloat(0).SetInt(fees), big.NewFloat(float64(b.N))).Float64()
+			b.ReportMetric(float64(numSelected)/float64(b.N), "txs/op")
+			b.ReportMetric(feesPerOp, "fee/op")
+		})
+	}
+}
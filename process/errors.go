@@ -1229,3 +1229,15 @@ var ErrNilSentSignatureTracker = errors.New("nil sent signature tracker")
 
 // ErrTransferAndExecuteByUserAddressesAreNil signals that transfer and execute by user addresses are nil
 var ErrTransferAndExecuteByUserAddressesAreNil = errors.New("transfer and execute by user addresses are nil")
+
+// ErrEmptyQueriesBatch signals that an empty batch of VM queries has been provided
+var ErrEmptyQueriesBatch = errors.New("empty batch of VM queries")
+
+// ErrTooManyQueriesInBatch signals that a batch of VM queries holds too many queries
+var ErrTooManyQueriesInBatch = errors.New("too many queries in batch")
+
+// ErrNilSCQuery signals that a nil smart contract query has been provided
+var ErrNilSCQuery = errors.New("nil SC query")
+
+// ErrInvalidTxSelectionStrategy signals that an invalid transaction selection strategy has been provided
+var ErrInvalidTxSelectionStrategy = errors.New("invalid transaction selection strategy")
@@ -794,6 +794,12 @@ type SCQuery struct {
 	BlockHash      []byte
 }
 
+// SCQueryResult holds the outcome of a smart contract query executed as part of a batch
+type SCQueryResult struct {
+	VMOutput *vmcommon.VMOutput
+	Error    error
+}
+
 // GasHandler is able to perform some gas calculation
 type GasHandler interface {
 	Init()
@@ -933,6 +939,7 @@ type PeerValidatorMapper interface {
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
 	ExecuteQuery(query *SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
+	ExecuteQueries(queries []*SCQuery) ([]*SCQueryResult, common.BlockInfo, error)
 	ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error)
 	Close() error
 	IsInterfaceNil() bool
@@ -8,6 +8,7 @@ import (
 type BlockNotarizerHandlerMock struct {
 	AddNotarizedHeaderCalled                 func(shardID uint32, notarizedHeader data.HeaderHandler, notarizedHeaderHash []byte)
 	CleanupNotarizedHeadersBehindNonceCalled func(shardID uint32, nonce uint64)
+	CleanupNotarizedHeadersAboveNonceCalled  func(shardID uint32, nonce uint64)
 	DisplayNotarizedHeadersCalled            func(shardID uint32, message string)
 	GetFirstNotarizedHeaderCalled            func(shardID uint32) (data.HeaderHandler, []byte, error)
 	GetLastNotarizedHeaderCalled             func(shardID uint32) (data.HeaderHandler, []byte, error)
@@ -32,6 +33,13 @@ func (bngm *BlockNotarizerHandlerMock) CleanupNotarizedHeadersBehindNonce(shardI
 	}
 }
 
+// CleanupNotarizedHeadersAboveNonce -
+func (bngm *BlockNotarizerHandlerMock) CleanupNotarizedHeadersAboveNonce(shardID uint32, nonce uint64) {
+	if bngm.CleanupNotarizedHeadersAboveNonceCalled != nil {
+		bngm.CleanupNotarizedHeadersAboveNonceCalled(shardID, nonce)
+	}
+}
+
 // DisplayNotarizedHeaders -
 func (bngm *BlockNotarizerHandlerMock) DisplayNotarizedHeaders(shardID uint32, message string) {
 	if bngm.DisplayNotarizedHeadersCalled != nil {
@@ -34,6 +34,9 @@ var logQueryService = logger.GetOrCreate("process/smartcontract.queryService")
 // MaxGasLimitPerQuery - each unit is the equivalent of 1 nanosecond processing time
 const MaxGasLimitPerQuery = 300_000_000_000
 
+// MaxQueriesInBatch is the maximum number of queries that can be executed in a single batch
+const MaxQueriesInBatch = 500
+
 // SCQueryService can execute Get functions over SC to fetch stored values
 type SCQueryService struct {
 	vmContainer                process.VirtualMachinesContainer
@@ -186,6 +189,84 @@ func (service *SCQueryService) shouldAllowQueriesExecution() bool {
 func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice uint64) (*vmcommon.VMOutput, common.BlockInfo, error) {
 	logQueryService.Trace("executeScCall", "address", query.ScAddress, "function", query.FuncName, "blockNonce", query.BlockNonce.Value, "blockHash", query.BlockHash)
 
+	blockHeader, blockRootHash, err := service.prepareBlockState(query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vmOutput, err := service.runScCall(query, gasPrice)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blockInfo, err := service.createBlockInfo(blockHeader, blockRootHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return vmOutput, blockInfo, nil
+}
+
+// ExecuteQueries executes the provided queries against the same block, recreating the trie only once. The block
+// coordinates and the sync requirement are taken from the first query. The returned error is not nil only if the batch
+// could not be executed at all, while the errors of each query are returned in the corresponding result
+func (service *SCQueryService) ExecuteQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+	if !service.shouldAllowQueriesExecution() {
+		return nil, nil, process.ErrQueriesNotAllowedYet
+	}
+	if len(queries) == 0 {
+		return nil, nil, process.ErrEmptyQueriesBatch
+	}
+	if len(queries) > MaxQueriesInBatch {
+		return nil, nil, fmt.Errorf("%w: maximum is %d", process.ErrTooManyQueriesInBatch, MaxQueriesInBatch)
+	}
+	if queries[0] == nil {
+		return nil, nil, process.ErrNilSCQuery
+	}
+
+	service.mutRunSc.Lock()
+	defer service.mutRunSc.Unlock()
+
+	logQueryService.Trace("ExecuteQueries", "numQueries", len(queries), "blockNonce", queries[0].BlockNonce.Value, "blockHash", queries[0].BlockHash)
+
+	blockHeader, blockRootHash, err := service.prepareBlockState(queries[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results := make([]*process.SCQueryResult, 0, len(queries))
+	for _, query := range queries {
+		vmOutput, errRun := service.runBatchedScCall(query)
+		results = append(results, &process.SCQueryResult{
+			VMOutput: vmOutput,
+			Error:    errRun,
+		})
+	}
+
+	blockInfo, err := service.createBlockInfo(blockHeader, blockRootHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return results, blockInfo, nil
+}
+
+func (service *SCQueryService) runBatchedScCall(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+	if query == nil {
+		return nil, process.ErrNilSCQuery
+	}
+	if query.ScAddress == nil {
+		return nil, process.ErrNilScAddress
+	}
+	if len(query.FuncName) == 0 {
+		return nil, process.ErrEmptyFunctionName
+	}
+
+	return service.runScCall(query, 0)
+}
+
+// prepareBlockState loads the block the query should be executed against and, if needed, recreates the trie of that block
+func (service *SCQueryService) prepareBlockState(query *process.SCQuery) (data.HeaderHandler, []byte, error) {
 	shouldEarlyExitBecauseOfSyncState := query.ShouldBeSynced && service.bootstrapper.GetNodeState() == common.NsNotSynchronized
 	if shouldEarlyExitBecauseOfSyncState {
 		return nil, nil, process.ErrNodeIsNotSynced
@@ -209,6 +290,10 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 		service.blockChainHook.SetCurrentHeader(blockHeader)
 	}
 
+	return blockHeader, blockRootHash, nil
+}
+
+func (service *SCQueryService) runScCall(query *process.SCQuery, gasPrice uint64) (*vmcommon.VMOutput, error) {
 	shouldCheckRootHashChanges := query.SameScState
 	rootHashBeforeExecution := make([]byte, 0)
 
@@ -220,7 +305,7 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 	vm, _, err := scrCommon.FindVMByScAddress(service.vmContainer, query.ScAddress)
 	if err != nil {
 		service.wasmVMChangeLocker.RUnlock()
-		return nil, nil, err
+		return nil, err
 	}
 
 	query = prepareScQuery(query)
@@ -228,27 +313,32 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 	vmOutput, err := vm.RunSmartContractCall(vmInput)
 	service.wasmVMChangeLocker.RUnlock()
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	if query.SameScState {
 		err = service.checkForRootHashChanges(rootHashBeforeExecution)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 	}
 
+	return vmOutput, nil
+}
+
+func (service *SCQueryService) createBlockInfo(blockHeader data.HeaderHandler, blockRootHash []byte) (common.BlockInfo, error) {
 	var blockHash []byte
 	var blockNonce uint64
 	if !check.IfNil(blockHeader) {
 		blockNonce = blockHeader.GetNonce()
+		var err error
 		blockHash, err = core.CalculateHash(service.marshaller, service.hasher, blockHeader)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 	}
-	blockInfo := holders.NewBlockInfo(blockHash, blockNonce, blockRootHash)
-	return vmOutput, blockInfo, nil
+
+	return holders.NewBlockInfo(blockHash, blockNonce, blockRootHash), nil
 }
 
 func (service *SCQueryService) recreateTrie(blockRootHash []byte, blockHeader data.HeaderHandler) error {
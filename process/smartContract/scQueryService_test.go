@@ -1174,3 +1174,167 @@ func TestNewSCQueryService_CloseShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, closeCalled)
 }
+
+func TestSCQueryService_ExecuteQueries(t *testing.T) {
+	t.Parallel()
+
+	validQuery := func(funcName string) *process.SCQuery {
+		return &process.SCQuery{
+			ScAddress: []byte(DummyScAddress),
+			FuncName:  funcName,
+		}
+	}
+
+	t.Run("queries not allowed yet should error", func(t *testing.T) {
+		t.Parallel()
+
+		argsNewSCQuery := createMockArgumentsForSCQuery()
+		argsNewSCQuery.AllowExternalQueriesChan = make(chan struct{})
+		target, _ := NewSCQueryService(argsNewSCQuery)
+
+		results, blockInfo, err := target.ExecuteQueries([]*process.SCQuery{validQuery("function")})
+		assert.Equal(t, process.ErrQueriesNotAllowedYet, err)
+		assert.Nil(t, results)
+		assert.Nil(t, blockInfo)
+	})
+	t.Run("empty batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		target, _ := NewSCQueryService(createMockArgumentsForSCQuery())
+
+		results, _, err := target.ExecuteQueries(nil)
+		assert.Equal(t, process.ErrEmptyQueriesBatch, err)
+		assert.Nil(t, results)
+	})
+	t.Run("too many queries should error", func(t *testing.T) {
+		t.Parallel()
+
+		target, _ := NewSCQueryService(createMockArgumentsForSCQuery())
+
+		queries := make([]*process.SCQuery, MaxQueriesInBatch+1)
+		results, _, err := target.ExecuteQueries(queries)
+		assert.ErrorIs(t, err, process.ErrTooManyQueriesInBatch)
+		assert.Nil(t, results)
+	})
+	t.Run("nil first query should error", func(t *testing.T) {
+		t.Parallel()
+
+		target, _ := NewSCQueryService(createMockArgumentsForSCQuery())
+
+		results, _, err := target.ExecuteQueries([]*process.SCQuery{nil, validQuery("function")})
+		assert.Equal(t, process.ErrNilSCQuery, err)
+		assert.Nil(t, results)
+	})
+	t.Run("node not synced should error", func(t *testing.T) {
+		t.Parallel()
+
+		argsNewSCQuery := createMockArgumentsForSCQuery()
+		argsNewSCQuery.Bootstrapper = &mock.BootstrapperStub{
+			GetNodeStateCalled: func() common.NodeState {
+				return common.NsNotSynchronized
+			},
+		}
+		target, _ := NewSCQueryService(argsNewSCQuery)
+
+		query := validQuery("function")
+		query.ShouldBeSynced = true
+		results, _, err := target.ExecuteQueries([]*process.SCQuery{query})
+		assert.Equal(t, process.ErrNodeIsNotSynced, err)
+		assert.Nil(t, results)
+	})
+	t.Run("should recreate the trie once and return the result of each query", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		executedFunctions := make([]string, 0)
+		mockVM := &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				executedFunctions = append(executedFunctions, input.Function)
+				if input.Function == "failing" {
+					return nil, expectedErr
+				}
+
+				return &vmcommon.VMOutput{
+					ReturnCode: vmcommon.Ok,
+					ReturnData: [][]byte{[]byte(input.Function)},
+				}, nil
+			},
+		}
+		argsNewSCQuery := createMockArgumentsForSCQuery()
+		argsNewSCQuery.VmContainer = &mock.VMContainerMock{
+			GetCalled: func(key []byte) (vmcommon.VMExecutionHandler, error) {
+				return mockVM, nil
+			},
+		}
+		argsNewSCQuery.EconomicsFee = &economicsmocks.EconomicsHandlerStub{
+			MaxGasLimitPerBlockCalled: func(_ uint32) uint64 {
+				return uint64(math.MaxUint64)
+			},
+		}
+		providedRootHash := []byte("provided root hash")
+		providedNonce := uint64(123)
+		argsNewSCQuery.Marshaller = &marshallerMock.MarshalizerMock{}
+		argsNewSCQuery.StorageService = &storageStubs.ChainStorerStub{
+			GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+				return &storageStubs.StorerStub{
+					GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
+						hdr := &block.Header{
+							Nonce:    providedNonce,
+							RootHash: providedRootHash,
+						}
+						return argsNewSCQuery.Marshaller.Marshal(hdr)
+					},
+				}, nil
+			},
+		}
+		argsNewSCQuery.HistoryRepository = &dblookupext.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+		}
+		numRecreateTrieCalls := 0
+		argsNewSCQuery.BlockChainHook = &testscommon.BlockChainHookStub{
+			GetAccountsAdapterCalled: func() state.AccountsAdapter {
+				return &stateMocks.AccountsStub{
+					RecreateTrieCalled: func(options common.RootHashHolder) error {
+						numRecreateTrieCalls++
+						assert.Equal(t, providedRootHash, options.GetRootHash())
+						return nil
+					},
+				}
+			},
+		}
+		target, _ := NewSCQueryService(argsNewSCQuery)
+
+		queries := []*process.SCQuery{
+			validQuery("first"),
+			nil,
+			{ScAddress: []byte(DummyScAddress)},
+			validQuery("failing"),
+			validQuery("second"),
+		}
+		for _, query := range queries {
+			if query != nil {
+				query.BlockHash = []byte("provided hash")
+			}
+		}
+
+		results, blockInfo, err := target.ExecuteQueries(queries)
+		require.Nil(t, err)
+		require.Len(t, results, len(queries))
+		assert.Equal(t, 1, numRecreateTrieCalls)
+		assert.Equal(t, []string{"first", "failing", "second"}, executedFunctions)
+
+		assert.Nil(t, results[0].Error)
+		assert.Equal(t, [][]byte{[]byte("first")}, results[0].VMOutput.ReturnData)
+		assert.Equal(t, process.ErrNilSCQuery, results[1].Error)
+		assert.Equal(t, process.ErrEmptyFunctionName, results[2].Error)
+		assert.Equal(t, expectedErr, results[3].Error)
+		assert.Nil(t, results[3].VMOutput)
+		assert.Nil(t, results[4].Error)
+		assert.Equal(t, [][]byte{[]byte("second")}, results[4].VMOutput.ReturnData)
+
+		assert.Equal(t, providedNonce, blockInfo.GetNonce())
+		assert.Equal(t, providedRootHash, blockInfo.GetRootHash())
+	})
+}
@@ -303,6 +303,31 @@ func (bbt *baseBlockTrack) cleanupTrackedHeadersBehindNonce(shardID uint32, nonc
 	}
 }
 
+// CleanupHeadersAboveNonce removes from local pools all the headers of the given shard with nonces higher than the
+// given one. It is used when a chain has been reverted and the headers above the given nonce will never be part of it
+// again. The caller should add back the last notarized headers, if all of them were removed
+func (bbt *baseBlockTrack) CleanupHeadersAboveNonce(shardID uint32, nonce uint64) {
+	bbt.selfNotarizer.CleanupNotarizedHeadersAboveNonce(shardID, nonce)
+	bbt.crossNotarizer.CleanupNotarizedHeadersAboveNonce(shardID, nonce)
+	bbt.cleanupTrackedHeadersAboveNonce(shardID, nonce)
+}
+
+func (bbt *baseBlockTrack) cleanupTrackedHeadersAboveNonce(shardID uint32, nonce uint64) {
+	bbt.mutHeaders.Lock()
+	defer bbt.mutHeaders.Unlock()
+
+	headersForShard, ok := bbt.headers[shardID]
+	if !ok {
+		return
+	}
+
+	for headersNonce := range headersForShard {
+		if headersNonce > nonce {
+			delete(headersForShard, headersNonce)
+		}
+	}
+}
+
 // ComputeLongestChain returns the longest valid chain for a given shard from a given header
 func (bbt *baseBlockTrack) ComputeLongestChain(shardID uint32, header data.HeaderHandler) ([]data.HeaderHandler, [][]byte) {
 	return bbt.blockProcessor.ComputeLongestChain(shardID, header)
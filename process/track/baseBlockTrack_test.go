@@ -1152,6 +1152,46 @@ func TestCleanupTrackedHeadersBehindNonce_ShouldWork(t *testing.T) {
 	assert.Zero(t, len(trackedHeaders))
 }
 
+func TestCleanupHeadersAboveNonce_ShouldReturnWhenShardNotExist(t *testing.T) {
+	t.Parallel()
+
+	shardArguments := CreateShardTrackerMockArguments()
+	sbt, _ := track.NewShardBlockTrack(shardArguments)
+
+	header := &block.Header{
+		ShardID: shardArguments.ShardCoordinator.SelfId(),
+		Nonce:   2,
+	}
+	sbt.AddTrackedHeader(header, []byte("hash"))
+
+	sbt.CleanupHeadersAboveNonce(header.GetShardID()+1, 1)
+	trackedHeaders, _ := sbt.GetTrackedHeaders(header.GetShardID())
+
+	assert.Equal(t, 1, len(trackedHeaders))
+}
+
+func TestCleanupHeadersAboveNonce_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	shardArguments := CreateShardTrackerMockArguments()
+	sbt, _ := track.NewShardBlockTrack(shardArguments)
+
+	shardID := shardArguments.ShardCoordinator.SelfId()
+	header1 := &block.Header{ShardID: shardID, Nonce: 1}
+	header2 := &block.Header{ShardID: shardID, Nonce: 2}
+	header3 := &block.Header{ShardID: shardID, Nonce: 3}
+	sbt.AddTrackedHeader(header1, []byte("hash1"))
+	sbt.AddTrackedHeader(header2, []byte("hash2"))
+	sbt.AddTrackedHeader(header3, []byte("hash3"))
+
+	sbt.CleanupHeadersAboveNonce(shardID, 1)
+	trackedHeaders, trackedHeadersHashes := sbt.GetTrackedHeaders(shardID)
+
+	require.Equal(t, 1, len(trackedHeaders))
+	assert.Equal(t, header1, trackedHeaders[0])
+	assert.Equal(t, []byte("hash1"), trackedHeadersHashes[0])
+}
+
 func TestComputeLongestChain_ShouldWork(t *testing.T) {
 	t.Parallel()
 
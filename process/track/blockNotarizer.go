@@ -102,6 +102,29 @@ func (bn *blockNotarizer) CleanupNotarizedHeadersBehindNonce(shardID uint32, non
 	bn.notarizedHeaders[shardID] = headersInfo
 }
 
+// CleanupNotarizedHeadersAboveNonce cleanups notarized headers for a given shard above a given nonce. As opposed to
+// the cleanup behind a nonce, all the notarized headers could be removed, so the caller should add back the ones needed
+func (bn *blockNotarizer) CleanupNotarizedHeadersAboveNonce(shardID uint32, nonce uint64) {
+	bn.mutNotarizedHeaders.Lock()
+	defer bn.mutNotarizedHeaders.Unlock()
+
+	notarizedHeaders, ok := bn.notarizedHeaders[shardID]
+	if !ok {
+		return
+	}
+
+	headersInfo := make([]*HeaderInfo, 0)
+	for _, hdrInfo := range notarizedHeaders {
+		if hdrInfo.Header.GetNonce() > nonce {
+			continue
+		}
+
+		headersInfo = append(headersInfo, hdrInfo)
+	}
+
+	bn.notarizedHeaders[shardID] = headersInfo
+}
+
 // DisplayNotarizedHeaders displays notarized headers for a given shard
 func (bn *blockNotarizer) DisplayNotarizedHeaders(shardID uint32, message string) {
 	bn.mutNotarizedHeaders.RLock()
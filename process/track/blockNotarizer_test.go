@@ -129,6 +129,48 @@ func TestCleanupNotarizedHeadersBehindNonce_ShouldWork(t *testing.T) {
 	assert.Equal(t, &hdr2, header)
 }
 
+func TestCleanupNotarizedHeadersAboveNonce_ShouldNotCleanWhenGivenShardIsInvalid(t *testing.T) {
+	t.Parallel()
+
+	bn, _ := track.NewBlockNotarizer(&hashingMocks.HasherMock{}, &mock.MarshalizerMock{}, mock.NewMultipleShardsCoordinatorMock())
+
+	bn.AddNotarizedHeader(0, &block.Header{}, nil)
+	bn.AddNotarizedHeader(0, &block.Header{Nonce: 2}, nil)
+	bn.CleanupNotarizedHeadersAboveNonce(1, 1)
+
+	assert.Equal(t, 2, len(bn.GetNotarizedHeaders()[0]))
+}
+
+func TestCleanupNotarizedHeadersAboveNonce_ShouldRemoveAllHeaders(t *testing.T) {
+	t.Parallel()
+
+	bn, _ := track.NewBlockNotarizer(&hashingMocks.HasherMock{}, &mock.MarshalizerMock{}, mock.NewMultipleShardsCoordinatorMock())
+
+	bn.AddNotarizedHeader(0, &block.Header{Nonce: 2}, nil)
+	bn.CleanupNotarizedHeadersAboveNonce(0, 1)
+
+	assert.Zero(t, len(bn.GetNotarizedHeaders()[0]))
+}
+
+func TestCleanupNotarizedHeadersAboveNonce_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	bn, _ := track.NewBlockNotarizer(&hashingMocks.HasherMock{}, &mock.MarshalizerMock{}, mock.NewMultipleShardsCoordinatorMock())
+
+	hdr1 := block.Header{Nonce: 1}
+	hdr2 := block.Header{Nonce: 2}
+	hdr3 := block.Header{Nonce: 3}
+	bn.AddNotarizedHeader(0, &hdr1, nil)
+	bn.AddNotarizedHeader(0, &hdr2, nil)
+	bn.AddNotarizedHeader(0, &hdr3, nil)
+
+	bn.CleanupNotarizedHeadersAboveNonce(0, 2)
+	require.Equal(t, 2, len(bn.GetNotarizedHeaders()[0]))
+
+	header, _, _ := bn.GetLastNotarizedHeader(0)
+	assert.Equal(t, &hdr2, header)
+}
+
 func TestNotarizedHeaders_ShouldNotPanicWhenGivenShardIsInvalid(t *testing.T) {
 	t.Parallel()
 
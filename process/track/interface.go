@@ -8,6 +8,7 @@ import (
 type blockNotarizerHandler interface {
 	AddNotarizedHeader(shardID uint32, notarizedHeader data.HeaderHandler, notarizedHeaderHash []byte)
 	CleanupNotarizedHeadersBehindNonce(shardID uint32, nonce uint64)
+	CleanupNotarizedHeadersAboveNonce(shardID uint32, nonce uint64)
 	DisplayNotarizedHeaders(shardID uint32, message string)
 	GetLastNotarizedHeader(shardID uint32) (data.HeaderHandler, []byte, error)
 	GetFirstNotarizedHeader(shardID uint32) (data.HeaderHandler, []byte, error)
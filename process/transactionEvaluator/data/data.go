@@ -1,6 +1,10 @@
 package data
 
 import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
@@ -10,3 +14,103 @@ type SimulationResultsWithVMOutput struct {
 	transaction.SimulationResults
 	VMOutput *vmcommon.VMOutput `json:"-"`
 }
+
+// StateOverride holds the changes to be applied on an account before simulating a transaction. The nil (or empty)
+// fields leave the corresponding account data unchanged
+type StateOverride struct {
+	Address      []byte
+	Balance      *big.Int
+	Nonce        *uint64
+	Code         []byte
+	CodeMetadata []byte
+	Storage      map[string][]byte
+	ESDTBalances []*ESDTBalanceOverride
+}
+
+// ESDTBalanceOverride holds the balance of an ESDT token (or of an NFT/SFT, if the nonce is not 0) to be set on an account
+type ESDTBalanceOverride struct {
+	TokenIdentifier []byte
+	Nonce           uint64
+	Balance         *big.Int
+}
+
+// TraceOptions holds the options of a transaction executed in trace mode
+type TraceOptions struct {
+	StateOverrides []*StateOverride
+	// HistoricalState, if set, holds the coordinates (root hash included) of the state to execute the transaction on
+	HistoricalState *api.AccountQueryOptions
+	// BlockHeader, if set, is used as the block context of the execution instead of the current header
+	BlockHeader data.HeaderHandler
+	// PrecedingTransactions are executed, in order and without being traced, before the traced transaction. They are
+	// the transactions executed before it within the same block, when re-executing a historical transaction
+	PrecedingTransactions []*transaction.Transaction
+}
+
+// StorageAccess holds a storage read or write recorded while executing a transaction in trace mode
+type StorageAccess struct {
+	Address []byte
+	Key     []byte
+	Value   []byte
+	Written bool
+}
+
+// ExecutionTrace holds the trace of a transaction executed in trace mode. InexactState is set if the transaction was
+// re-executed on a state which could not be exactly rebuilt, as some of the operations executed before it within its
+// block could not be re-applied
+type ExecutionTrace struct {
+	Status        transaction.TxStatus `json:"status"`
+	FailReason    string               `json:"failReason,omitempty"`
+	ReturnCode    string               `json:"returnCode,omitempty"`
+	ReturnMessage string               `json:"returnMessage,omitempty"`
+	GasLimit      uint64               `json:"gasLimit"`
+	GasUsed       uint64               `json:"gasUsed"`
+	BlockNonce    uint64               `json:"blockNonce"`
+	InexactState  bool                 `json:"inexactState,omitempty"`
+	Root          *CallFrame           `json:"root"`
+	Accounts      []*AccountTrace      `json:"accounts,omitempty"`
+}
+
+// CallFrame holds a call made while executing a traced transaction, along with its nested calls. GasProvided and
+// GasLocked are set for the calls recorded by the VM as output transfers. The VM reports the gas used and the storage
+// accesses by account, so they are charged to a frame only if it is the only one executed on its account. Otherwise,
+// SharedAccount is set and they can be found only in the accounts of the trace
+type CallFrame struct {
+	CallType        string                `json:"callType"`
+	BuiltInFunction string                `json:"builtInFunction,omitempty"`
+	Caller          string                `json:"caller"`
+	Callee          string                `json:"callee"`
+	CrossShard      bool                  `json:"crossShard,omitempty"`
+	Function        string                `json:"function,omitempty"`
+	Arguments       []string              `json:"arguments,omitempty"`
+	Value           string                `json:"value"`
+	ESDTTransfers   []*ESDTTransferTrace  `json:"esdtTransfers,omitempty"`
+	GasProvided     uint64                `json:"gasProvided,omitempty"`
+	GasLocked       uint64                `json:"gasLocked,omitempty"`
+	GasUsed         uint64                `json:"gasUsed"`
+	SharedAccount   bool                  `json:"sharedAccount,omitempty"`
+	StorageReads    []*StorageAccessTrace `json:"storageReads,omitempty"`
+	StorageWrites   []*StorageAccessTrace `json:"storageWrites,omitempty"`
+	Events          []*transaction.Events `json:"events,omitempty"`
+	Calls           []*CallFrame          `json:"calls,omitempty"`
+}
+
+// AccountTrace holds the gas used and the storage accesses of an account touched by a traced transaction
+type AccountTrace struct {
+	Address       string                `json:"address"`
+	GasUsed       uint64                `json:"gasUsed"`
+	StorageReads  []*StorageAccessTrace `json:"storageReads,omitempty"`
+	StorageWrites []*StorageAccessTrace `json:"storageWrites,omitempty"`
+}
+
+// ESDTTransferTrace holds an ESDT transfer made by a call frame
+type ESDTTransferTrace struct {
+	TokenIdentifier string `json:"tokenIdentifier"`
+	Nonce           uint64 `json:"nonce,omitempty"`
+	Value           string `json:"value"`
+}
+
+// StorageAccessTrace holds a hex encoded storage key and its value, as read or written by a call frame
+type StorageAccessTrace struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
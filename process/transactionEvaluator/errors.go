@@ -28,3 +28,21 @@ var ErrNilHasher = errors.New("nil hasher provided")
 
 // ErrNilDataFieldParser signals that a nil data field parser has been provided
 var ErrNilDataFieldParser = errors.New("nil data field parser")
+
+// ErrNilStateOverride signals that a nil state override has been provided
+var ErrNilStateOverride = errors.New("nil state override")
+
+// ErrEmptyStateOverrideAddress signals that a state override without an address has been provided
+var ErrEmptyStateOverrideAddress = errors.New("empty address in state override")
+
+// ErrNegativeBalanceOverride signals that a state override holds a negative balance
+var ErrNegativeBalanceOverride = errors.New("negative balance in state override")
+
+// ErrInvalidESDTBalanceOverride signals that an invalid ESDT balance override has been provided
+var ErrInvalidESDTBalanceOverride = errors.New("invalid ESDT balance override")
+
+// ErrWrongTypeAssertion signals that a type assertion failed
+var ErrWrongTypeAssertion = errors.New("wrong type assertion")
+
+// ErrNilAccountsRepository signals that a nil accounts repository has been provided
+var ErrNilAccountsRepository = errors.New("nil accounts repository")
@@ -0,0 +1,520 @@
+package transactionEvaluator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-go/process"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/sharding"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-common-go/parsers"
+)
+
+const (
+	transferValueOnlyIdentifier = "transferValueOnly"
+	directCallType              = "DirectCall"
+	builtInFunctionCallType     = "BuiltInFunction"
+	executeOnDestContextType    = "ExecuteOnDestContext"
+	executeOnSameContextType    = "ExecuteOnSameContext"
+	esdtTransferTopicsLen       = 3
+)
+
+// outputTransferCallTypes holds the labels of the call types of the output transfers, as the VM writes them in the
+// transferValueOnly events
+var outputTransferCallTypes = map[vm.CallType]string{
+	vm.DirectCall:             directCallType,
+	vm.AsynchronousCall:       "AsyncCall",
+	vm.AsynchronousCallBack:   "AsyncCallback",
+	vm.ESDTTransferAndExecute: "TransferAndExecute",
+	vm.ExecOnDestByCaller:     "ExecOnDestByCaller",
+}
+
+// esdtTransferFunctions holds, for each ESDT transfer built-in function, the number of arguments preceding the name of
+// the function called on the destination, for a single transfer. The multi transfer also has the destination and the
+// number of transfers as leading arguments.
+var esdtTransferFunctions = map[string]int{
+	core.BuiltInFunctionESDTTransfer:         2,
+	core.BuiltInFunctionESDTNFTTransfer:      4,
+	core.BuiltInFunctionMultiESDTNFTTransfer: 2,
+}
+
+// builtInFunctionsWithEvents holds the built-in functions (other than the ESDT transfers) that are traced as call frames
+var builtInFunctionsWithEvents = map[string]struct{}{
+	core.BuiltInFunctionESDTLocalMint:           {},
+	core.BuiltInFunctionESDTLocalBurn:           {},
+	core.BuiltInFunctionESDTNFTCreate:           {},
+	core.BuiltInFunctionESDTNFTAddQuantity:      {},
+	core.BuiltInFunctionESDTNFTBurn:             {},
+	core.BuiltInFunctionESDTNFTAddURI:           {},
+	core.BuiltInFunctionESDTNFTUpdateAttributes: {},
+	core.BuiltInFunctionESDTWipe:                {},
+	core.BuiltInFunctionESDTFreeze:              {},
+	core.BuiltInFunctionESDTUnFreeze:            {},
+	core.BuiltInFunctionClaimDeveloperRewards:   {},
+}
+
+type executionTraceInput struct {
+	tx              *transaction.Transaction
+	txType          process.TransactionType
+	results         *txSimData.SimulationResultsWithVMOutput
+	storageAccesses []*txSimData.StorageAccess
+	gasUsed         uint64
+	blockNonce      uint64
+}
+
+// outputTransfer is a transfer recorded in the VM output, along with its destination
+type outputTransfer struct {
+	destination []byte
+	transfer    *vmcommon.OutputTransfer
+	matched     bool
+}
+
+// executionTraceBuilder rebuilds the call tree of an executed transaction from its VM output. The calls are taken in
+// execution order from the transferValueOnly (or ESDT transfer) events, which the VM writes for every call, having
+// the caller as address, so the parent of a call is the latest frame executed on the caller's account. Each call is
+// then matched with the output transfer the VM recorded for it, if any, which holds the gas provided to the call. The
+// output transfers not matched by any event, such as the ones made by the built-in functions, are added as calls of
+// the latest frame executed on their sender's account.
+type executionTraceBuilder struct {
+	pubkeyConverter  core.PubkeyConverter
+	shardCoordinator sharding.Coordinator
+	argsParser       process.CallArgumentsParser
+}
+
+func newExecutionTraceBuilder(pubkeyConverter core.PubkeyConverter, shardCoordinator sharding.Coordinator) *executionTraceBuilder {
+	return &executionTraceBuilder{
+		pubkeyConverter:  pubkeyConverter,
+		shardCoordinator: shardCoordinator,
+		argsParser:       parsers.NewCallArgsParser(),
+	}
+}
+
+func (builder *executionTraceBuilder) build(input *executionTraceInput) *txSimData.ExecutionTrace {
+	trace := &txSimData.ExecutionTrace{
+		Status:     input.results.Status,
+		FailReason: input.results.FailReason,
+		GasLimit:   input.tx.GasLimit,
+		GasUsed:    input.gasUsed,
+		BlockNonce: input.blockNonce,
+		Root:       builder.createRootFrame(input.tx, input.txType),
+	}
+
+	vmOutput := input.results.VMOutput
+	if vmOutput != nil {
+		trace.ReturnCode = vmOutput.ReturnCode.String()
+		trace.ReturnMessage = vmOutput.ReturnMessage
+		builder.addFrames(trace.Root, vmOutput)
+	}
+
+	builder.addAccountsData(trace, vmOutput, input.storageAccesses)
+
+	return trace
+}
+
+func (builder *executionTraceBuilder) createRootFrame(tx *transaction.Transaction, txType process.TransactionType) *txSimData.CallFrame {
+	root := &txSimData.CallFrame{
+		CallType:   txType.String(),
+		Caller:     builder.encodeAddress(tx.SndAddr),
+		Callee:     builder.encodeAddress(tx.RcvAddr),
+		CrossShard: builder.isCrossShard(tx.RcvAddr),
+		Value:      bigIntToString(tx.Value),
+	}
+
+	if txType != process.SCInvoking && txType != process.BuiltInFunctionCall {
+		return root
+	}
+
+	function, args, err := builder.argsParser.ParseData(string(tx.Data))
+	if err != nil {
+		log.Debug("executionTraceBuilder.createRootFrame: cannot parse the transaction data", "error", err)
+		return root
+	}
+
+	root.Function = function
+	root.Arguments = hexEncodeAll(args)
+	_, isESDTTransfer := esdtTransferFunctions[function]
+	if isESDTTransfer {
+		root.BuiltInFunction = function
+	}
+
+	return root
+}
+
+func (builder *executionTraceBuilder) addFrames(root *txSimData.CallFrame, vmOutput *vmcommon.VMOutput) {
+	transfers := collectOutputTransfers(vmOutput)
+	stack := []*txSimData.CallFrame{root}
+	for _, entry := range vmOutput.Logs {
+		if entry == nil {
+			continue
+		}
+
+		identifier := string(entry.Identifier)
+		_, isESDTTransfer := esdtTransferFunctions[identifier]
+		_, isBuiltInFunction := builtInFunctionsWithEvents[identifier]
+		switch {
+		case identifier == transferValueOnlyIdentifier && builder.isRootTransfer(root, entry):
+			root.Events = append(root.Events, builder.createEvent(entry))
+		case identifier == transferValueOnlyIdentifier && len(entry.Topics) >= 2:
+			frame := builder.createValueTransferFrame(entry)
+			if frame.CallType != executeOnDestContextType && frame.CallType != executeOnSameContextType {
+				addGasProvided(frame, matchOutputTransfer(transfers, entry.Address, entry.Topics[1]))
+			}
+			stack = builder.pushFrame(stack, entry.Address, frame)
+		case isESDTTransfer && len(entry.Topics) > esdtTransferTopicsLen && (len(entry.Topics)-1)%esdtTransferTopicsLen == 0:
+			frame := builder.createESDTTransferFrame(entry)
+			addGasProvided(frame, matchOutputTransfer(transfers, entry.Address, entry.Topics[len(entry.Topics)-1]))
+			stack = builder.pushFrame(stack, entry.Address, frame)
+		case isBuiltInFunction:
+			var parent *txSimData.CallFrame
+			stack, parent = builder.findParent(stack, entry.Address)
+			parent.Calls = append(parent.Calls, builder.createBuiltInFunctionFrame(entry))
+		default:
+			var parent *txSimData.CallFrame
+			stack, parent = builder.findParent(stack, entry.Address)
+			parent.Events = append(parent.Events, builder.createEvent(entry))
+		}
+	}
+
+	for _, transfer := range transfers {
+		if transfer.matched {
+			continue
+		}
+
+		parent := builder.findLatestFrame(root, transfer.transfer.SenderAddress)
+		parent.Calls = append(parent.Calls, builder.createOutputTransferFrame(transfer))
+	}
+}
+
+// collectOutputTransfers returns the output transfers of all the accounts, in the order they were made
+func collectOutputTransfers(vmOutput *vmcommon.VMOutput) []*outputTransfer {
+	addresses := make([]string, 0, len(vmOutput.OutputAccounts))
+	for address := range vmOutput.OutputAccounts {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	transfers := make([]*outputTransfer, 0)
+	for _, address := range addresses {
+		outputAccount := vmOutput.OutputAccounts[address]
+		if outputAccount == nil {
+			continue
+		}
+
+		for i := range outputAccount.OutputTransfers {
+			transfers = append(transfers, &outputTransfer{
+				destination: outputAccount.Address,
+				transfer:    &outputAccount.OutputTransfers[i],
+			})
+		}
+	}
+
+	sort.SliceStable(transfers, func(i, j int) bool {
+		return transfers[i].transfer.Index < transfers[j].transfer.Index
+	})
+
+	return transfers
+}
+
+// matchOutputTransfer returns the first output transfer between the provided addresses not matched yet, if any
+func matchOutputTransfer(transfers []*outputTransfer, sender []byte, destination []byte) *outputTransfer {
+	for _, transfer := range transfers {
+		if transfer.matched || !bytes.Equal(transfer.transfer.SenderAddress, sender) || !bytes.Equal(transfer.destination, destination) {
+			continue
+		}
+
+		transfer.matched = true
+		return transfer
+	}
+
+	return nil
+}
+
+func addGasProvided(frame *txSimData.CallFrame, transfer *outputTransfer) {
+	if transfer == nil {
+		return
+	}
+
+	frame.GasProvided = transfer.transfer.GasLimit
+	frame.GasLocked = transfer.transfer.GasLocked
+}
+
+// isRootTransfer returns true if the event is the value transfer of the transaction itself, logged before any other call
+func (builder *executionTraceBuilder) isRootTransfer(root *txSimData.CallFrame, entry *vmcommon.LogEntry) bool {
+	if len(root.Calls) > 0 || len(entry.Topics) < 2 {
+		return false
+	}
+
+	return builder.encodeAddress(entry.Address) == root.Caller && builder.encodeAddress(entry.Topics[1]) == root.Callee
+}
+
+// findParent returns the latest frame executed on the provided address, along with the stack of frames leading to it.
+// If there is no such frame, the root frame is returned.
+func (builder *executionTraceBuilder) findParent(stack []*txSimData.CallFrame, address []byte) ([]*txSimData.CallFrame, *txSimData.CallFrame) {
+	encodedAddress := builder.encodeAddress(address)
+	for i := len(stack) - 1; i >= 0; i-- {
+		if stack[i].Callee == encodedAddress {
+			return stack[:i+1], stack[i]
+		}
+	}
+
+	return stack[:1], stack[0]
+}
+
+// findLatestFrame returns the latest frame executed on the provided address or, if there is no such frame, the root frame
+func (builder *executionTraceBuilder) findLatestFrame(root *txSimData.CallFrame, address []byte) *txSimData.CallFrame {
+	encodedAddress := builder.encodeAddress(address)
+	frames := collectExecutedFrames(root, nil)
+	for i := len(frames) - 1; i >= 0; i-- {
+		if frames[i].Callee == encodedAddress {
+			return frames[i]
+		}
+	}
+
+	return root
+}
+
+func (builder *executionTraceBuilder) pushFrame(stack []*txSimData.CallFrame, caller []byte, frame *txSimData.CallFrame) []*txSimData.CallFrame {
+	var parent *txSimData.CallFrame
+	stack, parent = builder.findParent(stack, caller)
+	parent.Calls = append(parent.Calls, frame)
+
+	return append(stack, frame)
+}
+
+func (builder *executionTraceBuilder) createValueTransferFrame(entry *vmcommon.LogEntry) *txSimData.CallFrame {
+	frame := &txSimData.CallFrame{
+		CallType:   getCallType(entry.Data),
+		Caller:     builder.encodeAddress(entry.Address),
+		Callee:     builder.encodeAddress(entry.Topics[1]),
+		CrossShard: builder.isCrossShard(entry.Topics[1]),
+		Value:      big.NewInt(0).SetBytes(entry.Topics[0]).String(),
+		Events:     []*transaction.Events{builder.createEvent(entry)},
+	}
+	if len(entry.Data) > 1 {
+		frame.Function = string(entry.Data[1])
+		frame.Arguments = hexEncodeAll(entry.Data[2:])
+	}
+
+	return frame
+}
+
+func (builder *executionTraceBuilder) createESDTTransferFrame(entry *vmcommon.LogEntry) *txSimData.CallFrame {
+	numTransfers := (len(entry.Topics) - 1) / esdtTransferTopicsLen
+	destination := entry.Topics[len(entry.Topics)-1]
+	frame := &txSimData.CallFrame{
+		CallType:        getCallType(entry.Data),
+		BuiltInFunction: string(entry.Identifier),
+		Caller:          builder.encodeAddress(entry.Address),
+		Callee:          builder.encodeAddress(destination),
+		CrossShard:      builder.isCrossShard(destination),
+		Value:           "0",
+		ESDTTransfers:   make([]*txSimData.ESDTTransferTrace, 0, numTransfers),
+		Events:          []*transaction.Events{builder.createEvent(entry)},
+	}
+
+	for i := 0; i < numTransfers; i++ {
+		topics := entry.Topics[i*esdtTransferTopicsLen : (i+1)*esdtTransferTopicsLen]
+		frame.ESDTTransfers = append(frame.ESDTTransfers, &txSimData.ESDTTransferTrace{
+			TokenIdentifier: string(topics[0]),
+			Nonce:           big.NewInt(0).SetBytes(topics[1]).Uint64(),
+			Value:           big.NewInt(0).SetBytes(topics[2]).String(),
+		})
+	}
+
+	if len(entry.Data) < 2 {
+		return frame
+	}
+
+	builtInFunctionArgs := entry.Data[2:]
+	numLeadingArgs := esdtTransferFunctions[string(entry.Identifier)]
+	if string(entry.Identifier) == core.BuiltInFunctionMultiESDTNFTTransfer {
+		numLeadingArgs += numTransfers * esdtTransferTopicsLen
+	}
+	if len(builtInFunctionArgs) > numLeadingArgs {
+		frame.Function = string(builtInFunctionArgs[numLeadingArgs])
+		frame.Arguments = hexEncodeAll(builtInFunctionArgs[numLeadingArgs+1:])
+	}
+
+	return frame
+}
+
+func (builder *executionTraceBuilder) createBuiltInFunctionFrame(entry *vmcommon.LogEntry) *txSimData.CallFrame {
+	return &txSimData.CallFrame{
+		CallType:        builtInFunctionCallType,
+		BuiltInFunction: string(entry.Identifier),
+		Caller:          builder.encodeAddress(entry.Address),
+		Callee:          builder.encodeAddress(entry.Address),
+		Value:           "0",
+		Events:          []*transaction.Events{builder.createEvent(entry)},
+	}
+}
+
+func (builder *executionTraceBuilder) createOutputTransferFrame(transfer *outputTransfer) *txSimData.CallFrame {
+	callType, found := outputTransferCallTypes[transfer.transfer.CallType]
+	if !found {
+		callType = directCallType
+	}
+
+	frame := &txSimData.CallFrame{
+		CallType:   callType,
+		Caller:     builder.encodeAddress(transfer.transfer.SenderAddress),
+		Callee:     builder.encodeAddress(transfer.destination),
+		CrossShard: builder.isCrossShard(transfer.destination),
+		Value:      bigIntToString(transfer.transfer.Value),
+	}
+	addGasProvided(frame, transfer)
+
+	function, args, err := builder.argsParser.ParseData(string(transfer.transfer.Data))
+	if err != nil {
+		return frame
+	}
+
+	frame.Function = function
+	frame.Arguments = hexEncodeAll(args)
+	_, isESDTTransfer := esdtTransferFunctions[function]
+	if isESDTTransfer {
+		frame.BuiltInFunction = function
+	}
+
+	return frame
+}
+
+func (builder *executionTraceBuilder) createEvent(entry *vmcommon.LogEntry) *transaction.Events {
+	return &transaction.Events{
+		Address:        builder.encodeAddress(entry.Address),
+		Identifier:     string(entry.Identifier),
+		Topics:         entry.Topics,
+		Data:           entry.GetFirstDataItem(),
+		AdditionalData: entry.Data,
+	}
+}
+
+// addAccountsData adds the gas used and the storage accesses of each account, as reported by the VM output and by the
+// storage tracing, to the trace. They are also charged to the frame executed on that account, if it is the only one:
+// the VM output does not split them between the frames executed on the same account, so such frames are only marked
+// as sharing their account.
+func (builder *executionTraceBuilder) addAccountsData(
+	trace *txSimData.ExecutionTrace,
+	vmOutput *vmcommon.VMOutput,
+	storageAccesses []*txSimData.StorageAccess,
+) {
+	accountTraces := make(map[string]*txSimData.AccountTrace)
+	orderedAccounts := make([]*txSimData.AccountTrace, 0)
+	getAccountTrace := func(address string) *txSimData.AccountTrace {
+		accountTrace, found := accountTraces[address]
+		if !found {
+			accountTrace = &txSimData.AccountTrace{Address: address}
+			accountTraces[address] = accountTrace
+			orderedAccounts = append(orderedAccounts, accountTrace)
+		}
+
+		return accountTrace
+	}
+
+	framesByAccount := make(map[string][]*txSimData.CallFrame)
+	for _, frame := range collectExecutedFrames(trace.Root, nil) {
+		framesByAccount[frame.Callee] = append(framesByAccount[frame.Callee], frame)
+		getAccountTrace(frame.Callee)
+	}
+
+	for _, access := range storageAccesses {
+		accountTrace := getAccountTrace(builder.encodeAddress(access.Address))
+		accessTrace := &txSimData.StorageAccessTrace{
+			Key:   hex.EncodeToString(access.Key),
+			Value: hex.EncodeToString(access.Value),
+		}
+		if access.Written {
+			accountTrace.StorageWrites = append(accountTrace.StorageWrites, accessTrace)
+			continue
+		}
+		accountTrace.StorageReads = append(accountTrace.StorageReads, accessTrace)
+	}
+
+	if vmOutput != nil {
+		outputAccounts := make([]*vmcommon.OutputAccount, 0, len(vmOutput.OutputAccounts))
+		for _, outputAccount := range vmOutput.OutputAccounts {
+			if outputAccount != nil && outputAccount.GasUsed > 0 {
+				outputAccounts = append(outputAccounts, outputAccount)
+			}
+		}
+		sort.Slice(outputAccounts, func(i, j int) bool {
+			return bytes.Compare(outputAccounts[i].Address, outputAccounts[j].Address) < 0
+		})
+
+		for _, outputAccount := range outputAccounts {
+			getAccountTrace(builder.encodeAddress(outputAccount.Address)).GasUsed = outputAccount.GasUsed
+		}
+	}
+
+	for _, accountTrace := range orderedAccounts {
+		if accountTrace.GasUsed == 0 && len(accountTrace.StorageReads) == 0 && len(accountTrace.StorageWrites) == 0 {
+			continue
+		}
+
+		trace.Accounts = append(trace.Accounts, accountTrace)
+		frames := framesByAccount[accountTrace.Address]
+		if len(frames) == 1 {
+			frames[0].GasUsed = accountTrace.GasUsed
+			frames[0].StorageReads = accountTrace.StorageReads
+			frames[0].StorageWrites = accountTrace.StorageWrites
+			continue
+		}
+
+		for _, frame := range frames {
+			frame.SharedAccount = true
+		}
+	}
+}
+
+// collectExecutedFrames returns the frames in execution order. The built-in function frames are skipped, as they are
+// executed by the protocol on the account of their caller's frame.
+func collectExecutedFrames(frame *txSimData.CallFrame, frames []*txSimData.CallFrame) []*txSimData.CallFrame {
+	if frame.CallType != builtInFunctionCallType {
+		frames = append(frames, frame)
+	}
+
+	for _, call := range frame.Calls {
+		frames = collectExecutedFrames(call, frames)
+	}
+
+	return frames
+}
+
+func (builder *executionTraceBuilder) isCrossShard(address []byte) bool {
+	return builder.shardCoordinator.ComputeId(address) != builder.shardCoordinator.SelfId()
+}
+
+func (builder *executionTraceBuilder) encodeAddress(address []byte) string {
+	return builder.pubkeyConverter.SilentEncode(address, log)
+}
+
+func getCallType(data [][]byte) string {
+	if len(data) == 0 || len(data[0]) == 0 {
+		return directCallType
+	}
+
+	return string(data[0])
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+func hexEncodeAll(values [][]byte) []string {
+	encoded := make([]string, 0, len(values))
+	for _, value := range values {
+		encoded = append(encoded, hex.EncodeToString(value))
+	}
+
+	return encoded
+}
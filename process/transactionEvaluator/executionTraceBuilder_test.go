@@ -0,0 +1,286 @@
+package transactionEvaluator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/mock"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestAddress(id byte) []byte {
+	return bytes.Repeat([]byte{id}, 32)
+}
+
+func createExecutionTraceBuilderForTests(crossShardAddress []byte) *executionTraceBuilder {
+	shardCoordinator := &mock.ShardCoordinatorStub{
+		ComputeIdCalled: func(address []byte) uint32 {
+			if bytes.Equal(address, crossShardAddress) {
+				return 1
+			}
+			return 0
+		},
+		SelfIdCalled: func() uint32 {
+			return 0
+		},
+	}
+
+	return newExecutionTraceBuilder(testscommon.NewPubkeyConverterMock(32), shardCoordinator)
+}
+
+func TestExecutionTraceBuilder_BuildMoveBalance(t *testing.T) {
+	t.Parallel()
+
+	sender, receiver := createTestAddress('s'), createTestAddress('r')
+	builder := createExecutionTraceBuilderForTests(nil)
+
+	trace := builder.build(&executionTraceInput{
+		tx:         &transaction.Transaction{SndAddr: sender, RcvAddr: receiver, Value: big.NewInt(10), GasLimit: 70000, Data: []byte("memo")},
+		txType:     process.MoveBalance,
+		results:    &txSimData.SimulationResultsWithVMOutput{SimulationResults: transaction.SimulationResults{Status: transaction.TxStatusSuccess}},
+		gasUsed:    50000,
+		blockNonce: 7,
+	})
+
+	expectedTrace := &txSimData.ExecutionTrace{
+		Status:     transaction.TxStatusSuccess,
+		GasLimit:   70000,
+		GasUsed:    50000,
+		BlockNonce: 7,
+		Root: &txSimData.CallFrame{
+			CallType: process.MoveBalance.String(),
+			Caller:   hex.EncodeToString(sender),
+			Callee:   hex.EncodeToString(receiver),
+			Value:    "10",
+		},
+	}
+	require.Equal(t, expectedTrace, trace)
+}
+
+func TestExecutionTraceBuilder_BuildShouldRecreateTheCallTree(t *testing.T) {
+	t.Parallel()
+
+	user, scA, scB, scC, scD := createTestAddress('u'), createTestAddress('a'), createTestAddress('b'), createTestAddress('c'), createTestAddress('d')
+	builder := createExecutionTraceBuilderForTests(scC)
+
+	logs := []*vmcommon.LogEntry{
+		// the transfer of the transaction itself
+		{
+			Identifier: []byte(transferValueOnlyIdentifier),
+			Address:    user,
+			Topics:     [][]byte{big.NewInt(3).Bytes(), scA},
+			Data:       [][]byte{[]byte(""), []byte("f"), {1}},
+		},
+		{
+			Identifier: []byte(transferValueOnlyIdentifier),
+			Address:    scA,
+			Topics:     [][]byte{big.NewInt(5).Bytes(), scB},
+			Data:       [][]byte{[]byte("ExecuteOnDestContext"), []byte("g"), {2}},
+		},
+		{
+			Identifier: []byte(core.BuiltInFunctionESDTLocalMint),
+			Address:    scB,
+			Topics:     [][]byte{[]byte("TKN-abcdef"), {}, big.NewInt(100).Bytes()},
+		},
+		{
+			Identifier: []byte("eventOfB"),
+			Address:    scB,
+		},
+		{
+			Identifier: []byte(core.BuiltInFunctionMultiESDTNFTTransfer),
+			Address:    scA,
+			Topics: [][]byte{
+				[]byte("TKN-abcdef"), {}, big.NewInt(40).Bytes(),
+				[]byte("NFT-abcdef"), {2}, big.NewInt(1).Bytes(),
+				scC,
+			},
+			Data: [][]byte{
+				[]byte("AsyncCall"),
+				[]byte(core.BuiltInFunctionMultiESDTNFTTransfer),
+				scC, {2},
+				[]byte("TKN-abcdef"), {}, big.NewInt(40).Bytes(),
+				[]byte("NFT-abcdef"), {2}, big.NewInt(1).Bytes(),
+				[]byte("h"), {4},
+			},
+		},
+		{
+			Identifier: []byte(transferValueOnlyIdentifier),
+			Address:    scA,
+			Topics:     [][]byte{{}, scB},
+			Data:       [][]byte{[]byte("ExecuteOnDestContext"), []byte("k")},
+		},
+		{
+			Identifier: []byte("eventOfA"),
+			Address:    scA,
+		},
+		{
+			Identifier: []byte("eventOfUnknownAddress"),
+			Address:    createTestAddress('x'),
+		},
+		nil,
+	}
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.UserError,
+		GasRemaining: 100,
+		Logs:         logs,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(scA): {Address: scA, GasUsed: 300},
+			string(scB): {Address: scB, GasUsed: 200},
+			string(scC): {
+				Address: scC,
+				OutputTransfers: []vmcommon.OutputTransfer{
+					{Index: 2, Value: big.NewInt(0), GasLimit: 500, GasLocked: 100, CallType: vm.AsynchronousCall, SenderAddress: scA},
+				},
+			},
+			string(scD): {
+				Address: scD,
+				OutputTransfers: []vmcommon.OutputTransfer{
+					{Index: 1, Value: big.NewInt(9), GasLimit: 50, CallType: vm.DirectCall, Data: []byte("claim@05"), SenderAddress: scB},
+				},
+			},
+		},
+	}
+	storageAccesses := []*txSimData.StorageAccess{
+		{Address: scA, Key: []byte("ka"), Value: []byte("va")},
+		{Address: scB, Key: []byte("kb"), Value: []byte("vb"), Written: true},
+		{Address: createTestAddress('x'), Key: []byte("kx"), Value: []byte("vx")},
+	}
+
+	trace := builder.build(&executionTraceInput{
+		tx:              &transaction.Transaction{SndAddr: user, RcvAddr: scA, Value: big.NewInt(3), GasLimit: 1000, Data: []byte("f@01")},
+		txType:          process.SCInvoking,
+		results:         &txSimData.SimulationResultsWithVMOutput{SimulationResults: transaction.SimulationResults{Status: transaction.TxStatusFail, FailReason: "fail"}, VMOutput: vmOutput},
+		storageAccesses: storageAccesses,
+		gasUsed:         900,
+		blockNonce:      8,
+	})
+
+	require.Equal(t, transaction.TxStatusFail, trace.Status)
+	require.Equal(t, "fail", trace.FailReason)
+	require.Equal(t, vmcommon.UserError.String(), trace.ReturnCode)
+	require.Equal(t, uint64(900), trace.GasUsed)
+
+	root := trace.Root
+	require.Equal(t, process.SCInvoking.String(), root.CallType)
+	require.Equal(t, "f", root.Function)
+	require.Equal(t, []string{"01"}, root.Arguments)
+	// the root frame is the only one executed on its account
+	require.Equal(t, uint64(300), root.GasUsed)
+	require.False(t, root.SharedAccount)
+	readOfA := &txSimData.StorageAccessTrace{Key: hex.EncodeToString([]byte("ka")), Value: hex.EncodeToString([]byte("va"))}
+	require.Equal(t, []*txSimData.StorageAccessTrace{readOfA}, root.StorageReads)
+	require.Len(t, root.Events, 3)
+	require.Equal(t, transferValueOnlyIdentifier, root.Events[0].Identifier)
+	require.Equal(t, "eventOfA", root.Events[1].Identifier)
+	require.Equal(t, "eventOfUnknownAddress", root.Events[2].Identifier)
+	require.Len(t, root.Calls, 3)
+
+	callToB := root.Calls[0]
+	require.Equal(t, "ExecuteOnDestContext", callToB.CallType)
+	require.Equal(t, hex.EncodeToString(scA), callToB.Caller)
+	require.Equal(t, hex.EncodeToString(scB), callToB.Callee)
+	require.False(t, callToB.CrossShard)
+	require.Equal(t, "g", callToB.Function)
+	require.Equal(t, []string{"02"}, callToB.Arguments)
+	require.Equal(t, "5", callToB.Value)
+	require.Zero(t, callToB.GasProvided)
+	// the gas used and the storage accesses of B can not be split between its two frames
+	require.True(t, callToB.SharedAccount)
+	require.Zero(t, callToB.GasUsed)
+	require.Empty(t, callToB.StorageWrites)
+	require.Len(t, callToB.Events, 2)
+	require.Equal(t, "eventOfB", callToB.Events[1].Identifier)
+	require.Len(t, callToB.Calls, 1)
+	require.Equal(t, builtInFunctionCallType, callToB.Calls[0].CallType)
+	require.Equal(t, core.BuiltInFunctionESDTLocalMint, callToB.Calls[0].BuiltInFunction)
+
+	callToC := root.Calls[1]
+	require.Equal(t, "AsyncCall", callToC.CallType)
+	require.Equal(t, core.BuiltInFunctionMultiESDTNFTTransfer, callToC.BuiltInFunction)
+	require.Equal(t, hex.EncodeToString(scC), callToC.Callee)
+	require.True(t, callToC.CrossShard)
+	require.Equal(t, "h", callToC.Function)
+	require.Equal(t, []string{"04"}, callToC.Arguments)
+	require.Equal(t, "0", callToC.Value)
+	require.Equal(t, uint64(500), callToC.GasProvided)
+	require.Equal(t, uint64(100), callToC.GasLocked)
+	require.Equal(t, []*txSimData.ESDTTransferTrace{
+		{TokenIdentifier: "TKN-abcdef", Nonce: 0, Value: "40"},
+		{TokenIdentifier: "NFT-abcdef", Nonce: 2, Value: "1"},
+	}, callToC.ESDTTransfers)
+	require.Empty(t, callToC.Calls)
+
+	secondCallToB := root.Calls[2]
+	require.Equal(t, "k", secondCallToB.Function)
+	require.True(t, secondCallToB.SharedAccount)
+	require.Len(t, secondCallToB.Calls, 1)
+
+	// the output transfer without event is added to the latest frame executed on its sender
+	transferToD := secondCallToB.Calls[0]
+	require.Equal(t, directCallType, transferToD.CallType)
+	require.Equal(t, hex.EncodeToString(scB), transferToD.Caller)
+	require.Equal(t, hex.EncodeToString(scD), transferToD.Callee)
+	require.Equal(t, "9", transferToD.Value)
+	require.Equal(t, "claim", transferToD.Function)
+	require.Equal(t, []string{"05"}, transferToD.Arguments)
+	require.Equal(t, uint64(50), transferToD.GasProvided)
+
+	expectedAccounts := []*txSimData.AccountTrace{
+		{Address: hex.EncodeToString(scA), GasUsed: 300, StorageReads: []*txSimData.StorageAccessTrace{readOfA}},
+		{
+			Address:       hex.EncodeToString(scB),
+			GasUsed:       200,
+			StorageWrites: []*txSimData.StorageAccessTrace{{Key: hex.EncodeToString([]byte("kb")), Value: hex.EncodeToString([]byte("vb"))}},
+		},
+		{
+			Address:      hex.EncodeToString(createTestAddress('x')),
+			StorageReads: []*txSimData.StorageAccessTrace{{Key: hex.EncodeToString([]byte("kx")), Value: hex.EncodeToString([]byte("vx"))}},
+		},
+	}
+	require.Equal(t, expectedAccounts, trace.Accounts)
+}
+
+func TestExecutionTraceBuilder_ESDTTransferFrames(t *testing.T) {
+	t.Parallel()
+
+	scA, scB := createTestAddress('a'), createTestAddress('b')
+	builder := createExecutionTraceBuilderForTests(nil)
+
+	t.Run("ESDTTransfer", func(t *testing.T) {
+		t.Parallel()
+
+		frame := builder.createESDTTransferFrame(&vmcommon.LogEntry{
+			Identifier: []byte(core.BuiltInFunctionESDTTransfer),
+			Address:    scA,
+			Topics:     [][]byte{[]byte("TKN-abcdef"), {}, big.NewInt(7).Bytes(), scB},
+			Data:       [][]byte{[]byte("TransferAndExecute"), []byte(core.BuiltInFunctionESDTTransfer), []byte("TKN-abcdef"), {7}, []byte("deposit"), {1}},
+		})
+		require.Equal(t, "TransferAndExecute", frame.CallType)
+		require.Equal(t, "deposit", frame.Function)
+		require.Equal(t, []string{"01"}, frame.Arguments)
+		require.Equal(t, []*txSimData.ESDTTransferTrace{{TokenIdentifier: "TKN-abcdef", Value: "7"}}, frame.ESDTTransfers)
+	})
+	t.Run("ESDTNFTTransfer without function", func(t *testing.T) {
+		t.Parallel()
+
+		frame := builder.createESDTTransferFrame(&vmcommon.LogEntry{
+			Identifier: []byte(core.BuiltInFunctionESDTNFTTransfer),
+			Address:    scA,
+			Topics:     [][]byte{[]byte("NFT-abcdef"), {3}, big.NewInt(1).Bytes(), scB},
+			Data:       [][]byte{{}, []byte(core.BuiltInFunctionESDTNFTTransfer), []byte("NFT-abcdef"), {3}, {1}, scB},
+		})
+		require.Equal(t, directCallType, frame.CallType)
+		require.Empty(t, frame.Function)
+		require.Equal(t, hex.EncodeToString(scB), frame.Callee)
+		require.Equal(t, []*txSimData.ESDTTransferTrace{{TokenIdentifier: "NFT-abcdef", Nonce: 3, Value: "1"}}, frame.ESDTTransfers)
+	})
+}
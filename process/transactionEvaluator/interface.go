@@ -1,7 +1,10 @@
 package transactionEvaluator
 
 import (
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/state"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	datafield "github.com/multiversx/mx-chain-vm-common-go/parsers/dataField"
 )
@@ -13,6 +16,16 @@ type TransactionProcessor interface {
 	IsInterfaceNil() bool
 }
 
+// SimulationAccountsAdapter defines the accounts adapter used when simulating transactions, able to alter the state
+// with the provided overrides, to work on a historical state and to record the storage accesses
+type SimulationAccountsAdapter interface {
+	state.AccountsAdapterWithClean
+	ApplyStateOverrides(overrides []*txSimData.StateOverride) error
+	SetHistoricalState(options api.AccountQueryOptions)
+	StartStorageTracing()
+	StopStorageTracing() []*txSimData.StorageAccess
+}
+
 // DataFieldParser defines what a data field parser should be able to do
 type DataFieldParser interface {
 	Parse(dataField []byte, sender, receiver []byte, numOfShards uint32) *datafield.ResponseParseData
@@ -2,31 +2,70 @@ package transactionEvaluator
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math/big"
 	"sync"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
 
+type nonceSetter interface {
+	SetNonce(nonce uint64)
+}
+
 // simulationAccountsDB is a wrapper over an accounts db which works read-only. write operation are disabled
 type simulationAccountsDB struct {
-	mutex            sync.RWMutex
-	cachedAccounts   map[string]vmcommon.AccountHandler
-	originalAccounts state.AccountsAdapter
+	mutex              sync.RWMutex
+	cachedAccounts     map[string]vmcommon.AccountHandler
+	overriddenCodes    map[string][]byte
+	originalAccounts   state.AccountsAdapter
+	accountsRepository state.AccountsRepository
+	historicalState    *api.AccountQueryOptions
+	isTracing          bool
+	storageAccesses    []*txSimData.StorageAccess
+	marshaller         marshal.Marshalizer
+	hasher             hashing.Hasher
 }
 
 // NewSimulationAccountsDB returns a new instance of simulationAccountsDB
-func NewSimulationAccountsDB(accountsDB state.AccountsAdapter) (*simulationAccountsDB, error) {
+func NewSimulationAccountsDB(
+	accountsDB state.AccountsAdapter,
+	accountsRepository state.AccountsRepository,
+	marshaller marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (*simulationAccountsDB, error) {
 	if check.IfNil(accountsDB) {
 		return nil, ErrNilAccountsAdapter
 	}
+	if check.IfNil(accountsRepository) {
+		return nil, ErrNilAccountsRepository
+	}
+	if check.IfNil(marshaller) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
 
 	return &simulationAccountsDB{
-		mutex:            sync.RWMutex{},
-		cachedAccounts:   make(map[string]vmcommon.AccountHandler),
-		originalAccounts: accountsDB,
+		mutex:              sync.RWMutex{},
+		cachedAccounts:     make(map[string]vmcommon.AccountHandler),
+		overriddenCodes:    make(map[string][]byte),
+		originalAccounts:   accountsDB,
+		accountsRepository: accountsRepository,
+		marshaller:         marshaller,
+		hasher:             hasher,
 	}, nil
 }
 
@@ -42,24 +81,41 @@ func (r *simulationAccountsDB) StartSnapshotIfNeeded() error {
 
 // GetCode returns the code for the given account
 func (r *simulationAccountsDB) GetCode(codeHash []byte) []byte {
-	return r.originalAccounts.GetCode(codeHash)
+	r.mutex.RLock()
+	code, found := r.overriddenCodes[string(codeHash)]
+	historicalState := r.historicalState
+	r.mutex.RUnlock()
+	if found {
+		return code
+	}
+	if historicalState == nil {
+		return r.originalAccounts.GetCode(codeHash)
+	}
+
+	code, _, err := r.accountsRepository.GetCodeWithBlockInfo(codeHash, *historicalState)
+	if err != nil {
+		log.Debug("simulationAccountsDB.GetCode: cannot get code from the historical state", "code hash", codeHash, "error", err)
+		return nil
+	}
+
+	return code
 }
 
 // GetExistingAccount will call the original accounts' function with the same name
 func (r *simulationAccountsDB) GetExistingAccount(address []byte) (vmcommon.AccountHandler, error) {
 	cachedAccount, ok := r.getFromCache(address)
 	if ok {
-		return cachedAccount, nil
+		return r.wrapIfTracing(cachedAccount), nil
 	}
 
-	account, err := r.originalAccounts.GetExistingAccount(address)
+	account, err := r.getOriginalAccount(address, false)
 	if err != nil {
 		return nil, err
 	}
 
 	r.addToCache(account)
 
-	return account, nil
+	return r.wrapIfTracing(account), nil
 }
 
 // GetAccountFromBytes will call the original accounts' function with the same name
@@ -71,17 +127,56 @@ func (r *simulationAccountsDB) GetAccountFromBytes(address []byte, accountBytes
 func (r *simulationAccountsDB) LoadAccount(address []byte) (vmcommon.AccountHandler, error) {
 	cachedAccount, ok := r.getFromCache(address)
 	if ok {
-		return cachedAccount, nil
+		return r.wrapIfTracing(cachedAccount), nil
 	}
 
-	account, err := r.originalAccounts.LoadAccount(address)
+	account, err := r.getOriginalAccount(address, true)
 	if err != nil {
 		return nil, err
 	}
 
 	r.addToCache(account)
 
-	return account, nil
+	return r.wrapIfTracing(account), nil
+}
+
+func (r *simulationAccountsDB) getOriginalAccount(address []byte, createIfMissing bool) (vmcommon.AccountHandler, error) {
+	r.mutex.RLock()
+	historicalState := r.historicalState
+	r.mutex.RUnlock()
+
+	if historicalState == nil {
+		if createIfMissing {
+			return r.originalAccounts.LoadAccount(address)
+		}
+
+		return r.originalAccounts.GetExistingAccount(address)
+	}
+
+	account, _, err := r.accountsRepository.GetAccountWithBlockInfo(address, *historicalState)
+	var errAccountNotFound *state.ErrAccountNotFoundAtBlock
+	if !errors.As(err, &errAccountNotFound) {
+		return account, err
+	}
+	if !createIfMissing {
+		return nil, state.ErrAccNotFound
+	}
+
+	return r.createEmptyAccount(address)
+}
+
+// createEmptyAccount creates a new account through the original accounts, without looking it up in the current state
+func (r *simulationAccountsDB) createEmptyAccount(address []byte) (vmcommon.AccountHandler, error) {
+	accountBytes, err := r.marshaller.Marshal(&accounts.UserAccountData{
+		Address:         address,
+		Balance:         big.NewInt(0),
+		DeveloperReward: big.NewInt(0),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.originalAccounts.GetAccountFromBytes(address, accountBytes)
 }
 
 // SaveAccount won't do anything as write operations are disabled on this component
@@ -90,6 +185,11 @@ func (r *simulationAccountsDB) SaveAccount(account vmcommon.AccountHandler) erro
 		return nil
 	}
 
+	tracedAccount, ok := account.(*tracedUserAccount)
+	if ok {
+		account = tracedAccount.userAccountHandler
+	}
+
 	r.addToCache(account)
 
 	return nil
@@ -177,13 +277,217 @@ func (r *simulationAccountsDB) IsInterfaceNil() bool {
 	return r == nil
 }
 
-// CleanCache will clean the internal map with the cached accounts
+// CleanCache will clean the internal map with the cached accounts, dropping the applied state overrides, the historical
+// state and the recorded storage accesses as well
 func (r *simulationAccountsDB) CleanCache() {
 	r.mutex.Lock()
 	r.cachedAccounts = make(map[string]vmcommon.AccountHandler)
+	r.overriddenCodes = make(map[string][]byte)
+	r.historicalState = nil
+	r.isTracing = false
+	r.storageAccesses = nil
+	r.mutex.Unlock()
+}
+
+// SetHistoricalState makes the component read the accounts from the state described by the provided options (which
+// should hold the root hash) instead of the current state, until the cache is cleaned
+func (r *simulationAccountsDB) SetHistoricalState(options api.AccountQueryOptions) {
+	r.mutex.Lock()
+	r.historicalState = &options
 	r.mutex.Unlock()
 }
 
+// StartStorageTracing starts recording the storage reads and writes done on the loaded accounts
+func (r *simulationAccountsDB) StartStorageTracing() {
+	r.mutex.Lock()
+	r.isTracing = true
+	r.storageAccesses = make([]*txSimData.StorageAccess, 0)
+	r.mutex.Unlock()
+}
+
+// StopStorageTracing stops recording the storage accesses and returns the ones recorded since the tracing was started
+func (r *simulationAccountsDB) StopStorageTracing() []*txSimData.StorageAccess {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	storageAccesses := r.storageAccesses
+	r.isTracing = false
+	r.storageAccesses = nil
+
+	return storageAccesses
+}
+
+func (r *simulationAccountsDB) recordStorageAccess(address []byte, key []byte, value []byte, written bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if !r.isTracing {
+		return
+	}
+
+	r.storageAccesses = append(r.storageAccesses, &txSimData.StorageAccess{
+		Address: address,
+		Key:     key,
+		Value:   value,
+		Written: written,
+	})
+}
+
+func (r *simulationAccountsDB) wrapIfTracing(account vmcommon.AccountHandler) vmcommon.AccountHandler {
+	r.mutex.RLock()
+	isTracing := r.isTracing
+	r.mutex.RUnlock()
+	if !isTracing {
+		return account
+	}
+
+	userAccount, ok := account.(userAccountHandler)
+	if !ok {
+		return account
+	}
+
+	return &tracedUserAccount{
+		userAccountHandler: userAccount,
+		recorder:           r,
+	}
+}
+
+// ApplyStateOverrides alters the cached accounts with the provided overrides. The original accounts are never changed,
+// while the overrides are kept until the cache is cleaned
+func (r *simulationAccountsDB) ApplyStateOverrides(overrides []*txSimData.StateOverride) error {
+	for index, override := range overrides {
+		err := r.applyStateOverride(override)
+		if err != nil {
+			return fmt.Errorf("%w for state override at index %d", err, index)
+		}
+	}
+
+	return nil
+}
+
+func (r *simulationAccountsDB) applyStateOverride(override *txSimData.StateOverride) error {
+	if override == nil {
+		return ErrNilStateOverride
+	}
+	if len(override.Address) == 0 {
+		return ErrEmptyStateOverrideAddress
+	}
+
+	account, err := r.LoadAccount(override.Address)
+	if err != nil {
+		return err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return ErrWrongTypeAssertion
+	}
+
+	if override.Balance != nil {
+		err = setBalance(userAccount, override.Balance)
+		if err != nil {
+			return err
+		}
+	}
+	if override.Nonce != nil {
+		err = setNonce(userAccount, *override.Nonce)
+		if err != nil {
+			return err
+		}
+	}
+	if override.Code != nil {
+		r.setCode(userAccount, override.Code)
+	}
+	if override.CodeMetadata != nil {
+		userAccount.SetCodeMetadata(override.CodeMetadata)
+	}
+	for key, value := range override.Storage {
+		err = userAccount.SaveKeyValue([]byte(key), value)
+		if err != nil {
+			return err
+		}
+	}
+	for _, esdtBalance := range override.ESDTBalances {
+		err = r.setESDTBalance(userAccount, esdtBalance)
+		if err != nil {
+			return err
+		}
+	}
+
+	return r.SaveAccount(userAccount)
+}
+
+func setBalance(account state.UserAccountHandler, balance *big.Int) error {
+	if balance.Sign() < 0 {
+		return ErrNegativeBalanceOverride
+	}
+
+	err := account.SubFromBalance(account.GetBalance())
+	if err != nil {
+		return err
+	}
+
+	return account.AddToBalance(balance)
+}
+
+func setNonce(account state.UserAccountHandler, nonce uint64) error {
+	accountWithNonceSetter, ok := account.(nonceSetter)
+	if !ok {
+		return ErrWrongTypeAssertion
+	}
+
+	accountWithNonceSetter.SetNonce(nonce)
+
+	return nil
+}
+
+func (r *simulationAccountsDB) setCode(account state.UserAccountHandler, code []byte) {
+	codeHash := r.hasher.Compute(string(code))
+	account.SetCode(code)
+	account.SetCodeHash(codeHash)
+
+	r.mutex.Lock()
+	r.overriddenCodes[string(codeHash)] = code
+	r.mutex.Unlock()
+}
+
+func (r *simulationAccountsDB) setESDTBalance(account state.UserAccountHandler, esdtBalance *txSimData.ESDTBalanceOverride) error {
+	if esdtBalance == nil || len(esdtBalance.TokenIdentifier) == 0 || esdtBalance.Balance == nil {
+		return ErrInvalidESDTBalanceOverride
+	}
+	if esdtBalance.Balance.Sign() < 0 {
+		return ErrNegativeBalanceOverride
+	}
+
+	key := []byte(core.ProtectedKeyPrefix + core.ESDTKeyIdentifier)
+	key = append(key, esdtBalance.TokenIdentifier...)
+	tokenType := core.Fungible
+	if esdtBalance.Nonce > 0 {
+		key = append(key, big.NewInt(0).SetUint64(esdtBalance.Nonce).Bytes()...)
+		tokenType = core.NonFungible
+	}
+
+	token := &esdt.ESDigitalToken{
+		Type: uint32(tokenType),
+	}
+	// the existing token data (such as the metadata) is kept, only the balance is altered
+	existingData, _, err := account.RetrieveValue(key)
+	if err == nil && len(existingData) > 0 {
+		err = r.marshaller.Unmarshal(token, existingData)
+		if err != nil {
+			return err
+		}
+	}
+	token.Value = big.NewInt(0).Set(esdtBalance.Balance)
+
+	marshalledToken, err := r.marshaller.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	return account.SaveKeyValue(key, marshalledToken)
+}
+
 func (r *simulationAccountsDB) addToCache(account vmcommon.AccountHandler) {
 	r.mutex.Lock()
 	r.cachedAccounts[string(account.AddressBytes())] = account
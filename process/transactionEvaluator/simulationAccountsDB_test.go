@@ -2,15 +2,23 @@ package transactionEvaluator
 
 import (
 	"context"
+	"math/big"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/errChan"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
 	"github.com/multiversx/mx-chain-go/state/parsers"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	testTrie "github.com/multiversx/mx-chain-go/testscommon/trie"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/stretchr/testify/require"
 )
@@ -18,15 +26,39 @@ import (
 func TestNewReadOnlyAccountsDB_NilOriginalAccountsDBShouldErr(t *testing.T) {
 	t.Parallel()
 
-	simAccountsDB, err := NewSimulationAccountsDB(nil)
+	simAccountsDB, err := NewSimulationAccountsDB(nil, &stateMock.AccountsRepositoryStub{}, &marshallerMock.MarshalizerMock{}, &hashingMocks.HasherMock{})
 	require.True(t, check.IfNil(simAccountsDB))
 	require.Equal(t, ErrNilAccountsAdapter, err)
 }
 
+func TestNewReadOnlyAccountsDB_NilAccountsRepositoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	simAccountsDB, err := NewSimulationAccountsDB(&stateMock.AccountsStub{}, nil, &marshallerMock.MarshalizerMock{}, &hashingMocks.HasherMock{})
+	require.True(t, check.IfNil(simAccountsDB))
+	require.Equal(t, ErrNilAccountsRepository, err)
+}
+
+func TestNewReadOnlyAccountsDB_NilMarshallerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	simAccountsDB, err := NewSimulationAccountsDB(&stateMock.AccountsStub{}, &stateMock.AccountsRepositoryStub{}, nil, &hashingMocks.HasherMock{})
+	require.True(t, check.IfNil(simAccountsDB))
+	require.Equal(t, ErrNilMarshalizer, err)
+}
+
+func TestNewReadOnlyAccountsDB_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	simAccountsDB, err := NewSimulationAccountsDB(&stateMock.AccountsStub{}, &stateMock.AccountsRepositoryStub{}, &marshallerMock.MarshalizerMock{}, nil)
+	require.True(t, check.IfNil(simAccountsDB))
+	require.Equal(t, ErrNilHasher, err)
+}
+
 func TestNewReadOnlyAccountsDB(t *testing.T) {
 	t.Parallel()
 
-	simAccountsDB, err := NewSimulationAccountsDB(&stateMock.AccountsStub{})
+	simAccountsDB, err := NewSimulationAccountsDB(&stateMock.AccountsStub{}, &stateMock.AccountsRepositoryStub{}, &marshallerMock.MarshalizerMock{}, &hashingMocks.HasherMock{})
 	require.False(t, check.IfNil(simAccountsDB))
 	require.NoError(t, err)
 }
@@ -71,7 +103,7 @@ func TestReadOnlyAccountsDB_WriteOperationsShouldNotCalled(t *testing.T) {
 		},
 	}
 
-	simAccountsDB, _ := NewSimulationAccountsDB(accDb)
+	simAccountsDB, _ := NewSimulationAccountsDB(accDb, &stateMock.AccountsRepositoryStub{}, &marshallerMock.MarshalizerMock{}, &hashingMocks.HasherMock{})
 	require.NotNil(t, simAccountsDB)
 
 	err := simAccountsDB.SaveAccount(nil)
@@ -124,7 +156,7 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 		},
 	}
 
-	simAccountsDB, _ := NewSimulationAccountsDB(accDb)
+	simAccountsDB, _ := NewSimulationAccountsDB(accDb, &stateMock.AccountsRepositoryStub{}, &marshallerMock.MarshalizerMock{}, &hashingMocks.HasherMock{})
 	require.NotNil(t, simAccountsDB)
 
 	actualAcc, err := simAccountsDB.GetExistingAccount(nil)
@@ -155,3 +187,243 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 	err = allLeaves.ErrChan.ReadFromChanNonBlocking()
 	require.NoError(t, err)
 }
+
+func createUserAccountWithStorage(t *testing.T, address []byte, storage map[string][]byte) state.UserAccountHandler {
+	tracker := &testTrie.DataTrieTrackerStub{
+		RetrieveValueCalled: func(key []byte) ([]byte, uint32, error) {
+			return storage[string(key)], 0, nil
+		},
+		SaveKeyValueCalled: func(key []byte, value []byte) error {
+			storage[string(key)] = value
+			return nil
+		},
+	}
+	account, err := accounts.NewUserAccount(address, tracker, &testTrie.TrieLeafParserStub{})
+	require.NoError(t, err)
+
+	return account
+}
+
+func TestSimulationAccountsDB_ApplyStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	marshaller := &marshallerMock.MarshalizerMock{}
+	hasher := &hashingMocks.HasherMock{}
+
+	t.Run("invalid overrides should error", func(t *testing.T) {
+		t.Parallel()
+
+		simAccountsDB, _ := NewSimulationAccountsDB(&stateMock.AccountsStub{}, &stateMock.AccountsRepositoryStub{}, marshaller, hasher)
+
+		err := simAccountsDB.ApplyStateOverrides([]*txSimData.StateOverride{nil})
+		require.ErrorIs(t, err, ErrNilStateOverride)
+
+		err = simAccountsDB.ApplyStateOverrides([]*txSimData.StateOverride{{}})
+		require.ErrorIs(t, err, ErrEmptyStateOverrideAddress)
+	})
+	t.Run("negative balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		accDb := &stateMock.AccountsStub{
+			LoadAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				return createUserAccountWithStorage(t, address, make(map[string][]byte)), nil
+			},
+		}
+		simAccountsDB, _ := NewSimulationAccountsDB(accDb, &stateMock.AccountsRepositoryStub{}, marshaller, hasher)
+
+		err := simAccountsDB.ApplyStateOverrides([]*txSimData.StateOverride{{Address: address, Balance: big.NewInt(-1)}})
+		require.ErrorIs(t, err, ErrNegativeBalanceOverride)
+
+		esdtBalances := []*txSimData.ESDTBalanceOverride{{TokenIdentifier: []byte("TKN-abcdef")}}
+		err = simAccountsDB.ApplyStateOverrides([]*txSimData.StateOverride{{Address: address, ESDTBalances: esdtBalances}})
+		require.ErrorIs(t, err, ErrInvalidESDTBalanceOverride)
+	})
+	t.Run("should alter only the cached account", func(t *testing.T) {
+		t.Parallel()
+
+		tokenKey := []byte(core.ProtectedKeyPrefix + core.ESDTKeyIdentifier + "NFT-abcdef")
+		tokenKey = append(tokenKey, 5)
+		existingToken := &esdt.ESDigitalToken{
+			Type:          uint32(core.NonFungible),
+			Value:         big.NewInt(1),
+			TokenMetaData: &esdt.MetaData{Name: []byte("name")},
+		}
+		existingTokenBytes, _ := marshaller.Marshal(existingToken)
+		storage := map[string][]byte{
+			string(tokenKey): existingTokenBytes,
+		}
+		account := createUserAccountWithStorage(t, address, storage)
+		_ = account.AddToBalance(big.NewInt(100))
+		account.IncreaseNonce(10)
+
+		originalCode := []byte("original code")
+		numLoadCalls := 0
+		accDb := &stateMock.AccountsStub{
+			LoadAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				numLoadCalls++
+				return account, nil
+			},
+			GetCodeCalled: func(_ []byte) []byte {
+				return originalCode
+			},
+		}
+		simAccountsDB, _ := NewSimulationAccountsDB(accDb, &stateMock.AccountsRepositoryStub{}, marshaller, hasher)
+
+		nonce := uint64(3)
+		newCode := []byte("new code")
+		err := simAccountsDB.ApplyStateOverrides([]*txSimData.StateOverride{
+			{
+				Address:      address,
+				Balance:      big.NewInt(7),
+				Nonce:        &nonce,
+				Code:         newCode,
+				CodeMetadata: []byte{1, 2},
+				Storage:      map[string][]byte{"key": []byte("value")},
+				ESDTBalances: []*txSimData.ESDTBalanceOverride{
+					{TokenIdentifier: []byte("TKN-abcdef"), Balance: big.NewInt(1000)},
+					{TokenIdentifier: []byte("NFT-abcdef"), Nonce: 5, Balance: big.NewInt(3)},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		loadedAccount, err := simAccountsDB.LoadAccount(address)
+		require.NoError(t, err)
+		require.Equal(t, 1, numLoadCalls)
+
+		userAccount := loadedAccount.(state.UserAccountHandler)
+		require.Equal(t, big.NewInt(7), userAccount.GetBalance())
+		require.Equal(t, nonce, userAccount.GetNonce())
+		require.Equal(t, []byte{1, 2}, userAccount.GetCodeMetadata())
+		require.Equal(t, hasher.Compute(string(newCode)), userAccount.GetCodeHash())
+		require.Equal(t, newCode, simAccountsDB.GetCode(userAccount.GetCodeHash()))
+		require.Equal(t, []byte("value"), storage["key"])
+
+		fungibleToken := &esdt.ESDigitalToken{}
+		err = marshaller.Unmarshal(fungibleToken, storage[core.ProtectedKeyPrefix+core.ESDTKeyIdentifier+"TKN-abcdef"])
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(1000), fungibleToken.Value)
+		require.Equal(t, uint32(core.Fungible), fungibleToken.Type)
+
+		nft := &esdt.ESDigitalToken{}
+		err = marshaller.Unmarshal(nft, storage[string(tokenKey)])
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(3), nft.Value)
+		require.Equal(t, existingToken.TokenMetaData, nft.TokenMetaData)
+
+		simAccountsDB.CleanCache()
+		require.Equal(t, originalCode, simAccountsDB.GetCode(userAccount.GetCodeHash()))
+	})
+}
+
+func TestSimulationAccountsDB_SetHistoricalState(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	options := api.AccountQueryOptions{BlockRootHash: []byte("root hash")}
+	accDb := &stateMock.AccountsStub{
+		LoadAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+		GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+		GetCodeCalled: func(_ []byte) []byte {
+			require.Fail(t, "should have not been called")
+			return nil
+		},
+		GetAccountFromBytesCalled: func(address []byte, accountBytes []byte) (vmcommon.AccountHandler, error) {
+			return accounts.NewUserAccount(address, &testTrie.DataTrieTrackerStub{}, &testTrie.TrieLeafParserStub{})
+		},
+	}
+
+	t.Run("should read the existing accounts and code from the historical state", func(t *testing.T) {
+		t.Parallel()
+
+		historicalAccount := createUserAccountWithStorage(t, address, make(map[string][]byte))
+		repository := &stateMock.AccountsRepositoryStub{
+			GetAccountWithBlockInfoCalled: func(providedAddress []byte, providedOptions api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				require.Equal(t, address, providedAddress)
+				require.Equal(t, options, providedOptions)
+				return historicalAccount, nil, nil
+			},
+			GetCodeWithBlockInfoCalled: func(codeHash []byte, providedOptions api.AccountQueryOptions) ([]byte, common.BlockInfo, error) {
+				require.Equal(t, options, providedOptions)
+				return []byte("historical code"), nil, nil
+			},
+		}
+		simAccountsDB, _ := NewSimulationAccountsDB(accDb, repository, &marshallerMock.MarshalizerMock{}, &hashingMocks.HasherMock{})
+		simAccountsDB.SetHistoricalState(options)
+
+		account, err := simAccountsDB.GetExistingAccount(address)
+		require.NoError(t, err)
+		require.Equal(t, historicalAccount, account)
+		require.Equal(t, []byte("historical code"), simAccountsDB.GetCode([]byte("code hash")))
+	})
+	t.Run("missing account should error on get and be created on load", func(t *testing.T) {
+		t.Parallel()
+
+		repository := &stateMock.AccountsRepositoryStub{
+			GetAccountWithBlockInfoCalled: func(_ []byte, _ api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				return nil, nil, state.NewErrAccountNotFoundAtBlock(nil)
+			},
+		}
+		simAccountsDB, _ := NewSimulationAccountsDB(accDb, repository, &marshallerMock.MarshalizerMock{}, &hashingMocks.HasherMock{})
+		simAccountsDB.SetHistoricalState(options)
+
+		account, err := simAccountsDB.GetExistingAccount(address)
+		require.Equal(t, state.ErrAccNotFound, err)
+		require.Nil(t, account)
+
+		account, err = simAccountsDB.LoadAccount(address)
+		require.NoError(t, err)
+		require.Equal(t, address, account.AddressBytes())
+		require.Equal(t, big.NewInt(0), account.(state.UserAccountHandler).GetBalance())
+	})
+}
+
+func TestSimulationAccountsDB_StorageTracing(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	storage := map[string][]byte{"key": []byte("value")}
+	accDb := &stateMock.AccountsStub{
+		LoadAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+			return createUserAccountWithStorage(t, address, storage), nil
+		},
+	}
+	simAccountsDB, _ := NewSimulationAccountsDB(accDb, &stateMock.AccountsRepositoryStub{}, &marshallerMock.MarshalizerMock{}, &hashingMocks.HasherMock{})
+
+	account, _ := simAccountsDB.LoadAccount(address)
+	_, _, _ = account.(state.UserAccountHandler).RetrieveValue([]byte("key"))
+
+	simAccountsDB.StartStorageTracing()
+	account, _ = simAccountsDB.LoadAccount(address)
+	userAccount := account.(state.UserAccountHandler)
+	value, _, err := userAccount.RetrieveValue([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+	err = userAccount.SaveKeyValue([]byte("new key"), []byte("new value"))
+	require.NoError(t, err)
+	_, _, _ = account.(userAccountHandler).AccountDataHandler().RetrieveValue([]byte("new key"))
+
+	err = simAccountsDB.SaveAccount(account)
+	require.NoError(t, err)
+	cachedAccount, _ := simAccountsDB.getFromCache(address)
+	_, isTraced := cachedAccount.(*tracedUserAccount)
+	require.False(t, isTraced)
+
+	expectedAccesses := []*txSimData.StorageAccess{
+		{Address: address, Key: []byte("key"), Value: []byte("value"), Written: false},
+		{Address: address, Key: []byte("new key"), Value: []byte("new value"), Written: true},
+		{Address: address, Key: []byte("new key"), Value: []byte("new value"), Written: false},
+	}
+	require.Equal(t, expectedAccesses, simAccountsDB.StopStorageTracing())
+
+	_, _, _ = userAccount.RetrieveValue([]byte("key"))
+	simAccountsDB.StartStorageTracing()
+	require.Empty(t, simAccountsDB.StopStorageTracing())
+}
@@ -0,0 +1,76 @@
+package transactionEvaluator
+
+import (
+	"github.com/multiversx/mx-chain-go/state"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+type userAccountHandler interface {
+	state.UserAccountHandler
+	AccountDataHandler() vmcommon.AccountDataHandler
+}
+
+type storageAccessRecorder interface {
+	recordStorageAccess(address []byte, key []byte, value []byte, written bool)
+}
+
+// tracedUserAccount wraps a user account, recording the storage reads and writes done through it
+type tracedUserAccount struct {
+	userAccountHandler
+	recorder storageAccessRecorder
+}
+
+// RetrieveValue returns the value of the provided key, recording the read
+func (account *tracedUserAccount) RetrieveValue(key []byte) ([]byte, uint32, error) {
+	value, depth, err := account.userAccountHandler.RetrieveValue(key)
+	if err == nil {
+		account.recorder.recordStorageAccess(account.AddressBytes(), key, value, false)
+	}
+
+	return value, depth, err
+}
+
+// SaveKeyValue saves the provided value, recording the write
+func (account *tracedUserAccount) SaveKeyValue(key []byte, value []byte) error {
+	err := account.userAccountHandler.SaveKeyValue(key, value)
+	if err == nil {
+		account.recorder.recordStorageAccess(account.AddressBytes(), key, value, true)
+	}
+
+	return err
+}
+
+// AccountDataHandler returns the data handler of the account, recording the reads and writes done through it as well
+func (account *tracedUserAccount) AccountDataHandler() vmcommon.AccountDataHandler {
+	return &tracedAccountDataHandler{
+		AccountDataHandler: account.userAccountHandler.AccountDataHandler(),
+		address:            account.AddressBytes(),
+		recorder:           account.recorder,
+	}
+}
+
+type tracedAccountDataHandler struct {
+	vmcommon.AccountDataHandler
+	address  []byte
+	recorder storageAccessRecorder
+}
+
+// RetrieveValue returns the value of the provided key, recording the read
+func (handler *tracedAccountDataHandler) RetrieveValue(key []byte) ([]byte, uint32, error) {
+	value, depth, err := handler.AccountDataHandler.RetrieveValue(key)
+	if err == nil {
+		handler.recorder.recordStorageAccess(handler.address, key, value, false)
+	}
+
+	return value, depth, err
+}
+
+// SaveKeyValue saves the provided value, recording the write
+func (handler *tracedAccountDataHandler) SaveKeyValue(key []byte, value []byte) error {
+	err := handler.AccountDataHandler.SaveKeyValue(key, value)
+	if err == nil {
+		handler.recorder.recordStorageAccess(handler.address, key, value, true)
+	}
+
+	return err
+}
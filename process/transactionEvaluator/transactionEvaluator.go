@@ -27,23 +27,25 @@ const gasUsedSlitString = "gas used = "
 
 // ArgsApiTransactionEvaluator holds the arguments required for creating a new transaction evaluator
 type ArgsApiTransactionEvaluator struct {
-	TxTypeHandler       process.TxTypeHandler
-	FeeHandler          process.FeeHandler
-	TxSimulator         facade.TransactionSimulatorProcessor
-	Accounts            state.AccountsAdapterWithClean
-	ShardCoordinator    sharding.Coordinator
-	EnableEpochsHandler common.EnableEpochsHandler
-	BlockChain          data.ChainHandler
+	TxTypeHandler          process.TxTypeHandler
+	FeeHandler             process.FeeHandler
+	TxSimulator            facade.TransactionSimulatorProcessor
+	Accounts               SimulationAccountsAdapter
+	ShardCoordinator       sharding.Coordinator
+	EnableEpochsHandler    common.EnableEpochsHandler
+	BlockChain             data.ChainHandler
+	AddressPubKeyConverter core.PubkeyConverter
 }
 
 type apiTransactionEvaluator struct {
-	accounts            state.AccountsAdapterWithClean
+	accounts            SimulationAccountsAdapter
 	shardCoordinator    sharding.Coordinator
 	txTypeHandler       process.TxTypeHandler
 	feeHandler          process.FeeHandler
 	txSimulator         facade.TransactionSimulatorProcessor
 	enableEpochsHandler common.EnableEpochsHandler
 	blockChain          data.ChainHandler
+	traceBuilder        *executionTraceBuilder
 	mutExecution        sync.RWMutex
 }
 
@@ -70,6 +72,9 @@ func NewAPITransactionEvaluator(args ArgsApiTransactionEvaluator) (*apiTransacti
 	if check.IfNil(args.BlockChain) {
 		return nil, process.ErrNilBlockChain
 	}
+	if check.IfNil(args.AddressPubKeyConverter) {
+		return nil, process.ErrNilPubkeyConverter
+	}
 	err := core.CheckHandlerCompatibility(args.EnableEpochsHandler, []core.EnableEpochFlag{
 		common.CleanUpInformativeSCRsFlag,
 	})
@@ -85,6 +90,7 @@ func NewAPITransactionEvaluator(args ArgsApiTransactionEvaluator) (*apiTransacti
 		shardCoordinator:    args.ShardCoordinator,
 		enableEpochsHandler: args.EnableEpochsHandler,
 		blockChain:          args.BlockChain,
+		traceBuilder:        newExecutionTraceBuilder(args.AddressPubKeyConverter, args.ShardCoordinator),
 	}
 
 	return tce, nil
@@ -92,25 +98,127 @@ func NewAPITransactionEvaluator(args ArgsApiTransactionEvaluator) (*apiTransacti
 
 // SimulateTransactionExecution will simulate a transaction's execution and will return the results
 func (ate *apiTransactionEvaluator) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+	return ate.SimulateTransactionExecutionWithStateOverrides(tx, nil)
+}
+
+// SimulateTransactionExecutionWithStateOverrides will simulate a transaction's execution against the current state altered
+// by the provided overrides and will return the results
+func (ate *apiTransactionEvaluator) SimulateTransactionExecutionWithStateOverrides(
+	tx *transaction.Transaction,
+	overrides []*txSimData.StateOverride,
+) (*txSimData.SimulationResultsWithVMOutput, error) {
 	ate.mutExecution.Lock()
 	defer func() {
 		ate.accounts.CleanCache()
 		ate.mutExecution.Unlock()
 	}()
 
+	err := ate.accounts.ApplyStateOverrides(overrides)
+	if err != nil {
+		return nil, err
+	}
+
 	currentHeader := ate.getCurrentBlockHeader()
 
 	return ate.txSimulator.ProcessTx(tx, currentHeader)
 }
 
+// TraceTransactionExecution will execute the transaction in trace mode, on the state and within the block context
+// described by the provided options, after the preceding transactions, and will return its execution trace
+func (ate *apiTransactionEvaluator) TraceTransactionExecution(
+	tx *transaction.Transaction,
+	options txSimData.TraceOptions,
+) (*txSimData.ExecutionTrace, error) {
+	ate.mutExecution.Lock()
+	defer func() {
+		ate.accounts.CleanCache()
+		ate.mutExecution.Unlock()
+	}()
+
+	if options.HistoricalState != nil {
+		ate.accounts.SetHistoricalState(*options.HistoricalState)
+	}
+
+	err := ate.accounts.ApplyStateOverrides(options.StateOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	header := options.BlockHeader
+	if check.IfNil(header) {
+		header = ate.getCurrentBlockHeader()
+	}
+
+	isInexactState := false
+	for _, precedingTx := range options.PrecedingTransactions {
+		// the transactions of a block were processable when executed, so a processing error means the state differs
+		precedingResults, errProcess := ate.txSimulator.ProcessTx(precedingTx, header)
+		if errProcess != nil {
+			log.Debug("apiTransactionEvaluator.TraceTransactionExecution: cannot re-apply preceding transaction", "error", errProcess)
+			isInexactState = true
+			continue
+		}
+		if len(precedingResults.FailReason) > 0 {
+			log.Debug("apiTransactionEvaluator.TraceTransactionExecution: cannot re-apply preceding transaction", "fail reason", precedingResults.FailReason)
+			isInexactState = true
+		}
+	}
+
+	txType, _ := ate.txTypeHandler.ComputeTransactionType(tx)
+
+	ate.accounts.StartStorageTracing()
+	results, err := ate.txSimulator.ProcessTx(tx, header)
+	storageAccesses := ate.accounts.StopStorageTracing()
+	if err != nil {
+		return nil, err
+	}
+
+	trace := ate.traceBuilder.build(&executionTraceInput{
+		tx:              tx,
+		txType:          txType,
+		results:         results,
+		storageAccesses: storageAccesses,
+		gasUsed:         ate.computeTracedGasUsed(tx, results),
+		blockNonce:      header.GetNonce(),
+	})
+	trace.InexactState = isInexactState
+
+	return trace, nil
+}
+
+func (ate *apiTransactionEvaluator) computeTracedGasUsed(tx *transaction.Transaction, results *txSimData.SimulationResultsWithVMOutput) uint64 {
+	if results.VMOutput != nil && results.VMOutput.GasRemaining <= tx.GasLimit {
+		return tx.GasLimit - results.VMOutput.GasRemaining
+	}
+	if results.VMOutput == nil && len(results.FailReason) == 0 {
+		return ate.feeHandler.ComputeGasLimit(tx)
+	}
+
+	return 0
+}
+
 // ComputeTransactionGasLimit will calculate how many gas units a transaction will consume
 func (ate *apiTransactionEvaluator) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
+	return ate.ComputeTransactionGasLimitWithStateOverrides(tx, nil)
+}
+
+// ComputeTransactionGasLimitWithStateOverrides will calculate how many gas units a transaction will consume when executed
+// against the current state altered by the provided overrides
+func (ate *apiTransactionEvaluator) ComputeTransactionGasLimitWithStateOverrides(
+	tx *transaction.Transaction,
+	overrides []*txSimData.StateOverride,
+) (*transaction.CostResponse, error) {
 	ate.mutExecution.Lock()
 	defer func() {
 		ate.accounts.CleanCache()
 		ate.mutExecution.Unlock()
 	}()
 
+	err := ate.accounts.ApplyStateOverrides(overrides)
+	if err != nil {
+		return nil, err
+	}
+
 	txTypeOnSender, txTypeOnDestination := ate.txTypeHandler.ComputeTransactionType(tx)
 	if txTypeOnSender == process.MoveBalance && txTypeOnDestination == process.MoveBalance {
 		return ate.computeMoveBalanceCost(tx), nil
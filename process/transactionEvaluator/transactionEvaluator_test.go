@@ -10,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/process"
@@ -25,13 +26,14 @@ import (
 
 func createArgs() ArgsApiTransactionEvaluator {
 	return ArgsApiTransactionEvaluator{
-		TxTypeHandler:       &testscommon.TxTypeHandlerMock{},
-		FeeHandler:          &economicsmocks.EconomicsHandlerStub{},
-		TxSimulator:         &mock.TransactionSimulatorStub{},
-		Accounts:            &stateMock.AccountsStub{},
-		ShardCoordinator:    &mock.ShardCoordinatorStub{},
-		EnableEpochsHandler: &enableEpochsHandlerMock.EnableEpochsHandlerStub{},
-		BlockChain:          &testscommon.ChainHandlerMock{},
+		TxTypeHandler:          &testscommon.TxTypeHandlerMock{},
+		FeeHandler:             &economicsmocks.EconomicsHandlerStub{},
+		TxSimulator:            &mock.TransactionSimulatorStub{},
+		Accounts:               &stateMock.AccountsStub{},
+		ShardCoordinator:       &mock.ShardCoordinatorStub{},
+		EnableEpochsHandler:    &enableEpochsHandlerMock.EnableEpochsHandlerStub{},
+		BlockChain:             &testscommon.ChainHandlerMock{},
+		AddressPubKeyConverter: testscommon.RealWorldBech32PubkeyConverter,
 	}
 }
 
@@ -55,6 +57,16 @@ func TestTransactionEvaluator_NilBlockChain(t *testing.T) {
 	require.Equal(t, process.ErrNilBlockChain, err)
 }
 
+func TestTransactionEvaluator_NilAddressPubKeyConverterShouldErr(t *testing.T) {
+	t.Parallel()
+	args := createArgs()
+	args.AddressPubKeyConverter = nil
+	tce, err := NewAPITransactionEvaluator(args)
+
+	require.Nil(t, tce)
+	require.Equal(t, process.ErrNilPubkeyConverter, err)
+}
+
 func TestTransactionEvaluator_NilFeeHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -408,6 +420,213 @@ func TestApiTransactionEvaluator_ComputeTransactionGasLimit(t *testing.T) {
 	require.True(t, called)
 }
 
+func TestApiTransactionEvaluator_WithStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	overrides := []*txSimData.StateOverride{{Address: []byte("address")}}
+
+	t.Run("apply overrides error should not simulate", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createArgs()
+		args.Accounts = &stateMock.AccountsStub{
+			ApplyStateOverridesCalled: func(_ []*txSimData.StateOverride) error {
+				return expectedErr
+			},
+		}
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(_ *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		results, err := tce.SimulateTransactionExecutionWithStateOverrides(&transaction.Transaction{}, overrides)
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, results)
+
+		cost, err := tce.ComputeTransactionGasLimitWithStateOverrides(&transaction.Transaction{}, overrides)
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, cost)
+	})
+	t.Run("should apply the overrides before simulating", func(t *testing.T) {
+		t.Parallel()
+
+		appliedOverrides := false
+		args := createArgs()
+		args.Accounts = &stateMock.AccountsStub{
+			ApplyStateOverridesCalled: func(providedOverrides []*txSimData.StateOverride) error {
+				require.Equal(t, overrides, providedOverrides)
+				appliedOverrides = true
+				return nil
+			},
+		}
+		args.TxTypeHandler = &testscommon.TxTypeHandlerMock{
+			ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, process.TransactionType) {
+				return process.SCInvoking, process.SCInvoking
+			},
+		}
+		numProcessCalls := 0
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(_ *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.True(t, appliedOverrides)
+				appliedOverrides = false
+				numProcessCalls++
+				return &txSimData.SimulationResultsWithVMOutput{}, nil
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		_, err := tce.SimulateTransactionExecutionWithStateOverrides(&transaction.Transaction{}, overrides)
+		require.Nil(t, err)
+
+		_, err = tce.ComputeTransactionGasLimitWithStateOverrides(&transaction.Transaction{GasLimit: 10}, overrides)
+		require.Nil(t, err)
+		require.Equal(t, 2, numProcessCalls)
+	})
+}
+
+func TestApiTransactionEvaluator_TraceTransactionExecution(t *testing.T) {
+	t.Parallel()
+
+	t.Run("apply overrides error should not execute", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createArgs()
+		args.Accounts = &stateMock.AccountsStub{
+			ApplyStateOverridesCalled: func(_ []*txSimData.StateOverride) error {
+				return expectedErr
+			},
+		}
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(_ *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		trace, err := tce.TraceTransactionExecution(&transaction.Transaction{}, txSimData.TraceOptions{})
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, trace)
+	})
+	t.Run("execution error should stop tracing", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		stoppedTracing := false
+		args := createArgs()
+		args.Accounts = &stateMock.AccountsStub{
+			StopStorageTracingCalled: func() []*txSimData.StorageAccess {
+				stoppedTracing = true
+				return nil
+			},
+		}
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(_ *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				return nil, expectedErr
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		trace, err := tce.TraceTransactionExecution(&transaction.Transaction{}, txSimData.TraceOptions{})
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, trace)
+		require.True(t, stoppedTracing)
+	})
+	t.Run("should execute on the historical state within the provided block", func(t *testing.T) {
+		t.Parallel()
+
+		historicalState := &api.AccountQueryOptions{BlockNonce: core.OptionalUint64{Value: 9, HasValue: true}, BlockRootHash: []byte("root hash")}
+		header := &block.Header{Nonce: 10}
+		overrides := []*txSimData.StateOverride{{Address: []byte("address")}}
+		var calls []string
+		args := createArgs()
+		args.Accounts = &stateMock.AccountsStub{
+			SetHistoricalStateCalled: func(options api.AccountQueryOptions) {
+				require.Equal(t, *historicalState, options)
+				calls = append(calls, "historical state")
+			},
+			ApplyStateOverridesCalled: func(providedOverrides []*txSimData.StateOverride) error {
+				require.Equal(t, overrides, providedOverrides)
+				calls = append(calls, "overrides")
+				return nil
+			},
+			StartStorageTracingCalled: func() {
+				calls = append(calls, "start tracing")
+			},
+			StopStorageTracingCalled: func() []*txSimData.StorageAccess {
+				calls = append(calls, "stop tracing")
+				return []*txSimData.StorageAccess{{Address: []byte("sender"), Key: []byte("key"), Value: []byte("value")}}
+			},
+		}
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(_ *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Equal(t, header, currentHeader)
+				calls = append(calls, "process")
+				return &txSimData.SimulationResultsWithVMOutput{
+					SimulationResults: transaction.SimulationResults{Status: transaction.TxStatusSuccess},
+					VMOutput:          &vmcommon.VMOutput{GasRemaining: 40},
+				}, nil
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		tx := &transaction.Transaction{GasLimit: 100}
+		trace, err := tce.TraceTransactionExecution(tx, txSimData.TraceOptions{
+			StateOverrides:  overrides,
+			HistoricalState: historicalState,
+			BlockHeader:     header,
+		})
+		require.Nil(t, err)
+		require.Equal(t, []string{"historical state", "overrides", "start tracing", "process", "stop tracing"}, calls)
+		require.Equal(t, transaction.TxStatusSuccess, trace.Status)
+		require.Equal(t, uint64(60), trace.GasUsed)
+		require.Equal(t, uint64(10), trace.BlockNonce)
+		require.False(t, trace.InexactState)
+		require.Len(t, trace.Accounts, 1)
+		require.Equal(t, []*txSimData.StorageAccessTrace{{Key: "6b6579", Value: "76616c7565"}}, trace.Accounts[0].StorageReads)
+	})
+	t.Run("should re-apply the preceding transactions before tracing", func(t *testing.T) {
+		t.Parallel()
+
+		precedingTxs := []*transaction.Transaction{{Nonce: 1}, {Nonce: 2}, {Nonce: 3}}
+		tracedTx := &transaction.Transaction{Nonce: 4, GasLimit: 100}
+		isTracing := false
+		processedNonces := make([]uint64, 0)
+		args := createArgs()
+		args.Accounts = &stateMock.AccountsStub{
+			StartStorageTracingCalled: func() {
+				isTracing = true
+			},
+		}
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(tx *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Equal(t, tx == tracedTx, isTracing)
+				processedNonces = append(processedNonces, tx.Nonce)
+				results := &txSimData.SimulationResultsWithVMOutput{}
+				if tx.Nonce == 2 {
+					results.FailReason = "higher nonce in transaction"
+				}
+
+				return results, nil
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		trace, err := tce.TraceTransactionExecution(tracedTx, txSimData.TraceOptions{
+			BlockHeader:           &block.Header{Nonce: 10},
+			PrecedingTransactions: precedingTxs,
+		})
+		require.Nil(t, err)
+		require.Equal(t, []uint64{1, 2, 3, 4}, processedNonces)
+		require.True(t, trace.InexactState)
+	})
+}
+
 func TestApiTransactionEvaluator_GetCurrentHeader(t *testing.T) {
 	t.Parallel()
 
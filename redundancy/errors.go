@@ -9,3 +9,36 @@ var ErrNilMessenger = errors.New("nil messenger")
 
 // ErrNilObserverPrivateKey signals that a nil observer private key has been provided
 var ErrNilObserverPrivateKey = errors.New("nil observer private key")
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilPrivateKey signals that a nil private key has been provided
+var ErrNilPrivateKey = errors.New("nil private key")
+
+// ErrNilSyncTimer signals that a nil sync timer has been provided
+var ErrNilSyncTimer = errors.New("nil sync timer")
+
+// ErrNilStatusMetricsProvider signals that a nil status metrics provider has been provided
+var ErrNilStatusMetricsProvider = errors.New("nil status metrics provider")
+
+// ErrNilAppStatusHandler signals that a nil app status handler has been provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
+
+// ErrInvalidValue signals that an invalid value has been provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrLeaseForAnotherKey signals that a liveness lease was issued for another validator key
+var ErrLeaseForAnotherKey = errors.New("liveness lease issued for another key")
+
+// ErrLeasePeerMismatch signals that the peer ID from the liveness lease does not match the message originator
+var ErrLeasePeerMismatch = errors.New("liveness lease peer ID does not match the message originator")
+
+// ErrExpiredLease signals that an expired liveness lease has been received
+var ErrExpiredLease = errors.New("expired liveness lease")
+
+// ErrLeaseFromTheFuture signals that a liveness lease issued too far in the future has been received
+var ErrLeaseFromTheFuture = errors.New("liveness lease issued in the future")
@@ -28,3 +28,16 @@ func (nr *nodeRedundancy) GetLastRoundIndexCheck() int64 {
 func (nr *nodeRedundancy) SetLastRoundIndexCheck(lastRoundIndexCheck int64) {
 	nr.lastRoundIndexCheck = lastRoundIndexCheck
 }
+
+// ProcessTick -
+func (fc *failoverCoordinator) ProcessTick() {
+	fc.processTick()
+}
+
+// State -
+func (fc *failoverCoordinator) State() string {
+	fc.mut.RLock()
+	defer fc.mut.RUnlock()
+
+	return fc.state
+}
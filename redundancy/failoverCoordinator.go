@@ -0,0 +1,388 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=. livenessLease.proto
+package redundancy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/p2p"
+)
+
+const (
+	leaseProcessorIdentifier = "redundancyLease"
+	minLeaseDuration         = time.Second
+
+	failoverStateStandby          = "standby"
+	failoverStateActive           = "active"
+	failoverStateAwaitingHandBack = "awaiting hand-back"
+)
+
+// ArgsFailoverCoordinator represents the arguments for the failover coordinator
+type ArgsFailoverCoordinator struct {
+	Messenger        LeaseMessenger
+	Marshaller       marshal.Marshalizer
+	SingleSigner     crypto.SingleSigner
+	PrivateKey       crypto.PrivateKey
+	SyncTimer        SyncTimer
+	StatusMetrics    StatusMetricsProvider
+	AppStatusHandler core.AppStatusHandler
+	RedundancyLevel  int64
+	LeaseDuration    time.Duration
+	RenewInterval    time.Duration
+}
+
+type receivedLease struct {
+	pid       core.PeerID
+	isActive  bool
+	issuedAt  time.Time
+	expiresAt time.Time
+}
+
+// failoverCoordinator exchanges signed liveness leases with the other machines sharing the same validator key and
+// decides which one of them should act as validator. The machine with the lowest redundancy level that is alive
+// wins. A machine that wants to take over from a lower priority active machine waits for it to hand back (or for
+// its lease to expire) so the two machines will never sign at the same time.
+type failoverCoordinator struct {
+	messenger        LeaseMessenger
+	marshaller       marshal.Marshalizer
+	singleSigner     crypto.SingleSigner
+	privateKey       crypto.PrivateKey
+	publicKeyBytes   []byte
+	syncTimer        SyncTimer
+	statusMetrics    StatusMetricsProvider
+	appStatusHandler core.AppStatusHandler
+	redundancyLevel  int64
+	leaseDuration    time.Duration
+	renewInterval    time.Duration
+	startTime        time.Time
+	cancelFunc       func()
+
+	mut            sync.RWMutex
+	state          string
+	numTransitions uint64
+	leases         map[int64]*receivedLease
+}
+
+// NewFailoverCoordinator creates a new failover coordinator and starts exchanging the liveness leases
+func NewFailoverCoordinator(args ArgsFailoverCoordinator) (*failoverCoordinator, error) {
+	err := checkFailoverCoordinatorArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeyBytes, err := args.PrivateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	fc := &failoverCoordinator{
+		messenger:        args.Messenger,
+		marshaller:       args.Marshaller,
+		singleSigner:     args.SingleSigner,
+		privateKey:       args.PrivateKey,
+		publicKeyBytes:   publicKeyBytes,
+		syncTimer:        args.SyncTimer,
+		statusMetrics:    args.StatusMetrics,
+		appStatusHandler: args.AppStatusHandler,
+		redundancyLevel:  args.RedundancyLevel,
+		leaseDuration:    args.LeaseDuration,
+		renewInterval:    args.RenewInterval,
+		startTime:        args.SyncTimer.CurrentTime(),
+		state:            failoverStateStandby,
+		leases:           make(map[int64]*receivedLease),
+	}
+
+	if !fc.messenger.HasTopic(common.RedundancyLeaseTopic) {
+		err = fc.messenger.CreateTopic(common.RedundancyLeaseTopic, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = fc.messenger.RegisterMessageProcessor(common.RedundancyLeaseTopic, leaseProcessorIdentifier, fc)
+	if err != nil {
+		return nil, err
+	}
+
+	fc.appStatusHandler.SetStringValue(common.MetricRedundancyFailoverState, fc.state)
+	fc.appStatusHandler.SetUInt64Value(common.MetricRedundancyFailoverTransitions, 0)
+
+	var ctx context.Context
+	ctx, fc.cancelFunc = context.WithCancel(context.Background())
+	go fc.processLoop(ctx)
+
+	return fc, nil
+}
+
+func checkFailoverCoordinatorArgs(args ArgsFailoverCoordinator) error {
+	if check.IfNil(args.Messenger) {
+		return ErrNilMessenger
+	}
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if check.IfNil(args.SingleSigner) {
+		return ErrNilSingleSigner
+	}
+	if check.IfNil(args.PrivateKey) {
+		return ErrNilPrivateKey
+	}
+	if check.IfNil(args.SyncTimer) {
+		return ErrNilSyncTimer
+	}
+	if check.IfNil(args.StatusMetrics) {
+		return ErrNilStatusMetricsProvider
+	}
+	if check.IfNil(args.AppStatusHandler) {
+		return ErrNilAppStatusHandler
+	}
+	if args.RedundancyLevel < 0 {
+		return fmt.Errorf("%w for the redundancy level, provided %d", ErrInvalidValue, args.RedundancyLevel)
+	}
+	if args.LeaseDuration < minLeaseDuration {
+		return fmt.Errorf("%w for the lease duration, provided %v, minimum %v",
+			ErrInvalidValue, args.LeaseDuration, minLeaseDuration)
+	}
+	if args.RenewInterval <= 0 || args.RenewInterval >= args.LeaseDuration {
+		return fmt.Errorf("%w for the renew interval, provided %v, it should be positive and lower than the lease duration %v",
+			ErrInvalidValue, args.RenewInterval, args.LeaseDuration)
+	}
+
+	return nil
+}
+
+func (fc *failoverCoordinator) processLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("closing failoverCoordinator.processLoop go routine")
+			return
+		case <-time.After(fc.renewInterval):
+			fc.processTick()
+		}
+	}
+}
+
+func (fc *failoverCoordinator) processTick() {
+	isSynchronized := fc.isNodeSynchronized()
+	fc.evaluate(isSynchronized)
+
+	// a machine that is not synchronized should not make the others step down, unless it is the one holding the
+	// validator role, in which case it keeps its claim until it catches up
+	if isSynchronized || fc.IsActive() {
+		fc.broadcastLease()
+	}
+}
+
+func (fc *failoverCoordinator) isNodeSynchronized() bool {
+	metrics, err := fc.statusMetrics.StatusMetricsMapWithoutP2P()
+	if err != nil {
+		return false
+	}
+
+	isSyncing, ok := metrics[common.MetricIsSyncing].(uint64)
+	if !ok {
+		return false
+	}
+
+	return isSyncing == 0
+}
+
+func (fc *failoverCoordinator) evaluate(isSynchronized bool) {
+	now := fc.syncTimer.CurrentTime()
+
+	fc.mut.Lock()
+	defer fc.mut.Unlock()
+
+	fc.removeExpiredLeases(now)
+
+	if now.Sub(fc.startTime) < fc.leaseDuration {
+		// still collecting the leases of the other machines
+		return
+	}
+
+	isHigherPriorityMachineAlive, isLowerPriorityMachineActive := fc.computeOtherMachinesStatus()
+	switch {
+	case isHigherPriorityMachineAlive:
+		fc.setState(failoverStateStandby, "a higher priority machine is alive")
+	case !isSynchronized:
+		if fc.state != failoverStateActive {
+			fc.setState(failoverStateStandby, "the node is not synchronized")
+		}
+	case isLowerPriorityMachineActive:
+		fc.setState(failoverStateAwaitingHandBack, "a lower priority machine is active")
+	case fc.redundancyLevel == 0:
+		fc.setState(failoverStateActive, "the main machine is alive")
+	default:
+		fc.setState(failoverStateActive, "the leases of the higher priority machines expired")
+	}
+}
+
+func (fc *failoverCoordinator) removeExpiredLeases(now time.Time) {
+	for level, lease := range fc.leases {
+		if now.After(lease.expiresAt) {
+			log.Debug("liveness lease expired", "redundancy level", level, "pid", lease.pid.Pretty())
+			delete(fc.leases, level)
+		}
+	}
+}
+
+func (fc *failoverCoordinator) computeOtherMachinesStatus() (bool, bool) {
+	isHigherPriorityMachineAlive := false
+	isLowerPriorityMachineActive := false
+	for level, lease := range fc.leases {
+		if level < fc.redundancyLevel {
+			isHigherPriorityMachineAlive = true
+		}
+		if level > fc.redundancyLevel && lease.isActive {
+			isLowerPriorityMachineActive = true
+		}
+	}
+
+	return isHigherPriorityMachineAlive, isLowerPriorityMachineActive
+}
+
+func (fc *failoverCoordinator) setState(state string, reason string) {
+	if fc.state == state {
+		return
+	}
+
+	log.Warn("redundancy failover transition", "redundancy level", fc.redundancyLevel,
+		"from", fc.state, "to", state, "reason", reason)
+
+	fc.state = state
+	fc.numTransitions++
+
+	fc.appStatusHandler.SetStringValue(common.MetricRedundancyFailoverState, state)
+	fc.appStatusHandler.SetUInt64Value(common.MetricRedundancyFailoverTransitions, fc.numTransitions)
+	fc.appStatusHandler.SetStringValue(common.MetricRedundancyFailoverLastTransition, reason)
+}
+
+func (fc *failoverCoordinator) broadcastLease() {
+	lease := &LivenessLease{
+		PublicKey:       fc.publicKeyBytes,
+		Pid:             []byte(fc.messenger.ID()),
+		RedundancyLevel: fc.redundancyLevel,
+		IsActive:        fc.IsActive(),
+		IssuedAt:        fc.syncTimer.CurrentTime().UnixNano(),
+		DurationInMs:    fc.leaseDuration.Milliseconds(),
+	}
+
+	payload, err := fc.marshaller.Marshal(lease)
+	if err != nil {
+		log.Warn("failoverCoordinator: could not marshal the liveness lease", "error", err)
+		return
+	}
+
+	lease.Signature, err = fc.singleSigner.Sign(fc.privateKey, payload)
+	if err != nil {
+		log.Warn("failoverCoordinator: could not sign the liveness lease", "error", err)
+		return
+	}
+
+	buff, err := fc.marshaller.Marshal(lease)
+	if err != nil {
+		log.Warn("failoverCoordinator: could not marshal the signed liveness lease", "error", err)
+		return
+	}
+
+	fc.messenger.Broadcast(common.RedundancyLeaseTopic, buff)
+}
+
+// ProcessReceivedMessage verifies and stores the liveness lease issued by another machine
+func (fc *failoverCoordinator) ProcessReceivedMessage(message p2p.MessageP2P, _ core.PeerID, _ p2p.MessageHandler) error {
+	if message.Peer() == fc.messenger.ID() {
+		return nil
+	}
+
+	lease := &LivenessLease{}
+	err := fc.marshaller.Unmarshal(lease, message.Data())
+	if err != nil {
+		return err
+	}
+
+	err = fc.checkLease(lease, message.Peer())
+	if err != nil {
+		return err
+	}
+
+	if lease.RedundancyLevel == fc.redundancyLevel {
+		log.Warn("another machine uses the same redundancy level, please check the configuration",
+			"redundancy level", fc.redundancyLevel, "pid", message.Peer().Pretty())
+		return nil
+	}
+
+	issuedAt := time.Unix(0, lease.IssuedAt)
+	newLease := &receivedLease{
+		pid:       message.Peer(),
+		isActive:  lease.IsActive,
+		issuedAt:  issuedAt,
+		expiresAt: issuedAt.Add(time.Duration(lease.DurationInMs) * time.Millisecond),
+	}
+
+	fc.mut.Lock()
+	defer fc.mut.Unlock()
+
+	existingLease, found := fc.leases[lease.RedundancyLevel]
+	if found && existingLease.issuedAt.After(newLease.issuedAt) {
+		return nil
+	}
+	fc.leases[lease.RedundancyLevel] = newLease
+
+	return nil
+}
+
+func (fc *failoverCoordinator) checkLease(lease *LivenessLease, originator core.PeerID) error {
+	if !bytes.Equal(lease.PublicKey, fc.publicKeyBytes) {
+		return ErrLeaseForAnotherKey
+	}
+	if core.PeerID(lease.Pid) != originator {
+		return ErrLeasePeerMismatch
+	}
+
+	now := fc.syncTimer.CurrentTime()
+	issuedAt := time.Unix(0, lease.IssuedAt)
+	if issuedAt.After(now.Add(fc.leaseDuration)) {
+		return ErrLeaseFromTheFuture
+	}
+	expiresAt := issuedAt.Add(time.Duration(lease.DurationInMs) * time.Millisecond)
+	if now.After(expiresAt) {
+		return ErrExpiredLease
+	}
+
+	signature := lease.Signature
+	lease.Signature = nil
+	payload, err := fc.marshaller.Marshal(lease)
+	if err != nil {
+		return err
+	}
+
+	return fc.singleSigner.Verify(fc.privateKey.GeneratePublic(), payload, signature)
+}
+
+// IsActive returns true if the current machine should act as validator
+func (fc *failoverCoordinator) IsActive() bool {
+	fc.mut.RLock()
+	defer fc.mut.RUnlock()
+
+	return fc.state == failoverStateActive
+}
+
+// Close stops exchanging the liveness leases
+func (fc *failoverCoordinator) Close() error {
+	fc.cancelFunc()
+
+	return fc.messenger.UnregisterMessageProcessor(common.RedundancyLeaseTopic, leaseProcessorIdentifier)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fc *failoverCoordinator) IsInterfaceNil() bool {
+	return fc == nil
+}
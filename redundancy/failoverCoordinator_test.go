@@ -0,0 +1,594 @@
+package redundancy_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	mclsig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/p2p"
+	"github.com/multiversx/mx-chain-go/redundancy"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
+	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testLeaseDuration = 2 * time.Hour
+	testRenewInterval = time.Hour
+)
+
+type testClock struct {
+	mut         sync.RWMutex
+	currentTime time.Time
+}
+
+func (clock *testClock) now() time.Time {
+	clock.mut.RLock()
+	defer clock.mut.RUnlock()
+
+	return clock.currentTime
+}
+
+func (clock *testClock) advance(duration time.Duration) {
+	clock.mut.Lock()
+	clock.currentTime = clock.currentTime.Add(duration)
+	clock.mut.Unlock()
+}
+
+// leasesNetwork delivers the broadcast liveness leases to all the other registered processors
+type leasesNetwork struct {
+	mut        sync.RWMutex
+	processors map[core.PeerID]p2p.MessageProcessor
+}
+
+func (network *leasesNetwork) createMessenger(pid core.PeerID) *p2pmocks.MessengerStub {
+	return &p2pmocks.MessengerStub{
+		IDCalled: func() core.PeerID {
+			return pid
+		},
+		RegisterMessageProcessorCalled: func(topic string, identifier string, handler p2p.MessageProcessor) error {
+			network.mut.Lock()
+			network.processors[pid] = handler
+			network.mut.Unlock()
+
+			return nil
+		},
+		UnregisterMessageProcessorCalled: func(topic string, identifier string) error {
+			network.mut.Lock()
+			delete(network.processors, pid)
+			network.mut.Unlock()
+
+			return nil
+		},
+		BroadcastCalled: func(topic string, buff []byte) {
+			network.mut.RLock()
+			defer network.mut.RUnlock()
+
+			for receiver, processor := range network.processors {
+				if receiver == pid {
+					continue
+				}
+
+				msg := &p2pmocks.P2PMessageMock{
+					DataField:  buff,
+					PeerField:  pid,
+					TopicField: topic,
+				}
+				_ = processor.ProcessReceivedMessage(msg, pid, nil)
+			}
+		},
+	}
+}
+
+type testMachine struct {
+	coordinator      redundancy.FailoverCoordinator
+	appStatusHandler *statusHandler.AppStatusHandlerMock
+	mutSyncing       sync.RWMutex
+	isSyncing        uint64
+}
+
+func (machine *testMachine) setSyncing(isSyncing bool) {
+	machine.mutSyncing.Lock()
+	defer machine.mutSyncing.Unlock()
+
+	machine.isSyncing = 0
+	if isSyncing {
+		machine.isSyncing = 1
+	}
+}
+
+func (machine *testMachine) processTick() {
+	type ticker interface {
+		ProcessTick()
+	}
+	machine.coordinator.(ticker).ProcessTick()
+}
+
+func (machine *testMachine) state() string {
+	type stateGetter interface {
+		State() string
+	}
+	return machine.coordinator.(stateGetter).State()
+}
+
+func createTestPrivateKey() crypto.PrivateKey {
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	sk, _ := keyGen.GeneratePair()
+
+	return sk
+}
+
+func createMockArgsFailoverCoordinator() redundancy.ArgsFailoverCoordinator {
+	return redundancy.ArgsFailoverCoordinator{
+		Messenger:        &p2pmocks.MessengerStub{},
+		Marshaller:       &marshal.GogoProtoMarshalizer{},
+		SingleSigner:     &mclsig.BlsSingleSigner{},
+		PrivateKey:       createTestPrivateKey(),
+		SyncTimer:        &testscommon.SyncTimerStub{},
+		StatusMetrics:    &testscommon.StatusMetricsStub{},
+		AppStatusHandler: statusHandler.NewAppStatusHandlerMock(),
+		RedundancyLevel:  1,
+		LeaseDuration:    testLeaseDuration,
+		RenewInterval:    testRenewInterval,
+	}
+}
+
+func createTestMachine(
+	tb testing.TB,
+	network *leasesNetwork,
+	clock *testClock,
+	sk crypto.PrivateKey,
+	pid core.PeerID,
+	redundancyLevel int64,
+) *testMachine {
+	machine := &testMachine{
+		appStatusHandler: statusHandler.NewAppStatusHandlerMock(),
+	}
+
+	args := createMockArgsFailoverCoordinator()
+	args.Messenger = network.createMessenger(pid)
+	args.PrivateKey = sk
+	args.SyncTimer = &testscommon.SyncTimerStub{
+		CurrentTimeCalled: clock.now,
+	}
+	args.StatusMetrics = &testscommon.StatusMetricsStub{
+		StatusMetricsMapWithoutP2PCalled: func() (map[string]interface{}, error) {
+			machine.mutSyncing.RLock()
+			defer machine.mutSyncing.RUnlock()
+
+			return map[string]interface{}{
+				common.MetricIsSyncing: machine.isSyncing,
+			}, nil
+		},
+	}
+	args.AppStatusHandler = machine.appStatusHandler
+	args.RedundancyLevel = redundancyLevel
+
+	var err error
+	machine.coordinator, err = redundancy.NewFailoverCoordinator(args)
+	require.Nil(tb, err)
+
+	return machine
+}
+
+func tickAll(tb testing.TB, clock *testClock, machines ...*testMachine) {
+	clock.advance(testRenewInterval)
+	for _, machine := range machines {
+		machine.processTick()
+	}
+
+	numActive := 0
+	for _, machine := range machines {
+		if machine.coordinator.IsActive() {
+			numActive++
+		}
+	}
+	require.LessOrEqual(tb, numActive, 1, "more than one machine acts as validator")
+}
+
+func createSignedLease(tb testing.TB, sk crypto.PrivateKey, pid core.PeerID, redundancyLevel int64, issuedAt time.Time) *redundancy.LivenessLease {
+	pkBytes, _ := sk.GeneratePublic().ToByteArray()
+	lease := &redundancy.LivenessLease{
+		PublicKey:       pkBytes,
+		Pid:             []byte(pid),
+		RedundancyLevel: redundancyLevel,
+		IsActive:        true,
+		IssuedAt:        issuedAt.UnixNano(),
+		DurationInMs:    testLeaseDuration.Milliseconds(),
+	}
+	signLease(tb, sk, lease)
+
+	return lease
+}
+
+func signLease(tb testing.TB, sk crypto.PrivateKey, lease *redundancy.LivenessLease) {
+	lease.Signature = nil
+	payload, err := lease.Marshal()
+	require.Nil(tb, err)
+
+	signer := &mclsig.BlsSingleSigner{}
+	lease.Signature, err = signer.Sign(sk, payload)
+	require.Nil(tb, err)
+}
+
+func createLeaseMessage(tb testing.TB, lease *redundancy.LivenessLease, pid core.PeerID) p2p.MessageP2P {
+	buff, err := lease.Marshal()
+	require.Nil(tb, err)
+
+	return &p2pmocks.P2PMessageMock{
+		DataField: buff,
+		PeerField: pid,
+	}
+}
+
+func TestNewFailoverCoordinator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil messenger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFailoverCoordinator()
+		args.Messenger = nil
+		coordinator, err := redundancy.NewFailoverCoordinator(args)
+		assert.Equal(t, redundancy.ErrNilMessenger, err)
+		assert.True(t, check.IfNil(coordinator))
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFailoverCoordinator()
+		args.Marshaller = nil
+		coordinator, err := redundancy.NewFailoverCoordinator(args)
+		assert.Equal(t, redundancy.ErrNilMarshaller, err)
+		assert.True(t, check.IfNil(coordinator))
+	})
+	t.Run("nil single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFailoverCoordinator()
+		args.SingleSigner = nil
+		coordinator, err := redundancy.NewFailoverCoordinator(args)
+		assert.Equal(t, redundancy.ErrNilSingleSigner, err)
+		assert.True(t, check.IfNil(coordinator))
+	})
+	t.Run("nil private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFailoverCoordinator()
+		args.PrivateKey = nil
+		coordinator, err := redundancy.NewFailoverCoordinator(args)
+		assert.Equal(t, redundancy.ErrNilPrivateKey, err)
+		assert.True(t, check.IfNil(coordinator))
+	})
+	t.Run("nil sync timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFailoverCoordinator()
+		args.SyncTimer = nil
+		coordinator, err := redundancy.NewFailoverCoordinator(args)
+		assert.Equal(t, redundancy.ErrNilSyncTimer, err)
+		assert.True(t, check.IfNil(coordinator))
+	})
+	t.Run("nil status metrics should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFailoverCoordinator()
+		args.StatusMetrics = nil
+		coordinator, err := redundancy.NewFailoverCoordinator(args)
+		assert.Equal(t, redundancy.ErrNilStatusMetricsProvider, err)
+		assert.True(t, check.IfNil(coordinator))
+	})
+	t.Run("nil app status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFailoverCoordinator()
+		args.AppStatusHandler = nil
+		coordinator, err := redundancy.NewFailoverCoordinator(args)
+		assert.Equal(t, redundancy.ErrNilAppStatusHandler, err)
+		assert.True(t, check.IfNil(coordinator))
+	})
+	t.Run("invalid redundancy level should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFailoverCoordinator()
+		args.RedundancyLevel = -1
+		coordinator, err := redundancy.NewFailoverCoordinator(args)
+		assert.True(t, errors.Is(err, redundancy.ErrInvalidValue))
+		assert.True(t, check.IfNil(coordinator))
+	})
+	t.Run("invalid lease duration should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFailoverCoordinator()
+		args.LeaseDuration = time.Millisecond
+		coordinator, err := redundancy.NewFailoverCoordinator(args)
+		assert.True(t, errors.Is(err, redundancy.ErrInvalidValue))
+		assert.True(t, check.IfNil(coordinator))
+	})
+	t.Run("invalid renew interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFailoverCoordinator()
+		args.RenewInterval = args.LeaseDuration
+		coordinator, err := redundancy.NewFailoverCoordinator(args)
+		assert.True(t, errors.Is(err, redundancy.ErrInvalidValue))
+		assert.True(t, check.IfNil(coordinator))
+
+		args.RenewInterval = 0
+		coordinator, err = redundancy.NewFailoverCoordinator(args)
+		assert.True(t, errors.Is(err, redundancy.ErrInvalidValue))
+		assert.True(t, check.IfNil(coordinator))
+	})
+	t.Run("create topic fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsFailoverCoordinator()
+		args.Messenger = &p2pmocks.MessengerStub{
+			CreateTopicCalled: func(name string, createChannelForTopic bool) error {
+				return expectedErr
+			},
+		}
+		coordinator, err := redundancy.NewFailoverCoordinator(args)
+		assert.Equal(t, expectedErr, err)
+		assert.True(t, check.IfNil(coordinator))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		registeredTopic := ""
+		args := createMockArgsFailoverCoordinator()
+		args.Messenger = &p2pmocks.MessengerStub{
+			RegisterMessageProcessorCalled: func(topic string, identifier string, handler p2p.MessageProcessor) error {
+				registeredTopic = topic
+				return nil
+			},
+		}
+		coordinator, err := redundancy.NewFailoverCoordinator(args)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(coordinator))
+		assert.False(t, coordinator.IsActive())
+		assert.Equal(t, common.RedundancyLeaseTopic, registeredTopic)
+		assert.Nil(t, coordinator.Close())
+	})
+}
+
+func TestFailoverCoordinator_BackupShouldTakeOverAndHandBack(t *testing.T) {
+	t.Parallel()
+
+	network := &leasesNetwork{
+		processors: make(map[core.PeerID]p2p.MessageProcessor),
+	}
+	clock := &testClock{
+		currentTime: time.Unix(1700000000, 0),
+	}
+	sk := createTestPrivateKey()
+
+	main := createTestMachine(t, network, clock, sk, "main", 0)
+	backup := createTestMachine(t, network, clock, sk, "backup", 1)
+
+	// both machines are collecting the leases
+	tickAll(t, clock, main, backup)
+	assert.False(t, main.coordinator.IsActive())
+	assert.False(t, backup.coordinator.IsActive())
+
+	tickAll(t, clock, main, backup)
+	assert.True(t, main.coordinator.IsActive())
+	assert.False(t, backup.coordinator.IsActive())
+	assert.Equal(t, "the main machine is alive", main.appStatusHandler.GetString(common.MetricRedundancyFailoverLastTransition))
+
+	// the main machine goes down, the backup waits for the last lease to expire
+	_ = main.coordinator.Close()
+	tickAll(t, clock, backup)
+	tickAll(t, clock, backup)
+	assert.False(t, backup.coordinator.IsActive())
+	tickAll(t, clock, backup)
+	assert.True(t, backup.coordinator.IsActive())
+	assert.Equal(t, "active", backup.appStatusHandler.GetString(common.MetricRedundancyFailoverState))
+	assert.Equal(t, uint64(1), backup.appStatusHandler.GetUint64(common.MetricRedundancyFailoverTransitions))
+	assert.Equal(t, "the leases of the higher priority machines expired",
+		backup.appStatusHandler.GetString(common.MetricRedundancyFailoverLastTransition))
+
+	// the main machine returns and gets the validator role back
+	main = createTestMachine(t, network, clock, sk, "main", 0)
+	tickAll(t, clock, main, backup)
+	assert.False(t, main.coordinator.IsActive())
+	assert.False(t, backup.coordinator.IsActive())
+	assert.Equal(t, "a higher priority machine is alive",
+		backup.appStatusHandler.GetString(common.MetricRedundancyFailoverLastTransition))
+
+	tickAll(t, clock, main, backup)
+	assert.True(t, main.coordinator.IsActive())
+	assert.False(t, backup.coordinator.IsActive())
+	assert.Equal(t, uint64(2), backup.appStatusHandler.GetUint64(common.MetricRedundancyFailoverTransitions))
+
+	_ = main.coordinator.Close()
+	_ = backup.coordinator.Close()
+}
+
+func TestFailoverCoordinator_ReturningMainMachineShouldWaitForTheHandBack(t *testing.T) {
+	t.Parallel()
+
+	network := &leasesNetwork{
+		processors: make(map[core.PeerID]p2p.MessageProcessor),
+	}
+	clock := &testClock{
+		currentTime: time.Unix(1700000000, 0),
+	}
+	sk := createTestPrivateKey()
+
+	backup := createTestMachine(t, network, clock, sk, "backup", 1)
+	tickAll(t, clock, backup)
+	tickAll(t, clock, backup)
+	assert.True(t, backup.coordinator.IsActive())
+
+	// the main machine does not announce itself while syncing
+	main := createTestMachine(t, network, clock, sk, "main", 0)
+	main.setSyncing(true)
+	tickAll(t, clock, main, backup)
+	tickAll(t, clock, main, backup)
+	tickAll(t, clock, main, backup)
+	assert.False(t, main.coordinator.IsActive())
+	assert.True(t, backup.coordinator.IsActive())
+
+	main.setSyncing(false)
+	tickAll(t, clock, main)
+	assert.Equal(t, "awaiting hand-back", main.state())
+	assert.True(t, backup.coordinator.IsActive())
+
+	tickAll(t, clock, backup)
+	assert.False(t, backup.coordinator.IsActive())
+
+	tickAll(t, clock, main)
+	assert.True(t, main.coordinator.IsActive())
+
+	_ = main.coordinator.Close()
+	_ = backup.coordinator.Close()
+}
+
+func TestFailoverCoordinator_ProcessReceivedMessage(t *testing.T) {
+	t.Parallel()
+
+	sk := createTestPrivateKey()
+	currentTime := time.Unix(1700000000, 0)
+	createCoordinator := func() redundancy.FailoverCoordinator {
+		args := createMockArgsFailoverCoordinator()
+		args.PrivateKey = sk
+		args.Messenger = &p2pmocks.MessengerStub{
+			IDCalled: func() core.PeerID {
+				return "self"
+			},
+		}
+		args.SyncTimer = &testscommon.SyncTimerStub{
+			CurrentTimeCalled: func() time.Time {
+				return currentTime
+			},
+		}
+		coordinator, _ := redundancy.NewFailoverCoordinator(args)
+
+		return coordinator
+	}
+	type messageProcessor interface {
+		ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID, source p2p.MessageHandler) error
+	}
+
+	t.Run("own message should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		coordinator := createCoordinator()
+		defer func() {
+			_ = coordinator.Close()
+		}()
+
+		msg := &p2pmocks.P2PMessageMock{
+			DataField: []byte("invalid data"),
+			PeerField: "self",
+		}
+		err := coordinator.(messageProcessor).ProcessReceivedMessage(msg, "self", nil)
+		assert.Nil(t, err)
+	})
+	t.Run("invalid data should error", func(t *testing.T) {
+		t.Parallel()
+
+		coordinator := createCoordinator()
+		defer func() {
+			_ = coordinator.Close()
+		}()
+
+		msg := &p2pmocks.P2PMessageMock{
+			DataField: []byte("invalid data"),
+			PeerField: "main",
+		}
+		err := coordinator.(messageProcessor).ProcessReceivedMessage(msg, "main", nil)
+		assert.NotNil(t, err)
+	})
+	t.Run("lease for another key should error", func(t *testing.T) {
+		t.Parallel()
+
+		coordinator := createCoordinator()
+		defer func() {
+			_ = coordinator.Close()
+		}()
+
+		lease := createSignedLease(t, createTestPrivateKey(), "main", 0, currentTime)
+		err := coordinator.(messageProcessor).ProcessReceivedMessage(createLeaseMessage(t, lease, "main"), "main", nil)
+		assert.Equal(t, redundancy.ErrLeaseForAnotherKey, err)
+	})
+	t.Run("peer mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		coordinator := createCoordinator()
+		defer func() {
+			_ = coordinator.Close()
+		}()
+
+		lease := createSignedLease(t, sk, "main", 0, currentTime)
+		err := coordinator.(messageProcessor).ProcessReceivedMessage(createLeaseMessage(t, lease, "other"), "other", nil)
+		assert.Equal(t, redundancy.ErrLeasePeerMismatch, err)
+	})
+	t.Run("expired lease should error", func(t *testing.T) {
+		t.Parallel()
+
+		coordinator := createCoordinator()
+		defer func() {
+			_ = coordinator.Close()
+		}()
+
+		lease := createSignedLease(t, sk, "main", 0, currentTime.Add(-testLeaseDuration-time.Second))
+		err := coordinator.(messageProcessor).ProcessReceivedMessage(createLeaseMessage(t, lease, "main"), "main", nil)
+		assert.Equal(t, redundancy.ErrExpiredLease, err)
+	})
+	t.Run("lease from the future should error", func(t *testing.T) {
+		t.Parallel()
+
+		coordinator := createCoordinator()
+		defer func() {
+			_ = coordinator.Close()
+		}()
+
+		lease := createSignedLease(t, sk, "main", 0, currentTime.Add(testLeaseDuration+time.Second))
+		err := coordinator.(messageProcessor).ProcessReceivedMessage(createLeaseMessage(t, lease, "main"), "main", nil)
+		assert.Equal(t, redundancy.ErrLeaseFromTheFuture, err)
+	})
+	t.Run("invalid signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		coordinator := createCoordinator()
+		defer func() {
+			_ = coordinator.Close()
+		}()
+
+		lease := createSignedLease(t, sk, "main", 0, currentTime)
+		lease.IsActive = false
+		err := coordinator.(messageProcessor).ProcessReceivedMessage(createLeaseMessage(t, lease, "main"), "main", nil)
+		assert.NotNil(t, err)
+	})
+	t.Run("valid lease should work", func(t *testing.T) {
+		t.Parallel()
+
+		coordinator := createCoordinator()
+		defer func() {
+			_ = coordinator.Close()
+		}()
+
+		lease := createSignedLease(t, sk, "main", 0, currentTime)
+		err := coordinator.(messageProcessor).ProcessReceivedMessage(createLeaseMessage(t, lease, "main"), "main", nil)
+		assert.Nil(t, err)
+
+		// same redundancy level is ignored
+		lease = createSignedLease(t, sk, "backup", 1, currentTime)
+		err = coordinator.(messageProcessor).ProcessReceivedMessage(createLeaseMessage(t, lease, "backup"), "backup", nil)
+		assert.Nil(t, err)
+	})
+}
@@ -1,7 +1,10 @@
 package redundancy
 
 import (
+	"time"
+
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/p2p"
 )
 
 // P2PMessenger defines a subset of the p2p.Messenger interface
@@ -9,3 +12,34 @@ type P2PMessenger interface {
 	ID() core.PeerID
 	IsInterfaceNil() bool
 }
+
+// LeaseMessenger defines the subset of the p2p.Messenger interface used to exchange the liveness leases
+type LeaseMessenger interface {
+	ID() core.PeerID
+	HasTopic(name string) bool
+	CreateTopic(name string, createChannelForTopic bool) error
+	RegisterMessageProcessor(topic string, identifier string, handler p2p.MessageProcessor) error
+	UnregisterMessageProcessor(topic string, identifier string) error
+	Broadcast(topic string, buff []byte)
+	IsInterfaceNil() bool
+}
+
+// SyncTimer defines the component able to provide the synchronized current time
+type SyncTimer interface {
+	CurrentTime() time.Time
+	IsInterfaceNil() bool
+}
+
+// StatusMetricsProvider defines the component able to provide the status metrics of the node
+type StatusMetricsProvider interface {
+	StatusMetricsMapWithoutP2P() (map[string]interface{}, error)
+	IsInterfaceNil() bool
+}
+
+// FailoverCoordinator defines the component that decides, based on the liveness leases exchanged with the other
+// machines sharing the same validator key, whether the current machine should act as validator
+type FailoverCoordinator interface {
+	IsActive() bool
+	Close() error
+	IsInterfaceNil() bool
+}
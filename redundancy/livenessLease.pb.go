@@ -0,0 +1,670 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: livenessLease.proto
+
+package redundancy
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LivenessLease is the message periodically broadcast by the main and the back-up machines sharing the same
+// validator key. A machine considers the issuer alive until the lease expires.
+type LivenessLease struct {
+	PublicKey       []byte `protobuf:"bytes,1,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+	Pid             []byte `protobuf:"bytes,2,opt,name=Pid,proto3" json:"Pid,omitempty"`
+	RedundancyLevel int64  `protobuf:"varint,3,opt,name=RedundancyLevel,proto3" json:"RedundancyLevel,omitempty"`
+	IsActive        bool   `protobuf:"varint,4,opt,name=IsActive,proto3" json:"IsActive,omitempty"`
+	IssuedAt        int64  `protobuf:"varint,5,opt,name=IssuedAt,proto3" json:"IssuedAt,omitempty"`
+	DurationInMs    int64  `protobuf:"varint,6,opt,name=DurationInMs,proto3" json:"DurationInMs,omitempty"`
+	Signature       []byte `protobuf:"bytes,7,opt,name=Signature,proto3" json:"Signature,omitempty"`
+}
+
+func (m *LivenessLease) Reset()      { *m = LivenessLease{} }
+func (*LivenessLease) ProtoMessage() {}
+func (*LivenessLease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85f95b7e162d501e, []int{0}
+}
+func (m *LivenessLease) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LivenessLease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LivenessLease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LivenessLease.Merge(m, src)
+}
+func (m *LivenessLease) XXX_Size() int {
+	return m.Size()
+}
+func (m *LivenessLease) XXX_DiscardUnknown() {
+	xxx_messageInfo_LivenessLease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LivenessLease proto.InternalMessageInfo
+
+func (m *LivenessLease) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *LivenessLease) GetPid() []byte {
+	if m != nil {
+		return m.Pid
+	}
+	return nil
+}
+
+func (m *LivenessLease) GetRedundancyLevel() int64 {
+	if m != nil {
+		return m.RedundancyLevel
+	}
+	return 0
+}
+
+func (m *LivenessLease) GetIsActive() bool {
+	if m != nil {
+		return m.IsActive
+	}
+	return false
+}
+
+func (m *LivenessLease) GetIssuedAt() int64 {
+	if m != nil {
+		return m.IssuedAt
+	}
+	return 0
+}
+
+func (m *LivenessLease) GetDurationInMs() int64 {
+	if m != nil {
+		return m.DurationInMs
+	}
+	return 0
+}
+
+func (m *LivenessLease) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*LivenessLease)(nil), "proto.LivenessLease")
+}
+
+func init() { proto.RegisterFile("livenessLease.proto", fileDescriptor_85f95b7e162d501e) }
+
+var fileDescriptor_85f95b7e162d501e = []byte{
+	// 288 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xcd, 0x4e, 0x32, 0x31,
+	0x14, 0x86, 0x7b, 0x3e, 0x3e, 0x10, 0x1b, 0x8c, 0xa6, 0x6e, 0x1a, 0x62, 0x4e, 0x08, 0xab, 0xd9,
+	0x08, 0x0b, 0xaf, 0x00, 0xc3, 0x86, 0x88, 0x09, 0x19, 0x77, 0xee, 0xe6, 0xa7, 0x8e, 0x4d, 0xb0,
+	0x35, 0xd3, 0x96, 0x84, 0x9d, 0x97, 0xe0, 0x65, 0x78, 0x29, 0x2e, 0x59, 0xb2, 0x94, 0xce, 0xc6,
+	0x25, 0x97, 0x60, 0xe8, 0x24, 0xe3, 0xcf, 0xaa, 0xe7, 0x79, 0x4e, 0xde, 0xe6, 0x6d, 0xe9, 0xf9,
+	0x52, 0xae, 0x84, 0x12, 0xc6, 0xcc, 0x45, 0x62, 0xc4, 0xe8, 0xb9, 0xd4, 0x56, 0xb3, 0x76, 0x38,
+	0xfa, 0x97, 0x85, 0xb4, 0x8f, 0x2e, 0x1d, 0x65, 0xfa, 0x69, 0x5c, 0xe8, 0x42, 0x8f, 0x83, 0x4e,
+	0xdd, 0x43, 0xa0, 0x00, 0x61, 0xaa, 0x53, 0xc3, 0x0a, 0xe8, 0xc9, 0xfc, 0xe7, 0x6d, 0xec, 0x82,
+	0x1e, 0x2f, 0x5c, 0xba, 0x94, 0xd9, 0x8d, 0x58, 0x73, 0x18, 0x40, 0xd4, 0x8b, 0xbf, 0x05, 0x3b,
+	0xa3, 0xad, 0x85, 0xcc, 0xf9, 0xbf, 0xe0, 0x0f, 0x23, 0x8b, 0xe8, 0x69, 0x2c, 0x72, 0xa7, 0xf2,
+	0x44, 0x65, 0xeb, 0xb9, 0x58, 0x89, 0x25, 0x6f, 0x0d, 0x20, 0x6a, 0xc5, 0x7f, 0x35, 0xeb, 0xd3,
+	0xee, 0xcc, 0x4c, 0x32, 0x2b, 0x57, 0x82, 0xff, 0x1f, 0x40, 0xd4, 0x8d, 0x1b, 0xae, 0x77, 0xc6,
+	0x89, 0x7c, 0x62, 0x79, 0x3b, 0xc4, 0x1b, 0x66, 0x43, 0xda, 0x9b, 0xba, 0x32, 0xb1, 0x52, 0xab,
+	0x99, 0xba, 0x35, 0xbc, 0x13, 0xf6, 0xbf, 0xdc, 0xa1, 0xf5, 0x9d, 0x2c, 0x54, 0x62, 0x5d, 0x29,
+	0xf8, 0x51, 0xdd, 0xba, 0x11, 0xd7, 0xd3, 0xcd, 0x0e, 0xc9, 0x76, 0x87, 0x64, 0xbf, 0x43, 0x78,
+	0xf1, 0x08, 0x6f, 0x1e, 0xe1, 0xdd, 0x23, 0x6c, 0x3c, 0xc2, 0xd6, 0x23, 0x7c, 0x78, 0x84, 0x4f,
+	0x8f, 0x64, 0xef, 0x11, 0x5e, 0x2b, 0x24, 0x9b, 0x0a, 0xc9, 0xb6, 0x42, 0x72, 0x4f, 0xcb, 0xe6,
+	0x11, 0x69, 0x27, 0x7c, 0xd9, 0xd5, 0xd7, 0x00, 0x8c, 0x95, 0x16, 0x97, 0x7f, 0x01, 0x00, 0x00,
+}
+
+func (this *LivenessLease) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LivenessLease)
+	if !ok {
+		that2, ok := that.(LivenessLease)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.PublicKey, that1.PublicKey) {
+		return false
+	}
+	if !bytes.Equal(this.Pid, that1.Pid) {
+		return false
+	}
+	if this.RedundancyLevel != that1.RedundancyLevel {
+		return false
+	}
+	if this.IsActive != that1.IsActive {
+		return false
+	}
+	if this.IssuedAt != that1.IssuedAt {
+		return false
+	}
+	if this.DurationInMs != that1.DurationInMs {
+		return false
+	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	return true
+}
+func (this *LivenessLease) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&redundancy.LivenessLease{")
+	s = append(s, "PublicKey: "+fmt.Sprintf("%#v", this.PublicKey)+",\n")
+	s = append(s, "Pid: "+fmt.Sprintf("%#v", this.Pid)+",\n")
+	s = append(s, "RedundancyLevel: "+fmt.Sprintf("%#v", this.RedundancyLevel)+",\n")
+	s = append(s, "IsActive: "+fmt.Sprintf("%#v", this.IsActive)+",\n")
+	s = append(s, "IssuedAt: "+fmt.Sprintf("%#v", this.IssuedAt)+",\n")
+	s = append(s, "DurationInMs: "+fmt.Sprintf("%#v", this.DurationInMs)+",\n")
+	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringLivenessLease(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *LivenessLease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LivenessLease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LivenessLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintLivenessLease(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.DurationInMs != 0 {
+		i = encodeVarintLivenessLease(dAtA, i, uint64(m.DurationInMs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IssuedAt != 0 {
+		i = encodeVarintLivenessLease(dAtA, i, uint64(m.IssuedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RedundancyLevel != 0 {
+		i = encodeVarintLivenessLease(dAtA, i, uint64(m.RedundancyLevel))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Pid) > 0 {
+		i -= len(m.Pid)
+		copy(dAtA[i:], m.Pid)
+		i = encodeVarintLivenessLease(dAtA, i, uint64(len(m.Pid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintLivenessLease(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLivenessLease(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLivenessLease(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LivenessLease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovLivenessLease(uint64(l))
+	}
+	l = len(m.Pid)
+	if l > 0 {
+		n += 1 + l + sovLivenessLease(uint64(l))
+	}
+	if m.RedundancyLevel != 0 {
+		n += 1 + sovLivenessLease(uint64(m.RedundancyLevel))
+	}
+	if m.IsActive {
+		n += 2
+	}
+	if m.IssuedAt != 0 {
+		n += 1 + sovLivenessLease(uint64(m.IssuedAt))
+	}
+	if m.DurationInMs != 0 {
+		n += 1 + sovLivenessLease(uint64(m.DurationInMs))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovLivenessLease(uint64(l))
+	}
+	return n
+}
+
+func sovLivenessLease(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLivenessLease(x uint64) (n int) {
+	return sovLivenessLease(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *LivenessLease) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LivenessLease{`,
+		`PublicKey:` + fmt.Sprintf("%v", this.PublicKey) + `,`,
+		`Pid:` + fmt.Sprintf("%v", this.Pid) + `,`,
+		`RedundancyLevel:` + fmt.Sprintf("%v", this.RedundancyLevel) + `,`,
+		`IsActive:` + fmt.Sprintf("%v", this.IsActive) + `,`,
+		`IssuedAt:` + fmt.Sprintf("%v", this.IssuedAt) + `,`,
+		`DurationInMs:` + fmt.Sprintf("%v", this.DurationInMs) + `,`,
+		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringLivenessLease(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *LivenessLease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLivenessLease
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LivenessLease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LivenessLease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLivenessLease
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLivenessLease
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLivenessLease
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLivenessLease
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLivenessLease
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLivenessLease
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pid = append(m.Pid[:0], dAtA[iNdEx:postIndex]...)
+			if m.Pid == nil {
+				m.Pid = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyLevel", wireType)
+			}
+			m.RedundancyLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLivenessLease
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundancyLevel |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLivenessLease
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			}
+			m.IssuedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLivenessLease
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationInMs", wireType)
+			}
+			m.DurationInMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLivenessLease
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationInMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLivenessLease
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLivenessLease
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLivenessLease
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLivenessLease(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLivenessLease
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLivenessLease
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLivenessLease(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLivenessLease
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLivenessLease
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLivenessLease
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLivenessLease
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLivenessLease
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLivenessLease
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLivenessLease        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLivenessLease          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLivenessLease = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,30 @@
+package mock
+
+// FailoverCoordinatorStub -
+type FailoverCoordinatorStub struct {
+	IsActiveCalled func() bool
+	CloseCalled    func() error
+}
+
+// IsActive -
+func (stub *FailoverCoordinatorStub) IsActive() bool {
+	if stub.IsActiveCalled != nil {
+		return stub.IsActiveCalled()
+	}
+
+	return false
+}
+
+// Close -
+func (stub *FailoverCoordinatorStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *FailoverCoordinatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
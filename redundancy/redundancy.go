@@ -26,6 +26,7 @@ type nodeRedundancy struct {
 	maxRoundsOfInactivity int
 	messenger             P2PMessenger
 	observerPrivateKey    crypto.PrivateKey
+	failoverCoordinator   FailoverCoordinator
 }
 
 // ArgNodeRedundancy represents the DTO structure used by the nodeRedundancy's constructor
@@ -33,6 +34,9 @@ type ArgNodeRedundancy struct {
 	MaxRoundsOfInactivity int
 	Messenger             P2PMessenger
 	ObserverPrivateKey    crypto.PrivateKey
+	// FailoverCoordinator is optional. When provided, the single-key operation is decided by the exchanged liveness
+	// leases instead of the rounds of inactivity
+	FailoverCoordinator FailoverCoordinator
 }
 
 // NewNodeRedundancy creates a node redundancy object which implements NodeRedundancyHandler interface
@@ -53,6 +57,7 @@ func NewNodeRedundancy(arg ArgNodeRedundancy) (*nodeRedundancy, error) {
 		maxRoundsOfInactivity: arg.MaxRoundsOfInactivity,
 		messenger:             arg.Messenger,
 		observerPrivateKey:    arg.ObserverPrivateKey,
+		failoverCoordinator:   arg.FailoverCoordinator,
 	}
 
 	return nr, nil
@@ -63,8 +68,18 @@ func (nr *nodeRedundancy) IsRedundancyNode() bool {
 	return !common.IsMainNode(nr.maxRoundsOfInactivity)
 }
 
-// IsMainMachineActive returns true if the main or lower level redundancy machines are active
+// IsMainMachineActive returns true if the main or lower level redundancy machines are active. When the failover
+// coordination is enabled, the main machine is active only if it holds the validator role
 func (nr *nodeRedundancy) IsMainMachineActive() bool {
+	if !check.IfNil(nr.failoverCoordinator) {
+		isActive := nr.failoverCoordinator.IsActive()
+		if nr.IsRedundancyNode() {
+			return !isActive
+		}
+
+		return isActive
+	}
+
 	nr.mutNodeRedundancy.RLock()
 	defer nr.mutNodeRedundancy.RUnlock()
 
@@ -268,3 +268,37 @@ func TestNodeRedundancy_ObserverPrivateKey(t *testing.T) {
 
 	assert.True(t, nr.ObserverPrivateKey() == arg.ObserverPrivateKey) //pointer testing
 }
+
+func TestNodeRedundancy_IsMainMachineActiveWithFailoverCoordinator(t *testing.T) {
+	t.Parallel()
+
+	isActive := false
+	coordinator := &mock.FailoverCoordinatorStub{
+		IsActiveCalled: func() bool {
+			return isActive
+		},
+	}
+
+	t.Run("main machine", func(t *testing.T) {
+		arg := createMockArguments(0)
+		arg.FailoverCoordinator = coordinator
+		nr, _ := redundancy.NewNodeRedundancy(arg)
+
+		isActive = false
+		assert.False(t, nr.IsMainMachineActive())
+		isActive = true
+		assert.True(t, nr.IsMainMachineActive())
+	})
+	t.Run("backup machine", func(t *testing.T) {
+		arg := createMockArguments(2)
+		arg.FailoverCoordinator = coordinator
+		nr, _ := redundancy.NewNodeRedundancy(arg)
+
+		// the rounds of inactivity are not taken into account
+		nr.SetRoundsOfInactivity(3)
+		isActive = false
+		assert.True(t, nr.IsMainMachineActive())
+		isActive = true
+		assert.False(t, nr.IsMainMachineActive())
+	})
+}
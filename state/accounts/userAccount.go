@@ -139,6 +139,12 @@ func (a *userAccount) IncreaseNonce(value uint64) {
 	a.Nonce = a.Nonce + value
 }
 
+// SetNonce sets the nonce of the account. Should only be used on accounts that are not committed, such as the ones
+// altered by state overrides when simulating transactions
+func (a *userAccount) SetNonce(nonce uint64) {
+	a.Nonce = nonce
+}
+
 // SetCodeHash sets the code hash associated with the account
 func (a *userAccount) SetCodeHash(codeHash []byte) {
 	a.CodeHash = codeHash
@@ -179,6 +179,16 @@ func TestUserAccount_SetAndGetNonce(t *testing.T) {
 	assert.Equal(t, nonce, acc.GetNonce())
 }
 
+func TestUserAccount_SetNonce(t *testing.T) {
+	t.Parallel()
+
+	acc, _ := accounts.NewUserAccount(make([]byte, 32), &testTrie.DataTrieTrackerStub{}, &testTrie.TrieLeafParserStub{})
+	acc.IncreaseNonce(10)
+
+	acc.SetNonce(3)
+	assert.Equal(t, uint64(3), acc.GetNonce())
+}
+
 func TestUserAccount_SetAndGetCodeHash(t *testing.T) {
 	t.Parallel()
 
@@ -1,33 +1,62 @@
 package state
 
 import (
-	"bytes"
 	"context"
-	"sync"
+	"fmt"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/holders"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
 
+// ArgsAccountsDBApiWithHistory is the argument DTO used to create a new instance of type accountsDBApiWithHistory
+type ArgsAccountsDBApiWithHistory struct {
+	AccountsAdapterCreator       AccountsAdapterCreator
+	AppStatusHandler             core.AppStatusHandler
+	MaxRecreatedTries            uint32
+	MaxRecreatedTriesSizeInBytes uint64
+}
+
 type accountsDBApiWithHistory struct {
-	innerAccountsAdapter    AccountsAdapter
-	latestRecreatedRootHash []byte
-	mutRecreateAndGet       sync.RWMutex
+	recreatedTries *recreatedTriesPool
 }
 
 // NewAccountsDBApiWithHistory will create a new instance of type accountsDBApiWithHistory
-func NewAccountsDBApiWithHistory(innerAccountsAdapter AccountsAdapter) (*accountsDBApiWithHistory, error) {
-	if check.IfNil(innerAccountsAdapter) {
-		return nil, ErrNilAccountsAdapter
+func NewAccountsDBApiWithHistory(args ArgsAccountsDBApiWithHistory) (*accountsDBApiWithHistory, error) {
+	err := checkArgsAccountsDBApiWithHistory(args)
+	if err != nil {
+		return nil, err
+	}
+
+	recreatedTries, err := newRecreatedTriesPool(args)
+	if err != nil {
+		return nil, err
 	}
 
 	return &accountsDBApiWithHistory{
-		innerAccountsAdapter: innerAccountsAdapter,
+		recreatedTries: recreatedTries,
 	}, nil
 }
 
+func checkArgsAccountsDBApiWithHistory(args ArgsAccountsDBApiWithHistory) error {
+	if check.IfNil(args.AccountsAdapterCreator) {
+		return ErrNilAccountsAdapterCreator
+	}
+	if check.IfNil(args.AppStatusHandler) {
+		return ErrNilAppStatusHandler
+	}
+	if args.MaxRecreatedTries == 0 {
+		return fmt.Errorf("%w: MaxRecreatedTries should be greater than 0", ErrInvalidRecreatedTriesPoolConfig)
+	}
+	if args.MaxRecreatedTriesSizeInBytes < recreatedTrieBaseSizeInBytes {
+		return fmt.Errorf("%w: MaxRecreatedTriesSizeInBytes should be at least %d", ErrInvalidRecreatedTriesPoolConfig, recreatedTrieBaseSizeInBytes)
+	}
+
+	return nil
+}
+
 // SetSyncer  is a not permitted operation in this implementation and thus, does nothing
 func (accountsDB *accountsDBApiWithHistory) SetSyncer(_ AccountsDBSyncer) error {
 	return nil
@@ -135,44 +164,23 @@ func (accountsDB *accountsDBApiWithHistory) GetStackDebugFirstEntry() []byte {
 	return nil
 }
 
-// Close will handle the closing of the underlying components
+// Close will close all the recreated tries
 func (accountsDB *accountsDBApiWithHistory) Close() error {
-	return accountsDB.innerAccountsAdapter.Close()
+	return accountsDB.recreatedTries.close()
 }
 
-// GetAccountWithBlockInfo returns the account and the associated block info
+// GetAccountWithBlockInfo returns the account and the associated block info. The account is read from the trie
+// recreated for the provided root hash, which is shared with the other queries on the same root hash
 func (accountsDB *accountsDBApiWithHistory) GetAccountWithBlockInfo(address []byte, options common.RootHashHolder) (vmcommon.AccountHandler, common.BlockInfo, error) {
 	rootHash := options.GetRootHash()
-
-	// First check to avoid re-creation:
-	accountsDB.mutRecreateAndGet.RLock()
-	if !accountsDB.shouldRecreateTrieUnprotected(rootHash) {
-		// Re-creation is not necessary, but make sure no other routine re-creates it.
-		defer accountsDB.mutRecreateAndGet.RUnlock()
-		return accountsDB.doGetAccountWithBlockInfoUnprotected(address, rootHash)
-	}
-
-	accountsDB.mutRecreateAndGet.RUnlock()
-
-	// Re-creation seems to be necessary, promote to a "write" lock.
-	accountsDB.mutRecreateAndGet.Lock()
-	defer accountsDB.mutRecreateAndGet.Unlock()
-
-	// Second check to avoid re-creation:
-	if accountsDB.shouldRecreateTrieUnprotected(rootHash) {
-		err := accountsDB.recreateTrieUnprotected(options)
-		if err != nil {
-			return nil, nil, err
-		}
+	recreatedTrie, err := accountsDB.recreatedTries.getOrRecreate(options)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return accountsDB.doGetAccountWithBlockInfoUnprotected(address, rootHash)
-}
-
-func (accountsDB *accountsDBApiWithHistory) doGetAccountWithBlockInfoUnprotected(address []byte, rootHash []byte) (vmcommon.AccountHandler, common.BlockInfo, error) {
 	blockInfo := holders.NewBlockInfo(nil, 0, rootHash)
-
-	account, err := accountsDB.innerAccountsAdapter.GetExistingAccount(address)
+	account, err := recreatedTrie.accountsAdapter.GetExistingAccount(address)
+	accountsDB.recreatedTries.addReadSize(rootHash, recreatedTrie, resolvedNodesSizePerReadInBytes)
 	if err == ErrAccNotFound {
 		return nil, nil, NewErrAccountNotFoundAtBlock(blockInfo)
 	}
@@ -186,50 +194,16 @@ func (accountsDB *accountsDBApiWithHistory) doGetAccountWithBlockInfoUnprotected
 // GetCodeWithBlockInfo returns the code and the associated block info
 func (accountsDB *accountsDBApiWithHistory) GetCodeWithBlockInfo(codeHash []byte, options common.RootHashHolder) ([]byte, common.BlockInfo, error) {
 	rootHash := options.GetRootHash()
-
-	// First check to avoid re-creation:
-	accountsDB.mutRecreateAndGet.RLock()
-	if !accountsDB.shouldRecreateTrieUnprotected(rootHash) {
-		// Re-creation is not necessary, but make sure no other routine re-creates it.
-		defer accountsDB.mutRecreateAndGet.RUnlock()
-		return accountsDB.doGetCodeWithBlockInfoUnprotected(codeHash, rootHash)
-	}
-
-	accountsDB.mutRecreateAndGet.RUnlock()
-
-	// Re-creation seems to be necessary, promote to a "write" lock.
-	accountsDB.mutRecreateAndGet.Lock()
-	defer accountsDB.mutRecreateAndGet.Unlock()
-
-	// Second check to avoid re-creation:
-	if accountsDB.shouldRecreateTrieUnprotected(rootHash) {
-		err := accountsDB.recreateTrieUnprotected(options)
-		if err != nil {
-			return nil, nil, err
-		}
+	recreatedTrie, err := accountsDB.recreatedTries.getOrRecreate(options)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return accountsDB.doGetCodeWithBlockInfoUnprotected(codeHash, rootHash)
-}
-
-func (accountsDB *accountsDBApiWithHistory) doGetCodeWithBlockInfoUnprotected(codeHash []byte, rootHash []byte) ([]byte, common.BlockInfo, error) {
 	blockInfo := holders.NewBlockInfo(nil, 0, rootHash)
-	code := accountsDB.innerAccountsAdapter.GetCode(codeHash)
-	return code, blockInfo, nil
-}
-
-func (accountsDB *accountsDBApiWithHistory) shouldRecreateTrieUnprotected(rootHash []byte) bool {
-	return !bytes.Equal(accountsDB.latestRecreatedRootHash, rootHash)
-}
+	code := recreatedTrie.accountsAdapter.GetCode(codeHash)
+	accountsDB.recreatedTries.addReadSize(rootHash, recreatedTrie, resolvedNodesSizePerReadInBytes+len(code))
 
-func (accountsDB *accountsDBApiWithHistory) recreateTrieUnprotected(options common.RootHashHolder) error {
-	err := accountsDB.innerAccountsAdapter.RecreateTrie(options)
-	if err != nil {
-		return err
-	}
-
-	accountsDB.latestRecreatedRootHash = options.GetRootHash()
-	return nil
+	return code, blockInfo, nil
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -15,20 +15,60 @@ import (
 	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	mockState "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+func createMockArgsAccountsDBApiWithHistory(accountsAdapter state.AccountsAdapter) state.ArgsAccountsDBApiWithHistory {
+	return state.ArgsAccountsDBApiWithHistory{
+		AccountsAdapterCreator: &mockState.AccountsAdapterCreatorStub{
+			CreateAccountsAdapterCalled: func() (state.AccountsAdapter, error) {
+				return accountsAdapter, nil
+			},
+		},
+		AppStatusHandler:             &statusHandler.AppStatusHandlerStub{},
+		MaxRecreatedTries:            16,
+		MaxRecreatedTriesSizeInBytes: 1024 * 1024,
+	}
+}
+
 func TestNewAccountsDBApiWithHistory(t *testing.T) {
-	t.Run("nil accounts adapter should error", func(t *testing.T) {
-		accountsApi, err := state.NewAccountsDBApiWithHistory(nil)
+	t.Run("nil accounts adapter creator should error", func(t *testing.T) {
+		args := createMockArgsAccountsDBApiWithHistory(&mockState.AccountsStub{})
+		args.AccountsAdapterCreator = nil
+		accountsApi, err := state.NewAccountsDBApiWithHistory(args)
+		assert.True(t, check.IfNil(accountsApi))
+		assert.Equal(t, state.ErrNilAccountsAdapterCreator, err)
+	})
+
+	t.Run("nil app status handler should error", func(t *testing.T) {
+		args := createMockArgsAccountsDBApiWithHistory(&mockState.AccountsStub{})
+		args.AppStatusHandler = nil
+		accountsApi, err := state.NewAccountsDBApiWithHistory(args)
+		assert.True(t, check.IfNil(accountsApi))
+		assert.Equal(t, state.ErrNilAppStatusHandler, err)
+	})
+
+	t.Run("invalid max recreated tries should error", func(t *testing.T) {
+		args := createMockArgsAccountsDBApiWithHistory(&mockState.AccountsStub{})
+		args.MaxRecreatedTries = 0
+		accountsApi, err := state.NewAccountsDBApiWithHistory(args)
 		assert.True(t, check.IfNil(accountsApi))
-		assert.Equal(t, state.ErrNilAccountsAdapter, err)
+		assert.True(t, errors.Is(err, state.ErrInvalidRecreatedTriesPoolConfig))
+	})
+
+	t.Run("invalid max recreated tries size should error", func(t *testing.T) {
+		args := createMockArgsAccountsDBApiWithHistory(&mockState.AccountsStub{})
+		args.MaxRecreatedTriesSizeInBytes = 1
+		accountsApi, err := state.NewAccountsDBApiWithHistory(args)
+		assert.True(t, check.IfNil(accountsApi))
+		assert.True(t, errors.Is(err, state.ErrInvalidRecreatedTriesPoolConfig))
 	})
 
 	t.Run("should work", func(t *testing.T) {
-		accountsApi, err := state.NewAccountsDBApiWithHistory(&mockState.AccountsStub{})
+		accountsApi, err := state.NewAccountsDBApiWithHistory(createMockArgsAccountsDBApiWithHistory(&mockState.AccountsStub{}))
 		assert.False(t, check.IfNil(accountsApi))
 		assert.Nil(t, err)
 	})
@@ -44,7 +84,7 @@ func TestAccountsDBApiWithHistory_NotPermittedOrNotImplementedOperationsDoNotPan
 		}
 	}()
 
-	accountsApi, _ := state.NewAccountsDBApiWithHistory(&mockState.AccountsStub{})
+	accountsApi, _ := state.NewAccountsDBApiWithHistory(createMockArgsAccountsDBApiWithHistory(&mockState.AccountsStub{}))
 
 	account, err := accountsApi.GetExistingAccount([]byte{})
 	assert.Nil(t, account)
@@ -110,7 +150,7 @@ func TestAccountsDBApiWithHistory_GetAccountWithBlockInfo(t *testing.T) {
 			},
 		}
 
-		accountsApi, _ := state.NewAccountsDBApiWithHistory(accountsAdapter)
+		accountsApi, _ := state.NewAccountsDBApiWithHistory(createMockArgsAccountsDBApiWithHistory(accountsAdapter))
 		account, blockInfo, err := accountsApi.GetAccountWithBlockInfo(testscommon.TestPubKeyAlice, options)
 		assert.Nil(t, account)
 		assert.Nil(t, blockInfo)
@@ -134,7 +174,7 @@ func TestAccountsDBApiWithHistory_GetAccountWithBlockInfo(t *testing.T) {
 			},
 		}
 
-		accountsApi, _ := state.NewAccountsDBApiWithHistory(accountsAdapter)
+		accountsApi, _ := state.NewAccountsDBApiWithHistory(createMockArgsAccountsDBApiWithHistory(accountsAdapter))
 		account, blockInfo, err := accountsApi.GetAccountWithBlockInfo(testscommon.TestPubKeyAlice, options)
 		assert.Nil(t, err)
 		assert.Equal(t, blockInfo.GetRootHash(), rootHash)
@@ -155,7 +195,7 @@ func TestAccountsDBApiWithHistory_GetAccountWithBlockInfo(t *testing.T) {
 			},
 		}
 
-		accountsApi, _ := state.NewAccountsDBApiWithHistory(accountsAdapter)
+		accountsApi, _ := state.NewAccountsDBApiWithHistory(createMockArgsAccountsDBApiWithHistory(accountsAdapter))
 		account, blockInfo, err := accountsApi.GetAccountWithBlockInfo(testscommon.TestPubKeyAlice, options)
 		assert.Nil(t, account)
 		assert.Nil(t, blockInfo)
@@ -176,7 +216,7 @@ func TestAccountsDBApiWithHistory_GetAccountWithBlockInfo(t *testing.T) {
 			},
 		}
 
-		accountsApi, _ := state.NewAccountsDBApiWithHistory(accountsAdapter)
+		accountsApi, _ := state.NewAccountsDBApiWithHistory(createMockArgsAccountsDBApiWithHistory(accountsAdapter))
 		account, blockInfo, err := accountsApi.GetAccountWithBlockInfo(testscommon.TestPubKeyAlice, options)
 		assert.Nil(t, account)
 		assert.Nil(t, blockInfo)
@@ -199,7 +239,7 @@ func TestAccountsDBApiWithHistory_GetCodeWithBlockInfo(t *testing.T) {
 			},
 		}
 
-		accountsApi, _ := state.NewAccountsDBApiWithHistory(accountsAdapter)
+		accountsApi, _ := state.NewAccountsDBApiWithHistory(createMockArgsAccountsDBApiWithHistory(accountsAdapter))
 		account, blockInfo, err := accountsApi.GetCodeWithBlockInfo(contractCodeHash, options)
 		assert.Nil(t, account)
 		assert.Nil(t, blockInfo)
@@ -223,7 +263,7 @@ func TestAccountsDBApiWithHistory_GetCodeWithBlockInfo(t *testing.T) {
 			},
 		}
 
-		accountsApi, _ := state.NewAccountsDBApiWithHistory(accountsAdapter)
+		accountsApi, _ := state.NewAccountsDBApiWithHistory(createMockArgsAccountsDBApiWithHistory(accountsAdapter))
 		code, blockInfo, err := accountsApi.GetCodeWithBlockInfo(contractCodeHash, options)
 		assert.Nil(t, err)
 		assert.Equal(t, blockInfo.GetRootHash(), rootHash)
@@ -244,33 +284,34 @@ func TestAccountsDBApiWithHistory_GetAccountWithBlockInfoWhenHighConcurrency(t *
 			recreationsCounterByRootHash[rootHashAsString] = &atomic.Counter{}
 		}
 
-		dummyAccount := createDummyAccountWithBalanceBytes([]byte{42, 0, 0, 0, 0, 0, 0, 0})
-		var dummyAccountMutex sync.RWMutex
-
-		accountsAdapter := &mockState.AccountsStub{
-			RecreateTrieCalled: func(options common.RootHashHolder) error {
-				rootHash := options.GetRootHash()
-
-				dummyAccountMutex.Lock()
-				defer dummyAccountMutex.Unlock()
-
-				// When a trie is recreated, we "add" to it a single account,
-				// having the balance correlated with the trie rootHash (for the sake of the test, for easier assertions).
-				dummyAccount = createDummyAccountWithBalanceString(string(rootHash))
-
-				// We also count the re-creation
-				counter := recreationsCounterByRootHash[string(rootHash)]
-				counter.Increment()
-				return nil
-			},
-			GetExistingAccountCalled: func(addressContainer []byte) (vmcommon.AccountHandler, error) {
-				dummyAccountMutex.RLock()
-				defer dummyAccountMutex.RUnlock()
-				return dummyAccount, nil
+		args := createMockArgsAccountsDBApiWithHistory(nil)
+		args.MaxRecreatedTries = uint32(numRootHashes)
+		args.MaxRecreatedTriesSizeInBytes = uint64(numRootHashes) * 1024 * 1024
+		args.AccountsAdapterCreator = &mockState.AccountsAdapterCreatorStub{
+			CreateAccountsAdapterCalled: func() (state.AccountsAdapter, error) {
+				var dummyAccount state.UserAccountHandler
+
+				return &mockState.AccountsStub{
+					RecreateTrieCalled: func(options common.RootHashHolder) error {
+						rootHash := options.GetRootHash()
+
+						// When a trie is recreated, we "add" to it a single account,
+						// having the balance correlated with the trie rootHash (for the sake of the test, for easier assertions).
+						dummyAccount = createDummyAccountWithBalanceString(string(rootHash))
+
+						// We also count the re-creation
+						counter := recreationsCounterByRootHash[string(rootHash)]
+						counter.Increment()
+						return nil
+					},
+					GetExistingAccountCalled: func(addressContainer []byte) (vmcommon.AccountHandler, error) {
+						return dummyAccount, nil
+					},
+				}, nil
 			},
 		}
 
-		accountsApiWithHistory, _ := state.NewAccountsDBApiWithHistory(accountsAdapter)
+		accountsApiWithHistory, _ := state.NewAccountsDBApiWithHistory(args)
 
 		var wg sync.WaitGroup
 
@@ -300,8 +341,96 @@ func TestAccountsDBApiWithHistory_GetAccountWithBlockInfoWhenHighConcurrency(t *
 		rootHashAsString := fmt.Sprintf("%d", i)
 		numRecreations := recreationsCounterByRootHash[rootHashAsString]
 
-		assert.True(t, numRecreations.Get() > 0 && numRecreations.Get() <= int64(numCallsPerRootHash))
+		// every trie fits in the pool, so it is recreated only once
+		assert.Equal(t, int64(1), numRecreations.Get())
+	}
+}
+
+func TestAccountsDBApiWithHistory_RecreatedTriesPool(t *testing.T) {
+	t.Parallel()
+
+	createArgs := func(recreatedRootHashes *[]string, numCloses *int) state.ArgsAccountsDBApiWithHistory {
+		args := createMockArgsAccountsDBApiWithHistory(nil)
+		args.AppStatusHandler = statusHandler.NewAppStatusHandlerMock()
+		args.AccountsAdapterCreator = &mockState.AccountsAdapterCreatorStub{
+			CreateAccountsAdapterCalled: func() (state.AccountsAdapter, error) {
+				var recreatedRootHash string
+
+				return &mockState.AccountsStub{
+					RecreateTrieCalled: func(options common.RootHashHolder) error {
+						recreatedRootHash = string(options.GetRootHash())
+						*recreatedRootHashes = append(*recreatedRootHashes, recreatedRootHash)
+						return nil
+					},
+					GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+						return createUserAcc(address), nil
+					},
+					GetCodeCalled: func(codeHash []byte) []byte {
+						return make([]byte, 8192)
+					},
+					CloseCalled: func() error {
+						assert.Fail(t, "the pooled tries should not be closed one by one")
+						return nil
+					},
+				}, nil
+			},
+			CloseCalled: func() error {
+				*numCloses++
+				return nil
+			},
+		}
+
+		return args
+	}
+	getAccount := func(accountsApi state.AccountsAdapterAPI, rootHash string) {
+		_, _, err := accountsApi.GetAccountWithBlockInfo(testscommon.TestPubKeyAlice, holders.NewDefaultRootHashesHolder([]byte(rootHash)))
+		require.Nil(t, err)
 	}
+
+	t.Run("least recently used trie should be evicted when reaching the max number of tries", func(t *testing.T) {
+		t.Parallel()
+
+		recreatedRootHashes := make([]string, 0)
+		numCloses := 0
+		args := createArgs(&recreatedRootHashes, &numCloses)
+		args.MaxRecreatedTries = 2
+		accountsApi, _ := state.NewAccountsDBApiWithHistory(args)
+
+		getAccount(accountsApi, "rootHash1")
+		getAccount(accountsApi, "rootHash2")
+		getAccount(accountsApi, "rootHash1")
+		getAccount(accountsApi, "rootHash3")
+		getAccount(accountsApi, "rootHash1")
+		getAccount(accountsApi, "rootHash2")
+
+		assert.Equal(t, []string{"rootHash1", "rootHash2", "rootHash3", "rootHash2"}, recreatedRootHashes)
+		appStatusHandler := args.AppStatusHandler.(*statusHandler.AppStatusHandlerMock)
+		assert.Equal(t, uint64(2), appStatusHandler.GetUint64(common.MetricHistoricalTriesPoolHits))
+		assert.Equal(t, uint64(4), appStatusHandler.GetUint64(common.MetricHistoricalTriesPoolRecreations))
+		assert.True(t, appStatusHandler.GetUint64(common.MetricHistoricalTriesPoolSizeInBytes) > 0)
+
+		err := accountsApi.Close()
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numCloses)
+		assert.Equal(t, uint64(0), appStatusHandler.GetUint64(common.MetricHistoricalTriesPoolSizeInBytes))
+	})
+	t.Run("least recently used trie should be evicted when reaching the max size", func(t *testing.T) {
+		t.Parallel()
+
+		recreatedRootHashes := make([]string, 0)
+		numCloses := 0
+		args := createArgs(&recreatedRootHashes, &numCloses)
+		args.MaxRecreatedTriesSizeInBytes = 16384
+		accountsApi, _ := state.NewAccountsDBApiWithHistory(args)
+
+		getAccount(accountsApi, "rootHash1")
+		getAccount(accountsApi, "rootHash2")
+		_, _, err := accountsApi.GetCodeWithBlockInfo([]byte("codeHash"), holders.NewDefaultRootHashesHolder([]byte("rootHash2")))
+		require.Nil(t, err)
+		getAccount(accountsApi, "rootHash1")
+
+		assert.Equal(t, []string{"rootHash1", "rootHash2", "rootHash1"}, recreatedRootHashes)
+	})
 }
 
 func createDummyAccountWithBalanceString(balanceString string) state.UserAccountHandler {
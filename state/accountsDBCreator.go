@@ -0,0 +1,34 @@
+package state
+
+type accountsDBCreator struct {
+	args ArgsAccountsDB
+}
+
+// NewAccountsDBCreator creates a component able to create independent accounts DB instances from the same arguments
+func NewAccountsDBCreator(args ArgsAccountsDB) (*accountsDBCreator, error) {
+	err := checkArgsAccountsDB(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountsDBCreator{
+		args: args,
+	}, nil
+}
+
+// CreateAccountsAdapter creates a new accounts DB instance. The instances share the trie storage
+func (creator *accountsDBCreator) CreateAccountsAdapter() (AccountsAdapter, error) {
+	return NewAccountsDB(creator.args)
+}
+
+// Close closes the storage shared by the created accounts DB instances. It should be called once, after the created
+// instances are no longer used
+func (creator *accountsDBCreator) Close() error {
+	_ = creator.args.Trie.Close()
+	return creator.args.StoragePruningManager.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (creator *accountsDBCreator) IsInterfaceNil() bool {
+	return creator == nil
+}
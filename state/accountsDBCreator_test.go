@@ -0,0 +1,47 @@
+package state_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAccountsDBCreator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid args should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockAccountsDBArgs()
+		args.AccountFactory = nil
+		creator, err := state.NewAccountsDBCreator(args)
+		assert.True(t, check.IfNil(creator))
+		assert.True(t, errors.Is(err, state.ErrNilAccountFactory))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		creator, err := state.NewAccountsDBCreator(createMockAccountsDBArgs())
+		assert.False(t, check.IfNil(creator))
+		assert.Nil(t, err)
+	})
+}
+
+func TestAccountsDBCreator_CreateAccountsAdapter(t *testing.T) {
+	t.Parallel()
+
+	creator, _ := state.NewAccountsDBCreator(createMockAccountsDBArgs())
+
+	firstAdapter, err := creator.CreateAccountsAdapter()
+	require.Nil(t, err)
+	secondAdapter, err := creator.CreateAccountsAdapter()
+	require.Nil(t, err)
+
+	assert.False(t, check.IfNil(firstAdapter))
+	assert.False(t, check.IfNil(secondAdapter))
+	assert.True(t, firstAdapter != secondAdapter)
+}
@@ -165,3 +165,9 @@ var ErrValidatorsDifferentShards = errors.New("validators are not in the same sh
 
 // ErrValidatorNotFound signals that a validator was not found
 var ErrValidatorNotFound = errors.New("validator not found")
+
+// ErrNilAccountsAdapterCreator signals that a nil accounts adapter creator has been provided
+var ErrNilAccountsAdapterCreator = errors.New("nil accounts adapter creator")
+
+// ErrInvalidRecreatedTriesPoolConfig signals that the configuration of the recreated tries pool is invalid
+var ErrInvalidRecreatedTriesPoolConfig = errors.New("invalid recreated tries pool config")
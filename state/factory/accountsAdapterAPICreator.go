@@ -3,7 +3,9 @@ package factory
 import (
 	"fmt"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	chainData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/state/blockInfoProviders"
 )
@@ -49,13 +51,23 @@ func CreateAccountsAdapterAPIOnCurrent(args state.ArgsAccountsDB, chainHandler c
 }
 
 // CreateAccountsAdapterAPIOnHistorical creates a new instance of AccountsAdapterAPI that tracks historical state
-func CreateAccountsAdapterAPIOnHistorical(args state.ArgsAccountsDB) (state.AccountsAdapterAPI, error) {
-	accounts, err := state.NewAccountsDB(args)
+func CreateAccountsAdapterAPIOnHistorical(
+	args state.ArgsAccountsDB,
+	stateTriesConfig config.StateTriesConfig,
+	appStatusHandler core.AppStatusHandler,
+) (state.AccountsAdapterAPI, error) {
+	accountsCreator, err := state.NewAccountsDBCreator(args)
 	if err != nil {
 		return nil, fmt.Errorf("%w in CreateAccountsAdapterAPIOnHistorical", err)
 	}
 
-	accountsAdapterApi, err := state.NewAccountsDBApiWithHistory(accounts)
+	argsAccountsDBApiWithHistory := state.ArgsAccountsDBApiWithHistory{
+		AccountsAdapterCreator:       accountsCreator,
+		AppStatusHandler:             appStatusHandler,
+		MaxRecreatedTries:            stateTriesConfig.MaxHistoricalTries,
+		MaxRecreatedTriesSizeInBytes: stateTriesConfig.MaxHistoricalTriesSizeInMB * core.MegabyteSize,
+	}
+	accountsAdapterApi, err := state.NewAccountsDBApiWithHistory(argsAccountsDBApiWithHistory)
 	if err != nil {
 		return nil, fmt.Errorf("%w in CreateAccountsAdapterAPIOnHistorical", err)
 	}
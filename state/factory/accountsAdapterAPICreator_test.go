@@ -1,15 +1,18 @@
 package factory
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	mockState "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	"github.com/multiversx/mx-chain-go/testscommon/storageManager"
 	mockTrie "github.com/multiversx/mx-chain-go/testscommon/trie"
 	"github.com/stretchr/testify/assert"
@@ -107,19 +110,36 @@ func TestCreateAccountsAdapterAPIOnHistorical(t *testing.T) {
 
 		args := createMockAccountsArgs()
 		args.AccountFactory = nil
-		accountsAdapterApi, err := CreateAccountsAdapterAPIOnHistorical(args)
+		accountsAdapterApi, err := CreateAccountsAdapterAPIOnHistorical(args, createStateTriesConfig(), &statusHandler.AppStatusHandlerStub{})
 
 		assert.NotNil(t, err)
 		assert.True(t, strings.Contains(err.Error(), "CreateAccountsAdapterAPIOnHistorical"))
 		assert.True(t, check.IfNil(accountsAdapterApi))
 	})
+	t.Run("invalid pool config should error", func(t *testing.T) {
+		t.Parallel()
+
+		stateTriesConfig := createStateTriesConfig()
+		stateTriesConfig.MaxHistoricalTries = 0
+		accountsAdapterApi, err := CreateAccountsAdapterAPIOnHistorical(createMockAccountsArgs(), stateTriesConfig, &statusHandler.AppStatusHandlerStub{})
+
+		assert.True(t, errors.Is(err, state.ErrInvalidRecreatedTriesPoolConfig))
+		assert.True(t, check.IfNil(accountsAdapterApi))
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
 		args := createMockAccountsArgs()
-		accountsAdapterApi, err := CreateAccountsAdapterAPIOnHistorical(args)
+		accountsAdapterApi, err := CreateAccountsAdapterAPIOnHistorical(args, createStateTriesConfig(), &statusHandler.AppStatusHandlerStub{})
 
 		assert.Nil(t, err)
 		assert.False(t, check.IfNil(accountsAdapterApi))
 	})
 }
+
+func createStateTriesConfig() config.StateTriesConfig {
+	return config.StateTriesConfig{
+		MaxHistoricalTries:         4,
+		MaxHistoricalTriesSizeInMB: 1,
+	}
+}
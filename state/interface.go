@@ -213,6 +213,13 @@ type AccountsAdapterAPI interface {
 	GetCodeWithBlockInfo(codeHash []byte, options common.RootHashHolder) ([]byte, common.BlockInfo, error)
 }
 
+// AccountsAdapterCreator defines a component able to create independent accounts adapters over the same storage
+type AccountsAdapterCreator interface {
+	CreateAccountsAdapter() (AccountsAdapter, error)
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // DataTrie defines the behavior of a data trie
 type DataTrie interface {
 	common.Trie
@@ -0,0 +1,129 @@
+package state
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-storage-go/lrucache/capacity"
+)
+
+const (
+	// recreatedTrieBaseSizeInBytes is the estimated memory held by a freshly recreated trie and its accounts adapter
+	recreatedTrieBaseSizeInBytes = 4096
+	// resolvedNodesSizePerReadInBytes is the estimated memory of the trie nodes a read may resolve and keep in memory
+	resolvedNodesSizePerReadInBytes = 2048
+)
+
+type recreatedTrie struct {
+	accountsAdapter AccountsAdapter
+	sizeInBytes     int64
+}
+
+// recreatedTriesPool holds a bounded number of independently recreated tries, keyed by their root hash.
+// The least recently used tries are evicted when either the maximum number of tries or the maximum estimated
+// size is reached. The tries are never closed one by one, as they share the storage with the rest of the pool. The
+// shared storage is closed once, when the pool is closed
+type recreatedTriesPool struct {
+	accountsAdapterCreator AccountsAdapterCreator
+	appStatusHandler       core.AppStatusHandler
+	cache                  storage.AdaptedSizedLRUCache
+	mutRecreate            sync.Mutex
+}
+
+func newRecreatedTriesPool(args ArgsAccountsDBApiWithHistory) (*recreatedTriesPool, error) {
+	lruCache, err := capacity.NewCapacityLRU(int(args.MaxRecreatedTries), int64(args.MaxRecreatedTriesSizeInBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	args.AppStatusHandler.SetUInt64Value(common.MetricHistoricalTriesPoolHits, 0)
+	args.AppStatusHandler.SetUInt64Value(common.MetricHistoricalTriesPoolRecreations, 0)
+	args.AppStatusHandler.SetUInt64Value(common.MetricHistoricalTriesPoolSizeInBytes, 0)
+
+	return &recreatedTriesPool{
+		accountsAdapterCreator: args.AccountsAdapterCreator,
+		appStatusHandler:       args.AppStatusHandler,
+		cache:                  lruCache,
+	}, nil
+}
+
+// getOrRecreate returns the pooled trie for the provided root hash, recreating it if it is missing
+func (pool *recreatedTriesPool) getOrRecreate(options common.RootHashHolder) (*recreatedTrie, error) {
+	key := string(options.GetRootHash())
+
+	// First check to avoid re-creation:
+	entry, ok := pool.get(key)
+	if ok {
+		pool.appStatusHandler.Increment(common.MetricHistoricalTriesPoolHits)
+		return entry, nil
+	}
+
+	pool.mutRecreate.Lock()
+	defer pool.mutRecreate.Unlock()
+
+	// Second check to avoid re-creation, another routine might have recreated the same trie in the meantime:
+	entry, ok = pool.get(key)
+	if ok {
+		pool.appStatusHandler.Increment(common.MetricHistoricalTriesPoolHits)
+		return entry, nil
+	}
+
+	accountsAdapter, err := pool.accountsAdapterCreator.CreateAccountsAdapter()
+	if err != nil {
+		return nil, err
+	}
+
+	err = accountsAdapter.RecreateTrie(options)
+	if err != nil {
+		return nil, err
+	}
+
+	entry = &recreatedTrie{
+		accountsAdapter: accountsAdapter,
+		sizeInBytes:     recreatedTrieBaseSizeInBytes,
+	}
+	pool.cache.AddSized(key, entry, entry.sizeInBytes)
+	pool.appStatusHandler.Increment(common.MetricHistoricalTriesPoolRecreations)
+	pool.updateSizeMetric()
+
+	return entry, nil
+}
+
+func (pool *recreatedTriesPool) get(key string) (*recreatedTrie, bool) {
+	value, ok := pool.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+
+	entry, ok := value.(*recreatedTrie)
+	return entry, ok
+}
+
+// addReadSize accounts the memory estimated to be retained by the trie after serving a read
+func (pool *recreatedTriesPool) addReadSize(rootHash []byte, entry *recreatedTrie, sizeInBytes int) {
+	newSize := atomic.AddInt64(&entry.sizeInBytes, int64(sizeInBytes))
+
+	key := string(rootHash)
+	if !pool.cache.Contains(key) {
+		// the trie was evicted while serving the read
+		return
+	}
+
+	pool.cache.AddSized(key, entry, newSize)
+	pool.updateSizeMetric()
+}
+
+func (pool *recreatedTriesPool) updateSizeMetric() {
+	pool.appStatusHandler.SetUInt64Value(common.MetricHistoricalTriesPoolSizeInBytes, pool.cache.SizeInBytesContained())
+}
+
+// close empties the pool and closes the storage shared by the pooled tries
+func (pool *recreatedTriesPool) close() error {
+	pool.cache.Purge()
+	pool.updateSizeMetric()
+
+	return pool.accountsAdapterCreator.Close()
+}
@@ -0,0 +1,33 @@
+package snapshotFile
+
+import "errors"
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrInvalidMaxChunkSize signals that an invalid maximum chunk size has been provided
+var ErrInvalidMaxChunkSize = errors.New("invalid maximum chunk size")
+
+// ErrEmptyRootHash signals that an empty root hash has been provided
+var ErrEmptyRootHash = errors.New("empty root hash")
+
+// ErrIncompleteTrie signals that the exported tries have missing or corrupted nodes
+var ErrIncompleteTrie = errors.New("the tries have missing or corrupted nodes")
+
+// ErrInvalidFileFormat signals that the provided file is not a state snapshot file or is truncated
+var ErrInvalidFileFormat = errors.New("invalid state snapshot file format")
+
+// ErrUnsupportedFormatVersion signals that the state snapshot file was written with an unsupported format version
+var ErrUnsupportedFormatVersion = errors.New("unsupported state snapshot format version")
+
+// ErrRootHashMismatch signals that the state snapshot file was written for another root hash
+var ErrRootHashMismatch = errors.New("state snapshot root hash mismatch")
+
+// ErrInvalidChunk signals that a chunk of the state snapshot file does not match its manifest entry
+var ErrInvalidChunk = errors.New("invalid state snapshot chunk")
+
+// ErrInvalidTrieNode signals that a trie node from the state snapshot file does not match its hash
+var ErrInvalidTrieNode = errors.New("invalid trie node")
+
+// ErrRootNodeNotFound signals that the root node is missing from the state snapshot file
+var ErrRootNodeNotFound = errors.New("root node not found in the state snapshot file")
@@ -0,0 +1,175 @@
+package snapshotFile
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// The state snapshot file has the following layout, all the integers being big endian encoded:
+//
+//	header:   magic (8 bytes) | format version (uint32)
+//	chunks:   records of key length (uint32) | key | value length (uint32) | value, one record for each trie node
+//	manifest: the JSON encoded Manifest
+//	footer:   manifest offset (uint64) | manifest size (uint64) | magic (8 bytes)
+//
+// The manifest is written after the chunks, as it holds their hashes, and it is found from the fixed size footer.
+
+const (
+	// FormatVersion is the version of the state snapshot file format written by this package
+	FormatVersion = uint32(1)
+
+	fileMagic      = "MXSTSNAP"
+	headerSize     = len(fileMagic) + 4
+	footerSize     = 8 + 8 + len(fileMagic)
+	recordLenSize  = 4
+	maxManifestLen = 1 << 30
+)
+
+// Manifest describes the content of a state snapshot file
+type Manifest struct {
+	FormatVersion uint32      `json:"formatVersion"`
+	ShardID       uint32      `json:"shardID"`
+	Epoch         uint32      `json:"epoch"`
+	RootHash      string      `json:"rootHash"`
+	NumTrieNodes  uint64      `json:"numTrieNodes"`
+	NumDataTries  uint64      `json:"numDataTries"`
+	Chunks        []ChunkInfo `json:"chunks"`
+}
+
+// ChunkInfo describes a chunk of trie nodes from a state snapshot file
+type ChunkInfo struct {
+	Offset   uint64 `json:"offset"`
+	Size     uint64 `json:"size"`
+	NumNodes uint64 `json:"numNodes"`
+	Hash     string `json:"hash"`
+}
+
+// ReadManifest reads the manifest of the provided state snapshot file
+func ReadManifest(input io.ReadSeeker) (*Manifest, error) {
+	fileSize, err := input.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	if fileSize < int64(headerSize+footerSize) {
+		return nil, fmt.Errorf("%w: the file is too small", ErrInvalidFileFormat)
+	}
+
+	header := make([]byte, headerSize)
+	err = readAt(input, header, 0)
+	if err != nil {
+		return nil, err
+	}
+	if string(header[:len(fileMagic)]) != fileMagic {
+		return nil, fmt.Errorf("%w: invalid header", ErrInvalidFileFormat)
+	}
+	version := binary.BigEndian.Uint32(header[len(fileMagic):])
+	if version != FormatVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedFormatVersion, version)
+	}
+
+	footer := make([]byte, footerSize)
+	err = readAt(input, footer, fileSize-int64(footerSize))
+	if err != nil {
+		return nil, err
+	}
+	if string(footer[16:]) != fileMagic {
+		return nil, fmt.Errorf("%w: invalid footer", ErrInvalidFileFormat)
+	}
+
+	manifestOffset := binary.BigEndian.Uint64(footer[:8])
+	manifestSize := binary.BigEndian.Uint64(footer[8:16])
+	manifestEnd := uint64(fileSize) - uint64(footerSize)
+	isManifestOutOfBounds := manifestOffset < uint64(headerSize) || manifestSize > maxManifestLen ||
+		manifestOffset+manifestSize != manifestEnd
+	if isManifestOutOfBounds {
+		return nil, fmt.Errorf("%w: invalid manifest position", ErrInvalidFileFormat)
+	}
+
+	manifestBytes := make([]byte, manifestSize)
+	err = readAt(input, manifestBytes, int64(manifestOffset))
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{}
+	err = json.Unmarshal(manifestBytes, manifest)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidFileFormat, err.Error())
+	}
+
+	err = checkChunksPositions(manifest.Chunks, manifestOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func checkChunksPositions(chunks []ChunkInfo, manifestOffset uint64) error {
+	expectedOffset := uint64(headerSize)
+	for i, chunk := range chunks {
+		if chunk.Offset != expectedOffset || chunk.Size == 0 || chunk.Offset+chunk.Size > manifestOffset {
+			return fmt.Errorf("%w: chunk %d has an invalid position", ErrInvalidFileFormat, i)
+		}
+
+		expectedOffset += chunk.Size
+	}
+	if expectedOffset != manifestOffset {
+		return fmt.Errorf("%w: the chunks do not end at the manifest", ErrInvalidFileFormat)
+	}
+
+	return nil
+}
+
+func readAt(input io.ReadSeeker, buff []byte, offset int64) error {
+	_, err := input.Seek(offset, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.ReadFull(input, buff)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidFileFormat, err.Error())
+	}
+
+	return nil
+}
+
+func appendRecord(buff []byte, key []byte, value []byte) []byte {
+	buff = binary.BigEndian.AppendUint32(buff, uint32(len(key)))
+	buff = append(buff, key...)
+	buff = binary.BigEndian.AppendUint32(buff, uint32(len(value)))
+
+	return append(buff, value...)
+}
+
+// readRecord returns the key, the value and the remaining bytes of the provided chunk
+func readRecord(buff []byte) ([]byte, []byte, []byte, error) {
+	key, buff, err := readLengthPrefixed(buff)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	value, buff, err := readLengthPrefixed(buff)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return key, value, buff, nil
+}
+
+func readLengthPrefixed(buff []byte) ([]byte, []byte, error) {
+	if len(buff) < recordLenSize {
+		return nil, nil, fmt.Errorf("%w: truncated record", ErrInvalidChunk)
+	}
+
+	length := uint64(binary.BigEndian.Uint32(buff))
+	buff = buff[recordLenSize:]
+	if uint64(len(buff)) < length {
+		return nil, nil, fmt.Errorf("%w: truncated record", ErrInvalidChunk)
+	}
+
+	return buff[:length], buff[length:], nil
+}
@@ -0,0 +1,134 @@
+package snapshotFile
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+// ArgsSnapshotFileImporter holds the arguments needed to create a state snapshot file importer
+type ArgsSnapshotFileImporter struct {
+	Storer common.BaseStorer
+	Hasher hashing.Hasher
+}
+
+type snapshotFileImporter struct {
+	storer common.BaseStorer
+	hasher hashing.Hasher
+}
+
+// NewSnapshotFileImporter creates a component able to verify a state snapshot file and to store its trie nodes
+func NewSnapshotFileImporter(args ArgsSnapshotFileImporter) (*snapshotFileImporter, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, state.ErrNilHasher
+	}
+
+	return &snapshotFileImporter{
+		storer: args.Storer,
+		hasher: args.Hasher,
+	}, nil
+}
+
+// Import checks that the state snapshot file was written for the expected root hash and stores its trie nodes, chunk
+// by chunk. Each chunk is checked against its manifest hash and each node value against its key before being stored,
+// so a node is only stored under the hash of its own content and can not replace the value of another key. The nodes
+// are not checked to be reachable from the root hash: the ones not belonging to any imported trie are stored as well,
+// but are never read. Only the presence of the root node is checked, after all the chunks were stored, and the nodes
+// already stored are not removed when the import fails. The completeness of the imported tries is not checked either,
+// as the tries are walked from the root hash by the accounts syncer which requests the nodes not found in the storer
+func (sfi *snapshotFileImporter) Import(input io.ReadSeeker, expectedRootHash []byte) (*Manifest, error) {
+	if len(expectedRootHash) == 0 {
+		return nil, ErrEmptyRootHash
+	}
+
+	manifest, err := ReadManifest(input)
+	if err != nil {
+		return nil, err
+	}
+
+	rootHash, err := hex.DecodeString(manifest.RootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidFileFormat, err.Error())
+	}
+	if !bytes.Equal(rootHash, expectedRootHash) {
+		return nil, fmt.Errorf("%w: expected %s, file has %s",
+			ErrRootHashMismatch, hex.EncodeToString(expectedRootHash), manifest.RootHash)
+	}
+
+	rootNodeFound := false
+	for i, chunkInfo := range manifest.Chunks {
+		chunkHasRootNode, errImport := sfi.importChunk(input, chunkInfo, rootHash)
+		if errImport != nil {
+			return nil, fmt.Errorf("%w, chunk %d", errImport, i)
+		}
+
+		rootNodeFound = rootNodeFound || chunkHasRootNode
+	}
+	if !rootNodeFound {
+		return nil, ErrRootNodeNotFound
+	}
+
+	log.Info("state snapshot file imported",
+		"root hash", rootHash,
+		"shard", manifest.ShardID,
+		"epoch", manifest.Epoch,
+		"num trie nodes", manifest.NumTrieNodes,
+		"num data tries", manifest.NumDataTries,
+	)
+
+	return manifest, nil
+}
+
+func (sfi *snapshotFileImporter) importChunk(input io.ReadSeeker, chunkInfo ChunkInfo, rootHash []byte) (bool, error) {
+	chunk := make([]byte, chunkInfo.Size)
+	err := readAt(input, chunk, int64(chunkInfo.Offset))
+	if err != nil {
+		return false, err
+	}
+
+	chunkHash := hex.EncodeToString(sfi.hasher.Compute(string(chunk)))
+	if chunkHash != chunkInfo.Hash {
+		return false, fmt.Errorf("%w: hash mismatch", ErrInvalidChunk)
+	}
+
+	rootNodeFound := false
+	numNodes := uint64(0)
+	for len(chunk) > 0 {
+		var key, value []byte
+		key, value, chunk, err = readRecord(chunk)
+		if err != nil {
+			return false, err
+		}
+
+		if !bytes.Equal(sfi.hasher.Compute(string(value)), key) {
+			return false, fmt.Errorf("%w: %s", ErrInvalidTrieNode, hex.EncodeToString(key))
+		}
+
+		err = sfi.storer.Put(key, value)
+		if err != nil {
+			return false, err
+		}
+
+		rootNodeFound = rootNodeFound || bytes.Equal(key, rootHash)
+		numNodes++
+	}
+	if numNodes != chunkInfo.NumNodes {
+		return false, fmt.Errorf("%w: expected %d nodes, found %d", ErrInvalidChunk, chunkInfo.NumNodes, numNodes)
+	}
+
+	return rootNodeFound, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sfi *snapshotFileImporter) IsInterfaceNil() bool {
+	return sfi == nil
+}
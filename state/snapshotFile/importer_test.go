@@ -0,0 +1,159 @@
+package snapshotFile
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockImporterArgs(storer common.BaseStorer) ArgsSnapshotFileImporter {
+	return ArgsSnapshotFileImporter{
+		Storer: storer,
+		Hasher: &hashingMocks.HasherMock{},
+	}
+}
+
+func TestNewSnapshotFileImporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		importer, err := NewSnapshotFileImporter(createMockImporterArgs(nil))
+		assert.Nil(t, importer)
+		assert.Equal(t, ErrNilStorer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockImporterArgs(testscommon.NewMemDbMock())
+		args.Hasher = nil
+		importer, err := NewSnapshotFileImporter(args)
+		assert.Nil(t, importer)
+		assert.Equal(t, state.ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		importer, err := NewSnapshotFileImporter(createMockImporterArgs(testscommon.NewMemDbMock()))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(importer))
+	})
+}
+
+func TestSnapshotFileImporter_Import(t *testing.T) {
+	t.Parallel()
+
+	tries := createTestTries(t, 10)
+	fileBytes, manifest := writeSnapshotFile(t, tries)
+
+	importFile := func(fileBytes []byte, rootHash []byte) (*Manifest, *testscommon.MemDbMock, error) {
+		storer := testscommon.NewMemDbMock()
+		importer, _ := NewSnapshotFileImporter(createMockImporterArgs(storer))
+		importedManifest, err := importer.Import(bytes.NewReader(fileBytes), rootHash)
+
+		return importedManifest, storer, err
+	}
+	alterFile := func(alter func(buff []byte)) []byte {
+		altered := append([]byte{}, fileBytes...)
+		alter(altered)
+
+		return altered
+	}
+
+	t.Run("empty root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		importedManifest, _, err := importFile(fileBytes, nil)
+		assert.Nil(t, importedManifest)
+		assert.Equal(t, ErrEmptyRootHash, err)
+	})
+	t.Run("truncated file should error", func(t *testing.T) {
+		t.Parallel()
+
+		importedManifest, _, err := importFile(fileBytes[:len(fileBytes)-1], tries.rootHash)
+		assert.Nil(t, importedManifest)
+		assert.True(t, errors.Is(err, ErrInvalidFileFormat))
+	})
+	t.Run("unsupported format version should error", func(t *testing.T) {
+		t.Parallel()
+
+		altered := alterFile(func(buff []byte) {
+			binary.BigEndian.PutUint32(buff[len(fileMagic):], FormatVersion+1)
+		})
+		importedManifest, _, err := importFile(altered, tries.rootHash)
+		assert.Nil(t, importedManifest)
+		assert.True(t, errors.Is(err, ErrUnsupportedFormatVersion))
+	})
+	t.Run("another root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		importedManifest, storer, err := importFile(fileBytes, tries.dataTriesRootHashes[0])
+		assert.Nil(t, importedManifest)
+		assert.True(t, errors.Is(err, ErrRootHashMismatch))
+		_, errGet := storer.Get(tries.rootHash)
+		assert.NotNil(t, errGet)
+	})
+	t.Run("altered chunk should error", func(t *testing.T) {
+		t.Parallel()
+
+		altered := alterFile(func(buff []byte) {
+			lastChunk := manifest.Chunks[len(manifest.Chunks)-1]
+			buff[lastChunk.Offset+lastChunk.Size-1]++
+		})
+		importedManifest, _, err := importFile(altered, tries.rootHash)
+		assert.Nil(t, importedManifest)
+		assert.True(t, errors.Is(err, ErrInvalidChunk))
+	})
+	t.Run("should import all the tries", func(t *testing.T) {
+		t.Parallel()
+
+		importedManifest, storer, err := importFile(fileBytes, tries.rootHash)
+		require.Nil(t, err)
+		assert.Equal(t, manifest, importedManifest)
+
+		checker, _ := trie.NewTrieNodesChecker(trie.ArgsTrieNodesChecker{
+			Storer:      storer,
+			Marshalizer: &marshal.GogoProtoMarshalizer{},
+			Hasher:      &hashingMocks.HasherMock{},
+		})
+		allRootHashes := append([][]byte{tries.rootHash}, tries.dataTriesRootHashes...)
+		for _, rootHash := range allRootHashes {
+			result := checker.Check(rootHash)
+			assert.Empty(t, result.MissingNodes)
+			assert.Empty(t, result.CorruptedNodes)
+		}
+	})
+}
+
+func TestSnapshotFileImporter_ImportAlteredNodeShouldError(t *testing.T) {
+	t.Parallel()
+
+	fileBytes := bytes.NewBuffer(nil)
+	cw := newChunksWriter(fileBytes, &hashingMocks.HasherMock{}, 1024, func() {})
+	require.Nil(t, cw.writeHeader())
+	cw.addNode([]byte("key"), []byte("not the value of the key"))
+	require.Nil(t, cw.flushChunk())
+	require.Nil(t, cw.writeManifest(&Manifest{
+		FormatVersion: FormatVersion,
+		RootHash:      "6b6579",
+		NumTrieNodes:  cw.numNodes,
+		Chunks:        cw.chunks,
+	}))
+
+	importer, _ := NewSnapshotFileImporter(createMockImporterArgs(testscommon.NewMemDbMock()))
+	importedManifest, err := importer.Import(bytes.NewReader(fileBytes.Bytes()), []byte("key"))
+	assert.Nil(t, importedManifest)
+	assert.True(t, errors.Is(err, ErrInvalidTrieNode))
+}
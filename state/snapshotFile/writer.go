@@ -0,0 +1,288 @@
+package snapshotFile
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/trie"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("state/snapshotFile")
+
+// ArgsSnapshotFileWriter holds the arguments needed to create a state snapshot file writer
+type ArgsSnapshotFileWriter struct {
+	Storer       common.BaseStorer
+	Marshalizer  marshal.Marshalizer
+	Hasher       hashing.Hasher
+	MaxChunkSize int
+}
+
+type snapshotFileWriter struct {
+	storer       common.BaseStorer
+	marshalizer  marshal.Marshalizer
+	hasher       hashing.Hasher
+	maxChunkSize int
+}
+
+// NewSnapshotFileWriter creates a component able to write the accounts trie and its data tries into a state snapshot file
+func NewSnapshotFileWriter(args ArgsSnapshotFileWriter) (*snapshotFileWriter, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, state.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, state.ErrNilHasher
+	}
+	if args.MaxChunkSize < 1 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidMaxChunkSize, args.MaxChunkSize)
+	}
+
+	return &snapshotFileWriter{
+		storer:       args.Storer,
+		marshalizer:  args.Marshalizer,
+		hasher:       args.Hasher,
+		maxChunkSize: args.MaxChunkSize,
+	}, nil
+}
+
+// Write walks the accounts trie with the provided root hash and all its data tries, writing their nodes into the
+// output. The nodes shared by several data tries are written once for each data trie. An error is returned if any
+// node is missing or corrupted, in which case the output holds an incomplete file and should be discarded
+func (sfw *snapshotFileWriter) Write(output io.Writer, rootHash []byte, shardID uint32, epoch uint32) (*Manifest, error) {
+	if len(rootHash) == 0 {
+		return nil, ErrEmptyRootHash
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cw := newChunksWriter(output, sfw.hasher, sfw.maxChunkSize, cancel)
+	err := cw.writeHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	checker, err := trie.NewTrieNodesChecker(trie.ArgsTrieNodesChecker{
+		Storer: &recordingStorer{
+			BaseStorer: sfw.storer,
+			handler:    cw.addNode,
+		},
+		Marshalizer: sfw.marshalizer,
+		Hasher:      sfw.hasher,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{
+		FormatVersion: FormatVersion,
+		ShardID:       shardID,
+		Epoch:         epoch,
+		RootHash:      hex.EncodeToString(rootHash),
+	}
+
+	numMissingNodes, numCorruptedNodes := 0, 0
+	addCheckResult := func(result *trie.TrieNodesCheckResult) {
+		numMissingNodes += len(result.MissingNodes)
+		numCorruptedNodes += len(result.CorruptedNodes)
+	}
+
+	writeDataTrie := func(leafValue []byte) {
+		accountData := &accounts.UserAccountData{}
+		errUnmarshal := sfw.marshalizer.Unmarshal(accountData, leafValue)
+		if errUnmarshal != nil {
+			log.Trace("this must be a leaf with code", "err", errUnmarshal)
+			return
+		}
+		if common.IsEmptyTrie(accountData.RootHash) {
+			return
+		}
+
+		// an interrupted data trie walk is also reported by the main trie walk
+		dataTrieResult, _ := checker.CheckWithLeavesHandler(ctx, accountData.RootHash, nil)
+		addCheckResult(dataTrieResult)
+		manifest.NumDataTries++
+	}
+
+	mainTrieResult, err := checker.CheckWithLeavesHandler(ctx, rootHash, writeDataTrie)
+	if cw.err != nil {
+		return nil, cw.err
+	}
+	if err != nil {
+		return nil, err
+	}
+	addCheckResult(mainTrieResult)
+	if numMissingNodes > 0 || numCorruptedNodes > 0 {
+		return nil, fmt.Errorf("%w: %d missing, %d corrupted", ErrIncompleteTrie, numMissingNodes, numCorruptedNodes)
+	}
+
+	err = cw.flushChunk()
+	if err != nil {
+		return nil, err
+	}
+
+	manifest.NumTrieNodes = cw.numNodes
+	manifest.Chunks = cw.chunks
+	err = cw.writeManifest(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("state snapshot file written",
+		"root hash", rootHash,
+		"num trie nodes", manifest.NumTrieNodes,
+		"num data tries", manifest.NumDataTries,
+		"num chunks", len(manifest.Chunks),
+	)
+
+	return manifest, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sfw *snapshotFileWriter) IsInterfaceNil() bool {
+	return sfw == nil
+}
+
+// chunksWriter groups the trie nodes in chunks and writes them, keeping the first error and cancelling the tries walk
+// when an error occurs
+type chunksWriter struct {
+	output       io.Writer
+	hasher       hashing.Hasher
+	maxChunkSize int
+	cancel       context.CancelFunc
+
+	offset        uint64
+	chunk         []byte
+	numChunkNodes uint64
+	numNodes      uint64
+	chunks        []ChunkInfo
+	err           error
+}
+
+func newChunksWriter(output io.Writer, hasher hashing.Hasher, maxChunkSize int, cancel context.CancelFunc) *chunksWriter {
+	return &chunksWriter{
+		output:       output,
+		hasher:       hasher,
+		maxChunkSize: maxChunkSize,
+		cancel:       cancel,
+		chunk:        make([]byte, 0, maxChunkSize),
+		chunks:       make([]ChunkInfo, 0),
+	}
+}
+
+func (cw *chunksWriter) writeHeader() error {
+	header := make([]byte, 0, headerSize)
+	header = append(header, fileMagic...)
+	header = binary.BigEndian.AppendUint32(header, FormatVersion)
+
+	return cw.write(header)
+}
+
+func (cw *chunksWriter) addNode(key []byte, value []byte) {
+	if cw.err != nil {
+		return
+	}
+
+	cw.chunk = appendRecord(cw.chunk, key, value)
+	cw.numChunkNodes++
+	cw.numNodes++
+	if len(cw.chunk) < cw.maxChunkSize {
+		return
+	}
+
+	err := cw.flushChunk()
+	if err != nil {
+		cw.err = err
+		cw.cancel()
+	}
+}
+
+func (cw *chunksWriter) flushChunk() error {
+	if len(cw.chunk) == 0 {
+		return nil
+	}
+
+	chunkInfo := ChunkInfo{
+		Offset:   cw.offset,
+		Size:     uint64(len(cw.chunk)),
+		NumNodes: cw.numChunkNodes,
+		Hash:     hex.EncodeToString(cw.hasher.Compute(string(cw.chunk))),
+	}
+	err := cw.write(cw.chunk)
+	if err != nil {
+		return err
+	}
+
+	cw.chunks = append(cw.chunks, chunkInfo)
+	cw.chunk = cw.chunk[:0]
+	cw.numChunkNodes = 0
+
+	return nil
+}
+
+func (cw *chunksWriter) writeManifest(manifest *Manifest) error {
+	manifestBytes, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+
+	footer := make([]byte, 0, footerSize)
+	footer = binary.BigEndian.AppendUint64(footer, cw.offset)
+	footer = binary.BigEndian.AppendUint64(footer, uint64(len(manifestBytes)))
+	footer = append(footer, fileMagic...)
+
+	err = cw.write(manifestBytes)
+	if err != nil {
+		return err
+	}
+
+	return cw.write(footer)
+}
+
+func (cw *chunksWriter) write(buff []byte) error {
+	n, err := cw.output.Write(buff)
+	cw.offset += uint64(n)
+
+	return err
+}
+
+// recordingStorer passes the trie nodes read by the tries walk to the chunks writer
+type recordingStorer struct {
+	common.BaseStorer
+	handler func(key []byte, value []byte)
+}
+
+// Get returns the value of the provided key from the wrapped storer, recording the read node
+func (rs *recordingStorer) Get(key []byte) ([]byte, error) {
+	value, err := rs.BaseStorer.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	rs.handler(key, value)
+
+	return value, nil
+}
+
+// Close does nothing as the wrapped storer is owned by the caller
+func (rs *recordingStorer) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rs *recordingStorer) IsInterfaceNil() bool {
+	return rs == nil
+}
@@ -0,0 +1,204 @@
+package snapshotFile
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testTries struct {
+	storageManager      common.StorageManager
+	rootHash            []byte
+	dataTriesRootHashes [][]byte
+}
+
+func createTestTries(t *testing.T, numAccounts int) *testTries {
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	hasher := &hashingMocks.HasherMock{}
+	storageManager, err := trie.NewTrieStorageManager(storage.GetStorageManagerArgs())
+	require.Nil(t, err)
+
+	mainTrie, err := trie.NewTrie(storageManager, marshaller, hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+	require.Nil(t, err)
+
+	tries := &testTries{
+		storageManager:      storageManager,
+		dataTriesRootHashes: make([][]byte, 0, numAccounts),
+	}
+	for i := 0; i < numAccounts; i++ {
+		dataTrie, errNew := trie.NewTrie(storageManager, marshaller, hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+		require.Nil(t, errNew)
+		for j := 0; j < 10; j++ {
+			require.Nil(t, dataTrie.Update([]byte(fmt.Sprintf("key%d_%d", i, j)), []byte("value")))
+		}
+		require.Nil(t, dataTrie.Commit())
+		dataTrieRootHash, _ := dataTrie.RootHash()
+		tries.dataTriesRootHashes = append(tries.dataTriesRootHashes, dataTrieRootHash)
+
+		address := []byte(fmt.Sprintf("address%d", i))
+		accountData := &accounts.UserAccountData{
+			Address:  address,
+			Balance:  big.NewInt(int64(i)),
+			RootHash: dataTrieRootHash,
+		}
+		accountBytes, errMarshal := marshaller.Marshal(accountData)
+		require.Nil(t, errMarshal)
+		require.Nil(t, mainTrie.Update(address, accountBytes))
+	}
+	require.Nil(t, mainTrie.Commit())
+	tries.rootHash, _ = mainTrie.RootHash()
+
+	return tries
+}
+
+func createMockWriterArgs(storer common.BaseStorer) ArgsSnapshotFileWriter {
+	return ArgsSnapshotFileWriter{
+		Storer:       storer,
+		Marshalizer:  &marshal.GogoProtoMarshalizer{},
+		Hasher:       &hashingMocks.HasherMock{},
+		MaxChunkSize: 1024,
+	}
+}
+
+func writeSnapshotFile(t *testing.T, tries *testTries) ([]byte, *Manifest) {
+	writer, err := NewSnapshotFileWriter(createMockWriterArgs(tries.storageManager))
+	require.Nil(t, err)
+
+	output := bytes.NewBuffer(nil)
+	manifest, err := writer.Write(output, tries.rootHash, 1, 7)
+	require.Nil(t, err)
+
+	return output.Bytes(), manifest
+}
+
+type failingWriter struct {
+	numWritesBeforeError int
+}
+
+func (fw *failingWriter) Write(buff []byte) (int, error) {
+	if fw.numWritesBeforeError == 0 {
+		return 0, errors.New("write error")
+	}
+	fw.numWritesBeforeError--
+
+	return len(buff), nil
+}
+
+func TestNewSnapshotFileWriter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		writer, err := NewSnapshotFileWriter(createMockWriterArgs(nil))
+		assert.Nil(t, writer)
+		assert.Equal(t, ErrNilStorer, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWriterArgs(createTestTries(t, 0).storageManager)
+		args.Marshalizer = nil
+		writer, err := NewSnapshotFileWriter(args)
+		assert.Nil(t, writer)
+		assert.Equal(t, state.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWriterArgs(createTestTries(t, 0).storageManager)
+		args.Hasher = nil
+		writer, err := NewSnapshotFileWriter(args)
+		assert.Nil(t, writer)
+		assert.Equal(t, state.ErrNilHasher, err)
+	})
+	t.Run("invalid max chunk size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWriterArgs(createTestTries(t, 0).storageManager)
+		args.MaxChunkSize = 0
+		writer, err := NewSnapshotFileWriter(args)
+		assert.Nil(t, writer)
+		assert.True(t, errors.Is(err, ErrInvalidMaxChunkSize))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		writer, err := NewSnapshotFileWriter(createMockWriterArgs(createTestTries(t, 0).storageManager))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(writer))
+	})
+}
+
+func TestSnapshotFileWriter_Write(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		writer, _ := NewSnapshotFileWriter(createMockWriterArgs(createTestTries(t, 0).storageManager))
+		manifest, err := writer.Write(bytes.NewBuffer(nil), nil, 0, 0)
+		assert.Nil(t, manifest)
+		assert.Equal(t, ErrEmptyRootHash, err)
+	})
+	t.Run("missing data trie node should error", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t, 3)
+		require.Nil(t, tries.storageManager.Remove(tries.dataTriesRootHashes[1]))
+
+		writer, _ := NewSnapshotFileWriter(createMockWriterArgs(tries.storageManager))
+		manifest, err := writer.Write(bytes.NewBuffer(nil), tries.rootHash, 0, 0)
+		assert.Nil(t, manifest)
+		assert.True(t, errors.Is(err, ErrIncompleteTrie))
+	})
+	t.Run("output error should stop the tries walk", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t, 10)
+		args := createMockWriterArgs(tries.storageManager)
+		args.MaxChunkSize = 1
+		writer, _ := NewSnapshotFileWriter(args)
+		manifest, err := writer.Write(&failingWriter{numWritesBeforeError: 3}, tries.rootHash, 0, 0)
+		assert.Nil(t, manifest)
+		assert.Equal(t, "write error", err.Error())
+	})
+	t.Run("should write all the tries in chunks", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t, 10)
+		fileBytes, manifest := writeSnapshotFile(t, tries)
+
+		assert.Equal(t, FormatVersion, manifest.FormatVersion)
+		assert.Equal(t, uint32(1), manifest.ShardID)
+		assert.Equal(t, uint32(7), manifest.Epoch)
+		assert.Equal(t, hex.EncodeToString(tries.rootHash), manifest.RootHash)
+		assert.Equal(t, uint64(10), manifest.NumDataTries)
+		assert.True(t, len(manifest.Chunks) > 1)
+
+		numNodes := uint64(0)
+		for _, chunk := range manifest.Chunks {
+			numNodes += chunk.NumNodes
+		}
+		assert.Equal(t, manifest.NumTrieNodes, numNodes)
+
+		readManifest, err := ReadManifest(bytes.NewReader(fileBytes))
+		require.Nil(t, err)
+		assert.Equal(t, manifest, readManifest)
+	})
+}
@@ -0,0 +1,30 @@
+package trieVerifier
+
+import (
+	"sync/atomic"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// countingStorer counts the nodes read by the trie walks, so the progress of a verification can be reported
+type countingStorer struct {
+	common.BaseStorer
+	numCheckedNodes *uint64
+}
+
+// Get returns the value of the provided key from the wrapped storer, counting the read
+func (cs *countingStorer) Get(key []byte) ([]byte, error) {
+	atomic.AddUint64(cs.numCheckedNodes, 1)
+
+	return cs.BaseStorer.Get(key)
+}
+
+// Close does nothing as the wrapped storer is owned by another component
+func (cs *countingStorer) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cs *countingStorer) IsInterfaceNil() bool {
+	return cs == nil
+}
@@ -0,0 +1,15 @@
+package trieVerifier
+
+import "errors"
+
+// ErrVerificationInProgress signals that a trie verification is already in progress
+var ErrVerificationInProgress = errors.New("trie verification already in progress")
+
+// ErrEmptyRootHash signals that an empty root hash has been provided
+var ErrEmptyRootHash = errors.New("empty root hash")
+
+// ErrVerifierClosed signals that the trie verifier has been closed
+var ErrVerifierClosed = errors.New("trie verifier is closed")
+
+// ErrNilDataTrieSyncer signals that a nil data trie syncer has been provided
+var ErrNilDataTrieSyncer = errors.New("nil data trie syncer")
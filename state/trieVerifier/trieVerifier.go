@@ -0,0 +1,314 @@
+package trieVerifier
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/multiversx/mx-chain-go/trie/storageMarker"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("state/trieVerifier")
+
+// maxListedNodes is the maximum number of missing or corrupted node hashes kept in the verification status
+const maxListedNodes = 1000
+
+// maxPendingMissingNodes is the maximum number of missing node hashes kept for healing. The data trie nodes are healed
+// in batches while walking the main trie, each time the limit is reached
+const maxPendingMissingNodes = 10000
+
+// ArgsTrieVerifier holds the arguments needed to create a trie verifier
+type ArgsTrieVerifier struct {
+	TrieStorageManager common.StorageManager
+	Marshalizer        marshal.Marshalizer
+	Hasher             hashing.Hasher
+	Syncer             state.AccountsDBSyncer
+	DataTrieSyncer     common.StateSyncNotifierSubscriber
+}
+
+type trieVerifier struct {
+	trieStorageManager common.StorageManager
+	marshalizer        marshal.Marshalizer
+	hasher             hashing.Hasher
+	syncer             state.AccountsDBSyncer
+	dataTrieSyncer     common.StateSyncNotifierSubscriber
+	numCheckedNodes    uint64
+	maxPendingNodes    int
+	ctx                context.Context
+	cancel             context.CancelFunc
+
+	mutStatus            sync.RWMutex
+	status               common.TrieVerificationStatus
+	missingMainTrieNodes [][]byte
+	missingDataTrieNodes [][]byte
+}
+
+// NewTrieVerifier creates a component able to verify, in background, the main trie and the data tries of a given
+// root hash. The missing nodes can be fetched from the peers through the provided syncers
+func NewTrieVerifier(args ArgsTrieVerifier) (*trieVerifier, error) {
+	if check.IfNil(args.TrieStorageManager) {
+		return nil, state.ErrNilStorageManager
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, state.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, state.ErrNilHasher
+	}
+	if check.IfNil(args.Syncer) {
+		return nil, state.ErrNilTrieSyncer
+	}
+	if check.IfNil(args.DataTrieSyncer) {
+		return nil, ErrNilDataTrieSyncer
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &trieVerifier{
+		trieStorageManager: args.TrieStorageManager,
+		marshalizer:        args.Marshalizer,
+		hasher:             args.Hasher,
+		syncer:             args.Syncer,
+		dataTrieSyncer:     args.DataTrieSyncer,
+		maxPendingNodes:    maxPendingMissingNodes,
+		ctx:                ctx,
+		cancel:             cancel,
+		status:             newStatus(""),
+	}, nil
+}
+
+// StartVerification starts, in background, the verification of the main trie with the provided root hash and of all
+// its data tries. If heal is set, the missing nodes are fetched from the peers: the data trie nodes in batches during
+// the tries walk, the remaining ones after the walk ends. The corrupted nodes are only reported, as they can not be
+// told apart from valid nodes by the syncer
+func (tv *trieVerifier) StartVerification(rootHash []byte, heal bool) error {
+	if len(rootHash) == 0 {
+		return ErrEmptyRootHash
+	}
+	if tv.ctx.Err() != nil {
+		return ErrVerifierClosed
+	}
+
+	tv.mutStatus.Lock()
+	defer tv.mutStatus.Unlock()
+
+	if tv.status.InProgress {
+		return ErrVerificationInProgress
+	}
+
+	tv.status = newStatus(hex.EncodeToString(rootHash))
+	tv.status.InProgress = true
+	tv.status.Heal = heal
+	tv.status.StartTimestamp = time.Now().Unix()
+	tv.missingMainTrieNodes = make([][]byte, 0)
+	tv.missingDataTrieNodes = make([][]byte, 0)
+	atomic.StoreUint64(&tv.numCheckedNodes, 0)
+
+	go tv.verify(rootHash, heal)
+
+	return nil
+}
+
+func newStatus(rootHash string) common.TrieVerificationStatus {
+	return common.TrieVerificationStatus{
+		RootHash:       rootHash,
+		MissingNodes:   make([]string, 0),
+		CorruptedNodes: make([]string, 0),
+	}
+}
+
+func (tv *trieVerifier) verify(rootHash []byte, heal bool) {
+	log.Info("trie verification started", "root hash", rootHash, "heal", heal)
+
+	err := tv.checkTries(rootHash, heal)
+	if err == nil && heal {
+		err = tv.healMissingNodes()
+	}
+
+	tv.mutStatus.Lock()
+	tv.status.InProgress = false
+	tv.status.EndTimestamp = time.Now().Unix()
+	tv.status.NumCheckedNodes = atomic.LoadUint64(&tv.numCheckedNodes)
+	if err != nil {
+		tv.status.Error = err.Error()
+	}
+	status := tv.status
+	tv.mutStatus.Unlock()
+
+	log.Info("trie verification finished",
+		"root hash", rootHash,
+		"num checked nodes", status.NumCheckedNodes,
+		"num checked data tries", status.NumCheckedDataTries,
+		"num missing nodes", status.NumMissingNodes,
+		"num corrupted nodes", status.NumCorruptedNodes,
+		"num healed nodes", status.NumHealedNodes,
+		"error", err,
+	)
+}
+
+func (tv *trieVerifier) checkTries(rootHash []byte, heal bool) error {
+	checker, err := trie.NewTrieNodesChecker(trie.ArgsTrieNodesChecker{
+		Storer: &countingStorer{
+			BaseStorer:      tv.trieStorageManager,
+			numCheckedNodes: &tv.numCheckedNodes,
+		},
+		Marshalizer: tv.marshalizer,
+		Hasher:      tv.hasher,
+	})
+	if err != nil {
+		return err
+	}
+
+	checkDataTrie := func(leafValue []byte) {
+		accountData := &accounts.UserAccountData{}
+		errUnmarshal := tv.marshalizer.Unmarshal(accountData, leafValue)
+		if errUnmarshal != nil {
+			log.Trace("this must be a leaf with code", "err", errUnmarshal)
+			return
+		}
+		if common.IsEmptyTrie(accountData.RootHash) {
+			return
+		}
+
+		// an interrupted data trie walk is also reported by the main trie walk
+		dataTrieResult, _ := checker.CheckWithLeavesHandler(tv.ctx, accountData.RootHash, nil)
+		tv.addCheckResult(dataTrieResult, true, heal)
+		if heal && tv.numPendingMissingNodes() >= tv.maxPendingNodes {
+			// an interrupted healing is reported by the main trie walk, which stops as well
+			_ = tv.healMissingNodes()
+		}
+	}
+
+	mainTrieResult, err := checker.CheckWithLeavesHandler(tv.ctx, rootHash, checkDataTrie)
+	tv.addCheckResult(mainTrieResult, false, heal)
+
+	return err
+}
+
+// addCheckResult updates the status with the provided result. The missing nodes hashes are only kept when healing
+func (tv *trieVerifier) addCheckResult(result *trie.TrieNodesCheckResult, isDataTrie bool, heal bool) {
+	tv.mutStatus.Lock()
+	defer tv.mutStatus.Unlock()
+
+	if isDataTrie {
+		tv.status.NumCheckedDataTries++
+	}
+
+	if heal && isDataTrie {
+		tv.missingDataTrieNodes = append(tv.missingDataTrieNodes, result.MissingNodes...)
+	}
+	if heal && !isDataTrie {
+		tv.missingMainTrieNodes = append(tv.missingMainTrieNodes, result.MissingNodes...)
+	}
+	tv.status.NumMissingNodes += uint64(len(result.MissingNodes))
+	tv.status.NumCorruptedNodes += uint64(len(result.CorruptedNodes))
+	tv.status.MissingNodes = appendHexHashes(tv.status.MissingNodes, result.MissingNodes)
+	tv.status.CorruptedNodes = appendHexHashes(tv.status.CorruptedNodes, result.CorruptedNodes)
+}
+
+func appendHexHashes(list []string, hashes [][]byte) []string {
+	for _, hash := range hashes {
+		if len(list) >= maxListedNodes {
+			return list
+		}
+
+		list = append(list, hex.EncodeToString(hash))
+	}
+
+	return list
+}
+
+func (tv *trieVerifier) numPendingMissingNodes() int {
+	tv.mutStatus.RLock()
+	defer tv.mutStatus.RUnlock()
+
+	return len(tv.missingMainTrieNodes) + len(tv.missingDataTrieNodes)
+}
+
+// healMissingNodes fetches the pending missing main trie nodes, along with the data tries under them, through the
+// accounts syncer and the pending missing data trie nodes through the data trie sync path
+func (tv *trieVerifier) healMissingNodes() error {
+	tv.mutStatus.Lock()
+	missingMainTrieNodes := tv.missingMainTrieNodes
+	missingDataTrieNodes := tv.missingDataTrieNodes
+	tv.missingMainTrieNodes = make([][]byte, 0)
+	tv.missingDataTrieNodes = make([][]byte, 0)
+	tv.mutStatus.Unlock()
+
+	for _, hash := range missingMainTrieNodes {
+		err := tv.ctx.Err()
+		if err != nil {
+			return err
+		}
+
+		err = tv.syncer.SyncAccounts(hash, storageMarker.NewDisabledStorageMarker())
+		if err != nil {
+			log.Warn("could not heal missing main trie node", "hash", hash, "error", err)
+			continue
+		}
+
+		tv.incrementHealedNodes()
+	}
+
+	for _, hash := range missingDataTrieNodes {
+		err := tv.ctx.Err()
+		if err != nil {
+			return err
+		}
+
+		// the data trie sync does not return its outcome, so the node is looked up once the sync ends
+		tv.dataTrieSyncer.MissingDataTrieNodeFound(hash)
+		_, err = tv.trieStorageManager.Get(hash)
+		if err != nil {
+			log.Warn("could not heal missing data trie node", "hash", hash, "error", err)
+			continue
+		}
+
+		tv.incrementHealedNodes()
+	}
+
+	return nil
+}
+
+func (tv *trieVerifier) incrementHealedNodes() {
+	tv.mutStatus.Lock()
+	tv.status.NumHealedNodes++
+	tv.mutStatus.Unlock()
+}
+
+// GetStatus returns the status of the current or of the last trie verification
+func (tv *trieVerifier) GetStatus() common.TrieVerificationStatus {
+	tv.mutStatus.RLock()
+	defer tv.mutStatus.RUnlock()
+
+	status := tv.status
+	if status.InProgress {
+		status.NumCheckedNodes = atomic.LoadUint64(&tv.numCheckedNodes)
+	}
+	status.MissingNodes = append(make([]string, 0, len(tv.status.MissingNodes)), tv.status.MissingNodes...)
+	status.CorruptedNodes = append(make([]string, 0, len(tv.status.CorruptedNodes)), tv.status.CorruptedNodes...)
+
+	return status
+}
+
+// Close stops the verification in progress, if any
+func (tv *trieVerifier) Close() error {
+	tv.cancel()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tv *trieVerifier) IsInterfaceNil() bool {
+	return tv == nil
+}
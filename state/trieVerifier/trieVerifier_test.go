@@ -0,0 +1,406 @@
+package trieVerifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const waitVerificationTimeout = 5 * time.Second
+
+type testTries struct {
+	storageManager      common.StorageManager
+	rootHash            []byte
+	dataTriesRootHashes [][]byte
+	numDataTries        int
+}
+
+func createTestTries(t *testing.T, numAccounts int) *testTries {
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	hasher := &hashingMocks.HasherMock{}
+	storageManager, err := trie.NewTrieStorageManager(storage.GetStorageManagerArgs())
+	require.Nil(t, err)
+
+	mainTrie, err := trie.NewTrie(storageManager, marshaller, hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+	require.Nil(t, err)
+
+	tries := &testTries{
+		storageManager:      storageManager,
+		dataTriesRootHashes: make([][]byte, 0, numAccounts),
+		numDataTries:        numAccounts,
+	}
+	for i := 0; i < numAccounts; i++ {
+		dataTrie, errNew := trie.NewTrie(storageManager, marshaller, hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+		require.Nil(t, errNew)
+		for j := 0; j < 10; j++ {
+			require.Nil(t, dataTrie.Update([]byte(fmt.Sprintf("key%d_%d", i, j)), []byte("value")))
+		}
+		require.Nil(t, dataTrie.Commit())
+		dataTrieRootHash, _ := dataTrie.RootHash()
+		tries.dataTriesRootHashes = append(tries.dataTriesRootHashes, dataTrieRootHash)
+
+		address := []byte(fmt.Sprintf("address%d", i))
+		accountData := &accounts.UserAccountData{
+			Address:  address,
+			Balance:  big.NewInt(int64(i)),
+			RootHash: dataTrieRootHash,
+		}
+		accountBytes, errMarshal := marshaller.Marshal(accountData)
+		require.Nil(t, errMarshal)
+		require.Nil(t, mainTrie.Update(address, accountBytes))
+	}
+	require.Nil(t, mainTrie.Commit())
+	tries.rootHash, _ = mainTrie.RootHash()
+
+	return tries
+}
+
+func createMockArgs(storageManager common.StorageManager) ArgsTrieVerifier {
+	return ArgsTrieVerifier{
+		TrieStorageManager: storageManager,
+		Marshalizer:        &marshal.GogoProtoMarshalizer{},
+		Hasher:             &hashingMocks.HasherMock{},
+		Syncer:             &mock.AccountsDBSyncerStub{},
+		DataTrieSyncer:     &testscommon.StateSyncNotifierSubscriberStub{},
+	}
+}
+
+func waitVerificationToFinish(t *testing.T, verifier *trieVerifier) common.TrieVerificationStatus {
+	require.Eventually(t, func() bool {
+		return !verifier.GetStatus().InProgress
+	}, waitVerificationTimeout, 10*time.Millisecond)
+
+	return verifier.GetStatus()
+}
+
+func TestNewTrieVerifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storage manager should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(nil)
+		verifier, err := NewTrieVerifier(args)
+		assert.Nil(t, verifier)
+		assert.Equal(t, state.ErrNilStorageManager, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(createTestTries(t, 0).storageManager)
+		args.Marshalizer = nil
+		verifier, err := NewTrieVerifier(args)
+		assert.Nil(t, verifier)
+		assert.Equal(t, state.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(createTestTries(t, 0).storageManager)
+		args.Hasher = nil
+		verifier, err := NewTrieVerifier(args)
+		assert.Nil(t, verifier)
+		assert.Equal(t, state.ErrNilHasher, err)
+	})
+	t.Run("nil syncer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(createTestTries(t, 0).storageManager)
+		args.Syncer = nil
+		verifier, err := NewTrieVerifier(args)
+		assert.Nil(t, verifier)
+		assert.Equal(t, state.ErrNilTrieSyncer, err)
+	})
+	t.Run("nil data trie syncer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(createTestTries(t, 0).storageManager)
+		args.DataTrieSyncer = nil
+		verifier, err := NewTrieVerifier(args)
+		assert.Nil(t, verifier)
+		assert.Equal(t, ErrNilDataTrieSyncer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		verifier, err := NewTrieVerifier(createMockArgs(createTestTries(t, 0).storageManager))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(verifier))
+		assert.False(t, verifier.GetStatus().InProgress)
+	})
+}
+
+func TestTrieVerifier_StartVerification(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier, _ := NewTrieVerifier(createMockArgs(createTestTries(t, 0).storageManager))
+		err := verifier.StartVerification(nil, false)
+		assert.Equal(t, ErrEmptyRootHash, err)
+	})
+	t.Run("closed verifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier, _ := NewTrieVerifier(createMockArgs(createTestTries(t, 0).storageManager))
+		require.Nil(t, verifier.Close())
+
+		err := verifier.StartVerification([]byte("root hash"), false)
+		assert.Equal(t, ErrVerifierClosed, err)
+	})
+	t.Run("verification in progress should error", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t, 1)
+		encodedRoot, err := tries.storageManager.Get(tries.dataTriesRootHashes[0])
+		require.Nil(t, err)
+		require.Nil(t, tries.storageManager.Remove(tries.dataTriesRootHashes[0]))
+
+		syncStarted := make(chan struct{})
+		releaseSync := make(chan struct{})
+		args := createMockArgs(tries.storageManager)
+		args.DataTrieSyncer = &testscommon.StateSyncNotifierSubscriberStub{
+			MissingDataTrieNodeFoundCalled: func(hash []byte) {
+				close(syncStarted)
+				<-releaseSync
+				_ = tries.storageManager.Put(hash, encodedRoot)
+			},
+		}
+		verifier, _ := NewTrieVerifier(args)
+
+		require.Nil(t, verifier.StartVerification(tries.rootHash, true))
+		<-syncStarted
+
+		err = verifier.StartVerification(tries.rootHash, true)
+		assert.Equal(t, ErrVerificationInProgress, err)
+		assert.True(t, verifier.GetStatus().InProgress)
+
+		close(releaseSync)
+		status := waitVerificationToFinish(t, verifier)
+		assert.Equal(t, uint64(1), status.NumHealedNodes)
+	})
+	t.Run("complete tries should not report faulty nodes", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t, 10)
+		args := createMockArgs(tries.storageManager)
+		args.Syncer = &mock.AccountsDBSyncerStub{
+			SyncAccountsCalled: func(_ []byte, _ common.StorageMarker) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		args.DataTrieSyncer = &testscommon.StateSyncNotifierSubscriberStub{
+			MissingDataTrieNodeFoundCalled: func(_ []byte) {
+				assert.Fail(t, "should have not been called")
+			},
+		}
+		verifier, _ := NewTrieVerifier(args)
+
+		require.Nil(t, verifier.StartVerification(tries.rootHash, true))
+		status := waitVerificationToFinish(t, verifier)
+		assert.Equal(t, hex.EncodeToString(tries.rootHash), status.RootHash)
+		assert.True(t, status.Heal)
+		assert.True(t, status.NumCheckedNodes > uint64(tries.numDataTries))
+		assert.Equal(t, uint64(tries.numDataTries), status.NumCheckedDataTries)
+		assert.Zero(t, status.NumMissingNodes)
+		assert.Zero(t, status.NumCorruptedNodes)
+		assert.Empty(t, status.MissingNodes)
+		assert.Empty(t, status.CorruptedNodes)
+		assert.Empty(t, status.Error)
+		assert.True(t, status.EndTimestamp >= status.StartTimestamp)
+	})
+	t.Run("missing and corrupted nodes should be reported without healing", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t, 3)
+		require.Nil(t, tries.storageManager.Remove(tries.dataTriesRootHashes[0]))
+		corruptedHash := []byte("corrupted node hash")
+		require.Nil(t, tries.storageManager.Put(corruptedHash, []byte("corrupted node")))
+
+		args := createMockArgs(tries.storageManager)
+		args.Syncer = &mock.AccountsDBSyncerStub{
+			SyncAccountsCalled: func(_ []byte, _ common.StorageMarker) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		args.DataTrieSyncer = &testscommon.StateSyncNotifierSubscriberStub{
+			MissingDataTrieNodeFoundCalled: func(_ []byte) {
+				assert.Fail(t, "should have not been called")
+			},
+		}
+		verifier, _ := NewTrieVerifier(args)
+
+		require.Nil(t, verifier.StartVerification(tries.rootHash, false))
+		status := waitVerificationToFinish(t, verifier)
+		assert.Equal(t, uint64(tries.numDataTries), status.NumCheckedDataTries)
+		assert.Equal(t, uint64(1), status.NumMissingNodes)
+		assert.Equal(t, []string{hex.EncodeToString(tries.dataTriesRootHashes[0])}, status.MissingNodes)
+		assert.Zero(t, status.NumHealedNodes)
+
+		require.Nil(t, verifier.StartVerification(corruptedHash, false))
+		status = waitVerificationToFinish(t, verifier)
+		assert.Equal(t, uint64(1), status.NumCorruptedNodes)
+		assert.Equal(t, []string{hex.EncodeToString(corruptedHash)}, status.CorruptedNodes)
+		assert.Zero(t, status.NumMissingNodes)
+	})
+	t.Run("missing main trie nodes should be healed through the accounts syncer", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t, 3)
+		encodedRoot, err := tries.storageManager.Get(tries.rootHash)
+		require.Nil(t, err)
+		require.Nil(t, tries.storageManager.Remove(tries.rootHash))
+
+		numSyncCalls := 0
+		args := createMockArgs(tries.storageManager)
+		args.Syncer = &mock.AccountsDBSyncerStub{
+			SyncAccountsCalled: func(rootHash []byte, _ common.StorageMarker) error {
+				numSyncCalls++
+				assert.Equal(t, tries.rootHash, rootHash)
+				return tries.storageManager.Put(rootHash, encodedRoot)
+			},
+		}
+		args.DataTrieSyncer = &testscommon.StateSyncNotifierSubscriberStub{
+			MissingDataTrieNodeFoundCalled: func(_ []byte) {
+				assert.Fail(t, "should have not been called")
+			},
+		}
+		verifier, _ := NewTrieVerifier(args)
+
+		require.Nil(t, verifier.StartVerification(tries.rootHash, true))
+		status := waitVerificationToFinish(t, verifier)
+		assert.Equal(t, uint64(1), status.NumMissingNodes)
+		assert.Equal(t, uint64(1), status.NumHealedNodes)
+		assert.Equal(t, 1, numSyncCalls)
+
+		require.Nil(t, verifier.StartVerification(tries.rootHash, true))
+		status = waitVerificationToFinish(t, verifier)
+		assert.Zero(t, status.NumMissingNodes)
+		assert.Equal(t, uint64(tries.numDataTries), status.NumCheckedDataTries)
+	})
+	t.Run("missing data trie nodes should be healed through the data trie syncer", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t, 3)
+		healedHash := tries.dataTriesRootHashes[0]
+		encodedRoot, err := tries.storageManager.Get(healedHash)
+		require.Nil(t, err)
+		require.Nil(t, tries.storageManager.Remove(healedHash))
+		require.Nil(t, tries.storageManager.Remove(tries.dataTriesRootHashes[1]))
+
+		syncedHashes := make([][]byte, 0)
+		args := createMockArgs(tries.storageManager)
+		args.Syncer = &mock.AccountsDBSyncerStub{
+			SyncAccountsCalled: func(_ []byte, _ common.StorageMarker) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		args.DataTrieSyncer = &testscommon.StateSyncNotifierSubscriberStub{
+			MissingDataTrieNodeFoundCalled: func(hash []byte) {
+				syncedHashes = append(syncedHashes, hash)
+				if bytes.Equal(hash, healedHash) {
+					_ = tries.storageManager.Put(hash, encodedRoot)
+				}
+			},
+		}
+		verifier, _ := NewTrieVerifier(args)
+
+		require.Nil(t, verifier.StartVerification(tries.rootHash, true))
+		status := waitVerificationToFinish(t, verifier)
+		assert.Equal(t, uint64(2), status.NumMissingNodes)
+		assert.Equal(t, uint64(1), status.NumHealedNodes)
+		assert.ElementsMatch(t, [][]byte{healedHash, tries.dataTriesRootHashes[1]}, syncedHashes)
+
+		require.Nil(t, verifier.StartVerification(tries.rootHash, false))
+		status = waitVerificationToFinish(t, verifier)
+		assert.Equal(t, uint64(1), status.NumMissingNodes)
+		assert.Equal(t, []string{hex.EncodeToString(tries.dataTriesRootHashes[1])}, status.MissingNodes)
+	})
+	t.Run("missing data trie nodes should be healed in batches during the walk", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t, 6)
+		encodedRoots := make(map[string][]byte)
+		for _, dataTrieRootHash := range tries.dataTriesRootHashes {
+			encodedRoot, err := tries.storageManager.Get(dataTrieRootHash)
+			require.Nil(t, err)
+			encodedRoots[string(dataTrieRootHash)] = encodedRoot
+			require.Nil(t, tries.storageManager.Remove(dataTrieRootHash))
+		}
+
+		var verifier *trieVerifier
+		numCheckedDataTriesOnSync := make([]uint64, 0)
+		args := createMockArgs(tries.storageManager)
+		args.DataTrieSyncer = &testscommon.StateSyncNotifierSubscriberStub{
+			MissingDataTrieNodeFoundCalled: func(hash []byte) {
+				verifier.mutStatus.RLock()
+				numCheckedDataTriesOnSync = append(numCheckedDataTriesOnSync, verifier.status.NumCheckedDataTries)
+				assert.LessOrEqual(t, len(verifier.missingDataTrieNodes), verifier.maxPendingNodes)
+				verifier.mutStatus.RUnlock()
+
+				_ = tries.storageManager.Put(hash, encodedRoots[string(hash)])
+			},
+		}
+		verifier, _ = NewTrieVerifier(args)
+		verifier.maxPendingNodes = 2
+
+		require.Nil(t, verifier.StartVerification(tries.rootHash, true))
+		status := waitVerificationToFinish(t, verifier)
+		assert.Equal(t, uint64(tries.numDataTries), status.NumMissingNodes)
+		assert.Equal(t, uint64(tries.numDataTries), status.NumHealedNodes)
+		assert.Equal(t, []uint64{2, 2, 4, 4, 6, 6}, numCheckedDataTriesOnSync)
+		assert.Empty(t, verifier.missingDataTrieNodes)
+
+		require.Nil(t, verifier.StartVerification(tries.rootHash, false))
+		status = waitVerificationToFinish(t, verifier)
+		assert.Zero(t, status.NumMissingNodes)
+	})
+	t.Run("close should stop the verification", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t, 3)
+		for _, dataTrieRootHash := range tries.dataTriesRootHashes {
+			require.Nil(t, tries.storageManager.Remove(dataTrieRootHash))
+		}
+
+		var verifier *trieVerifier
+		numSyncCalls := 0
+		args := createMockArgs(tries.storageManager)
+		args.DataTrieSyncer = &testscommon.StateSyncNotifierSubscriberStub{
+			MissingDataTrieNodeFoundCalled: func(_ []byte) {
+				numSyncCalls++
+				_ = verifier.Close()
+			},
+		}
+		verifier, _ = NewTrieVerifier(args)
+
+		require.Nil(t, verifier.StartVerification(tries.rootHash, true))
+		status := waitVerificationToFinish(t, verifier)
+		assert.Equal(t, uint64(tries.numDataTries), status.NumMissingNodes)
+		assert.Zero(t, status.NumHealedNodes)
+		assert.Equal(t, context.Canceled.Error(), status.Error)
+		assert.Equal(t, 1, numSyncCalls)
+	})
+}
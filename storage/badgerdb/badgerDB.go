@@ -0,0 +1,380 @@
+package badgerdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/multiversx/mx-chain-core-go/core"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-storage-go/common"
+	"github.com/multiversx/mx-chain-storage-go/types"
+)
+
+var _ types.Persister = (*DB)(nil)
+
+// read + write + execute for owner only
+const rwxOwner = 0700
+const openBadgerDBFunction = "openBadgerDB"
+
+const (
+	// the node opens tens of storers at once so the badger defaults, sized for a single large database, are lowered
+	memTableSizeInBytes     = 16 * 1024 * 1024
+	numMemTables            = 2
+	blockCacheSizeInBytes   = 8 * 1024 * 1024
+	valueLogFileSizeInBytes = 64 * 1024 * 1024
+	numCompactors           = 2
+
+	valueLogGCInterval     = 10 * time.Minute
+	valueLogGCDiscardRatio = 0.5
+)
+
+var log = logger.GetOrCreate("storage/badgerdb")
+
+// DB holds a pointer to the badger database and the path to where it is stored.
+type DB struct {
+	mutDb             sync.RWMutex
+	db                *badger.DB
+	path              string
+	maxBatchSize      int
+	batchDelaySeconds int
+	sizeBatch         int
+	batch             *batch
+	mutBatch          sync.RWMutex
+	cancel            context.CancelFunc
+}
+
+// NewDB is a constructor for the badger persister
+// It creates the files in the location given as parameter. The maximum number of open files is only validated,
+// as badger memory maps its tables instead of keeping a cache of open file handlers
+func NewDB(path string, batchDelaySeconds int, maxBatchSize int, maxOpenFiles int) (s *DB, err error) {
+	constructorName := "NewDB"
+
+	sw := core.NewStopWatch()
+	sw.Start(constructorName)
+
+	err = os.MkdirAll(path, rwxOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxOpenFiles < 1 {
+		return nil, common.ErrInvalidNumOpenFiles
+	}
+
+	options := badger.DefaultOptions(path).
+		WithSyncWrites(true).
+		WithLogger(&badgerLogger{path: path}).
+		WithMemTableSize(memTableSizeInBytes).
+		WithNumMemtables(numMemTables).
+		WithBlockCacheSize(blockCacheSizeInBytes).
+		WithValueLogFileSize(valueLogFileSizeInBytes).
+		WithNumCompactors(numCompactors)
+
+	sw.Start(openBadgerDBFunction)
+	db, err := badger.Open(options)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+	sw.Stop(openBadgerDBFunction)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	dbStore := &DB{
+		db:                db,
+		path:              path,
+		maxBatchSize:      maxBatchSize,
+		batchDelaySeconds: batchDelaySeconds,
+		sizeBatch:         0,
+		batch:             NewBatch(),
+		cancel:            cancel,
+	}
+
+	go dbStore.batchTimeoutHandle(ctx)
+
+	runtime.SetFinalizer(dbStore, func(db *DB) {
+		_ = db.Close()
+	})
+
+	sw.Stop(constructorName)
+
+	logArguments := []interface{}{"path", path, "created pointer", fmt.Sprintf("%p", db)}
+	logArguments = append(logArguments, sw.GetMeasurements()...)
+	log.Debug("opened badger db persister", logArguments...)
+
+	return dbStore, nil
+}
+
+func (s *DB) batchTimeoutHandle(ctx context.Context) {
+	interval := time.Duration(s.batchDelaySeconds) * time.Second
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	gcTicker := time.NewTicker(valueLogGCInterval)
+	defer gcTicker.Stop()
+
+	for {
+		timer.Reset(interval)
+
+		select {
+		case <-timer.C:
+			s.mutBatch.Lock()
+			err := s.putBatch()
+			if err != nil {
+				log.Warn("badgerdb putBatch", "error", err.Error())
+				s.mutBatch.Unlock()
+				continue
+			}
+
+			s.batch.Reset()
+			s.sizeBatch = 0
+			s.mutBatch.Unlock()
+		case <-gcTicker.C:
+			s.runValueLogGC()
+		case <-ctx.Done():
+			log.Debug("closing the timed batch handler", "path", s.path)
+			return
+		}
+	}
+}
+
+// runValueLogGC reclaims the value log space left behind by the overwritten and removed values
+func (s *DB) runValueLogGC() {
+	db := s.getDbPointer()
+	if db == nil {
+		return
+	}
+
+	for {
+		err := db.RunValueLogGC(valueLogGCDiscardRatio)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, badger.ErrNoRewrite) {
+			log.Debug("badgerdb value log garbage collection", "path", s.path, "error", err.Error())
+		}
+
+		return
+	}
+}
+
+func (s *DB) updateBatchWithIncrement() error {
+	s.mutBatch.Lock()
+	defer s.mutBatch.Unlock()
+
+	s.sizeBatch++
+	if s.sizeBatch < s.maxBatchSize {
+		return nil
+	}
+
+	err := s.putBatch()
+	if err != nil {
+		log.Warn("badgerdb putBatch", "error", err.Error())
+		return err
+	}
+
+	s.batch.Reset()
+	s.sizeBatch = 0
+
+	return nil
+}
+
+// Put adds the value to the (key, val) storage medium
+func (s *DB) Put(key, val []byte) error {
+	s.mutBatch.RLock()
+	err := s.batch.Put(key, val)
+	s.mutBatch.RUnlock()
+
+	if err != nil {
+		return err
+	}
+
+	return s.updateBatchWithIncrement()
+}
+
+// Get returns the value associated to the key
+func (s *DB) Get(key []byte) ([]byte, error) {
+	db := s.getDbPointer()
+	if db == nil {
+		return nil, common.ErrDBIsClosed
+	}
+
+	if s.batch.IsRemoved(key) {
+		return nil, common.ErrKeyNotFound
+	}
+
+	data := s.batch.Get(key)
+	if data != nil {
+		return data, nil
+	}
+
+	err := db.View(func(txn *badger.Txn) error {
+		item, errGet := txn.Get(key)
+		if errGet != nil {
+			return errGet
+		}
+
+		data, errGet = item.ValueCopy(nil)
+		return errGet
+	})
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return nil, common.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *DB) Has(key []byte) error {
+	db := s.getDbPointer()
+	if db == nil {
+		return common.ErrDBIsClosed
+	}
+
+	if s.batch.IsRemoved(key) {
+		return common.ErrKeyNotFound
+	}
+
+	data := s.batch.Get(key)
+	if data != nil {
+		return nil
+	}
+
+	err := db.View(func(txn *badger.Txn) error {
+		_, errGet := txn.Get(key)
+		return errGet
+	})
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return common.ErrKeyNotFound
+	}
+
+	return err
+}
+
+// putBatch writes the batch data into the database
+func (s *DB) putBatch() error {
+	db := s.getDbPointer()
+	if db == nil {
+		return common.ErrDBIsClosed
+	}
+
+	return s.batch.writeTo(db)
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (s *DB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	db := s.getDbPointer()
+	if db == nil {
+		return
+	}
+
+	err := db.View(func(txn *badger.Txn) error {
+		iterator := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer iterator.Close()
+
+		for iterator.Rewind(); iterator.Valid(); iterator.Next() {
+			item := iterator.Item()
+			clonedVal, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+
+			shouldContinue := handler(item.KeyCopy(nil), clonedVal)
+			if !shouldContinue {
+				return nil
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Warn("badgerdb RangeKeys", "path", s.path, "error", err.Error())
+	}
+}
+
+// Close closes the files/resources associated to the storage medium
+func (s *DB) Close() error {
+	s.mutBatch.Lock()
+	_ = s.putBatch()
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	s.cancel()
+	db := s.makeDbPointerNilReturningLast()
+	if db != nil {
+		return db.Close()
+	}
+
+	return nil
+}
+
+// Remove removes the data associated to the given key
+func (s *DB) Remove(key []byte) error {
+	s.mutBatch.Lock()
+	_ = s.batch.Delete(key)
+	s.mutBatch.Unlock()
+
+	return s.updateBatchWithIncrement()
+}
+
+// Destroy removes the storage medium stored data
+func (s *DB) Destroy() error {
+	s.mutBatch.Lock()
+	s.batch.Reset()
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	s.cancel()
+	db := s.makeDbPointerNilReturningLast()
+	if db != nil {
+		err := db.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return os.RemoveAll(s.path)
+}
+
+// DestroyClosed removes the already closed storage medium stored data
+func (s *DB) DestroyClosed() error {
+	return os.RemoveAll(s.path)
+}
+
+func (s *DB) getDbPointer() *badger.DB {
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	return s.db
+}
+
+func (s *DB) makeDbPointerNilReturningLast() *badger.DB {
+	s.mutDb.Lock()
+	defer s.mutDb.Unlock()
+
+	if s.db != nil {
+		log.Debug("makeDbPointerNilReturningLast", "path", s.path, "nilled pointer", fmt.Sprintf("%p", s.db))
+	}
+
+	db := s.db
+	s.db = nil
+
+	return db
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *DB) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,345 @@
+package badgerdb_test
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/storage/badgerdb"
+	"github.com/multiversx/mx-chain-storage-go/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createBadgerDB(t *testing.T, batchDelaySeconds int, maxBatchSize int) *badgerdb.DB {
+	db, err := badgerdb.NewDB(t.TempDir(), batchDelaySeconds, maxBatchSize, 10)
+	require.Nil(t, err)
+
+	return db
+}
+
+func TestNewDB(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max open files should error", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := badgerdb.NewDB(t.TempDir(), 10, 1, 0)
+		assert.Equal(t, common.ErrInvalidNumOpenFiles, err)
+		assert.Nil(t, db)
+	})
+	t.Run("double open should error", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		db, err := badgerdb.NewDB(dir, 10, 1, 10)
+		require.Nil(t, err)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		_, err = badgerdb.NewDB(dir, 10, 1, 10)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := badgerdb.NewDB(t.TempDir(), 10, 1, 10)
+		assert.Nil(t, err)
+		assert.False(t, db.IsInterfaceNil())
+		assert.Nil(t, db.Close())
+	})
+}
+
+func TestDB_PutGetHas(t *testing.T) {
+	t.Parallel()
+
+	t.Run("from batch before the timeout", func(t *testing.T) {
+		t.Parallel()
+
+		key, val := []byte("key"), []byte("value")
+		db := createBadgerDB(t, 10, 100)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		err := db.Put(key, val)
+		assert.Nil(t, err)
+
+		v, err := db.Get(key)
+		assert.Nil(t, err)
+		assert.Equal(t, val, v)
+		assert.Nil(t, db.Has(key))
+	})
+	t.Run("from database after the batch was written", func(t *testing.T) {
+		t.Parallel()
+
+		key, val := []byte("key"), []byte("value")
+		db := createBadgerDB(t, 10, 1)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		err := db.Put(key, val)
+		assert.Nil(t, err)
+
+		v, err := db.Get(key)
+		assert.Nil(t, err)
+		assert.Equal(t, val, v)
+		assert.Nil(t, db.Has(key))
+	})
+	t.Run("from database after the timeout", func(t *testing.T) {
+		t.Parallel()
+
+		key, val := []byte("key"), []byte("value")
+		db := createBadgerDB(t, 1, 100)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		err := db.Put(key, val)
+		assert.Nil(t, err)
+		time.Sleep(time.Second * 2)
+
+		v, err := db.Get(key)
+		assert.Nil(t, err)
+		assert.Equal(t, val, v)
+	})
+	t.Run("missing key should error", func(t *testing.T) {
+		t.Parallel()
+
+		db := createBadgerDB(t, 10, 1)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		v, err := db.Get([]byte("missing"))
+		assert.Nil(t, v)
+		assert.Equal(t, common.ErrKeyNotFound, err)
+		assert.Equal(t, common.ErrKeyNotFound, db.Has([]byte("missing")))
+	})
+	t.Run("closed database should error", func(t *testing.T) {
+		t.Parallel()
+
+		db := createBadgerDB(t, 10, 1)
+		_ = db.Close()
+
+		v, err := db.Get([]byte("key"))
+		assert.Nil(t, v)
+		assert.Equal(t, common.ErrDBIsClosed, err)
+		assert.Equal(t, common.ErrDBIsClosed, db.Has([]byte("key")))
+	})
+}
+
+func TestDB_Remove(t *testing.T) {
+	t.Parallel()
+
+	t.Run("before the batch was written", func(t *testing.T) {
+		t.Parallel()
+
+		key, val := []byte("key"), []byte("value")
+		db := createBadgerDB(t, 10, 100)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		_ = db.Put(key, val)
+		err := db.Remove(key)
+		assert.Nil(t, err)
+
+		v, err := db.Get(key)
+		assert.Nil(t, v)
+		assert.Equal(t, common.ErrKeyNotFound, err)
+		assert.Equal(t, common.ErrKeyNotFound, db.Has(key))
+	})
+	t.Run("after the batch was written", func(t *testing.T) {
+		t.Parallel()
+
+		key, val := []byte("key"), []byte("value")
+		db := createBadgerDB(t, 10, 1)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		_ = db.Put(key, val)
+		err := db.Remove(key)
+		assert.Nil(t, err)
+
+		v, err := db.Get(key)
+		assert.Nil(t, v)
+		assert.Equal(t, common.ErrKeyNotFound, err)
+	})
+	t.Run("missing key should not error", func(t *testing.T) {
+		t.Parallel()
+
+		db := createBadgerDB(t, 10, 1)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		assert.Nil(t, db.Remove([]byte("missing")))
+	})
+}
+
+func TestDB_CloseShouldPersistTheBatch(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	key, val := []byte("key"), []byte("value")
+	db, err := badgerdb.NewDB(dir, 10, 100, 10)
+	require.Nil(t, err)
+
+	_ = db.Put(key, val)
+	err = db.Close()
+	require.Nil(t, err)
+
+	reopened, err := badgerdb.NewDB(dir, 10, 100, 10)
+	require.Nil(t, err)
+	defer func() {
+		_ = reopened.Close()
+	}()
+
+	v, err := reopened.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+}
+
+func TestDB_RangeKeys(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil handler should not panic", func(t *testing.T) {
+		t.Parallel()
+
+		db := createBadgerDB(t, 10, 1)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		db.RangeKeys(nil)
+	})
+	t.Run("closed database should not call the handler", func(t *testing.T) {
+		t.Parallel()
+
+		db := createBadgerDB(t, 10, 1)
+		_ = db.Put([]byte("key"), []byte("value"))
+		_ = db.Close()
+
+		db.RangeKeys(func(key []byte, value []byte) bool {
+			assert.Fail(t, "should have not been called")
+			return true
+		})
+	})
+	t.Run("should iterate all the written pairs", func(t *testing.T) {
+		t.Parallel()
+
+		db := createBadgerDB(t, 10, 1)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		expected := map[string][]byte{
+			"key1": []byte("value1"),
+			"key2": []byte("value2"),
+			"key3": []byte("value3"),
+		}
+		for key, val := range expected {
+			_ = db.Put([]byte(key), val)
+		}
+
+		recovered := make(map[string][]byte)
+		db.RangeKeys(func(key []byte, value []byte) bool {
+			recovered[string(key)] = value
+			return true
+		})
+		assert.Equal(t, expected, recovered)
+	})
+	t.Run("should stop when the handler returns false", func(t *testing.T) {
+		t.Parallel()
+
+		db := createBadgerDB(t, 10, 1)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		for i := 0; i < 10; i++ {
+			_ = db.Put([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+		}
+
+		numCalls := 0
+		db.RangeKeys(func(key []byte, value []byte) bool {
+			numCalls++
+			return numCalls < 3
+		})
+		assert.Equal(t, 3, numCalls)
+	})
+}
+
+func TestDB_Destroy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("open database", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		db, err := badgerdb.NewDB(dir, 10, 1, 10)
+		require.Nil(t, err)
+		_ = db.Put([]byte("key"), []byte("value"))
+
+		err = db.Destroy()
+		assert.Nil(t, err)
+
+		_, err = os.Stat(dir)
+		assert.True(t, os.IsNotExist(err))
+	})
+	t.Run("closed database", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		db, err := badgerdb.NewDB(dir, 10, 1, 10)
+		require.Nil(t, err)
+		_ = db.Close()
+
+		err = db.DestroyClosed()
+		assert.Nil(t, err)
+
+		_, err = os.Stat(dir)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestDB_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	db := createBadgerDB(t, 1, 5)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	numOperations := 1000
+	wg := sync.WaitGroup{}
+	wg.Add(numOperations)
+	for i := 0; i < numOperations; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			key := []byte(fmt.Sprintf("key%d", idx%50))
+			switch idx % 5 {
+			case 0:
+				_ = db.Put(key, []byte("value"))
+			case 1:
+				_, _ = db.Get(key)
+			case 2:
+				_ = db.Has(key)
+			case 3:
+				_ = db.Remove(key)
+			case 4:
+				db.RangeKeys(func(key []byte, value []byte) bool {
+					return true
+				})
+			}
+		}(i)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,102 @@
+package badgerdb
+
+import (
+	"sync"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/multiversx/mx-chain-storage-go/types"
+)
+
+var _ types.Batcher = (*batch)(nil)
+
+type batch struct {
+	cachedData  map[string][]byte
+	removedData map[string]struct{}
+	mutBatch    sync.RWMutex
+}
+
+// NewBatch creates a batch
+func NewBatch() *batch {
+	return &batch{
+		cachedData:  make(map[string][]byte),
+		removedData: make(map[string]struct{}),
+		mutBatch:    sync.RWMutex{},
+	}
+}
+
+// Put inserts one entry - key, value pair - into the batch
+func (b *batch) Put(key []byte, val []byte) error {
+	b.mutBatch.Lock()
+	b.cachedData[string(key)] = val
+	delete(b.removedData, string(key))
+	b.mutBatch.Unlock()
+	return nil
+}
+
+// Delete deletes the entry for the provided key from the batch
+func (b *batch) Delete(key []byte) error {
+	b.mutBatch.Lock()
+	b.removedData[string(key)] = struct{}{}
+	delete(b.cachedData, string(key))
+	b.mutBatch.Unlock()
+	return nil
+}
+
+// Reset clears the contents of the batch
+func (b *batch) Reset() {
+	b.mutBatch.Lock()
+	b.cachedData = make(map[string][]byte)
+	b.removedData = make(map[string]struct{})
+	b.mutBatch.Unlock()
+}
+
+// Get returns the value
+func (b *batch) Get(key []byte) []byte {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	return b.cachedData[string(key)]
+}
+
+// IsRemoved returns true if the key is marked for removal
+func (b *batch) IsRemoved(key []byte) bool {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	_, found := b.removedData[string(key)]
+
+	return found
+}
+
+// writeTo applies the batched changes on the provided badger database in a single write batch
+func (b *batch) writeTo(db *badger.DB) error {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	if len(b.cachedData) == 0 && len(b.removedData) == 0 {
+		return nil
+	}
+
+	writeBatch := db.NewWriteBatch()
+	defer writeBatch.Cancel()
+
+	for key, val := range b.cachedData {
+		err := writeBatch.Set([]byte(key), val)
+		if err != nil {
+			return err
+		}
+	}
+	for key := range b.removedData {
+		err := writeBatch.Delete([]byte(key))
+		if err != nil {
+			return err
+		}
+	}
+
+	return writeBatch.Flush()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (b *batch) IsInterfaceNil() bool {
+	return b == nil
+}
@@ -0,0 +1,40 @@
+package badgerdb
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+var _ badger.Logger = (*badgerLogger)(nil)
+
+// badgerLogger routes the badger internal logs to the node's logger, one level below the badger level
+// as badger is verbose on routine operations like compactions and value log replays
+type badgerLogger struct {
+	path string
+}
+
+// Errorf logs an error message
+func (bl *badgerLogger) Errorf(format string, args ...interface{}) {
+	log.Error(bl.format(format, args...), "path", bl.path)
+}
+
+// Warningf logs a warning message
+func (bl *badgerLogger) Warningf(format string, args ...interface{}) {
+	log.Warn(bl.format(format, args...), "path", bl.path)
+}
+
+// Infof logs an info message
+func (bl *badgerLogger) Infof(format string, args ...interface{}) {
+	log.Debug(bl.format(format, args...), "path", bl.path)
+}
+
+// Debugf logs a debug message
+func (bl *badgerLogger) Debugf(format string, args ...interface{}) {
+	log.Trace(bl.format(format, args...), "path", bl.path)
+}
+
+func (bl *badgerLogger) format(format string, args ...interface{}) string {
+	return strings.TrimSpace(fmt.Sprintf(format, args...))
+}
@@ -2,6 +2,7 @@ package database
 
 import (
 	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/badgerdb"
 	"github.com/multiversx/mx-chain-storage-go/leveldb"
 	"github.com/multiversx/mx-chain-storage-go/memorydb"
 	"github.com/multiversx/mx-chain-storage-go/sharded"
@@ -33,6 +34,12 @@ func NewSerialDB(path string, batchDelaySeconds int, maxBatchSize int, maxOpenFi
 	return leveldb.NewSerialDB(path, batchDelaySeconds, maxBatchSize, maxOpenFiles)
 }
 
+// NewBadgerDB is a constructor for the badger persister
+// It creates the files in the location given as parameter
+func NewBadgerDB(path string, batchDelaySeconds int, maxBatchSize int, maxOpenFiles int) (s *badgerdb.DB, err error) {
+	return badgerdb.NewDB(path, batchDelaySeconds, maxBatchSize, maxOpenFiles)
+}
+
 // NewShardIDProvider is a constructor for shard id provider
 func NewShardIDProvider(numShards int32) (storage.ShardIDProvider, error) {
 	return sharded.NewShardIDProvider(numShards)
@@ -52,6 +52,26 @@ func TestNewLevelDB(t *testing.T) {
 	})
 }
 
+func TestNewBadgerDB(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid argument should error", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewBadgerDB(t.TempDir(), 0, 0, 0)
+		assert.Nil(t, instance)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewBadgerDB(t.TempDir(), 1, 1, 1)
+		assert.NotNil(t, instance)
+		assert.Nil(t, err)
+		_ = instance.Close()
+	})
+}
+
 func TestNewSerialDB(t *testing.T) {
 	t.Parallel()
 
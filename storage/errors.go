@@ -125,3 +125,12 @@ func IsNotFoundInStorageErr(err error) bool {
 
 	return strings.Contains(err.Error(), "not found")
 }
+
+// ErrReadOnlyDB signals that a write operation was attempted on a database opened in read-only mode
+var ErrReadOnlyDB = errors.New("the database is opened in read-only mode")
+
+// ErrDatabaseNotFound signals that no database was found at the provided path
+var ErrDatabaseNotFound = errors.New("database not found")
+
+// ErrUnsupportedReadOnlyDB signals that the database type can not be opened in read-only mode
+var ErrUnsupportedReadOnlyDB = errors.New("database type can not be opened in read-only mode")
@@ -2,6 +2,7 @@ package factory_test
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -46,9 +47,10 @@ func TestPersisterCreator_Create(t *testing.T) {
 
 		pc := factory.NewPersisterCreator(conf)
 
-		p, err := pc.Create("path1")
+		p, err := pc.Create(filepath.Join(t.TempDir(), "path1"))
 		require.Nil(t, err)
 		require.NotNil(t, p)
+		_ = p.Close()
 	})
 
 	t.Run("should create non sharded persister", func(t *testing.T) {
@@ -122,6 +124,22 @@ func TestPersisterCreator_CreateBasePersister(t *testing.T) {
 		assert.True(t, strings.Contains(fmt.Sprintf("%T", p), "*leveldb.SerialDB"))
 	})
 
+	t.Run("badgerdb", func(t *testing.T) {
+		t.Parallel()
+
+		dbConfig := createDefaultBasePersisterConfig()
+		dbConfig.Type = string(storageunit.BadgerDB)
+		pc := factory.NewPersisterCreator(dbConfig)
+
+		dir := t.TempDir()
+		p, err := pc.CreateBasePersister(dir)
+		require.NotNil(t, p)
+		require.Nil(t, err)
+
+		assert.True(t, strings.Contains(fmt.Sprintf("%T", p), "*badgerdb.DB"))
+		_ = p.Close()
+	})
+
 	t.Run("memorydb", func(t *testing.T) {
 		t.Parallel()
 
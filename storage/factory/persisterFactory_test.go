@@ -152,6 +152,38 @@ func TestPersisterFactory_Create_ConfigSaveToFilePath(t *testing.T) {
 		require.False(t, os.IsNotExist(err))
 	})
 
+	t.Run("should write toml config file for badger db and reopen it", func(t *testing.T) {
+		t.Parallel()
+
+		dbConfig := createDefaultBasePersisterConfig()
+		dbConfig.Type = string(storageunit.BadgerDB)
+		pf, _ := factory.NewPersisterFactory(dbConfig)
+
+		dir := t.TempDir()
+		path := dir + "storer/"
+
+		p, err := pf.Create(path)
+		require.NotNil(t, p)
+		require.Nil(t, err)
+		require.Nil(t, p.Put([]byte("key"), []byte("value")))
+		require.Nil(t, p.Close())
+
+		configPath := factory.GetPersisterConfigFilePath(path)
+		_, err = os.Stat(configPath)
+		require.False(t, os.IsNotExist(err))
+
+		// the stored config takes precedence over the main config when reopening
+		pf, _ = factory.NewPersisterFactory(createDefaultBasePersisterConfig())
+		p, err = pf.Create(path)
+		require.Nil(t, err)
+		assert.True(t, strings.Contains(fmt.Sprintf("%T", p), "*badgerdb.DB"))
+
+		val, err := p.Get([]byte("key"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("value"), val)
+		_ = p.Close()
+	})
+
 	t.Run("should not write toml config file for memory db", func(t *testing.T) {
 		t.Parallel()
 
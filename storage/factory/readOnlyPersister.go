@@ -0,0 +1,234 @@
+package factory
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/database"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+// NewReadOnlyPersister opens the database found at the provided path without changing it, using the configuration
+// stored beside the database by the node
+func NewReadOnlyPersister(path string) (storage.Persister, error) {
+	info, err := os.Stat(path)
+	if err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("%w: %s", storage.ErrDatabaseNotFound, path)
+	}
+
+	dbConfigHandler := NewDBConfigHandler(DefaultReadOnlyDBConfig())
+	dbConfig, err := dbConfigHandler.GetDBConfig(path)
+	if err != nil {
+		return nil, err
+	}
+
+	creator := &readOnlyPersisterCreator{dbType: storageunit.DBType(dbConfig.Type)}
+	if dbConfig.NumShards < minNumShards {
+		return creator.CreateBasePersister(path)
+	}
+
+	shardIDProvider, err := database.NewShardIDProvider(dbConfig.NumShards)
+	if err != nil {
+		return nil, err
+	}
+
+	return database.NewShardedPersister(path, creator, shardIDProvider)
+}
+
+// DefaultReadOnlyDBConfig returns the configuration used for the databases created before the node stored their configuration
+func DefaultReadOnlyDBConfig() config.DBConfig {
+	return config.DBConfig{
+		Type:              string(storageunit.LvlDBSerial),
+		BatchDelaySeconds: 2,
+		MaxBatchSize:      100,
+		MaxOpenFiles:      10,
+	}
+}
+
+type readOnlyPersisterCreator struct {
+	dbType storageunit.DBType
+}
+
+// CreateBasePersister opens the database found at the provided path in read-only mode
+func (creator *readOnlyPersisterCreator) CreateBasePersister(path string) (storage.Persister, error) {
+	switch creator.dbType {
+	case storageunit.LvlDB, storageunit.LvlDBSerial:
+		db, err := leveldb.OpenFile(path, &opt.Options{
+			ReadOnly:       true,
+			ErrorIfMissing: true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%w for path %s", err, path)
+		}
+
+		return &readOnlyLevelDB{db: db}, nil
+	case storageunit.BadgerDB:
+		db, err := badger.Open(badger.DefaultOptions(path).WithReadOnly(true).WithLogger(nil))
+		if err != nil {
+			return nil, fmt.Errorf("%w for path %s", err, path)
+		}
+
+		return &readOnlyBadgerDB{db: db}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", storage.ErrUnsupportedReadOnlyDB, creator.dbType)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (creator *readOnlyPersisterCreator) IsInterfaceNil() bool {
+	return creator == nil
+}
+
+type readOnlyBase struct{}
+
+// Put returns an error as the database is opened in read-only mode
+func (base *readOnlyBase) Put(_, _ []byte) error {
+	return storage.ErrReadOnlyDB
+}
+
+// Remove returns an error as the database is opened in read-only mode
+func (base *readOnlyBase) Remove(_ []byte) error {
+	return storage.ErrReadOnlyDB
+}
+
+// Destroy returns an error as the database is opened in read-only mode
+func (base *readOnlyBase) Destroy() error {
+	return storage.ErrReadOnlyDB
+}
+
+// DestroyClosed returns an error as the database is opened in read-only mode
+func (base *readOnlyBase) DestroyClosed() error {
+	return storage.ErrReadOnlyDB
+}
+
+type readOnlyLevelDB struct {
+	readOnlyBase
+	db *leveldb.DB
+}
+
+// Get returns the value associated to the key
+func (rodb *readOnlyLevelDB) Get(key []byte) ([]byte, error) {
+	data, err := rodb.db.Get(key, nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	return data, err
+}
+
+// Has returns nil if the given key is present in the database
+func (rodb *readOnlyLevelDB) Has(key []byte) error {
+	has, err := rodb.db.Has(key, nil)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return storage.ErrKeyNotFound
+	}
+
+	return nil
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (rodb *readOnlyLevelDB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	iterator := rodb.db.NewIterator(nil, nil)
+	defer iterator.Release()
+
+	for iterator.Next() {
+		clonedKey := append([]byte{}, iterator.Key()...)
+		clonedVal := append([]byte{}, iterator.Value()...)
+		if !handler(clonedKey, clonedVal) {
+			return
+		}
+	}
+}
+
+// Close closes the database
+func (rodb *readOnlyLevelDB) Close() error {
+	return rodb.db.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rodb *readOnlyLevelDB) IsInterfaceNil() bool {
+	return rodb == nil
+}
+
+type readOnlyBadgerDB struct {
+	readOnlyBase
+	db *badger.DB
+}
+
+// Get returns the value associated to the key
+func (rodb *readOnlyBadgerDB) Get(key []byte) ([]byte, error) {
+	var data []byte
+	err := rodb.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(key)
+		if err != nil {
+			return err
+		}
+
+		data, err = item.ValueCopy(nil)
+		return err
+	})
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	return data, err
+}
+
+// Has returns nil if the given key is present in the database
+func (rodb *readOnlyBadgerDB) Has(key []byte) error {
+	_, err := rodb.Get(key)
+	return err
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (rodb *readOnlyBadgerDB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	err := rodb.db.View(func(txn *badger.Txn) error {
+		iterator := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer iterator.Close()
+
+		for iterator.Rewind(); iterator.Valid(); iterator.Next() {
+			item := iterator.Item()
+			clonedVal, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			if !handler(item.KeyCopy(nil), clonedVal) {
+				return nil
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Warn("read-only badger RangeKeys", "error", err.Error())
+	}
+}
+
+// Close closes the database
+func (rodb *readOnlyBadgerDB) Close() error {
+	return rodb.db.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rodb *readOnlyBadgerDB) IsInterfaceNil() bool {
+	return rodb == nil
+}
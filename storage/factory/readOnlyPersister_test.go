@@ -0,0 +1,95 @@
+package factory_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createReadOnlyTestDBConfig(dbType storageunit.DBType, numShards int32) config.DBConfig {
+	return config.DBConfig{
+		Type:                string(dbType),
+		BatchDelaySeconds:   2,
+		MaxBatchSize:        100,
+		MaxOpenFiles:        10,
+		ShardIDProviderType: "BinarySplit",
+		NumShards:           numShards,
+	}
+}
+
+func writeReadOnlyTestEntries(t *testing.T, path string, dbConfig config.DBConfig, entries map[string][]byte) {
+	persisterFactory, err := factory.NewPersisterFactory(dbConfig)
+	require.Nil(t, err)
+
+	persister, err := persisterFactory.Create(path)
+	require.Nil(t, err)
+	for key, val := range entries {
+		require.Nil(t, persister.Put([]byte(key), val))
+	}
+	require.Nil(t, persister.Close())
+}
+
+func TestNewReadOnlyPersister(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		persister, err := factory.NewReadOnlyPersister(filepath.Join(t.TempDir(), "missing"))
+		assert.Nil(t, persister)
+		assert.True(t, errors.Is(err, storage.ErrDatabaseNotFound))
+	})
+	t.Run("unsupported type should error", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "storer")
+		writeReadOnlyTestEntries(t, path, createReadOnlyTestDBConfig(storageunit.LvlDBSerial, 1), nil)
+		dbConfig := createReadOnlyTestDBConfig(storageunit.MemoryDB, 1)
+		require.Nil(t, factory.NewDBConfigHandler(dbConfig).SaveDBConfigToFilePath(path, &dbConfig))
+
+		persister, err := factory.NewReadOnlyPersister(path)
+		assert.Nil(t, persister)
+		assert.True(t, errors.Is(err, storage.ErrUnsupportedReadOnlyDB))
+	})
+	t.Run("writes should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, dbType := range []storageunit.DBType{storageunit.LvlDBSerial, storageunit.BadgerDB} {
+			path := filepath.Join(t.TempDir(), "storer")
+			writeReadOnlyTestEntries(t, path, createReadOnlyTestDBConfig(dbType, 1), map[string][]byte{"key": []byte("value")})
+
+			persister, err := factory.NewReadOnlyPersister(path)
+			require.Nil(t, err)
+
+			assert.Equal(t, storage.ErrReadOnlyDB, persister.Put([]byte("key"), []byte("new value")))
+			assert.Equal(t, storage.ErrReadOnlyDB, persister.Remove([]byte("key")))
+			assert.Nil(t, persister.Has([]byte("key")))
+			value, err := persister.Get([]byte("key"))
+			assert.Nil(t, err)
+			assert.Equal(t, []byte("value"), value)
+			assert.Nil(t, persister.Close())
+		}
+	})
+	t.Run("sharded database should work", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "storer")
+		writeReadOnlyTestEntries(t, path, createReadOnlyTestDBConfig(storageunit.LvlDBSerial, 4), map[string][]byte{"key": []byte("value")})
+
+		persister, err := factory.NewReadOnlyPersister(path)
+		require.Nil(t, err)
+
+		value, err := persister.Get([]byte("key"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("value"), value)
+		assert.Equal(t, storage.ErrReadOnlyDB, persister.Put([]byte("key"), []byte("new value")))
+		assert.Nil(t, persister.Close())
+	})
+}
@@ -503,6 +503,19 @@ func (psf *StorageServiceFactory) setUpDbLookupExtensions(chainStorer *dataRetri
 
 	chainStorer.AddStorer(dataRetriever.MiniblocksMetadataUnit, miniblocksMetadataPruningStorer)
 
+	// Create the logsIndex (PRUNING) storer
+	logsIndexConfig := psf.generalConfig.DbLookupExtensions.LogsIndexStorageConfig
+	logsIndexPruningStorerArgs, err := psf.createPruningStorerArgs(logsIndexConfig, disabled.NewDisabledCustomDatabaseRemover())
+	if err != nil {
+		return err
+	}
+	logsIndexPruningStorer, err := psf.createPruningPersister(logsIndexPruningStorerArgs)
+	if err != nil {
+		return fmt.Errorf("%w for DbLookupExtensions.LogsIndexStorageConfig", err)
+	}
+
+	chainStorer.AddStorer(dataRetriever.LogsIndexUnit, logsIndexPruningStorer)
+
 	miniblockHashByTxHashUnit, err := psf.createStaticStorageUnit(psf.generalConfig.DbLookupExtensions.MiniblockHashByTxHashStorageConfig, shardID, emptyDBPathSuffix)
 	if err != nil {
 		return fmt.Errorf("%w for DbLookupExtensions.MiniblockHashByTxHashStorageConfig", err)
@@ -524,6 +537,13 @@ func (psf *StorageServiceFactory) setUpDbLookupExtensions(chainStorer *dataRetri
 
 	chainStorer.AddStorer(dataRetriever.EpochByHashUnit, epochByHashUnit)
 
+	accountTransactionsUnit, err := psf.createStaticStorageUnit(psf.generalConfig.DbLookupExtensions.AccountTransactionsStorageConfig, shardID, emptyDBPathSuffix)
+	if err != nil {
+		return fmt.Errorf("%w for DbLookupExtensions.AccountTransactionsStorageConfig", err)
+	}
+
+	chainStorer.AddStorer(dataRetriever.AccountTransactionsUnit, accountTransactionsUnit)
+
 	return psf.setUpEsdtSuppliesStorer(chainStorer, shardID)
 }
 
@@ -56,6 +56,8 @@ func createMockArgument(t *testing.T) StorageServiceFactoryArgs {
 				ResultsHashesByTxHashStorageConfig: createMockStorageConfig("ResultsHashesByTxHashStorage"),
 				ESDTSuppliesStorageConfig:          createMockStorageConfig("ESDTSuppliesStorage"),
 				RoundHashStorageConfig:             createMockStorageConfig("RoundHashStorage"),
+				LogsIndexStorageConfig:             createMockStorageConfig("LogsIndexStorage"),
+				AccountTransactionsStorageConfig:   createMockStorageConfig("AccountTransactionsStorage"),
 			},
 			LogsAndEvents: config.LogsAndEventsConfig{
 				SaveInStorageEnabled: true,
@@ -369,6 +371,26 @@ func TestStorageServiceFactory_CreateForShard(t *testing.T) {
 		assert.Equal(t, expectedErrForCacheString+" for DbLookupExtensions.ResultsHashesByTxHashStorageConfig", err.Error())
 		assert.True(t, check.IfNil(storageService))
 	})
+	t.Run("wrong config for DbLookupExtensions.LogsIndexStorageConfig should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgument(t)
+		args.Config.DbLookupExtensions.LogsIndexStorageConfig.Cache.Type = ""
+		storageServiceFactory, _ := NewStorageServiceFactory(args)
+		storageService, err := storageServiceFactory.CreateForShard()
+		assert.Equal(t, expectedErrForCacheString+" for DbLookupExtensions.LogsIndexStorageConfig", err.Error())
+		assert.True(t, check.IfNil(storageService))
+	})
+	t.Run("wrong config for DbLookupExtensions.AccountTransactionsStorageConfig should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgument(t)
+		args.Config.DbLookupExtensions.AccountTransactionsStorageConfig.Cache.Type = ""
+		storageServiceFactory, _ := NewStorageServiceFactory(args)
+		storageService, err := storageServiceFactory.CreateForShard()
+		assert.Equal(t, expectedErrForCacheString+" for DbLookupExtensions.AccountTransactionsStorageConfig", err.Error())
+		assert.True(t, check.IfNil(storageService))
+	})
 	t.Run("wrong config for DbLookupExtensions.ESDTSuppliesStorageConfig should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -408,7 +430,7 @@ func TestStorageServiceFactory_CreateForShard(t *testing.T) {
 		assert.Nil(t, err)
 		assert.False(t, check.IfNil(storageService))
 		allStorers := storageService.GetAllStorers()
-		expectedStorers := 23
+		expectedStorers := 25
 		assert.Equal(t, expectedStorers, len(allStorers))
 
 		storer, _ := storageService.GetStorer(dataRetriever.UserAccountsUnit)
@@ -429,8 +451,8 @@ func TestStorageServiceFactory_CreateForShard(t *testing.T) {
 		assert.Nil(t, err)
 		assert.False(t, check.IfNil(storageService))
 		allStorers := storageService.GetAllStorers()
-		numDBLookupExtensionUnits := 6
-		expectedStorers := 23 - numDBLookupExtensionUnits
+		numDBLookupExtensionUnits := 8
+		expectedStorers := 25 - numDBLookupExtensionUnits
 		assert.Equal(t, expectedStorers, len(allStorers))
 		_ = storageService.CloseAll()
 	})
@@ -444,7 +466,7 @@ func TestStorageServiceFactory_CreateForShard(t *testing.T) {
 		assert.Nil(t, err)
 		assert.False(t, check.IfNil(storageService))
 		allStorers := storageService.GetAllStorers()
-		expectedStorers := 23 // we still have a storer for trie epoch root hash
+		expectedStorers := 25 // we still have a storer for trie epoch root hash
 		assert.Equal(t, expectedStorers, len(allStorers))
 		_ = storageService.CloseAll()
 	})
@@ -458,7 +480,7 @@ func TestStorageServiceFactory_CreateForShard(t *testing.T) {
 		assert.Nil(t, err)
 		assert.False(t, check.IfNil(storageService))
 		allStorers := storageService.GetAllStorers()
-		expectedStorers := 23
+		expectedStorers := 25
 		assert.Equal(t, expectedStorers, len(allStorers))
 
 		storer, _ := storageService.GetStorer(dataRetriever.UserAccountsUnit)
@@ -527,7 +549,7 @@ func TestStorageServiceFactory_CreateForMeta(t *testing.T) {
 		allStorers := storageService.GetAllStorers()
 		missingStorers := 2 // PeerChangesUnit and ShardHdrNonceHashDataUnit
 		numShardHdrStorage := 3
-		expectedStorers := 23 - missingStorers + numShardHdrStorage
+		expectedStorers := 25 - missingStorers + numShardHdrStorage
 		assert.Equal(t, expectedStorers, len(allStorers))
 
 		storer, _ := storageService.GetStorer(dataRetriever.UserAccountsUnit)
@@ -550,7 +572,7 @@ func TestStorageServiceFactory_CreateForMeta(t *testing.T) {
 		allStorers := storageService.GetAllStorers()
 		missingStorers := 2 // PeerChangesUnit and ShardHdrNonceHashDataUnit
 		numShardHdrStorage := 3
-		expectedStorers := 23 - missingStorers + numShardHdrStorage
+		expectedStorers := 25 - missingStorers + numShardHdrStorage
 		assert.Equal(t, expectedStorers, len(allStorers))
 
 		storer, _ := storageService.GetStorer(dataRetriever.UserAccountsUnit)
@@ -19,6 +19,8 @@ const (
 	LvlDBSerial = common.LvlDBSerial
 	// MemoryDB represents an in memory storage identifier
 	MemoryDB = common.MemoryDB
+	// BadgerDB represents a badger storage identifier
+	BadgerDB DBType = "BadgerDB"
 )
 
 // Shard id provider types that are currently supported
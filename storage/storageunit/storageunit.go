@@ -3,6 +3,7 @@ package storageunit
 import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/badgerdb"
 	"github.com/multiversx/mx-chain-storage-go/common"
 	"github.com/multiversx/mx-chain-storage-go/factory"
 	"github.com/multiversx/mx-chain-storage-go/storageCacherAdapter"
@@ -47,6 +48,10 @@ func NewCache(config CacheConfig) (storage.Cacher, error) {
 
 // NewDB creates a new database from database config
 func NewDB(args ArgDB) (storage.Persister, error) {
+	if args.DBType == BadgerDB {
+		return badgerdb.NewDB(args.Path, args.BatchDelaySeconds, args.MaxBatchSize, args.MaxOpenFiles)
+	}
+
 	return factory.NewDB(args)
 }
 
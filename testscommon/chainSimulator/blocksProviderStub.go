@@ -0,0 +1,35 @@
+package chainSimulator
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+// BlocksProviderStub -
+type BlocksProviderStub struct {
+	GetBlockByNonceCalled func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetTransactionCalled  func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+}
+
+// GetBlockByNonce -
+func (stub *BlocksProviderStub) GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+	if stub.GetBlockByNonceCalled != nil {
+		return stub.GetBlockByNonceCalled(nonce, options)
+	}
+
+	return &api.Block{}, nil
+}
+
+// GetTransaction -
+func (stub *BlocksProviderStub) GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+	if stub.GetTransactionCalled != nil {
+		return stub.GetTransactionCalled(hash, withResults)
+	}
+
+	return &transaction.ApiTransactionResult{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *BlocksProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
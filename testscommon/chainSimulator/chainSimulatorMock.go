@@ -1,11 +1,18 @@
 package chainSimulator
 
-import "github.com/multiversx/mx-chain-go/node/chainSimulator/process"
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/process"
+)
 
 // ChainSimulatorMock -
 type ChainSimulatorMock struct {
-	GenerateBlocksCalled func(numOfBlocks int) error
-	GetNodeHandlerCalled func(shardID uint32) process.NodeHandler
+	GenerateBlocksCalled                         func(numOfBlocks int) error
+	GetNodeHandlerCalled                         func(shardID uint32) process.NodeHandler
+	SendTxsAndGenerateBlocksTilAreExecutedCalled func(txsToSend []*transaction.Transaction, maxNumOfBlocksToGenerateWhenExecutingTx int) ([]*transaction.ApiTransactionResult, error)
+	AdvanceTimeCalled                            func(duration time.Duration) error
 }
 
 // GenerateBlocks -
@@ -25,6 +32,24 @@ func (mock *ChainSimulatorMock) GetNodeHandler(shardID uint32) process.NodeHandl
 	return nil
 }
 
+// SendTxsAndGenerateBlocksTilAreExecuted -
+func (mock *ChainSimulatorMock) SendTxsAndGenerateBlocksTilAreExecuted(txsToSend []*transaction.Transaction, maxNumOfBlocksToGenerateWhenExecutingTx int) ([]*transaction.ApiTransactionResult, error) {
+	if mock.SendTxsAndGenerateBlocksTilAreExecutedCalled != nil {
+		return mock.SendTxsAndGenerateBlocksTilAreExecutedCalled(txsToSend, maxNumOfBlocksToGenerateWhenExecutingTx)
+	}
+
+	return nil, nil
+}
+
+// AdvanceTime -
+func (mock *ChainSimulatorMock) AdvanceTime(duration time.Duration) error {
+	if mock.AdvanceTimeCalled != nil {
+		return mock.AdvanceTimeCalled(duration)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil -
 func (mock *ChainSimulatorMock) IsInterfaceNil() bool {
 	return mock == nil
@@ -24,6 +24,9 @@ type NodeHandlerMock struct {
 	SetKeyValueForAddressCalled   func(addressBytes []byte, state map[string]string) error
 	SetStateForAddressCalled      func(address []byte, state *dtos.AddressState) error
 	RemoveAccountCalled           func(address []byte) error
+	TakeSnapshotCalled            func() (*dtos.NodeSnapshot, error)
+	CheckSnapshotCalled           func(snapshot *dtos.NodeSnapshot) error
+	RevertToSnapshotCalled        func(snapshot *dtos.NodeSnapshot, shardsNonces map[uint32]uint64) error
 	CloseCalled                   func() error
 }
 
@@ -137,6 +140,33 @@ func (mock *NodeHandlerMock) RemoveAccount(address []byte) error {
 	return nil
 }
 
+// TakeSnapshot -
+func (mock *NodeHandlerMock) TakeSnapshot() (*dtos.NodeSnapshot, error) {
+	if mock.TakeSnapshotCalled != nil {
+		return mock.TakeSnapshotCalled()
+	}
+
+	return &dtos.NodeSnapshot{}, nil
+}
+
+// CheckSnapshot -
+func (mock *NodeHandlerMock) CheckSnapshot(snapshot *dtos.NodeSnapshot) error {
+	if mock.CheckSnapshotCalled != nil {
+		return mock.CheckSnapshotCalled(snapshot)
+	}
+
+	return nil
+}
+
+// RevertToSnapshot -
+func (mock *NodeHandlerMock) RevertToSnapshot(snapshot *dtos.NodeSnapshot, shardsNonces map[uint32]uint64) error {
+	if mock.RevertToSnapshotCalled != nil {
+		return mock.RevertToSnapshotCalled(snapshot, shardsNonces)
+	}
+
+	return nil
+}
+
 // Close -
 func (mock *NodeHandlerMock) Close() error {
 	if mock.CloseCalled != nil {
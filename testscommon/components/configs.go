@@ -23,6 +23,8 @@ func GetGeneralConfig() config.Config {
 			PeerStatePruningEnabled:     true,
 			MaxStateTrieLevelInMemory:   5,
 			MaxPeerTrieLevelInMemory:    5,
+			MaxHistoricalTries:          16,
+			MaxHistoricalTriesSizeInMB:  16,
 		},
 		EvictionWaitingList: config.EvictionWaitingListConfig{
 			HashesSize:     100,
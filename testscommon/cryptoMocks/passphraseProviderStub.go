@@ -0,0 +1,20 @@
+package cryptoMocks
+
+// PassphraseProviderStub -
+type PassphraseProviderStub struct {
+	PassphraseCalled func() ([]byte, error)
+}
+
+// Passphrase -
+func (stub *PassphraseProviderStub) Passphrase() ([]byte, error) {
+	if stub.PassphraseCalled != nil {
+		return stub.PassphraseCalled()
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *PassphraseProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,39 @@
+package cryptoMocks
+
+import "github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+
+// RemoteSignerClientStub -
+type RemoteSignerClientStub struct {
+	GetPublicKeysCalled func() (*remoteSigner.PublicKeys, error)
+	SignCalled          func(publicKey []byte, message []byte) ([]byte, error)
+	SignShareCalled     func(publicKey []byte, message []byte, epoch uint32) ([]byte, error)
+}
+
+// GetPublicKeys -
+func (stub *RemoteSignerClientStub) GetPublicKeys() (*remoteSigner.PublicKeys, error) {
+	if stub.GetPublicKeysCalled != nil {
+		return stub.GetPublicKeysCalled()
+	}
+	return &remoteSigner.PublicKeys{}, nil
+}
+
+// Sign -
+func (stub *RemoteSignerClientStub) Sign(publicKey []byte, message []byte) ([]byte, error) {
+	if stub.SignCalled != nil {
+		return stub.SignCalled(publicKey, message)
+	}
+	return nil, nil
+}
+
+// SignShare -
+func (stub *RemoteSignerClientStub) SignShare(publicKey []byte, message []byte, epoch uint32) ([]byte, error) {
+	if stub.SignShareCalled != nil {
+		return stub.SignShareCalled(publicKey, message, epoch)
+	}
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *RemoteSignerClientStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -11,12 +11,14 @@ import (
 
 // HistoryRepositoryStub -
 type HistoryRepositoryStub struct {
-	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler, createdIntraMiniBlocks []*block.MiniBlock, logs []*data.LogData) error
+	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, txsPool map[string]data.TransactionHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler, createdIntraMiniBlocks []*block.MiniBlock, logs []*data.LogData) error
 	OnNotarizedBlocksCalled            func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
 	GetMiniblockMetadataByTxHashCalled func(hash []byte) (*dblookupext.MiniblockMetadata, error)
 	GetEpochByHashCalled               func(hash []byte) (uint32, error)
 	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
 	GetESDTSupplyCalled                func(token string) (*esdtSupply.SupplyESDT, error)
+	FilterLogsIndexCalled              func(filter *dblookupext.LogsIndexFilter) ([]*dblookupext.LogsIndexBlock, error)
+	GetAccountTransactionsCalled       func(address []byte, beforeNonce uint64, beforeEpoch uint32, maxTransactions int) (*dblookupext.AccountTransactionsPage, error)
 	IsEnabledCalled                    func() bool
 }
 
@@ -25,13 +27,14 @@ func (hp *HistoryRepositoryStub) RecordBlock(
 	blockHeaderHash []byte,
 	blockHeader data.HeaderHandler,
 	blockBody data.BodyHandler,
+	txsPool map[string]data.TransactionHandler,
 	scrsPool map[string]data.TransactionHandler,
 	receipts map[string]data.TransactionHandler,
 	createdIntraMiniBlocks []*block.MiniBlock,
 	logs []*data.LogData,
 ) error {
 	if hp.RecordBlockCalled != nil {
-		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, scrsPool, receipts, createdIntraMiniBlocks, logs)
+		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, txsPool, scrsPool, receipts, createdIntraMiniBlocks, logs)
 	}
 	return nil
 }
@@ -89,6 +92,24 @@ func (hp *HistoryRepositoryStub) GetESDTSupply(token string) (*esdtSupply.Supply
 	return nil, nil
 }
 
+// FilterLogsIndex -
+func (hp *HistoryRepositoryStub) FilterLogsIndex(filter *dblookupext.LogsIndexFilter) ([]*dblookupext.LogsIndexBlock, error) {
+	if hp.FilterLogsIndexCalled != nil {
+		return hp.FilterLogsIndexCalled(filter)
+	}
+
+	return nil, nil
+}
+
+// GetAccountTransactions -
+func (hp *HistoryRepositoryStub) GetAccountTransactions(address []byte, beforeNonce uint64, beforeEpoch uint32, maxTransactions int) (*dblookupext.AccountTransactionsPage, error) {
+	if hp.GetAccountTransactionsCalled != nil {
+		return hp.GetAccountTransactionsCalled(address, beforeNonce, beforeEpoch, maxTransactions)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
@@ -110,6 +110,8 @@ func GetGeneralConfig() config.Config {
 			PeerStatePruningEnabled:     false,
 			MaxStateTrieLevelInMemory:   5,
 			MaxPeerTrieLevelInMemory:    5,
+			MaxHistoricalTries:          16,
+			MaxHistoricalTriesSizeInMB:  16,
 		},
 		TrieStorageManagerConfig: config.TrieStorageManagerConfig{
 			PruningBufferLen:      1000,
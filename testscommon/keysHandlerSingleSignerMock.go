@@ -72,6 +72,10 @@ func (mock *keysHandlerSingleSignerMock) GetRedundancyStepInReason() string {
 	return ""
 }
 
+// ApplyPendingChanges -
+func (mock *keysHandlerSingleSignerMock) ApplyPendingChanges() {
+}
+
 // IsInterfaceNil -
 func (mock *keysHandlerSingleSignerMock) IsInterfaceNil() bool {
 	return mock == nil
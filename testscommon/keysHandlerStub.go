@@ -16,6 +16,7 @@ type KeysHandlerStub struct {
 	IsOriginalPublicKeyOfTheNodeCalled           func(pkBytes []byte) bool
 	ResetRoundsWithoutReceivedMessagesCalled     func(pkBytes []byte, pid core.PeerID)
 	GetRedundancyStepInReasonCalled              func() string
+	ApplyPendingChangesCalled                    func()
 }
 
 // GetHandledPrivateKey -
@@ -86,6 +87,13 @@ func (stub *KeysHandlerStub) GetRedundancyStepInReason() string {
 	return ""
 }
 
+// ApplyPendingChanges -
+func (stub *KeysHandlerStub) ApplyPendingChanges() {
+	if stub.ApplyPendingChangesCalled != nil {
+		stub.ApplyPendingChangesCalled()
+	}
+}
+
 // IsInterfaceNil -
 func (stub *KeysHandlerStub) IsInterfaceNil() bool {
 	return stub == nil
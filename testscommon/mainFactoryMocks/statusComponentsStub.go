@@ -9,10 +9,11 @@ import (
 
 // StatusComponentsStub -
 type StatusComponentsStub struct {
-	Outport                  outport.OutportHandler
-	SoftwareVersionCheck     statistics.SoftwareVersionChecker
-	AppStatusHandler         core.AppStatusHandler
-	ManagedPeersMonitorField common.ManagedPeersMonitor
+	Outport                   outport.OutportHandler
+	SoftwareVersionCheck      statistics.SoftwareVersionChecker
+	AppStatusHandler          core.AppStatusHandler
+	ManagedPeersMonitorField  common.ManagedPeersMonitor
+	SubscriptionsHandlerField common.SubscriptionsHandler
 }
 
 // Create -
@@ -45,6 +46,11 @@ func (scs *StatusComponentsStub) ManagedPeersMonitor() common.ManagedPeersMonito
 	return scs.ManagedPeersMonitorField
 }
 
+// SubscriptionsHandler -
+func (scs *StatusComponentsStub) SubscriptionsHandler() common.SubscriptionsHandler {
+	return scs.SubscriptionsHandlerField
+}
+
 // IsInterfaceNil -
 func (scs *StatusComponentsStub) IsInterfaceNil() bool {
 	return scs == nil
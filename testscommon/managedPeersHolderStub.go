@@ -10,6 +10,7 @@ import (
 // ManagedPeersHolderStub -
 type ManagedPeersHolderStub struct {
 	AddManagedPeerCalled                         func(privateKeyBytes []byte) error
+	AddManagedPeerFromPrivateKeyCalled           func(privateKey crypto.PrivateKey) error
 	GetPrivateKeyCalled                          func(pkBytes []byte) (crypto.PrivateKey, error)
 	GetP2PIdentityCalled                         func(pkBytes []byte) ([]byte, core.PeerID, error)
 	GetMachineIDCalled                           func(pkBytes []byte) (string, error)
@@ -27,6 +28,12 @@ type ManagedPeersHolderStub struct {
 	SetNextPeerAuthenticationTimeCalled          func(pkBytes []byte, nextTime time.Time)
 	IsMultiKeyModeCalled                         func() bool
 	GetRedundancyStepInReasonCalled              func() string
+	ScheduleAddManagedPeerCalled                 func(privateKeyBytes []byte) ([]byte, error)
+	ScheduleRemoveManagedPeerCalled              func(pkBytes []byte) error
+	SchedulePauseManagedPeerCalled               func(pkBytes []byte) error
+	ScheduleResumeManagedPeerCalled              func(pkBytes []byte) error
+	ApplyPendingChangesCalled                    func()
+	IsKeyPausedCalled                            func(pkBytes []byte) bool
 }
 
 // AddManagedPeer -
@@ -37,6 +44,14 @@ func (stub *ManagedPeersHolderStub) AddManagedPeer(privateKeyBytes []byte) error
 	return nil
 }
 
+// AddManagedPeerFromPrivateKey -
+func (stub *ManagedPeersHolderStub) AddManagedPeerFromPrivateKey(privateKey crypto.PrivateKey) error {
+	if stub.AddManagedPeerFromPrivateKeyCalled != nil {
+		return stub.AddManagedPeerFromPrivateKeyCalled(privateKey)
+	}
+	return nil
+}
+
 // GetPrivateKey -
 func (stub *ManagedPeersHolderStub) GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error) {
 	if stub.GetPrivateKeyCalled != nil {
@@ -161,6 +176,53 @@ func (stub *ManagedPeersHolderStub) IsMultiKeyMode() bool {
 	return false
 }
 
+// ScheduleAddManagedPeer -
+func (stub *ManagedPeersHolderStub) ScheduleAddManagedPeer(privateKeyBytes []byte) ([]byte, error) {
+	if stub.ScheduleAddManagedPeerCalled != nil {
+		return stub.ScheduleAddManagedPeerCalled(privateKeyBytes)
+	}
+	return nil, nil
+}
+
+// ScheduleRemoveManagedPeer -
+func (stub *ManagedPeersHolderStub) ScheduleRemoveManagedPeer(pkBytes []byte) error {
+	if stub.ScheduleRemoveManagedPeerCalled != nil {
+		return stub.ScheduleRemoveManagedPeerCalled(pkBytes)
+	}
+	return nil
+}
+
+// SchedulePauseManagedPeer -
+func (stub *ManagedPeersHolderStub) SchedulePauseManagedPeer(pkBytes []byte) error {
+	if stub.SchedulePauseManagedPeerCalled != nil {
+		return stub.SchedulePauseManagedPeerCalled(pkBytes)
+	}
+	return nil
+}
+
+// ScheduleResumeManagedPeer -
+func (stub *ManagedPeersHolderStub) ScheduleResumeManagedPeer(pkBytes []byte) error {
+	if stub.ScheduleResumeManagedPeerCalled != nil {
+		return stub.ScheduleResumeManagedPeerCalled(pkBytes)
+	}
+	return nil
+}
+
+// ApplyPendingChanges -
+func (stub *ManagedPeersHolderStub) ApplyPendingChanges() {
+	if stub.ApplyPendingChangesCalled != nil {
+		stub.ApplyPendingChangesCalled()
+	}
+}
+
+// IsKeyPaused -
+func (stub *ManagedPeersHolderStub) IsKeyPaused(pkBytes []byte) bool {
+	if stub.IsKeyPausedCalled != nil {
+		return stub.IsKeyPausedCalled(pkBytes)
+	}
+	return false
+}
+
 // GetRedundancyStepInReason -
 func (stub *ManagedPeersHolderStub) GetRedundancyStepInReason() string {
 	if stub.GetRedundancyStepInReasonCalled != nil {
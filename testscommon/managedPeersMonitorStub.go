@@ -7,6 +7,42 @@ type ManagedPeersMonitorStub struct {
 	GetWaitingManagedKeysCalled  func() ([][]byte, error)
 	GetManagedKeysCalled         func() [][]byte
 	GetLoadedKeysCalled          func() [][]byte
+	AddManagedKeyCalled          func(privateKeyBytes []byte) ([]byte, error)
+	RemoveManagedKeyCalled       func(pkBytes []byte) error
+	PauseManagedKeyCalled        func(pkBytes []byte) error
+	ResumeManagedKeyCalled       func(pkBytes []byte) error
+}
+
+// AddManagedKey -
+func (stub *ManagedPeersMonitorStub) AddManagedKey(privateKeyBytes []byte) ([]byte, error) {
+	if stub.AddManagedKeyCalled != nil {
+		return stub.AddManagedKeyCalled(privateKeyBytes)
+	}
+	return nil, nil
+}
+
+// RemoveManagedKey -
+func (stub *ManagedPeersMonitorStub) RemoveManagedKey(pkBytes []byte) error {
+	if stub.RemoveManagedKeyCalled != nil {
+		return stub.RemoveManagedKeyCalled(pkBytes)
+	}
+	return nil
+}
+
+// PauseManagedKey -
+func (stub *ManagedPeersMonitorStub) PauseManagedKey(pkBytes []byte) error {
+	if stub.PauseManagedKeyCalled != nil {
+		return stub.PauseManagedKeyCalled(pkBytes)
+	}
+	return nil
+}
+
+// ResumeManagedKey -
+func (stub *ManagedPeersMonitorStub) ResumeManagedKey(pkBytes []byte) error {
+	if stub.ResumeManagedKeyCalled != nil {
+		return stub.ResumeManagedKeyCalled(pkBytes)
+	}
+	return nil
 }
 
 // GetManagedKeys -
@@ -77,3 +77,7 @@ func (as *OutportStub) SubscribeDriver(_ outport.Driver) error {
 // FinalizedBlock -
 func (as *OutportStub) FinalizedBlock(_ *outportcore.FinalizedBlock) {
 }
+
+// NewTransactionInPool -
+func (as *OutportStub) NewTransactionInPool(_ []byte, _ interface{}) {
+}
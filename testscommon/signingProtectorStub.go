@@ -0,0 +1,30 @@
+package testscommon
+
+// SigningProtectorStub -
+type SigningProtectorStub struct {
+	CheckAndRecordSignatureShareCalled func(publicKey []byte, epoch uint32, round int64, headerHash []byte) error
+	CheckAndRecordBlockSignatureCalled func(publicKey []byte, epoch uint32, round int64, headerHash []byte) error
+}
+
+// CheckAndRecordSignatureShare -
+func (stub *SigningProtectorStub) CheckAndRecordSignatureShare(publicKey []byte, epoch uint32, round int64, headerHash []byte) error {
+	if stub.CheckAndRecordSignatureShareCalled != nil {
+		return stub.CheckAndRecordSignatureShareCalled(publicKey, epoch, round, headerHash)
+	}
+
+	return nil
+}
+
+// CheckAndRecordBlockSignature -
+func (stub *SigningProtectorStub) CheckAndRecordBlockSignature(publicKey []byte, epoch uint32, round int64, headerHash []byte) error {
+	if stub.CheckAndRecordBlockSignatureCalled != nil {
+		return stub.CheckAndRecordBlockSignatureCalled(publicKey, epoch, round, headerHash)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *SigningProtectorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,34 @@
+package state
+
+import (
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+// AccountsAdapterCreatorStub -
+type AccountsAdapterCreatorStub struct {
+	CreateAccountsAdapterCalled func() (state.AccountsAdapter, error)
+	CloseCalled                 func() error
+}
+
+// CreateAccountsAdapter -
+func (stub *AccountsAdapterCreatorStub) CreateAccountsAdapter() (state.AccountsAdapter, error) {
+	if stub.CreateAccountsAdapterCalled != nil {
+		return stub.CreateAccountsAdapterCalled()
+	}
+
+	return &AccountsStub{}, nil
+}
+
+// Close -
+func (stub *AccountsAdapterCreatorStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *AccountsAdapterCreatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-go/common"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/state"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
@@ -38,12 +40,48 @@ type AccountsStub struct {
 	CloseCalled                   func() error
 	SetSyncerCalled               func(syncer state.AccountsDBSyncer) error
 	StartSnapshotIfNeededCalled   func() error
+	ApplyStateOverridesCalled     func(overrides []*txSimData.StateOverride) error
+	SetHistoricalStateCalled      func(options api.AccountQueryOptions)
+	StartStorageTracingCalled     func()
+	StopStorageTracingCalled      func() []*txSimData.StorageAccess
 }
 
 // CleanCache -
 func (as *AccountsStub) CleanCache() {
 }
 
+// ApplyStateOverrides -
+func (as *AccountsStub) ApplyStateOverrides(overrides []*txSimData.StateOverride) error {
+	if as.ApplyStateOverridesCalled != nil {
+		return as.ApplyStateOverridesCalled(overrides)
+	}
+
+	return nil
+}
+
+// SetHistoricalState -
+func (as *AccountsStub) SetHistoricalState(options api.AccountQueryOptions) {
+	if as.SetHistoricalStateCalled != nil {
+		as.SetHistoricalStateCalled(options)
+	}
+}
+
+// StartStorageTracing -
+func (as *AccountsStub) StartStorageTracing() {
+	if as.StartStorageTracingCalled != nil {
+		as.StartStorageTracingCalled()
+	}
+}
+
+// StopStorageTracing -
+func (as *AccountsStub) StopStorageTracing() []*txSimData.StorageAccess {
+	if as.StopStorageTracingCalled != nil {
+		return as.StopStorageTracingCalled()
+	}
+
+	return nil
+}
+
 // SetSyncer -
 func (as *AccountsStub) SetSyncer(syncer state.AccountsDBSyncer) error {
 	if as.SetSyncerCalled != nil {
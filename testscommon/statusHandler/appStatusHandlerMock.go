@@ -103,6 +103,14 @@ func (ashm *AppStatusHandlerMock) GetUint64(key string) uint64 {
 	return ashm.data[key].(uint64)
 }
 
+// GetString -
+func (ashm *AppStatusHandlerMock) GetString(key string) string {
+	ashm.mut.Lock()
+	defer ashm.mut.Unlock()
+
+	return ashm.data[key].(string)
+}
+
 // Close -
 func (ashm *AppStatusHandlerMock) Close() {
 }
@@ -0,0 +1,49 @@
+package testscommon
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// SubscriptionsHandlerStub -
+type SubscriptionsHandlerStub struct {
+	SubscribeCalled func(filter common.SubscriptionFilter) (common.Subscription, error)
+}
+
+// Subscribe -
+func (stub *SubscriptionsHandlerStub) Subscribe(filter common.SubscriptionFilter) (common.Subscription, error) {
+	if stub.SubscribeCalled != nil {
+		return stub.SubscribeCalled(filter)
+	}
+
+	return &SubscriptionStub{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *SubscriptionsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+// SubscriptionStub -
+type SubscriptionStub struct {
+	NextEventsCalled func(ctx context.Context) ([]*common.SubscriptionEvent, error)
+	CloseCalled      func()
+}
+
+// NextEvents -
+func (stub *SubscriptionStub) NextEvents(ctx context.Context) ([]*common.SubscriptionEvent, error) {
+	if stub.NextEventsCalled != nil {
+		return stub.NextEventsCalled(ctx)
+	}
+
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+// Close -
+func (stub *SubscriptionStub) Close() {
+	if stub.CloseCalled != nil {
+		stub.CloseCalled()
+	}
+}
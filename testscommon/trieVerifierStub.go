@@ -0,0 +1,42 @@
+package testscommon
+
+import "github.com/multiversx/mx-chain-go/common"
+
+// TrieVerifierStub -
+type TrieVerifierStub struct {
+	StartVerificationCalled func(rootHash []byte, heal bool) error
+	GetStatusCalled         func() common.TrieVerificationStatus
+	CloseCalled             func() error
+}
+
+// StartVerification -
+func (stub *TrieVerifierStub) StartVerification(rootHash []byte, heal bool) error {
+	if stub.StartVerificationCalled != nil {
+		return stub.StartVerificationCalled(rootHash, heal)
+	}
+
+	return nil
+}
+
+// GetStatus -
+func (stub *TrieVerifierStub) GetStatus() common.TrieVerificationStatus {
+	if stub.GetStatusCalled != nil {
+		return stub.GetStatusCalled()
+	}
+
+	return common.TrieVerificationStatus{}
+}
+
+// Close -
+func (stub *TrieVerifierStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *TrieVerifierStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -6,9 +6,12 @@ import (
 )
 
 type baseIterator struct {
-	currentNode node
-	nextNodes   []node
-	db          common.TrieStorageInteractor
+	currentNode     node
+	currentDepth    uint32
+	nextNodes       []node
+	nextNodesDepths []uint32
+	db              common.TrieStorageInteractor
+	nodesLoader     *checkedNodesLoader
 }
 
 // newBaseIterator creates a new instance of trie iterator
@@ -29,9 +32,10 @@ func newBaseIterator(trie common.Trie) (*baseIterator, error) {
 	}
 
 	return &baseIterator{
-		currentNode: pmt.root,
-		nextNodes:   nextNodes,
-		db:          trieStorage,
+		currentNode:     pmt.root,
+		nextNodes:       nextNodes,
+		nextNodesDepths: createDepths(len(nextNodes), 1),
+		db:              trieStorage,
 	}, nil
 }
 
@@ -50,7 +54,27 @@ func (it *baseIterator) next() ([]node, error) {
 	}
 
 	it.currentNode = n
-	return it.currentNode.getChildren(it.db)
+	it.currentDepth = it.nextNodesDepths[0]
+
+	return it.getCurrentNodeChildren()
+}
+
+func (it *baseIterator) getCurrentNodeChildren() ([]node, error) {
+	if it.nodesLoader == nil {
+		return it.currentNode.getChildren(it.db)
+	}
+
+	_, children, err := it.currentNode.loadChildren(it.nodesLoader.getValidNode)
+	return children, err
+}
+
+func createDepths(numNodes int, depth uint32) []uint32 {
+	depths := make([]uint32, numNodes)
+	for i := range depths {
+		depths[i] = depth
+	}
+
+	return depths
 }
 
 // MarshalizedNode marshalizes the current node, and then returns the serialized node
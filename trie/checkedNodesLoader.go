@@ -0,0 +1,54 @@
+package trie
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// FaultyNodeHandler is called with the hash of each trie node that is missing or corrupted, along with the loading
+// error. The errors of the missing nodes wrap ErrMissingTrieNode
+type FaultyNodeHandler func(hash []byte, err error)
+
+// checkedNodesLoader loads the trie nodes from a storer, checking that each node can be decoded and matches its hash
+type checkedNodesLoader struct {
+	storer            common.BaseStorer
+	marshalizer       marshal.Marshalizer
+	hasher            hashing.Hasher
+	faultyNodeHandler FaultyNodeHandler
+}
+
+func (loader *checkedNodesLoader) getValidNode(hash []byte) (node, error) {
+	n, err := loader.loadAndVerify(hash)
+	if err != nil {
+		loader.faultyNodeHandler(hash, err)
+		return nil, err
+	}
+
+	return n, nil
+}
+
+func (loader *checkedNodesLoader) loadAndVerify(hash []byte) (node, error) {
+	encodedNode, err := loader.storer.Get(hash)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrMissingTrieNode, err.Error())
+	}
+
+	computedHash := loader.hasher.Compute(string(encodedNode))
+	if !bytes.Equal(computedHash, hash) {
+		return nil, ErrInvalidNode
+	}
+
+	decodedNode, err := decodeNode(encodedNode, loader.marshalizer, loader.hasher)
+	if err != nil {
+		return nil, err
+	}
+	if !decodedNode.isValid() {
+		return nil, ErrInvalidNode
+	}
+
+	return decodedNode, nil
+}
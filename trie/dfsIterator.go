@@ -1,6 +1,21 @@
 package trie
 
-import "github.com/multiversx/mx-chain-go/common"
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// ArgsDFSIteratorWithNodesCheck holds the arguments needed to create a depth first traversal iterator that checks the
+// trie nodes it loads
+type ArgsDFSIteratorWithNodesCheck struct {
+	RootHash          []byte
+	Storer            common.BaseStorer
+	Marshalizer       marshal.Marshalizer
+	Hasher            hashing.Hasher
+	FaultyNodeHandler FaultyNodeHandler
+}
 
 type dfsIterator struct {
 	*baseIterator
@@ -18,6 +33,50 @@ func NewDFSIterator(trie common.Trie) (*dfsIterator, error) {
 	}, nil
 }
 
+// NewDFSIteratorWithNodesCheck creates a new depth first traversal iterator over the trie with the provided root hash,
+// loading the nodes from the provided storer. Unlike the iterator created from a trie, it does not stop at the nodes
+// that are missing or corrupted: these are passed to the faulty node handler and skipped together with their subtries.
+// The root node is not passed to the handler, its loading error being returned instead
+func NewDFSIteratorWithNodesCheck(args ArgsDFSIteratorWithNodesCheck) (*dfsIterator, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if args.FaultyNodeHandler == nil {
+		return nil, ErrNilFaultyNodeHandler
+	}
+
+	loader := &checkedNodesLoader{
+		storer:            args.Storer,
+		marshalizer:       args.Marshalizer,
+		hasher:            args.Hasher,
+		faultyNodeHandler: args.FaultyNodeHandler,
+	}
+	root, err := loader.loadAndVerify(args.RootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	_, nextNodes, err := root.loadChildren(loader.getValidNode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dfsIterator{
+		baseIterator: &baseIterator{
+			currentNode:     root,
+			nextNodes:       nextNodes,
+			nextNodesDepths: createDepths(len(nextNodes), 1),
+			nodesLoader:     loader,
+		},
+	}, nil
+}
+
 // Next moves the iterator to the next node
 func (it *dfsIterator) Next() error {
 	nextChildren, err := it.next()
@@ -26,5 +85,6 @@ func (it *dfsIterator) Next() error {
 	}
 
 	it.nextNodes = append(nextChildren, it.nextNodes[1:]...)
+	it.nextNodesDepths = append(createDepths(len(nextChildren), it.currentDepth+1), it.nextNodesDepths[1:]...)
 	return nil
 }
@@ -1,10 +1,14 @@
 package trie_test
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/trie"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewDFSIterator(t *testing.T) {
@@ -39,3 +43,147 @@ func TestDFSIterator_Next(t *testing.T) {
 		assert.Nil(t, err)
 	}
 }
+
+func createMockArgsDFSIteratorWithNodesCheck(t *testing.T) (trie.ArgsDFSIteratorWithNodesCheck, [][]byte) {
+	tr, _ := initTrieMultipleValues(100)
+	require.Nil(t, tr.Commit())
+
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+	hashes, err := tr.GetAllHashes()
+	require.Nil(t, err)
+
+	args := trie.ArgsDFSIteratorWithNodesCheck{
+		RootHash:          rootHash,
+		Storer:            tr.GetStorageManager(),
+		Marshalizer:       &marshal.GogoProtoMarshalizer{},
+		Hasher:            &testscommon.KeccakMock{},
+		FaultyNodeHandler: func(_ []byte, _ error) {},
+	}
+
+	return args, hashes
+}
+
+func iterateAllNodes(t *testing.T, args trie.ArgsDFSIteratorWithNodesCheck) [][]byte {
+	it, err := trie.NewDFSIteratorWithNodesCheck(args)
+	require.Nil(t, err)
+
+	hash, err := it.GetHash()
+	require.Nil(t, err)
+
+	hashes := [][]byte{hash}
+	for it.HasNext() {
+		require.Nil(t, it.Next())
+
+		hash, err = it.GetHash()
+		require.Nil(t, err)
+		hashes = append(hashes, hash)
+	}
+
+	return hashes
+}
+
+func TestNewDFSIteratorWithNodesCheck(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsDFSIteratorWithNodesCheck(t)
+		args.Storer = nil
+		it, err := trie.NewDFSIteratorWithNodesCheck(args)
+		assert.Equal(t, trie.ErrNilStorer, err)
+		assert.Nil(t, it)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsDFSIteratorWithNodesCheck(t)
+		args.Marshalizer = nil
+		it, err := trie.NewDFSIteratorWithNodesCheck(args)
+		assert.Equal(t, trie.ErrNilMarshalizer, err)
+		assert.Nil(t, it)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsDFSIteratorWithNodesCheck(t)
+		args.Hasher = nil
+		it, err := trie.NewDFSIteratorWithNodesCheck(args)
+		assert.Equal(t, trie.ErrNilHasher, err)
+		assert.Nil(t, it)
+	})
+	t.Run("nil faulty node handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsDFSIteratorWithNodesCheck(t)
+		args.FaultyNodeHandler = nil
+		it, err := trie.NewDFSIteratorWithNodesCheck(args)
+		assert.Equal(t, trie.ErrNilFaultyNodeHandler, err)
+		assert.Nil(t, it)
+	})
+	t.Run("missing root should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsDFSIteratorWithNodesCheck(t)
+		args.RootHash = []byte("missing root hash")
+		it, err := trie.NewDFSIteratorWithNodesCheck(args)
+		assert.True(t, errors.Is(err, trie.ErrMissingTrieNode))
+		assert.Nil(t, it)
+	})
+	t.Run("corrupted root should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsDFSIteratorWithNodesCheck(t)
+		require.Nil(t, args.Storer.Put(args.RootHash, []byte("corrupted node")))
+		it, err := trie.NewDFSIteratorWithNodesCheck(args)
+		assert.Equal(t, trie.ErrInvalidNode, err)
+		assert.Nil(t, it)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsDFSIteratorWithNodesCheck(t)
+		it, err := trie.NewDFSIteratorWithNodesCheck(args)
+		assert.Nil(t, err)
+		assert.NotNil(t, it)
+	})
+}
+
+func TestDFSIteratorWithNodesCheck_Next(t *testing.T) {
+	t.Parallel()
+
+	t.Run("complete trie should iterate all nodes", func(t *testing.T) {
+		t.Parallel()
+
+		args, hashes := createMockArgsDFSIteratorWithNodesCheck(t)
+		args.FaultyNodeHandler = func(_ []byte, _ error) {
+			assert.Fail(t, "should have not been called")
+		}
+
+		assert.ElementsMatch(t, hashes, iterateAllNodes(t, args))
+	})
+	t.Run("faulty nodes should be reported and skipped", func(t *testing.T) {
+		t.Parallel()
+
+		args, hashes := createMockArgsDFSIteratorWithNodesCheck(t)
+		// the hashes are listed children first, so the first leaf and the last child of the root belong to different subtries
+		missingHash := hashes[0]
+		corruptedHash := hashes[len(hashes)-2]
+		require.Nil(t, args.Storer.Remove(missingHash))
+		require.Nil(t, args.Storer.Put(corruptedHash, []byte("corrupted node")))
+
+		faultyNodes := make(map[string]error)
+		args.FaultyNodeHandler = func(hash []byte, err error) {
+			faultyNodes[string(hash)] = err
+		}
+
+		iteratedHashes := iterateAllNodes(t, args)
+		assert.NotContains(t, iteratedHashes, missingHash)
+		assert.NotContains(t, iteratedHashes, corruptedHash)
+		assert.True(t, len(iteratedHashes) <= len(hashes)-2)
+		assert.Equal(t, 2, len(faultyNodes))
+		assert.True(t, errors.Is(faultyNodes[string(missingHash)], trie.ErrMissingTrieNode))
+		assert.Equal(t, trie.ErrInvalidNode, faultyNodes[string(corruptedHash)])
+	})
+}
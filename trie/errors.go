@@ -123,3 +123,9 @@ var ErrNilTrieLeafParser = errors.New("nil trie leaf parser")
 
 // ErrInvalidNodeVersion signals that an invalid node version has been provided
 var ErrInvalidNodeVersion = errors.New("invalid node version provided")
+
+// ErrMissingTrieNode signals that a trie node is missing from the storage
+var ErrMissingTrieNode = errors.New("missing trie node")
+
+// ErrNilFaultyNodeHandler signals that a nil faulty node handler has been provided
+var ErrNilFaultyNodeHandler = errors.New("nil faulty node handler")
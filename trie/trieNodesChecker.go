@@ -0,0 +1,145 @@
+package trie
+
+import (
+	"context"
+	"errors"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const numCheckedNodesBetweenLogs = 1000000
+
+// ArgsTrieNodesChecker holds the arguments needed to create a trie nodes checker
+type ArgsTrieNodesChecker struct {
+	Storer      common.BaseStorer
+	Marshalizer marshal.Marshalizer
+	Hasher      hashing.Hasher
+}
+
+// TrieNodesCheckResult holds the outcome of a trie integrity check
+type TrieNodesCheckResult struct {
+	NumNodes       uint64
+	NumLeaves      uint64
+	MaxDepth       uint32
+	MissingNodes   [][]byte
+	CorruptedNodes [][]byte
+}
+
+type trieNodesChecker struct {
+	storer      common.BaseStorer
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+}
+
+// NewTrieNodesChecker creates a component able to check that all the nodes of a trie are present and valid in a storer
+func NewTrieNodesChecker(args ArgsTrieNodesChecker) (*trieNodesChecker, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &trieNodesChecker{
+		storer:      args.Storer,
+		marshalizer: args.Marshalizer,
+		hasher:      args.Hasher,
+	}, nil
+}
+
+// Check walks the trie with the provided root hash and reports the nodes that are missing from the storer and the
+// ones that can not be decoded or do not match their hash. The walk continues past the faulty nodes, so the result
+// covers all the reachable nodes, but the subtries under a faulty node can not be checked
+func (tnc *trieNodesChecker) Check(rootHash []byte) *TrieNodesCheckResult {
+	// the walk can not be interrupted when using the background context, so no error is returned
+	result, _ := tnc.CheckWithLeavesHandler(context.Background(), rootHash, nil)
+
+	return result
+}
+
+// CheckWithLeavesHandler works as Check but also calls the provided handler, if any, with the value of each valid leaf.
+// The walk stops when the context is done, returning the partial result along with the context error
+func (tnc *trieNodesChecker) CheckWithLeavesHandler(
+	ctx context.Context,
+	rootHash []byte,
+	leafValueHandler func(leafValue []byte),
+) (*TrieNodesCheckResult, error) {
+	result := &TrieNodesCheckResult{
+		MissingNodes:   make([][]byte, 0),
+		CorruptedNodes: make([][]byte, 0),
+	}
+	if common.IsEmptyTrie(rootHash) {
+		return result, nil
+	}
+
+	it, err := NewDFSIteratorWithNodesCheck(ArgsDFSIteratorWithNodesCheck{
+		RootHash:          rootHash,
+		Storer:            tnc.storer,
+		Marshalizer:       tnc.marshalizer,
+		Hasher:            tnc.hasher,
+		FaultyNodeHandler: result.addFaultyNode,
+	})
+	if err != nil {
+		result.addFaultyNode(rootHash, err)
+		return result, nil
+	}
+
+	for {
+		err = ctx.Err()
+		if err != nil {
+			return result, err
+		}
+
+		result.addCheckedNode(it, rootHash, leafValueHandler)
+		if !it.HasNext() {
+			return result, nil
+		}
+
+		err = it.Next()
+		if err != nil {
+			return result, err
+		}
+	}
+}
+
+func (result *TrieNodesCheckResult) addCheckedNode(it *dfsIterator, rootHash []byte, leafValueHandler func(leafValue []byte)) {
+	result.NumNodes++
+	if result.NumNodes%numCheckedNodesBetweenLogs == 0 {
+		log.Debug("checking trie nodes", "root hash", rootHash, "num checked nodes", result.NumNodes)
+	}
+	if it.currentDepth > result.MaxDepth {
+		result.MaxDepth = it.currentDepth
+	}
+
+	leaf, isLeaf := it.currentNode.(*leafNode)
+	if !isLeaf {
+		return
+	}
+
+	result.NumLeaves++
+	if leafValueHandler != nil {
+		leafValueHandler(leaf.Value)
+	}
+}
+
+func (result *TrieNodesCheckResult) addFaultyNode(hash []byte, err error) {
+	if errors.Is(err, ErrMissingTrieNode) {
+		log.Trace("trie node missing", "hash", hash, "error", err)
+		result.MissingNodes = append(result.MissingNodes, hash)
+		return
+	}
+
+	log.Trace("trie node corrupted", "hash", hash, "error", err)
+	result.CorruptedNodes = append(result.CorruptedNodes, hash)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tnc *trieNodesChecker) IsInterfaceNil() bool {
+	return tnc == nil
+}
@@ -0,0 +1,201 @@
+package trie
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createCommittedTrie(t *testing.T, numLeaves int) (*patriciaMerkleTrie, *trieStorageManager, []byte) {
+	tr, trieStorage := newEmptyTrie()
+	for i := 0; i < numLeaves; i++ {
+		key := fmt.Sprintf("key%d", i)
+		require.Nil(t, tr.Update([]byte(key), []byte("value"+key)))
+	}
+	require.Nil(t, tr.Commit())
+
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+
+	return tr, trieStorage, rootHash
+}
+
+func createTrieNodesChecker(trieStorage *trieStorageManager) *trieNodesChecker {
+	checker, _ := NewTrieNodesChecker(ArgsTrieNodesChecker{
+		Storer:      trieStorage,
+		Marshalizer: &marshal.GogoProtoMarshalizer{},
+		Hasher:      &testscommon.KeccakMock{},
+	})
+
+	return checker
+}
+
+func TestNewTrieNodesChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewTrieNodesChecker(ArgsTrieNodesChecker{
+			Marshalizer: &marshal.GogoProtoMarshalizer{},
+			Hasher:      &testscommon.KeccakMock{},
+		})
+		assert.Nil(t, checker)
+		assert.Equal(t, ErrNilStorer, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewTrieNodesChecker(ArgsTrieNodesChecker{
+			Storer: testscommon.NewMemDbMock(),
+			Hasher: &testscommon.KeccakMock{},
+		})
+		assert.Nil(t, checker)
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewTrieNodesChecker(ArgsTrieNodesChecker{
+			Storer:      testscommon.NewMemDbMock(),
+			Marshalizer: &marshal.GogoProtoMarshalizer{},
+		})
+		assert.Nil(t, checker)
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewTrieNodesChecker(ArgsTrieNodesChecker{
+			Storer:      testscommon.NewMemDbMock(),
+			Marshalizer: &marshal.GogoProtoMarshalizer{},
+			Hasher:      &testscommon.KeccakMock{},
+		})
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(checker))
+	})
+}
+
+func TestTrieNodesChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty trie", func(t *testing.T) {
+		t.Parallel()
+
+		_, trieStorage := newEmptyTrie()
+		checker := createTrieNodesChecker(trieStorage)
+
+		result := checker.Check(make([]byte, 32))
+		assert.Equal(t, uint64(0), result.NumNodes)
+		assert.Empty(t, result.MissingNodes)
+		assert.Empty(t, result.CorruptedNodes)
+	})
+	t.Run("complete trie", func(t *testing.T) {
+		t.Parallel()
+
+		numLeaves := 100
+		tr, trieStorage, rootHash := createCommittedTrie(t, numLeaves)
+		hashes, err := tr.GetAllHashes()
+		require.Nil(t, err)
+
+		checker := createTrieNodesChecker(trieStorage)
+		result := checker.Check(rootHash)
+		assert.Equal(t, uint64(len(hashes)), result.NumNodes)
+		assert.Equal(t, uint64(numLeaves), result.NumLeaves)
+		assert.True(t, result.MaxDepth > 0)
+		assert.Empty(t, result.MissingNodes)
+		assert.Empty(t, result.CorruptedNodes)
+	})
+	t.Run("missing root", func(t *testing.T) {
+		t.Parallel()
+
+		_, trieStorage, rootHash := createCommittedTrie(t, 10)
+		require.Nil(t, trieStorage.Remove(rootHash))
+
+		checker := createTrieNodesChecker(trieStorage)
+		result := checker.Check(rootHash)
+		assert.Equal(t, uint64(0), result.NumNodes)
+		assert.Equal(t, [][]byte{rootHash}, result.MissingNodes)
+		assert.Empty(t, result.CorruptedNodes)
+	})
+	t.Run("missing and corrupted nodes should be reported and the walk should continue", func(t *testing.T) {
+		t.Parallel()
+
+		tr, trieStorage, rootHash := createCommittedTrie(t, 100)
+		hashes, err := tr.GetAllHashes()
+		require.Nil(t, err)
+
+		var missingHash, corruptedHash []byte
+		for _, hash := range hashes {
+			encodedNode, errGet := trieStorage.Get(hash)
+			require.Nil(t, errGet)
+
+			n, errDecode := decodeNode(encodedNode, tr.marshalizer, tr.hasher)
+			require.Nil(t, errDecode)
+			if _, isLeaf := n.(*leafNode); !isLeaf {
+				continue
+			}
+
+			if missingHash == nil {
+				missingHash = hash
+				continue
+			}
+			corruptedHash = hash
+			break
+		}
+		require.Nil(t, trieStorage.Remove(missingHash))
+		require.Nil(t, trieStorage.Put(corruptedHash, []byte("corrupted node")))
+
+		checker := createTrieNodesChecker(trieStorage)
+		result := checker.Check(rootHash)
+		assert.Equal(t, uint64(len(hashes)-2), result.NumNodes)
+		assert.Equal(t, uint64(98), result.NumLeaves)
+		assert.Equal(t, [][]byte{missingHash}, result.MissingNodes)
+		assert.Equal(t, [][]byte{corruptedHash}, result.CorruptedNodes)
+	})
+}
+
+func TestTrieNodesChecker_CheckWithLeavesHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should call the handler for each leaf", func(t *testing.T) {
+		t.Parallel()
+
+		numLeaves := 50
+		_, trieStorage, rootHash := createCommittedTrie(t, numLeaves)
+
+		leafValues := make(map[string]struct{})
+		handler := func(leafValue []byte) {
+			leafValues[string(leafValue)] = struct{}{}
+		}
+
+		checker := createTrieNodesChecker(trieStorage)
+		result, err := checker.CheckWithLeavesHandler(context.Background(), rootHash, handler)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(numLeaves), result.NumLeaves)
+		assert.Equal(t, numLeaves, len(leafValues))
+		for i := 0; i < numLeaves; i++ {
+			_, found := leafValues[fmt.Sprintf("valuekey%d", i)]
+			assert.True(t, found)
+		}
+	})
+	t.Run("closed context should stop the walk", func(t *testing.T) {
+		t.Parallel()
+
+		_, trieStorage, rootHash := createCommittedTrie(t, 50)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		checker := createTrieNodesChecker(trieStorage)
+		result, err := checker.CheckWithLeavesHandler(ctx, rootHash, nil)
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, uint64(0), result.NumNodes)
+	})
+}